@@ -6,11 +6,13 @@ import (
 	"fmt"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
 
 	"github.com/sskorolev/balun_microservices/lib/app"
+	"github.com/sskorolev/balun_microservices/lib/idempotency"
 	"github.com/sskorolev/balun_microservices/lib/logger"
 	"github.com/sskorolev/balun_microservices/lib/secrets"
 
@@ -18,16 +20,25 @@ import (
 	"auth/internal/app/crypto"
 	deliveryGrpc "auth/internal/app/delivery/grpc"
 	"auth/internal/app/keystore"
+	"auth/internal/app/mail"
 	"auth/internal/app/repository"
 	"auth/internal/app/token"
 	"auth/internal/app/usecase"
 	"auth/internal/config"
+	authMiddleware "auth/internal/middleware/auth"
 	errorsMiddleware "auth/internal/middleware/errors"
 
 	authPb "auth/pkg/api"
 	usersPb "auth/pkg/users/api"
 )
 
+const (
+	// revocationsCleanupInterval период очистки истекших отзывов access токенов
+	revocationsCleanupInterval = 10 * time.Minute
+	// actionTokensCleanupInterval период очистки истекших токенов из писем
+	actionTokensCleanupInterval = time.Hour
+)
+
 func main() {
 	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer cancel()
@@ -94,10 +105,27 @@ func main() {
 	repo := repository.NewRepository(application.TransactionManager())
 
 	// 2. Crypto
-	passwordHasher := crypto.NewBcryptHasher(cfg.Crypto.Password.BcryptCost, cfg.Crypto.Password.MinLength)
+	passwordHasher, err := crypto.NewPasswordHasher(
+		cfg.Crypto.Password.Algorithm,
+		cfg.Crypto.Password.MinLength,
+		cfg.Crypto.Password.BcryptCost,
+		crypto.Argon2idParams{
+			MemoryKiB:   cfg.Crypto.Password.Argon2id.MemoryKiB,
+			Iterations:  cfg.Crypto.Password.Argon2id.Iterations,
+			Parallelism: cfg.Crypto.Password.Argon2id.Parallelism,
+		},
+	)
+	if err != nil {
+		logger.FatalKV(ctx, "failed to create password hasher", "error", err.Error())
+	}
 
 	// 3. Keystore
 	var keyStore keystore.KeyStore
+	keyAlgorithm, err := keystore.ParseKeyAlgorithm(cfg.Keys.Algorithm)
+	if err != nil {
+		logger.FatalKV(ctx, "invalid keys algorithm", "error", err.Error())
+	}
+
 	// Определяем какой secrets provider использовать в зависимости от окружения
 	secretsProvider := cfg.Secrets.Dev
 	if cfg.Service.Environment == "prod" || cfg.Service.Environment == "production" {
@@ -115,11 +143,11 @@ func main() {
 		if err != nil {
 			logger.FatalKV(ctx, "failed to create vault provider", "error", err)
 		}
-		keyStore = keystore.NewVaultKeyStore(vaultProvider, cfg.Keys.Vault.Path)
-		logger.InfoKV(ctx, "using Vault for RSA keys", "path", cfg.Keys.Vault.Path)
+		keyStore = keystore.NewVaultKeyStore(vaultProvider, cfg.Keys.Vault.Path, keystore.WithAlgorithm(keyAlgorithm))
+		logger.InfoKV(ctx, "using Vault for RSA keys", "path", cfg.Keys.Vault.Path, "algorithm", keyAlgorithm)
 	} else {
-		keyStore = keystore.NewDBKeyStore(application.TransactionManager())
-		logger.InfoKV(ctx, "using database for RSA keys")
+		keyStore = keystore.NewDBKeyStore(application.TransactionManager(), keystore.WithAlgorithm(keyAlgorithm))
+		logger.InfoKV(ctx, "using database for RSA keys", "algorithm", keyAlgorithm)
 
 		// Авто-создание ключа при старте, если нужно
 		if cfg.Keys.DB.AutoCreateOnStart {
@@ -143,31 +171,86 @@ func main() {
 	// 5. Adapters
 	usersClient := adapters.NewUsersClient(usersPb.NewUsersServiceClient(application.GetGRPCClient("users")))
 
+	loginMetrics, err := adapters.NewLoginMetrics()
+	if err != nil {
+		logger.FatalKV(ctx, "failed to register login metrics", "error", err.Error())
+	}
+
+	mailSender, err := mail.NewSender(cfg.Mail.Sender, cfg.Mail.Dir)
+	if err != nil {
+		logger.FatalKV(ctx, "failed to create mail sender", "error", err.Error())
+	}
+
 	// 6. Usecase
 	authUsecase := usecase.NewUsecase(
 		usersClient,
 		repo, // единый репозиторий реализует UsersRepository
 		repo, // и RefreshTokensRepository одновременно
+		repo, // и AccessTokenRevocationsRepository
+		repo, // и LoginAttemptsRepository
+		repo, // и ActionTokensRepository
+		repo, // и AuditLogRepository
+		loginMetrics,
+		mailSender,
+		application.TransactionManager(),
 		passwordHasher,
 		tokenManager,
 		keyStore,
 		usecase.Config{
-			AccessTokenTTL:  cfg.Auth.AccessTokenTTL,
-			RefreshTokenTTL: cfg.Auth.RefreshTokenTTL,
+			AccessTokenTTL:    cfg.Auth.AccessTokenTTL,
+			RefreshTokenTTL:   cfg.Auth.RefreshTokenTTL,
+			LoginProtection:   usecase.LoginProtectionConfig(cfg.Auth.LoginProtection),
+			EmailVerification: usecase.ActionTokenConfig(cfg.Auth.EmailVerification),
+			PasswordReset:     usecase.ActionTokenConfig(cfg.Auth.PasswordReset),
 		},
 	)
 
 	// 7. Controller
-	controller := deliveryGrpc.NewAuthController(authUsecase)
+	controller := deliveryGrpc.NewAuthController(authUsecase, deliveryGrpc.WithTrustedProxies(cfg.Auth.TrustedProxies))
+
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		errorsMiddleware.ErrorsUnaryInterceptor(),
+		// Управление сессиями и учетными данными требует access токен, остальные методы auth публичные
+		authMiddleware.UnaryServerInterceptor(tokenManager, repo,
+			authPb.AuthService_ListSessions_FullMethodName,
+			authPb.AuthService_RevokeSession_FullMethodName,
+			authPb.AuthService_RevokeAllOtherSessions_FullMethodName,
+			authPb.AuthService_RequestEmailVerification_FullMethodName,
+			authPb.AuthService_ChangePassword_FullMethodName,
+			authPb.AuthService_ChangeEmail_FullMethodName,
+		),
+	}
+
+	// Идемпотентность по idempotency-key, ключи хранятся в Postgres.
+	// Методы auth вызываются без JWT, поэтому ключи не привязаны к пользователю.
+	var idempotencyStore *idempotency.PostgresStore
+	if cfg.Idempotency != nil && cfg.Idempotency.Enabled {
+		idempotencyStore = idempotency.NewPostgresStore(application.Postgres())
+		unaryInterceptors = append(unaryInterceptors, idempotency.UnaryServerInterceptor(idempotencyStore,
+			idempotency.WithTTL(cfg.Idempotency.TTL),
+			idempotency.WithLockTimeout(cfg.Idempotency.LockTimeout),
+			idempotency.WithMethods(cfg.Idempotency.Methods...),
+		))
+	}
 
 	// Инициализируем gRPC сервер
-	application.InitGRPCServer(cfg.Server, errorsMiddleware.ErrorsUnaryInterceptor())
+	application.InitGRPCServer(cfg.Server, unaryInterceptors...)
 
 	// Регистрируем gRPC сервисы
 	application.RegisterGRPC(func(s *grpc.Server) {
 		authPb.RegisterAuthServiceServer(s, controller)
 	})
 
+	// Список отзывов раскрывает user_id со сменой пароля и кражей токенов, поэтому
+	// AuthInternalService только на admin сервере, не на публичном gRPC
+	if cfg.Server.Admin != nil {
+		internalServer := grpc.NewServer(grpc.ChainUnaryInterceptor(errorsMiddleware.ErrorsUnaryInterceptor()))
+		authPb.RegisterAuthInternalServiceServer(internalServer, deliveryGrpc.NewAuthInternalController(authUsecase))
+		if err := application.RegisterAdminHandler("/"+authPb.AuthInternalService_ServiceDesc.ServiceName+"/", internalServer); err != nil {
+			logger.FatalKV(ctx, "failed to register auth internal gRPC service", "error", err.Error())
+		}
+	}
+
 	// Запускаем gRPC сервер через errgroup
 	g, gCtx := errgroup.WithContext(ctx)
 
@@ -179,6 +262,49 @@ func main() {
 		return nil
 	})
 
+	// Запускаем очистку истекших ключей идемпотентности
+	if idempotencyStore != nil {
+		g.Go(func() error {
+			return idempotency.RunCleaner(gCtx, idempotencyStore, cfg.Idempotency.CleanupInterval)
+		})
+	}
+
+	// Запускаем очистку истекших отзывов access токенов
+	g.Go(func() error {
+		return runCleaner(gCtx, "access token revocations", revocationsCleanupInterval, repo.DeleteExpiredRevocations)
+	})
+
+	// Запускаем очистку истекших токенов подтверждения email и сброса пароля
+	g.Go(func() error {
+		return runCleaner(gCtx, "action tokens", actionTokensCleanupInterval, repo.DeleteExpiredActionTokens)
+	})
+
+	// Запускаем очистку счетчиков неудачных попыток входа за прошедшие окна
+	if cfg.Auth.LoginProtection.Enabled {
+		g.Go(func() error {
+			return runCleaner(gCtx, "login attempts", cfg.Auth.LoginProtection.Window, func(ctx context.Context) error {
+				return repo.DeleteStaleLoginAttempts(ctx, time.Now().Add(-cfg.Auth.LoginProtection.Window))
+			})
+		})
+	}
+
+	// Запускаем ротацию RSA ключей. Выведенные из подписи ключи проверяют токены
+	// до истечения самого долгого из них
+	if cfg.Keys.Rotation.Enabled {
+		rotator := keystore.NewRotator(keyStore, repo, application.TransactionManager(),
+			keystore.WithCheckInterval(cfg.Keys.Rotation.CheckInterval),
+			keystore.WithRotationPeriod(cfg.Keys.Rotation.Period),
+			keystore.WithOverlap(cfg.Keys.Rotation.Overlap),
+			keystore.WithRetention(max(cfg.Auth.AccessTokenTTL, cfg.Auth.RefreshTokenTTL)),
+		)
+		g.Go(func() error {
+			if err := rotator.Run(gCtx); err != nil && !errors.Is(err, context.Canceled) {
+				return err
+			}
+			return nil
+		})
+	}
+
 	// Запускаем admin HTTP сервер
 	if cfg.Server.Admin != nil {
 		g.Go(func() error {
@@ -203,6 +329,23 @@ func main() {
 	logger.InfoKV(ctx, "auth service shutdown complete")
 }
 
+// runCleaner периодически удаляет устаревшие записи, ошибки только логируются
+func runCleaner(ctx context.Context, name string, interval time.Duration, clean func(ctx context.Context) error) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := clean(ctx); err != nil {
+				logger.ErrorKV(ctx, "failed to clean up stale records", "records", name, "error", err.Error())
+			}
+		}
+	}
+}
+
 // ensureActiveKey проверяет наличие активного ключа и создает его, если отсутствует
 func ensureActiveKey(ctx context.Context, keyStore keystore.KeyStore) error {
 	_, err := keyStore.GetActiveKey(ctx)
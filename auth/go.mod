@@ -8,11 +8,15 @@ require (
 	github.com/gojuno/minimock/v3 v3.4.6
 	github.com/golang-jwt/jwt/v5 v5.3.0
 	github.com/google/uuid v1.6.0
+	github.com/jackc/pgerrcode v0.0.0-20250907135507-afb5586c32a6
 	github.com/jackc/pgx/v5 v5.7.6
+	github.com/prometheus/client_golang v1.23.2
 	github.com/spf13/viper v1.21.0
 	github.com/sskorolev/balun_microservices/lib/app v0.0.0
 	github.com/sskorolev/balun_microservices/lib/config v0.0.0
+	github.com/sskorolev/balun_microservices/lib/idempotency v0.0.0
 	github.com/sskorolev/balun_microservices/lib/logger v0.0.0
+	github.com/sskorolev/balun_microservices/lib/metrics v0.0.0
 	github.com/sskorolev/balun_microservices/lib/postgres v0.0.0
 	github.com/sskorolev/balun_microservices/lib/secrets v0.0.0
 	github.com/stretchr/testify v1.11.1
@@ -51,7 +55,6 @@ require (
 	github.com/hashicorp/hcl v1.0.1-vault-7 // indirect
 	github.com/hashicorp/vault/api v1.22.0 // indirect
 	github.com/hashicorp/vault/api/auth/approle v0.11.0 // indirect
-	github.com/jackc/pgerrcode v0.0.0-20250907135507-afb5586c32a6 // indirect
 	github.com/jackc/pgpassfile v1.0.0 // indirect
 	github.com/jackc/pgservicefile v0.0.0-20240606120523-5a60cdf6a761 // indirect
 	github.com/jackc/puddle/v2 v2.2.2 // indirect
@@ -64,7 +67,6 @@ require (
 	github.com/opentracing/opentracing-go v1.2.0 // indirect
 	github.com/pelletier/go-toml/v2 v2.2.4 // indirect
 	github.com/pmezard/go-difflib v1.0.1-0.20181226105442-5d4384ee4fb2 // indirect
-	github.com/prometheus/client_golang v1.23.2 // indirect
 	github.com/prometheus/client_model v0.6.2 // indirect
 	github.com/prometheus/common v0.67.1 // indirect
 	github.com/prometheus/procfs v0.17.0 // indirect
@@ -76,7 +78,6 @@ require (
 	github.com/sskorolev/balun_microservices/lib/admin v0.0.0 // indirect
 	github.com/sskorolev/balun_microservices/lib/authmw v0.0.0 // indirect
 	github.com/sskorolev/balun_microservices/lib/grpc v0.0.0 // indirect
-	github.com/sskorolev/balun_microservices/lib/metrics v0.0.0 // indirect
 	github.com/sskorolev/balun_microservices/lib/tracer v0.0.0 // indirect
 	github.com/subosito/gotenv v1.6.0 // indirect
 	github.com/uber/jaeger-client-go v2.30.0+incompatible // indirect
@@ -123,3 +124,5 @@ replace github.com/sskorolev/balun_microservices/lib/admin => ../lib/admin
 replace github.com/sskorolev/balun_microservices/lib/logger => ../lib/logger
 
 replace github.com/sskorolev/balun_microservices/lib/authmw => ../lib/authmw
+
+replace github.com/sskorolev/balun_microservices/lib/idempotency => ../lib/idempotency
@@ -0,0 +1,48 @@
+package adapters
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sskorolev/balun_microservices/lib/metrics"
+
+	"auth/internal/app/models"
+)
+
+// LoginMetrics - prometheus счетчики защиты входа от перебора
+type LoginMetrics struct {
+	lockouts *prometheus.CounterVec
+	rejected *prometheus.CounterVec
+}
+
+// NewLoginMetrics создает счетчики и регистрирует их в registry сервиса
+func NewLoginMetrics() (*LoginMetrics, error) {
+	m := &LoginMetrics{
+		lockouts: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metrics.Namespace(),
+			Subsystem: "auth",
+			Name:      "login_lockouts_total",
+			Help:      "Количество блокировок входа после серии неудачных попыток",
+		}, []string{"scope"}),
+		rejected: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metrics.Namespace(),
+			Subsystem: "auth",
+			Name:      "login_rejected_total",
+			Help:      "Количество попыток входа, отклоненных из-за задержки или блокировки",
+		}, []string{"scope"}),
+	}
+
+	if err := metrics.Register(m.lockouts, m.rejected); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// IncLockout увеличивает счетчик блокировок входа
+func (m *LoginMetrics) IncLockout(scope models.LoginAttemptScope) {
+	m.lockouts.WithLabelValues(string(scope)).Inc()
+}
+
+// IncRejected увеличивает счетчик отклоненных попыток входа
+func (m *LoginMetrics) IncRejected(scope models.LoginAttemptScope) {
+	m.rejected.WithLabelValues(string(scope)).Inc()
+}
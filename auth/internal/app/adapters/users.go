@@ -2,7 +2,13 @@ package adapters
 
 import (
 	"context"
+	"fmt"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"auth/internal/app/models"
 	pb "auth/pkg/users/api"
 )
 
@@ -14,15 +20,39 @@ func NewUsersClient(client pb.UsersServiceClient) *UsersClient {
 	return &UsersClient{client: client}
 }
 
-// CreateProfile - Создание профиля пользователя
-func (c *UsersClient) CreateUser(ctx context.Context, userID string, nickname string) error {
-	_, err := c.client.CreateProfile(ctx, &pb.CreateProfileRequest{
+// CreateUser - Создание профиля пользователя
+//
+// users требует JWT, поэтому вызов выполняется с access токеном нового пользователя.
+func (c *UsersClient) CreateUser(ctx context.Context, accessToken, userID, nickname string) error {
+	_, err := c.client.CreateProfile(withBearer(ctx, accessToken), &pb.CreateProfileRequest{
 		UserId:   userID,
 		Nickname: nickname,
 	})
 	if err != nil {
+		if status.Code(err) == codes.AlreadyExists {
+			return fmt.Errorf("%w: %s", models.ErrAlreadyExists, status.Convert(err).Message())
+		}
 		return err
 	}
 
 	return nil
 }
+
+// ProfileExists - Проверка, создан ли профиль пользователя
+func (c *UsersClient) ProfileExists(ctx context.Context, accessToken, userID string) (bool, error) {
+	_, err := c.client.GetProfileByID(withBearer(ctx, accessToken), &pb.GetProfileByIDRequest{
+		UserId: userID,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+func withBearer(ctx context.Context, accessToken string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+accessToken)
+}
@@ -1,8 +1,8 @@
 package crypto
 
 import (
-	"crypto/subtle"
 	"errors"
+	"strings"
 
 	"golang.org/x/crypto/bcrypt"
 )
@@ -10,12 +10,36 @@ import (
 var (
 	ErrInvalidPassword  = errors.New("invalid password")
 	ErrPasswordTooShort = errors.New("password is too short")
+	ErrUnknownHash      = errors.New("unknown password hash format")
+)
+
+// Алгоритмы хеширования паролей
+const (
+	AlgorithmArgon2id = "argon2id"
+	AlgorithmBcrypt   = "bcrypt"
 )
 
 // PasswordHasher - интерфейс для хеширования паролей
+//
+// Verify проверяет хеши всех поддерживаемых алгоритмов, поэтому смена алгоритма
+// не ломает вход пользователей со старыми хешами.
 type PasswordHasher interface {
 	Hash(password string) (string, error)
 	Verify(hash, password string) error
+	// NeedsRehash - хеш сделан другим алгоритмом или с другими параметрами и его стоит пересчитать
+	NeedsRehash(hash string) bool
+}
+
+// NewPasswordHasher создает хешер по имени алгоритма: argon2id или bcrypt
+func NewPasswordHasher(algorithm string, minLength, bcryptCost int, argon2Params Argon2idParams) (PasswordHasher, error) {
+	switch algorithm {
+	case "", AlgorithmArgon2id:
+		return NewArgon2idHasher(argon2Params, minLength), nil
+	case AlgorithmBcrypt:
+		return NewBcryptHasher(bcryptCost, minLength), nil
+	default:
+		return nil, errors.New("unknown password hashing algorithm " + algorithm)
+	}
 }
 
 // BcryptHasher - bcrypt реализация
@@ -50,6 +74,35 @@ func (h *BcryptHasher) Hash(password string) (string, error) {
 }
 
 func (h *BcryptHasher) Verify(hash, password string) error {
+	return verifyPassword(hash, password)
+}
+
+func (h *BcryptHasher) NeedsRehash(hash string) bool {
+	if !isBcryptHash(hash) {
+		return true
+	}
+	cost, err := bcrypt.Cost([]byte(hash))
+	return err != nil || cost != h.cost
+}
+
+// verifyPassword проверяет пароль по хешу, алгоритм определяется по префиксу хеша
+func verifyPassword(hash, password string) error {
+	switch {
+	case isArgon2idHash(hash):
+		return verifyArgon2id(hash, password)
+	case isBcryptHash(hash):
+		return verifyBcrypt(hash, password)
+	default:
+		return ErrUnknownHash
+	}
+}
+
+func isBcryptHash(hash string) bool {
+	return strings.HasPrefix(hash, "$2a$") || strings.HasPrefix(hash, "$2b$") || strings.HasPrefix(hash, "$2y$")
+}
+
+func verifyBcrypt(hash, password string) error {
+	// bcrypt сравнивает хеши за постоянное время
 	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
 	if err != nil {
 		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
@@ -57,12 +110,5 @@ func (h *BcryptHasher) Verify(hash, password string) error {
 		}
 		return err
 	}
-
-	// Constant-time сравнение для защиты от timing attacks
-	// (bcrypt уже делает это внутри, но добавим для явности)
-	if subtle.ConstantTimeCompare([]byte(hash), []byte(hash)) != 1 {
-		return ErrInvalidPassword
-	}
-
 	return nil
 }
@@ -0,0 +1,61 @@
+package crypto
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testArgon2idParams - облегченные параметры, чтобы тесты не тратили 19 MiB на хеш
+var testArgon2idParams = Argon2idParams{MemoryKiB: 1024, Iterations: 1, Parallelism: 1}
+
+func TestArgon2idHasher(t *testing.T) {
+	hasher := NewArgon2idHasher(testArgon2idParams, 8)
+
+	t.Run("хеш в формате PHC проверяется", func(t *testing.T) {
+		hash, err := hasher.Hash("correct horse")
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(hash, "$argon2id$v=19$m=1024,t=1,p=1$"))
+
+		require.NoError(t, hasher.Verify(hash, "correct horse"))
+		require.ErrorIs(t, hasher.Verify(hash, "wrong horse"), ErrInvalidPassword)
+		require.False(t, hasher.NeedsRehash(hash))
+	})
+
+	t.Run("короткий пароль", func(t *testing.T) {
+		_, err := hasher.Hash("short")
+		require.ErrorIs(t, err, ErrPasswordTooShort)
+	})
+
+	t.Run("неизвестный формат хеша", func(t *testing.T) {
+		require.ErrorIs(t, hasher.Verify("plain", "correct horse"), ErrUnknownHash)
+	})
+}
+
+func TestPasswordHasher_NeedsRehash(t *testing.T) {
+	bcryptHasher := NewBcryptHasher(4, 8)
+	argon2Hasher := NewArgon2idHasher(testArgon2idParams, 8)
+
+	bcryptHash, err := bcryptHasher.Hash("correct horse")
+	require.NoError(t, err)
+	argon2Hash, err := argon2Hasher.Hash("correct horse")
+	require.NoError(t, err)
+
+	t.Run("bcrypt хеш проверяется и пересчитывается в argon2id", func(t *testing.T) {
+		require.NoError(t, argon2Hasher.Verify(bcryptHash, "correct horse"))
+		require.True(t, argon2Hasher.NeedsRehash(bcryptHash))
+	})
+
+	t.Run("argon2id хеш с другими параметрами пересчитывается", func(t *testing.T) {
+		stronger := NewArgon2idHasher(Argon2idParams{MemoryKiB: 2048, Iterations: 1, Parallelism: 1}, 8)
+		require.NoError(t, stronger.Verify(argon2Hash, "correct horse"))
+		require.True(t, stronger.NeedsRehash(argon2Hash))
+	})
+
+	t.Run("bcrypt с другим cost пересчитывается", func(t *testing.T) {
+		require.False(t, bcryptHasher.NeedsRehash(bcryptHash))
+		require.True(t, NewBcryptHasher(5, 8).NeedsRehash(bcryptHash))
+		require.True(t, bcryptHasher.NeedsRehash(argon2Hash))
+	})
+}
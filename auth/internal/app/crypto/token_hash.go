@@ -1,12 +1,26 @@
 package crypto
 
 import (
+	"crypto/rand"
 	"crypto/sha256"
+	"encoding/base64"
 	"encoding/hex"
 )
 
+// actionTokenBytes - размер случайной части одноразового токена
+const actionTokenBytes = 32
+
 // HashToken - создает SHA-256 хеш токена для хранения в БД
 func HashToken(token string) string {
 	hash := sha256.Sum256([]byte(token))
 	return hex.EncodeToString(hash[:])
 }
+
+// GenerateToken - случайный одноразовый токен для ссылок в письмах (base64url)
+func GenerateToken() (string, error) {
+	b := make([]byte, actionTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
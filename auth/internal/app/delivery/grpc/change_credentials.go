@@ -0,0 +1,67 @@
+package grpc
+
+import (
+	"context"
+
+	"auth/internal/app/usecase/dto"
+
+	pb "auth/pkg/api"
+)
+
+func (h *AuthController) ChangePassword(ctx context.Context, req *pb.ChangePasswordRequest) (*pb.ChangePasswordResponse, error) {
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateNotEmpty("currentPassword", req.GetCurrentPassword()); err != nil {
+		return nil, err
+	}
+	if err := validateNotEmpty("newPassword", req.GetNewPassword()); err != nil {
+		return nil, err
+	}
+	if err := validateDeviceID("currentDeviceId", req.GetCurrentDeviceId()); err != nil {
+		return nil, err
+	}
+
+	resp, err := h.usecase.ChangePassword(ctx, dto.ChangePasswordRequest{
+		UserID:          userID,
+		CurrentPassword: req.GetCurrentPassword(),
+		NewPassword:     req.GetNewPassword(),
+		CurrentDeviceID: req.GetCurrentDeviceId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ChangePasswordResponse{
+		Revoked:      resp.Revoked,
+		AccessToken:  resp.Token.AccessToken,
+		RefreshToken: resp.Token.RefreshToken,
+	}, nil
+}
+
+func (h *AuthController) ChangeEmail(ctx context.Context, req *pb.ChangeEmailRequest) (*pb.ChangeEmailResponse, error) {
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateNotEmpty("currentPassword", req.GetCurrentPassword()); err != nil {
+		return nil, err
+	}
+	if err := validateNotEmpty("newEmail", req.GetNewEmail()); err != nil {
+		return nil, err
+	}
+
+	err = h.usecase.ChangeEmail(ctx, dto.ChangeEmailRequest{
+		UserID:          userID,
+		CurrentPassword: req.GetCurrentPassword(),
+		NewEmail:        req.GetNewEmail(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ChangeEmailResponse{}, nil
+}
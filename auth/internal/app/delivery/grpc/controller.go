@@ -1,17 +1,34 @@
 package grpc
 
 import (
+	"net/netip"
+
 	"auth/internal/app/usecase"
 	pb "auth/pkg/api"
 )
 
 type AuthController struct {
 	pb.AuthServiceServer
-	usecase usecase.Usecase
+	usecase        usecase.Usecase
+	trustedProxies []netip.Prefix
+}
+
+// Option - опция AuthController
+type Option func(*AuthController)
+
+// WithTrustedProxies адреса прокси (gateway), от которых принимается x-forwarded-for
+func WithTrustedProxies(proxies []netip.Prefix) Option {
+	return func(h *AuthController) {
+		h.trustedProxies = proxies
+	}
 }
 
-func NewAuthController(usecase usecase.Usecase) *AuthController {
-	return &AuthController{
+func NewAuthController(usecase usecase.Usecase, opts ...Option) *AuthController {
+	h := &AuthController{
 		usecase: usecase,
 	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
 }
@@ -31,15 +31,16 @@ func TestAuthController_Register(t *testing.T) {
 			req: &pb.RegisterRequest{
 				Email:    "test@example.com",
 				Password: "password123",
+				Nickname: "tester",
 			},
 			setupMocks: func(ctx context.Context, usecase *mocks.UsecaseMock) {
 				usecase.RegisterMock.Expect(ctx, dto.RegisterRequest{
 					Email:    "test@example.com",
 					Password: "password123",
+					Nickname: "tester",
 				}).Return(&models.User{
-					ID:       "550e8400-e29b-41d4-a716-446655440000",
-					Email:    "test@example.com",
-					Password: "password123",
+					ID:    "550e8400-e29b-41d4-a716-446655440000",
+					Email: "test@example.com",
 				}, nil)
 			},
 			expectedResponse: &pb.RegisterResponse{
@@ -52,6 +53,7 @@ func TestAuthController_Register(t *testing.T) {
 			req: &pb.RegisterRequest{
 				Email:    "",
 				Password: "password123",
+				Nickname: "tester",
 			},
 			setupMocks: func(ctx context.Context, usecase *mocks.UsecaseMock) {
 				// мок не вызывается, ошибка валидации
@@ -69,6 +71,7 @@ func TestAuthController_Register(t *testing.T) {
 			req: &pb.RegisterRequest{
 				Email:    "test@example.com",
 				Password: "",
+				Nickname: "tester",
 			},
 			setupMocks: func(ctx context.Context, usecase *mocks.UsecaseMock) {
 				// мок не вызывается, ошибка валидации
@@ -86,11 +89,13 @@ func TestAuthController_Register(t *testing.T) {
 			req: &pb.RegisterRequest{
 				Email:    "existing@example.com",
 				Password: "password123",
+				Nickname: "tester",
 			},
 			setupMocks: func(ctx context.Context, usecase *mocks.UsecaseMock) {
 				usecase.RegisterMock.Expect(ctx, dto.RegisterRequest{
 					Email:    "existing@example.com",
 					Password: "password123",
+					Nickname: "tester",
 				}).Return(nil, models.ErrAlreadyExists)
 			},
 			expectedResponse: nil,
@@ -101,11 +106,13 @@ func TestAuthController_Register(t *testing.T) {
 			req: &pb.RegisterRequest{
 				Email:    "test@example.com",
 				Password: "password123",
+				Nickname: "tester",
 			},
 			setupMocks: func(ctx context.Context, usecase *mocks.UsecaseMock) {
 				usecase.RegisterMock.Expect(ctx, dto.RegisterRequest{
 					Email:    "test@example.com",
 					Password: "password123",
+					Nickname: "tester",
 				}).Return(nil, errors.New("database error"))
 			},
 			expectedResponse: nil,
@@ -299,7 +306,6 @@ func TestAuthController_Refresh(t *testing.T) {
 			},
 			setupMocks: func(ctx context.Context, usecase *mocks.UsecaseMock) {
 				usecase.RefreshMock.Expect(ctx, dto.RefreshRequest{
-					UserID:       "1", // hardcoded в методе Refresh (пока string "1", потом исправим на реальный UUID)
 					RefreshToken: "valid-refresh-token",
 				}).Return(&models.User{
 					ID:    "550e8400-e29b-41d4-a716-446655440000",
@@ -325,7 +331,6 @@ func TestAuthController_Refresh(t *testing.T) {
 			},
 			setupMocks: func(ctx context.Context, usecase *mocks.UsecaseMock) {
 				usecase.RefreshMock.Expect(ctx, dto.RefreshRequest{
-					UserID:       "1",
 					RefreshToken: "valid-refresh-token",
 				}).Return(nil, models.ErrNotFound)
 			},
@@ -339,7 +344,6 @@ func TestAuthController_Refresh(t *testing.T) {
 			},
 			setupMocks: func(ctx context.Context, usecase *mocks.UsecaseMock) {
 				usecase.RefreshMock.Expect(ctx, dto.RefreshRequest{
-					UserID:       "1",
 					RefreshToken: "invalid-token",
 				}).Return(nil, errors.New("wrong token"))
 			},
@@ -353,7 +357,6 @@ func TestAuthController_Refresh(t *testing.T) {
 			},
 			setupMocks: func(ctx context.Context, usecase *mocks.UsecaseMock) {
 				usecase.RefreshMock.Expect(ctx, dto.RefreshRequest{
-					UserID:       "1",
 					RefreshToken: "valid-refresh-token",
 				}).Return(nil, errors.New("database error"))
 			},
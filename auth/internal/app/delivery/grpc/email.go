@@ -0,0 +1,32 @@
+package grpc
+
+import (
+	"context"
+
+	pb "auth/pkg/api"
+)
+
+func (h *AuthController) RequestEmailVerification(ctx context.Context, _ *pb.RequestEmailVerificationRequest) (*pb.RequestEmailVerificationResponse, error) {
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.usecase.RequestEmailVerification(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	return &pb.RequestEmailVerificationResponse{}, nil
+}
+
+func (h *AuthController) ConfirmEmail(ctx context.Context, req *pb.ConfirmEmailRequest) (*pb.ConfirmEmailResponse, error) {
+	if err := validateNotEmpty("token", req.GetToken()); err != nil {
+		return nil, err
+	}
+
+	if err := h.usecase.ConfirmEmail(ctx, req.GetToken()); err != nil {
+		return nil, err
+	}
+
+	return &pb.ConfirmEmailResponse{}, nil
+}
@@ -22,6 +22,9 @@ func (h *AuthController) GetJWKS(ctx context.Context, req *pb.GetJWKSRequest) (*
 			Alg: key.Alg,
 			N:   key.N,
 			E:   key.E,
+			X:   key.X,
+			Y:   key.Y,
+			Crv: key.Crv,
 		})
 	}
 
@@ -3,12 +3,15 @@ package grpc
 import (
 	"context"
 	"log"
+	"net/netip"
+	"strings"
 
 	"auth/internal/app/usecase/dto"
 
 	pb "auth/pkg/api"
 
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 )
 
 func (h *AuthController) Login(ctx context.Context, req *pb.LoginRequest) (*pb.LoginResponse, error) {
@@ -29,6 +32,7 @@ func (h *AuthController) Login(ctx context.Context, req *pb.LoginRequest) (*pb.L
 		Email:    req.GetEmail(),
 		Password: req.GetPassword(),
 		DeviceID: req.GetDeviceId(),
+		ClientIP: h.clientIP(ctx),
 	})
 	if err != nil {
 		return nil, err
@@ -40,3 +44,54 @@ func (h *AuthController) Login(ctx context.Context, req *pb.LoginRequest) (*pb.L
 		RefreshToken: user.Token.RefreshToken,
 	}, nil
 }
+
+// clientIP адрес клиента для защиты входа от перебора
+//
+// x-forwarded-for учитывается, только если запрос пришел от доверенного прокси (gateway):
+// адреса в нем просматриваются справа налево до первого недоверенного. От остальных пиров
+// заголовок игнорируется, иначе клиент подменял бы адрес и обходил блокировку по IP.
+func (h *AuthController) clientIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	addrPort, err := netip.ParseAddrPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	peerIP := addrPort.Addr().Unmap()
+	if !h.isTrustedProxy(peerIP) {
+		return peerIP.String()
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	var addrs []string
+	for _, value := range md.Get("x-forwarded-for") {
+		addrs = append(addrs, strings.Split(value, ",")...)
+	}
+
+	clientIP := peerIP
+	for i := len(addrs) - 1; i >= 0; i-- {
+		ip, err := netip.ParseAddr(strings.TrimSpace(addrs[i]))
+		if err != nil {
+			// Дальше адреса записаны не доверенными прокси
+			break
+		}
+		clientIP = ip.Unmap()
+		if !h.isTrustedProxy(clientIP) {
+			break
+		}
+	}
+
+	return clientIP.String()
+}
+
+func (h *AuthController) isTrustedProxy(ip netip.Addr) bool {
+	for _, prefix := range h.trustedProxies {
+		if prefix.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
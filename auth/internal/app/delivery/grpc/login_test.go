@@ -0,0 +1,92 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestAuthController_clientIP(t *testing.T) {
+	gateway := netip.MustParsePrefix("172.16.0.0/12")
+	withPeer := func(ip string, forwardedFor ...string) context.Context {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{
+			Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 51234},
+		})
+		if len(forwardedFor) > 0 {
+			ctx = metadata.NewIncomingContext(ctx, metadata.MD{"x-forwarded-for": forwardedFor})
+		}
+		return ctx
+	}
+
+	tests := []struct {
+		name           string
+		trustedProxies []netip.Prefix
+		ctx            context.Context
+		want           string
+	}{
+		{
+			name: "без доверенных прокси x-forwarded-for игнорируется",
+			ctx:  withPeer("172.18.0.5", "203.0.113.7"),
+			want: "172.18.0.5",
+		},
+		{
+			name:           "x-forwarded-for от недоверенного пира игнорируется",
+			trustedProxies: []netip.Prefix{gateway},
+			ctx:            withPeer("198.51.100.20", "203.0.113.7"),
+			want:           "198.51.100.20",
+		},
+		{
+			name:           "адрес, который дописал gateway",
+			trustedProxies: []netip.Prefix{gateway},
+			ctx:            withPeer("172.18.0.5", "203.0.113.7"),
+			want:           "203.0.113.7",
+		},
+		{
+			name:           "подставленный клиентом x-forwarded-for не учитывается",
+			trustedProxies: []netip.Prefix{gateway},
+			ctx:            withPeer("172.18.0.5", "10.0.0.1, 203.0.113.7"),
+			want:           "203.0.113.7",
+		},
+		{
+			name:           "цепочка доверенных прокси",
+			trustedProxies: []netip.Prefix{gateway, netip.MustParsePrefix("192.0.2.10/32")},
+			ctx:            withPeer("172.18.0.5", "10.0.0.1, 203.0.113.7", "192.0.2.10"),
+			want:           "203.0.113.7",
+		},
+		{
+			name:           "некорректный адрес в x-forwarded-for",
+			trustedProxies: []netip.Prefix{gateway},
+			ctx:            withPeer("172.18.0.5", "203.0.113.7, unknown"),
+			want:           "172.18.0.5",
+		},
+		{
+			name:           "доверенный пир без x-forwarded-for",
+			trustedProxies: []netip.Prefix{gateway},
+			ctx:            withPeer("172.18.0.5"),
+			want:           "172.18.0.5",
+		},
+		{
+			name:           "IPv4 адрес пира в IPv6 записи",
+			trustedProxies: []netip.Prefix{gateway},
+			ctx:            withPeer("::ffff:172.18.0.5", "2001:db8::1"),
+			want:           "2001:db8::1",
+		},
+		{
+			name: "без пира",
+			ctx:  metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-forwarded-for", "203.0.113.7")),
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewAuthController(nil, WithTrustedProxies(tt.trustedProxies))
+			assert.Equal(t, tt.want, h.clientIP(tt.ctx))
+		})
+	}
+}
@@ -4,13 +4,18 @@ import (
 	"context"
 
 	"auth/internal/app/usecase/dto"
+	authMiddleware "auth/internal/middleware/auth"
 
 	pb "auth/pkg/api"
 )
 
 func (h *AuthController) Logout(ctx context.Context, req *pb.LogoutRequest) (*pb.LogoutResponse, error) {
+	// Access токен необязателен: Logout вызывается и без него
+	accessToken, _ := authMiddleware.BearerToken(ctx)
+
 	err := h.usecase.Logout(ctx, dto.LogoutRequest{
 		RefreshToken: req.GetRefreshToken(),
+		AccessToken:  accessToken,
 	})
 	if err != nil {
 		return nil, err
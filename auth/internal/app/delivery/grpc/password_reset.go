@@ -0,0 +1,40 @@
+package grpc
+
+import (
+	"context"
+
+	"auth/internal/app/usecase/dto"
+
+	pb "auth/pkg/api"
+)
+
+func (h *AuthController) RequestPasswordReset(ctx context.Context, req *pb.RequestPasswordResetRequest) (*pb.RequestPasswordResetResponse, error) {
+	if err := validateNotEmpty("email", req.GetEmail()); err != nil {
+		return nil, err
+	}
+
+	if err := h.usecase.RequestPasswordReset(ctx, req.GetEmail()); err != nil {
+		return nil, err
+	}
+
+	return &pb.RequestPasswordResetResponse{}, nil
+}
+
+func (h *AuthController) ResetPassword(ctx context.Context, req *pb.ResetPasswordRequest) (*pb.ResetPasswordResponse, error) {
+	if err := validateNotEmpty("token", req.GetToken()); err != nil {
+		return nil, err
+	}
+	if err := validateNotEmpty("newPassword", req.GetNewPassword()); err != nil {
+		return nil, err
+	}
+
+	err := h.usecase.ResetPassword(ctx, dto.ResetPasswordRequest{
+		Token:       req.GetToken(),
+		NewPassword: req.GetNewPassword(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ResetPasswordResponse{}, nil
+}
@@ -25,9 +25,14 @@ func (h *AuthController) Register(ctx context.Context, req *pb.RegisterRequest)
 		return nil, err
 	}
 
+	if err := validateNickname(req.GetNickname()); err != nil {
+		return nil, err
+	}
+
 	user, err := h.usecase.Register(ctx, dto.RegisterRequest{
 		Email:    req.GetEmail(),
 		Password: req.GetPassword(),
+		Nickname: req.GetNickname(),
 	})
 	if err != nil {
 		return nil, err
@@ -0,0 +1,47 @@
+package grpc
+
+import (
+	"context"
+
+	"auth/internal/app/usecase"
+	pb "auth/pkg/api"
+)
+
+// AuthInternalController - методы для других сервисов, регистрируется только на admin сервере
+type AuthInternalController struct {
+	pb.AuthInternalServiceServer
+	usecase usecase.Usecase
+}
+
+func NewAuthInternalController(usecase usecase.Usecase) *AuthInternalController {
+	return &AuthInternalController{
+		usecase: usecase,
+	}
+}
+
+func (h *AuthInternalController) GetRevocations(ctx context.Context, _ *pb.GetRevocationsRequest) (*pb.GetRevocationsResponse, error) {
+	revocations, err := h.usecase.GetRevocations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.GetRevocationsResponse{
+		RevokedTokens:  make([]*pb.RevokedAccessToken, 0, len(revocations.Tokens)),
+		UserWatermarks: make([]*pb.UserTokenWatermark, 0, len(revocations.Watermarks)),
+	}
+	for _, token := range revocations.Tokens {
+		resp.RevokedTokens = append(resp.RevokedTokens, &pb.RevokedAccessToken{
+			Jti:           token.JTI,
+			ExpiresAtUnix: token.ExpiresAt.Unix(),
+		})
+	}
+	for _, watermark := range revocations.Watermarks {
+		resp.UserWatermarks = append(resp.UserWatermarks, &pb.UserTokenWatermark{
+			UserId:        watermark.UserID,
+			NotBeforeUnix: watermark.NotBefore.Unix(),
+			ExpiresAtUnix: watermark.ExpiresAt.Unix(),
+		})
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,108 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"auth/internal/app/usecase/dto"
+	authMiddleware "auth/internal/middleware/auth"
+
+	pb "auth/pkg/api"
+)
+
+func (h *AuthController) ListSessions(ctx context.Context, _ *pb.ListSessionsRequest) (*pb.ListSessionsResponse, error) {
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := h.usecase.ListSessions(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	pbSessions := make([]*pb.Session, 0, len(sessions))
+	for _, session := range sessions {
+		pbSessions = append(pbSessions, &pb.Session{
+			DeviceId:            session.DeviceID,
+			CreatedAtUnixMs:     session.CreatedAt.UnixMilli(),
+			LastRefreshAtUnixMs: session.LastRefreshAt.UnixMilli(),
+			ExpiresAtUnixMs:     session.ExpiresAt.UnixMilli(),
+		})
+	}
+
+	return &pb.ListSessionsResponse{Sessions: pbSessions}, nil
+}
+
+func (h *AuthController) RevokeSession(ctx context.Context, req *pb.RevokeSessionRequest) (*pb.RevokeSessionResponse, error) {
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateDeviceID("deviceId", req.GetDeviceId()); err != nil {
+		return nil, err
+	}
+
+	revoked, err := h.usecase.RevokeSession(ctx, dto.RevokeSessionRequest{
+		UserID:   userID,
+		DeviceID: req.GetDeviceId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RevokeSessionResponse{Revoked: revoked}, nil
+}
+
+func (h *AuthController) RevokeAllOtherSessions(ctx context.Context, req *pb.RevokeAllOtherSessionsRequest) (*pb.RevokeAllOtherSessionsResponse, error) {
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateDeviceID("currentDeviceId", req.GetCurrentDeviceId()); err != nil {
+		return nil, err
+	}
+
+	revoked, err := h.usecase.RevokeAllOtherSessions(ctx, dto.RevokeAllOtherSessionsRequest{
+		UserID:          userID,
+		CurrentDeviceID: req.GetCurrentDeviceId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RevokeAllOtherSessionsResponse{Revoked: revoked}, nil
+}
+
+// userIDFromContext пользователь из access токена
+func userIDFromContext(ctx context.Context) (string, error) {
+	userID, ok := authMiddleware.GetUserID(ctx)
+	if !ok {
+		return "", status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+	return userID, nil
+}
+
+func validateDeviceID(field, deviceID string) error {
+	if deviceID != "" {
+		return nil
+	}
+
+	rpcErr := status.New(codes.InvalidArgument, "id устройства пустой")
+	detailedError, err := rpcErr.WithDetails(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{{
+			Field:       field,
+			Description: "empty",
+		}},
+	})
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return detailedError.Err()
+}
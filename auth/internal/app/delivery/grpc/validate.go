@@ -1,6 +1,8 @@
 package grpc
 
 import (
+	"regexp"
+
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -36,3 +38,46 @@ func (h *AuthController) validateCredentials(email string, password string) erro
 
 	return nil
 }
+
+// nicknameRe - то же правило, что у users для CreateProfileRequest.nickname
+var nicknameRe = regexp.MustCompile(`^[a-z0-9_]{3,20}$`)
+
+func validateNickname(nickname string) error {
+	if nicknameRe.MatchString(nickname) {
+		return nil
+	}
+
+	rpcErr := status.New(codes.InvalidArgument, "некорректный nickname")
+
+	detailedError, err := rpcErr.WithDetails(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{{
+			Field:       "nickname",
+			Description: "must match ^[a-z0-9_]{3,20}$",
+		}},
+	})
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return detailedError.Err()
+}
+
+func validateNotEmpty(field, value string) error {
+	if value != "" {
+		return nil
+	}
+
+	rpcErr := status.New(codes.InvalidArgument, field+" пустой")
+
+	detailedError, err := rpcErr.WithDetails(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{{
+			Field:       field,
+			Description: "empty",
+		}},
+	})
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return detailedError.Err()
+}
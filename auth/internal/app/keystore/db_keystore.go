@@ -14,20 +14,20 @@ import (
 // DBKeyStore - реализация KeyStore через PostgreSQL (fallback для dev)
 type DBKeyStore struct {
 	tm        postgres.TransactionManagerAPI
-	generator *RSAKeyGenerator
+	generator *KeyGenerator
 }
 
-func NewDBKeyStore(tm postgres.TransactionManagerAPI) *DBKeyStore {
+func NewDBKeyStore(tm postgres.TransactionManagerAPI, opts ...Option) *DBKeyStore {
 	return &DBKeyStore{
 		tm:        tm,
-		generator: NewRSAKeyGenerator(),
+		generator: NewKeyGenerator(opts...),
 	}
 }
 
 // GetActiveKey возвращает активный ключ
 func (s *DBKeyStore) GetActiveKey(ctx context.Context) (*RSAKey, error) {
 	query := `
-		SELECT kid, private_key_pem, public_key_pem, status
+		SELECT kid, algorithm, private_key_pem, public_key_pem, status, created_at, expires_at
 		FROM rsa_keys
 		WHERE status = $1
 		ORDER BY created_at DESC
@@ -37,7 +37,7 @@ func (s *DBKeyStore) GetActiveKey(ctx context.Context) (*RSAKey, error) {
 	var key RSAKey
 	conn := s.tm.GetQueryEngine(ctx)
 	err := conn.QueryRow(ctx, query, KeyStatusActive).Scan(
-		&key.KID, &key.PrivateKeyPEM, &key.PublicKeyPEM, &key.Status,
+		&key.KID, &key.Algorithm, &key.PrivateKeyPEM, &key.PublicKeyPEM, &key.Status, &key.CreatedAt, &key.ExpiresAt,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -52,7 +52,7 @@ func (s *DBKeyStore) GetActiveKey(ctx context.Context) (*RSAKey, error) {
 // GetKeyByKID возвращает ключ по KID
 func (s *DBKeyStore) GetKeyByKID(ctx context.Context, kid string) (*RSAKey, error) {
 	query := `
-		SELECT kid, private_key_pem, public_key_pem, status
+		SELECT kid, algorithm, private_key_pem, public_key_pem, status, created_at, expires_at
 		FROM rsa_keys
 		WHERE kid = $1
 	`
@@ -60,7 +60,7 @@ func (s *DBKeyStore) GetKeyByKID(ctx context.Context, kid string) (*RSAKey, erro
 	var key RSAKey
 	conn := s.tm.GetQueryEngine(ctx)
 	err := conn.QueryRow(ctx, query, kid).Scan(
-		&key.KID, &key.PrivateKeyPEM, &key.PublicKeyPEM, &key.Status,
+		&key.KID, &key.Algorithm, &key.PrivateKeyPEM, &key.PublicKeyPEM, &key.Status, &key.CreatedAt, &key.ExpiresAt,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -75,7 +75,7 @@ func (s *DBKeyStore) GetKeyByKID(ctx context.Context, kid string) (*RSAKey, erro
 // GetAllActiveKeys возвращает все активные ключи (active + next)
 func (s *DBKeyStore) GetAllActiveKeys(ctx context.Context) ([]*RSAKey, error) {
 	query := `
-		SELECT kid, private_key_pem, public_key_pem, status
+		SELECT kid, algorithm, private_key_pem, public_key_pem, status, created_at, expires_at
 		FROM rsa_keys
 		WHERE status IN ($1, $2)
 		ORDER BY created_at DESC
@@ -91,47 +91,36 @@ func (s *DBKeyStore) GetAllActiveKeys(ctx context.Context) ([]*RSAKey, error) {
 	var keys []*RSAKey
 	for rows.Next() {
 		var key RSAKey
-		if err := rows.Scan(&key.KID, &key.PrivateKeyPEM, &key.PublicKeyPEM, &key.Status); err != nil {
+		if err := rows.Scan(&key.KID, &key.Algorithm, &key.PrivateKeyPEM, &key.PublicKeyPEM, &key.Status, &key.CreatedAt, &key.ExpiresAt); err != nil {
 			return nil, err
 		}
 		keys = append(keys, &key)
 	}
 
-	return keys, nil
+	return keys, rows.Err()
 }
 
-// CreateKey создает новый RSA ключ
+// CreateKey создает новый ключ подписи
 func (s *DBKeyStore) CreateKey(ctx context.Context, status KeyStatus) (*RSAKey, error) {
-	// Генерируем RSA ключ
-	privateKey, err := s.generator.Generate()
+	privateKeyPEM, publicKeyPEM, err := s.generator.GeneratePEM()
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate RSA key: %w", err)
-	}
-
-	privateKeyPEM, err := EncodePrivateKeyToPEM(privateKey)
-	if err != nil {
-		return nil, fmt.Errorf("failed to encode private key: %w", err)
-	}
-
-	publicKeyPEM, err := EncodePublicKeyToPEM(&privateKey.PublicKey)
-	if err != nil {
-		return nil, fmt.Errorf("failed to encode public key: %w", err)
+		return nil, err
 	}
 
 	kid := uuid.New().String()
 	now := time.Now()
 
 	query := `
-		INSERT INTO rsa_keys (kid, private_key_pem, public_key_pem, status, created_at)
-		VALUES ($1, $2, $3, $4, $5)
-		RETURNING kid, private_key_pem, public_key_pem, status
+		INSERT INTO rsa_keys (kid, algorithm, private_key_pem, public_key_pem, status, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING kid, algorithm, private_key_pem, public_key_pem, status, created_at, expires_at
 	`
 
 	key := &RSAKey{}
 	conn := s.tm.GetQueryEngine(ctx)
 	err = conn.QueryRow(
-		ctx, query, kid, privateKeyPEM, publicKeyPEM, status, now,
-	).Scan(&key.KID, &key.PrivateKeyPEM, &key.PublicKeyPEM, &key.Status)
+		ctx, query, kid, s.generator.Algorithm(), privateKeyPEM, publicKeyPEM, status, now,
+	).Scan(&key.KID, &key.Algorithm, &key.PrivateKeyPEM, &key.PublicKeyPEM, &key.Status, &key.CreatedAt, &key.ExpiresAt)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create key: %w", err)
 	}
@@ -155,3 +144,48 @@ func (s *DBKeyStore) UpdateKeyStatus(ctx context.Context, kid string, status Key
 
 	return nil
 }
+
+// ListKeys возвращает неистекшие ключи (active + next) без приватной части
+func (s *DBKeyStore) ListKeys(ctx context.Context) ([]*RSAKey, error) {
+	query := `
+		SELECT kid, algorithm, public_key_pem, status, created_at, expires_at
+		FROM rsa_keys
+		WHERE status IN ($1, $2)
+		ORDER BY created_at DESC
+	`
+
+	conn := s.tm.GetQueryEngine(ctx)
+	rows, err := conn.Query(ctx, query, KeyStatusActive, KeyStatusNext)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list keys: %w", err)
+	}
+	defer rows.Close()
+
+	var keys []*RSAKey
+	for rows.Next() {
+		var key RSAKey
+		if err := rows.Scan(&key.KID, &key.Algorithm, &key.PublicKeyPEM, &key.Status, &key.CreatedAt, &key.ExpiresAt); err != nil {
+			return nil, err
+		}
+		keys = append(keys, &key)
+	}
+
+	return keys, rows.Err()
+}
+
+// SetKeyExpiration задает время истечения ключа
+func (s *DBKeyStore) SetKeyExpiration(ctx context.Context, kid string, expiresAt time.Time) error {
+	query := `
+		UPDATE rsa_keys
+		SET expires_at = $1
+		WHERE kid = $2
+	`
+
+	conn := s.tm.GetQueryEngine(ctx)
+	_, err := conn.Exec(ctx, query, expiresAt, kid)
+	if err != nil {
+		return fmt.Errorf("failed to set key expiration: %w", err)
+	}
+
+	return nil
+}
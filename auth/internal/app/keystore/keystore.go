@@ -3,6 +3,7 @@ package keystore
 import (
 	"context"
 	"errors"
+	"time"
 )
 
 var (
@@ -11,6 +12,10 @@ var (
 )
 
 // KeyStatus - статус RSA ключа
+//
+// Жизненный цикл при ротации: next (опубликован в JWKS, не подписывает) -> active
+// (самый новый active подписывает токены) -> active с ExpiresAt (уже не подписывает,
+// но проверяет выданные токены) -> expired.
 type KeyStatus string
 
 const (
@@ -19,17 +24,20 @@ const (
 	KeyStatusExpired KeyStatus = "expired"
 )
 
-// RSAKey - структура RSA ключа
+// RSAKey - структура ключа подписи. Исторически RSA, алгоритм задается в Algorithm
 type RSAKey struct {
 	KID           string
+	Algorithm     KeyAlgorithm
 	PrivateKeyPEM string
 	PublicKeyPEM  string
 	Status        KeyStatus
+	CreatedAt     time.Time
+	ExpiresAt     *time.Time // задается при выводе ключа из подписи
 }
 
 // KeyStore - интерфейс для управления RSA ключами
 type KeyStore interface {
-	// GetActiveKey возвращает активный ключ для подписи
+	// GetActiveKey возвращает активный ключ для подписи (самый новый active)
 	GetActiveKey(ctx context.Context) (*RSAKey, error)
 
 	// GetKeyByKID возвращает ключ по его ID
@@ -38,9 +46,15 @@ type KeyStore interface {
 	// GetAllActiveKeys возвращает все активные ключи (active + next)
 	GetAllActiveKeys(ctx context.Context) ([]*RSAKey, error)
 
-	// CreateKey создает новый RSA ключ
+	// CreateKey создает новый ключ с алгоритмом, заданным хранилищу
 	CreateKey(ctx context.Context, status KeyStatus) (*RSAKey, error)
 
 	// UpdateKeyStatus обновляет статус ключа
 	UpdateKeyStatus(ctx context.Context, kid string, status KeyStatus) error
+
+	// ListKeys возвращает неистекшие ключи (active + next) без приватной части
+	ListKeys(ctx context.Context) ([]*RSAKey, error)
+
+	// SetKeyExpiration задает время, после которого ключ переводится в expired
+	SetKeyExpiration(ctx context.Context, kid string, expiresAt time.Time) error
 }
@@ -0,0 +1,220 @@
+package keystore
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sskorolev/balun_microservices/lib/logger"
+)
+
+// rotationLockKey ключ advisory lock, чтобы ключи ротировала одна реплика за раз
+const rotationLockKey int64 = 0x7273615f6b6579 // "rsa_key"
+
+type (
+	// Locker - advisory lock в Postgres
+	Locker interface {
+		TryAdvisoryLock(ctx context.Context, key int64) (bool, error)
+	}
+
+	// TransactionManager - менеджер транзакций
+	TransactionManager interface {
+		RunReadCommitted(ctx context.Context, f func(txCtx context.Context) error) error
+	}
+)
+
+// RotatorOption опция Rotator
+type RotatorOption func(*Rotator)
+
+// WithCheckInterval период проверки необходимости ротации
+func WithCheckInterval(d time.Duration) RotatorOption {
+	return func(r *Rotator) {
+		if d > 0 {
+			r.interval = d
+		}
+	}
+}
+
+// WithRotationPeriod как долго ключ подписывает токены до замены следующим
+func WithRotationPeriod(d time.Duration) RotatorOption {
+	return func(r *Rotator) {
+		if d > 0 {
+			r.period = d
+		}
+	}
+}
+
+// WithOverlap сколько next ключ публикуется в JWKS до перевода в active,
+// должен быть не меньше TTL кэша JWKS у потребителей
+func WithOverlap(d time.Duration) RotatorOption {
+	return func(r *Rotator) {
+		if d > 0 {
+			r.overlap = d
+		}
+	}
+}
+
+// WithRetention сколько выведенный из подписи ключ продолжает проверять токены,
+// должен быть не меньше самого долгого TTL выданных токенов
+func WithRetention(d time.Duration) RotatorOption {
+	return func(r *Rotator) {
+		if d > 0 {
+			r.retention = d
+		}
+	}
+}
+
+// Rotator ротирует RSA ключи: заранее публикует next ключ, после overlap делает его active,
+// а предыдущие active ключи переводит в expired по истечении retention
+type Rotator struct {
+	store  KeyStore
+	locker Locker
+	tm     TransactionManager
+
+	interval  time.Duration
+	period    time.Duration
+	overlap   time.Duration
+	retention time.Duration
+
+	now func() time.Time
+}
+
+// NewRotator конструктор Rotator
+func NewRotator(store KeyStore, locker Locker, tm TransactionManager, opts ...RotatorOption) *Rotator {
+	r := &Rotator{
+		store:     store,
+		locker:    locker,
+		tm:        tm,
+		interval:  time.Minute,
+		period:    720 * time.Hour,
+		overlap:   10 * time.Minute,
+		retention: 720 * time.Hour,
+		now:       time.Now,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// Run проверяет ключи сразу при старте и далее раз в interval до отмены ctx
+func (r *Rotator) Run(ctx context.Context) error {
+	if err := r.Rotate(ctx); err != nil {
+		logger.ErrorKV(ctx, "rsa keys rotation: rotate error", "error", err.Error())
+	}
+
+	t := time.NewTicker(r.interval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.C:
+			if err := r.Rotate(ctx); err != nil {
+				logger.ErrorKV(ctx, "rsa keys rotation: rotate error", "error", err.Error())
+			}
+		}
+	}
+}
+
+// Rotate выполняет один шаг ротации под advisory lock
+func (r *Rotator) Rotate(ctx context.Context) error {
+	const api = "[keystore.Rotator][Rotate]"
+
+	err := r.tm.RunReadCommitted(ctx, func(txCtx context.Context) error {
+		locked, err := r.locker.TryAdvisoryLock(txCtx, rotationLockKey)
+		if err != nil {
+			return err
+		}
+		if !locked {
+			// Ротацией занимается другая реплика
+			return nil
+		}
+
+		return r.rotate(txCtx)
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", api, err)
+	}
+
+	return nil
+}
+
+func (r *Rotator) rotate(ctx context.Context) error {
+	now := r.now()
+
+	keys, err := r.store.ListKeys(ctx)
+	if err != nil {
+		return err
+	}
+
+	var current, next *RSAKey
+	var active []*RSAKey
+	for _, key := range keys {
+		switch key.Status {
+		case KeyStatusActive:
+			if key.ExpiresAt != nil && !key.ExpiresAt.After(now) {
+				if err := r.store.UpdateKeyStatus(ctx, key.KID, KeyStatusExpired); err != nil {
+					return err
+				}
+				logger.InfoKV(ctx, "rsa keys rotation: key expired", "kid", key.KID)
+				continue
+			}
+			active = append(active, key)
+			if current == nil || key.CreatedAt.After(current.CreatedAt) {
+				current = key
+			}
+		case KeyStatusNext:
+			if next == nil || key.CreatedAt.After(next.CreatedAt) {
+				next = key
+			}
+		}
+	}
+
+	// Next ключ провисел в JWKS дольше кэша потребителей - начинаем подписывать им.
+	// Без active ключа подписывать нечем, поэтому next переводится сразу
+	if next != nil && (current == nil || !now.Before(next.CreatedAt.Add(r.overlap))) {
+		return r.promote(ctx, next, active, now)
+	}
+
+	if current == nil {
+		key, err := r.store.CreateKey(ctx, KeyStatusActive)
+		if err != nil {
+			return err
+		}
+		logger.InfoKV(ctx, "rsa keys rotation: created active key", "kid", key.KID)
+		return nil
+	}
+
+	// Заранее публикуем следующий ключ, чтобы он попал в кэши JWKS до перевода в active
+	if next == nil && !now.Before(current.CreatedAt.Add(r.period-r.overlap)) {
+		key, err := r.store.CreateKey(ctx, KeyStatusNext)
+		if err != nil {
+			return err
+		}
+		logger.InfoKV(ctx, "rsa keys rotation: published next key", "kid", key.KID)
+	}
+
+	return nil
+}
+
+// promote переводит next ключ в active, предыдущие active ключи остаются для проверки до now + retention
+func (r *Rotator) promote(ctx context.Context, next *RSAKey, active []*RSAKey, now time.Time) error {
+	if err := r.store.UpdateKeyStatus(ctx, next.KID, KeyStatusActive); err != nil {
+		return err
+	}
+
+	expiresAt := now.Add(r.retention)
+	for _, key := range active {
+		if key.ExpiresAt != nil {
+			continue
+		}
+		if err := r.store.SetKeyExpiration(ctx, key.KID, expiresAt); err != nil {
+			return err
+		}
+	}
+
+	logger.InfoKV(ctx, "rsa keys rotation: promoted next key", "kid", next.KID, "retired", len(active))
+	return nil
+}
@@ -0,0 +1,143 @@
+package keystore
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type memoryKeyStore struct {
+	keys []*RSAKey
+	now  func() time.Time
+}
+
+func (s *memoryKeyStore) GetActiveKey(context.Context) (*RSAKey, error) {
+	var active *RSAKey
+	for _, key := range s.keys {
+		if key.Status == KeyStatusActive && (active == nil || key.CreatedAt.After(active.CreatedAt)) {
+			active = key
+		}
+	}
+	if active == nil {
+		return nil, ErrNoActiveKey
+	}
+	return active, nil
+}
+
+func (s *memoryKeyStore) GetKeyByKID(_ context.Context, kid string) (*RSAKey, error) {
+	for _, key := range s.keys {
+		if key.KID == kid {
+			return key, nil
+		}
+	}
+	return nil, ErrKeyNotFound
+}
+
+func (s *memoryKeyStore) GetAllActiveKeys(ctx context.Context) ([]*RSAKey, error) {
+	return s.ListKeys(ctx)
+}
+
+func (s *memoryKeyStore) CreateKey(_ context.Context, status KeyStatus) (*RSAKey, error) {
+	key := &RSAKey{KID: fmt.Sprintf("kid-%d", len(s.keys)+1), Status: status, CreatedAt: s.now()}
+	s.keys = append(s.keys, key)
+	return key, nil
+}
+
+func (s *memoryKeyStore) UpdateKeyStatus(ctx context.Context, kid string, status KeyStatus) error {
+	key, err := s.GetKeyByKID(ctx, kid)
+	if err != nil {
+		return err
+	}
+	key.Status = status
+	return nil
+}
+
+func (s *memoryKeyStore) ListKeys(context.Context) ([]*RSAKey, error) {
+	var keys []*RSAKey
+	for _, key := range s.keys {
+		if key.Status != KeyStatusExpired {
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+func (s *memoryKeyStore) SetKeyExpiration(ctx context.Context, kid string, expiresAt time.Time) error {
+	key, err := s.GetKeyByKID(ctx, kid)
+	if err != nil {
+		return err
+	}
+	key.ExpiresAt = &expiresAt
+	return nil
+}
+
+type stubLocker struct{ locked bool }
+
+func (l stubLocker) TryAdvisoryLock(context.Context, int64) (bool, error) { return l.locked, nil }
+
+type stubTM struct{}
+
+func (stubTM) RunReadCommitted(ctx context.Context, f func(txCtx context.Context) error) error {
+	return f(ctx)
+}
+
+func TestRotator_Rotate(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("полный цикл ротации", func(t *testing.T) {
+		now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		clock := func() time.Time { return now }
+		store := &memoryKeyStore{now: clock}
+		r := NewRotator(store, stubLocker{locked: true}, stubTM{},
+			WithRotationPeriod(24*time.Hour),
+			WithOverlap(time.Hour),
+			WithRetention(48*time.Hour),
+		)
+		r.now = clock
+
+		// Нет ключей - создается active
+		require.NoError(t, r.Rotate(ctx))
+		first, err := store.GetActiveKey(ctx)
+		require.NoError(t, err)
+
+		// До period - overlap ничего не меняется
+		now = now.Add(22 * time.Hour)
+		require.NoError(t, r.Rotate(ctx))
+		require.Len(t, store.keys, 1)
+
+		// Публикуется next, подпись остается за первым ключом
+		now = now.Add(time.Hour)
+		require.NoError(t, r.Rotate(ctx))
+		require.Len(t, store.keys, 2)
+		require.Equal(t, KeyStatusNext, store.keys[1].Status)
+		active, err := store.GetActiveKey(ctx)
+		require.NoError(t, err)
+		require.Equal(t, first.KID, active.KID)
+
+		// После overlap next становится active, первый ключ получает срок жизни
+		now = now.Add(time.Hour)
+		require.NoError(t, r.Rotate(ctx))
+		active, err = store.GetActiveKey(ctx)
+		require.NoError(t, err)
+		require.Equal(t, store.keys[1].KID, active.KID)
+		require.Equal(t, KeyStatusActive, first.Status)
+		require.NotNil(t, first.ExpiresAt)
+		require.Equal(t, now.Add(48*time.Hour), *first.ExpiresAt)
+
+		// По истечении retention первый ключ переводится в expired
+		now = now.Add(48 * time.Hour)
+		require.NoError(t, r.Rotate(ctx))
+		require.Equal(t, KeyStatusExpired, first.Status)
+	})
+
+	t.Run("без advisory lock ключи не меняются", func(t *testing.T) {
+		store := &memoryKeyStore{now: time.Now}
+		r := NewRotator(store, stubLocker{locked: false}, stubTM{})
+
+		require.NoError(t, r.Rotate(ctx))
+		require.Empty(t, store.keys)
+	})
+}
@@ -21,8 +21,8 @@ func (g *RSAKeyGenerator) Generate() (*rsa.PrivateKey, error) {
 	return rsa.GenerateKey(rand.Reader, g.bits)
 }
 
-// EncodePrivateKeyToPEM - кодирует приватный ключ в PEM формат
-func EncodePrivateKeyToPEM(key *rsa.PrivateKey) (string, error) {
+// EncodePrivateKeyToPEM - кодирует приватный ключ (RSA, ECDSA, Ed25519) в PEM формат (PKCS#8)
+func EncodePrivateKeyToPEM(key any) (string, error) {
 	privateKeyBytes, err := x509.MarshalPKCS8PrivateKey(key)
 	if err != nil {
 		return "", err
@@ -36,8 +36,8 @@ func EncodePrivateKeyToPEM(key *rsa.PrivateKey) (string, error) {
 	return string(pem.EncodeToMemory(block)), nil
 }
 
-// EncodePublicKeyToPEM - кодирует публичный ключ в PEM формат
-func EncodePublicKeyToPEM(key *rsa.PublicKey) (string, error) {
+// EncodePublicKeyToPEM - кодирует публичный ключ (RSA, ECDSA, Ed25519) в PEM формат (PKIX)
+func EncodePublicKeyToPEM(key any) (string, error) {
 	publicKeyBytes, err := x509.MarshalPKIXPublicKey(key)
 	if err != nil {
 		return "", err
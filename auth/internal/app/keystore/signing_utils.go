@@ -0,0 +1,139 @@
+package keystore
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+)
+
+// KeyAlgorithm - алгоритм подписи JWT, задается для каждого ключа отдельно
+type KeyAlgorithm string
+
+const (
+	KeyAlgorithmRS256 KeyAlgorithm = "RS256"
+	KeyAlgorithmES256 KeyAlgorithm = "ES256" // ECDSA P-256
+	KeyAlgorithmEdDSA KeyAlgorithm = "EdDSA" // Ed25519
+)
+
+// ParseKeyAlgorithm проверяет название алгоритма, пустая строка - RS256
+func ParseKeyAlgorithm(s string) (KeyAlgorithm, error) {
+	switch alg := KeyAlgorithm(s); alg {
+	case "":
+		return KeyAlgorithmRS256, nil
+	case KeyAlgorithmRS256, KeyAlgorithmES256, KeyAlgorithmEdDSA:
+		return alg, nil
+	default:
+		return "", fmt.Errorf("unsupported key algorithm: %s", s)
+	}
+}
+
+// Option опция хранилища ключей
+type Option func(*KeyGenerator)
+
+// WithAlgorithm алгоритм новых ключей, по умолчанию RS256.
+// Уже созданные ключи сохраняют свой алгоритм
+func WithAlgorithm(alg KeyAlgorithm) Option {
+	return func(g *KeyGenerator) {
+		if alg != "" {
+			g.algorithm = alg
+		}
+	}
+}
+
+// KeyGenerator - генератор ключей подписи выбранного алгоритма
+type KeyGenerator struct {
+	algorithm KeyAlgorithm
+	rsa       *RSAKeyGenerator
+}
+
+func NewKeyGenerator(opts ...Option) *KeyGenerator {
+	g := &KeyGenerator{
+		algorithm: KeyAlgorithmRS256,
+		rsa:       NewRSAKeyGenerator(),
+	}
+	for _, opt := range opts {
+		opt(g)
+	}
+	return g
+}
+
+// Algorithm возвращает алгоритм новых ключей
+func (g *KeyGenerator) Algorithm() KeyAlgorithm {
+	return g.algorithm
+}
+
+// GeneratePEM создает пару ключей и возвращает ее в PEM формате
+func (g *KeyGenerator) GeneratePEM() (privateKeyPEM, publicKeyPEM string, err error) {
+	var signer crypto.Signer
+	switch g.algorithm {
+	case KeyAlgorithmRS256:
+		signer, err = g.rsa.Generate()
+	case KeyAlgorithmES256:
+		signer, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case KeyAlgorithmEdDSA:
+		_, signer, err = ed25519.GenerateKey(rand.Reader)
+	default:
+		err = fmt.Errorf("unsupported key algorithm: %s", g.algorithm)
+	}
+	if err != nil {
+		return "", "", fmt.Errorf("failed to generate %s key: %w", g.algorithm, err)
+	}
+
+	privateKeyPEM, err = EncodePrivateKeyToPEM(signer)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to encode private key: %w", err)
+	}
+
+	publicKeyPEM, err = EncodePublicKeyToPEM(signer.Public())
+	if err != nil {
+		return "", "", fmt.Errorf("failed to encode public key: %w", err)
+	}
+
+	return privateKeyPEM, publicKeyPEM, nil
+}
+
+// DecodeSigningKeyFromPEM - декодирует приватный ключ любого поддерживаемого алгоритма
+func DecodeSigningKeyFromPEM(pemData string) (crypto.Signer, error) {
+	block, _ := pem.Decode([]byte(pemData))
+	if block == nil || block.Type != "PRIVATE KEY" {
+		return nil, fmt.Errorf("invalid PEM block for private key")
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	switch k := key.(type) {
+	case *rsa.PrivateKey, *ecdsa.PrivateKey, ed25519.PrivateKey:
+		return k.(crypto.Signer), nil
+	default:
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+}
+
+// DecodeVerificationKeyFromPEM - декодирует публичный ключ любого поддерживаемого алгоритма
+func DecodeVerificationKeyFromPEM(pemData string) (crypto.PublicKey, error) {
+	block, _ := pem.Decode([]byte(pemData))
+	if block == nil || block.Type != "PUBLIC KEY" {
+		return nil, fmt.Errorf("invalid PEM block for public key")
+	}
+
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	switch key.(type) {
+	case *rsa.PublicKey, *ecdsa.PublicKey, ed25519.PublicKey:
+		return key, nil
+	default:
+		return nil, fmt.Errorf("unsupported public key type %T", key)
+	}
+}
@@ -0,0 +1,61 @@
+package keystore
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyGenerator_GeneratePEM(t *testing.T) {
+	digest := sha256.Sum256([]byte("payload"))
+
+	t.Run("RS256", func(t *testing.T) {
+		signer, publicKey := generateKeyPair(t, KeyAlgorithmRS256)
+
+		signature, err := signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+		require.NoError(t, err)
+		require.NoError(t, rsa.VerifyPKCS1v15(publicKey.(*rsa.PublicKey), crypto.SHA256, digest[:], signature))
+	})
+
+	t.Run("ES256", func(t *testing.T) {
+		signer, publicKey := generateKeyPair(t, KeyAlgorithmES256)
+
+		signature, err := signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+		require.NoError(t, err)
+		require.True(t, ecdsa.VerifyASN1(publicKey.(*ecdsa.PublicKey), digest[:], signature))
+	})
+
+	t.Run("EdDSA", func(t *testing.T) {
+		signer, publicKey := generateKeyPair(t, KeyAlgorithmEdDSA)
+
+		signature, err := signer.Sign(rand.Reader, []byte("payload"), crypto.Hash(0))
+		require.NoError(t, err)
+		require.True(t, ed25519.Verify(publicKey.(ed25519.PublicKey), []byte("payload"), signature))
+	})
+
+	t.Run("неизвестный алгоритм", func(t *testing.T) {
+		_, err := ParseKeyAlgorithm("HS256")
+		require.Error(t, err)
+	})
+}
+
+func generateKeyPair(t *testing.T, alg KeyAlgorithm) (crypto.Signer, crypto.PublicKey) {
+	t.Helper()
+
+	privateKeyPEM, publicKeyPEM, err := NewKeyGenerator(WithAlgorithm(alg)).GeneratePEM()
+	require.NoError(t, err)
+
+	signer, err := DecodeSigningKeyFromPEM(privateKeyPEM)
+	require.NoError(t, err)
+
+	publicKey, err := DecodeVerificationKeyFromPEM(publicKeyPEM)
+	require.NoError(t, err)
+
+	return signer, publicKey
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/sskorolev/balun_microservices/lib/secrets"
@@ -14,17 +15,17 @@ import (
 type VaultKeyStore struct {
 	provider  secrets.SecretsProvider
 	basePath  string
-	generator *RSAKeyGenerator
+	generator *KeyGenerator
 }
 
-func NewVaultKeyStore(provider secrets.SecretsProvider, basePath string) *VaultKeyStore {
+func NewVaultKeyStore(provider secrets.SecretsProvider, basePath string, opts ...Option) *VaultKeyStore {
 	if basePath == "" {
 		basePath = "rsa-keys"
 	}
 	return &VaultKeyStore{
 		provider:  provider,
 		basePath:  basePath,
-		generator: NewRSAKeyGenerator(),
+		generator: NewKeyGenerator(opts...),
 	}
 }
 
@@ -36,14 +37,18 @@ func (s *VaultKeyStore) GetActiveKey(ctx context.Context) (*RSAKey, error) {
 		return nil, err
 	}
 
-	// Ищем активный ключ
-	for _, keyMeta := range metadata {
-		if keyMeta.Status == KeyStatusActive {
-			return s.GetKeyByKID(ctx, keyMeta.KID)
+	// Ищем самый новый активный ключ
+	var active *RSAKey
+	for i := range metadata {
+		if metadata[i].Status == KeyStatusActive && (active == nil || metadata[i].CreatedAt.After(active.CreatedAt)) {
+			active = &metadata[i]
 		}
 	}
+	if active == nil {
+		return nil, ErrNoActiveKey
+	}
 
-	return nil, ErrNoActiveKey
+	return s.GetKeyByKID(ctx, active.KID)
 }
 
 // GetKeyByKID возвращает ключ по KID
@@ -65,6 +70,10 @@ func (s *VaultKeyStore) GetKeyByKID(ctx context.Context, kid string) (*RSAKey, e
 	if err := json.Unmarshal([]byte(keyData), &key); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal key data: %w", err)
 	}
+	// Ключи, созданные до поддержки других алгоритмов, сохранены без него
+	if key.Algorithm == "" {
+		key.Algorithm = KeyAlgorithmRS256
+	}
 
 	return &key, nil
 }
@@ -90,57 +99,116 @@ func (s *VaultKeyStore) GetAllActiveKeys(ctx context.Context) ([]*RSAKey, error)
 	return keys, nil
 }
 
-// CreateKey создает новый RSA ключ
+// CreateKey создает новый ключ подписи
 func (s *VaultKeyStore) CreateKey(ctx context.Context, status KeyStatus) (*RSAKey, error) {
-	// Генерируем RSA ключ
-	privateKey, err := s.generator.Generate()
-	if err != nil {
-		return nil, fmt.Errorf("failed to generate RSA key: %w", err)
-	}
-
-	privateKeyPEM, err := EncodePrivateKeyToPEM(privateKey)
-	if err != nil {
-		return nil, fmt.Errorf("failed to encode private key: %w", err)
-	}
-
-	publicKeyPEM, err := EncodePublicKeyToPEM(&privateKey.PublicKey)
+	privateKeyPEM, publicKeyPEM, err := s.generator.GeneratePEM()
 	if err != nil {
-		return nil, fmt.Errorf("failed to encode public key: %w", err)
+		return nil, err
 	}
 
-	kid := uuid.New().String()
 	key := &RSAKey{
-		KID:           kid,
+		KID:           uuid.New().String(),
+		Algorithm:     s.generator.Algorithm(),
 		PrivateKeyPEM: privateKeyPEM,
 		PublicKeyPEM:  publicKeyPEM,
 		Status:        status,
+		CreatedAt:     time.Now(),
 	}
 
-	// Сохраняем ключ в Vault
-	_ = fmt.Sprintf("%s/%s", s.basePath, kid) // keyPath для будущей реализации
-	_, err = json.Marshal(key)                // keyData для будущей реализации
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal key: %w", err)
+	if err := s.saveKey(ctx, key); err != nil {
+		return nil, err
 	}
 
-	// Note: lib/secrets не имеет метода Set, поэтому нужно использовать Vault API напрямую
-	// Для упрощения, пока вернем ошибку
-	return nil, fmt.Errorf("vault key creation not implemented yet - use Vault CLI or API")
+	return key, nil
 }
 
 // UpdateKeyStatus обновляет статус ключа
 func (s *VaultKeyStore) UpdateKeyStatus(ctx context.Context, kid string, status KeyStatus) error {
-	// Получаем текущий ключ
 	key, err := s.GetKeyByKID(ctx, kid)
 	if err != nil {
 		return err
 	}
 
-	// Обновляем статус
 	key.Status = status
+	return s.saveKey(ctx, key)
+}
+
+// ListKeys возвращает неистекшие ключи (active + next) без приватной части
+func (s *VaultKeyStore) ListKeys(ctx context.Context) ([]*RSAKey, error) {
+	metadata, err := s.getKeysMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []*RSAKey
+	for i := range metadata {
+		if metadata[i].Status == KeyStatusActive || metadata[i].Status == KeyStatusNext {
+			keys = append(keys, &metadata[i])
+		}
+	}
+
+	return keys, nil
+}
+
+// SetKeyExpiration задает время истечения ключа
+func (s *VaultKeyStore) SetKeyExpiration(ctx context.Context, kid string, expiresAt time.Time) error {
+	key, err := s.GetKeyByKID(ctx, kid)
+	if err != nil {
+		return err
+	}
+
+	key.ExpiresAt = &expiresAt
+	return s.saveKey(ctx, key)
+}
+
+// saveKey записывает ключ и обновляет его запись в метаданных
+func (s *VaultKeyStore) saveKey(ctx context.Context, key *RSAKey) error {
+	writer, ok := s.provider.(secrets.SecretsWriter)
+	if !ok {
+		return fmt.Errorf("secrets provider does not support writes")
+	}
+
+	keyData, err := json.Marshal(key)
+	if err != nil {
+		return fmt.Errorf("failed to marshal key: %w", err)
+	}
+
+	if err := writer.Set(ctx, fmt.Sprintf("%s/%s", s.basePath, key.KID), string(keyData)); err != nil {
+		return fmt.Errorf("failed to save key to vault: %w", err)
+	}
+
+	metadata, err := s.getKeysMetadata(ctx)
+	if err != nil {
+		return err
+	}
+
+	// В метаданных приватная часть не хранится
+	meta := *key
+	meta.PrivateKeyPEM = ""
+	meta.PublicKeyPEM = ""
+
+	replaced := false
+	for i := range metadata {
+		if metadata[i].KID == key.KID {
+			metadata[i] = meta
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		metadata = append(metadata, meta)
+	}
+
+	metadataJSON, err := json.Marshal(metadata)
+	if err != nil {
+		return fmt.Errorf("failed to marshal metadata: %w", err)
+	}
+
+	if err := writer.Set(ctx, fmt.Sprintf("%s/_metadata", s.basePath), string(metadataJSON)); err != nil {
+		return fmt.Errorf("failed to save keys metadata to vault: %w", err)
+	}
 
-	// Note: lib/secrets не имеет метода Set, поэтому нужно использовать Vault API напрямую
-	return fmt.Errorf("vault key update not implemented yet - use Vault CLI or API")
+	return nil
 }
 
 // getKeysMetadata получает метаданные всех ключей
@@ -0,0 +1,24 @@
+package mail
+
+import (
+	"context"
+
+	"github.com/sskorolev/balun_microservices/lib/logger"
+)
+
+// LogSender пишет письма в лог, для локальной разработки
+type LogSender struct{}
+
+// NewLogSender конструктор LogSender
+func NewLogSender() *LogSender {
+	return &LogSender{}
+}
+
+func (s *LogSender) Send(ctx context.Context, msg Message) error {
+	logger.InfoKV(ctx, "mail: message sent to log",
+		"to", msg.To,
+		"subject", msg.Subject,
+		"body", msg.Body,
+	)
+	return nil
+}
@@ -0,0 +1,30 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+)
+
+// Message - письмо пользователю
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Sender - отправка писем. Реализация выбирается конфигурацией (mail.sender)
+type Sender interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// NewSender создает отправителя по имени: log - письма пишутся в лог, file - в файлы каталога dir
+func NewSender(kind, dir string) (Sender, error) {
+	switch kind {
+	case "", "log":
+		return NewLogSender(), nil
+	case "file":
+		return NewFileSender(dir)
+	default:
+		return nil, fmt.Errorf("unknown mail sender %q", kind)
+	}
+}
@@ -0,0 +1,22 @@
+package models
+
+import "time"
+
+// ActionTokenPurpose - назначение одноразового токена из письма
+type ActionTokenPurpose string
+
+const (
+	ActionTokenEmailVerification ActionTokenPurpose = "email_verification"
+	ActionTokenPasswordReset     ActionTokenPurpose = "password_reset"
+)
+
+// ActionToken - одноразовый токен подтверждения email или сброса пароля, хранится только хеш
+type ActionToken struct {
+	ID        string             `db:"id"`
+	UserID    string             `db:"user_id"`
+	Purpose   ActionTokenPurpose `db:"purpose"`
+	TokenHash string             `db:"token_hash"` // SHA-256 хеш токена
+	ExpiresAt time.Time          `db:"expires_at"`
+	UsedAt    *time.Time         `db:"used_at"`
+	CreatedAt time.Time          `db:"created_at"`
+}
@@ -0,0 +1,26 @@
+package models
+
+import "time"
+
+// AuditEventType - тип события журнала аудита
+type AuditEventType string
+
+const (
+	// AuditEventRefreshTokenReuse - предъявлен уже использованный refresh токен, семейство токенов отозвано
+	AuditEventRefreshTokenReuse AuditEventType = "refresh_token_reuse_detected"
+	// AuditEventPasswordChanged - пользователь сменил пароль, все сессии отозваны, текущее устройство получило новые токены
+	AuditEventPasswordChanged AuditEventType = "password_changed"
+	// AuditEventEmailChangeRequested - пользователь запросил смену email, новый адрес ждет подтверждения
+	AuditEventEmailChangeRequested AuditEventType = "email_change_requested"
+	// AuditEventEmailChanged - новый email подтвержден по ссылке и заменил прежний
+	AuditEventEmailChanged AuditEventType = "email_changed"
+)
+
+// AuditEvent - запись журнала аудита
+type AuditEvent struct {
+	ID        string
+	UserID    *string
+	Type      AuditEventType
+	Details   map[string]any
+	CreatedAt time.Time
+}
@@ -5,4 +5,10 @@ import "errors"
 var (
 	ErrNotFound      = errors.New("user not found")
 	ErrAlreadyExists = errors.New("user already exists")
+
+	// ErrNicknameTaken - никнейм уже занят другим профилем в users
+	ErrNicknameTaken = errors.New("nickname already taken")
+
+	// ErrTokenAlreadyUsed - refresh токен уже использован (конкурентная ротация)
+	ErrTokenAlreadyUsed = errors.New("refresh token already used")
 )
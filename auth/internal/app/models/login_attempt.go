@@ -0,0 +1,26 @@
+package models
+
+import "time"
+
+// LoginAttemptScope - по чему считаются неудачные попытки входа
+type LoginAttemptScope string
+
+const (
+	LoginAttemptScopeEmail LoginAttemptScope = "email"
+	LoginAttemptScopeIP    LoginAttemptScope = "ip"
+)
+
+// LoginAttemptKey - ключ счетчика неудачных попыток входа
+type LoginAttemptKey struct {
+	Scope LoginAttemptScope
+	Key   string
+}
+
+// LoginAttempt - неудачные попытки входа по ключу за текущее окно
+type LoginAttempt struct {
+	Scope        LoginAttemptScope `db:"scope"`
+	Key          string            `db:"key"`
+	FailedCount  int               `db:"failed_count"`
+	LastFailedAt time.Time         `db:"last_failed_at"`
+	LockedUntil  *time.Time        `db:"locked_until"`
+}
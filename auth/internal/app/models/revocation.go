@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// RevokedAccessToken - отозванный access токен
+type RevokedAccessToken struct {
+	JTI       string    `db:"jti"`
+	UserID    string    `db:"user_id"`
+	ExpiresAt time.Time `db:"expires_at"`
+}
+
+// AccessTokenWatermark - access токены пользователя, выпущенные до NotBefore, недействительны
+type AccessTokenWatermark struct {
+	UserID    string    `db:"user_id"`
+	NotBefore time.Time `db:"not_before"`
+	ExpiresAt time.Time `db:"expires_at"`
+}
@@ -4,13 +4,26 @@ import (
 	"time"
 )
 
+// RegistrationStatus - статус саги регистрации
+type RegistrationStatus string
+
+const (
+	// RegistrationStatusPending - пользователь создан в auth, профиль в users еще не создан
+	RegistrationStatusPending RegistrationStatus = "pending"
+	// RegistrationStatusCompleted - профиль создан, пользователь может входить
+	RegistrationStatusCompleted RegistrationStatus = "completed"
+)
+
 type User struct {
-	ID           string     `db:"id"`
-	Email        string     `db:"email"`
-	PasswordHash string     `db:"password_hash"`
-	Token        *UserToken `db:"-"` // не мапится на БД
-	CreatedAt    time.Time  `db:"created_at"`
-	UpdatedAt    time.Time  `db:"updated_at"`
+	ID                 string             `db:"id"`
+	Email              string             `db:"email"`
+	PasswordHash       string             `db:"password_hash"`
+	RegistrationStatus RegistrationStatus `db:"registration_status"`
+	EmailVerifiedAt    *time.Time         `db:"email_verified_at"` // nil - email не подтвержден
+	PendingEmail       *string            `db:"pending_email"`     // новый email до подтверждения
+	Token              *UserToken         `db:"-"`                 // не мапится на БД
+	CreatedAt          time.Time          `db:"created_at"`
+	UpdatedAt          time.Time          `db:"updated_at"`
 }
 
 type UserToken struct {
@@ -21,13 +34,23 @@ type UserToken struct {
 
 // RefreshToken - модель refresh токена для БД
 type RefreshToken struct {
-	ID            string     `db:"id"`
-	UserID        string     `db:"user_id"`
-	TokenHash     string     `db:"token_hash"` // SHA-256 хеш токена
-	JTI           string     `db:"jti"`        // JWT ID
-	DeviceID      *string    `db:"device_id"`
-	ExpiresAt     time.Time  `db:"expires_at"`
-	UsedAt        *time.Time `db:"used_at"`
-	ReplacedByJTI *string    `db:"replaced_by_jti"`
-	CreatedAt     time.Time  `db:"created_at"`
+	ID               string     `db:"id"`
+	UserID           string     `db:"user_id"`
+	TokenHash        string     `db:"token_hash"` // SHA-256 хеш токена
+	JTI              string     `db:"jti"`        // JWT ID
+	DeviceID         *string    `db:"device_id"`
+	ExpiresAt        time.Time  `db:"expires_at"`
+	UsedAt           *time.Time `db:"used_at"`
+	ReplacedByJTI    *string    `db:"replaced_by_jti"`
+	RevokedAt        *time.Time `db:"revoked_at"`
+	SessionStartedAt time.Time  `db:"session_started_at"` // время входа, общее для цепочки ротации
+	CreatedAt        time.Time  `db:"created_at"`
+}
+
+// Session - активная сессия пользователя на устройстве
+type Session struct {
+	DeviceID      string    `db:"device_id"` // пусто для входа без device_id
+	CreatedAt     time.Time `db:"created_at"`
+	LastRefreshAt time.Time `db:"last_refresh_at"`
+	ExpiresAt     time.Time `db:"expires_at"`
 }
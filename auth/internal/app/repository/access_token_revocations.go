@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/sskorolev/balun_microservices/lib/postgres"
+
+	"auth/internal/app/models"
+)
+
+// RevokeAccessToken добавляет access токен в список отозванных
+func (r *Repository) RevokeAccessToken(ctx context.Context, token *models.RevokedAccessToken) error {
+	insertQuery := r.sb.Insert("revoked_access_tokens").
+		Columns("jti", "user_id", "expires_at").
+		Values(token.JTI, token.UserID, token.ExpiresAt).
+		Suffix("ON CONFLICT (jti) DO NOTHING")
+
+	err := r.tm.RunReadCommitted(ctx, func(txCtx context.Context) error {
+		conn := r.tm.GetQueryEngine(txCtx)
+		_, err := conn.Execx(txCtx, insertQuery)
+		return err
+	})
+	if err != nil {
+		return postgres.ConvertPGError(err)
+	}
+
+	return nil
+}
+
+// RevokeUserAccessTokens отзывает все access токены пользователя, выпущенные до watermark.NotBefore.
+// Граница только сдвигается вперед
+func (r *Repository) RevokeUserAccessTokens(ctx context.Context, watermark *models.AccessTokenWatermark) error {
+	upsertQuery := r.sb.Insert("access_token_watermarks").
+		Columns("user_id", "not_before", "expires_at", "updated_at").
+		Values(watermark.UserID, watermark.NotBefore, watermark.ExpiresAt, time.Now()).
+		Suffix(`ON CONFLICT (user_id) DO UPDATE SET
+			not_before = GREATEST(access_token_watermarks.not_before, EXCLUDED.not_before),
+			expires_at = GREATEST(access_token_watermarks.expires_at, EXCLUDED.expires_at),
+			updated_at = EXCLUDED.updated_at`)
+
+	err := r.tm.RunReadCommitted(ctx, func(txCtx context.Context) error {
+		conn := r.tm.GetQueryEngine(txCtx)
+		_, err := conn.Execx(txCtx, upsertQuery)
+		return err
+	})
+	if err != nil {
+		return postgres.ConvertPGError(err)
+	}
+
+	return nil
+}
+
+// ListActiveRevocations возвращает отзывы, которые еще могут затронуть неистекшие токены
+func (r *Repository) ListActiveRevocations(ctx context.Context) ([]*models.RevokedAccessToken, []*models.AccessTokenWatermark, error) {
+	now := time.Now()
+	tokensQuery := r.sb.Select("jti", "user_id", "expires_at").
+		From("revoked_access_tokens").
+		Where(squirrel.Gt{"expires_at": now})
+	watermarksQuery := r.sb.Select("user_id", "not_before", "expires_at").
+		From("access_token_watermarks").
+		Where(squirrel.Gt{"expires_at": now})
+
+	var (
+		tokens     []*models.RevokedAccessToken
+		watermarks []*models.AccessTokenWatermark
+	)
+	err := r.tm.RunReadCommitted(ctx, func(txCtx context.Context) error {
+		conn := r.tm.GetQueryEngine(txCtx)
+		if err := conn.Selectx(txCtx, &tokens, tokensQuery); err != nil {
+			return err
+		}
+		return conn.Selectx(txCtx, &watermarks, watermarksQuery)
+	})
+	if err != nil {
+		return nil, nil, postgres.ConvertPGError(err)
+	}
+
+	return tokens, watermarks, nil
+}
+
+// DeleteExpiredRevocations удаляет отзывы, все затронутые токены которых уже истекли
+func (r *Repository) DeleteExpiredRevocations(ctx context.Context) error {
+	now := time.Now()
+	deleteTokensQuery := r.sb.Delete("revoked_access_tokens").Where(squirrel.LtOrEq{"expires_at": now})
+	deleteWatermarksQuery := r.sb.Delete("access_token_watermarks").Where(squirrel.LtOrEq{"expires_at": now})
+
+	err := r.tm.RunReadCommitted(ctx, func(txCtx context.Context) error {
+		conn := r.tm.GetQueryEngine(txCtx)
+		if _, err := conn.Execx(txCtx, deleteTokensQuery); err != nil {
+			return err
+		}
+		_, err := conn.Execx(txCtx, deleteWatermarksQuery)
+		return err
+	})
+	if err != nil {
+		return postgres.ConvertPGError(err)
+	}
+
+	return nil
+}
+
+// IsAccessTokenRevoked проверяет, отозван ли access токен по jti или границей отзыва пользователя.
+// Граница сравнивается с точностью до секунды, как iat токена и authmw.RevocationList
+func (r *Repository) IsAccessTokenRevoked(ctx context.Context, jti, userID string, issuedAt time.Time) (bool, error) {
+	selectQuery := squirrel.Expr(`
+		SELECT EXISTS (
+			SELECT 1 FROM revoked_access_tokens WHERE jti = $1 AND expires_at > $3
+		) OR EXISTS (
+			SELECT 1 FROM access_token_watermarks
+			WHERE user_id = $2 AND expires_at > $3 AND date_trunc('second', not_before) > $4
+		)`, jti, userID, time.Now(), issuedAt)
+
+	var revoked bool
+	err := r.tm.RunReadCommitted(ctx, func(txCtx context.Context) error {
+		conn := r.tm.GetQueryEngine(txCtx)
+		return conn.Getx(txCtx, &revoked, selectQuery)
+	})
+	if err != nil {
+		return false, postgres.ConvertPGError(err)
+	}
+
+	return revoked, nil
+}
@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/sskorolev/balun_microservices/lib/postgres"
+
+	"auth/internal/app/models"
+)
+
+// CreateActionToken сохраняет одноразовый токен из письма
+func (r *Repository) CreateActionToken(ctx context.Context, token *models.ActionToken) error {
+	insertQuery := r.sb.Insert("user_action_tokens").
+		Columns("user_id", "purpose", "token_hash", "expires_at", "created_at").
+		Values(token.UserID, token.Purpose, token.TokenHash, token.ExpiresAt, token.CreatedAt)
+
+	err := r.tm.RunReadCommitted(ctx, func(txCtx context.Context) error {
+		conn := r.tm.GetQueryEngine(txCtx)
+		_, err := conn.Execx(txCtx, insertQuery)
+		return err
+	})
+	if err != nil {
+		return postgres.ConvertPGError(err)
+	}
+
+	return nil
+}
+
+// UseActionToken помечает токен использованным и возвращает его.
+// Если токена нет, он истек или уже использован, возвращает models.ErrNotFound
+func (r *Repository) UseActionToken(ctx context.Context, purpose models.ActionTokenPurpose, tokenHash string) (*models.ActionToken, error) {
+	now := time.Now()
+	updateQuery := r.sb.Update("user_action_tokens").
+		Set("used_at", now).
+		Where(squirrel.Eq{"token_hash": tokenHash, "purpose": purpose}).
+		Where("used_at IS NULL").
+		Where(squirrel.Gt{"expires_at": now}).
+		Suffix("RETURNING id, user_id, purpose, token_hash, expires_at, used_at, created_at")
+
+	token := &models.ActionToken{}
+	err := r.tm.RunReadCommitted(ctx, func(txCtx context.Context) error {
+		conn := r.tm.GetQueryEngine(txCtx)
+		return conn.Getx(txCtx, token, updateQuery)
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, models.ErrNotFound
+		}
+		return nil, postgres.ConvertPGError(err)
+	}
+
+	return token, nil
+}
+
+// InvalidateActionTokens аннулирует неиспользованные токены пользователя с указанным назначением
+func (r *Repository) InvalidateActionTokens(ctx context.Context, userID string, purpose models.ActionTokenPurpose) error {
+	updateQuery := r.sb.Update("user_action_tokens").
+		Set("used_at", time.Now()).
+		Where(squirrel.Eq{"user_id": userID, "purpose": purpose}).
+		Where("used_at IS NULL")
+
+	err := r.tm.RunReadCommitted(ctx, func(txCtx context.Context) error {
+		conn := r.tm.GetQueryEngine(txCtx)
+		_, err := conn.Execx(txCtx, updateQuery)
+		return err
+	})
+	if err != nil {
+		return postgres.ConvertPGError(err)
+	}
+
+	return nil
+}
+
+// DeleteExpiredActionTokens удаляет истекшие токены
+func (r *Repository) DeleteExpiredActionTokens(ctx context.Context) error {
+	deleteQuery := r.sb.Delete("user_action_tokens").
+		Where(squirrel.Lt{"expires_at": time.Now()})
+
+	err := r.tm.RunReadCommitted(ctx, func(txCtx context.Context) error {
+		conn := r.tm.GetQueryEngine(txCtx)
+		_, err := conn.Execx(txCtx, deleteQuery)
+		return err
+	})
+	if err != nil {
+		return postgres.ConvertPGError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/sskorolev/balun_microservices/lib/postgres"
+)
+
+// TryAdvisoryLock берет транзакционный advisory lock, не дожидаясь его освобождения
+//
+// Должен вызываться внутри транзакции, lock снимается на COMMIT/ROLLBACK.
+func (r *Repository) TryAdvisoryLock(ctx context.Context, key int64) (bool, error) {
+	conn := r.tm.GetQueryEngine(ctx)
+	var locked bool
+	if err := conn.Getx(ctx, &locked, squirrel.Expr("SELECT pg_try_advisory_xact_lock($1)", key)); err != nil {
+		return false, postgres.ConvertPGError(err)
+	}
+	return locked, nil
+}
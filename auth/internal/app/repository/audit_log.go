@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/sskorolev/balun_microservices/lib/postgres"
+
+	"auth/internal/app/models"
+)
+
+// SaveAuditEvent сохраняет событие в журнал аудита
+func (r *Repository) SaveAuditEvent(ctx context.Context, event *models.AuditEvent) error {
+	details := event.Details
+	if details == nil {
+		details = map[string]any{}
+	}
+	detailsJSON, err := json.Marshal(details)
+	if err != nil {
+		return fmt.Errorf("marshal audit event details: %w", err)
+	}
+
+	createdAt := event.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
+
+	insertQuery := r.sb.Insert("auth_audit_log").
+		Columns("user_id", "event_type", "details", "created_at").
+		Values(event.UserID, string(event.Type), detailsJSON, createdAt).
+		Suffix("RETURNING id")
+
+	err = r.tm.RunReadCommitted(ctx, func(txCtx context.Context) error {
+		conn := r.tm.GetQueryEngine(txCtx)
+		return conn.Getx(txCtx, &event.ID, insertQuery)
+	})
+	if err != nil {
+		return postgres.ConvertPGError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/sskorolev/balun_microservices/lib/postgres"
+
+	"auth/internal/app/models"
+)
+
+// ListLoginLocks возвращает действующие задержки и блокировки входа по ключам
+func (r *Repository) ListLoginLocks(ctx context.Context, keys []models.LoginAttemptKey) ([]*models.LoginAttempt, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	byKey := make(squirrel.Or, 0, len(keys))
+	for _, key := range keys {
+		byKey = append(byKey, squirrel.Eq{"scope": key.Scope, "key": key.Key})
+	}
+
+	selectQuery := r.sb.Select("scope", "key", "failed_count", "last_failed_at", "locked_until").
+		From("login_attempts").
+		Where(byKey).
+		Where(squirrel.Gt{"locked_until": time.Now()})
+
+	var attempts []*models.LoginAttempt
+	err := r.tm.RunReadCommitted(ctx, func(txCtx context.Context) error {
+		conn := r.tm.GetQueryEngine(txCtx)
+		return conn.Selectx(txCtx, &attempts, selectQuery)
+	})
+	if err != nil {
+		return nil, postgres.ConvertPGError(err)
+	}
+
+	return attempts, nil
+}
+
+// RegisterFailedLogin увеличивает счетчик неудачных попыток и возвращает его значение.
+// Если последняя неудачная попытка была раньше windowStart, счет начинается заново
+func (r *Repository) RegisterFailedLogin(ctx context.Context, key models.LoginAttemptKey, windowStart time.Time) (int, error) {
+	upsertQuery := r.sb.Insert("login_attempts").
+		Columns("scope", "key", "failed_count", "last_failed_at").
+		Values(key.Scope, key.Key, 1, time.Now()).
+		Suffix(`ON CONFLICT (scope, key) DO UPDATE SET
+			failed_count = CASE WHEN login_attempts.last_failed_at < ? THEN 1 ELSE login_attempts.failed_count + 1 END,
+			last_failed_at = EXCLUDED.last_failed_at
+			RETURNING failed_count`, windowStart)
+
+	var failedCount int
+	err := r.tm.RunReadCommitted(ctx, func(txCtx context.Context) error {
+		conn := r.tm.GetQueryEngine(txCtx)
+		return conn.Getx(txCtx, &failedCount, upsertQuery)
+	})
+	if err != nil {
+		return 0, postgres.ConvertPGError(err)
+	}
+
+	return failedCount, nil
+}
+
+// LockLogin запрещает попытки входа по ключу до lockedUntil
+func (r *Repository) LockLogin(ctx context.Context, key models.LoginAttemptKey, lockedUntil time.Time) error {
+	updateQuery := r.sb.Update("login_attempts").
+		Set("locked_until", lockedUntil).
+		Where(squirrel.Eq{"scope": key.Scope, "key": key.Key})
+
+	err := r.tm.RunReadCommitted(ctx, func(txCtx context.Context) error {
+		conn := r.tm.GetQueryEngine(txCtx)
+		_, err := conn.Execx(txCtx, updateQuery)
+		return err
+	})
+	if err != nil {
+		return postgres.ConvertPGError(err)
+	}
+
+	return nil
+}
+
+// ResetLoginAttempts сбрасывает счетчик неудачных попыток по ключу
+func (r *Repository) ResetLoginAttempts(ctx context.Context, key models.LoginAttemptKey) error {
+	deleteQuery := r.sb.Delete("login_attempts").
+		Where(squirrel.Eq{"scope": key.Scope, "key": key.Key})
+
+	err := r.tm.RunReadCommitted(ctx, func(txCtx context.Context) error {
+		conn := r.tm.GetQueryEngine(txCtx)
+		_, err := conn.Execx(txCtx, deleteQuery)
+		return err
+	})
+	if err != nil {
+		return postgres.ConvertPGError(err)
+	}
+
+	return nil
+}
+
+// DeleteStaleLoginAttempts удаляет счетчики без попыток после before и без действующей блокировки
+func (r *Repository) DeleteStaleLoginAttempts(ctx context.Context, before time.Time) error {
+	deleteQuery := r.sb.Delete("login_attempts").
+		Where(squirrel.Lt{"last_failed_at": before}).
+		Where(squirrel.Or{
+			squirrel.Eq{"locked_until": nil},
+			squirrel.LtOrEq{"locked_until": time.Now()},
+		})
+
+	err := r.tm.RunReadCommitted(ctx, func(txCtx context.Context) error {
+		conn := r.tm.GetQueryEngine(txCtx)
+		_, err := conn.Execx(txCtx, deleteQuery)
+		return err
+	})
+	if err != nil {
+		return postgres.ConvertPGError(err)
+	}
+
+	return nil
+}
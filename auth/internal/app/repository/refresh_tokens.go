@@ -5,6 +5,7 @@ import (
 	"errors"
 	"time"
 
+	"github.com/Masterminds/squirrel"
 	"github.com/jackc/pgx/v5"
 	"github.com/sskorolev/balun_microservices/lib/postgres"
 
@@ -13,9 +14,14 @@ import (
 
 // CreateToken создает новый refresh token в БД
 func (r *Repository) CreateToken(ctx context.Context, token *models.RefreshToken) error {
+	sessionStartedAt := token.SessionStartedAt
+	if sessionStartedAt.IsZero() {
+		sessionStartedAt = token.CreatedAt
+	}
+
 	insertQuery := r.sb.Insert("refresh_tokens").
-		Columns("user_id", "token_hash", "jti", "device_id", "expires_at", "created_at").
-		Values(token.UserID, token.TokenHash, token.JTI, token.DeviceID, token.ExpiresAt, token.CreatedAt)
+		Columns("user_id", "token_hash", "jti", "device_id", "expires_at", "session_started_at", "created_at").
+		Values(token.UserID, token.TokenHash, token.JTI, token.DeviceID, token.ExpiresAt, sessionStartedAt, token.CreatedAt)
 
 	err := r.tm.RunReadCommitted(ctx, func(txCtx context.Context) error {
 		conn := r.tm.GetQueryEngine(txCtx)
@@ -31,7 +37,7 @@ func (r *Repository) CreateToken(ctx context.Context, token *models.RefreshToken
 
 // GetTokenByJTI получает refresh token по JTI
 func (r *Repository) GetTokenByJTI(ctx context.Context, jti string) (*models.RefreshToken, error) {
-	selectQuery := r.sb.Select("id", "user_id", "token_hash", "jti", "device_id", "expires_at", "used_at", "replaced_by_jti", "created_at").
+	selectQuery := r.sb.Select("id", "user_id", "token_hash", "jti", "device_id", "expires_at", "used_at", "replaced_by_jti", "revoked_at", "session_started_at", "created_at").
 		From("refresh_tokens").
 		Where("jti = ?", jti)
 
@@ -51,30 +57,44 @@ func (r *Repository) GetTokenByJTI(ctx context.Context, jti string) (*models.Ref
 	return token, nil
 }
 
-// MarkAsUsed помечает токен как использованный
+// MarkAsUsed помечает токен как использованный.
+// Если токен уже использован параллельным запросом, возвращает models.ErrTokenAlreadyUsed
 func (r *Repository) MarkAsUsed(ctx context.Context, jti, replacedByJTI string) error {
 	updateQuery := r.sb.Update("refresh_tokens").
 		Set("used_at", time.Now()).
 		Set("replaced_by_jti", replacedByJTI).
-		Where("jti = ?", jti)
+		Where("jti = ?", jti).
+		Where("used_at IS NULL")
 
+	var updated int64
 	err := r.tm.RunReadCommitted(ctx, func(txCtx context.Context) error {
 		conn := r.tm.GetQueryEngine(txCtx)
-		_, err := conn.Execx(txCtx, updateQuery)
-		return err
+		tag, err := conn.Execx(txCtx, updateQuery)
+		if err != nil {
+			return err
+		}
+		updated = tag.RowsAffected()
+		return nil
 	})
 	if err != nil {
 		return postgres.ConvertPGError(err)
 	}
 
+	if updated == 0 {
+		return models.ErrTokenAlreadyUsed
+	}
+
 	return nil
 }
 
 // RevokeTokenByJTI отзывает токен по JTI
 func (r *Repository) RevokeTokenByJTI(ctx context.Context, jti string) error {
+	now := time.Now()
 	updateQuery := r.sb.Update("refresh_tokens").
-		Set("used_at", time.Now()).
-		Where("jti = ?", jti)
+		Set("used_at", squirrel.Expr("COALESCE(used_at, ?)", now)).
+		Set("revoked_at", now).
+		Where("jti = ?", jti).
+		Where("revoked_at IS NULL")
 
 	err := r.tm.RunReadCommitted(ctx, func(txCtx context.Context) error {
 		conn := r.tm.GetQueryEngine(txCtx)
@@ -88,6 +108,36 @@ func (r *Repository) RevokeTokenByJTI(ctx context.Context, jti string) error {
 	return nil
 }
 
+// RevokeTokenFamily отзывает токен и всех его потомков по цепочке replaced_by_jti.
+// Возвращает JTI отозванных в этом вызове токенов
+func (r *Repository) RevokeTokenFamily(ctx context.Context, jti string) ([]string, error) {
+	now := time.Now()
+	// UNION (а не UNION ALL) защищает от зацикливания цепочки
+	revokeQuery := squirrel.Expr(`
+		WITH RECURSIVE family AS (
+			SELECT jti, replaced_by_jti FROM refresh_tokens WHERE jti = $1
+			UNION
+			SELECT t.jti, t.replaced_by_jti
+			FROM refresh_tokens t
+			JOIN family f ON t.jti = f.replaced_by_jti
+		)
+		UPDATE refresh_tokens
+		SET revoked_at = $2, used_at = COALESCE(used_at, $2)
+		WHERE jti IN (SELECT jti FROM family) AND revoked_at IS NULL
+		RETURNING jti`, jti, now)
+
+	var revoked []string
+	err := r.tm.RunReadCommitted(ctx, func(txCtx context.Context) error {
+		conn := r.tm.GetQueryEngine(txCtx)
+		return conn.Selectx(txCtx, &revoked, revokeQuery)
+	})
+	if err != nil {
+		return nil, postgres.ConvertPGError(err)
+	}
+
+	return revoked, nil
+}
+
 // CleanupExpiredTokens удаляет истекшие токены
 func (r *Repository) CleanupExpiredTokens(ctx context.Context) error {
 	deleteQuery := r.sb.Delete("refresh_tokens").
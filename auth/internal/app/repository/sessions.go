@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/sskorolev/balun_microservices/lib/postgres"
+
+	"auth/internal/app/models"
+)
+
+// activeTokens условие активного refresh токена: не использован, не отозван и не истек
+func activeTokens(now time.Time) squirrel.Sqlizer {
+	return squirrel.And{
+		squirrel.Expr("used_at IS NULL"),
+		squirrel.Expr("revoked_at IS NULL"),
+		squirrel.Gt{"expires_at": now},
+	}
+}
+
+// ListActiveSessions возвращает активные сессии пользователя, сгруппированные по устройству
+func (r *Repository) ListActiveSessions(ctx context.Context, userID string) ([]*models.Session, error) {
+	selectQuery := r.sb.Select(
+		"COALESCE(device_id, '') AS device_id",
+		"MIN(session_started_at) AS created_at",
+		"MAX(created_at) AS last_refresh_at",
+		"MAX(expires_at) AS expires_at",
+	).
+		From("refresh_tokens").
+		Where(squirrel.Eq{"user_id": userID}).
+		Where(activeTokens(time.Now())).
+		GroupBy("device_id").
+		OrderBy("last_refresh_at DESC")
+
+	var sessions []*models.Session
+	err := r.tm.RunReadCommitted(ctx, func(txCtx context.Context) error {
+		conn := r.tm.GetQueryEngine(txCtx)
+		return conn.Selectx(txCtx, &sessions, selectQuery)
+	})
+	if err != nil {
+		return nil, postgres.ConvertPGError(err)
+	}
+
+	return sessions, nil
+}
+
+// deviceTokens условие токенов пользователя на устройстве
+func deviceTokens(userID, deviceID string) squirrel.Sqlizer {
+	return squirrel.Eq{"user_id": userID, "device_id": deviceID}
+}
+
+// otherDeviceTokens условие токенов пользователя на всех устройствах, кроме указанного.
+// Токены входа без device_id относятся к другим устройствам
+func otherDeviceTokens(userID, keepDeviceID string) squirrel.Sqlizer {
+	return squirrel.And{
+		squirrel.Eq{"user_id": userID},
+		squirrel.Or{
+			squirrel.Eq{"device_id": nil},
+			squirrel.NotEq{"device_id": keepDeviceID},
+		},
+	}
+}
+
+// RevokeDeviceTokens отзывает активные refresh токены пользователя на устройстве
+func (r *Repository) RevokeDeviceTokens(ctx context.Context, userID, deviceID string) (int64, error) {
+	return r.revokeActiveTokens(ctx, deviceTokens(userID, deviceID))
+}
+
+// RevokeOtherDeviceTokens отзывает активные refresh токены пользователя на всех устройствах, кроме указанного
+func (r *Repository) RevokeOtherDeviceTokens(ctx context.Context, userID, keepDeviceID string) (int64, error) {
+	return r.revokeActiveTokens(ctx, otherDeviceTokens(userID, keepDeviceID))
+}
+
+// RevokeUserTokens отзывает активные refresh токены пользователя на всех устройствах
+func (r *Repository) RevokeUserTokens(ctx context.Context, userID string) (int64, error) {
+	return r.revokeActiveTokens(ctx, squirrel.Eq{"user_id": userID})
+}
+
+func (r *Repository) revokeActiveTokens(ctx context.Context, where squirrel.Sqlizer) (int64, error) {
+	updateQuery := r.revokeActiveTokensQuery(where, time.Now())
+
+	var revoked int64
+	err := r.tm.RunReadCommitted(ctx, func(txCtx context.Context) error {
+		conn := r.tm.GetQueryEngine(txCtx)
+		tag, err := conn.Execx(txCtx, updateQuery)
+		if err != nil {
+			return err
+		}
+		revoked = tag.RowsAffected()
+		return nil
+	})
+	if err != nil {
+		return 0, postgres.ConvertPGError(err)
+	}
+
+	return revoked, nil
+}
+
+// revokeActiveTokensQuery отзывает активные refresh токены, подходящие под where
+func (r *Repository) revokeActiveTokensQuery(where squirrel.Sqlizer, now time.Time) squirrel.UpdateBuilder {
+	return r.sb.Update("refresh_tokens").
+		Set("revoked_at", now).
+		Where(where).
+		Where(activeTokens(now))
+}
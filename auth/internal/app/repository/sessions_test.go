@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRevokeActiveTokensQuery(t *testing.T) {
+	now := time.Date(2026, time.October, 17, 12, 0, 0, 0, time.UTC)
+	r := NewRepository(nil)
+
+	tests := []struct {
+		name      string
+		where     squirrel.Sqlizer
+		wantWhere string
+		wantArgs  []any
+	}{
+		{
+			name:      "токены одного устройства",
+			where:     deviceTokens("user", "phone"),
+			wantWhere: "WHERE device_id = $2 AND user_id = $3",
+			wantArgs:  []any{now, "phone", "user", now},
+		},
+		{
+			name:      "все устройства, кроме текущего, включая вход без device_id",
+			where:     otherDeviceTokens("user", "phone"),
+			wantWhere: "WHERE (user_id = $2 AND (device_id IS NULL OR device_id <> $3))",
+			wantArgs:  []any{now, "user", "phone", now},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, args, err := r.revokeActiveTokensQuery(tt.where, now).ToSql()
+			require.NoError(t, err)
+
+			assert.Equal(t, "UPDATE refresh_tokens SET revoked_at = $1 "+tt.wantWhere+
+				" AND (used_at IS NULL AND revoked_at IS NULL AND expires_at > $4)", sql)
+			assert.Equal(t, tt.wantArgs, args)
+		})
+	}
+}
@@ -5,21 +5,24 @@ import (
 	"errors"
 	"time"
 
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgerrcode"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/sskorolev/balun_microservices/lib/postgres"
 
 	"auth/internal/app/models"
 )
 
-// CreateUser создает нового пользователя в БД
+// CreateUser создает нового пользователя в БД в статусе незавершенной регистрации
 func (r *Repository) CreateUser(ctx context.Context, email, passwordHash string) (*models.User, error) {
 	now := time.Now()
 	user := &models.User{}
 
 	insertQuery := r.sb.Insert("users").
-		Columns("email", "password_hash", "created_at", "updated_at").
-		Values(email, passwordHash, now, now).
-		Suffix("RETURNING id, email, password_hash, created_at, updated_at")
+		Columns("email", "password_hash", "registration_status", "created_at", "updated_at").
+		Values(email, passwordHash, models.RegistrationStatusPending, now, now).
+		Suffix("RETURNING id, email, password_hash, registration_status, email_verified_at, pending_email, created_at, updated_at")
 
 	err := r.tm.RunReadCommitted(ctx, func(txCtx context.Context) error {
 		conn := r.tm.GetQueryEngine(txCtx)
@@ -34,7 +37,7 @@ func (r *Repository) CreateUser(ctx context.Context, email, passwordHash string)
 
 // GetUserByEmail получает пользователя по email
 func (r *Repository) GetUserByEmail(ctx context.Context, email string) (*models.User, error) {
-	selectQuery := r.sb.Select("id", "email", "password_hash", "created_at", "updated_at").
+	selectQuery := r.sb.Select("id", "email", "password_hash", "registration_status", "email_verified_at", "pending_email", "created_at", "updated_at").
 		From("users").
 		Where("email = ?", email)
 
@@ -56,7 +59,7 @@ func (r *Repository) GetUserByEmail(ctx context.Context, email string) (*models.
 
 // GetUserByID получает пользователя по ID
 func (r *Repository) GetUserByID(ctx context.Context, userID string) (*models.User, error) {
-	selectQuery := r.sb.Select("id", "email", "password_hash", "created_at", "updated_at").
+	selectQuery := r.sb.Select("id", "email", "password_hash", "registration_status", "email_verified_at", "pending_email", "created_at", "updated_at").
 		From("users").
 		Where("id = ?", userID)
 
@@ -76,15 +79,96 @@ func (r *Repository) GetUserByID(ctx context.Context, userID string) (*models.Us
 	return user, nil
 }
 
-// UpdateUser обновляет данные пользователя
-func (r *Repository) UpdateUser(ctx context.Context, user *models.User) error {
-	user.UpdatedAt = time.Now()
+// SetPasswordHash заменяет хеш пароля, остальные поля пользователя не меняются
+func (r *Repository) SetPasswordHash(ctx context.Context, userID, passwordHash string) error {
+	updateQuery := r.sb.Update("users").
+		Set("password_hash", passwordHash).
+		Set("updated_at", time.Now()).
+		Where("id = ?", userID)
+
+	err := r.tm.RunReadCommitted(ctx, func(txCtx context.Context) error {
+		conn := r.tm.GetQueryEngine(txCtx)
+		_, err := conn.Execx(txCtx, updateQuery)
+		return err
+	})
+	if err != nil {
+		return postgres.ConvertPGError(err)
+	}
+
+	return nil
+}
+
+// UpdatePasswordHash заменяет хеш пароля, только если он не изменился с момента чтения.
+// false - хеш уже сменили (например, ChangePassword), обновление не выполнено
+func (r *Repository) UpdatePasswordHash(ctx context.Context, userID, oldHash, newHash string) (bool, error) {
+	updateQuery := r.sb.Update("users").
+		Set("password_hash", newHash).
+		Set("updated_at", time.Now()).
+		Where("id = ?", userID).
+		Where("password_hash = ?", oldHash)
+
+	var updated int64
+	err := r.tm.RunReadCommitted(ctx, func(txCtx context.Context) error {
+		conn := r.tm.GetQueryEngine(txCtx)
+		tag, err := conn.Execx(txCtx, updateQuery)
+		if err != nil {
+			return err
+		}
+		updated = tag.RowsAffected()
+		return nil
+	})
+	if err != nil {
+		return false, postgres.ConvertPGError(err)
+	}
+
+	return updated > 0, nil
+}
+
+// CompleteRegistration переводит пользователя в статус завершенной регистрации
+func (r *Repository) CompleteRegistration(ctx context.Context, userID string) error {
+	updateQuery := r.sb.Update("users").
+		Set("registration_status", models.RegistrationStatusCompleted).
+		Set("updated_at", time.Now()).
+		Where("id = ?", userID)
+
+	err := r.tm.RunReadCommitted(ctx, func(txCtx context.Context) error {
+		conn := r.tm.GetQueryEngine(txCtx)
+		_, err := conn.Execx(txCtx, updateQuery)
+		return err
+	})
+	if err != nil {
+		return postgres.ConvertPGError(err)
+	}
+
+	return nil
+}
+
+// MarkEmailVerified отмечает email пользователя подтвержденным, повторное подтверждение время не меняет
+func (r *Repository) MarkEmailVerified(ctx context.Context, userID string) error {
+	now := time.Now()
+	updateQuery := r.sb.Update("users").
+		Set("email_verified_at", squirrel.Expr("COALESCE(email_verified_at, ?)", now)).
+		Set("updated_at", now).
+		Where("id = ?", userID)
+
+	err := r.tm.RunReadCommitted(ctx, func(txCtx context.Context) error {
+		conn := r.tm.GetQueryEngine(txCtx)
+		_, err := conn.Execx(txCtx, updateQuery)
+		return err
+	})
+	if err != nil {
+		return postgres.ConvertPGError(err)
+	}
 
+	return nil
+}
+
+// SetPendingEmail сохраняет новый email до подтверждения, nil отменяет смену email
+func (r *Repository) SetPendingEmail(ctx context.Context, userID string, email *string) error {
 	updateQuery := r.sb.Update("users").
-		Set("email", user.Email).
-		Set("password_hash", user.PasswordHash).
-		Set("updated_at", user.UpdatedAt).
-		Where("id = ?", user.ID)
+		Set("pending_email", email).
+		Set("updated_at", time.Now()).
+		Where("id = ?", userID)
 
 	err := r.tm.RunReadCommitted(ctx, func(txCtx context.Context) error {
 		conn := r.tm.GetQueryEngine(txCtx)
@@ -98,6 +182,60 @@ func (r *Repository) UpdateUser(ctx context.Context, user *models.User) error {
 	return nil
 }
 
+// ApplyPendingEmail заменяет email подтвержденным новым адресом.
+// models.ErrNotFound - смена email не запрошена, models.ErrAlreadyExists - адрес занят другим пользователем
+func (r *Repository) ApplyPendingEmail(ctx context.Context, userID string) error {
+	now := time.Now()
+	updateQuery := r.sb.Update("users").
+		Set("email", squirrel.Expr("pending_email")).
+		Set("pending_email", nil).
+		Set("email_verified_at", now).
+		Set("updated_at", now).
+		Where("id = ?", userID).
+		Where("pending_email IS NOT NULL")
+
+	var updated int64
+	err := r.tm.RunReadCommitted(ctx, func(txCtx context.Context) error {
+		conn := r.tm.GetQueryEngine(txCtx)
+		tag, err := conn.Execx(txCtx, updateQuery)
+		if err != nil {
+			return err
+		}
+		updated = tag.RowsAffected()
+		return nil
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation {
+			return models.ErrAlreadyExists
+		}
+		return postgres.ConvertPGError(err)
+	}
+	if updated == 0 {
+		return models.ErrNotFound
+	}
+
+	return nil
+}
+
+// DeletePendingUser удаляет пользователя с незавершенной регистрацией (компенсация саги)
+func (r *Repository) DeletePendingUser(ctx context.Context, userID string) error {
+	deleteQuery := r.sb.Delete("users").
+		Where("id = ?", userID).
+		Where("registration_status = ?", models.RegistrationStatusPending)
+
+	err := r.tm.RunReadCommitted(ctx, func(txCtx context.Context) error {
+		conn := r.tm.GetQueryEngine(txCtx)
+		_, err := conn.Execx(txCtx, deleteQuery)
+		return err
+	})
+	if err != nil {
+		return postgres.ConvertPGError(err)
+	}
+
+	return nil
+}
+
 // SaveUser - для совместимости со старым интерфейсом
 func (r *Repository) SaveUser(ctx context.Context, email, password string) (*models.User, error) {
 	return r.CreateUser(ctx, email, password)
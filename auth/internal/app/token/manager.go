@@ -2,6 +2,7 @@ package token
 
 import (
 	"context"
+	"crypto"
 	"errors"
 	"fmt"
 	"time"
@@ -17,6 +18,14 @@ var (
 	ErrTokenExpired = errors.New("token expired")
 )
 
+// validMethods алгоритмы, которыми могут быть подписаны токены. Конкретный метод
+// дополнительно сверяется с алгоритмом ключа по kid
+var validMethods = []string{
+	jwt.SigningMethodRS256.Alg(),
+	jwt.SigningMethodES256.Alg(),
+	jwt.SigningMethodEdDSA.Alg(),
+}
+
 // RefreshTokenClaims - claims для refresh токена
 type RefreshTokenClaims struct {
 	Issuer    string `json:"iss"`
@@ -28,6 +37,14 @@ type RefreshTokenClaims struct {
 	DeviceID  string `json:"device_id,omitempty"`
 }
 
+// AccessTokenClaims - claims для access токена
+type AccessTokenClaims struct {
+	Subject   string `json:"sub"` // user_id
+	IssuedAt  int64  `json:"iat"`
+	ExpiresAt int64  `json:"exp"`
+	JWTID     string `json:"jti"`
+}
+
 // TokenManager - менеджер для создания и валидации JWT токенов
 type TokenManager struct {
 	cfg      Config
@@ -44,14 +61,9 @@ func NewTokenManager(cfg Config, keyStore keystore.KeyStore) *TokenManager {
 // CreateAccessToken - создает access JWT токен
 func (tm *TokenManager) CreateAccessToken(ctx context.Context, userID string) (string, error) {
 	// Получаем активный ключ для подписи
-	key, err := tm.keyStore.GetActiveKey(ctx)
+	key, privateKey, method, err := tm.signingKey(ctx)
 	if err != nil {
-		return "", fmt.Errorf("failed to get active key: %w", err)
-	}
-
-	privateKey, err := keystore.DecodePrivateKeyFromPEM(key.PrivateKeyPEM)
-	if err != nil {
-		return "", fmt.Errorf("failed to decode private key: %w", err)
+		return "", err
 	}
 
 	now := time.Now()
@@ -65,7 +77,7 @@ func (tm *TokenManager) CreateAccessToken(ctx context.Context, userID string) (s
 		"jti": uuid.New().String(),
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token := jwt.NewWithClaims(method, claims)
 	token.Header["kid"] = key.KID
 
 	return token.SignedString(privateKey)
@@ -73,14 +85,9 @@ func (tm *TokenManager) CreateAccessToken(ctx context.Context, userID string) (s
 
 // CreateRefreshToken - создает refresh JWT токен
 func (tm *TokenManager) CreateRefreshToken(ctx context.Context, userID, deviceID string) (string, string, error) {
-	key, err := tm.keyStore.GetActiveKey(ctx)
+	key, privateKey, method, err := tm.signingKey(ctx)
 	if err != nil {
-		return "", "", fmt.Errorf("failed to get active key: %w", err)
-	}
-
-	privateKey, err := keystore.DecodePrivateKeyFromPEM(key.PrivateKeyPEM)
-	if err != nil {
-		return "", "", fmt.Errorf("failed to decode private key: %w", err)
+		return "", "", err
 	}
 
 	now := time.Now()
@@ -99,7 +106,7 @@ func (tm *TokenManager) CreateRefreshToken(ctx context.Context, userID, deviceID
 		claims["device_id"] = deviceID
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token := jwt.NewWithClaims(method, claims)
 	token.Header["kid"] = key.KID
 
 	signedToken, err := token.SignedString(privateKey)
@@ -113,7 +120,7 @@ func (tm *TokenManager) CreateRefreshToken(ctx context.Context, userID, deviceID
 // VerifyRefreshToken - верифицирует refresh токен
 func (tm *TokenManager) VerifyRefreshToken(ctx context.Context, tokenString string) (*RefreshTokenClaims, error) {
 	token, err := jwt.Parse(tokenString, tm.keyFunc(ctx),
-		jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Name}),
+		jwt.WithValidMethods(validMethods),
 		jwt.WithIssuer(tm.cfg.Issuer),
 		jwt.WithExpirationRequired(),
 	)
@@ -138,6 +145,55 @@ func (tm *TokenManager) VerifyRefreshToken(ctx context.Context, tokenString stri
 	return tm.mapToRefreshClaims(claims)
 }
 
+// VerifyAccessToken - верифицирует access токен
+func (tm *TokenManager) VerifyAccessToken(ctx context.Context, tokenString string) (*AccessTokenClaims, error) {
+	token, err := jwt.Parse(tokenString, tm.keyFunc(ctx),
+		jwt.WithValidMethods(validMethods),
+		jwt.WithIssuer(tm.cfg.Issuer),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return nil, ErrTokenExpired
+		}
+		return nil, fmt.Errorf("failed to parse token: %w", err)
+	}
+
+	if !token.Valid {
+		return nil, ErrInvalidToken
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, ErrInvalidToken
+	}
+
+	// refresh токен не принимается вместо access
+	if _, isRefresh := claims["type"]; isRefresh {
+		return nil, ErrInvalidToken
+	}
+
+	sub, ok := claims["sub"].(string)
+	if !ok || sub == "" {
+		return nil, ErrInvalidToken
+	}
+
+	exp, ok := claims["exp"].(float64)
+	if !ok {
+		return nil, ErrInvalidToken
+	}
+
+	jti, _ := claims["jti"].(string)
+	iat, _ := claims["iat"].(float64)
+
+	return &AccessTokenClaims{
+		Subject:   sub,
+		IssuedAt:  int64(iat),
+		ExpiresAt: int64(exp),
+		JWTID:     jti,
+	}, nil
+}
+
 func (tm *TokenManager) keyFunc(ctx context.Context) jwt.Keyfunc {
 	return func(token *jwt.Token) (interface{}, error) {
 		kid, ok := token.Header["kid"].(string)
@@ -149,9 +205,37 @@ func (tm *TokenManager) keyFunc(ctx context.Context) jwt.Keyfunc {
 		if err != nil {
 			return nil, fmt.Errorf("failed to get key by KID: %w", err)
 		}
+		// Истекшие после ротации ключи больше не принимаются
+		if key.Status == keystore.KeyStatusExpired {
+			return nil, fmt.Errorf("key %s is expired", kid)
+		}
+		// Алгоритм токена должен совпадать с алгоритмом ключа
+		if token.Method.Alg() != string(key.Algorithm) {
+			return nil, fmt.Errorf("unexpected signing method %s for key %s", token.Method.Alg(), kid)
+		}
+
+		return keystore.DecodeVerificationKeyFromPEM(key.PublicKeyPEM)
+	}
+}
 
-		return keystore.DecodePublicKeyFromPEM(key.PublicKeyPEM)
+// signingKey возвращает активный ключ, его приватную часть и метод подписи по алгоритму ключа
+func (tm *TokenManager) signingKey(ctx context.Context) (*keystore.RSAKey, crypto.Signer, jwt.SigningMethod, error) {
+	key, err := tm.keyStore.GetActiveKey(ctx)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to get active key: %w", err)
+	}
+
+	privateKey, err := keystore.DecodeSigningKeyFromPEM(key.PrivateKeyPEM)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to decode private key: %w", err)
+	}
+
+	method := jwt.GetSigningMethod(string(key.Algorithm))
+	if method == nil {
+		return nil, nil, nil, fmt.Errorf("unsupported key algorithm: %s", key.Algorithm)
 	}
+
+	return key, privateKey, method, nil
 }
 
 func (tm *TokenManager) mapToRefreshClaims(claims jwt.MapClaims) (*RefreshTokenClaims, error) {
@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"time"
+
+	"auth/internal/app/crypto"
+	"auth/internal/app/models"
+)
+
+// issueActionToken создает одноразовый токен для письма, ранее выданные токены с тем же назначением аннулируются.
+// В БД хранится только хеш, сам токен возвращается для ссылки
+func (s *AuthService) issueActionToken(ctx context.Context, userID string, purpose models.ActionTokenPurpose, ttl time.Duration) (string, error) {
+	rawToken, err := crypto.GenerateToken()
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	err = s.txManager.RunReadCommitted(ctx, func(txCtx context.Context) error {
+		if err := s.actionTokensRepo.InvalidateActionTokens(txCtx, userID, purpose); err != nil {
+			return err
+		}
+		return s.actionTokensRepo.CreateActionToken(txCtx, &models.ActionToken{
+			UserID:    userID,
+			Purpose:   purpose,
+			TokenHash: crypto.HashToken(rawToken),
+			ExpiresAt: now.Add(ttl),
+			CreatedAt: now,
+		})
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return rawToken, nil
+}
+
+// useActionToken погашает токен из письма, неизвестный, истекший и использованный токен - ErrInvalidActionToken
+func (s *AuthService) useActionToken(ctx context.Context, purpose models.ActionTokenPurpose, rawToken string) (*models.ActionToken, error) {
+	token, err := s.actionTokensRepo.UseActionToken(ctx, purpose, crypto.HashToken(rawToken))
+	if err != nil {
+		if errors.Is(err, models.ErrNotFound) {
+			return nil, ErrInvalidActionToken
+		}
+		return nil, err
+	}
+	return token, nil
+}
+
+// actionLink ссылка для письма: base с параметром token
+func actionLink(base, token string) string {
+	u, err := url.Parse(base)
+	if err != nil {
+		return base + "?token=" + url.QueryEscape(token)
+	}
+	q := u.Query()
+	q.Set("token", token)
+	u.RawQuery = q.Encode()
+	return u.String()
+}
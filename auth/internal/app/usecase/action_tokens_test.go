@@ -0,0 +1,182 @@
+package usecase
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"auth/internal/app/crypto"
+	"auth/internal/app/mail"
+	"auth/internal/app/models"
+	"auth/internal/app/usecase/dto"
+)
+
+var actionLinkRe = regexp.MustCompile(`https?://\S+`)
+
+// lastMailToken токен из ссылки последнего отправленного письма
+func (env *testEnv) lastMailToken(t *testing.T) string {
+	t.Helper()
+
+	require.NotEmpty(t, env.mailSender.messages)
+	return mailToken(t, env.mailSender.messages[len(env.mailSender.messages)-1])
+}
+
+// lastMailTokenTo токен из ссылки последнего письма на адрес to
+func (env *testEnv) lastMailTokenTo(t *testing.T, to string) string {
+	t.Helper()
+
+	for i := len(env.mailSender.messages) - 1; i >= 0; i-- {
+		if msg := env.mailSender.messages[i]; msg.To == to {
+			return mailToken(t, msg)
+		}
+	}
+	require.Failf(t, "no mail", "to %s", to)
+	return ""
+}
+
+func mailToken(t *testing.T, msg mail.Message) string {
+	t.Helper()
+
+	link, err := url.Parse(actionLinkRe.FindString(msg.Body))
+	require.NoError(t, err)
+	token := link.Query().Get("token")
+	require.NotEmpty(t, token)
+	return token
+}
+
+func TestAuthService_EmailVerification(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func(t *testing.T) (*AuthService, *testEnv) {
+		s, env := newTestService(t, testConfig())
+		env.usersRepo.GetUserByIDMock.Optional().Return(env.testUser(t), nil)
+		return s, env
+	}
+
+	t.Run("ссылка подтверждает email один раз", func(t *testing.T) {
+		s, env := setup(t)
+		require.NoError(t, s.RequestEmailVerification(ctx, testUserID))
+		token := env.lastMailToken(t)
+		assert.Equal(t, testEmail, env.mailSender.messages[0].To)
+
+		env.usersRepo.MarkEmailVerifiedMock.Expect(ctx, testUserID).Return(nil)
+		require.NoError(t, s.ConfirmEmail(ctx, token))
+
+		require.ErrorIs(t, s.ConfirmEmail(ctx, token), ErrInvalidActionToken)
+	})
+
+	t.Run("истекшая ссылка", func(t *testing.T) {
+		s, env := setup(t)
+		require.NoError(t, s.RequestEmailVerification(ctx, testUserID))
+		token := env.lastMailToken(t)
+		env.actionTokens.tokens[0].ExpiresAt = time.Now().Add(-time.Minute)
+
+		require.ErrorIs(t, s.ConfirmEmail(ctx, token), ErrInvalidActionToken)
+	})
+
+	t.Run("новое письмо аннулирует прежнюю ссылку", func(t *testing.T) {
+		s, env := setup(t)
+		require.NoError(t, s.RequestEmailVerification(ctx, testUserID))
+		first := env.lastMailToken(t)
+		require.NoError(t, s.RequestEmailVerification(ctx, testUserID))
+		second := env.lastMailToken(t)
+
+		require.ErrorIs(t, s.ConfirmEmail(ctx, first), ErrInvalidActionToken)
+
+		env.usersRepo.MarkEmailVerifiedMock.Return(nil)
+		require.NoError(t, s.ConfirmEmail(ctx, second))
+	})
+
+	t.Run("неизвестный токен", func(t *testing.T) {
+		s, _ := setup(t)
+		require.ErrorIs(t, s.ConfirmEmail(ctx, "unknown"), ErrInvalidActionToken)
+	})
+
+	t.Run("подтвержденный email", func(t *testing.T) {
+		s, env := newTestService(t, testConfig())
+		user := env.testUser(t)
+		verifiedAt := time.Now()
+		user.EmailVerifiedAt = &verifiedAt
+		env.usersRepo.GetUserByIDMock.Return(user, nil)
+
+		require.ErrorIs(t, s.RequestEmailVerification(ctx, testUserID), ErrEmailAlreadyVerified)
+		assert.Empty(t, env.mailSender.messages)
+	})
+}
+
+func TestAuthService_PasswordReset(t *testing.T) {
+	ctx := context.Background()
+	const newPassword = "newpassword123"
+
+	// setup - пользователь вошел с телефона и запросил сброс пароля
+	setup := func(t *testing.T) (*AuthService, *testEnv, string) {
+		s, env := newTestService(t, testConfig())
+		user := env.testUser(t)
+		env.login(t, s, user, "phone")
+		env.usersRepo.GetUserByIDMock.Optional().Return(user, nil)
+
+		require.NoError(t, s.RequestPasswordReset(ctx, testEmail))
+		return s, env, env.lastMailToken(t)
+	}
+
+	t.Run("сброс пароля по ссылке отзывает все токены", func(t *testing.T) {
+		s, env, token := setup(t)
+		var passwordHash string
+		env.usersRepo.SetPasswordHashMock.Set(func(_ context.Context, userID, hash string) error {
+			assert.Equal(t, testUserID, userID)
+			passwordHash = hash
+			return nil
+		})
+		env.usersRepo.MarkEmailVerifiedMock.Expect(ctx, testUserID).Return(nil)
+
+		require.NoError(t, s.ResetPassword(ctx, dto.ResetPasswordRequest{Token: token, NewPassword: newPassword}))
+
+		require.NoError(t, env.hasher.Verify(passwordHash, newPassword))
+
+		sessions, err := s.ListSessions(ctx, testUserID)
+		require.NoError(t, err)
+		assert.Empty(t, sessions)
+		assert.NotNil(t, env.revocations.watermarks[testUserID])
+
+		require.ErrorIs(t, s.ResetPassword(ctx, dto.ResetPasswordRequest{Token: token, NewPassword: newPassword}),
+			ErrInvalidActionToken)
+	})
+
+	t.Run("слишком короткий пароль не гасит ссылку", func(t *testing.T) {
+		s, env, token := setup(t)
+
+		require.ErrorIs(t, s.ResetPassword(ctx, dto.ResetPasswordRequest{Token: token, NewPassword: "short"}),
+			crypto.ErrPasswordTooShort)
+		assert.Nil(t, env.actionTokens.tokens[0].UsedAt)
+	})
+
+	t.Run("истекшая ссылка", func(t *testing.T) {
+		s, env, token := setup(t)
+		env.actionTokens.tokens[0].ExpiresAt = time.Now().Add(-time.Minute)
+
+		require.ErrorIs(t, s.ResetPassword(ctx, dto.ResetPasswordRequest{Token: token, NewPassword: newPassword}),
+			ErrInvalidActionToken)
+	})
+
+	t.Run("ссылка подтверждения email не сбрасывает пароль", func(t *testing.T) {
+		s, env, _ := setup(t)
+		require.NoError(t, s.RequestEmailVerification(ctx, testUserID))
+
+		require.ErrorIs(t, s.ResetPassword(ctx, dto.ResetPasswordRequest{Token: env.lastMailToken(t), NewPassword: newPassword}),
+			ErrInvalidActionToken)
+	})
+
+	t.Run("неизвестный email не раскрывается", func(t *testing.T) {
+		s, env := newTestService(t, testConfig())
+		env.usersRepo.GetUserByEmailMock.Return(nil, models.ErrNotFound)
+
+		require.NoError(t, s.RequestPasswordReset(ctx, "unknown@example.com"))
+		assert.Empty(t, env.mailSender.messages)
+		assert.Empty(t, env.actionTokens.tokens)
+	})
+}
@@ -0,0 +1,187 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/sskorolev/balun_microservices/lib/logger"
+
+	"auth/internal/app/mail"
+	"auth/internal/app/models"
+	"auth/internal/app/usecase/dto"
+)
+
+const (
+	apiChangePassword = "[AuthService][ChangePassword]"
+	apiChangeEmail    = "[AuthService][ChangeEmail]"
+)
+
+func (s *AuthService) ChangePassword(ctx context.Context, req dto.ChangePasswordRequest) (*dto.ChangePasswordResponse, error) {
+	user, err := s.usersRepo.GetUserByID(ctx, req.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: userRepo GetUserByID error: %w", apiChangePassword, err)
+	}
+
+	if err := s.verifyCurrentPassword(ctx, user, req.CurrentPassword); err != nil {
+		return nil, err
+	}
+
+	passwordHash, err := s.passwordHasher.Hash(req.NewPassword)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to hash password: %w", apiChangePassword, err)
+	}
+
+	resp := &dto.ChangePasswordResponse{}
+	err = s.txManager.RunReadCommitted(ctx, func(txCtx context.Context) error {
+		// Обновляется только хеш и только если пароль не сменили после проверки текущего:
+		// запись всей строки вернула бы email, подтвержденный параллельно через ConfirmEmail
+		updated, err := s.usersRepo.UpdatePasswordHash(txCtx, user.ID, user.PasswordHash, passwordHash)
+		if err != nil {
+			return err
+		}
+		if !updated {
+			return ErrWrongPassword
+		}
+
+		// Ссылки сброса, отправленные до смены, больше не нужны
+		if err := s.actionTokensRepo.InvalidateActionTokens(txCtx, user.ID, models.ActionTokenPasswordReset); err != nil {
+			return err
+		}
+
+		// Старые токены могли утечь вместе с паролем, поэтому отзываются все, включая текущее устройство
+		resp.Revoked, err = s.revokeAllUserTokens(txCtx, user.ID)
+		if err != nil {
+			return err
+		}
+
+		// Токены выдаются после watermark и под ним не попадают
+		resp.Token, err = s.issueTokens(txCtx, user.ID, req.CurrentDeviceID)
+		if err != nil {
+			return err
+		}
+
+		return s.auditLogRepo.SaveAuditEvent(txCtx, &models.AuditEvent{
+			UserID: &user.ID,
+			Type:   models.AuditEventPasswordChanged,
+			Details: map[string]any{
+				"current_device_id": req.CurrentDeviceID,
+				"revoked_tokens":    resp.Revoked,
+			},
+			CreatedAt: time.Now(),
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", apiChangePassword, err)
+	}
+
+	logger.InfoKV(ctx, "password changed, sessions revoked", "user_id", user.ID, "revoked", resp.Revoked)
+	return resp, nil
+}
+
+func (s *AuthService) ChangeEmail(ctx context.Context, req dto.ChangeEmailRequest) error {
+	user, err := s.usersRepo.GetUserByID(ctx, req.UserID)
+	if err != nil {
+		return fmt.Errorf("%s: userRepo GetUserByID error: %w", apiChangeEmail, err)
+	}
+
+	if err := s.verifyCurrentPassword(ctx, user, req.CurrentPassword); err != nil {
+		return err
+	}
+
+	if user.Email == req.NewEmail {
+		if user.PendingEmail == nil {
+			return nil
+		}
+		// Возврат к текущему адресу отменяет запрошенную смену
+		err = s.txManager.RunReadCommitted(ctx, func(txCtx context.Context) error {
+			if err := s.usersRepo.SetPendingEmail(txCtx, user.ID, nil); err != nil {
+				return err
+			}
+			return s.actionTokensRepo.InvalidateActionTokens(txCtx, user.ID, models.ActionTokenEmailVerification)
+		})
+		if err != nil {
+			return fmt.Errorf("%s: %w", apiChangeEmail, err)
+		}
+
+		logger.InfoKV(ctx, "email change cancelled", "user_id", user.ID)
+		return nil
+	}
+
+	existingUser, err := s.usersRepo.GetUserByEmail(ctx, req.NewEmail)
+	if err != nil && !errors.Is(err, models.ErrNotFound) {
+		return fmt.Errorf("%s: userRepo GetUserByEmail error: %w", apiChangeEmail, err)
+	}
+	if existingUser != nil {
+		return models.ErrAlreadyExists
+	}
+
+	err = s.txManager.RunReadCommitted(ctx, func(txCtx context.Context) error {
+		if err := s.usersRepo.SetPendingEmail(txCtx, user.ID, &req.NewEmail); err != nil {
+			return err
+		}
+
+		// Ссылки подтверждения, отправленные ранее, не должны подтвердить новый адрес
+		if err := s.actionTokensRepo.InvalidateActionTokens(txCtx, user.ID, models.ActionTokenEmailVerification); err != nil {
+			return err
+		}
+
+		return s.auditLogRepo.SaveAuditEvent(txCtx, &models.AuditEvent{
+			UserID: &user.ID,
+			Type:   models.AuditEventEmailChangeRequested,
+			Details: map[string]any{
+				"old_email": user.Email,
+				"new_email": req.NewEmail,
+			},
+			CreatedAt: time.Now(),
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", apiChangeEmail, err)
+	}
+	user.PendingEmail = &req.NewEmail
+
+	// Письмо можно запросить повторно через RequestEmailVerification
+	if err := s.sendEmailVerification(ctx, user); err != nil {
+		logger.WarnKV(ctx, "change email: failed to send email verification",
+			"user_id", user.ID,
+			"error", err.Error(),
+		)
+	}
+
+	err = s.mailSender.Send(ctx, mail.Message{
+		To:      user.Email,
+		Subject: "Смена email",
+		Body: fmt.Sprintf("Для вашего аккаунта запрошена смена email на %s.\n"+
+			"Email изменится после перехода по ссылке из письма, отправленного на новый адрес.\n\n"+
+			"Если вы не запрашивали смену email, смените пароль.\n", req.NewEmail),
+	})
+	if err != nil {
+		logger.WarnKV(ctx, "change email: failed to notify old email",
+			"user_id", user.ID,
+			"error", err.Error(),
+		)
+	}
+
+	logger.InfoKV(ctx, "email change requested, verification required", "user_id", user.ID)
+	return nil
+}
+
+// verifyCurrentPassword проверяет текущий пароль с той же защитой от перебора, что и вход.
+// Попытки считаются по email пользователя, поэтому перебор через смену учетных данных
+// блокирует и вход, и наоборот
+func (s *AuthService) verifyCurrentPassword(ctx context.Context, user *models.User, password string) error {
+	attemptKeys := emailAttemptKeys(user.Email)
+	if err := s.checkLoginAllowed(ctx, attemptKeys); err != nil {
+		return err
+	}
+
+	if err := s.passwordHasher.Verify(user.PasswordHash, password); err != nil {
+		s.registerFailedLogin(ctx, attemptKeys)
+		return ErrWrongPassword
+	}
+	s.resetLoginAttempts(ctx, attemptKeys)
+
+	return nil
+}
@@ -0,0 +1,361 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"auth/internal/app/models"
+	"auth/internal/app/usecase/dto"
+)
+
+func TestAuthService_ChangeCredentialsLoginProtection(t *testing.T) {
+	ctx := context.Background()
+
+	cfg := testConfig()
+	cfg.LoginProtection = LoginProtectionConfig{
+		Enabled:               true,
+		Window:                15 * time.Minute,
+		FreeAttempts:          5,
+		EmailLockoutThreshold: 3,
+		LockoutDuration:       time.Hour,
+	}
+
+	changes := []struct {
+		name   string
+		change func(s *AuthService, password string) error
+	}{
+		{
+			name: "ChangePassword",
+			change: func(s *AuthService, password string) error {
+				_, err := s.ChangePassword(ctx, dto.ChangePasswordRequest{
+					UserID:          testUserID,
+					CurrentPassword: password,
+					NewPassword:     "newpassword123",
+					CurrentDeviceID: "phone",
+				})
+				return err
+			},
+		},
+		{
+			name: "ChangeEmail",
+			change: func(s *AuthService, password string) error {
+				return s.ChangeEmail(ctx, dto.ChangeEmailRequest{
+					UserID:          testUserID,
+					CurrentPassword: password,
+					NewEmail:        testEmail,
+				})
+			},
+		},
+	}
+
+	for _, tt := range changes {
+		t.Run(tt.name+": перебор текущего пароля блокирует вход по email", func(t *testing.T) {
+			s, env := newTestService(t, cfg)
+			user := env.testUser(t)
+			env.usersRepo.GetUserByIDMock.Return(user, nil)
+
+			for range 3 {
+				require.ErrorIs(t, tt.change(s, "wrongpassword"), ErrWrongPassword)
+			}
+
+			// Верный пароль больше не проверяется
+			require.ErrorIs(t, tt.change(s, testPassword), ErrLoginLocked)
+
+			// Блокировка общая со входом: до поиска пользователя дело не доходит
+			env.usersRepo.GetUserByEmailMock.Optional().Return(user, nil)
+			_, err := s.Login(ctx, dto.LoginRequest{Email: testEmail, Password: testPassword})
+			require.ErrorIs(t, err, ErrLoginLocked)
+		})
+
+		t.Run(tt.name+": заблокированный вход запрещает смену", func(t *testing.T) {
+			s, env := newTestService(t, cfg)
+			user := env.testUser(t)
+			env.usersRepo.GetUserByIDMock.Return(user, nil)
+			env.usersRepo.GetUserByEmailMock.Return(user, nil)
+
+			for range 3 {
+				_, err := s.Login(ctx, dto.LoginRequest{Email: testEmail, Password: "wrongpassword"})
+				require.ErrorIs(t, err, ErrWrongPassword)
+			}
+
+			require.ErrorIs(t, tt.change(s, testPassword), ErrLoginLocked)
+		})
+	}
+
+	t.Run("верный пароль сбрасывает счетчик email", func(t *testing.T) {
+		s, env := newTestService(t, cfg)
+		env.usersRepo.GetUserByIDMock.Return(env.testUser(t), nil)
+
+		require.ErrorIs(t, changes[1].change(s, "wrongpassword"), ErrWrongPassword)
+		require.NoError(t, changes[1].change(s, testPassword))
+
+		assert.NotContains(t, env.loginAttempts.attempts,
+			models.LoginAttemptKey{Scope: models.LoginAttemptScopeEmail, Key: testEmail})
+	})
+}
+
+func TestAuthService_ChangeEmail(t *testing.T) {
+	ctx := context.Background()
+	const newEmail = "new@example.com"
+
+	// setup - пользователь с состоянием email в памяти
+	setup := func(t *testing.T) (*AuthService, *testEnv, *models.User) {
+		s, env := newTestService(t, testConfig())
+		user := env.testUser(t)
+		env.usersRepo.GetUserByIDMock.Return(user, nil)
+		env.usersRepo.GetUserByEmailMock.Optional().Set(func(_ context.Context, email string) (*models.User, error) {
+			if email == user.Email {
+				return user, nil
+			}
+			return nil, models.ErrNotFound
+		})
+		env.usersRepo.SetPendingEmailMock.Set(func(_ context.Context, _ string, email *string) error {
+			user.PendingEmail = email
+			return nil
+		})
+		env.usersRepo.ApplyPendingEmailMock.Optional().Set(func(context.Context, string) error {
+			user.Email, user.PendingEmail = *user.PendingEmail, nil
+			return nil
+		})
+		return s, env, user
+	}
+	changeEmail := func(s *AuthService, email string) error {
+		return s.ChangeEmail(ctx, dto.ChangeEmailRequest{
+			UserID:          testUserID,
+			CurrentPassword: testPassword,
+			NewEmail:        email,
+		})
+	}
+
+	t.Run("email меняется только после подтверждения нового адреса", func(t *testing.T) {
+		s, env, user := setup(t)
+		require.NoError(t, s.RequestPasswordReset(ctx, testEmail))
+		resetToken := env.lastMailToken(t)
+
+		require.NoError(t, changeEmail(s, newEmail))
+
+		assert.Equal(t, testEmail, user.Email)
+		assert.Equal(t, models.AuditEventEmailChangeRequested, env.auditLog.events[0].Type)
+		// Уведомление на прежний адрес
+		notification := env.mailSender.messages[len(env.mailSender.messages)-1]
+		assert.Equal(t, testEmail, notification.To)
+		assert.Contains(t, notification.Body, newEmail)
+
+		require.NoError(t, s.ConfirmEmail(ctx, env.lastMailTokenTo(t, newEmail)))
+
+		assert.Equal(t, newEmail, user.Email)
+		assert.Nil(t, user.PendingEmail)
+		assert.Equal(t, models.AuditEventEmailChanged, env.auditLog.events[1].Type)
+		// Ссылка сброса, отправленная на прежний адрес, больше не действует
+		require.ErrorIs(t, s.ResetPassword(ctx, dto.ResetPasswordRequest{Token: resetToken, NewPassword: "newpassword123"}),
+			ErrInvalidActionToken)
+	})
+
+	t.Run("прежняя ссылка подтверждения не подтверждает новый адрес", func(t *testing.T) {
+		s, env, user := setup(t)
+		require.NoError(t, s.RequestEmailVerification(ctx, testUserID))
+		oldToken := env.lastMailToken(t)
+
+		require.NoError(t, changeEmail(s, newEmail))
+
+		require.ErrorIs(t, s.ConfirmEmail(ctx, oldToken), ErrInvalidActionToken)
+		assert.Equal(t, testEmail, user.Email)
+	})
+
+	t.Run("повторное письмо уходит на новый адрес", func(t *testing.T) {
+		s, env, user := setup(t)
+		verifiedAt := time.Now()
+		user.EmailVerifiedAt = &verifiedAt
+
+		require.NoError(t, changeEmail(s, newEmail))
+		require.NoError(t, s.RequestEmailVerification(ctx, testUserID))
+
+		assert.Equal(t, newEmail, env.mailSender.messages[len(env.mailSender.messages)-1].To)
+	})
+
+	t.Run("возврат к текущему адресу отменяет смену", func(t *testing.T) {
+		s, env, user := setup(t)
+		require.NoError(t, changeEmail(s, newEmail))
+		token := env.lastMailTokenTo(t, newEmail)
+
+		require.NoError(t, changeEmail(s, testEmail))
+
+		assert.Nil(t, user.PendingEmail)
+		require.ErrorIs(t, s.ConfirmEmail(ctx, token), ErrInvalidActionToken)
+		assert.Equal(t, testEmail, user.Email)
+	})
+
+	t.Run("адрес заняли до подтверждения", func(t *testing.T) {
+		s, env, _ := setup(t)
+		require.NoError(t, changeEmail(s, newEmail))
+		env.usersRepo.ApplyPendingEmailMock.Set(func(context.Context, string) error {
+			return models.ErrAlreadyExists
+		})
+
+		require.ErrorIs(t, s.ConfirmEmail(ctx, env.lastMailTokenTo(t, newEmail)), models.ErrAlreadyExists)
+	})
+
+	t.Run("занятый адрес", func(t *testing.T) {
+		s, env, user := setup(t)
+		env.usersRepo.GetUserByEmailMock.Set(func(context.Context, string) (*models.User, error) {
+			return &models.User{ID: "other"}, nil
+		})
+		env.usersRepo.SetPendingEmailMock.Optional()
+
+		require.ErrorIs(t, changeEmail(s, newEmail), models.ErrAlreadyExists)
+		assert.Nil(t, user.PendingEmail)
+		assert.Empty(t, env.mailSender.messages)
+	})
+}
+
+func TestAuthService_ChangePassword(t *testing.T) {
+	ctx := context.Background()
+	const newPassword = "newpassword123"
+
+	t.Run("все токены отзываются, текущее устройство получает новые", func(t *testing.T) {
+		s, env := newTestService(t, testConfig())
+		user := env.testUser(t)
+		phone := env.login(t, s, user, "phone")
+		env.login(t, s, user, "laptop")
+		env.usersRepo.GetUserByIDMock.Return(user, nil)
+		var passwordHash string
+		env.usersRepo.UpdatePasswordHashMock.Set(func(_ context.Context, _, oldHash, newHash string) (bool, error) {
+			assert.Equal(t, user.PasswordHash, oldHash)
+			passwordHash = newHash
+			return true, nil
+		})
+
+		resp, err := s.ChangePassword(ctx, dto.ChangePasswordRequest{
+			UserID:          testUserID,
+			CurrentPassword: testPassword,
+			NewPassword:     newPassword,
+			CurrentDeviceID: "phone",
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, env.hasher.Verify(passwordHash, newPassword))
+		assert.Equal(t, int64(2), resp.Revoked)
+
+		// Старый refresh токен текущего устройства тоже отозван
+		_, err = s.Refresh(ctx, dto.RefreshRequest{RefreshToken: phone.RefreshToken, DeviceID: "phone"})
+		require.ErrorIs(t, err, ErrTokenRevoked)
+
+		sessions, err := s.ListSessions(ctx, testUserID)
+		require.NoError(t, err)
+		require.Len(t, sessions, 1)
+		assert.Equal(t, "phone", sessions[0].DeviceID)
+
+		// Новый access токен выдан не раньше watermark и остается действующим
+		watermark := env.revocations.watermarks[testUserID]
+		require.NotNil(t, watermark)
+		claims, err := env.tokenManager.VerifyAccessToken(ctx, resp.Token.AccessToken)
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, claims.IssuedAt, watermark.NotBefore.Unix())
+
+		_, err = s.Refresh(ctx, dto.RefreshRequest{RefreshToken: resp.Token.RefreshToken, DeviceID: "phone"})
+		require.NoError(t, err)
+	})
+
+	t.Run("неверный текущий пароль не трогает сессии", func(t *testing.T) {
+		s, env := newTestService(t, testConfig())
+		user := env.testUser(t)
+		env.login(t, s, user, "phone")
+		env.usersRepo.GetUserByIDMock.Return(user, nil)
+
+		_, err := s.ChangePassword(ctx, dto.ChangePasswordRequest{
+			UserID:          testUserID,
+			CurrentPassword: "wrongpassword",
+			NewPassword:     newPassword,
+			CurrentDeviceID: "phone",
+		})
+		require.ErrorIs(t, err, ErrWrongPassword)
+
+		sessions, err := s.ListSessions(ctx, testUserID)
+		require.NoError(t, err)
+		assert.Len(t, sessions, 1)
+		assert.Empty(t, env.revocations.watermarks)
+	})
+
+	t.Run("пароль, смененный после проверки текущего, не перезаписывается", func(t *testing.T) {
+		s, env := newTestService(t, testConfig())
+		user := env.testUser(t)
+		env.login(t, s, user, "phone")
+		env.usersRepo.GetUserByIDMock.Return(user, nil)
+		env.usersRepo.UpdatePasswordHashMock.Return(false, nil)
+
+		_, err := s.ChangePassword(ctx, dto.ChangePasswordRequest{
+			UserID:          testUserID,
+			CurrentPassword: testPassword,
+			NewPassword:     newPassword,
+			CurrentDeviceID: "phone",
+		})
+		require.ErrorIs(t, err, ErrWrongPassword)
+		assert.Empty(t, env.revocations.watermarks)
+	})
+}
+
+func TestAuthService_PasswordChangeKeepsConfirmedEmail(t *testing.T) {
+	ctx := context.Background()
+	const (
+		newEmail    = "new@example.com"
+		newPassword = "newpassword123"
+	)
+
+	// setup - пользователь в памяти, хеш пароля меняется отдельно от остальных полей
+	setup := func(t *testing.T) (*AuthService, *testEnv, *models.User) {
+		s, env := newTestService(t, testConfig())
+		user := env.testUser(t)
+		env.login(t, s, user, "phone")
+		env.usersRepo.UpdatePasswordHashMock.Optional().Set(func(_ context.Context, _, oldHash, newHash string) (bool, error) {
+			if user.PasswordHash != oldHash {
+				return false, nil
+			}
+			user.PasswordHash = newHash
+			return true, nil
+		})
+		env.usersRepo.SetPasswordHashMock.Optional().Set(func(_ context.Context, _, hash string) error {
+			user.PasswordHash = hash
+			return nil
+		})
+		return s, env, user
+	}
+
+	t.Run("ChangePassword", func(t *testing.T) {
+		s, env, user := setup(t)
+		// ConfirmEmail применяет новый адрес сразу после того, как смена пароля прочитала пользователя
+		env.usersRepo.GetUserByIDMock.Set(func(context.Context, string) (*models.User, error) {
+			snapshot := *user
+			user.Email = newEmail
+			return &snapshot, nil
+		})
+
+		_, err := s.ChangePassword(ctx, dto.ChangePasswordRequest{
+			UserID:          testUserID,
+			CurrentPassword: testPassword,
+			NewPassword:     newPassword,
+			CurrentDeviceID: "phone",
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, newEmail, user.Email)
+		require.NoError(t, env.hasher.Verify(user.PasswordHash, newPassword))
+	})
+
+	t.Run("ResetPassword", func(t *testing.T) {
+		s, env, user := setup(t)
+		env.usersRepo.GetUserByEmailMock.Return(user, nil)
+		env.usersRepo.MarkEmailVerifiedMock.Return(nil)
+		require.NoError(t, s.RequestPasswordReset(ctx, testEmail))
+		// Новый адрес подтвержден, пока письмо сброса шло на прежний
+		user.Email = newEmail
+
+		require.NoError(t, s.ResetPassword(ctx, dto.ResetPasswordRequest{Token: env.lastMailToken(t), NewPassword: newPassword}))
+
+		assert.Equal(t, newEmail, user.Email)
+		require.NoError(t, env.hasher.Verify(user.PasswordHash, newPassword))
+	})
+}
@@ -1,14 +1,18 @@
 package dto
 
+import "auth/internal/app/models"
+
 type RegisterRequest struct {
 	Email    string
 	Password string
+	Nickname string
 }
 
 type LoginRequest struct {
 	Email    string
 	Password string
 	DeviceID string
+	ClientIP string // адрес клиента для учета неудачных попыток, может быть пустым
 }
 
 type RefreshRequest struct {
@@ -18,6 +22,46 @@ type RefreshRequest struct {
 
 type LogoutRequest struct {
 	RefreshToken string
+	AccessToken  string // необязательный, отзывается вместе с refresh токеном
+}
+
+type RevokeSessionRequest struct {
+	UserID   string
+	DeviceID string
+}
+
+type RevokeAllOtherSessionsRequest struct {
+	UserID          string
+	CurrentDeviceID string
+}
+
+type ResetPasswordRequest struct {
+	Token       string
+	NewPassword string
+}
+
+type ChangePasswordRequest struct {
+	UserID          string
+	CurrentPassword string
+	NewPassword     string
+	CurrentDeviceID string // для этого устройства выдаются новые токены
+}
+
+type ChangePasswordResponse struct {
+	Token   *models.UserToken // токены новой сессии текущего устройства
+	Revoked int64             // количество отозванных refresh токенов
+}
+
+type ChangeEmailRequest struct {
+	UserID          string
+	CurrentPassword string
+	NewEmail        string
+}
+
+// RevocationsResponse - отозванные access токены для валидаторов сервисов
+type RevocationsResponse struct {
+	Tokens     []*models.RevokedAccessToken
+	Watermarks []*models.AccessTokenWatermark
 }
 
 // JWKSResponse - формат ответа JWKS endpoint
@@ -25,12 +69,15 @@ type JWKSResponse struct {
 	Keys []JWK `json:"keys"`
 }
 
-// JWK - JSON Web Key в формате RSA
+// JWK - JSON Web Key (RSA, EC или OKP)
 type JWK struct {
-	KTY string `json:"kty"` // Key Type (RSA)
-	Use string `json:"use"` // Public Key Use (sig)
-	KID string `json:"kid"` // Key ID
-	Alg string `json:"alg"` // Algorithm (RS256)
-	N   string `json:"n"`   // Modulus (base64url)
-	E   string `json:"e"`   // Exponent (base64url)
+	KTY string `json:"kty"`           // Key Type (RSA, EC, OKP)
+	Use string `json:"use"`           // Public Key Use (sig)
+	KID string `json:"kid"`           // Key ID
+	Alg string `json:"alg"`           // Algorithm (RS256, ES256, EdDSA)
+	N   string `json:"n,omitempty"`   // Modulus (base64url) - RSA
+	E   string `json:"e,omitempty"`   // Exponent (base64url) - RSA
+	X   string `json:"x,omitempty"`   // X координата (base64url) - EC, публичный ключ - OKP
+	Y   string `json:"y,omitempty"`   // Y координата (base64url) - EC
+	Crv string `json:"crv,omitempty"` // Кривая (P-256, Ed25519) - EC, OKP
 }
@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sskorolev/balun_microservices/lib/logger"
+
+	"auth/internal/app/mail"
+	"auth/internal/app/models"
+)
+
+const (
+	apiRequestEmailVerification = "[AuthService][RequestEmailVerification]"
+	apiConfirmEmail             = "[AuthService][ConfirmEmail]"
+)
+
+func (s *AuthService) RequestEmailVerification(ctx context.Context, userID string) error {
+	user, err := s.usersRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("%s: userRepo GetUserByID error: %w", apiRequestEmailVerification, err)
+	}
+
+	if user.EmailVerifiedAt != nil && user.PendingEmail == nil {
+		return ErrEmailAlreadyVerified
+	}
+
+	if err := s.sendEmailVerification(ctx, user); err != nil {
+		return fmt.Errorf("%s: %w", apiRequestEmailVerification, err)
+	}
+
+	return nil
+}
+
+func (s *AuthService) ConfirmEmail(ctx context.Context, token string) error {
+	err := s.txManager.RunReadCommitted(ctx, func(txCtx context.Context) error {
+		actionToken, err := s.useActionToken(txCtx, models.ActionTokenEmailVerification, token)
+		if err != nil {
+			return err
+		}
+
+		user, err := s.usersRepo.GetUserByID(txCtx, actionToken.UserID)
+		if err != nil {
+			return err
+		}
+		if user.PendingEmail == nil {
+			return s.usersRepo.MarkEmailVerified(txCtx, user.ID)
+		}
+
+		// Ссылка пришла на новый адрес - он заменяет прежний
+		oldEmail, newEmail := user.Email, *user.PendingEmail
+		if err := s.usersRepo.ApplyPendingEmail(txCtx, user.ID); err != nil {
+			return err
+		}
+		// Ссылки сброса пароля, отправленные на прежний адрес, больше не действуют
+		if err := s.actionTokensRepo.InvalidateActionTokens(txCtx, user.ID, models.ActionTokenPasswordReset); err != nil {
+			return err
+		}
+
+		return s.auditLogRepo.SaveAuditEvent(txCtx, &models.AuditEvent{
+			UserID: &user.ID,
+			Type:   models.AuditEventEmailChanged,
+			Details: map[string]any{
+				"old_email": oldEmail,
+				"new_email": newEmail,
+			},
+			CreatedAt: time.Now(),
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", apiConfirmEmail, err)
+	}
+
+	return nil
+}
+
+// sendEmailVerification выдает токен подтверждения email и отправляет ссылку на него.
+// Если запрошена смена email, ссылка отправляется на новый адрес
+func (s *AuthService) sendEmailVerification(ctx context.Context, user *models.User) error {
+	to := user.Email
+	if user.PendingEmail != nil {
+		to = *user.PendingEmail
+	}
+
+	token, err := s.issueActionToken(ctx, user.ID, models.ActionTokenEmailVerification, s.cfg.EmailVerification.TTL)
+	if err != nil {
+		return fmt.Errorf("failed to issue verification token: %w", err)
+	}
+
+	err = s.mailSender.Send(ctx, mail.Message{
+		To:      to,
+		Subject: "Подтверждение email",
+		Body: fmt.Sprintf("Чтобы подтвердить email, перейдите по ссылке:\n%s\n\nСсылка действует %s.\n",
+			actionLink(s.cfg.EmailVerification.URL, token), s.cfg.EmailVerification.TTL),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send verification mail: %w", err)
+	}
+
+	logger.InfoKV(ctx, "email verification sent", "user_id", user.ID)
+	return nil
+}
@@ -2,6 +2,10 @@ package usecase
 
 import (
 	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
 	"crypto/rsa"
 	"encoding/base64"
 	"fmt"
@@ -25,13 +29,13 @@ func (s *AuthService) GetJWKS(ctx context.Context) (*dto.JWKSResponse, error) {
 
 	for _, key := range keys {
 		// Декодируем публичный ключ из PEM
-		publicKey, err := keystore.DecodePublicKeyFromPEM(key.PublicKeyPEM)
+		publicKey, err := keystore.DecodeVerificationKeyFromPEM(key.PublicKeyPEM)
 		if err != nil {
 			return nil, fmt.Errorf("%s: failed to decode public key: %w", apiGetJWKS, err)
 		}
 
-		// Конвертируем RSA public key в JWK формат
-		jwk, err := rsaPublicKeyToJWK(key.KID, publicKey)
+		// Конвертируем public key в JWK формат по его типу
+		jwk, err := publicKeyToJWK(key.KID, publicKey)
 		if err != nil {
 			return nil, fmt.Errorf("%s: failed to convert key to JWK: %w", apiGetJWKS, err)
 		}
@@ -42,6 +46,51 @@ func (s *AuthService) GetJWKS(ctx context.Context) (*dto.JWKSResponse, error) {
 	return &dto.JWKSResponse{Keys: jwks}, nil
 }
 
+// publicKeyToJWK конвертирует public key в JWK формат (RFC 7517, 7518, 8037)
+func publicKeyToJWK(kid string, publicKey crypto.PublicKey) (dto.JWK, error) {
+	switch key := publicKey.(type) {
+	case *rsa.PublicKey:
+		return rsaPublicKeyToJWK(kid, key)
+	case *ecdsa.PublicKey:
+		return ecdsaPublicKeyToJWK(kid, key)
+	case ed25519.PublicKey:
+		return dto.JWK{
+			KTY: "OKP",
+			Use: "sig",
+			KID: kid,
+			Alg: string(keystore.KeyAlgorithmEdDSA),
+			Crv: "Ed25519",
+			X:   base64.RawURLEncoding.EncodeToString(key),
+		}, nil
+	default:
+		return dto.JWK{}, fmt.Errorf("unsupported public key type %T", publicKey)
+	}
+}
+
+// ecdsaPublicKeyToJWK конвертирует ECDSA P-256 public key в JWK формат
+func ecdsaPublicKeyToJWK(kid string, publicKey *ecdsa.PublicKey) (dto.JWK, error) {
+	if publicKey.Curve != elliptic.P256() {
+		return dto.JWK{}, fmt.Errorf("unsupported curve %s", publicKey.Curve.Params().Name)
+	}
+
+	// Несжатая точка: 0x04 || X || Y, координаты фиксированной длины 32 байта
+	point, err := publicKey.Bytes()
+	if err != nil {
+		return dto.JWK{}, err
+	}
+	size := (len(point) - 1) / 2
+
+	return dto.JWK{
+		KTY: "EC",
+		Use: "sig",
+		KID: kid,
+		Alg: string(keystore.KeyAlgorithmES256),
+		Crv: "P-256",
+		X:   base64.RawURLEncoding.EncodeToString(point[1 : 1+size]),
+		Y:   base64.RawURLEncoding.EncodeToString(point[1+size:]),
+	}, nil
+}
+
 // rsaPublicKeyToJWK конвертирует RSA public key в JWK формат
 func rsaPublicKeyToJWK(kid string, publicKey *rsa.PublicKey) (dto.JWK, error) {
 	// Modulus (n) - base64url encoding
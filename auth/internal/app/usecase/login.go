@@ -2,9 +2,12 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/sskorolev/balun_microservices/lib/logger"
+
 	"auth/internal/app/crypto"
 	"auth/internal/app/models"
 	"auth/internal/app/usecase/dto"
@@ -15,30 +18,53 @@ const (
 )
 
 func (s *AuthService) Login(ctx context.Context, req dto.LoginRequest) (*models.User, error) {
+	// Проверяем, не заблокирован ли вход по email или IP клиента
+	attemptKeys := loginAttemptKeys(req)
+	if err := s.checkLoginAllowed(ctx, attemptKeys); err != nil {
+		return nil, err
+	}
+
 	// Получаем пользователя по email
 	user, err := s.usersRepo.GetUserByEmail(ctx, req.Email)
-	if err != nil {
+	if err != nil && !errors.Is(err, models.ErrNotFound) {
 		return nil, fmt.Errorf("%s: userRepo GetUserByEmail error: %w", apiLogin, err)
 	}
-	if user == nil {
+	// Пользователь с незавершенной регистрацией еще не может входить
+	if user == nil || user.RegistrationStatus == models.RegistrationStatusPending {
+		// Перебор email считается так же, как перебор паролей
+		s.registerFailedLogin(ctx, attemptKeys)
 		return nil, models.ErrNotFound
 	}
 
 	// Проверяем пароль
 	if err := s.passwordHasher.Verify(user.PasswordHash, req.Password); err != nil {
+		s.registerFailedLogin(ctx, attemptKeys)
 		return nil, ErrWrongPassword
 	}
+	s.resetLoginAttempts(ctx, attemptKeys)
+	s.rehashPassword(ctx, user, req.Password)
+
+	token, err := s.issueTokens(ctx, user.ID, req.DeviceID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", apiLogin, err)
+	}
+	user.Token = token
+
+	return user, nil
+}
 
+// issueTokens выдает access токен и refresh токен новой сессии устройства, refresh токен сохраняется в БД
+func (s *AuthService) issueTokens(ctx context.Context, userID, deviceID string) (*models.UserToken, error) {
 	// Создаем access token
-	accessToken, err := s.tokenManager.CreateAccessToken(ctx, user.ID)
+	accessToken, err := s.tokenManager.CreateAccessToken(ctx, userID)
 	if err != nil {
-		return nil, fmt.Errorf("%s: failed to create access token: %w", apiLogin, err)
+		return nil, fmt.Errorf("failed to create access token: %w", err)
 	}
 
 	// Создаем refresh token
-	refreshToken, jti, err := s.tokenManager.CreateRefreshToken(ctx, user.ID, req.DeviceID)
+	refreshToken, jti, err := s.tokenManager.CreateRefreshToken(ctx, userID, deviceID)
 	if err != nil {
-		return nil, fmt.Errorf("%s: failed to create refresh token: %w", apiLogin, err)
+		return nil, fmt.Errorf("failed to create refresh token: %w", err)
 	}
 
 	// Хешируем refresh token для хранения в БД
@@ -46,29 +72,58 @@ func (s *AuthService) Login(ctx context.Context, req dto.LoginRequest) (*models.
 
 	// Сохраняем refresh token в БД
 	var deviceIDPtr *string
-	if req.DeviceID != "" {
-		deviceIDPtr = &req.DeviceID
+	if deviceID != "" {
+		deviceIDPtr = &deviceID
 	}
 
+	now := time.Now()
 	refreshTokenModel := &models.RefreshToken{
-		UserID:    user.ID,
-		TokenHash: tokenHash,
-		JTI:       jti,
-		DeviceID:  deviceIDPtr,
-		ExpiresAt: time.Now().Add(s.cfg.RefreshTokenTTL),
-		CreatedAt: time.Now(),
+		UserID:           userID,
+		TokenHash:        tokenHash,
+		JTI:              jti,
+		DeviceID:         deviceIDPtr,
+		ExpiresAt:        now.Add(s.cfg.RefreshTokenTTL),
+		SessionStartedAt: now,
+		CreatedAt:        now,
 	}
 
 	if err := s.refreshTokensRepo.CreateToken(ctx, refreshTokenModel); err != nil {
-		return nil, fmt.Errorf("%s: failed to save refresh token: %w", apiLogin, err)
+		return nil, fmt.Errorf("failed to save refresh token: %w", err)
 	}
 
-	// Заполняем токены в пользователя
-	user.Token = &models.UserToken{
+	return &models.UserToken{
 		AccessToken:    accessToken,
 		RefreshToken:   refreshToken,
 		TokenExpiresAt: time.Now().Add(s.cfg.AccessTokenTTL),
+	}, nil
+}
+
+// rehashPassword пересчитывает хеш после успешного входа, если он сделан старым алгоритмом
+// или с другими параметрами. Ошибка не мешает входу: хеш пересчитается при следующем входе
+func (s *AuthService) rehashPassword(ctx context.Context, user *models.User, password string) {
+	if !s.passwordHasher.NeedsRehash(user.PasswordHash) {
+		return
 	}
 
-	return user, nil
+	passwordHash, err := s.passwordHasher.Hash(password)
+	if err != nil {
+		// Например, пароль короче нового минимума - он сменится только через ChangePassword
+		logger.WarnKV(ctx, "login: failed to rehash password", "user_id", user.ID, "error", err.Error())
+		return
+	}
+
+	// Обновляется только хеш и только если пароль не сменили параллельно,
+	// иначе пересчитанный старый пароль затер бы новый
+	updated, err := s.usersRepo.UpdatePasswordHash(ctx, user.ID, user.PasswordHash, passwordHash)
+	if err != nil {
+		logger.WarnKV(ctx, "login: failed to save rehashed password", "user_id", user.ID, "error", err.Error())
+		return
+	}
+	if !updated {
+		logger.InfoKV(ctx, "login: password changed concurrently, rehash skipped", "user_id", user.ID)
+		return
+	}
+	user.PasswordHash = passwordHash
+
+	logger.InfoKV(ctx, "login: password rehashed", "user_id", user.ID)
 }
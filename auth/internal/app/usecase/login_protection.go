@@ -0,0 +1,139 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sskorolev/balun_microservices/lib/logger"
+
+	"auth/internal/app/models"
+	"auth/internal/app/usecase/dto"
+)
+
+const (
+	apiLoginProtection = "[AuthService][LoginProtection]"
+)
+
+// loginAttemptKeys ключи, по которым считаются неудачные попытки входа
+func loginAttemptKeys(req dto.LoginRequest) []models.LoginAttemptKey {
+	keys := emailAttemptKeys(req.Email)
+	if req.ClientIP != "" {
+		keys = append(keys, models.LoginAttemptKey{Scope: models.LoginAttemptScopeIP, Key: req.ClientIP})
+	}
+	return keys
+}
+
+// emailAttemptKeys ключ счетчика неудачных попыток по email
+func emailAttemptKeys(email string) []models.LoginAttemptKey {
+	return []models.LoginAttemptKey{
+		{Scope: models.LoginAttemptScopeEmail, Key: strings.ToLower(strings.TrimSpace(email))},
+	}
+}
+
+// checkLoginAllowed возвращает *LoginLockedError, если по одному из ключей действует задержка или блокировка
+func (s *AuthService) checkLoginAllowed(ctx context.Context, keys []models.LoginAttemptKey) error {
+	if !s.cfg.LoginProtection.Enabled {
+		return nil
+	}
+
+	locks, err := s.loginAttemptsRepo.ListLoginLocks(ctx, keys)
+	if err != nil {
+		return fmt.Errorf("%s: failed to list login locks: %w", apiLoginProtection, err)
+	}
+	if len(locks) == 0 {
+		return nil
+	}
+
+	// Ждать нужно до снятия самой долгой из блокировок
+	var lock *models.LoginAttempt
+	for _, l := range locks {
+		if lock == nil || l.LockedUntil.After(*lock.LockedUntil) {
+			lock = l
+		}
+	}
+	s.loginMetrics.IncRejected(lock.Scope)
+
+	return &LoginLockedError{RetryAfter: time.Until(*lock.LockedUntil)}
+}
+
+// registerFailedLogin учитывает неудачную попытку и назначает задержку или блокировку
+//
+// Ошибки хранилища только логируются: ответ пользователю остается ошибкой входа.
+func (s *AuthService) registerFailedLogin(ctx context.Context, keys []models.LoginAttemptKey) {
+	cfg := s.cfg.LoginProtection
+	if !cfg.Enabled {
+		return
+	}
+
+	now := time.Now()
+	for _, key := range keys {
+		failedCount, err := s.loginAttemptsRepo.RegisterFailedLogin(ctx, key, now.Add(-cfg.Window))
+		if err != nil {
+			logger.ErrorKV(ctx, "failed to register failed login", "scope", key.Scope, "error", err.Error())
+			continue
+		}
+
+		delay, lockout := cfg.lockDuration(key.Scope, failedCount)
+		if delay == 0 {
+			continue
+		}
+
+		if err := s.loginAttemptsRepo.LockLogin(ctx, key, now.Add(delay)); err != nil {
+			logger.ErrorKV(ctx, "failed to lock login", "scope", key.Scope, "error", err.Error())
+			continue
+		}
+
+		if lockout {
+			s.loginMetrics.IncLockout(key.Scope)
+			logger.WarnKV(ctx, "login locked after failed attempts",
+				"scope", key.Scope,
+				"failed_count", failedCount,
+				"locked_for", delay.String(),
+			)
+		}
+	}
+}
+
+// resetLoginAttempts сбрасывает счетчик по email после успешного входа.
+// Счетчик по IP не сбрасывается, чтобы успешный вход в свой аккаунт не открывал перебор чужих
+func (s *AuthService) resetLoginAttempts(ctx context.Context, keys []models.LoginAttemptKey) {
+	if !s.cfg.LoginProtection.Enabled {
+		return
+	}
+
+	for _, key := range keys {
+		if key.Scope != models.LoginAttemptScopeEmail {
+			continue
+		}
+		if err := s.loginAttemptsRepo.ResetLoginAttempts(ctx, key); err != nil {
+			logger.ErrorKV(ctx, "failed to reset login attempts", "scope", key.Scope, "error", err.Error())
+		}
+	}
+}
+
+// lockDuration задержка после failedCount неудачных попыток подряд и признак блокировки
+func (c LoginProtectionConfig) lockDuration(scope models.LoginAttemptScope, failedCount int) (time.Duration, bool) {
+	threshold := c.EmailLockoutThreshold
+	if scope == models.LoginAttemptScopeIP {
+		threshold = c.IPLockoutThreshold
+	}
+	if threshold > 0 && failedCount >= threshold {
+		return c.LockoutDuration, true
+	}
+
+	if failedCount <= c.FreeAttempts || c.BaseDelay <= 0 {
+		return 0, false
+	}
+
+	delay := c.BaseDelay
+	for i := c.FreeAttempts + 1; i < failedCount && delay < c.MaxDelay; i++ {
+		delay *= 2
+	}
+	if c.MaxDelay > 0 && delay > c.MaxDelay {
+		delay = c.MaxDelay
+	}
+
+	return delay, false
+}
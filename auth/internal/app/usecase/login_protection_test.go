@@ -0,0 +1,172 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"auth/internal/app/models"
+	"auth/internal/app/usecase/dto"
+)
+
+func TestLoginProtectionConfig_lockDuration(t *testing.T) {
+	cfg := LoginProtectionConfig{
+		FreeAttempts:          3,
+		BaseDelay:             time.Second,
+		MaxDelay:              8 * time.Second,
+		EmailLockoutThreshold: 10,
+		IPLockoutThreshold:    20,
+		LockoutDuration:       15 * time.Minute,
+	}
+
+	tests := []struct {
+		name        string
+		scope       models.LoginAttemptScope
+		failedCount int
+		wantDelay   time.Duration
+		wantLockout bool
+	}{
+		{name: "первая попытка без задержки", scope: models.LoginAttemptScopeEmail, failedCount: 1},
+		{name: "последняя бесплатная попытка", scope: models.LoginAttemptScopeEmail, failedCount: 3},
+		{name: "первая задержка", scope: models.LoginAttemptScopeEmail, failedCount: 4, wantDelay: time.Second},
+		{name: "задержка удваивается", scope: models.LoginAttemptScopeEmail, failedCount: 5, wantDelay: 2 * time.Second},
+		{name: "задержка удваивается дальше", scope: models.LoginAttemptScopeEmail, failedCount: 6, wantDelay: 4 * time.Second},
+		{name: "задержка достигает максимума", scope: models.LoginAttemptScopeEmail, failedCount: 7, wantDelay: 8 * time.Second},
+		{name: "задержка не превышает максимум", scope: models.LoginAttemptScopeEmail, failedCount: 9, wantDelay: 8 * time.Second},
+		{
+			name:        "блокировка email по порогу",
+			scope:       models.LoginAttemptScopeEmail,
+			failedCount: 10,
+			wantDelay:   15 * time.Minute,
+			wantLockout: true,
+		},
+		{name: "у IP свой порог блокировки", scope: models.LoginAttemptScopeIP, failedCount: 10, wantDelay: 8 * time.Second},
+		{
+			name:        "блокировка IP по порогу",
+			scope:       models.LoginAttemptScopeIP,
+			failedCount: 20,
+			wantDelay:   15 * time.Minute,
+			wantLockout: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delay, lockout := cfg.lockDuration(tt.scope, tt.failedCount)
+			assert.Equal(t, tt.wantDelay, delay)
+			assert.Equal(t, tt.wantLockout, lockout)
+		})
+	}
+
+	t.Run("без базовой задержки только блокировка", func(t *testing.T) {
+		cfg := cfg
+		cfg.BaseDelay = 0
+
+		delay, lockout := cfg.lockDuration(models.LoginAttemptScopeEmail, 5)
+		assert.Zero(t, delay)
+		assert.False(t, lockout)
+	})
+}
+
+func TestAuthService_LoginProtection(t *testing.T) {
+	ctx := context.Background()
+
+	cfg := testConfig()
+	cfg.LoginProtection = LoginProtectionConfig{
+		Enabled:               true,
+		Window:                15 * time.Minute,
+		FreeAttempts:          2,
+		BaseDelay:             time.Minute,
+		MaxDelay:              time.Minute,
+		EmailLockoutThreshold: 3,
+		IPLockoutThreshold:    5,
+		LockoutDuration:       time.Hour,
+	}
+
+	// setup - пользователь с любым email и паролем testPassword
+	setup := func(t *testing.T) (*AuthService, *testEnv) {
+		s, env := newTestService(t, cfg)
+		user := env.testUser(t)
+		env.usersRepo.GetUserByEmailMock.Set(func(_ context.Context, email string) (*models.User, error) {
+			found := *user
+			found.Email = email
+			return &found, nil
+		})
+		return s, env
+	}
+	login := func(s *AuthService, email, password, clientIP string) error {
+		_, err := s.Login(ctx, dto.LoginRequest{Email: email, Password: password, ClientIP: clientIP})
+		return err
+	}
+	requireLocked := func(t *testing.T, err error) {
+		var lockedErr *LoginLockedError
+		require.ErrorAs(t, err, &lockedErr)
+		assert.ErrorIs(t, err, ErrLoginLocked)
+		assert.Positive(t, lockedErr.RetryAfter)
+	}
+
+	t.Run("email блокируется независимо от IP", func(t *testing.T) {
+		s, env := setup(t)
+
+		for _, ip := range []string{"10.0.0.1", "10.0.0.2", "10.0.0.3"} {
+			require.ErrorIs(t, login(s, testEmail, "wrongpassword", ip), ErrWrongPassword)
+		}
+
+		requireLocked(t, login(s, testEmail, testPassword, "10.0.0.4"))
+		assert.Equal(t, 1, env.loginMetrics.lockouts)
+		assert.Equal(t, 1, env.loginMetrics.rejected)
+	})
+
+	t.Run("email в разном регистре считается одним ключом", func(t *testing.T) {
+		s, _ := setup(t)
+
+		for _, email := range []string{"Test@Example.com", " test@example.com", "TEST@EXAMPLE.COM"} {
+			require.ErrorIs(t, login(s, email, "wrongpassword", ""), ErrWrongPassword)
+		}
+
+		requireLocked(t, login(s, testEmail, testPassword, ""))
+	})
+
+	t.Run("перебор разных email с одного IP задерживает IP", func(t *testing.T) {
+		s, _ := setup(t)
+
+		for _, email := range []string{"a@example.com", "b@example.com", "c@example.com"} {
+			require.ErrorIs(t, login(s, email, "wrongpassword", "10.0.0.1"), ErrWrongPassword)
+		}
+
+		requireLocked(t, login(s, "d@example.com", testPassword, "10.0.0.1"))
+		// Для другого IP задержки нет
+		require.NoError(t, login(s, "d@example.com", testPassword, "10.0.0.2"))
+	})
+
+	t.Run("успешный вход сбрасывает счетчик email, но не IP", func(t *testing.T) {
+		s, env := setup(t)
+
+		for range 2 {
+			require.ErrorIs(t, login(s, testEmail, "wrongpassword", "10.0.0.1"), ErrWrongPassword)
+		}
+		require.NoError(t, login(s, testEmail, testPassword, "10.0.0.1"))
+
+		assert.NotContains(t, env.loginAttempts.attempts,
+			models.LoginAttemptKey{Scope: models.LoginAttemptScopeEmail, Key: testEmail})
+		ipAttempt := env.loginAttempts.attempts[models.LoginAttemptKey{Scope: models.LoginAttemptScopeIP, Key: "10.0.0.1"}]
+		require.NotNil(t, ipAttempt)
+		assert.Equal(t, 2, ipAttempt.FailedCount)
+	})
+
+	t.Run("попытки вне окна не накапливаются", func(t *testing.T) {
+		s, env := setup(t)
+
+		key := models.LoginAttemptKey{Scope: models.LoginAttemptScopeEmail, Key: testEmail}
+		for range 2 {
+			require.ErrorIs(t, login(s, testEmail, "wrongpassword", ""), ErrWrongPassword)
+			env.loginAttempts.attempts[key].LastFailedAt = time.Now().Add(-time.Hour)
+		}
+		require.ErrorIs(t, login(s, testEmail, "wrongpassword", ""), ErrWrongPassword)
+
+		assert.Equal(t, 1, env.loginAttempts.attempts[key].FailedCount)
+	})
+}
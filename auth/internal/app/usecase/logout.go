@@ -3,7 +3,9 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"auth/internal/app/models"
 	"auth/internal/app/usecase/dto"
 )
 
@@ -23,5 +25,23 @@ func (s *AuthService) Logout(ctx context.Context, req dto.LogoutRequest) error {
 		return fmt.Errorf("%s: failed to revoke token: %w", apiLogout, err)
 	}
 
+	if req.AccessToken == "" {
+		return nil
+	}
+
+	// Отзываем текущий access token. Истекший или чужой токен отзывать не нужно
+	accessClaims, err := s.tokenManager.VerifyAccessToken(ctx, req.AccessToken)
+	if err != nil || accessClaims.Subject != claims.Subject || accessClaims.JWTID == "" {
+		return nil
+	}
+
+	if err := s.revocationsRepo.RevokeAccessToken(ctx, &models.RevokedAccessToken{
+		JTI:       accessClaims.JWTID,
+		UserID:    accessClaims.Subject,
+		ExpiresAt: time.Unix(accessClaims.ExpiresAt, 0),
+	}); err != nil {
+		return fmt.Errorf("%s: failed to revoke access token: %w", apiLogout, err)
+	}
+
 	return nil
 }
@@ -18,6 +18,48 @@ type UsecaseMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
+	funcChangeEmail          func(ctx context.Context, req dto.ChangeEmailRequest) (err error)
+	funcChangeEmailOrigin    string
+	inspectFuncChangeEmail   func(ctx context.Context, req dto.ChangeEmailRequest)
+	afterChangeEmailCounter  uint64
+	beforeChangeEmailCounter uint64
+	ChangeEmailMock          mUsecaseMockChangeEmail
+
+	funcChangePassword          func(ctx context.Context, req dto.ChangePasswordRequest) (cp1 *dto.ChangePasswordResponse, err error)
+	funcChangePasswordOrigin    string
+	inspectFuncChangePassword   func(ctx context.Context, req dto.ChangePasswordRequest)
+	afterChangePasswordCounter  uint64
+	beforeChangePasswordCounter uint64
+	ChangePasswordMock          mUsecaseMockChangePassword
+
+	funcConfirmEmail          func(ctx context.Context, token string) (err error)
+	funcConfirmEmailOrigin    string
+	inspectFuncConfirmEmail   func(ctx context.Context, token string)
+	afterConfirmEmailCounter  uint64
+	beforeConfirmEmailCounter uint64
+	ConfirmEmailMock          mUsecaseMockConfirmEmail
+
+	funcGetJWKS          func(ctx context.Context) (jp1 *dto.JWKSResponse, err error)
+	funcGetJWKSOrigin    string
+	inspectFuncGetJWKS   func(ctx context.Context)
+	afterGetJWKSCounter  uint64
+	beforeGetJWKSCounter uint64
+	GetJWKSMock          mUsecaseMockGetJWKS
+
+	funcGetRevocations          func(ctx context.Context) (rp1 *dto.RevocationsResponse, err error)
+	funcGetRevocationsOrigin    string
+	inspectFuncGetRevocations   func(ctx context.Context)
+	afterGetRevocationsCounter  uint64
+	beforeGetRevocationsCounter uint64
+	GetRevocationsMock          mUsecaseMockGetRevocations
+
+	funcListSessions          func(ctx context.Context, userID string) (spa1 []*models.Session, err error)
+	funcListSessionsOrigin    string
+	inspectFuncListSessions   func(ctx context.Context, userID string)
+	afterListSessionsCounter  uint64
+	beforeListSessionsCounter uint64
+	ListSessionsMock          mUsecaseMockListSessions
+
 	funcLogin          func(ctx context.Context, req dto.LoginRequest) (up1 *models.User, err error)
 	funcLoginOrigin    string
 	inspectFuncLogin   func(ctx context.Context, req dto.LoginRequest)
@@ -25,6 +67,13 @@ type UsecaseMock struct {
 	beforeLoginCounter uint64
 	LoginMock          mUsecaseMockLogin
 
+	funcLogout          func(ctx context.Context, req dto.LogoutRequest) (err error)
+	funcLogoutOrigin    string
+	inspectFuncLogout   func(ctx context.Context, req dto.LogoutRequest)
+	afterLogoutCounter  uint64
+	beforeLogoutCounter uint64
+	LogoutMock          mUsecaseMockLogout
+
 	funcRefresh          func(ctx context.Context, req dto.RefreshRequest) (up1 *models.User, err error)
 	funcRefreshOrigin    string
 	inspectFuncRefresh   func(ctx context.Context, req dto.RefreshRequest)
@@ -38,6 +87,41 @@ type UsecaseMock struct {
 	afterRegisterCounter  uint64
 	beforeRegisterCounter uint64
 	RegisterMock          mUsecaseMockRegister
+
+	funcRequestEmailVerification          func(ctx context.Context, userID string) (err error)
+	funcRequestEmailVerificationOrigin    string
+	inspectFuncRequestEmailVerification   func(ctx context.Context, userID string)
+	afterRequestEmailVerificationCounter  uint64
+	beforeRequestEmailVerificationCounter uint64
+	RequestEmailVerificationMock          mUsecaseMockRequestEmailVerification
+
+	funcRequestPasswordReset          func(ctx context.Context, email string) (err error)
+	funcRequestPasswordResetOrigin    string
+	inspectFuncRequestPasswordReset   func(ctx context.Context, email string)
+	afterRequestPasswordResetCounter  uint64
+	beforeRequestPasswordResetCounter uint64
+	RequestPasswordResetMock          mUsecaseMockRequestPasswordReset
+
+	funcResetPassword          func(ctx context.Context, req dto.ResetPasswordRequest) (err error)
+	funcResetPasswordOrigin    string
+	inspectFuncResetPassword   func(ctx context.Context, req dto.ResetPasswordRequest)
+	afterResetPasswordCounter  uint64
+	beforeResetPasswordCounter uint64
+	ResetPasswordMock          mUsecaseMockResetPassword
+
+	funcRevokeAllOtherSessions          func(ctx context.Context, req dto.RevokeAllOtherSessionsRequest) (i1 int64, err error)
+	funcRevokeAllOtherSessionsOrigin    string
+	inspectFuncRevokeAllOtherSessions   func(ctx context.Context, req dto.RevokeAllOtherSessionsRequest)
+	afterRevokeAllOtherSessionsCounter  uint64
+	beforeRevokeAllOtherSessionsCounter uint64
+	RevokeAllOtherSessionsMock          mUsecaseMockRevokeAllOtherSessions
+
+	funcRevokeSession          func(ctx context.Context, req dto.RevokeSessionRequest) (i1 int64, err error)
+	funcRevokeSessionOrigin    string
+	inspectFuncRevokeSession   func(ctx context.Context, req dto.RevokeSessionRequest)
+	afterRevokeSessionCounter  uint64
+	beforeRevokeSessionCounter uint64
+	RevokeSessionMock          mUsecaseMockRevokeSession
 }
 
 // NewUsecaseMock returns a mock for mm_usecase.Usecase
@@ -48,64 +132,99 @@ func NewUsecaseMock(t minimock.Tester) *UsecaseMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.ChangeEmailMock = mUsecaseMockChangeEmail{mock: m}
+	m.ChangeEmailMock.callArgs = []*UsecaseMockChangeEmailParams{}
+
+	m.ChangePasswordMock = mUsecaseMockChangePassword{mock: m}
+	m.ChangePasswordMock.callArgs = []*UsecaseMockChangePasswordParams{}
+
+	m.ConfirmEmailMock = mUsecaseMockConfirmEmail{mock: m}
+	m.ConfirmEmailMock.callArgs = []*UsecaseMockConfirmEmailParams{}
+
+	m.GetJWKSMock = mUsecaseMockGetJWKS{mock: m}
+	m.GetJWKSMock.callArgs = []*UsecaseMockGetJWKSParams{}
+
+	m.GetRevocationsMock = mUsecaseMockGetRevocations{mock: m}
+	m.GetRevocationsMock.callArgs = []*UsecaseMockGetRevocationsParams{}
+
+	m.ListSessionsMock = mUsecaseMockListSessions{mock: m}
+	m.ListSessionsMock.callArgs = []*UsecaseMockListSessionsParams{}
+
 	m.LoginMock = mUsecaseMockLogin{mock: m}
 	m.LoginMock.callArgs = []*UsecaseMockLoginParams{}
 
+	m.LogoutMock = mUsecaseMockLogout{mock: m}
+	m.LogoutMock.callArgs = []*UsecaseMockLogoutParams{}
+
 	m.RefreshMock = mUsecaseMockRefresh{mock: m}
 	m.RefreshMock.callArgs = []*UsecaseMockRefreshParams{}
 
 	m.RegisterMock = mUsecaseMockRegister{mock: m}
 	m.RegisterMock.callArgs = []*UsecaseMockRegisterParams{}
 
+	m.RequestEmailVerificationMock = mUsecaseMockRequestEmailVerification{mock: m}
+	m.RequestEmailVerificationMock.callArgs = []*UsecaseMockRequestEmailVerificationParams{}
+
+	m.RequestPasswordResetMock = mUsecaseMockRequestPasswordReset{mock: m}
+	m.RequestPasswordResetMock.callArgs = []*UsecaseMockRequestPasswordResetParams{}
+
+	m.ResetPasswordMock = mUsecaseMockResetPassword{mock: m}
+	m.ResetPasswordMock.callArgs = []*UsecaseMockResetPasswordParams{}
+
+	m.RevokeAllOtherSessionsMock = mUsecaseMockRevokeAllOtherSessions{mock: m}
+	m.RevokeAllOtherSessionsMock.callArgs = []*UsecaseMockRevokeAllOtherSessionsParams{}
+
+	m.RevokeSessionMock = mUsecaseMockRevokeSession{mock: m}
+	m.RevokeSessionMock.callArgs = []*UsecaseMockRevokeSessionParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
 }
 
-type mUsecaseMockLogin struct {
+type mUsecaseMockChangeEmail struct {
 	optional           bool
 	mock               *UsecaseMock
-	defaultExpectation *UsecaseMockLoginExpectation
-	expectations       []*UsecaseMockLoginExpectation
+	defaultExpectation *UsecaseMockChangeEmailExpectation
+	expectations       []*UsecaseMockChangeEmailExpectation
 
-	callArgs []*UsecaseMockLoginParams
+	callArgs []*UsecaseMockChangeEmailParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// UsecaseMockLoginExpectation specifies expectation struct of the Usecase.Login
-type UsecaseMockLoginExpectation struct {
+// UsecaseMockChangeEmailExpectation specifies expectation struct of the Usecase.ChangeEmail
+type UsecaseMockChangeEmailExpectation struct {
 	mock               *UsecaseMock
-	params             *UsecaseMockLoginParams
-	paramPtrs          *UsecaseMockLoginParamPtrs
-	expectationOrigins UsecaseMockLoginExpectationOrigins
-	results            *UsecaseMockLoginResults
+	params             *UsecaseMockChangeEmailParams
+	paramPtrs          *UsecaseMockChangeEmailParamPtrs
+	expectationOrigins UsecaseMockChangeEmailExpectationOrigins
+	results            *UsecaseMockChangeEmailResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// UsecaseMockLoginParams contains parameters of the Usecase.Login
-type UsecaseMockLoginParams struct {
+// UsecaseMockChangeEmailParams contains parameters of the Usecase.ChangeEmail
+type UsecaseMockChangeEmailParams struct {
 	ctx context.Context
-	req dto.LoginRequest
+	req dto.ChangeEmailRequest
 }
 
-// UsecaseMockLoginParamPtrs contains pointers to parameters of the Usecase.Login
-type UsecaseMockLoginParamPtrs struct {
+// UsecaseMockChangeEmailParamPtrs contains pointers to parameters of the Usecase.ChangeEmail
+type UsecaseMockChangeEmailParamPtrs struct {
 	ctx *context.Context
-	req *dto.LoginRequest
+	req *dto.ChangeEmailRequest
 }
 
-// UsecaseMockLoginResults contains results of the Usecase.Login
-type UsecaseMockLoginResults struct {
-	up1 *models.User
+// UsecaseMockChangeEmailResults contains results of the Usecase.ChangeEmail
+type UsecaseMockChangeEmailResults struct {
 	err error
 }
 
-// UsecaseMockLoginOrigins contains origins of expectations of the Usecase.Login
-type UsecaseMockLoginExpectationOrigins struct {
+// UsecaseMockChangeEmailOrigins contains origins of expectations of the Usecase.ChangeEmail
+type UsecaseMockChangeEmailExpectationOrigins struct {
 	origin    string
 	originCtx string
 	originReq string
@@ -116,339 +235,339 @@ type UsecaseMockLoginExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmLogin *mUsecaseMockLogin) Optional() *mUsecaseMockLogin {
-	mmLogin.optional = true
-	return mmLogin
+func (mmChangeEmail *mUsecaseMockChangeEmail) Optional() *mUsecaseMockChangeEmail {
+	mmChangeEmail.optional = true
+	return mmChangeEmail
 }
 
-// Expect sets up expected params for Usecase.Login
-func (mmLogin *mUsecaseMockLogin) Expect(ctx context.Context, req dto.LoginRequest) *mUsecaseMockLogin {
-	if mmLogin.mock.funcLogin != nil {
-		mmLogin.mock.t.Fatalf("UsecaseMock.Login mock is already set by Set")
+// Expect sets up expected params for Usecase.ChangeEmail
+func (mmChangeEmail *mUsecaseMockChangeEmail) Expect(ctx context.Context, req dto.ChangeEmailRequest) *mUsecaseMockChangeEmail {
+	if mmChangeEmail.mock.funcChangeEmail != nil {
+		mmChangeEmail.mock.t.Fatalf("UsecaseMock.ChangeEmail mock is already set by Set")
 	}
 
-	if mmLogin.defaultExpectation == nil {
-		mmLogin.defaultExpectation = &UsecaseMockLoginExpectation{}
+	if mmChangeEmail.defaultExpectation == nil {
+		mmChangeEmail.defaultExpectation = &UsecaseMockChangeEmailExpectation{}
 	}
 
-	if mmLogin.defaultExpectation.paramPtrs != nil {
-		mmLogin.mock.t.Fatalf("UsecaseMock.Login mock is already set by ExpectParams functions")
+	if mmChangeEmail.defaultExpectation.paramPtrs != nil {
+		mmChangeEmail.mock.t.Fatalf("UsecaseMock.ChangeEmail mock is already set by ExpectParams functions")
 	}
 
-	mmLogin.defaultExpectation.params = &UsecaseMockLoginParams{ctx, req}
-	mmLogin.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmLogin.expectations {
-		if minimock.Equal(e.params, mmLogin.defaultExpectation.params) {
-			mmLogin.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLogin.defaultExpectation.params)
+	mmChangeEmail.defaultExpectation.params = &UsecaseMockChangeEmailParams{ctx, req}
+	mmChangeEmail.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmChangeEmail.expectations {
+		if minimock.Equal(e.params, mmChangeEmail.defaultExpectation.params) {
+			mmChangeEmail.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmChangeEmail.defaultExpectation.params)
 		}
 	}
 
-	return mmLogin
+	return mmChangeEmail
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Usecase.Login
-func (mmLogin *mUsecaseMockLogin) ExpectCtxParam1(ctx context.Context) *mUsecaseMockLogin {
-	if mmLogin.mock.funcLogin != nil {
-		mmLogin.mock.t.Fatalf("UsecaseMock.Login mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Usecase.ChangeEmail
+func (mmChangeEmail *mUsecaseMockChangeEmail) ExpectCtxParam1(ctx context.Context) *mUsecaseMockChangeEmail {
+	if mmChangeEmail.mock.funcChangeEmail != nil {
+		mmChangeEmail.mock.t.Fatalf("UsecaseMock.ChangeEmail mock is already set by Set")
 	}
 
-	if mmLogin.defaultExpectation == nil {
-		mmLogin.defaultExpectation = &UsecaseMockLoginExpectation{}
+	if mmChangeEmail.defaultExpectation == nil {
+		mmChangeEmail.defaultExpectation = &UsecaseMockChangeEmailExpectation{}
 	}
 
-	if mmLogin.defaultExpectation.params != nil {
-		mmLogin.mock.t.Fatalf("UsecaseMock.Login mock is already set by Expect")
+	if mmChangeEmail.defaultExpectation.params != nil {
+		mmChangeEmail.mock.t.Fatalf("UsecaseMock.ChangeEmail mock is already set by Expect")
 	}
 
-	if mmLogin.defaultExpectation.paramPtrs == nil {
-		mmLogin.defaultExpectation.paramPtrs = &UsecaseMockLoginParamPtrs{}
+	if mmChangeEmail.defaultExpectation.paramPtrs == nil {
+		mmChangeEmail.defaultExpectation.paramPtrs = &UsecaseMockChangeEmailParamPtrs{}
 	}
-	mmLogin.defaultExpectation.paramPtrs.ctx = &ctx
-	mmLogin.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmChangeEmail.defaultExpectation.paramPtrs.ctx = &ctx
+	mmChangeEmail.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmLogin
+	return mmChangeEmail
 }
 
-// ExpectReqParam2 sets up expected param req for Usecase.Login
-func (mmLogin *mUsecaseMockLogin) ExpectReqParam2(req dto.LoginRequest) *mUsecaseMockLogin {
-	if mmLogin.mock.funcLogin != nil {
-		mmLogin.mock.t.Fatalf("UsecaseMock.Login mock is already set by Set")
+// ExpectReqParam2 sets up expected param req for Usecase.ChangeEmail
+func (mmChangeEmail *mUsecaseMockChangeEmail) ExpectReqParam2(req dto.ChangeEmailRequest) *mUsecaseMockChangeEmail {
+	if mmChangeEmail.mock.funcChangeEmail != nil {
+		mmChangeEmail.mock.t.Fatalf("UsecaseMock.ChangeEmail mock is already set by Set")
 	}
 
-	if mmLogin.defaultExpectation == nil {
-		mmLogin.defaultExpectation = &UsecaseMockLoginExpectation{}
+	if mmChangeEmail.defaultExpectation == nil {
+		mmChangeEmail.defaultExpectation = &UsecaseMockChangeEmailExpectation{}
 	}
 
-	if mmLogin.defaultExpectation.params != nil {
-		mmLogin.mock.t.Fatalf("UsecaseMock.Login mock is already set by Expect")
+	if mmChangeEmail.defaultExpectation.params != nil {
+		mmChangeEmail.mock.t.Fatalf("UsecaseMock.ChangeEmail mock is already set by Expect")
 	}
 
-	if mmLogin.defaultExpectation.paramPtrs == nil {
-		mmLogin.defaultExpectation.paramPtrs = &UsecaseMockLoginParamPtrs{}
+	if mmChangeEmail.defaultExpectation.paramPtrs == nil {
+		mmChangeEmail.defaultExpectation.paramPtrs = &UsecaseMockChangeEmailParamPtrs{}
 	}
-	mmLogin.defaultExpectation.paramPtrs.req = &req
-	mmLogin.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+	mmChangeEmail.defaultExpectation.paramPtrs.req = &req
+	mmChangeEmail.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
 
-	return mmLogin
+	return mmChangeEmail
 }
 
-// Inspect accepts an inspector function that has same arguments as the Usecase.Login
-func (mmLogin *mUsecaseMockLogin) Inspect(f func(ctx context.Context, req dto.LoginRequest)) *mUsecaseMockLogin {
-	if mmLogin.mock.inspectFuncLogin != nil {
-		mmLogin.mock.t.Fatalf("Inspect function is already set for UsecaseMock.Login")
+// Inspect accepts an inspector function that has same arguments as the Usecase.ChangeEmail
+func (mmChangeEmail *mUsecaseMockChangeEmail) Inspect(f func(ctx context.Context, req dto.ChangeEmailRequest)) *mUsecaseMockChangeEmail {
+	if mmChangeEmail.mock.inspectFuncChangeEmail != nil {
+		mmChangeEmail.mock.t.Fatalf("Inspect function is already set for UsecaseMock.ChangeEmail")
 	}
 
-	mmLogin.mock.inspectFuncLogin = f
+	mmChangeEmail.mock.inspectFuncChangeEmail = f
 
-	return mmLogin
+	return mmChangeEmail
 }
 
-// Return sets up results that will be returned by Usecase.Login
-func (mmLogin *mUsecaseMockLogin) Return(up1 *models.User, err error) *UsecaseMock {
-	if mmLogin.mock.funcLogin != nil {
-		mmLogin.mock.t.Fatalf("UsecaseMock.Login mock is already set by Set")
+// Return sets up results that will be returned by Usecase.ChangeEmail
+func (mmChangeEmail *mUsecaseMockChangeEmail) Return(err error) *UsecaseMock {
+	if mmChangeEmail.mock.funcChangeEmail != nil {
+		mmChangeEmail.mock.t.Fatalf("UsecaseMock.ChangeEmail mock is already set by Set")
 	}
 
-	if mmLogin.defaultExpectation == nil {
-		mmLogin.defaultExpectation = &UsecaseMockLoginExpectation{mock: mmLogin.mock}
+	if mmChangeEmail.defaultExpectation == nil {
+		mmChangeEmail.defaultExpectation = &UsecaseMockChangeEmailExpectation{mock: mmChangeEmail.mock}
 	}
-	mmLogin.defaultExpectation.results = &UsecaseMockLoginResults{up1, err}
-	mmLogin.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmLogin.mock
+	mmChangeEmail.defaultExpectation.results = &UsecaseMockChangeEmailResults{err}
+	mmChangeEmail.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmChangeEmail.mock
 }
 
-// Set uses given function f to mock the Usecase.Login method
-func (mmLogin *mUsecaseMockLogin) Set(f func(ctx context.Context, req dto.LoginRequest) (up1 *models.User, err error)) *UsecaseMock {
-	if mmLogin.defaultExpectation != nil {
-		mmLogin.mock.t.Fatalf("Default expectation is already set for the Usecase.Login method")
+// Set uses given function f to mock the Usecase.ChangeEmail method
+func (mmChangeEmail *mUsecaseMockChangeEmail) Set(f func(ctx context.Context, req dto.ChangeEmailRequest) (err error)) *UsecaseMock {
+	if mmChangeEmail.defaultExpectation != nil {
+		mmChangeEmail.mock.t.Fatalf("Default expectation is already set for the Usecase.ChangeEmail method")
 	}
 
-	if len(mmLogin.expectations) > 0 {
-		mmLogin.mock.t.Fatalf("Some expectations are already set for the Usecase.Login method")
+	if len(mmChangeEmail.expectations) > 0 {
+		mmChangeEmail.mock.t.Fatalf("Some expectations are already set for the Usecase.ChangeEmail method")
 	}
 
-	mmLogin.mock.funcLogin = f
-	mmLogin.mock.funcLoginOrigin = minimock.CallerInfo(1)
-	return mmLogin.mock
+	mmChangeEmail.mock.funcChangeEmail = f
+	mmChangeEmail.mock.funcChangeEmailOrigin = minimock.CallerInfo(1)
+	return mmChangeEmail.mock
 }
 
-// When sets expectation for the Usecase.Login which will trigger the result defined by the following
+// When sets expectation for the Usecase.ChangeEmail which will trigger the result defined by the following
 // Then helper
-func (mmLogin *mUsecaseMockLogin) When(ctx context.Context, req dto.LoginRequest) *UsecaseMockLoginExpectation {
-	if mmLogin.mock.funcLogin != nil {
-		mmLogin.mock.t.Fatalf("UsecaseMock.Login mock is already set by Set")
+func (mmChangeEmail *mUsecaseMockChangeEmail) When(ctx context.Context, req dto.ChangeEmailRequest) *UsecaseMockChangeEmailExpectation {
+	if mmChangeEmail.mock.funcChangeEmail != nil {
+		mmChangeEmail.mock.t.Fatalf("UsecaseMock.ChangeEmail mock is already set by Set")
 	}
 
-	expectation := &UsecaseMockLoginExpectation{
-		mock:               mmLogin.mock,
-		params:             &UsecaseMockLoginParams{ctx, req},
-		expectationOrigins: UsecaseMockLoginExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &UsecaseMockChangeEmailExpectation{
+		mock:               mmChangeEmail.mock,
+		params:             &UsecaseMockChangeEmailParams{ctx, req},
+		expectationOrigins: UsecaseMockChangeEmailExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmLogin.expectations = append(mmLogin.expectations, expectation)
+	mmChangeEmail.expectations = append(mmChangeEmail.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Usecase.Login return parameters for the expectation previously defined by the When method
-func (e *UsecaseMockLoginExpectation) Then(up1 *models.User, err error) *UsecaseMock {
-	e.results = &UsecaseMockLoginResults{up1, err}
+// Then sets up Usecase.ChangeEmail return parameters for the expectation previously defined by the When method
+func (e *UsecaseMockChangeEmailExpectation) Then(err error) *UsecaseMock {
+	e.results = &UsecaseMockChangeEmailResults{err}
 	return e.mock
 }
 
-// Times sets number of times Usecase.Login should be invoked
-func (mmLogin *mUsecaseMockLogin) Times(n uint64) *mUsecaseMockLogin {
+// Times sets number of times Usecase.ChangeEmail should be invoked
+func (mmChangeEmail *mUsecaseMockChangeEmail) Times(n uint64) *mUsecaseMockChangeEmail {
 	if n == 0 {
-		mmLogin.mock.t.Fatalf("Times of UsecaseMock.Login mock can not be zero")
+		mmChangeEmail.mock.t.Fatalf("Times of UsecaseMock.ChangeEmail mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmLogin.expectedInvocations, n)
-	mmLogin.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmLogin
+	mm_atomic.StoreUint64(&mmChangeEmail.expectedInvocations, n)
+	mmChangeEmail.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmChangeEmail
 }
 
-func (mmLogin *mUsecaseMockLogin) invocationsDone() bool {
-	if len(mmLogin.expectations) == 0 && mmLogin.defaultExpectation == nil && mmLogin.mock.funcLogin == nil {
+func (mmChangeEmail *mUsecaseMockChangeEmail) invocationsDone() bool {
+	if len(mmChangeEmail.expectations) == 0 && mmChangeEmail.defaultExpectation == nil && mmChangeEmail.mock.funcChangeEmail == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmLogin.mock.afterLoginCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmLogin.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmChangeEmail.mock.afterChangeEmailCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmChangeEmail.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// Login implements mm_usecase.Usecase
-func (mmLogin *UsecaseMock) Login(ctx context.Context, req dto.LoginRequest) (up1 *models.User, err error) {
-	mm_atomic.AddUint64(&mmLogin.beforeLoginCounter, 1)
-	defer mm_atomic.AddUint64(&mmLogin.afterLoginCounter, 1)
+// ChangeEmail implements mm_usecase.Usecase
+func (mmChangeEmail *UsecaseMock) ChangeEmail(ctx context.Context, req dto.ChangeEmailRequest) (err error) {
+	mm_atomic.AddUint64(&mmChangeEmail.beforeChangeEmailCounter, 1)
+	defer mm_atomic.AddUint64(&mmChangeEmail.afterChangeEmailCounter, 1)
 
-	mmLogin.t.Helper()
+	mmChangeEmail.t.Helper()
 
-	if mmLogin.inspectFuncLogin != nil {
-		mmLogin.inspectFuncLogin(ctx, req)
+	if mmChangeEmail.inspectFuncChangeEmail != nil {
+		mmChangeEmail.inspectFuncChangeEmail(ctx, req)
 	}
 
-	mm_params := UsecaseMockLoginParams{ctx, req}
+	mm_params := UsecaseMockChangeEmailParams{ctx, req}
 
 	// Record call args
-	mmLogin.LoginMock.mutex.Lock()
-	mmLogin.LoginMock.callArgs = append(mmLogin.LoginMock.callArgs, &mm_params)
-	mmLogin.LoginMock.mutex.Unlock()
+	mmChangeEmail.ChangeEmailMock.mutex.Lock()
+	mmChangeEmail.ChangeEmailMock.callArgs = append(mmChangeEmail.ChangeEmailMock.callArgs, &mm_params)
+	mmChangeEmail.ChangeEmailMock.mutex.Unlock()
 
-	for _, e := range mmLogin.LoginMock.expectations {
+	for _, e := range mmChangeEmail.ChangeEmailMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.up1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmLogin.LoginMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmLogin.LoginMock.defaultExpectation.Counter, 1)
-		mm_want := mmLogin.LoginMock.defaultExpectation.params
-		mm_want_ptrs := mmLogin.LoginMock.defaultExpectation.paramPtrs
+	if mmChangeEmail.ChangeEmailMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmChangeEmail.ChangeEmailMock.defaultExpectation.Counter, 1)
+		mm_want := mmChangeEmail.ChangeEmailMock.defaultExpectation.params
+		mm_want_ptrs := mmChangeEmail.ChangeEmailMock.defaultExpectation.paramPtrs
 
-		mm_got := UsecaseMockLoginParams{ctx, req}
+		mm_got := UsecaseMockChangeEmailParams{ctx, req}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmLogin.t.Errorf("UsecaseMock.Login got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmLogin.LoginMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmChangeEmail.t.Errorf("UsecaseMock.ChangeEmail got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmChangeEmail.ChangeEmailMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
 			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
-				mmLogin.t.Errorf("UsecaseMock.Login got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmLogin.LoginMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+				mmChangeEmail.t.Errorf("UsecaseMock.ChangeEmail got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmChangeEmail.ChangeEmailMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmLogin.t.Errorf("UsecaseMock.Login got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmLogin.LoginMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmChangeEmail.t.Errorf("UsecaseMock.ChangeEmail got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmChangeEmail.ChangeEmailMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmLogin.LoginMock.defaultExpectation.results
+		mm_results := mmChangeEmail.ChangeEmailMock.defaultExpectation.results
 		if mm_results == nil {
-			mmLogin.t.Fatal("No results are set for the UsecaseMock.Login")
+			mmChangeEmail.t.Fatal("No results are set for the UsecaseMock.ChangeEmail")
 		}
-		return (*mm_results).up1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmLogin.funcLogin != nil {
-		return mmLogin.funcLogin(ctx, req)
+	if mmChangeEmail.funcChangeEmail != nil {
+		return mmChangeEmail.funcChangeEmail(ctx, req)
 	}
-	mmLogin.t.Fatalf("Unexpected call to UsecaseMock.Login. %v %v", ctx, req)
+	mmChangeEmail.t.Fatalf("Unexpected call to UsecaseMock.ChangeEmail. %v %v", ctx, req)
 	return
 }
 
-// LoginAfterCounter returns a count of finished UsecaseMock.Login invocations
-func (mmLogin *UsecaseMock) LoginAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmLogin.afterLoginCounter)
+// ChangeEmailAfterCounter returns a count of finished UsecaseMock.ChangeEmail invocations
+func (mmChangeEmail *UsecaseMock) ChangeEmailAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmChangeEmail.afterChangeEmailCounter)
 }
 
-// LoginBeforeCounter returns a count of UsecaseMock.Login invocations
-func (mmLogin *UsecaseMock) LoginBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmLogin.beforeLoginCounter)
+// ChangeEmailBeforeCounter returns a count of UsecaseMock.ChangeEmail invocations
+func (mmChangeEmail *UsecaseMock) ChangeEmailBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmChangeEmail.beforeChangeEmailCounter)
 }
 
-// Calls returns a list of arguments used in each call to UsecaseMock.Login.
+// Calls returns a list of arguments used in each call to UsecaseMock.ChangeEmail.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmLogin *mUsecaseMockLogin) Calls() []*UsecaseMockLoginParams {
-	mmLogin.mutex.RLock()
+func (mmChangeEmail *mUsecaseMockChangeEmail) Calls() []*UsecaseMockChangeEmailParams {
+	mmChangeEmail.mutex.RLock()
 
-	argCopy := make([]*UsecaseMockLoginParams, len(mmLogin.callArgs))
-	copy(argCopy, mmLogin.callArgs)
+	argCopy := make([]*UsecaseMockChangeEmailParams, len(mmChangeEmail.callArgs))
+	copy(argCopy, mmChangeEmail.callArgs)
 
-	mmLogin.mutex.RUnlock()
+	mmChangeEmail.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockLoginDone returns true if the count of the Login invocations corresponds
+// MinimockChangeEmailDone returns true if the count of the ChangeEmail invocations corresponds
 // the number of defined expectations
-func (m *UsecaseMock) MinimockLoginDone() bool {
-	if m.LoginMock.optional {
+func (m *UsecaseMock) MinimockChangeEmailDone() bool {
+	if m.ChangeEmailMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.LoginMock.expectations {
+	for _, e := range m.ChangeEmailMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.LoginMock.invocationsDone()
+	return m.ChangeEmailMock.invocationsDone()
 }
 
-// MinimockLoginInspect logs each unmet expectation
-func (m *UsecaseMock) MinimockLoginInspect() {
-	for _, e := range m.LoginMock.expectations {
+// MinimockChangeEmailInspect logs each unmet expectation
+func (m *UsecaseMock) MinimockChangeEmailInspect() {
+	for _, e := range m.ChangeEmailMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to UsecaseMock.Login at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to UsecaseMock.ChangeEmail at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterLoginCounter := mm_atomic.LoadUint64(&m.afterLoginCounter)
+	afterChangeEmailCounter := mm_atomic.LoadUint64(&m.afterChangeEmailCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.LoginMock.defaultExpectation != nil && afterLoginCounter < 1 {
-		if m.LoginMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to UsecaseMock.Login at\n%s", m.LoginMock.defaultExpectation.returnOrigin)
+	if m.ChangeEmailMock.defaultExpectation != nil && afterChangeEmailCounter < 1 {
+		if m.ChangeEmailMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UsecaseMock.ChangeEmail at\n%s", m.ChangeEmailMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to UsecaseMock.Login at\n%s with params: %#v", m.LoginMock.defaultExpectation.expectationOrigins.origin, *m.LoginMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to UsecaseMock.ChangeEmail at\n%s with params: %#v", m.ChangeEmailMock.defaultExpectation.expectationOrigins.origin, *m.ChangeEmailMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcLogin != nil && afterLoginCounter < 1 {
-		m.t.Errorf("Expected call to UsecaseMock.Login at\n%s", m.funcLoginOrigin)
+	if m.funcChangeEmail != nil && afterChangeEmailCounter < 1 {
+		m.t.Errorf("Expected call to UsecaseMock.ChangeEmail at\n%s", m.funcChangeEmailOrigin)
 	}
 
-	if !m.LoginMock.invocationsDone() && afterLoginCounter > 0 {
-		m.t.Errorf("Expected %d calls to UsecaseMock.Login at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.LoginMock.expectedInvocations), m.LoginMock.expectedInvocationsOrigin, afterLoginCounter)
+	if !m.ChangeEmailMock.invocationsDone() && afterChangeEmailCounter > 0 {
+		m.t.Errorf("Expected %d calls to UsecaseMock.ChangeEmail at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ChangeEmailMock.expectedInvocations), m.ChangeEmailMock.expectedInvocationsOrigin, afterChangeEmailCounter)
 	}
 }
 
-type mUsecaseMockRefresh struct {
+type mUsecaseMockChangePassword struct {
 	optional           bool
 	mock               *UsecaseMock
-	defaultExpectation *UsecaseMockRefreshExpectation
-	expectations       []*UsecaseMockRefreshExpectation
+	defaultExpectation *UsecaseMockChangePasswordExpectation
+	expectations       []*UsecaseMockChangePasswordExpectation
 
-	callArgs []*UsecaseMockRefreshParams
+	callArgs []*UsecaseMockChangePasswordParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// UsecaseMockRefreshExpectation specifies expectation struct of the Usecase.Refresh
-type UsecaseMockRefreshExpectation struct {
+// UsecaseMockChangePasswordExpectation specifies expectation struct of the Usecase.ChangePassword
+type UsecaseMockChangePasswordExpectation struct {
 	mock               *UsecaseMock
-	params             *UsecaseMockRefreshParams
-	paramPtrs          *UsecaseMockRefreshParamPtrs
-	expectationOrigins UsecaseMockRefreshExpectationOrigins
-	results            *UsecaseMockRefreshResults
+	params             *UsecaseMockChangePasswordParams
+	paramPtrs          *UsecaseMockChangePasswordParamPtrs
+	expectationOrigins UsecaseMockChangePasswordExpectationOrigins
+	results            *UsecaseMockChangePasswordResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// UsecaseMockRefreshParams contains parameters of the Usecase.Refresh
-type UsecaseMockRefreshParams struct {
+// UsecaseMockChangePasswordParams contains parameters of the Usecase.ChangePassword
+type UsecaseMockChangePasswordParams struct {
 	ctx context.Context
-	req dto.RefreshRequest
+	req dto.ChangePasswordRequest
 }
 
-// UsecaseMockRefreshParamPtrs contains pointers to parameters of the Usecase.Refresh
-type UsecaseMockRefreshParamPtrs struct {
+// UsecaseMockChangePasswordParamPtrs contains pointers to parameters of the Usecase.ChangePassword
+type UsecaseMockChangePasswordParamPtrs struct {
 	ctx *context.Context
-	req *dto.RefreshRequest
+	req *dto.ChangePasswordRequest
 }
 
-// UsecaseMockRefreshResults contains results of the Usecase.Refresh
-type UsecaseMockRefreshResults struct {
-	up1 *models.User
+// UsecaseMockChangePasswordResults contains results of the Usecase.ChangePassword
+type UsecaseMockChangePasswordResults struct {
+	cp1 *dto.ChangePasswordResponse
 	err error
 }
 
-// UsecaseMockRefreshOrigins contains origins of expectations of the Usecase.Refresh
-type UsecaseMockRefreshExpectationOrigins struct {
+// UsecaseMockChangePasswordOrigins contains origins of expectations of the Usecase.ChangePassword
+type UsecaseMockChangePasswordExpectationOrigins struct {
 	origin    string
 	originCtx string
 	originReq string
@@ -459,91 +578,2428 @@ type UsecaseMockRefreshExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmRefresh *mUsecaseMockRefresh) Optional() *mUsecaseMockRefresh {
-	mmRefresh.optional = true
-	return mmRefresh
+func (mmChangePassword *mUsecaseMockChangePassword) Optional() *mUsecaseMockChangePassword {
+	mmChangePassword.optional = true
+	return mmChangePassword
 }
 
-// Expect sets up expected params for Usecase.Refresh
-func (mmRefresh *mUsecaseMockRefresh) Expect(ctx context.Context, req dto.RefreshRequest) *mUsecaseMockRefresh {
-	if mmRefresh.mock.funcRefresh != nil {
-		mmRefresh.mock.t.Fatalf("UsecaseMock.Refresh mock is already set by Set")
+// Expect sets up expected params for Usecase.ChangePassword
+func (mmChangePassword *mUsecaseMockChangePassword) Expect(ctx context.Context, req dto.ChangePasswordRequest) *mUsecaseMockChangePassword {
+	if mmChangePassword.mock.funcChangePassword != nil {
+		mmChangePassword.mock.t.Fatalf("UsecaseMock.ChangePassword mock is already set by Set")
 	}
 
-	if mmRefresh.defaultExpectation == nil {
-		mmRefresh.defaultExpectation = &UsecaseMockRefreshExpectation{}
+	if mmChangePassword.defaultExpectation == nil {
+		mmChangePassword.defaultExpectation = &UsecaseMockChangePasswordExpectation{}
 	}
 
-	if mmRefresh.defaultExpectation.paramPtrs != nil {
-		mmRefresh.mock.t.Fatalf("UsecaseMock.Refresh mock is already set by ExpectParams functions")
+	if mmChangePassword.defaultExpectation.paramPtrs != nil {
+		mmChangePassword.mock.t.Fatalf("UsecaseMock.ChangePassword mock is already set by ExpectParams functions")
 	}
 
-	mmRefresh.defaultExpectation.params = &UsecaseMockRefreshParams{ctx, req}
-	mmRefresh.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmRefresh.expectations {
-		if minimock.Equal(e.params, mmRefresh.defaultExpectation.params) {
-			mmRefresh.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRefresh.defaultExpectation.params)
+	mmChangePassword.defaultExpectation.params = &UsecaseMockChangePasswordParams{ctx, req}
+	mmChangePassword.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmChangePassword.expectations {
+		if minimock.Equal(e.params, mmChangePassword.defaultExpectation.params) {
+			mmChangePassword.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmChangePassword.defaultExpectation.params)
 		}
 	}
 
-	return mmRefresh
+	return mmChangePassword
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Usecase.Refresh
-func (mmRefresh *mUsecaseMockRefresh) ExpectCtxParam1(ctx context.Context) *mUsecaseMockRefresh {
-	if mmRefresh.mock.funcRefresh != nil {
-		mmRefresh.mock.t.Fatalf("UsecaseMock.Refresh mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Usecase.ChangePassword
+func (mmChangePassword *mUsecaseMockChangePassword) ExpectCtxParam1(ctx context.Context) *mUsecaseMockChangePassword {
+	if mmChangePassword.mock.funcChangePassword != nil {
+		mmChangePassword.mock.t.Fatalf("UsecaseMock.ChangePassword mock is already set by Set")
 	}
 
-	if mmRefresh.defaultExpectation == nil {
-		mmRefresh.defaultExpectation = &UsecaseMockRefreshExpectation{}
+	if mmChangePassword.defaultExpectation == nil {
+		mmChangePassword.defaultExpectation = &UsecaseMockChangePasswordExpectation{}
 	}
 
-	if mmRefresh.defaultExpectation.params != nil {
-		mmRefresh.mock.t.Fatalf("UsecaseMock.Refresh mock is already set by Expect")
+	if mmChangePassword.defaultExpectation.params != nil {
+		mmChangePassword.mock.t.Fatalf("UsecaseMock.ChangePassword mock is already set by Expect")
 	}
 
-	if mmRefresh.defaultExpectation.paramPtrs == nil {
-		mmRefresh.defaultExpectation.paramPtrs = &UsecaseMockRefreshParamPtrs{}
+	if mmChangePassword.defaultExpectation.paramPtrs == nil {
+		mmChangePassword.defaultExpectation.paramPtrs = &UsecaseMockChangePasswordParamPtrs{}
 	}
-	mmRefresh.defaultExpectation.paramPtrs.ctx = &ctx
-	mmRefresh.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmChangePassword.defaultExpectation.paramPtrs.ctx = &ctx
+	mmChangePassword.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmRefresh
+	return mmChangePassword
 }
 
-// ExpectReqParam2 sets up expected param req for Usecase.Refresh
-func (mmRefresh *mUsecaseMockRefresh) ExpectReqParam2(req dto.RefreshRequest) *mUsecaseMockRefresh {
-	if mmRefresh.mock.funcRefresh != nil {
-		mmRefresh.mock.t.Fatalf("UsecaseMock.Refresh mock is already set by Set")
+// ExpectReqParam2 sets up expected param req for Usecase.ChangePassword
+func (mmChangePassword *mUsecaseMockChangePassword) ExpectReqParam2(req dto.ChangePasswordRequest) *mUsecaseMockChangePassword {
+	if mmChangePassword.mock.funcChangePassword != nil {
+		mmChangePassword.mock.t.Fatalf("UsecaseMock.ChangePassword mock is already set by Set")
 	}
 
-	if mmRefresh.defaultExpectation == nil {
-		mmRefresh.defaultExpectation = &UsecaseMockRefreshExpectation{}
+	if mmChangePassword.defaultExpectation == nil {
+		mmChangePassword.defaultExpectation = &UsecaseMockChangePasswordExpectation{}
 	}
 
-	if mmRefresh.defaultExpectation.params != nil {
-		mmRefresh.mock.t.Fatalf("UsecaseMock.Refresh mock is already set by Expect")
+	if mmChangePassword.defaultExpectation.params != nil {
+		mmChangePassword.mock.t.Fatalf("UsecaseMock.ChangePassword mock is already set by Expect")
 	}
 
-	if mmRefresh.defaultExpectation.paramPtrs == nil {
-		mmRefresh.defaultExpectation.paramPtrs = &UsecaseMockRefreshParamPtrs{}
+	if mmChangePassword.defaultExpectation.paramPtrs == nil {
+		mmChangePassword.defaultExpectation.paramPtrs = &UsecaseMockChangePasswordParamPtrs{}
 	}
-	mmRefresh.defaultExpectation.paramPtrs.req = &req
-	mmRefresh.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+	mmChangePassword.defaultExpectation.paramPtrs.req = &req
+	mmChangePassword.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
 
-	return mmRefresh
+	return mmChangePassword
 }
 
-// Inspect accepts an inspector function that has same arguments as the Usecase.Refresh
-func (mmRefresh *mUsecaseMockRefresh) Inspect(f func(ctx context.Context, req dto.RefreshRequest)) *mUsecaseMockRefresh {
-	if mmRefresh.mock.inspectFuncRefresh != nil {
-		mmRefresh.mock.t.Fatalf("Inspect function is already set for UsecaseMock.Refresh")
+// Inspect accepts an inspector function that has same arguments as the Usecase.ChangePassword
+func (mmChangePassword *mUsecaseMockChangePassword) Inspect(f func(ctx context.Context, req dto.ChangePasswordRequest)) *mUsecaseMockChangePassword {
+	if mmChangePassword.mock.inspectFuncChangePassword != nil {
+		mmChangePassword.mock.t.Fatalf("Inspect function is already set for UsecaseMock.ChangePassword")
 	}
 
-	mmRefresh.mock.inspectFuncRefresh = f
+	mmChangePassword.mock.inspectFuncChangePassword = f
 
-	return mmRefresh
+	return mmChangePassword
+}
+
+// Return sets up results that will be returned by Usecase.ChangePassword
+func (mmChangePassword *mUsecaseMockChangePassword) Return(cp1 *dto.ChangePasswordResponse, err error) *UsecaseMock {
+	if mmChangePassword.mock.funcChangePassword != nil {
+		mmChangePassword.mock.t.Fatalf("UsecaseMock.ChangePassword mock is already set by Set")
+	}
+
+	if mmChangePassword.defaultExpectation == nil {
+		mmChangePassword.defaultExpectation = &UsecaseMockChangePasswordExpectation{mock: mmChangePassword.mock}
+	}
+	mmChangePassword.defaultExpectation.results = &UsecaseMockChangePasswordResults{cp1, err}
+	mmChangePassword.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmChangePassword.mock
+}
+
+// Set uses given function f to mock the Usecase.ChangePassword method
+func (mmChangePassword *mUsecaseMockChangePassword) Set(f func(ctx context.Context, req dto.ChangePasswordRequest) (cp1 *dto.ChangePasswordResponse, err error)) *UsecaseMock {
+	if mmChangePassword.defaultExpectation != nil {
+		mmChangePassword.mock.t.Fatalf("Default expectation is already set for the Usecase.ChangePassword method")
+	}
+
+	if len(mmChangePassword.expectations) > 0 {
+		mmChangePassword.mock.t.Fatalf("Some expectations are already set for the Usecase.ChangePassword method")
+	}
+
+	mmChangePassword.mock.funcChangePassword = f
+	mmChangePassword.mock.funcChangePasswordOrigin = minimock.CallerInfo(1)
+	return mmChangePassword.mock
+}
+
+// When sets expectation for the Usecase.ChangePassword which will trigger the result defined by the following
+// Then helper
+func (mmChangePassword *mUsecaseMockChangePassword) When(ctx context.Context, req dto.ChangePasswordRequest) *UsecaseMockChangePasswordExpectation {
+	if mmChangePassword.mock.funcChangePassword != nil {
+		mmChangePassword.mock.t.Fatalf("UsecaseMock.ChangePassword mock is already set by Set")
+	}
+
+	expectation := &UsecaseMockChangePasswordExpectation{
+		mock:               mmChangePassword.mock,
+		params:             &UsecaseMockChangePasswordParams{ctx, req},
+		expectationOrigins: UsecaseMockChangePasswordExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmChangePassword.expectations = append(mmChangePassword.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Usecase.ChangePassword return parameters for the expectation previously defined by the When method
+func (e *UsecaseMockChangePasswordExpectation) Then(cp1 *dto.ChangePasswordResponse, err error) *UsecaseMock {
+	e.results = &UsecaseMockChangePasswordResults{cp1, err}
+	return e.mock
+}
+
+// Times sets number of times Usecase.ChangePassword should be invoked
+func (mmChangePassword *mUsecaseMockChangePassword) Times(n uint64) *mUsecaseMockChangePassword {
+	if n == 0 {
+		mmChangePassword.mock.t.Fatalf("Times of UsecaseMock.ChangePassword mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmChangePassword.expectedInvocations, n)
+	mmChangePassword.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmChangePassword
+}
+
+func (mmChangePassword *mUsecaseMockChangePassword) invocationsDone() bool {
+	if len(mmChangePassword.expectations) == 0 && mmChangePassword.defaultExpectation == nil && mmChangePassword.mock.funcChangePassword == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmChangePassword.mock.afterChangePasswordCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmChangePassword.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ChangePassword implements mm_usecase.Usecase
+func (mmChangePassword *UsecaseMock) ChangePassword(ctx context.Context, req dto.ChangePasswordRequest) (cp1 *dto.ChangePasswordResponse, err error) {
+	mm_atomic.AddUint64(&mmChangePassword.beforeChangePasswordCounter, 1)
+	defer mm_atomic.AddUint64(&mmChangePassword.afterChangePasswordCounter, 1)
+
+	mmChangePassword.t.Helper()
+
+	if mmChangePassword.inspectFuncChangePassword != nil {
+		mmChangePassword.inspectFuncChangePassword(ctx, req)
+	}
+
+	mm_params := UsecaseMockChangePasswordParams{ctx, req}
+
+	// Record call args
+	mmChangePassword.ChangePasswordMock.mutex.Lock()
+	mmChangePassword.ChangePasswordMock.callArgs = append(mmChangePassword.ChangePasswordMock.callArgs, &mm_params)
+	mmChangePassword.ChangePasswordMock.mutex.Unlock()
+
+	for _, e := range mmChangePassword.ChangePasswordMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cp1, e.results.err
+		}
+	}
+
+	if mmChangePassword.ChangePasswordMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmChangePassword.ChangePasswordMock.defaultExpectation.Counter, 1)
+		mm_want := mmChangePassword.ChangePasswordMock.defaultExpectation.params
+		mm_want_ptrs := mmChangePassword.ChangePasswordMock.defaultExpectation.paramPtrs
+
+		mm_got := UsecaseMockChangePasswordParams{ctx, req}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmChangePassword.t.Errorf("UsecaseMock.ChangePassword got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmChangePassword.ChangePasswordMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmChangePassword.t.Errorf("UsecaseMock.ChangePassword got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmChangePassword.ChangePasswordMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmChangePassword.t.Errorf("UsecaseMock.ChangePassword got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmChangePassword.ChangePasswordMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmChangePassword.ChangePasswordMock.defaultExpectation.results
+		if mm_results == nil {
+			mmChangePassword.t.Fatal("No results are set for the UsecaseMock.ChangePassword")
+		}
+		return (*mm_results).cp1, (*mm_results).err
+	}
+	if mmChangePassword.funcChangePassword != nil {
+		return mmChangePassword.funcChangePassword(ctx, req)
+	}
+	mmChangePassword.t.Fatalf("Unexpected call to UsecaseMock.ChangePassword. %v %v", ctx, req)
+	return
+}
+
+// ChangePasswordAfterCounter returns a count of finished UsecaseMock.ChangePassword invocations
+func (mmChangePassword *UsecaseMock) ChangePasswordAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmChangePassword.afterChangePasswordCounter)
+}
+
+// ChangePasswordBeforeCounter returns a count of UsecaseMock.ChangePassword invocations
+func (mmChangePassword *UsecaseMock) ChangePasswordBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmChangePassword.beforeChangePasswordCounter)
+}
+
+// Calls returns a list of arguments used in each call to UsecaseMock.ChangePassword.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmChangePassword *mUsecaseMockChangePassword) Calls() []*UsecaseMockChangePasswordParams {
+	mmChangePassword.mutex.RLock()
+
+	argCopy := make([]*UsecaseMockChangePasswordParams, len(mmChangePassword.callArgs))
+	copy(argCopy, mmChangePassword.callArgs)
+
+	mmChangePassword.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockChangePasswordDone returns true if the count of the ChangePassword invocations corresponds
+// the number of defined expectations
+func (m *UsecaseMock) MinimockChangePasswordDone() bool {
+	if m.ChangePasswordMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ChangePasswordMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ChangePasswordMock.invocationsDone()
+}
+
+// MinimockChangePasswordInspect logs each unmet expectation
+func (m *UsecaseMock) MinimockChangePasswordInspect() {
+	for _, e := range m.ChangePasswordMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UsecaseMock.ChangePassword at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterChangePasswordCounter := mm_atomic.LoadUint64(&m.afterChangePasswordCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ChangePasswordMock.defaultExpectation != nil && afterChangePasswordCounter < 1 {
+		if m.ChangePasswordMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UsecaseMock.ChangePassword at\n%s", m.ChangePasswordMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UsecaseMock.ChangePassword at\n%s with params: %#v", m.ChangePasswordMock.defaultExpectation.expectationOrigins.origin, *m.ChangePasswordMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcChangePassword != nil && afterChangePasswordCounter < 1 {
+		m.t.Errorf("Expected call to UsecaseMock.ChangePassword at\n%s", m.funcChangePasswordOrigin)
+	}
+
+	if !m.ChangePasswordMock.invocationsDone() && afterChangePasswordCounter > 0 {
+		m.t.Errorf("Expected %d calls to UsecaseMock.ChangePassword at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ChangePasswordMock.expectedInvocations), m.ChangePasswordMock.expectedInvocationsOrigin, afterChangePasswordCounter)
+	}
+}
+
+type mUsecaseMockConfirmEmail struct {
+	optional           bool
+	mock               *UsecaseMock
+	defaultExpectation *UsecaseMockConfirmEmailExpectation
+	expectations       []*UsecaseMockConfirmEmailExpectation
+
+	callArgs []*UsecaseMockConfirmEmailParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UsecaseMockConfirmEmailExpectation specifies expectation struct of the Usecase.ConfirmEmail
+type UsecaseMockConfirmEmailExpectation struct {
+	mock               *UsecaseMock
+	params             *UsecaseMockConfirmEmailParams
+	paramPtrs          *UsecaseMockConfirmEmailParamPtrs
+	expectationOrigins UsecaseMockConfirmEmailExpectationOrigins
+	results            *UsecaseMockConfirmEmailResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UsecaseMockConfirmEmailParams contains parameters of the Usecase.ConfirmEmail
+type UsecaseMockConfirmEmailParams struct {
+	ctx   context.Context
+	token string
+}
+
+// UsecaseMockConfirmEmailParamPtrs contains pointers to parameters of the Usecase.ConfirmEmail
+type UsecaseMockConfirmEmailParamPtrs struct {
+	ctx   *context.Context
+	token *string
+}
+
+// UsecaseMockConfirmEmailResults contains results of the Usecase.ConfirmEmail
+type UsecaseMockConfirmEmailResults struct {
+	err error
+}
+
+// UsecaseMockConfirmEmailOrigins contains origins of expectations of the Usecase.ConfirmEmail
+type UsecaseMockConfirmEmailExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originToken string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmConfirmEmail *mUsecaseMockConfirmEmail) Optional() *mUsecaseMockConfirmEmail {
+	mmConfirmEmail.optional = true
+	return mmConfirmEmail
+}
+
+// Expect sets up expected params for Usecase.ConfirmEmail
+func (mmConfirmEmail *mUsecaseMockConfirmEmail) Expect(ctx context.Context, token string) *mUsecaseMockConfirmEmail {
+	if mmConfirmEmail.mock.funcConfirmEmail != nil {
+		mmConfirmEmail.mock.t.Fatalf("UsecaseMock.ConfirmEmail mock is already set by Set")
+	}
+
+	if mmConfirmEmail.defaultExpectation == nil {
+		mmConfirmEmail.defaultExpectation = &UsecaseMockConfirmEmailExpectation{}
+	}
+
+	if mmConfirmEmail.defaultExpectation.paramPtrs != nil {
+		mmConfirmEmail.mock.t.Fatalf("UsecaseMock.ConfirmEmail mock is already set by ExpectParams functions")
+	}
+
+	mmConfirmEmail.defaultExpectation.params = &UsecaseMockConfirmEmailParams{ctx, token}
+	mmConfirmEmail.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmConfirmEmail.expectations {
+		if minimock.Equal(e.params, mmConfirmEmail.defaultExpectation.params) {
+			mmConfirmEmail.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmConfirmEmail.defaultExpectation.params)
+		}
+	}
+
+	return mmConfirmEmail
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Usecase.ConfirmEmail
+func (mmConfirmEmail *mUsecaseMockConfirmEmail) ExpectCtxParam1(ctx context.Context) *mUsecaseMockConfirmEmail {
+	if mmConfirmEmail.mock.funcConfirmEmail != nil {
+		mmConfirmEmail.mock.t.Fatalf("UsecaseMock.ConfirmEmail mock is already set by Set")
+	}
+
+	if mmConfirmEmail.defaultExpectation == nil {
+		mmConfirmEmail.defaultExpectation = &UsecaseMockConfirmEmailExpectation{}
+	}
+
+	if mmConfirmEmail.defaultExpectation.params != nil {
+		mmConfirmEmail.mock.t.Fatalf("UsecaseMock.ConfirmEmail mock is already set by Expect")
+	}
+
+	if mmConfirmEmail.defaultExpectation.paramPtrs == nil {
+		mmConfirmEmail.defaultExpectation.paramPtrs = &UsecaseMockConfirmEmailParamPtrs{}
+	}
+	mmConfirmEmail.defaultExpectation.paramPtrs.ctx = &ctx
+	mmConfirmEmail.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmConfirmEmail
+}
+
+// ExpectTokenParam2 sets up expected param token for Usecase.ConfirmEmail
+func (mmConfirmEmail *mUsecaseMockConfirmEmail) ExpectTokenParam2(token string) *mUsecaseMockConfirmEmail {
+	if mmConfirmEmail.mock.funcConfirmEmail != nil {
+		mmConfirmEmail.mock.t.Fatalf("UsecaseMock.ConfirmEmail mock is already set by Set")
+	}
+
+	if mmConfirmEmail.defaultExpectation == nil {
+		mmConfirmEmail.defaultExpectation = &UsecaseMockConfirmEmailExpectation{}
+	}
+
+	if mmConfirmEmail.defaultExpectation.params != nil {
+		mmConfirmEmail.mock.t.Fatalf("UsecaseMock.ConfirmEmail mock is already set by Expect")
+	}
+
+	if mmConfirmEmail.defaultExpectation.paramPtrs == nil {
+		mmConfirmEmail.defaultExpectation.paramPtrs = &UsecaseMockConfirmEmailParamPtrs{}
+	}
+	mmConfirmEmail.defaultExpectation.paramPtrs.token = &token
+	mmConfirmEmail.defaultExpectation.expectationOrigins.originToken = minimock.CallerInfo(1)
+
+	return mmConfirmEmail
+}
+
+// Inspect accepts an inspector function that has same arguments as the Usecase.ConfirmEmail
+func (mmConfirmEmail *mUsecaseMockConfirmEmail) Inspect(f func(ctx context.Context, token string)) *mUsecaseMockConfirmEmail {
+	if mmConfirmEmail.mock.inspectFuncConfirmEmail != nil {
+		mmConfirmEmail.mock.t.Fatalf("Inspect function is already set for UsecaseMock.ConfirmEmail")
+	}
+
+	mmConfirmEmail.mock.inspectFuncConfirmEmail = f
+
+	return mmConfirmEmail
+}
+
+// Return sets up results that will be returned by Usecase.ConfirmEmail
+func (mmConfirmEmail *mUsecaseMockConfirmEmail) Return(err error) *UsecaseMock {
+	if mmConfirmEmail.mock.funcConfirmEmail != nil {
+		mmConfirmEmail.mock.t.Fatalf("UsecaseMock.ConfirmEmail mock is already set by Set")
+	}
+
+	if mmConfirmEmail.defaultExpectation == nil {
+		mmConfirmEmail.defaultExpectation = &UsecaseMockConfirmEmailExpectation{mock: mmConfirmEmail.mock}
+	}
+	mmConfirmEmail.defaultExpectation.results = &UsecaseMockConfirmEmailResults{err}
+	mmConfirmEmail.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmConfirmEmail.mock
+}
+
+// Set uses given function f to mock the Usecase.ConfirmEmail method
+func (mmConfirmEmail *mUsecaseMockConfirmEmail) Set(f func(ctx context.Context, token string) (err error)) *UsecaseMock {
+	if mmConfirmEmail.defaultExpectation != nil {
+		mmConfirmEmail.mock.t.Fatalf("Default expectation is already set for the Usecase.ConfirmEmail method")
+	}
+
+	if len(mmConfirmEmail.expectations) > 0 {
+		mmConfirmEmail.mock.t.Fatalf("Some expectations are already set for the Usecase.ConfirmEmail method")
+	}
+
+	mmConfirmEmail.mock.funcConfirmEmail = f
+	mmConfirmEmail.mock.funcConfirmEmailOrigin = minimock.CallerInfo(1)
+	return mmConfirmEmail.mock
+}
+
+// When sets expectation for the Usecase.ConfirmEmail which will trigger the result defined by the following
+// Then helper
+func (mmConfirmEmail *mUsecaseMockConfirmEmail) When(ctx context.Context, token string) *UsecaseMockConfirmEmailExpectation {
+	if mmConfirmEmail.mock.funcConfirmEmail != nil {
+		mmConfirmEmail.mock.t.Fatalf("UsecaseMock.ConfirmEmail mock is already set by Set")
+	}
+
+	expectation := &UsecaseMockConfirmEmailExpectation{
+		mock:               mmConfirmEmail.mock,
+		params:             &UsecaseMockConfirmEmailParams{ctx, token},
+		expectationOrigins: UsecaseMockConfirmEmailExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmConfirmEmail.expectations = append(mmConfirmEmail.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Usecase.ConfirmEmail return parameters for the expectation previously defined by the When method
+func (e *UsecaseMockConfirmEmailExpectation) Then(err error) *UsecaseMock {
+	e.results = &UsecaseMockConfirmEmailResults{err}
+	return e.mock
+}
+
+// Times sets number of times Usecase.ConfirmEmail should be invoked
+func (mmConfirmEmail *mUsecaseMockConfirmEmail) Times(n uint64) *mUsecaseMockConfirmEmail {
+	if n == 0 {
+		mmConfirmEmail.mock.t.Fatalf("Times of UsecaseMock.ConfirmEmail mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmConfirmEmail.expectedInvocations, n)
+	mmConfirmEmail.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmConfirmEmail
+}
+
+func (mmConfirmEmail *mUsecaseMockConfirmEmail) invocationsDone() bool {
+	if len(mmConfirmEmail.expectations) == 0 && mmConfirmEmail.defaultExpectation == nil && mmConfirmEmail.mock.funcConfirmEmail == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmConfirmEmail.mock.afterConfirmEmailCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmConfirmEmail.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ConfirmEmail implements mm_usecase.Usecase
+func (mmConfirmEmail *UsecaseMock) ConfirmEmail(ctx context.Context, token string) (err error) {
+	mm_atomic.AddUint64(&mmConfirmEmail.beforeConfirmEmailCounter, 1)
+	defer mm_atomic.AddUint64(&mmConfirmEmail.afterConfirmEmailCounter, 1)
+
+	mmConfirmEmail.t.Helper()
+
+	if mmConfirmEmail.inspectFuncConfirmEmail != nil {
+		mmConfirmEmail.inspectFuncConfirmEmail(ctx, token)
+	}
+
+	mm_params := UsecaseMockConfirmEmailParams{ctx, token}
+
+	// Record call args
+	mmConfirmEmail.ConfirmEmailMock.mutex.Lock()
+	mmConfirmEmail.ConfirmEmailMock.callArgs = append(mmConfirmEmail.ConfirmEmailMock.callArgs, &mm_params)
+	mmConfirmEmail.ConfirmEmailMock.mutex.Unlock()
+
+	for _, e := range mmConfirmEmail.ConfirmEmailMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmConfirmEmail.ConfirmEmailMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmConfirmEmail.ConfirmEmailMock.defaultExpectation.Counter, 1)
+		mm_want := mmConfirmEmail.ConfirmEmailMock.defaultExpectation.params
+		mm_want_ptrs := mmConfirmEmail.ConfirmEmailMock.defaultExpectation.paramPtrs
+
+		mm_got := UsecaseMockConfirmEmailParams{ctx, token}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmConfirmEmail.t.Errorf("UsecaseMock.ConfirmEmail got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmConfirmEmail.ConfirmEmailMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.token != nil && !minimock.Equal(*mm_want_ptrs.token, mm_got.token) {
+				mmConfirmEmail.t.Errorf("UsecaseMock.ConfirmEmail got unexpected parameter token, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmConfirmEmail.ConfirmEmailMock.defaultExpectation.expectationOrigins.originToken, *mm_want_ptrs.token, mm_got.token, minimock.Diff(*mm_want_ptrs.token, mm_got.token))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmConfirmEmail.t.Errorf("UsecaseMock.ConfirmEmail got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmConfirmEmail.ConfirmEmailMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmConfirmEmail.ConfirmEmailMock.defaultExpectation.results
+		if mm_results == nil {
+			mmConfirmEmail.t.Fatal("No results are set for the UsecaseMock.ConfirmEmail")
+		}
+		return (*mm_results).err
+	}
+	if mmConfirmEmail.funcConfirmEmail != nil {
+		return mmConfirmEmail.funcConfirmEmail(ctx, token)
+	}
+	mmConfirmEmail.t.Fatalf("Unexpected call to UsecaseMock.ConfirmEmail. %v %v", ctx, token)
+	return
+}
+
+// ConfirmEmailAfterCounter returns a count of finished UsecaseMock.ConfirmEmail invocations
+func (mmConfirmEmail *UsecaseMock) ConfirmEmailAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmConfirmEmail.afterConfirmEmailCounter)
+}
+
+// ConfirmEmailBeforeCounter returns a count of UsecaseMock.ConfirmEmail invocations
+func (mmConfirmEmail *UsecaseMock) ConfirmEmailBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmConfirmEmail.beforeConfirmEmailCounter)
+}
+
+// Calls returns a list of arguments used in each call to UsecaseMock.ConfirmEmail.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmConfirmEmail *mUsecaseMockConfirmEmail) Calls() []*UsecaseMockConfirmEmailParams {
+	mmConfirmEmail.mutex.RLock()
+
+	argCopy := make([]*UsecaseMockConfirmEmailParams, len(mmConfirmEmail.callArgs))
+	copy(argCopy, mmConfirmEmail.callArgs)
+
+	mmConfirmEmail.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockConfirmEmailDone returns true if the count of the ConfirmEmail invocations corresponds
+// the number of defined expectations
+func (m *UsecaseMock) MinimockConfirmEmailDone() bool {
+	if m.ConfirmEmailMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ConfirmEmailMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ConfirmEmailMock.invocationsDone()
+}
+
+// MinimockConfirmEmailInspect logs each unmet expectation
+func (m *UsecaseMock) MinimockConfirmEmailInspect() {
+	for _, e := range m.ConfirmEmailMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UsecaseMock.ConfirmEmail at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterConfirmEmailCounter := mm_atomic.LoadUint64(&m.afterConfirmEmailCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ConfirmEmailMock.defaultExpectation != nil && afterConfirmEmailCounter < 1 {
+		if m.ConfirmEmailMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UsecaseMock.ConfirmEmail at\n%s", m.ConfirmEmailMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UsecaseMock.ConfirmEmail at\n%s with params: %#v", m.ConfirmEmailMock.defaultExpectation.expectationOrigins.origin, *m.ConfirmEmailMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcConfirmEmail != nil && afterConfirmEmailCounter < 1 {
+		m.t.Errorf("Expected call to UsecaseMock.ConfirmEmail at\n%s", m.funcConfirmEmailOrigin)
+	}
+
+	if !m.ConfirmEmailMock.invocationsDone() && afterConfirmEmailCounter > 0 {
+		m.t.Errorf("Expected %d calls to UsecaseMock.ConfirmEmail at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ConfirmEmailMock.expectedInvocations), m.ConfirmEmailMock.expectedInvocationsOrigin, afterConfirmEmailCounter)
+	}
+}
+
+type mUsecaseMockGetJWKS struct {
+	optional           bool
+	mock               *UsecaseMock
+	defaultExpectation *UsecaseMockGetJWKSExpectation
+	expectations       []*UsecaseMockGetJWKSExpectation
+
+	callArgs []*UsecaseMockGetJWKSParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UsecaseMockGetJWKSExpectation specifies expectation struct of the Usecase.GetJWKS
+type UsecaseMockGetJWKSExpectation struct {
+	mock               *UsecaseMock
+	params             *UsecaseMockGetJWKSParams
+	paramPtrs          *UsecaseMockGetJWKSParamPtrs
+	expectationOrigins UsecaseMockGetJWKSExpectationOrigins
+	results            *UsecaseMockGetJWKSResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UsecaseMockGetJWKSParams contains parameters of the Usecase.GetJWKS
+type UsecaseMockGetJWKSParams struct {
+	ctx context.Context
+}
+
+// UsecaseMockGetJWKSParamPtrs contains pointers to parameters of the Usecase.GetJWKS
+type UsecaseMockGetJWKSParamPtrs struct {
+	ctx *context.Context
+}
+
+// UsecaseMockGetJWKSResults contains results of the Usecase.GetJWKS
+type UsecaseMockGetJWKSResults struct {
+	jp1 *dto.JWKSResponse
+	err error
+}
+
+// UsecaseMockGetJWKSOrigins contains origins of expectations of the Usecase.GetJWKS
+type UsecaseMockGetJWKSExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetJWKS *mUsecaseMockGetJWKS) Optional() *mUsecaseMockGetJWKS {
+	mmGetJWKS.optional = true
+	return mmGetJWKS
+}
+
+// Expect sets up expected params for Usecase.GetJWKS
+func (mmGetJWKS *mUsecaseMockGetJWKS) Expect(ctx context.Context) *mUsecaseMockGetJWKS {
+	if mmGetJWKS.mock.funcGetJWKS != nil {
+		mmGetJWKS.mock.t.Fatalf("UsecaseMock.GetJWKS mock is already set by Set")
+	}
+
+	if mmGetJWKS.defaultExpectation == nil {
+		mmGetJWKS.defaultExpectation = &UsecaseMockGetJWKSExpectation{}
+	}
+
+	if mmGetJWKS.defaultExpectation.paramPtrs != nil {
+		mmGetJWKS.mock.t.Fatalf("UsecaseMock.GetJWKS mock is already set by ExpectParams functions")
+	}
+
+	mmGetJWKS.defaultExpectation.params = &UsecaseMockGetJWKSParams{ctx}
+	mmGetJWKS.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetJWKS.expectations {
+		if minimock.Equal(e.params, mmGetJWKS.defaultExpectation.params) {
+			mmGetJWKS.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetJWKS.defaultExpectation.params)
+		}
+	}
+
+	return mmGetJWKS
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Usecase.GetJWKS
+func (mmGetJWKS *mUsecaseMockGetJWKS) ExpectCtxParam1(ctx context.Context) *mUsecaseMockGetJWKS {
+	if mmGetJWKS.mock.funcGetJWKS != nil {
+		mmGetJWKS.mock.t.Fatalf("UsecaseMock.GetJWKS mock is already set by Set")
+	}
+
+	if mmGetJWKS.defaultExpectation == nil {
+		mmGetJWKS.defaultExpectation = &UsecaseMockGetJWKSExpectation{}
+	}
+
+	if mmGetJWKS.defaultExpectation.params != nil {
+		mmGetJWKS.mock.t.Fatalf("UsecaseMock.GetJWKS mock is already set by Expect")
+	}
+
+	if mmGetJWKS.defaultExpectation.paramPtrs == nil {
+		mmGetJWKS.defaultExpectation.paramPtrs = &UsecaseMockGetJWKSParamPtrs{}
+	}
+	mmGetJWKS.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetJWKS.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetJWKS
+}
+
+// Inspect accepts an inspector function that has same arguments as the Usecase.GetJWKS
+func (mmGetJWKS *mUsecaseMockGetJWKS) Inspect(f func(ctx context.Context)) *mUsecaseMockGetJWKS {
+	if mmGetJWKS.mock.inspectFuncGetJWKS != nil {
+		mmGetJWKS.mock.t.Fatalf("Inspect function is already set for UsecaseMock.GetJWKS")
+	}
+
+	mmGetJWKS.mock.inspectFuncGetJWKS = f
+
+	return mmGetJWKS
+}
+
+// Return sets up results that will be returned by Usecase.GetJWKS
+func (mmGetJWKS *mUsecaseMockGetJWKS) Return(jp1 *dto.JWKSResponse, err error) *UsecaseMock {
+	if mmGetJWKS.mock.funcGetJWKS != nil {
+		mmGetJWKS.mock.t.Fatalf("UsecaseMock.GetJWKS mock is already set by Set")
+	}
+
+	if mmGetJWKS.defaultExpectation == nil {
+		mmGetJWKS.defaultExpectation = &UsecaseMockGetJWKSExpectation{mock: mmGetJWKS.mock}
+	}
+	mmGetJWKS.defaultExpectation.results = &UsecaseMockGetJWKSResults{jp1, err}
+	mmGetJWKS.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetJWKS.mock
+}
+
+// Set uses given function f to mock the Usecase.GetJWKS method
+func (mmGetJWKS *mUsecaseMockGetJWKS) Set(f func(ctx context.Context) (jp1 *dto.JWKSResponse, err error)) *UsecaseMock {
+	if mmGetJWKS.defaultExpectation != nil {
+		mmGetJWKS.mock.t.Fatalf("Default expectation is already set for the Usecase.GetJWKS method")
+	}
+
+	if len(mmGetJWKS.expectations) > 0 {
+		mmGetJWKS.mock.t.Fatalf("Some expectations are already set for the Usecase.GetJWKS method")
+	}
+
+	mmGetJWKS.mock.funcGetJWKS = f
+	mmGetJWKS.mock.funcGetJWKSOrigin = minimock.CallerInfo(1)
+	return mmGetJWKS.mock
+}
+
+// When sets expectation for the Usecase.GetJWKS which will trigger the result defined by the following
+// Then helper
+func (mmGetJWKS *mUsecaseMockGetJWKS) When(ctx context.Context) *UsecaseMockGetJWKSExpectation {
+	if mmGetJWKS.mock.funcGetJWKS != nil {
+		mmGetJWKS.mock.t.Fatalf("UsecaseMock.GetJWKS mock is already set by Set")
+	}
+
+	expectation := &UsecaseMockGetJWKSExpectation{
+		mock:               mmGetJWKS.mock,
+		params:             &UsecaseMockGetJWKSParams{ctx},
+		expectationOrigins: UsecaseMockGetJWKSExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetJWKS.expectations = append(mmGetJWKS.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Usecase.GetJWKS return parameters for the expectation previously defined by the When method
+func (e *UsecaseMockGetJWKSExpectation) Then(jp1 *dto.JWKSResponse, err error) *UsecaseMock {
+	e.results = &UsecaseMockGetJWKSResults{jp1, err}
+	return e.mock
+}
+
+// Times sets number of times Usecase.GetJWKS should be invoked
+func (mmGetJWKS *mUsecaseMockGetJWKS) Times(n uint64) *mUsecaseMockGetJWKS {
+	if n == 0 {
+		mmGetJWKS.mock.t.Fatalf("Times of UsecaseMock.GetJWKS mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetJWKS.expectedInvocations, n)
+	mmGetJWKS.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetJWKS
+}
+
+func (mmGetJWKS *mUsecaseMockGetJWKS) invocationsDone() bool {
+	if len(mmGetJWKS.expectations) == 0 && mmGetJWKS.defaultExpectation == nil && mmGetJWKS.mock.funcGetJWKS == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetJWKS.mock.afterGetJWKSCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetJWKS.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetJWKS implements mm_usecase.Usecase
+func (mmGetJWKS *UsecaseMock) GetJWKS(ctx context.Context) (jp1 *dto.JWKSResponse, err error) {
+	mm_atomic.AddUint64(&mmGetJWKS.beforeGetJWKSCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetJWKS.afterGetJWKSCounter, 1)
+
+	mmGetJWKS.t.Helper()
+
+	if mmGetJWKS.inspectFuncGetJWKS != nil {
+		mmGetJWKS.inspectFuncGetJWKS(ctx)
+	}
+
+	mm_params := UsecaseMockGetJWKSParams{ctx}
+
+	// Record call args
+	mmGetJWKS.GetJWKSMock.mutex.Lock()
+	mmGetJWKS.GetJWKSMock.callArgs = append(mmGetJWKS.GetJWKSMock.callArgs, &mm_params)
+	mmGetJWKS.GetJWKSMock.mutex.Unlock()
+
+	for _, e := range mmGetJWKS.GetJWKSMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.jp1, e.results.err
+		}
+	}
+
+	if mmGetJWKS.GetJWKSMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetJWKS.GetJWKSMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetJWKS.GetJWKSMock.defaultExpectation.params
+		mm_want_ptrs := mmGetJWKS.GetJWKSMock.defaultExpectation.paramPtrs
+
+		mm_got := UsecaseMockGetJWKSParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetJWKS.t.Errorf("UsecaseMock.GetJWKS got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetJWKS.GetJWKSMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetJWKS.t.Errorf("UsecaseMock.GetJWKS got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetJWKS.GetJWKSMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetJWKS.GetJWKSMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetJWKS.t.Fatal("No results are set for the UsecaseMock.GetJWKS")
+		}
+		return (*mm_results).jp1, (*mm_results).err
+	}
+	if mmGetJWKS.funcGetJWKS != nil {
+		return mmGetJWKS.funcGetJWKS(ctx)
+	}
+	mmGetJWKS.t.Fatalf("Unexpected call to UsecaseMock.GetJWKS. %v", ctx)
+	return
+}
+
+// GetJWKSAfterCounter returns a count of finished UsecaseMock.GetJWKS invocations
+func (mmGetJWKS *UsecaseMock) GetJWKSAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetJWKS.afterGetJWKSCounter)
+}
+
+// GetJWKSBeforeCounter returns a count of UsecaseMock.GetJWKS invocations
+func (mmGetJWKS *UsecaseMock) GetJWKSBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetJWKS.beforeGetJWKSCounter)
+}
+
+// Calls returns a list of arguments used in each call to UsecaseMock.GetJWKS.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetJWKS *mUsecaseMockGetJWKS) Calls() []*UsecaseMockGetJWKSParams {
+	mmGetJWKS.mutex.RLock()
+
+	argCopy := make([]*UsecaseMockGetJWKSParams, len(mmGetJWKS.callArgs))
+	copy(argCopy, mmGetJWKS.callArgs)
+
+	mmGetJWKS.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetJWKSDone returns true if the count of the GetJWKS invocations corresponds
+// the number of defined expectations
+func (m *UsecaseMock) MinimockGetJWKSDone() bool {
+	if m.GetJWKSMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetJWKSMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetJWKSMock.invocationsDone()
+}
+
+// MinimockGetJWKSInspect logs each unmet expectation
+func (m *UsecaseMock) MinimockGetJWKSInspect() {
+	for _, e := range m.GetJWKSMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UsecaseMock.GetJWKS at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetJWKSCounter := mm_atomic.LoadUint64(&m.afterGetJWKSCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetJWKSMock.defaultExpectation != nil && afterGetJWKSCounter < 1 {
+		if m.GetJWKSMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UsecaseMock.GetJWKS at\n%s", m.GetJWKSMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UsecaseMock.GetJWKS at\n%s with params: %#v", m.GetJWKSMock.defaultExpectation.expectationOrigins.origin, *m.GetJWKSMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetJWKS != nil && afterGetJWKSCounter < 1 {
+		m.t.Errorf("Expected call to UsecaseMock.GetJWKS at\n%s", m.funcGetJWKSOrigin)
+	}
+
+	if !m.GetJWKSMock.invocationsDone() && afterGetJWKSCounter > 0 {
+		m.t.Errorf("Expected %d calls to UsecaseMock.GetJWKS at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetJWKSMock.expectedInvocations), m.GetJWKSMock.expectedInvocationsOrigin, afterGetJWKSCounter)
+	}
+}
+
+type mUsecaseMockGetRevocations struct {
+	optional           bool
+	mock               *UsecaseMock
+	defaultExpectation *UsecaseMockGetRevocationsExpectation
+	expectations       []*UsecaseMockGetRevocationsExpectation
+
+	callArgs []*UsecaseMockGetRevocationsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UsecaseMockGetRevocationsExpectation specifies expectation struct of the Usecase.GetRevocations
+type UsecaseMockGetRevocationsExpectation struct {
+	mock               *UsecaseMock
+	params             *UsecaseMockGetRevocationsParams
+	paramPtrs          *UsecaseMockGetRevocationsParamPtrs
+	expectationOrigins UsecaseMockGetRevocationsExpectationOrigins
+	results            *UsecaseMockGetRevocationsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UsecaseMockGetRevocationsParams contains parameters of the Usecase.GetRevocations
+type UsecaseMockGetRevocationsParams struct {
+	ctx context.Context
+}
+
+// UsecaseMockGetRevocationsParamPtrs contains pointers to parameters of the Usecase.GetRevocations
+type UsecaseMockGetRevocationsParamPtrs struct {
+	ctx *context.Context
+}
+
+// UsecaseMockGetRevocationsResults contains results of the Usecase.GetRevocations
+type UsecaseMockGetRevocationsResults struct {
+	rp1 *dto.RevocationsResponse
+	err error
+}
+
+// UsecaseMockGetRevocationsOrigins contains origins of expectations of the Usecase.GetRevocations
+type UsecaseMockGetRevocationsExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetRevocations *mUsecaseMockGetRevocations) Optional() *mUsecaseMockGetRevocations {
+	mmGetRevocations.optional = true
+	return mmGetRevocations
+}
+
+// Expect sets up expected params for Usecase.GetRevocations
+func (mmGetRevocations *mUsecaseMockGetRevocations) Expect(ctx context.Context) *mUsecaseMockGetRevocations {
+	if mmGetRevocations.mock.funcGetRevocations != nil {
+		mmGetRevocations.mock.t.Fatalf("UsecaseMock.GetRevocations mock is already set by Set")
+	}
+
+	if mmGetRevocations.defaultExpectation == nil {
+		mmGetRevocations.defaultExpectation = &UsecaseMockGetRevocationsExpectation{}
+	}
+
+	if mmGetRevocations.defaultExpectation.paramPtrs != nil {
+		mmGetRevocations.mock.t.Fatalf("UsecaseMock.GetRevocations mock is already set by ExpectParams functions")
+	}
+
+	mmGetRevocations.defaultExpectation.params = &UsecaseMockGetRevocationsParams{ctx}
+	mmGetRevocations.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetRevocations.expectations {
+		if minimock.Equal(e.params, mmGetRevocations.defaultExpectation.params) {
+			mmGetRevocations.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetRevocations.defaultExpectation.params)
+		}
+	}
+
+	return mmGetRevocations
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Usecase.GetRevocations
+func (mmGetRevocations *mUsecaseMockGetRevocations) ExpectCtxParam1(ctx context.Context) *mUsecaseMockGetRevocations {
+	if mmGetRevocations.mock.funcGetRevocations != nil {
+		mmGetRevocations.mock.t.Fatalf("UsecaseMock.GetRevocations mock is already set by Set")
+	}
+
+	if mmGetRevocations.defaultExpectation == nil {
+		mmGetRevocations.defaultExpectation = &UsecaseMockGetRevocationsExpectation{}
+	}
+
+	if mmGetRevocations.defaultExpectation.params != nil {
+		mmGetRevocations.mock.t.Fatalf("UsecaseMock.GetRevocations mock is already set by Expect")
+	}
+
+	if mmGetRevocations.defaultExpectation.paramPtrs == nil {
+		mmGetRevocations.defaultExpectation.paramPtrs = &UsecaseMockGetRevocationsParamPtrs{}
+	}
+	mmGetRevocations.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetRevocations.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetRevocations
+}
+
+// Inspect accepts an inspector function that has same arguments as the Usecase.GetRevocations
+func (mmGetRevocations *mUsecaseMockGetRevocations) Inspect(f func(ctx context.Context)) *mUsecaseMockGetRevocations {
+	if mmGetRevocations.mock.inspectFuncGetRevocations != nil {
+		mmGetRevocations.mock.t.Fatalf("Inspect function is already set for UsecaseMock.GetRevocations")
+	}
+
+	mmGetRevocations.mock.inspectFuncGetRevocations = f
+
+	return mmGetRevocations
+}
+
+// Return sets up results that will be returned by Usecase.GetRevocations
+func (mmGetRevocations *mUsecaseMockGetRevocations) Return(rp1 *dto.RevocationsResponse, err error) *UsecaseMock {
+	if mmGetRevocations.mock.funcGetRevocations != nil {
+		mmGetRevocations.mock.t.Fatalf("UsecaseMock.GetRevocations mock is already set by Set")
+	}
+
+	if mmGetRevocations.defaultExpectation == nil {
+		mmGetRevocations.defaultExpectation = &UsecaseMockGetRevocationsExpectation{mock: mmGetRevocations.mock}
+	}
+	mmGetRevocations.defaultExpectation.results = &UsecaseMockGetRevocationsResults{rp1, err}
+	mmGetRevocations.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetRevocations.mock
+}
+
+// Set uses given function f to mock the Usecase.GetRevocations method
+func (mmGetRevocations *mUsecaseMockGetRevocations) Set(f func(ctx context.Context) (rp1 *dto.RevocationsResponse, err error)) *UsecaseMock {
+	if mmGetRevocations.defaultExpectation != nil {
+		mmGetRevocations.mock.t.Fatalf("Default expectation is already set for the Usecase.GetRevocations method")
+	}
+
+	if len(mmGetRevocations.expectations) > 0 {
+		mmGetRevocations.mock.t.Fatalf("Some expectations are already set for the Usecase.GetRevocations method")
+	}
+
+	mmGetRevocations.mock.funcGetRevocations = f
+	mmGetRevocations.mock.funcGetRevocationsOrigin = minimock.CallerInfo(1)
+	return mmGetRevocations.mock
+}
+
+// When sets expectation for the Usecase.GetRevocations which will trigger the result defined by the following
+// Then helper
+func (mmGetRevocations *mUsecaseMockGetRevocations) When(ctx context.Context) *UsecaseMockGetRevocationsExpectation {
+	if mmGetRevocations.mock.funcGetRevocations != nil {
+		mmGetRevocations.mock.t.Fatalf("UsecaseMock.GetRevocations mock is already set by Set")
+	}
+
+	expectation := &UsecaseMockGetRevocationsExpectation{
+		mock:               mmGetRevocations.mock,
+		params:             &UsecaseMockGetRevocationsParams{ctx},
+		expectationOrigins: UsecaseMockGetRevocationsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetRevocations.expectations = append(mmGetRevocations.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Usecase.GetRevocations return parameters for the expectation previously defined by the When method
+func (e *UsecaseMockGetRevocationsExpectation) Then(rp1 *dto.RevocationsResponse, err error) *UsecaseMock {
+	e.results = &UsecaseMockGetRevocationsResults{rp1, err}
+	return e.mock
+}
+
+// Times sets number of times Usecase.GetRevocations should be invoked
+func (mmGetRevocations *mUsecaseMockGetRevocations) Times(n uint64) *mUsecaseMockGetRevocations {
+	if n == 0 {
+		mmGetRevocations.mock.t.Fatalf("Times of UsecaseMock.GetRevocations mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetRevocations.expectedInvocations, n)
+	mmGetRevocations.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetRevocations
+}
+
+func (mmGetRevocations *mUsecaseMockGetRevocations) invocationsDone() bool {
+	if len(mmGetRevocations.expectations) == 0 && mmGetRevocations.defaultExpectation == nil && mmGetRevocations.mock.funcGetRevocations == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetRevocations.mock.afterGetRevocationsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetRevocations.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetRevocations implements mm_usecase.Usecase
+func (mmGetRevocations *UsecaseMock) GetRevocations(ctx context.Context) (rp1 *dto.RevocationsResponse, err error) {
+	mm_atomic.AddUint64(&mmGetRevocations.beforeGetRevocationsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetRevocations.afterGetRevocationsCounter, 1)
+
+	mmGetRevocations.t.Helper()
+
+	if mmGetRevocations.inspectFuncGetRevocations != nil {
+		mmGetRevocations.inspectFuncGetRevocations(ctx)
+	}
+
+	mm_params := UsecaseMockGetRevocationsParams{ctx}
+
+	// Record call args
+	mmGetRevocations.GetRevocationsMock.mutex.Lock()
+	mmGetRevocations.GetRevocationsMock.callArgs = append(mmGetRevocations.GetRevocationsMock.callArgs, &mm_params)
+	mmGetRevocations.GetRevocationsMock.mutex.Unlock()
+
+	for _, e := range mmGetRevocations.GetRevocationsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rp1, e.results.err
+		}
+	}
+
+	if mmGetRevocations.GetRevocationsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetRevocations.GetRevocationsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetRevocations.GetRevocationsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetRevocations.GetRevocationsMock.defaultExpectation.paramPtrs
+
+		mm_got := UsecaseMockGetRevocationsParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetRevocations.t.Errorf("UsecaseMock.GetRevocations got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetRevocations.GetRevocationsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetRevocations.t.Errorf("UsecaseMock.GetRevocations got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetRevocations.GetRevocationsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetRevocations.GetRevocationsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetRevocations.t.Fatal("No results are set for the UsecaseMock.GetRevocations")
+		}
+		return (*mm_results).rp1, (*mm_results).err
+	}
+	if mmGetRevocations.funcGetRevocations != nil {
+		return mmGetRevocations.funcGetRevocations(ctx)
+	}
+	mmGetRevocations.t.Fatalf("Unexpected call to UsecaseMock.GetRevocations. %v", ctx)
+	return
+}
+
+// GetRevocationsAfterCounter returns a count of finished UsecaseMock.GetRevocations invocations
+func (mmGetRevocations *UsecaseMock) GetRevocationsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetRevocations.afterGetRevocationsCounter)
+}
+
+// GetRevocationsBeforeCounter returns a count of UsecaseMock.GetRevocations invocations
+func (mmGetRevocations *UsecaseMock) GetRevocationsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetRevocations.beforeGetRevocationsCounter)
+}
+
+// Calls returns a list of arguments used in each call to UsecaseMock.GetRevocations.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetRevocations *mUsecaseMockGetRevocations) Calls() []*UsecaseMockGetRevocationsParams {
+	mmGetRevocations.mutex.RLock()
+
+	argCopy := make([]*UsecaseMockGetRevocationsParams, len(mmGetRevocations.callArgs))
+	copy(argCopy, mmGetRevocations.callArgs)
+
+	mmGetRevocations.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetRevocationsDone returns true if the count of the GetRevocations invocations corresponds
+// the number of defined expectations
+func (m *UsecaseMock) MinimockGetRevocationsDone() bool {
+	if m.GetRevocationsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetRevocationsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetRevocationsMock.invocationsDone()
+}
+
+// MinimockGetRevocationsInspect logs each unmet expectation
+func (m *UsecaseMock) MinimockGetRevocationsInspect() {
+	for _, e := range m.GetRevocationsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UsecaseMock.GetRevocations at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetRevocationsCounter := mm_atomic.LoadUint64(&m.afterGetRevocationsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetRevocationsMock.defaultExpectation != nil && afterGetRevocationsCounter < 1 {
+		if m.GetRevocationsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UsecaseMock.GetRevocations at\n%s", m.GetRevocationsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UsecaseMock.GetRevocations at\n%s with params: %#v", m.GetRevocationsMock.defaultExpectation.expectationOrigins.origin, *m.GetRevocationsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetRevocations != nil && afterGetRevocationsCounter < 1 {
+		m.t.Errorf("Expected call to UsecaseMock.GetRevocations at\n%s", m.funcGetRevocationsOrigin)
+	}
+
+	if !m.GetRevocationsMock.invocationsDone() && afterGetRevocationsCounter > 0 {
+		m.t.Errorf("Expected %d calls to UsecaseMock.GetRevocations at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetRevocationsMock.expectedInvocations), m.GetRevocationsMock.expectedInvocationsOrigin, afterGetRevocationsCounter)
+	}
+}
+
+type mUsecaseMockListSessions struct {
+	optional           bool
+	mock               *UsecaseMock
+	defaultExpectation *UsecaseMockListSessionsExpectation
+	expectations       []*UsecaseMockListSessionsExpectation
+
+	callArgs []*UsecaseMockListSessionsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UsecaseMockListSessionsExpectation specifies expectation struct of the Usecase.ListSessions
+type UsecaseMockListSessionsExpectation struct {
+	mock               *UsecaseMock
+	params             *UsecaseMockListSessionsParams
+	paramPtrs          *UsecaseMockListSessionsParamPtrs
+	expectationOrigins UsecaseMockListSessionsExpectationOrigins
+	results            *UsecaseMockListSessionsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UsecaseMockListSessionsParams contains parameters of the Usecase.ListSessions
+type UsecaseMockListSessionsParams struct {
+	ctx    context.Context
+	userID string
+}
+
+// UsecaseMockListSessionsParamPtrs contains pointers to parameters of the Usecase.ListSessions
+type UsecaseMockListSessionsParamPtrs struct {
+	ctx    *context.Context
+	userID *string
+}
+
+// UsecaseMockListSessionsResults contains results of the Usecase.ListSessions
+type UsecaseMockListSessionsResults struct {
+	spa1 []*models.Session
+	err  error
+}
+
+// UsecaseMockListSessionsOrigins contains origins of expectations of the Usecase.ListSessions
+type UsecaseMockListSessionsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListSessions *mUsecaseMockListSessions) Optional() *mUsecaseMockListSessions {
+	mmListSessions.optional = true
+	return mmListSessions
+}
+
+// Expect sets up expected params for Usecase.ListSessions
+func (mmListSessions *mUsecaseMockListSessions) Expect(ctx context.Context, userID string) *mUsecaseMockListSessions {
+	if mmListSessions.mock.funcListSessions != nil {
+		mmListSessions.mock.t.Fatalf("UsecaseMock.ListSessions mock is already set by Set")
+	}
+
+	if mmListSessions.defaultExpectation == nil {
+		mmListSessions.defaultExpectation = &UsecaseMockListSessionsExpectation{}
+	}
+
+	if mmListSessions.defaultExpectation.paramPtrs != nil {
+		mmListSessions.mock.t.Fatalf("UsecaseMock.ListSessions mock is already set by ExpectParams functions")
+	}
+
+	mmListSessions.defaultExpectation.params = &UsecaseMockListSessionsParams{ctx, userID}
+	mmListSessions.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListSessions.expectations {
+		if minimock.Equal(e.params, mmListSessions.defaultExpectation.params) {
+			mmListSessions.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListSessions.defaultExpectation.params)
+		}
+	}
+
+	return mmListSessions
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Usecase.ListSessions
+func (mmListSessions *mUsecaseMockListSessions) ExpectCtxParam1(ctx context.Context) *mUsecaseMockListSessions {
+	if mmListSessions.mock.funcListSessions != nil {
+		mmListSessions.mock.t.Fatalf("UsecaseMock.ListSessions mock is already set by Set")
+	}
+
+	if mmListSessions.defaultExpectation == nil {
+		mmListSessions.defaultExpectation = &UsecaseMockListSessionsExpectation{}
+	}
+
+	if mmListSessions.defaultExpectation.params != nil {
+		mmListSessions.mock.t.Fatalf("UsecaseMock.ListSessions mock is already set by Expect")
+	}
+
+	if mmListSessions.defaultExpectation.paramPtrs == nil {
+		mmListSessions.defaultExpectation.paramPtrs = &UsecaseMockListSessionsParamPtrs{}
+	}
+	mmListSessions.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListSessions.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListSessions
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Usecase.ListSessions
+func (mmListSessions *mUsecaseMockListSessions) ExpectUserIDParam2(userID string) *mUsecaseMockListSessions {
+	if mmListSessions.mock.funcListSessions != nil {
+		mmListSessions.mock.t.Fatalf("UsecaseMock.ListSessions mock is already set by Set")
+	}
+
+	if mmListSessions.defaultExpectation == nil {
+		mmListSessions.defaultExpectation = &UsecaseMockListSessionsExpectation{}
+	}
+
+	if mmListSessions.defaultExpectation.params != nil {
+		mmListSessions.mock.t.Fatalf("UsecaseMock.ListSessions mock is already set by Expect")
+	}
+
+	if mmListSessions.defaultExpectation.paramPtrs == nil {
+		mmListSessions.defaultExpectation.paramPtrs = &UsecaseMockListSessionsParamPtrs{}
+	}
+	mmListSessions.defaultExpectation.paramPtrs.userID = &userID
+	mmListSessions.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmListSessions
+}
+
+// Inspect accepts an inspector function that has same arguments as the Usecase.ListSessions
+func (mmListSessions *mUsecaseMockListSessions) Inspect(f func(ctx context.Context, userID string)) *mUsecaseMockListSessions {
+	if mmListSessions.mock.inspectFuncListSessions != nil {
+		mmListSessions.mock.t.Fatalf("Inspect function is already set for UsecaseMock.ListSessions")
+	}
+
+	mmListSessions.mock.inspectFuncListSessions = f
+
+	return mmListSessions
+}
+
+// Return sets up results that will be returned by Usecase.ListSessions
+func (mmListSessions *mUsecaseMockListSessions) Return(spa1 []*models.Session, err error) *UsecaseMock {
+	if mmListSessions.mock.funcListSessions != nil {
+		mmListSessions.mock.t.Fatalf("UsecaseMock.ListSessions mock is already set by Set")
+	}
+
+	if mmListSessions.defaultExpectation == nil {
+		mmListSessions.defaultExpectation = &UsecaseMockListSessionsExpectation{mock: mmListSessions.mock}
+	}
+	mmListSessions.defaultExpectation.results = &UsecaseMockListSessionsResults{spa1, err}
+	mmListSessions.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListSessions.mock
+}
+
+// Set uses given function f to mock the Usecase.ListSessions method
+func (mmListSessions *mUsecaseMockListSessions) Set(f func(ctx context.Context, userID string) (spa1 []*models.Session, err error)) *UsecaseMock {
+	if mmListSessions.defaultExpectation != nil {
+		mmListSessions.mock.t.Fatalf("Default expectation is already set for the Usecase.ListSessions method")
+	}
+
+	if len(mmListSessions.expectations) > 0 {
+		mmListSessions.mock.t.Fatalf("Some expectations are already set for the Usecase.ListSessions method")
+	}
+
+	mmListSessions.mock.funcListSessions = f
+	mmListSessions.mock.funcListSessionsOrigin = minimock.CallerInfo(1)
+	return mmListSessions.mock
+}
+
+// When sets expectation for the Usecase.ListSessions which will trigger the result defined by the following
+// Then helper
+func (mmListSessions *mUsecaseMockListSessions) When(ctx context.Context, userID string) *UsecaseMockListSessionsExpectation {
+	if mmListSessions.mock.funcListSessions != nil {
+		mmListSessions.mock.t.Fatalf("UsecaseMock.ListSessions mock is already set by Set")
+	}
+
+	expectation := &UsecaseMockListSessionsExpectation{
+		mock:               mmListSessions.mock,
+		params:             &UsecaseMockListSessionsParams{ctx, userID},
+		expectationOrigins: UsecaseMockListSessionsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListSessions.expectations = append(mmListSessions.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Usecase.ListSessions return parameters for the expectation previously defined by the When method
+func (e *UsecaseMockListSessionsExpectation) Then(spa1 []*models.Session, err error) *UsecaseMock {
+	e.results = &UsecaseMockListSessionsResults{spa1, err}
+	return e.mock
+}
+
+// Times sets number of times Usecase.ListSessions should be invoked
+func (mmListSessions *mUsecaseMockListSessions) Times(n uint64) *mUsecaseMockListSessions {
+	if n == 0 {
+		mmListSessions.mock.t.Fatalf("Times of UsecaseMock.ListSessions mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListSessions.expectedInvocations, n)
+	mmListSessions.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListSessions
+}
+
+func (mmListSessions *mUsecaseMockListSessions) invocationsDone() bool {
+	if len(mmListSessions.expectations) == 0 && mmListSessions.defaultExpectation == nil && mmListSessions.mock.funcListSessions == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListSessions.mock.afterListSessionsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListSessions.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListSessions implements mm_usecase.Usecase
+func (mmListSessions *UsecaseMock) ListSessions(ctx context.Context, userID string) (spa1 []*models.Session, err error) {
+	mm_atomic.AddUint64(&mmListSessions.beforeListSessionsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListSessions.afterListSessionsCounter, 1)
+
+	mmListSessions.t.Helper()
+
+	if mmListSessions.inspectFuncListSessions != nil {
+		mmListSessions.inspectFuncListSessions(ctx, userID)
+	}
+
+	mm_params := UsecaseMockListSessionsParams{ctx, userID}
+
+	// Record call args
+	mmListSessions.ListSessionsMock.mutex.Lock()
+	mmListSessions.ListSessionsMock.callArgs = append(mmListSessions.ListSessionsMock.callArgs, &mm_params)
+	mmListSessions.ListSessionsMock.mutex.Unlock()
+
+	for _, e := range mmListSessions.ListSessionsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.spa1, e.results.err
+		}
+	}
+
+	if mmListSessions.ListSessionsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListSessions.ListSessionsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListSessions.ListSessionsMock.defaultExpectation.params
+		mm_want_ptrs := mmListSessions.ListSessionsMock.defaultExpectation.paramPtrs
+
+		mm_got := UsecaseMockListSessionsParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListSessions.t.Errorf("UsecaseMock.ListSessions got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListSessions.ListSessionsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmListSessions.t.Errorf("UsecaseMock.ListSessions got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListSessions.ListSessionsMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListSessions.t.Errorf("UsecaseMock.ListSessions got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListSessions.ListSessionsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListSessions.ListSessionsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListSessions.t.Fatal("No results are set for the UsecaseMock.ListSessions")
+		}
+		return (*mm_results).spa1, (*mm_results).err
+	}
+	if mmListSessions.funcListSessions != nil {
+		return mmListSessions.funcListSessions(ctx, userID)
+	}
+	mmListSessions.t.Fatalf("Unexpected call to UsecaseMock.ListSessions. %v %v", ctx, userID)
+	return
+}
+
+// ListSessionsAfterCounter returns a count of finished UsecaseMock.ListSessions invocations
+func (mmListSessions *UsecaseMock) ListSessionsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListSessions.afterListSessionsCounter)
+}
+
+// ListSessionsBeforeCounter returns a count of UsecaseMock.ListSessions invocations
+func (mmListSessions *UsecaseMock) ListSessionsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListSessions.beforeListSessionsCounter)
+}
+
+// Calls returns a list of arguments used in each call to UsecaseMock.ListSessions.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListSessions *mUsecaseMockListSessions) Calls() []*UsecaseMockListSessionsParams {
+	mmListSessions.mutex.RLock()
+
+	argCopy := make([]*UsecaseMockListSessionsParams, len(mmListSessions.callArgs))
+	copy(argCopy, mmListSessions.callArgs)
+
+	mmListSessions.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListSessionsDone returns true if the count of the ListSessions invocations corresponds
+// the number of defined expectations
+func (m *UsecaseMock) MinimockListSessionsDone() bool {
+	if m.ListSessionsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListSessionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListSessionsMock.invocationsDone()
+}
+
+// MinimockListSessionsInspect logs each unmet expectation
+func (m *UsecaseMock) MinimockListSessionsInspect() {
+	for _, e := range m.ListSessionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UsecaseMock.ListSessions at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListSessionsCounter := mm_atomic.LoadUint64(&m.afterListSessionsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListSessionsMock.defaultExpectation != nil && afterListSessionsCounter < 1 {
+		if m.ListSessionsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UsecaseMock.ListSessions at\n%s", m.ListSessionsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UsecaseMock.ListSessions at\n%s with params: %#v", m.ListSessionsMock.defaultExpectation.expectationOrigins.origin, *m.ListSessionsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListSessions != nil && afterListSessionsCounter < 1 {
+		m.t.Errorf("Expected call to UsecaseMock.ListSessions at\n%s", m.funcListSessionsOrigin)
+	}
+
+	if !m.ListSessionsMock.invocationsDone() && afterListSessionsCounter > 0 {
+		m.t.Errorf("Expected %d calls to UsecaseMock.ListSessions at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListSessionsMock.expectedInvocations), m.ListSessionsMock.expectedInvocationsOrigin, afterListSessionsCounter)
+	}
+}
+
+type mUsecaseMockLogin struct {
+	optional           bool
+	mock               *UsecaseMock
+	defaultExpectation *UsecaseMockLoginExpectation
+	expectations       []*UsecaseMockLoginExpectation
+
+	callArgs []*UsecaseMockLoginParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UsecaseMockLoginExpectation specifies expectation struct of the Usecase.Login
+type UsecaseMockLoginExpectation struct {
+	mock               *UsecaseMock
+	params             *UsecaseMockLoginParams
+	paramPtrs          *UsecaseMockLoginParamPtrs
+	expectationOrigins UsecaseMockLoginExpectationOrigins
+	results            *UsecaseMockLoginResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UsecaseMockLoginParams contains parameters of the Usecase.Login
+type UsecaseMockLoginParams struct {
+	ctx context.Context
+	req dto.LoginRequest
+}
+
+// UsecaseMockLoginParamPtrs contains pointers to parameters of the Usecase.Login
+type UsecaseMockLoginParamPtrs struct {
+	ctx *context.Context
+	req *dto.LoginRequest
+}
+
+// UsecaseMockLoginResults contains results of the Usecase.Login
+type UsecaseMockLoginResults struct {
+	up1 *models.User
+	err error
+}
+
+// UsecaseMockLoginOrigins contains origins of expectations of the Usecase.Login
+type UsecaseMockLoginExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originReq string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLogin *mUsecaseMockLogin) Optional() *mUsecaseMockLogin {
+	mmLogin.optional = true
+	return mmLogin
+}
+
+// Expect sets up expected params for Usecase.Login
+func (mmLogin *mUsecaseMockLogin) Expect(ctx context.Context, req dto.LoginRequest) *mUsecaseMockLogin {
+	if mmLogin.mock.funcLogin != nil {
+		mmLogin.mock.t.Fatalf("UsecaseMock.Login mock is already set by Set")
+	}
+
+	if mmLogin.defaultExpectation == nil {
+		mmLogin.defaultExpectation = &UsecaseMockLoginExpectation{}
+	}
+
+	if mmLogin.defaultExpectation.paramPtrs != nil {
+		mmLogin.mock.t.Fatalf("UsecaseMock.Login mock is already set by ExpectParams functions")
+	}
+
+	mmLogin.defaultExpectation.params = &UsecaseMockLoginParams{ctx, req}
+	mmLogin.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmLogin.expectations {
+		if minimock.Equal(e.params, mmLogin.defaultExpectation.params) {
+			mmLogin.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLogin.defaultExpectation.params)
+		}
+	}
+
+	return mmLogin
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Usecase.Login
+func (mmLogin *mUsecaseMockLogin) ExpectCtxParam1(ctx context.Context) *mUsecaseMockLogin {
+	if mmLogin.mock.funcLogin != nil {
+		mmLogin.mock.t.Fatalf("UsecaseMock.Login mock is already set by Set")
+	}
+
+	if mmLogin.defaultExpectation == nil {
+		mmLogin.defaultExpectation = &UsecaseMockLoginExpectation{}
+	}
+
+	if mmLogin.defaultExpectation.params != nil {
+		mmLogin.mock.t.Fatalf("UsecaseMock.Login mock is already set by Expect")
+	}
+
+	if mmLogin.defaultExpectation.paramPtrs == nil {
+		mmLogin.defaultExpectation.paramPtrs = &UsecaseMockLoginParamPtrs{}
+	}
+	mmLogin.defaultExpectation.paramPtrs.ctx = &ctx
+	mmLogin.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmLogin
+}
+
+// ExpectReqParam2 sets up expected param req for Usecase.Login
+func (mmLogin *mUsecaseMockLogin) ExpectReqParam2(req dto.LoginRequest) *mUsecaseMockLogin {
+	if mmLogin.mock.funcLogin != nil {
+		mmLogin.mock.t.Fatalf("UsecaseMock.Login mock is already set by Set")
+	}
+
+	if mmLogin.defaultExpectation == nil {
+		mmLogin.defaultExpectation = &UsecaseMockLoginExpectation{}
+	}
+
+	if mmLogin.defaultExpectation.params != nil {
+		mmLogin.mock.t.Fatalf("UsecaseMock.Login mock is already set by Expect")
+	}
+
+	if mmLogin.defaultExpectation.paramPtrs == nil {
+		mmLogin.defaultExpectation.paramPtrs = &UsecaseMockLoginParamPtrs{}
+	}
+	mmLogin.defaultExpectation.paramPtrs.req = &req
+	mmLogin.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+
+	return mmLogin
+}
+
+// Inspect accepts an inspector function that has same arguments as the Usecase.Login
+func (mmLogin *mUsecaseMockLogin) Inspect(f func(ctx context.Context, req dto.LoginRequest)) *mUsecaseMockLogin {
+	if mmLogin.mock.inspectFuncLogin != nil {
+		mmLogin.mock.t.Fatalf("Inspect function is already set for UsecaseMock.Login")
+	}
+
+	mmLogin.mock.inspectFuncLogin = f
+
+	return mmLogin
+}
+
+// Return sets up results that will be returned by Usecase.Login
+func (mmLogin *mUsecaseMockLogin) Return(up1 *models.User, err error) *UsecaseMock {
+	if mmLogin.mock.funcLogin != nil {
+		mmLogin.mock.t.Fatalf("UsecaseMock.Login mock is already set by Set")
+	}
+
+	if mmLogin.defaultExpectation == nil {
+		mmLogin.defaultExpectation = &UsecaseMockLoginExpectation{mock: mmLogin.mock}
+	}
+	mmLogin.defaultExpectation.results = &UsecaseMockLoginResults{up1, err}
+	mmLogin.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmLogin.mock
+}
+
+// Set uses given function f to mock the Usecase.Login method
+func (mmLogin *mUsecaseMockLogin) Set(f func(ctx context.Context, req dto.LoginRequest) (up1 *models.User, err error)) *UsecaseMock {
+	if mmLogin.defaultExpectation != nil {
+		mmLogin.mock.t.Fatalf("Default expectation is already set for the Usecase.Login method")
+	}
+
+	if len(mmLogin.expectations) > 0 {
+		mmLogin.mock.t.Fatalf("Some expectations are already set for the Usecase.Login method")
+	}
+
+	mmLogin.mock.funcLogin = f
+	mmLogin.mock.funcLoginOrigin = minimock.CallerInfo(1)
+	return mmLogin.mock
+}
+
+// When sets expectation for the Usecase.Login which will trigger the result defined by the following
+// Then helper
+func (mmLogin *mUsecaseMockLogin) When(ctx context.Context, req dto.LoginRequest) *UsecaseMockLoginExpectation {
+	if mmLogin.mock.funcLogin != nil {
+		mmLogin.mock.t.Fatalf("UsecaseMock.Login mock is already set by Set")
+	}
+
+	expectation := &UsecaseMockLoginExpectation{
+		mock:               mmLogin.mock,
+		params:             &UsecaseMockLoginParams{ctx, req},
+		expectationOrigins: UsecaseMockLoginExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmLogin.expectations = append(mmLogin.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Usecase.Login return parameters for the expectation previously defined by the When method
+func (e *UsecaseMockLoginExpectation) Then(up1 *models.User, err error) *UsecaseMock {
+	e.results = &UsecaseMockLoginResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times Usecase.Login should be invoked
+func (mmLogin *mUsecaseMockLogin) Times(n uint64) *mUsecaseMockLogin {
+	if n == 0 {
+		mmLogin.mock.t.Fatalf("Times of UsecaseMock.Login mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLogin.expectedInvocations, n)
+	mmLogin.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmLogin
+}
+
+func (mmLogin *mUsecaseMockLogin) invocationsDone() bool {
+	if len(mmLogin.expectations) == 0 && mmLogin.defaultExpectation == nil && mmLogin.mock.funcLogin == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLogin.mock.afterLoginCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLogin.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Login implements mm_usecase.Usecase
+func (mmLogin *UsecaseMock) Login(ctx context.Context, req dto.LoginRequest) (up1 *models.User, err error) {
+	mm_atomic.AddUint64(&mmLogin.beforeLoginCounter, 1)
+	defer mm_atomic.AddUint64(&mmLogin.afterLoginCounter, 1)
+
+	mmLogin.t.Helper()
+
+	if mmLogin.inspectFuncLogin != nil {
+		mmLogin.inspectFuncLogin(ctx, req)
+	}
+
+	mm_params := UsecaseMockLoginParams{ctx, req}
+
+	// Record call args
+	mmLogin.LoginMock.mutex.Lock()
+	mmLogin.LoginMock.callArgs = append(mmLogin.LoginMock.callArgs, &mm_params)
+	mmLogin.LoginMock.mutex.Unlock()
+
+	for _, e := range mmLogin.LoginMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmLogin.LoginMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLogin.LoginMock.defaultExpectation.Counter, 1)
+		mm_want := mmLogin.LoginMock.defaultExpectation.params
+		mm_want_ptrs := mmLogin.LoginMock.defaultExpectation.paramPtrs
+
+		mm_got := UsecaseMockLoginParams{ctx, req}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLogin.t.Errorf("UsecaseMock.Login got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLogin.LoginMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmLogin.t.Errorf("UsecaseMock.Login got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLogin.LoginMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLogin.t.Errorf("UsecaseMock.Login got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmLogin.LoginMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLogin.LoginMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLogin.t.Fatal("No results are set for the UsecaseMock.Login")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmLogin.funcLogin != nil {
+		return mmLogin.funcLogin(ctx, req)
+	}
+	mmLogin.t.Fatalf("Unexpected call to UsecaseMock.Login. %v %v", ctx, req)
+	return
+}
+
+// LoginAfterCounter returns a count of finished UsecaseMock.Login invocations
+func (mmLogin *UsecaseMock) LoginAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLogin.afterLoginCounter)
+}
+
+// LoginBeforeCounter returns a count of UsecaseMock.Login invocations
+func (mmLogin *UsecaseMock) LoginBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLogin.beforeLoginCounter)
+}
+
+// Calls returns a list of arguments used in each call to UsecaseMock.Login.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLogin *mUsecaseMockLogin) Calls() []*UsecaseMockLoginParams {
+	mmLogin.mutex.RLock()
+
+	argCopy := make([]*UsecaseMockLoginParams, len(mmLogin.callArgs))
+	copy(argCopy, mmLogin.callArgs)
+
+	mmLogin.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLoginDone returns true if the count of the Login invocations corresponds
+// the number of defined expectations
+func (m *UsecaseMock) MinimockLoginDone() bool {
+	if m.LoginMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LoginMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LoginMock.invocationsDone()
+}
+
+// MinimockLoginInspect logs each unmet expectation
+func (m *UsecaseMock) MinimockLoginInspect() {
+	for _, e := range m.LoginMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UsecaseMock.Login at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterLoginCounter := mm_atomic.LoadUint64(&m.afterLoginCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LoginMock.defaultExpectation != nil && afterLoginCounter < 1 {
+		if m.LoginMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UsecaseMock.Login at\n%s", m.LoginMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UsecaseMock.Login at\n%s with params: %#v", m.LoginMock.defaultExpectation.expectationOrigins.origin, *m.LoginMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLogin != nil && afterLoginCounter < 1 {
+		m.t.Errorf("Expected call to UsecaseMock.Login at\n%s", m.funcLoginOrigin)
+	}
+
+	if !m.LoginMock.invocationsDone() && afterLoginCounter > 0 {
+		m.t.Errorf("Expected %d calls to UsecaseMock.Login at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.LoginMock.expectedInvocations), m.LoginMock.expectedInvocationsOrigin, afterLoginCounter)
+	}
+}
+
+type mUsecaseMockLogout struct {
+	optional           bool
+	mock               *UsecaseMock
+	defaultExpectation *UsecaseMockLogoutExpectation
+	expectations       []*UsecaseMockLogoutExpectation
+
+	callArgs []*UsecaseMockLogoutParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UsecaseMockLogoutExpectation specifies expectation struct of the Usecase.Logout
+type UsecaseMockLogoutExpectation struct {
+	mock               *UsecaseMock
+	params             *UsecaseMockLogoutParams
+	paramPtrs          *UsecaseMockLogoutParamPtrs
+	expectationOrigins UsecaseMockLogoutExpectationOrigins
+	results            *UsecaseMockLogoutResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UsecaseMockLogoutParams contains parameters of the Usecase.Logout
+type UsecaseMockLogoutParams struct {
+	ctx context.Context
+	req dto.LogoutRequest
+}
+
+// UsecaseMockLogoutParamPtrs contains pointers to parameters of the Usecase.Logout
+type UsecaseMockLogoutParamPtrs struct {
+	ctx *context.Context
+	req *dto.LogoutRequest
+}
+
+// UsecaseMockLogoutResults contains results of the Usecase.Logout
+type UsecaseMockLogoutResults struct {
+	err error
+}
+
+// UsecaseMockLogoutOrigins contains origins of expectations of the Usecase.Logout
+type UsecaseMockLogoutExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originReq string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLogout *mUsecaseMockLogout) Optional() *mUsecaseMockLogout {
+	mmLogout.optional = true
+	return mmLogout
+}
+
+// Expect sets up expected params for Usecase.Logout
+func (mmLogout *mUsecaseMockLogout) Expect(ctx context.Context, req dto.LogoutRequest) *mUsecaseMockLogout {
+	if mmLogout.mock.funcLogout != nil {
+		mmLogout.mock.t.Fatalf("UsecaseMock.Logout mock is already set by Set")
+	}
+
+	if mmLogout.defaultExpectation == nil {
+		mmLogout.defaultExpectation = &UsecaseMockLogoutExpectation{}
+	}
+
+	if mmLogout.defaultExpectation.paramPtrs != nil {
+		mmLogout.mock.t.Fatalf("UsecaseMock.Logout mock is already set by ExpectParams functions")
+	}
+
+	mmLogout.defaultExpectation.params = &UsecaseMockLogoutParams{ctx, req}
+	mmLogout.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmLogout.expectations {
+		if minimock.Equal(e.params, mmLogout.defaultExpectation.params) {
+			mmLogout.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLogout.defaultExpectation.params)
+		}
+	}
+
+	return mmLogout
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Usecase.Logout
+func (mmLogout *mUsecaseMockLogout) ExpectCtxParam1(ctx context.Context) *mUsecaseMockLogout {
+	if mmLogout.mock.funcLogout != nil {
+		mmLogout.mock.t.Fatalf("UsecaseMock.Logout mock is already set by Set")
+	}
+
+	if mmLogout.defaultExpectation == nil {
+		mmLogout.defaultExpectation = &UsecaseMockLogoutExpectation{}
+	}
+
+	if mmLogout.defaultExpectation.params != nil {
+		mmLogout.mock.t.Fatalf("UsecaseMock.Logout mock is already set by Expect")
+	}
+
+	if mmLogout.defaultExpectation.paramPtrs == nil {
+		mmLogout.defaultExpectation.paramPtrs = &UsecaseMockLogoutParamPtrs{}
+	}
+	mmLogout.defaultExpectation.paramPtrs.ctx = &ctx
+	mmLogout.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmLogout
+}
+
+// ExpectReqParam2 sets up expected param req for Usecase.Logout
+func (mmLogout *mUsecaseMockLogout) ExpectReqParam2(req dto.LogoutRequest) *mUsecaseMockLogout {
+	if mmLogout.mock.funcLogout != nil {
+		mmLogout.mock.t.Fatalf("UsecaseMock.Logout mock is already set by Set")
+	}
+
+	if mmLogout.defaultExpectation == nil {
+		mmLogout.defaultExpectation = &UsecaseMockLogoutExpectation{}
+	}
+
+	if mmLogout.defaultExpectation.params != nil {
+		mmLogout.mock.t.Fatalf("UsecaseMock.Logout mock is already set by Expect")
+	}
+
+	if mmLogout.defaultExpectation.paramPtrs == nil {
+		mmLogout.defaultExpectation.paramPtrs = &UsecaseMockLogoutParamPtrs{}
+	}
+	mmLogout.defaultExpectation.paramPtrs.req = &req
+	mmLogout.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+
+	return mmLogout
+}
+
+// Inspect accepts an inspector function that has same arguments as the Usecase.Logout
+func (mmLogout *mUsecaseMockLogout) Inspect(f func(ctx context.Context, req dto.LogoutRequest)) *mUsecaseMockLogout {
+	if mmLogout.mock.inspectFuncLogout != nil {
+		mmLogout.mock.t.Fatalf("Inspect function is already set for UsecaseMock.Logout")
+	}
+
+	mmLogout.mock.inspectFuncLogout = f
+
+	return mmLogout
+}
+
+// Return sets up results that will be returned by Usecase.Logout
+func (mmLogout *mUsecaseMockLogout) Return(err error) *UsecaseMock {
+	if mmLogout.mock.funcLogout != nil {
+		mmLogout.mock.t.Fatalf("UsecaseMock.Logout mock is already set by Set")
+	}
+
+	if mmLogout.defaultExpectation == nil {
+		mmLogout.defaultExpectation = &UsecaseMockLogoutExpectation{mock: mmLogout.mock}
+	}
+	mmLogout.defaultExpectation.results = &UsecaseMockLogoutResults{err}
+	mmLogout.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmLogout.mock
+}
+
+// Set uses given function f to mock the Usecase.Logout method
+func (mmLogout *mUsecaseMockLogout) Set(f func(ctx context.Context, req dto.LogoutRequest) (err error)) *UsecaseMock {
+	if mmLogout.defaultExpectation != nil {
+		mmLogout.mock.t.Fatalf("Default expectation is already set for the Usecase.Logout method")
+	}
+
+	if len(mmLogout.expectations) > 0 {
+		mmLogout.mock.t.Fatalf("Some expectations are already set for the Usecase.Logout method")
+	}
+
+	mmLogout.mock.funcLogout = f
+	mmLogout.mock.funcLogoutOrigin = minimock.CallerInfo(1)
+	return mmLogout.mock
+}
+
+// When sets expectation for the Usecase.Logout which will trigger the result defined by the following
+// Then helper
+func (mmLogout *mUsecaseMockLogout) When(ctx context.Context, req dto.LogoutRequest) *UsecaseMockLogoutExpectation {
+	if mmLogout.mock.funcLogout != nil {
+		mmLogout.mock.t.Fatalf("UsecaseMock.Logout mock is already set by Set")
+	}
+
+	expectation := &UsecaseMockLogoutExpectation{
+		mock:               mmLogout.mock,
+		params:             &UsecaseMockLogoutParams{ctx, req},
+		expectationOrigins: UsecaseMockLogoutExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmLogout.expectations = append(mmLogout.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Usecase.Logout return parameters for the expectation previously defined by the When method
+func (e *UsecaseMockLogoutExpectation) Then(err error) *UsecaseMock {
+	e.results = &UsecaseMockLogoutResults{err}
+	return e.mock
+}
+
+// Times sets number of times Usecase.Logout should be invoked
+func (mmLogout *mUsecaseMockLogout) Times(n uint64) *mUsecaseMockLogout {
+	if n == 0 {
+		mmLogout.mock.t.Fatalf("Times of UsecaseMock.Logout mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLogout.expectedInvocations, n)
+	mmLogout.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmLogout
+}
+
+func (mmLogout *mUsecaseMockLogout) invocationsDone() bool {
+	if len(mmLogout.expectations) == 0 && mmLogout.defaultExpectation == nil && mmLogout.mock.funcLogout == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLogout.mock.afterLogoutCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLogout.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Logout implements mm_usecase.Usecase
+func (mmLogout *UsecaseMock) Logout(ctx context.Context, req dto.LogoutRequest) (err error) {
+	mm_atomic.AddUint64(&mmLogout.beforeLogoutCounter, 1)
+	defer mm_atomic.AddUint64(&mmLogout.afterLogoutCounter, 1)
+
+	mmLogout.t.Helper()
+
+	if mmLogout.inspectFuncLogout != nil {
+		mmLogout.inspectFuncLogout(ctx, req)
+	}
+
+	mm_params := UsecaseMockLogoutParams{ctx, req}
+
+	// Record call args
+	mmLogout.LogoutMock.mutex.Lock()
+	mmLogout.LogoutMock.callArgs = append(mmLogout.LogoutMock.callArgs, &mm_params)
+	mmLogout.LogoutMock.mutex.Unlock()
+
+	for _, e := range mmLogout.LogoutMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmLogout.LogoutMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLogout.LogoutMock.defaultExpectation.Counter, 1)
+		mm_want := mmLogout.LogoutMock.defaultExpectation.params
+		mm_want_ptrs := mmLogout.LogoutMock.defaultExpectation.paramPtrs
+
+		mm_got := UsecaseMockLogoutParams{ctx, req}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLogout.t.Errorf("UsecaseMock.Logout got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLogout.LogoutMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmLogout.t.Errorf("UsecaseMock.Logout got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLogout.LogoutMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLogout.t.Errorf("UsecaseMock.Logout got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmLogout.LogoutMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLogout.LogoutMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLogout.t.Fatal("No results are set for the UsecaseMock.Logout")
+		}
+		return (*mm_results).err
+	}
+	if mmLogout.funcLogout != nil {
+		return mmLogout.funcLogout(ctx, req)
+	}
+	mmLogout.t.Fatalf("Unexpected call to UsecaseMock.Logout. %v %v", ctx, req)
+	return
+}
+
+// LogoutAfterCounter returns a count of finished UsecaseMock.Logout invocations
+func (mmLogout *UsecaseMock) LogoutAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLogout.afterLogoutCounter)
+}
+
+// LogoutBeforeCounter returns a count of UsecaseMock.Logout invocations
+func (mmLogout *UsecaseMock) LogoutBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLogout.beforeLogoutCounter)
+}
+
+// Calls returns a list of arguments used in each call to UsecaseMock.Logout.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLogout *mUsecaseMockLogout) Calls() []*UsecaseMockLogoutParams {
+	mmLogout.mutex.RLock()
+
+	argCopy := make([]*UsecaseMockLogoutParams, len(mmLogout.callArgs))
+	copy(argCopy, mmLogout.callArgs)
+
+	mmLogout.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLogoutDone returns true if the count of the Logout invocations corresponds
+// the number of defined expectations
+func (m *UsecaseMock) MinimockLogoutDone() bool {
+	if m.LogoutMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LogoutMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LogoutMock.invocationsDone()
+}
+
+// MinimockLogoutInspect logs each unmet expectation
+func (m *UsecaseMock) MinimockLogoutInspect() {
+	for _, e := range m.LogoutMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UsecaseMock.Logout at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterLogoutCounter := mm_atomic.LoadUint64(&m.afterLogoutCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LogoutMock.defaultExpectation != nil && afterLogoutCounter < 1 {
+		if m.LogoutMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UsecaseMock.Logout at\n%s", m.LogoutMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UsecaseMock.Logout at\n%s with params: %#v", m.LogoutMock.defaultExpectation.expectationOrigins.origin, *m.LogoutMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLogout != nil && afterLogoutCounter < 1 {
+		m.t.Errorf("Expected call to UsecaseMock.Logout at\n%s", m.funcLogoutOrigin)
+	}
+
+	if !m.LogoutMock.invocationsDone() && afterLogoutCounter > 0 {
+		m.t.Errorf("Expected %d calls to UsecaseMock.Logout at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.LogoutMock.expectedInvocations), m.LogoutMock.expectedInvocationsOrigin, afterLogoutCounter)
+	}
+}
+
+type mUsecaseMockRefresh struct {
+	optional           bool
+	mock               *UsecaseMock
+	defaultExpectation *UsecaseMockRefreshExpectation
+	expectations       []*UsecaseMockRefreshExpectation
+
+	callArgs []*UsecaseMockRefreshParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UsecaseMockRefreshExpectation specifies expectation struct of the Usecase.Refresh
+type UsecaseMockRefreshExpectation struct {
+	mock               *UsecaseMock
+	params             *UsecaseMockRefreshParams
+	paramPtrs          *UsecaseMockRefreshParamPtrs
+	expectationOrigins UsecaseMockRefreshExpectationOrigins
+	results            *UsecaseMockRefreshResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UsecaseMockRefreshParams contains parameters of the Usecase.Refresh
+type UsecaseMockRefreshParams struct {
+	ctx context.Context
+	req dto.RefreshRequest
+}
+
+// UsecaseMockRefreshParamPtrs contains pointers to parameters of the Usecase.Refresh
+type UsecaseMockRefreshParamPtrs struct {
+	ctx *context.Context
+	req *dto.RefreshRequest
+}
+
+// UsecaseMockRefreshResults contains results of the Usecase.Refresh
+type UsecaseMockRefreshResults struct {
+	up1 *models.User
+	err error
+}
+
+// UsecaseMockRefreshOrigins contains origins of expectations of the Usecase.Refresh
+type UsecaseMockRefreshExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originReq string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRefresh *mUsecaseMockRefresh) Optional() *mUsecaseMockRefresh {
+	mmRefresh.optional = true
+	return mmRefresh
+}
+
+// Expect sets up expected params for Usecase.Refresh
+func (mmRefresh *mUsecaseMockRefresh) Expect(ctx context.Context, req dto.RefreshRequest) *mUsecaseMockRefresh {
+	if mmRefresh.mock.funcRefresh != nil {
+		mmRefresh.mock.t.Fatalf("UsecaseMock.Refresh mock is already set by Set")
+	}
+
+	if mmRefresh.defaultExpectation == nil {
+		mmRefresh.defaultExpectation = &UsecaseMockRefreshExpectation{}
+	}
+
+	if mmRefresh.defaultExpectation.paramPtrs != nil {
+		mmRefresh.mock.t.Fatalf("UsecaseMock.Refresh mock is already set by ExpectParams functions")
+	}
+
+	mmRefresh.defaultExpectation.params = &UsecaseMockRefreshParams{ctx, req}
+	mmRefresh.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRefresh.expectations {
+		if minimock.Equal(e.params, mmRefresh.defaultExpectation.params) {
+			mmRefresh.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRefresh.defaultExpectation.params)
+		}
+	}
+
+	return mmRefresh
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Usecase.Refresh
+func (mmRefresh *mUsecaseMockRefresh) ExpectCtxParam1(ctx context.Context) *mUsecaseMockRefresh {
+	if mmRefresh.mock.funcRefresh != nil {
+		mmRefresh.mock.t.Fatalf("UsecaseMock.Refresh mock is already set by Set")
+	}
+
+	if mmRefresh.defaultExpectation == nil {
+		mmRefresh.defaultExpectation = &UsecaseMockRefreshExpectation{}
+	}
+
+	if mmRefresh.defaultExpectation.params != nil {
+		mmRefresh.mock.t.Fatalf("UsecaseMock.Refresh mock is already set by Expect")
+	}
+
+	if mmRefresh.defaultExpectation.paramPtrs == nil {
+		mmRefresh.defaultExpectation.paramPtrs = &UsecaseMockRefreshParamPtrs{}
+	}
+	mmRefresh.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRefresh.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRefresh
+}
+
+// ExpectReqParam2 sets up expected param req for Usecase.Refresh
+func (mmRefresh *mUsecaseMockRefresh) ExpectReqParam2(req dto.RefreshRequest) *mUsecaseMockRefresh {
+	if mmRefresh.mock.funcRefresh != nil {
+		mmRefresh.mock.t.Fatalf("UsecaseMock.Refresh mock is already set by Set")
+	}
+
+	if mmRefresh.defaultExpectation == nil {
+		mmRefresh.defaultExpectation = &UsecaseMockRefreshExpectation{}
+	}
+
+	if mmRefresh.defaultExpectation.params != nil {
+		mmRefresh.mock.t.Fatalf("UsecaseMock.Refresh mock is already set by Expect")
+	}
+
+	if mmRefresh.defaultExpectation.paramPtrs == nil {
+		mmRefresh.defaultExpectation.paramPtrs = &UsecaseMockRefreshParamPtrs{}
+	}
+	mmRefresh.defaultExpectation.paramPtrs.req = &req
+	mmRefresh.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+
+	return mmRefresh
+}
+
+// Inspect accepts an inspector function that has same arguments as the Usecase.Refresh
+func (mmRefresh *mUsecaseMockRefresh) Inspect(f func(ctx context.Context, req dto.RefreshRequest)) *mUsecaseMockRefresh {
+	if mmRefresh.mock.inspectFuncRefresh != nil {
+		mmRefresh.mock.t.Fatalf("Inspect function is already set for UsecaseMock.Refresh")
+	}
+
+	mmRefresh.mock.inspectFuncRefresh = f
+
+	return mmRefresh
 }
 
 // Return sets up results that will be returned by Usecase.Refresh
@@ -552,246 +3008,1958 @@ func (mmRefresh *mUsecaseMockRefresh) Return(up1 *models.User, err error) *Useca
 		mmRefresh.mock.t.Fatalf("UsecaseMock.Refresh mock is already set by Set")
 	}
 
-	if mmRefresh.defaultExpectation == nil {
-		mmRefresh.defaultExpectation = &UsecaseMockRefreshExpectation{mock: mmRefresh.mock}
+	if mmRefresh.defaultExpectation == nil {
+		mmRefresh.defaultExpectation = &UsecaseMockRefreshExpectation{mock: mmRefresh.mock}
+	}
+	mmRefresh.defaultExpectation.results = &UsecaseMockRefreshResults{up1, err}
+	mmRefresh.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRefresh.mock
+}
+
+// Set uses given function f to mock the Usecase.Refresh method
+func (mmRefresh *mUsecaseMockRefresh) Set(f func(ctx context.Context, req dto.RefreshRequest) (up1 *models.User, err error)) *UsecaseMock {
+	if mmRefresh.defaultExpectation != nil {
+		mmRefresh.mock.t.Fatalf("Default expectation is already set for the Usecase.Refresh method")
+	}
+
+	if len(mmRefresh.expectations) > 0 {
+		mmRefresh.mock.t.Fatalf("Some expectations are already set for the Usecase.Refresh method")
+	}
+
+	mmRefresh.mock.funcRefresh = f
+	mmRefresh.mock.funcRefreshOrigin = minimock.CallerInfo(1)
+	return mmRefresh.mock
+}
+
+// When sets expectation for the Usecase.Refresh which will trigger the result defined by the following
+// Then helper
+func (mmRefresh *mUsecaseMockRefresh) When(ctx context.Context, req dto.RefreshRequest) *UsecaseMockRefreshExpectation {
+	if mmRefresh.mock.funcRefresh != nil {
+		mmRefresh.mock.t.Fatalf("UsecaseMock.Refresh mock is already set by Set")
+	}
+
+	expectation := &UsecaseMockRefreshExpectation{
+		mock:               mmRefresh.mock,
+		params:             &UsecaseMockRefreshParams{ctx, req},
+		expectationOrigins: UsecaseMockRefreshExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRefresh.expectations = append(mmRefresh.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Usecase.Refresh return parameters for the expectation previously defined by the When method
+func (e *UsecaseMockRefreshExpectation) Then(up1 *models.User, err error) *UsecaseMock {
+	e.results = &UsecaseMockRefreshResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times Usecase.Refresh should be invoked
+func (mmRefresh *mUsecaseMockRefresh) Times(n uint64) *mUsecaseMockRefresh {
+	if n == 0 {
+		mmRefresh.mock.t.Fatalf("Times of UsecaseMock.Refresh mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRefresh.expectedInvocations, n)
+	mmRefresh.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRefresh
+}
+
+func (mmRefresh *mUsecaseMockRefresh) invocationsDone() bool {
+	if len(mmRefresh.expectations) == 0 && mmRefresh.defaultExpectation == nil && mmRefresh.mock.funcRefresh == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRefresh.mock.afterRefreshCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRefresh.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Refresh implements mm_usecase.Usecase
+func (mmRefresh *UsecaseMock) Refresh(ctx context.Context, req dto.RefreshRequest) (up1 *models.User, err error) {
+	mm_atomic.AddUint64(&mmRefresh.beforeRefreshCounter, 1)
+	defer mm_atomic.AddUint64(&mmRefresh.afterRefreshCounter, 1)
+
+	mmRefresh.t.Helper()
+
+	if mmRefresh.inspectFuncRefresh != nil {
+		mmRefresh.inspectFuncRefresh(ctx, req)
+	}
+
+	mm_params := UsecaseMockRefreshParams{ctx, req}
+
+	// Record call args
+	mmRefresh.RefreshMock.mutex.Lock()
+	mmRefresh.RefreshMock.callArgs = append(mmRefresh.RefreshMock.callArgs, &mm_params)
+	mmRefresh.RefreshMock.mutex.Unlock()
+
+	for _, e := range mmRefresh.RefreshMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmRefresh.RefreshMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRefresh.RefreshMock.defaultExpectation.Counter, 1)
+		mm_want := mmRefresh.RefreshMock.defaultExpectation.params
+		mm_want_ptrs := mmRefresh.RefreshMock.defaultExpectation.paramPtrs
+
+		mm_got := UsecaseMockRefreshParams{ctx, req}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRefresh.t.Errorf("UsecaseMock.Refresh got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRefresh.RefreshMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmRefresh.t.Errorf("UsecaseMock.Refresh got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRefresh.RefreshMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRefresh.t.Errorf("UsecaseMock.Refresh got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRefresh.RefreshMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRefresh.RefreshMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRefresh.t.Fatal("No results are set for the UsecaseMock.Refresh")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmRefresh.funcRefresh != nil {
+		return mmRefresh.funcRefresh(ctx, req)
+	}
+	mmRefresh.t.Fatalf("Unexpected call to UsecaseMock.Refresh. %v %v", ctx, req)
+	return
+}
+
+// RefreshAfterCounter returns a count of finished UsecaseMock.Refresh invocations
+func (mmRefresh *UsecaseMock) RefreshAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRefresh.afterRefreshCounter)
+}
+
+// RefreshBeforeCounter returns a count of UsecaseMock.Refresh invocations
+func (mmRefresh *UsecaseMock) RefreshBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRefresh.beforeRefreshCounter)
+}
+
+// Calls returns a list of arguments used in each call to UsecaseMock.Refresh.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRefresh *mUsecaseMockRefresh) Calls() []*UsecaseMockRefreshParams {
+	mmRefresh.mutex.RLock()
+
+	argCopy := make([]*UsecaseMockRefreshParams, len(mmRefresh.callArgs))
+	copy(argCopy, mmRefresh.callArgs)
+
+	mmRefresh.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRefreshDone returns true if the count of the Refresh invocations corresponds
+// the number of defined expectations
+func (m *UsecaseMock) MinimockRefreshDone() bool {
+	if m.RefreshMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RefreshMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RefreshMock.invocationsDone()
+}
+
+// MinimockRefreshInspect logs each unmet expectation
+func (m *UsecaseMock) MinimockRefreshInspect() {
+	for _, e := range m.RefreshMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UsecaseMock.Refresh at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRefreshCounter := mm_atomic.LoadUint64(&m.afterRefreshCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RefreshMock.defaultExpectation != nil && afterRefreshCounter < 1 {
+		if m.RefreshMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UsecaseMock.Refresh at\n%s", m.RefreshMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UsecaseMock.Refresh at\n%s with params: %#v", m.RefreshMock.defaultExpectation.expectationOrigins.origin, *m.RefreshMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRefresh != nil && afterRefreshCounter < 1 {
+		m.t.Errorf("Expected call to UsecaseMock.Refresh at\n%s", m.funcRefreshOrigin)
+	}
+
+	if !m.RefreshMock.invocationsDone() && afterRefreshCounter > 0 {
+		m.t.Errorf("Expected %d calls to UsecaseMock.Refresh at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RefreshMock.expectedInvocations), m.RefreshMock.expectedInvocationsOrigin, afterRefreshCounter)
+	}
+}
+
+type mUsecaseMockRegister struct {
+	optional           bool
+	mock               *UsecaseMock
+	defaultExpectation *UsecaseMockRegisterExpectation
+	expectations       []*UsecaseMockRegisterExpectation
+
+	callArgs []*UsecaseMockRegisterParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UsecaseMockRegisterExpectation specifies expectation struct of the Usecase.Register
+type UsecaseMockRegisterExpectation struct {
+	mock               *UsecaseMock
+	params             *UsecaseMockRegisterParams
+	paramPtrs          *UsecaseMockRegisterParamPtrs
+	expectationOrigins UsecaseMockRegisterExpectationOrigins
+	results            *UsecaseMockRegisterResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UsecaseMockRegisterParams contains parameters of the Usecase.Register
+type UsecaseMockRegisterParams struct {
+	ctx context.Context
+	req dto.RegisterRequest
+}
+
+// UsecaseMockRegisterParamPtrs contains pointers to parameters of the Usecase.Register
+type UsecaseMockRegisterParamPtrs struct {
+	ctx *context.Context
+	req *dto.RegisterRequest
+}
+
+// UsecaseMockRegisterResults contains results of the Usecase.Register
+type UsecaseMockRegisterResults struct {
+	up1 *models.User
+	err error
+}
+
+// UsecaseMockRegisterOrigins contains origins of expectations of the Usecase.Register
+type UsecaseMockRegisterExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originReq string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRegister *mUsecaseMockRegister) Optional() *mUsecaseMockRegister {
+	mmRegister.optional = true
+	return mmRegister
+}
+
+// Expect sets up expected params for Usecase.Register
+func (mmRegister *mUsecaseMockRegister) Expect(ctx context.Context, req dto.RegisterRequest) *mUsecaseMockRegister {
+	if mmRegister.mock.funcRegister != nil {
+		mmRegister.mock.t.Fatalf("UsecaseMock.Register mock is already set by Set")
+	}
+
+	if mmRegister.defaultExpectation == nil {
+		mmRegister.defaultExpectation = &UsecaseMockRegisterExpectation{}
+	}
+
+	if mmRegister.defaultExpectation.paramPtrs != nil {
+		mmRegister.mock.t.Fatalf("UsecaseMock.Register mock is already set by ExpectParams functions")
+	}
+
+	mmRegister.defaultExpectation.params = &UsecaseMockRegisterParams{ctx, req}
+	mmRegister.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRegister.expectations {
+		if minimock.Equal(e.params, mmRegister.defaultExpectation.params) {
+			mmRegister.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRegister.defaultExpectation.params)
+		}
+	}
+
+	return mmRegister
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Usecase.Register
+func (mmRegister *mUsecaseMockRegister) ExpectCtxParam1(ctx context.Context) *mUsecaseMockRegister {
+	if mmRegister.mock.funcRegister != nil {
+		mmRegister.mock.t.Fatalf("UsecaseMock.Register mock is already set by Set")
+	}
+
+	if mmRegister.defaultExpectation == nil {
+		mmRegister.defaultExpectation = &UsecaseMockRegisterExpectation{}
+	}
+
+	if mmRegister.defaultExpectation.params != nil {
+		mmRegister.mock.t.Fatalf("UsecaseMock.Register mock is already set by Expect")
+	}
+
+	if mmRegister.defaultExpectation.paramPtrs == nil {
+		mmRegister.defaultExpectation.paramPtrs = &UsecaseMockRegisterParamPtrs{}
+	}
+	mmRegister.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRegister.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRegister
+}
+
+// ExpectReqParam2 sets up expected param req for Usecase.Register
+func (mmRegister *mUsecaseMockRegister) ExpectReqParam2(req dto.RegisterRequest) *mUsecaseMockRegister {
+	if mmRegister.mock.funcRegister != nil {
+		mmRegister.mock.t.Fatalf("UsecaseMock.Register mock is already set by Set")
+	}
+
+	if mmRegister.defaultExpectation == nil {
+		mmRegister.defaultExpectation = &UsecaseMockRegisterExpectation{}
+	}
+
+	if mmRegister.defaultExpectation.params != nil {
+		mmRegister.mock.t.Fatalf("UsecaseMock.Register mock is already set by Expect")
+	}
+
+	if mmRegister.defaultExpectation.paramPtrs == nil {
+		mmRegister.defaultExpectation.paramPtrs = &UsecaseMockRegisterParamPtrs{}
+	}
+	mmRegister.defaultExpectation.paramPtrs.req = &req
+	mmRegister.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+
+	return mmRegister
+}
+
+// Inspect accepts an inspector function that has same arguments as the Usecase.Register
+func (mmRegister *mUsecaseMockRegister) Inspect(f func(ctx context.Context, req dto.RegisterRequest)) *mUsecaseMockRegister {
+	if mmRegister.mock.inspectFuncRegister != nil {
+		mmRegister.mock.t.Fatalf("Inspect function is already set for UsecaseMock.Register")
+	}
+
+	mmRegister.mock.inspectFuncRegister = f
+
+	return mmRegister
+}
+
+// Return sets up results that will be returned by Usecase.Register
+func (mmRegister *mUsecaseMockRegister) Return(up1 *models.User, err error) *UsecaseMock {
+	if mmRegister.mock.funcRegister != nil {
+		mmRegister.mock.t.Fatalf("UsecaseMock.Register mock is already set by Set")
+	}
+
+	if mmRegister.defaultExpectation == nil {
+		mmRegister.defaultExpectation = &UsecaseMockRegisterExpectation{mock: mmRegister.mock}
+	}
+	mmRegister.defaultExpectation.results = &UsecaseMockRegisterResults{up1, err}
+	mmRegister.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRegister.mock
+}
+
+// Set uses given function f to mock the Usecase.Register method
+func (mmRegister *mUsecaseMockRegister) Set(f func(ctx context.Context, req dto.RegisterRequest) (up1 *models.User, err error)) *UsecaseMock {
+	if mmRegister.defaultExpectation != nil {
+		mmRegister.mock.t.Fatalf("Default expectation is already set for the Usecase.Register method")
+	}
+
+	if len(mmRegister.expectations) > 0 {
+		mmRegister.mock.t.Fatalf("Some expectations are already set for the Usecase.Register method")
+	}
+
+	mmRegister.mock.funcRegister = f
+	mmRegister.mock.funcRegisterOrigin = minimock.CallerInfo(1)
+	return mmRegister.mock
+}
+
+// When sets expectation for the Usecase.Register which will trigger the result defined by the following
+// Then helper
+func (mmRegister *mUsecaseMockRegister) When(ctx context.Context, req dto.RegisterRequest) *UsecaseMockRegisterExpectation {
+	if mmRegister.mock.funcRegister != nil {
+		mmRegister.mock.t.Fatalf("UsecaseMock.Register mock is already set by Set")
+	}
+
+	expectation := &UsecaseMockRegisterExpectation{
+		mock:               mmRegister.mock,
+		params:             &UsecaseMockRegisterParams{ctx, req},
+		expectationOrigins: UsecaseMockRegisterExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRegister.expectations = append(mmRegister.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Usecase.Register return parameters for the expectation previously defined by the When method
+func (e *UsecaseMockRegisterExpectation) Then(up1 *models.User, err error) *UsecaseMock {
+	e.results = &UsecaseMockRegisterResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times Usecase.Register should be invoked
+func (mmRegister *mUsecaseMockRegister) Times(n uint64) *mUsecaseMockRegister {
+	if n == 0 {
+		mmRegister.mock.t.Fatalf("Times of UsecaseMock.Register mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRegister.expectedInvocations, n)
+	mmRegister.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRegister
+}
+
+func (mmRegister *mUsecaseMockRegister) invocationsDone() bool {
+	if len(mmRegister.expectations) == 0 && mmRegister.defaultExpectation == nil && mmRegister.mock.funcRegister == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRegister.mock.afterRegisterCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRegister.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Register implements mm_usecase.Usecase
+func (mmRegister *UsecaseMock) Register(ctx context.Context, req dto.RegisterRequest) (up1 *models.User, err error) {
+	mm_atomic.AddUint64(&mmRegister.beforeRegisterCounter, 1)
+	defer mm_atomic.AddUint64(&mmRegister.afterRegisterCounter, 1)
+
+	mmRegister.t.Helper()
+
+	if mmRegister.inspectFuncRegister != nil {
+		mmRegister.inspectFuncRegister(ctx, req)
+	}
+
+	mm_params := UsecaseMockRegisterParams{ctx, req}
+
+	// Record call args
+	mmRegister.RegisterMock.mutex.Lock()
+	mmRegister.RegisterMock.callArgs = append(mmRegister.RegisterMock.callArgs, &mm_params)
+	mmRegister.RegisterMock.mutex.Unlock()
+
+	for _, e := range mmRegister.RegisterMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmRegister.RegisterMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRegister.RegisterMock.defaultExpectation.Counter, 1)
+		mm_want := mmRegister.RegisterMock.defaultExpectation.params
+		mm_want_ptrs := mmRegister.RegisterMock.defaultExpectation.paramPtrs
+
+		mm_got := UsecaseMockRegisterParams{ctx, req}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRegister.t.Errorf("UsecaseMock.Register got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRegister.RegisterMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmRegister.t.Errorf("UsecaseMock.Register got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRegister.RegisterMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRegister.t.Errorf("UsecaseMock.Register got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRegister.RegisterMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRegister.RegisterMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRegister.t.Fatal("No results are set for the UsecaseMock.Register")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmRegister.funcRegister != nil {
+		return mmRegister.funcRegister(ctx, req)
+	}
+	mmRegister.t.Fatalf("Unexpected call to UsecaseMock.Register. %v %v", ctx, req)
+	return
+}
+
+// RegisterAfterCounter returns a count of finished UsecaseMock.Register invocations
+func (mmRegister *UsecaseMock) RegisterAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRegister.afterRegisterCounter)
+}
+
+// RegisterBeforeCounter returns a count of UsecaseMock.Register invocations
+func (mmRegister *UsecaseMock) RegisterBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRegister.beforeRegisterCounter)
+}
+
+// Calls returns a list of arguments used in each call to UsecaseMock.Register.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRegister *mUsecaseMockRegister) Calls() []*UsecaseMockRegisterParams {
+	mmRegister.mutex.RLock()
+
+	argCopy := make([]*UsecaseMockRegisterParams, len(mmRegister.callArgs))
+	copy(argCopy, mmRegister.callArgs)
+
+	mmRegister.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRegisterDone returns true if the count of the Register invocations corresponds
+// the number of defined expectations
+func (m *UsecaseMock) MinimockRegisterDone() bool {
+	if m.RegisterMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RegisterMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RegisterMock.invocationsDone()
+}
+
+// MinimockRegisterInspect logs each unmet expectation
+func (m *UsecaseMock) MinimockRegisterInspect() {
+	for _, e := range m.RegisterMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UsecaseMock.Register at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRegisterCounter := mm_atomic.LoadUint64(&m.afterRegisterCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RegisterMock.defaultExpectation != nil && afterRegisterCounter < 1 {
+		if m.RegisterMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UsecaseMock.Register at\n%s", m.RegisterMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UsecaseMock.Register at\n%s with params: %#v", m.RegisterMock.defaultExpectation.expectationOrigins.origin, *m.RegisterMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRegister != nil && afterRegisterCounter < 1 {
+		m.t.Errorf("Expected call to UsecaseMock.Register at\n%s", m.funcRegisterOrigin)
+	}
+
+	if !m.RegisterMock.invocationsDone() && afterRegisterCounter > 0 {
+		m.t.Errorf("Expected %d calls to UsecaseMock.Register at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RegisterMock.expectedInvocations), m.RegisterMock.expectedInvocationsOrigin, afterRegisterCounter)
+	}
+}
+
+type mUsecaseMockRequestEmailVerification struct {
+	optional           bool
+	mock               *UsecaseMock
+	defaultExpectation *UsecaseMockRequestEmailVerificationExpectation
+	expectations       []*UsecaseMockRequestEmailVerificationExpectation
+
+	callArgs []*UsecaseMockRequestEmailVerificationParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UsecaseMockRequestEmailVerificationExpectation specifies expectation struct of the Usecase.RequestEmailVerification
+type UsecaseMockRequestEmailVerificationExpectation struct {
+	mock               *UsecaseMock
+	params             *UsecaseMockRequestEmailVerificationParams
+	paramPtrs          *UsecaseMockRequestEmailVerificationParamPtrs
+	expectationOrigins UsecaseMockRequestEmailVerificationExpectationOrigins
+	results            *UsecaseMockRequestEmailVerificationResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UsecaseMockRequestEmailVerificationParams contains parameters of the Usecase.RequestEmailVerification
+type UsecaseMockRequestEmailVerificationParams struct {
+	ctx    context.Context
+	userID string
+}
+
+// UsecaseMockRequestEmailVerificationParamPtrs contains pointers to parameters of the Usecase.RequestEmailVerification
+type UsecaseMockRequestEmailVerificationParamPtrs struct {
+	ctx    *context.Context
+	userID *string
+}
+
+// UsecaseMockRequestEmailVerificationResults contains results of the Usecase.RequestEmailVerification
+type UsecaseMockRequestEmailVerificationResults struct {
+	err error
+}
+
+// UsecaseMockRequestEmailVerificationOrigins contains origins of expectations of the Usecase.RequestEmailVerification
+type UsecaseMockRequestEmailVerificationExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRequestEmailVerification *mUsecaseMockRequestEmailVerification) Optional() *mUsecaseMockRequestEmailVerification {
+	mmRequestEmailVerification.optional = true
+	return mmRequestEmailVerification
+}
+
+// Expect sets up expected params for Usecase.RequestEmailVerification
+func (mmRequestEmailVerification *mUsecaseMockRequestEmailVerification) Expect(ctx context.Context, userID string) *mUsecaseMockRequestEmailVerification {
+	if mmRequestEmailVerification.mock.funcRequestEmailVerification != nil {
+		mmRequestEmailVerification.mock.t.Fatalf("UsecaseMock.RequestEmailVerification mock is already set by Set")
+	}
+
+	if mmRequestEmailVerification.defaultExpectation == nil {
+		mmRequestEmailVerification.defaultExpectation = &UsecaseMockRequestEmailVerificationExpectation{}
+	}
+
+	if mmRequestEmailVerification.defaultExpectation.paramPtrs != nil {
+		mmRequestEmailVerification.mock.t.Fatalf("UsecaseMock.RequestEmailVerification mock is already set by ExpectParams functions")
+	}
+
+	mmRequestEmailVerification.defaultExpectation.params = &UsecaseMockRequestEmailVerificationParams{ctx, userID}
+	mmRequestEmailVerification.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRequestEmailVerification.expectations {
+		if minimock.Equal(e.params, mmRequestEmailVerification.defaultExpectation.params) {
+			mmRequestEmailVerification.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRequestEmailVerification.defaultExpectation.params)
+		}
+	}
+
+	return mmRequestEmailVerification
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Usecase.RequestEmailVerification
+func (mmRequestEmailVerification *mUsecaseMockRequestEmailVerification) ExpectCtxParam1(ctx context.Context) *mUsecaseMockRequestEmailVerification {
+	if mmRequestEmailVerification.mock.funcRequestEmailVerification != nil {
+		mmRequestEmailVerification.mock.t.Fatalf("UsecaseMock.RequestEmailVerification mock is already set by Set")
+	}
+
+	if mmRequestEmailVerification.defaultExpectation == nil {
+		mmRequestEmailVerification.defaultExpectation = &UsecaseMockRequestEmailVerificationExpectation{}
+	}
+
+	if mmRequestEmailVerification.defaultExpectation.params != nil {
+		mmRequestEmailVerification.mock.t.Fatalf("UsecaseMock.RequestEmailVerification mock is already set by Expect")
+	}
+
+	if mmRequestEmailVerification.defaultExpectation.paramPtrs == nil {
+		mmRequestEmailVerification.defaultExpectation.paramPtrs = &UsecaseMockRequestEmailVerificationParamPtrs{}
+	}
+	mmRequestEmailVerification.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRequestEmailVerification.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRequestEmailVerification
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Usecase.RequestEmailVerification
+func (mmRequestEmailVerification *mUsecaseMockRequestEmailVerification) ExpectUserIDParam2(userID string) *mUsecaseMockRequestEmailVerification {
+	if mmRequestEmailVerification.mock.funcRequestEmailVerification != nil {
+		mmRequestEmailVerification.mock.t.Fatalf("UsecaseMock.RequestEmailVerification mock is already set by Set")
+	}
+
+	if mmRequestEmailVerification.defaultExpectation == nil {
+		mmRequestEmailVerification.defaultExpectation = &UsecaseMockRequestEmailVerificationExpectation{}
+	}
+
+	if mmRequestEmailVerification.defaultExpectation.params != nil {
+		mmRequestEmailVerification.mock.t.Fatalf("UsecaseMock.RequestEmailVerification mock is already set by Expect")
+	}
+
+	if mmRequestEmailVerification.defaultExpectation.paramPtrs == nil {
+		mmRequestEmailVerification.defaultExpectation.paramPtrs = &UsecaseMockRequestEmailVerificationParamPtrs{}
+	}
+	mmRequestEmailVerification.defaultExpectation.paramPtrs.userID = &userID
+	mmRequestEmailVerification.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmRequestEmailVerification
+}
+
+// Inspect accepts an inspector function that has same arguments as the Usecase.RequestEmailVerification
+func (mmRequestEmailVerification *mUsecaseMockRequestEmailVerification) Inspect(f func(ctx context.Context, userID string)) *mUsecaseMockRequestEmailVerification {
+	if mmRequestEmailVerification.mock.inspectFuncRequestEmailVerification != nil {
+		mmRequestEmailVerification.mock.t.Fatalf("Inspect function is already set for UsecaseMock.RequestEmailVerification")
+	}
+
+	mmRequestEmailVerification.mock.inspectFuncRequestEmailVerification = f
+
+	return mmRequestEmailVerification
+}
+
+// Return sets up results that will be returned by Usecase.RequestEmailVerification
+func (mmRequestEmailVerification *mUsecaseMockRequestEmailVerification) Return(err error) *UsecaseMock {
+	if mmRequestEmailVerification.mock.funcRequestEmailVerification != nil {
+		mmRequestEmailVerification.mock.t.Fatalf("UsecaseMock.RequestEmailVerification mock is already set by Set")
+	}
+
+	if mmRequestEmailVerification.defaultExpectation == nil {
+		mmRequestEmailVerification.defaultExpectation = &UsecaseMockRequestEmailVerificationExpectation{mock: mmRequestEmailVerification.mock}
+	}
+	mmRequestEmailVerification.defaultExpectation.results = &UsecaseMockRequestEmailVerificationResults{err}
+	mmRequestEmailVerification.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRequestEmailVerification.mock
+}
+
+// Set uses given function f to mock the Usecase.RequestEmailVerification method
+func (mmRequestEmailVerification *mUsecaseMockRequestEmailVerification) Set(f func(ctx context.Context, userID string) (err error)) *UsecaseMock {
+	if mmRequestEmailVerification.defaultExpectation != nil {
+		mmRequestEmailVerification.mock.t.Fatalf("Default expectation is already set for the Usecase.RequestEmailVerification method")
+	}
+
+	if len(mmRequestEmailVerification.expectations) > 0 {
+		mmRequestEmailVerification.mock.t.Fatalf("Some expectations are already set for the Usecase.RequestEmailVerification method")
+	}
+
+	mmRequestEmailVerification.mock.funcRequestEmailVerification = f
+	mmRequestEmailVerification.mock.funcRequestEmailVerificationOrigin = minimock.CallerInfo(1)
+	return mmRequestEmailVerification.mock
+}
+
+// When sets expectation for the Usecase.RequestEmailVerification which will trigger the result defined by the following
+// Then helper
+func (mmRequestEmailVerification *mUsecaseMockRequestEmailVerification) When(ctx context.Context, userID string) *UsecaseMockRequestEmailVerificationExpectation {
+	if mmRequestEmailVerification.mock.funcRequestEmailVerification != nil {
+		mmRequestEmailVerification.mock.t.Fatalf("UsecaseMock.RequestEmailVerification mock is already set by Set")
+	}
+
+	expectation := &UsecaseMockRequestEmailVerificationExpectation{
+		mock:               mmRequestEmailVerification.mock,
+		params:             &UsecaseMockRequestEmailVerificationParams{ctx, userID},
+		expectationOrigins: UsecaseMockRequestEmailVerificationExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRequestEmailVerification.expectations = append(mmRequestEmailVerification.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Usecase.RequestEmailVerification return parameters for the expectation previously defined by the When method
+func (e *UsecaseMockRequestEmailVerificationExpectation) Then(err error) *UsecaseMock {
+	e.results = &UsecaseMockRequestEmailVerificationResults{err}
+	return e.mock
+}
+
+// Times sets number of times Usecase.RequestEmailVerification should be invoked
+func (mmRequestEmailVerification *mUsecaseMockRequestEmailVerification) Times(n uint64) *mUsecaseMockRequestEmailVerification {
+	if n == 0 {
+		mmRequestEmailVerification.mock.t.Fatalf("Times of UsecaseMock.RequestEmailVerification mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRequestEmailVerification.expectedInvocations, n)
+	mmRequestEmailVerification.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRequestEmailVerification
+}
+
+func (mmRequestEmailVerification *mUsecaseMockRequestEmailVerification) invocationsDone() bool {
+	if len(mmRequestEmailVerification.expectations) == 0 && mmRequestEmailVerification.defaultExpectation == nil && mmRequestEmailVerification.mock.funcRequestEmailVerification == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRequestEmailVerification.mock.afterRequestEmailVerificationCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRequestEmailVerification.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RequestEmailVerification implements mm_usecase.Usecase
+func (mmRequestEmailVerification *UsecaseMock) RequestEmailVerification(ctx context.Context, userID string) (err error) {
+	mm_atomic.AddUint64(&mmRequestEmailVerification.beforeRequestEmailVerificationCounter, 1)
+	defer mm_atomic.AddUint64(&mmRequestEmailVerification.afterRequestEmailVerificationCounter, 1)
+
+	mmRequestEmailVerification.t.Helper()
+
+	if mmRequestEmailVerification.inspectFuncRequestEmailVerification != nil {
+		mmRequestEmailVerification.inspectFuncRequestEmailVerification(ctx, userID)
+	}
+
+	mm_params := UsecaseMockRequestEmailVerificationParams{ctx, userID}
+
+	// Record call args
+	mmRequestEmailVerification.RequestEmailVerificationMock.mutex.Lock()
+	mmRequestEmailVerification.RequestEmailVerificationMock.callArgs = append(mmRequestEmailVerification.RequestEmailVerificationMock.callArgs, &mm_params)
+	mmRequestEmailVerification.RequestEmailVerificationMock.mutex.Unlock()
+
+	for _, e := range mmRequestEmailVerification.RequestEmailVerificationMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRequestEmailVerification.RequestEmailVerificationMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRequestEmailVerification.RequestEmailVerificationMock.defaultExpectation.Counter, 1)
+		mm_want := mmRequestEmailVerification.RequestEmailVerificationMock.defaultExpectation.params
+		mm_want_ptrs := mmRequestEmailVerification.RequestEmailVerificationMock.defaultExpectation.paramPtrs
+
+		mm_got := UsecaseMockRequestEmailVerificationParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRequestEmailVerification.t.Errorf("UsecaseMock.RequestEmailVerification got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRequestEmailVerification.RequestEmailVerificationMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmRequestEmailVerification.t.Errorf("UsecaseMock.RequestEmailVerification got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRequestEmailVerification.RequestEmailVerificationMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRequestEmailVerification.t.Errorf("UsecaseMock.RequestEmailVerification got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRequestEmailVerification.RequestEmailVerificationMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRequestEmailVerification.RequestEmailVerificationMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRequestEmailVerification.t.Fatal("No results are set for the UsecaseMock.RequestEmailVerification")
+		}
+		return (*mm_results).err
+	}
+	if mmRequestEmailVerification.funcRequestEmailVerification != nil {
+		return mmRequestEmailVerification.funcRequestEmailVerification(ctx, userID)
+	}
+	mmRequestEmailVerification.t.Fatalf("Unexpected call to UsecaseMock.RequestEmailVerification. %v %v", ctx, userID)
+	return
+}
+
+// RequestEmailVerificationAfterCounter returns a count of finished UsecaseMock.RequestEmailVerification invocations
+func (mmRequestEmailVerification *UsecaseMock) RequestEmailVerificationAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRequestEmailVerification.afterRequestEmailVerificationCounter)
+}
+
+// RequestEmailVerificationBeforeCounter returns a count of UsecaseMock.RequestEmailVerification invocations
+func (mmRequestEmailVerification *UsecaseMock) RequestEmailVerificationBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRequestEmailVerification.beforeRequestEmailVerificationCounter)
+}
+
+// Calls returns a list of arguments used in each call to UsecaseMock.RequestEmailVerification.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRequestEmailVerification *mUsecaseMockRequestEmailVerification) Calls() []*UsecaseMockRequestEmailVerificationParams {
+	mmRequestEmailVerification.mutex.RLock()
+
+	argCopy := make([]*UsecaseMockRequestEmailVerificationParams, len(mmRequestEmailVerification.callArgs))
+	copy(argCopy, mmRequestEmailVerification.callArgs)
+
+	mmRequestEmailVerification.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRequestEmailVerificationDone returns true if the count of the RequestEmailVerification invocations corresponds
+// the number of defined expectations
+func (m *UsecaseMock) MinimockRequestEmailVerificationDone() bool {
+	if m.RequestEmailVerificationMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RequestEmailVerificationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RequestEmailVerificationMock.invocationsDone()
+}
+
+// MinimockRequestEmailVerificationInspect logs each unmet expectation
+func (m *UsecaseMock) MinimockRequestEmailVerificationInspect() {
+	for _, e := range m.RequestEmailVerificationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UsecaseMock.RequestEmailVerification at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRequestEmailVerificationCounter := mm_atomic.LoadUint64(&m.afterRequestEmailVerificationCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RequestEmailVerificationMock.defaultExpectation != nil && afterRequestEmailVerificationCounter < 1 {
+		if m.RequestEmailVerificationMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UsecaseMock.RequestEmailVerification at\n%s", m.RequestEmailVerificationMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UsecaseMock.RequestEmailVerification at\n%s with params: %#v", m.RequestEmailVerificationMock.defaultExpectation.expectationOrigins.origin, *m.RequestEmailVerificationMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRequestEmailVerification != nil && afterRequestEmailVerificationCounter < 1 {
+		m.t.Errorf("Expected call to UsecaseMock.RequestEmailVerification at\n%s", m.funcRequestEmailVerificationOrigin)
+	}
+
+	if !m.RequestEmailVerificationMock.invocationsDone() && afterRequestEmailVerificationCounter > 0 {
+		m.t.Errorf("Expected %d calls to UsecaseMock.RequestEmailVerification at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RequestEmailVerificationMock.expectedInvocations), m.RequestEmailVerificationMock.expectedInvocationsOrigin, afterRequestEmailVerificationCounter)
+	}
+}
+
+type mUsecaseMockRequestPasswordReset struct {
+	optional           bool
+	mock               *UsecaseMock
+	defaultExpectation *UsecaseMockRequestPasswordResetExpectation
+	expectations       []*UsecaseMockRequestPasswordResetExpectation
+
+	callArgs []*UsecaseMockRequestPasswordResetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UsecaseMockRequestPasswordResetExpectation specifies expectation struct of the Usecase.RequestPasswordReset
+type UsecaseMockRequestPasswordResetExpectation struct {
+	mock               *UsecaseMock
+	params             *UsecaseMockRequestPasswordResetParams
+	paramPtrs          *UsecaseMockRequestPasswordResetParamPtrs
+	expectationOrigins UsecaseMockRequestPasswordResetExpectationOrigins
+	results            *UsecaseMockRequestPasswordResetResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UsecaseMockRequestPasswordResetParams contains parameters of the Usecase.RequestPasswordReset
+type UsecaseMockRequestPasswordResetParams struct {
+	ctx   context.Context
+	email string
+}
+
+// UsecaseMockRequestPasswordResetParamPtrs contains pointers to parameters of the Usecase.RequestPasswordReset
+type UsecaseMockRequestPasswordResetParamPtrs struct {
+	ctx   *context.Context
+	email *string
+}
+
+// UsecaseMockRequestPasswordResetResults contains results of the Usecase.RequestPasswordReset
+type UsecaseMockRequestPasswordResetResults struct {
+	err error
+}
+
+// UsecaseMockRequestPasswordResetOrigins contains origins of expectations of the Usecase.RequestPasswordReset
+type UsecaseMockRequestPasswordResetExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originEmail string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRequestPasswordReset *mUsecaseMockRequestPasswordReset) Optional() *mUsecaseMockRequestPasswordReset {
+	mmRequestPasswordReset.optional = true
+	return mmRequestPasswordReset
+}
+
+// Expect sets up expected params for Usecase.RequestPasswordReset
+func (mmRequestPasswordReset *mUsecaseMockRequestPasswordReset) Expect(ctx context.Context, email string) *mUsecaseMockRequestPasswordReset {
+	if mmRequestPasswordReset.mock.funcRequestPasswordReset != nil {
+		mmRequestPasswordReset.mock.t.Fatalf("UsecaseMock.RequestPasswordReset mock is already set by Set")
+	}
+
+	if mmRequestPasswordReset.defaultExpectation == nil {
+		mmRequestPasswordReset.defaultExpectation = &UsecaseMockRequestPasswordResetExpectation{}
+	}
+
+	if mmRequestPasswordReset.defaultExpectation.paramPtrs != nil {
+		mmRequestPasswordReset.mock.t.Fatalf("UsecaseMock.RequestPasswordReset mock is already set by ExpectParams functions")
+	}
+
+	mmRequestPasswordReset.defaultExpectation.params = &UsecaseMockRequestPasswordResetParams{ctx, email}
+	mmRequestPasswordReset.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRequestPasswordReset.expectations {
+		if minimock.Equal(e.params, mmRequestPasswordReset.defaultExpectation.params) {
+			mmRequestPasswordReset.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRequestPasswordReset.defaultExpectation.params)
+		}
+	}
+
+	return mmRequestPasswordReset
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Usecase.RequestPasswordReset
+func (mmRequestPasswordReset *mUsecaseMockRequestPasswordReset) ExpectCtxParam1(ctx context.Context) *mUsecaseMockRequestPasswordReset {
+	if mmRequestPasswordReset.mock.funcRequestPasswordReset != nil {
+		mmRequestPasswordReset.mock.t.Fatalf("UsecaseMock.RequestPasswordReset mock is already set by Set")
+	}
+
+	if mmRequestPasswordReset.defaultExpectation == nil {
+		mmRequestPasswordReset.defaultExpectation = &UsecaseMockRequestPasswordResetExpectation{}
+	}
+
+	if mmRequestPasswordReset.defaultExpectation.params != nil {
+		mmRequestPasswordReset.mock.t.Fatalf("UsecaseMock.RequestPasswordReset mock is already set by Expect")
+	}
+
+	if mmRequestPasswordReset.defaultExpectation.paramPtrs == nil {
+		mmRequestPasswordReset.defaultExpectation.paramPtrs = &UsecaseMockRequestPasswordResetParamPtrs{}
+	}
+	mmRequestPasswordReset.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRequestPasswordReset.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRequestPasswordReset
+}
+
+// ExpectEmailParam2 sets up expected param email for Usecase.RequestPasswordReset
+func (mmRequestPasswordReset *mUsecaseMockRequestPasswordReset) ExpectEmailParam2(email string) *mUsecaseMockRequestPasswordReset {
+	if mmRequestPasswordReset.mock.funcRequestPasswordReset != nil {
+		mmRequestPasswordReset.mock.t.Fatalf("UsecaseMock.RequestPasswordReset mock is already set by Set")
+	}
+
+	if mmRequestPasswordReset.defaultExpectation == nil {
+		mmRequestPasswordReset.defaultExpectation = &UsecaseMockRequestPasswordResetExpectation{}
+	}
+
+	if mmRequestPasswordReset.defaultExpectation.params != nil {
+		mmRequestPasswordReset.mock.t.Fatalf("UsecaseMock.RequestPasswordReset mock is already set by Expect")
+	}
+
+	if mmRequestPasswordReset.defaultExpectation.paramPtrs == nil {
+		mmRequestPasswordReset.defaultExpectation.paramPtrs = &UsecaseMockRequestPasswordResetParamPtrs{}
+	}
+	mmRequestPasswordReset.defaultExpectation.paramPtrs.email = &email
+	mmRequestPasswordReset.defaultExpectation.expectationOrigins.originEmail = minimock.CallerInfo(1)
+
+	return mmRequestPasswordReset
+}
+
+// Inspect accepts an inspector function that has same arguments as the Usecase.RequestPasswordReset
+func (mmRequestPasswordReset *mUsecaseMockRequestPasswordReset) Inspect(f func(ctx context.Context, email string)) *mUsecaseMockRequestPasswordReset {
+	if mmRequestPasswordReset.mock.inspectFuncRequestPasswordReset != nil {
+		mmRequestPasswordReset.mock.t.Fatalf("Inspect function is already set for UsecaseMock.RequestPasswordReset")
+	}
+
+	mmRequestPasswordReset.mock.inspectFuncRequestPasswordReset = f
+
+	return mmRequestPasswordReset
+}
+
+// Return sets up results that will be returned by Usecase.RequestPasswordReset
+func (mmRequestPasswordReset *mUsecaseMockRequestPasswordReset) Return(err error) *UsecaseMock {
+	if mmRequestPasswordReset.mock.funcRequestPasswordReset != nil {
+		mmRequestPasswordReset.mock.t.Fatalf("UsecaseMock.RequestPasswordReset mock is already set by Set")
+	}
+
+	if mmRequestPasswordReset.defaultExpectation == nil {
+		mmRequestPasswordReset.defaultExpectation = &UsecaseMockRequestPasswordResetExpectation{mock: mmRequestPasswordReset.mock}
+	}
+	mmRequestPasswordReset.defaultExpectation.results = &UsecaseMockRequestPasswordResetResults{err}
+	mmRequestPasswordReset.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRequestPasswordReset.mock
+}
+
+// Set uses given function f to mock the Usecase.RequestPasswordReset method
+func (mmRequestPasswordReset *mUsecaseMockRequestPasswordReset) Set(f func(ctx context.Context, email string) (err error)) *UsecaseMock {
+	if mmRequestPasswordReset.defaultExpectation != nil {
+		mmRequestPasswordReset.mock.t.Fatalf("Default expectation is already set for the Usecase.RequestPasswordReset method")
+	}
+
+	if len(mmRequestPasswordReset.expectations) > 0 {
+		mmRequestPasswordReset.mock.t.Fatalf("Some expectations are already set for the Usecase.RequestPasswordReset method")
+	}
+
+	mmRequestPasswordReset.mock.funcRequestPasswordReset = f
+	mmRequestPasswordReset.mock.funcRequestPasswordResetOrigin = minimock.CallerInfo(1)
+	return mmRequestPasswordReset.mock
+}
+
+// When sets expectation for the Usecase.RequestPasswordReset which will trigger the result defined by the following
+// Then helper
+func (mmRequestPasswordReset *mUsecaseMockRequestPasswordReset) When(ctx context.Context, email string) *UsecaseMockRequestPasswordResetExpectation {
+	if mmRequestPasswordReset.mock.funcRequestPasswordReset != nil {
+		mmRequestPasswordReset.mock.t.Fatalf("UsecaseMock.RequestPasswordReset mock is already set by Set")
+	}
+
+	expectation := &UsecaseMockRequestPasswordResetExpectation{
+		mock:               mmRequestPasswordReset.mock,
+		params:             &UsecaseMockRequestPasswordResetParams{ctx, email},
+		expectationOrigins: UsecaseMockRequestPasswordResetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRequestPasswordReset.expectations = append(mmRequestPasswordReset.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Usecase.RequestPasswordReset return parameters for the expectation previously defined by the When method
+func (e *UsecaseMockRequestPasswordResetExpectation) Then(err error) *UsecaseMock {
+	e.results = &UsecaseMockRequestPasswordResetResults{err}
+	return e.mock
+}
+
+// Times sets number of times Usecase.RequestPasswordReset should be invoked
+func (mmRequestPasswordReset *mUsecaseMockRequestPasswordReset) Times(n uint64) *mUsecaseMockRequestPasswordReset {
+	if n == 0 {
+		mmRequestPasswordReset.mock.t.Fatalf("Times of UsecaseMock.RequestPasswordReset mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRequestPasswordReset.expectedInvocations, n)
+	mmRequestPasswordReset.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRequestPasswordReset
+}
+
+func (mmRequestPasswordReset *mUsecaseMockRequestPasswordReset) invocationsDone() bool {
+	if len(mmRequestPasswordReset.expectations) == 0 && mmRequestPasswordReset.defaultExpectation == nil && mmRequestPasswordReset.mock.funcRequestPasswordReset == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRequestPasswordReset.mock.afterRequestPasswordResetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRequestPasswordReset.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RequestPasswordReset implements mm_usecase.Usecase
+func (mmRequestPasswordReset *UsecaseMock) RequestPasswordReset(ctx context.Context, email string) (err error) {
+	mm_atomic.AddUint64(&mmRequestPasswordReset.beforeRequestPasswordResetCounter, 1)
+	defer mm_atomic.AddUint64(&mmRequestPasswordReset.afterRequestPasswordResetCounter, 1)
+
+	mmRequestPasswordReset.t.Helper()
+
+	if mmRequestPasswordReset.inspectFuncRequestPasswordReset != nil {
+		mmRequestPasswordReset.inspectFuncRequestPasswordReset(ctx, email)
+	}
+
+	mm_params := UsecaseMockRequestPasswordResetParams{ctx, email}
+
+	// Record call args
+	mmRequestPasswordReset.RequestPasswordResetMock.mutex.Lock()
+	mmRequestPasswordReset.RequestPasswordResetMock.callArgs = append(mmRequestPasswordReset.RequestPasswordResetMock.callArgs, &mm_params)
+	mmRequestPasswordReset.RequestPasswordResetMock.mutex.Unlock()
+
+	for _, e := range mmRequestPasswordReset.RequestPasswordResetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRequestPasswordReset.RequestPasswordResetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRequestPasswordReset.RequestPasswordResetMock.defaultExpectation.Counter, 1)
+		mm_want := mmRequestPasswordReset.RequestPasswordResetMock.defaultExpectation.params
+		mm_want_ptrs := mmRequestPasswordReset.RequestPasswordResetMock.defaultExpectation.paramPtrs
+
+		mm_got := UsecaseMockRequestPasswordResetParams{ctx, email}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRequestPasswordReset.t.Errorf("UsecaseMock.RequestPasswordReset got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRequestPasswordReset.RequestPasswordResetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.email != nil && !minimock.Equal(*mm_want_ptrs.email, mm_got.email) {
+				mmRequestPasswordReset.t.Errorf("UsecaseMock.RequestPasswordReset got unexpected parameter email, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRequestPasswordReset.RequestPasswordResetMock.defaultExpectation.expectationOrigins.originEmail, *mm_want_ptrs.email, mm_got.email, minimock.Diff(*mm_want_ptrs.email, mm_got.email))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRequestPasswordReset.t.Errorf("UsecaseMock.RequestPasswordReset got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRequestPasswordReset.RequestPasswordResetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRequestPasswordReset.RequestPasswordResetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRequestPasswordReset.t.Fatal("No results are set for the UsecaseMock.RequestPasswordReset")
+		}
+		return (*mm_results).err
+	}
+	if mmRequestPasswordReset.funcRequestPasswordReset != nil {
+		return mmRequestPasswordReset.funcRequestPasswordReset(ctx, email)
+	}
+	mmRequestPasswordReset.t.Fatalf("Unexpected call to UsecaseMock.RequestPasswordReset. %v %v", ctx, email)
+	return
+}
+
+// RequestPasswordResetAfterCounter returns a count of finished UsecaseMock.RequestPasswordReset invocations
+func (mmRequestPasswordReset *UsecaseMock) RequestPasswordResetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRequestPasswordReset.afterRequestPasswordResetCounter)
+}
+
+// RequestPasswordResetBeforeCounter returns a count of UsecaseMock.RequestPasswordReset invocations
+func (mmRequestPasswordReset *UsecaseMock) RequestPasswordResetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRequestPasswordReset.beforeRequestPasswordResetCounter)
+}
+
+// Calls returns a list of arguments used in each call to UsecaseMock.RequestPasswordReset.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRequestPasswordReset *mUsecaseMockRequestPasswordReset) Calls() []*UsecaseMockRequestPasswordResetParams {
+	mmRequestPasswordReset.mutex.RLock()
+
+	argCopy := make([]*UsecaseMockRequestPasswordResetParams, len(mmRequestPasswordReset.callArgs))
+	copy(argCopy, mmRequestPasswordReset.callArgs)
+
+	mmRequestPasswordReset.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRequestPasswordResetDone returns true if the count of the RequestPasswordReset invocations corresponds
+// the number of defined expectations
+func (m *UsecaseMock) MinimockRequestPasswordResetDone() bool {
+	if m.RequestPasswordResetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RequestPasswordResetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RequestPasswordResetMock.invocationsDone()
+}
+
+// MinimockRequestPasswordResetInspect logs each unmet expectation
+func (m *UsecaseMock) MinimockRequestPasswordResetInspect() {
+	for _, e := range m.RequestPasswordResetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UsecaseMock.RequestPasswordReset at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRequestPasswordResetCounter := mm_atomic.LoadUint64(&m.afterRequestPasswordResetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RequestPasswordResetMock.defaultExpectation != nil && afterRequestPasswordResetCounter < 1 {
+		if m.RequestPasswordResetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UsecaseMock.RequestPasswordReset at\n%s", m.RequestPasswordResetMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UsecaseMock.RequestPasswordReset at\n%s with params: %#v", m.RequestPasswordResetMock.defaultExpectation.expectationOrigins.origin, *m.RequestPasswordResetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRequestPasswordReset != nil && afterRequestPasswordResetCounter < 1 {
+		m.t.Errorf("Expected call to UsecaseMock.RequestPasswordReset at\n%s", m.funcRequestPasswordResetOrigin)
+	}
+
+	if !m.RequestPasswordResetMock.invocationsDone() && afterRequestPasswordResetCounter > 0 {
+		m.t.Errorf("Expected %d calls to UsecaseMock.RequestPasswordReset at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RequestPasswordResetMock.expectedInvocations), m.RequestPasswordResetMock.expectedInvocationsOrigin, afterRequestPasswordResetCounter)
+	}
+}
+
+type mUsecaseMockResetPassword struct {
+	optional           bool
+	mock               *UsecaseMock
+	defaultExpectation *UsecaseMockResetPasswordExpectation
+	expectations       []*UsecaseMockResetPasswordExpectation
+
+	callArgs []*UsecaseMockResetPasswordParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UsecaseMockResetPasswordExpectation specifies expectation struct of the Usecase.ResetPassword
+type UsecaseMockResetPasswordExpectation struct {
+	mock               *UsecaseMock
+	params             *UsecaseMockResetPasswordParams
+	paramPtrs          *UsecaseMockResetPasswordParamPtrs
+	expectationOrigins UsecaseMockResetPasswordExpectationOrigins
+	results            *UsecaseMockResetPasswordResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UsecaseMockResetPasswordParams contains parameters of the Usecase.ResetPassword
+type UsecaseMockResetPasswordParams struct {
+	ctx context.Context
+	req dto.ResetPasswordRequest
+}
+
+// UsecaseMockResetPasswordParamPtrs contains pointers to parameters of the Usecase.ResetPassword
+type UsecaseMockResetPasswordParamPtrs struct {
+	ctx *context.Context
+	req *dto.ResetPasswordRequest
+}
+
+// UsecaseMockResetPasswordResults contains results of the Usecase.ResetPassword
+type UsecaseMockResetPasswordResults struct {
+	err error
+}
+
+// UsecaseMockResetPasswordOrigins contains origins of expectations of the Usecase.ResetPassword
+type UsecaseMockResetPasswordExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originReq string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmResetPassword *mUsecaseMockResetPassword) Optional() *mUsecaseMockResetPassword {
+	mmResetPassword.optional = true
+	return mmResetPassword
+}
+
+// Expect sets up expected params for Usecase.ResetPassword
+func (mmResetPassword *mUsecaseMockResetPassword) Expect(ctx context.Context, req dto.ResetPasswordRequest) *mUsecaseMockResetPassword {
+	if mmResetPassword.mock.funcResetPassword != nil {
+		mmResetPassword.mock.t.Fatalf("UsecaseMock.ResetPassword mock is already set by Set")
+	}
+
+	if mmResetPassword.defaultExpectation == nil {
+		mmResetPassword.defaultExpectation = &UsecaseMockResetPasswordExpectation{}
 	}
-	mmRefresh.defaultExpectation.results = &UsecaseMockRefreshResults{up1, err}
-	mmRefresh.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmRefresh.mock
+
+	if mmResetPassword.defaultExpectation.paramPtrs != nil {
+		mmResetPassword.mock.t.Fatalf("UsecaseMock.ResetPassword mock is already set by ExpectParams functions")
+	}
+
+	mmResetPassword.defaultExpectation.params = &UsecaseMockResetPasswordParams{ctx, req}
+	mmResetPassword.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmResetPassword.expectations {
+		if minimock.Equal(e.params, mmResetPassword.defaultExpectation.params) {
+			mmResetPassword.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmResetPassword.defaultExpectation.params)
+		}
+	}
+
+	return mmResetPassword
 }
 
-// Set uses given function f to mock the Usecase.Refresh method
-func (mmRefresh *mUsecaseMockRefresh) Set(f func(ctx context.Context, req dto.RefreshRequest) (up1 *models.User, err error)) *UsecaseMock {
-	if mmRefresh.defaultExpectation != nil {
-		mmRefresh.mock.t.Fatalf("Default expectation is already set for the Usecase.Refresh method")
+// ExpectCtxParam1 sets up expected param ctx for Usecase.ResetPassword
+func (mmResetPassword *mUsecaseMockResetPassword) ExpectCtxParam1(ctx context.Context) *mUsecaseMockResetPassword {
+	if mmResetPassword.mock.funcResetPassword != nil {
+		mmResetPassword.mock.t.Fatalf("UsecaseMock.ResetPassword mock is already set by Set")
 	}
 
-	if len(mmRefresh.expectations) > 0 {
-		mmRefresh.mock.t.Fatalf("Some expectations are already set for the Usecase.Refresh method")
+	if mmResetPassword.defaultExpectation == nil {
+		mmResetPassword.defaultExpectation = &UsecaseMockResetPasswordExpectation{}
 	}
 
-	mmRefresh.mock.funcRefresh = f
-	mmRefresh.mock.funcRefreshOrigin = minimock.CallerInfo(1)
-	return mmRefresh.mock
+	if mmResetPassword.defaultExpectation.params != nil {
+		mmResetPassword.mock.t.Fatalf("UsecaseMock.ResetPassword mock is already set by Expect")
+	}
+
+	if mmResetPassword.defaultExpectation.paramPtrs == nil {
+		mmResetPassword.defaultExpectation.paramPtrs = &UsecaseMockResetPasswordParamPtrs{}
+	}
+	mmResetPassword.defaultExpectation.paramPtrs.ctx = &ctx
+	mmResetPassword.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmResetPassword
 }
 
-// When sets expectation for the Usecase.Refresh which will trigger the result defined by the following
+// ExpectReqParam2 sets up expected param req for Usecase.ResetPassword
+func (mmResetPassword *mUsecaseMockResetPassword) ExpectReqParam2(req dto.ResetPasswordRequest) *mUsecaseMockResetPassword {
+	if mmResetPassword.mock.funcResetPassword != nil {
+		mmResetPassword.mock.t.Fatalf("UsecaseMock.ResetPassword mock is already set by Set")
+	}
+
+	if mmResetPassword.defaultExpectation == nil {
+		mmResetPassword.defaultExpectation = &UsecaseMockResetPasswordExpectation{}
+	}
+
+	if mmResetPassword.defaultExpectation.params != nil {
+		mmResetPassword.mock.t.Fatalf("UsecaseMock.ResetPassword mock is already set by Expect")
+	}
+
+	if mmResetPassword.defaultExpectation.paramPtrs == nil {
+		mmResetPassword.defaultExpectation.paramPtrs = &UsecaseMockResetPasswordParamPtrs{}
+	}
+	mmResetPassword.defaultExpectation.paramPtrs.req = &req
+	mmResetPassword.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+
+	return mmResetPassword
+}
+
+// Inspect accepts an inspector function that has same arguments as the Usecase.ResetPassword
+func (mmResetPassword *mUsecaseMockResetPassword) Inspect(f func(ctx context.Context, req dto.ResetPasswordRequest)) *mUsecaseMockResetPassword {
+	if mmResetPassword.mock.inspectFuncResetPassword != nil {
+		mmResetPassword.mock.t.Fatalf("Inspect function is already set for UsecaseMock.ResetPassword")
+	}
+
+	mmResetPassword.mock.inspectFuncResetPassword = f
+
+	return mmResetPassword
+}
+
+// Return sets up results that will be returned by Usecase.ResetPassword
+func (mmResetPassword *mUsecaseMockResetPassword) Return(err error) *UsecaseMock {
+	if mmResetPassword.mock.funcResetPassword != nil {
+		mmResetPassword.mock.t.Fatalf("UsecaseMock.ResetPassword mock is already set by Set")
+	}
+
+	if mmResetPassword.defaultExpectation == nil {
+		mmResetPassword.defaultExpectation = &UsecaseMockResetPasswordExpectation{mock: mmResetPassword.mock}
+	}
+	mmResetPassword.defaultExpectation.results = &UsecaseMockResetPasswordResults{err}
+	mmResetPassword.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmResetPassword.mock
+}
+
+// Set uses given function f to mock the Usecase.ResetPassword method
+func (mmResetPassword *mUsecaseMockResetPassword) Set(f func(ctx context.Context, req dto.ResetPasswordRequest) (err error)) *UsecaseMock {
+	if mmResetPassword.defaultExpectation != nil {
+		mmResetPassword.mock.t.Fatalf("Default expectation is already set for the Usecase.ResetPassword method")
+	}
+
+	if len(mmResetPassword.expectations) > 0 {
+		mmResetPassword.mock.t.Fatalf("Some expectations are already set for the Usecase.ResetPassword method")
+	}
+
+	mmResetPassword.mock.funcResetPassword = f
+	mmResetPassword.mock.funcResetPasswordOrigin = minimock.CallerInfo(1)
+	return mmResetPassword.mock
+}
+
+// When sets expectation for the Usecase.ResetPassword which will trigger the result defined by the following
 // Then helper
-func (mmRefresh *mUsecaseMockRefresh) When(ctx context.Context, req dto.RefreshRequest) *UsecaseMockRefreshExpectation {
-	if mmRefresh.mock.funcRefresh != nil {
-		mmRefresh.mock.t.Fatalf("UsecaseMock.Refresh mock is already set by Set")
+func (mmResetPassword *mUsecaseMockResetPassword) When(ctx context.Context, req dto.ResetPasswordRequest) *UsecaseMockResetPasswordExpectation {
+	if mmResetPassword.mock.funcResetPassword != nil {
+		mmResetPassword.mock.t.Fatalf("UsecaseMock.ResetPassword mock is already set by Set")
 	}
 
-	expectation := &UsecaseMockRefreshExpectation{
-		mock:               mmRefresh.mock,
-		params:             &UsecaseMockRefreshParams{ctx, req},
-		expectationOrigins: UsecaseMockRefreshExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &UsecaseMockResetPasswordExpectation{
+		mock:               mmResetPassword.mock,
+		params:             &UsecaseMockResetPasswordParams{ctx, req},
+		expectationOrigins: UsecaseMockResetPasswordExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmRefresh.expectations = append(mmRefresh.expectations, expectation)
+	mmResetPassword.expectations = append(mmResetPassword.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Usecase.Refresh return parameters for the expectation previously defined by the When method
-func (e *UsecaseMockRefreshExpectation) Then(up1 *models.User, err error) *UsecaseMock {
-	e.results = &UsecaseMockRefreshResults{up1, err}
+// Then sets up Usecase.ResetPassword return parameters for the expectation previously defined by the When method
+func (e *UsecaseMockResetPasswordExpectation) Then(err error) *UsecaseMock {
+	e.results = &UsecaseMockResetPasswordResults{err}
+	return e.mock
+}
+
+// Times sets number of times Usecase.ResetPassword should be invoked
+func (mmResetPassword *mUsecaseMockResetPassword) Times(n uint64) *mUsecaseMockResetPassword {
+	if n == 0 {
+		mmResetPassword.mock.t.Fatalf("Times of UsecaseMock.ResetPassword mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmResetPassword.expectedInvocations, n)
+	mmResetPassword.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmResetPassword
+}
+
+func (mmResetPassword *mUsecaseMockResetPassword) invocationsDone() bool {
+	if len(mmResetPassword.expectations) == 0 && mmResetPassword.defaultExpectation == nil && mmResetPassword.mock.funcResetPassword == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmResetPassword.mock.afterResetPasswordCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmResetPassword.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ResetPassword implements mm_usecase.Usecase
+func (mmResetPassword *UsecaseMock) ResetPassword(ctx context.Context, req dto.ResetPasswordRequest) (err error) {
+	mm_atomic.AddUint64(&mmResetPassword.beforeResetPasswordCounter, 1)
+	defer mm_atomic.AddUint64(&mmResetPassword.afterResetPasswordCounter, 1)
+
+	mmResetPassword.t.Helper()
+
+	if mmResetPassword.inspectFuncResetPassword != nil {
+		mmResetPassword.inspectFuncResetPassword(ctx, req)
+	}
+
+	mm_params := UsecaseMockResetPasswordParams{ctx, req}
+
+	// Record call args
+	mmResetPassword.ResetPasswordMock.mutex.Lock()
+	mmResetPassword.ResetPasswordMock.callArgs = append(mmResetPassword.ResetPasswordMock.callArgs, &mm_params)
+	mmResetPassword.ResetPasswordMock.mutex.Unlock()
+
+	for _, e := range mmResetPassword.ResetPasswordMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmResetPassword.ResetPasswordMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmResetPassword.ResetPasswordMock.defaultExpectation.Counter, 1)
+		mm_want := mmResetPassword.ResetPasswordMock.defaultExpectation.params
+		mm_want_ptrs := mmResetPassword.ResetPasswordMock.defaultExpectation.paramPtrs
+
+		mm_got := UsecaseMockResetPasswordParams{ctx, req}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmResetPassword.t.Errorf("UsecaseMock.ResetPassword got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmResetPassword.ResetPasswordMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmResetPassword.t.Errorf("UsecaseMock.ResetPassword got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmResetPassword.ResetPasswordMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmResetPassword.t.Errorf("UsecaseMock.ResetPassword got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmResetPassword.ResetPasswordMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmResetPassword.ResetPasswordMock.defaultExpectation.results
+		if mm_results == nil {
+			mmResetPassword.t.Fatal("No results are set for the UsecaseMock.ResetPassword")
+		}
+		return (*mm_results).err
+	}
+	if mmResetPassword.funcResetPassword != nil {
+		return mmResetPassword.funcResetPassword(ctx, req)
+	}
+	mmResetPassword.t.Fatalf("Unexpected call to UsecaseMock.ResetPassword. %v %v", ctx, req)
+	return
+}
+
+// ResetPasswordAfterCounter returns a count of finished UsecaseMock.ResetPassword invocations
+func (mmResetPassword *UsecaseMock) ResetPasswordAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmResetPassword.afterResetPasswordCounter)
+}
+
+// ResetPasswordBeforeCounter returns a count of UsecaseMock.ResetPassword invocations
+func (mmResetPassword *UsecaseMock) ResetPasswordBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmResetPassword.beforeResetPasswordCounter)
+}
+
+// Calls returns a list of arguments used in each call to UsecaseMock.ResetPassword.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmResetPassword *mUsecaseMockResetPassword) Calls() []*UsecaseMockResetPasswordParams {
+	mmResetPassword.mutex.RLock()
+
+	argCopy := make([]*UsecaseMockResetPasswordParams, len(mmResetPassword.callArgs))
+	copy(argCopy, mmResetPassword.callArgs)
+
+	mmResetPassword.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockResetPasswordDone returns true if the count of the ResetPassword invocations corresponds
+// the number of defined expectations
+func (m *UsecaseMock) MinimockResetPasswordDone() bool {
+	if m.ResetPasswordMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ResetPasswordMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ResetPasswordMock.invocationsDone()
+}
+
+// MinimockResetPasswordInspect logs each unmet expectation
+func (m *UsecaseMock) MinimockResetPasswordInspect() {
+	for _, e := range m.ResetPasswordMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UsecaseMock.ResetPassword at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterResetPasswordCounter := mm_atomic.LoadUint64(&m.afterResetPasswordCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ResetPasswordMock.defaultExpectation != nil && afterResetPasswordCounter < 1 {
+		if m.ResetPasswordMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UsecaseMock.ResetPassword at\n%s", m.ResetPasswordMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UsecaseMock.ResetPassword at\n%s with params: %#v", m.ResetPasswordMock.defaultExpectation.expectationOrigins.origin, *m.ResetPasswordMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcResetPassword != nil && afterResetPasswordCounter < 1 {
+		m.t.Errorf("Expected call to UsecaseMock.ResetPassword at\n%s", m.funcResetPasswordOrigin)
+	}
+
+	if !m.ResetPasswordMock.invocationsDone() && afterResetPasswordCounter > 0 {
+		m.t.Errorf("Expected %d calls to UsecaseMock.ResetPassword at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ResetPasswordMock.expectedInvocations), m.ResetPasswordMock.expectedInvocationsOrigin, afterResetPasswordCounter)
+	}
+}
+
+type mUsecaseMockRevokeAllOtherSessions struct {
+	optional           bool
+	mock               *UsecaseMock
+	defaultExpectation *UsecaseMockRevokeAllOtherSessionsExpectation
+	expectations       []*UsecaseMockRevokeAllOtherSessionsExpectation
+
+	callArgs []*UsecaseMockRevokeAllOtherSessionsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UsecaseMockRevokeAllOtherSessionsExpectation specifies expectation struct of the Usecase.RevokeAllOtherSessions
+type UsecaseMockRevokeAllOtherSessionsExpectation struct {
+	mock               *UsecaseMock
+	params             *UsecaseMockRevokeAllOtherSessionsParams
+	paramPtrs          *UsecaseMockRevokeAllOtherSessionsParamPtrs
+	expectationOrigins UsecaseMockRevokeAllOtherSessionsExpectationOrigins
+	results            *UsecaseMockRevokeAllOtherSessionsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UsecaseMockRevokeAllOtherSessionsParams contains parameters of the Usecase.RevokeAllOtherSessions
+type UsecaseMockRevokeAllOtherSessionsParams struct {
+	ctx context.Context
+	req dto.RevokeAllOtherSessionsRequest
+}
+
+// UsecaseMockRevokeAllOtherSessionsParamPtrs contains pointers to parameters of the Usecase.RevokeAllOtherSessions
+type UsecaseMockRevokeAllOtherSessionsParamPtrs struct {
+	ctx *context.Context
+	req *dto.RevokeAllOtherSessionsRequest
+}
+
+// UsecaseMockRevokeAllOtherSessionsResults contains results of the Usecase.RevokeAllOtherSessions
+type UsecaseMockRevokeAllOtherSessionsResults struct {
+	i1  int64
+	err error
+}
+
+// UsecaseMockRevokeAllOtherSessionsOrigins contains origins of expectations of the Usecase.RevokeAllOtherSessions
+type UsecaseMockRevokeAllOtherSessionsExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originReq string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRevokeAllOtherSessions *mUsecaseMockRevokeAllOtherSessions) Optional() *mUsecaseMockRevokeAllOtherSessions {
+	mmRevokeAllOtherSessions.optional = true
+	return mmRevokeAllOtherSessions
+}
+
+// Expect sets up expected params for Usecase.RevokeAllOtherSessions
+func (mmRevokeAllOtherSessions *mUsecaseMockRevokeAllOtherSessions) Expect(ctx context.Context, req dto.RevokeAllOtherSessionsRequest) *mUsecaseMockRevokeAllOtherSessions {
+	if mmRevokeAllOtherSessions.mock.funcRevokeAllOtherSessions != nil {
+		mmRevokeAllOtherSessions.mock.t.Fatalf("UsecaseMock.RevokeAllOtherSessions mock is already set by Set")
+	}
+
+	if mmRevokeAllOtherSessions.defaultExpectation == nil {
+		mmRevokeAllOtherSessions.defaultExpectation = &UsecaseMockRevokeAllOtherSessionsExpectation{}
+	}
+
+	if mmRevokeAllOtherSessions.defaultExpectation.paramPtrs != nil {
+		mmRevokeAllOtherSessions.mock.t.Fatalf("UsecaseMock.RevokeAllOtherSessions mock is already set by ExpectParams functions")
+	}
+
+	mmRevokeAllOtherSessions.defaultExpectation.params = &UsecaseMockRevokeAllOtherSessionsParams{ctx, req}
+	mmRevokeAllOtherSessions.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRevokeAllOtherSessions.expectations {
+		if minimock.Equal(e.params, mmRevokeAllOtherSessions.defaultExpectation.params) {
+			mmRevokeAllOtherSessions.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRevokeAllOtherSessions.defaultExpectation.params)
+		}
+	}
+
+	return mmRevokeAllOtherSessions
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Usecase.RevokeAllOtherSessions
+func (mmRevokeAllOtherSessions *mUsecaseMockRevokeAllOtherSessions) ExpectCtxParam1(ctx context.Context) *mUsecaseMockRevokeAllOtherSessions {
+	if mmRevokeAllOtherSessions.mock.funcRevokeAllOtherSessions != nil {
+		mmRevokeAllOtherSessions.mock.t.Fatalf("UsecaseMock.RevokeAllOtherSessions mock is already set by Set")
+	}
+
+	if mmRevokeAllOtherSessions.defaultExpectation == nil {
+		mmRevokeAllOtherSessions.defaultExpectation = &UsecaseMockRevokeAllOtherSessionsExpectation{}
+	}
+
+	if mmRevokeAllOtherSessions.defaultExpectation.params != nil {
+		mmRevokeAllOtherSessions.mock.t.Fatalf("UsecaseMock.RevokeAllOtherSessions mock is already set by Expect")
+	}
+
+	if mmRevokeAllOtherSessions.defaultExpectation.paramPtrs == nil {
+		mmRevokeAllOtherSessions.defaultExpectation.paramPtrs = &UsecaseMockRevokeAllOtherSessionsParamPtrs{}
+	}
+	mmRevokeAllOtherSessions.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRevokeAllOtherSessions.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRevokeAllOtherSessions
+}
+
+// ExpectReqParam2 sets up expected param req for Usecase.RevokeAllOtherSessions
+func (mmRevokeAllOtherSessions *mUsecaseMockRevokeAllOtherSessions) ExpectReqParam2(req dto.RevokeAllOtherSessionsRequest) *mUsecaseMockRevokeAllOtherSessions {
+	if mmRevokeAllOtherSessions.mock.funcRevokeAllOtherSessions != nil {
+		mmRevokeAllOtherSessions.mock.t.Fatalf("UsecaseMock.RevokeAllOtherSessions mock is already set by Set")
+	}
+
+	if mmRevokeAllOtherSessions.defaultExpectation == nil {
+		mmRevokeAllOtherSessions.defaultExpectation = &UsecaseMockRevokeAllOtherSessionsExpectation{}
+	}
+
+	if mmRevokeAllOtherSessions.defaultExpectation.params != nil {
+		mmRevokeAllOtherSessions.mock.t.Fatalf("UsecaseMock.RevokeAllOtherSessions mock is already set by Expect")
+	}
+
+	if mmRevokeAllOtherSessions.defaultExpectation.paramPtrs == nil {
+		mmRevokeAllOtherSessions.defaultExpectation.paramPtrs = &UsecaseMockRevokeAllOtherSessionsParamPtrs{}
+	}
+	mmRevokeAllOtherSessions.defaultExpectation.paramPtrs.req = &req
+	mmRevokeAllOtherSessions.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+
+	return mmRevokeAllOtherSessions
+}
+
+// Inspect accepts an inspector function that has same arguments as the Usecase.RevokeAllOtherSessions
+func (mmRevokeAllOtherSessions *mUsecaseMockRevokeAllOtherSessions) Inspect(f func(ctx context.Context, req dto.RevokeAllOtherSessionsRequest)) *mUsecaseMockRevokeAllOtherSessions {
+	if mmRevokeAllOtherSessions.mock.inspectFuncRevokeAllOtherSessions != nil {
+		mmRevokeAllOtherSessions.mock.t.Fatalf("Inspect function is already set for UsecaseMock.RevokeAllOtherSessions")
+	}
+
+	mmRevokeAllOtherSessions.mock.inspectFuncRevokeAllOtherSessions = f
+
+	return mmRevokeAllOtherSessions
+}
+
+// Return sets up results that will be returned by Usecase.RevokeAllOtherSessions
+func (mmRevokeAllOtherSessions *mUsecaseMockRevokeAllOtherSessions) Return(i1 int64, err error) *UsecaseMock {
+	if mmRevokeAllOtherSessions.mock.funcRevokeAllOtherSessions != nil {
+		mmRevokeAllOtherSessions.mock.t.Fatalf("UsecaseMock.RevokeAllOtherSessions mock is already set by Set")
+	}
+
+	if mmRevokeAllOtherSessions.defaultExpectation == nil {
+		mmRevokeAllOtherSessions.defaultExpectation = &UsecaseMockRevokeAllOtherSessionsExpectation{mock: mmRevokeAllOtherSessions.mock}
+	}
+	mmRevokeAllOtherSessions.defaultExpectation.results = &UsecaseMockRevokeAllOtherSessionsResults{i1, err}
+	mmRevokeAllOtherSessions.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRevokeAllOtherSessions.mock
+}
+
+// Set uses given function f to mock the Usecase.RevokeAllOtherSessions method
+func (mmRevokeAllOtherSessions *mUsecaseMockRevokeAllOtherSessions) Set(f func(ctx context.Context, req dto.RevokeAllOtherSessionsRequest) (i1 int64, err error)) *UsecaseMock {
+	if mmRevokeAllOtherSessions.defaultExpectation != nil {
+		mmRevokeAllOtherSessions.mock.t.Fatalf("Default expectation is already set for the Usecase.RevokeAllOtherSessions method")
+	}
+
+	if len(mmRevokeAllOtherSessions.expectations) > 0 {
+		mmRevokeAllOtherSessions.mock.t.Fatalf("Some expectations are already set for the Usecase.RevokeAllOtherSessions method")
+	}
+
+	mmRevokeAllOtherSessions.mock.funcRevokeAllOtherSessions = f
+	mmRevokeAllOtherSessions.mock.funcRevokeAllOtherSessionsOrigin = minimock.CallerInfo(1)
+	return mmRevokeAllOtherSessions.mock
+}
+
+// When sets expectation for the Usecase.RevokeAllOtherSessions which will trigger the result defined by the following
+// Then helper
+func (mmRevokeAllOtherSessions *mUsecaseMockRevokeAllOtherSessions) When(ctx context.Context, req dto.RevokeAllOtherSessionsRequest) *UsecaseMockRevokeAllOtherSessionsExpectation {
+	if mmRevokeAllOtherSessions.mock.funcRevokeAllOtherSessions != nil {
+		mmRevokeAllOtherSessions.mock.t.Fatalf("UsecaseMock.RevokeAllOtherSessions mock is already set by Set")
+	}
+
+	expectation := &UsecaseMockRevokeAllOtherSessionsExpectation{
+		mock:               mmRevokeAllOtherSessions.mock,
+		params:             &UsecaseMockRevokeAllOtherSessionsParams{ctx, req},
+		expectationOrigins: UsecaseMockRevokeAllOtherSessionsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRevokeAllOtherSessions.expectations = append(mmRevokeAllOtherSessions.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Usecase.RevokeAllOtherSessions return parameters for the expectation previously defined by the When method
+func (e *UsecaseMockRevokeAllOtherSessionsExpectation) Then(i1 int64, err error) *UsecaseMock {
+	e.results = &UsecaseMockRevokeAllOtherSessionsResults{i1, err}
 	return e.mock
 }
 
-// Times sets number of times Usecase.Refresh should be invoked
-func (mmRefresh *mUsecaseMockRefresh) Times(n uint64) *mUsecaseMockRefresh {
+// Times sets number of times Usecase.RevokeAllOtherSessions should be invoked
+func (mmRevokeAllOtherSessions *mUsecaseMockRevokeAllOtherSessions) Times(n uint64) *mUsecaseMockRevokeAllOtherSessions {
 	if n == 0 {
-		mmRefresh.mock.t.Fatalf("Times of UsecaseMock.Refresh mock can not be zero")
+		mmRevokeAllOtherSessions.mock.t.Fatalf("Times of UsecaseMock.RevokeAllOtherSessions mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmRefresh.expectedInvocations, n)
-	mmRefresh.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmRefresh
+	mm_atomic.StoreUint64(&mmRevokeAllOtherSessions.expectedInvocations, n)
+	mmRevokeAllOtherSessions.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRevokeAllOtherSessions
 }
 
-func (mmRefresh *mUsecaseMockRefresh) invocationsDone() bool {
-	if len(mmRefresh.expectations) == 0 && mmRefresh.defaultExpectation == nil && mmRefresh.mock.funcRefresh == nil {
+func (mmRevokeAllOtherSessions *mUsecaseMockRevokeAllOtherSessions) invocationsDone() bool {
+	if len(mmRevokeAllOtherSessions.expectations) == 0 && mmRevokeAllOtherSessions.defaultExpectation == nil && mmRevokeAllOtherSessions.mock.funcRevokeAllOtherSessions == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmRefresh.mock.afterRefreshCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmRefresh.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmRevokeAllOtherSessions.mock.afterRevokeAllOtherSessionsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRevokeAllOtherSessions.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// Refresh implements mm_usecase.Usecase
-func (mmRefresh *UsecaseMock) Refresh(ctx context.Context, req dto.RefreshRequest) (up1 *models.User, err error) {
-	mm_atomic.AddUint64(&mmRefresh.beforeRefreshCounter, 1)
-	defer mm_atomic.AddUint64(&mmRefresh.afterRefreshCounter, 1)
+// RevokeAllOtherSessions implements mm_usecase.Usecase
+func (mmRevokeAllOtherSessions *UsecaseMock) RevokeAllOtherSessions(ctx context.Context, req dto.RevokeAllOtherSessionsRequest) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmRevokeAllOtherSessions.beforeRevokeAllOtherSessionsCounter, 1)
+	defer mm_atomic.AddUint64(&mmRevokeAllOtherSessions.afterRevokeAllOtherSessionsCounter, 1)
 
-	mmRefresh.t.Helper()
+	mmRevokeAllOtherSessions.t.Helper()
 
-	if mmRefresh.inspectFuncRefresh != nil {
-		mmRefresh.inspectFuncRefresh(ctx, req)
+	if mmRevokeAllOtherSessions.inspectFuncRevokeAllOtherSessions != nil {
+		mmRevokeAllOtherSessions.inspectFuncRevokeAllOtherSessions(ctx, req)
 	}
 
-	mm_params := UsecaseMockRefreshParams{ctx, req}
+	mm_params := UsecaseMockRevokeAllOtherSessionsParams{ctx, req}
 
 	// Record call args
-	mmRefresh.RefreshMock.mutex.Lock()
-	mmRefresh.RefreshMock.callArgs = append(mmRefresh.RefreshMock.callArgs, &mm_params)
-	mmRefresh.RefreshMock.mutex.Unlock()
+	mmRevokeAllOtherSessions.RevokeAllOtherSessionsMock.mutex.Lock()
+	mmRevokeAllOtherSessions.RevokeAllOtherSessionsMock.callArgs = append(mmRevokeAllOtherSessions.RevokeAllOtherSessionsMock.callArgs, &mm_params)
+	mmRevokeAllOtherSessions.RevokeAllOtherSessionsMock.mutex.Unlock()
 
-	for _, e := range mmRefresh.RefreshMock.expectations {
+	for _, e := range mmRevokeAllOtherSessions.RevokeAllOtherSessionsMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.up1, e.results.err
+			return e.results.i1, e.results.err
 		}
 	}
 
-	if mmRefresh.RefreshMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmRefresh.RefreshMock.defaultExpectation.Counter, 1)
-		mm_want := mmRefresh.RefreshMock.defaultExpectation.params
-		mm_want_ptrs := mmRefresh.RefreshMock.defaultExpectation.paramPtrs
+	if mmRevokeAllOtherSessions.RevokeAllOtherSessionsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRevokeAllOtherSessions.RevokeAllOtherSessionsMock.defaultExpectation.Counter, 1)
+		mm_want := mmRevokeAllOtherSessions.RevokeAllOtherSessionsMock.defaultExpectation.params
+		mm_want_ptrs := mmRevokeAllOtherSessions.RevokeAllOtherSessionsMock.defaultExpectation.paramPtrs
 
-		mm_got := UsecaseMockRefreshParams{ctx, req}
+		mm_got := UsecaseMockRevokeAllOtherSessionsParams{ctx, req}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmRefresh.t.Errorf("UsecaseMock.Refresh got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmRefresh.RefreshMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmRevokeAllOtherSessions.t.Errorf("UsecaseMock.RevokeAllOtherSessions got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevokeAllOtherSessions.RevokeAllOtherSessionsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
 			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
-				mmRefresh.t.Errorf("UsecaseMock.Refresh got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmRefresh.RefreshMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+				mmRevokeAllOtherSessions.t.Errorf("UsecaseMock.RevokeAllOtherSessions got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevokeAllOtherSessions.RevokeAllOtherSessionsMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmRefresh.t.Errorf("UsecaseMock.Refresh got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmRefresh.RefreshMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmRevokeAllOtherSessions.t.Errorf("UsecaseMock.RevokeAllOtherSessions got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRevokeAllOtherSessions.RevokeAllOtherSessionsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmRefresh.RefreshMock.defaultExpectation.results
+		mm_results := mmRevokeAllOtherSessions.RevokeAllOtherSessionsMock.defaultExpectation.results
 		if mm_results == nil {
-			mmRefresh.t.Fatal("No results are set for the UsecaseMock.Refresh")
+			mmRevokeAllOtherSessions.t.Fatal("No results are set for the UsecaseMock.RevokeAllOtherSessions")
 		}
-		return (*mm_results).up1, (*mm_results).err
+		return (*mm_results).i1, (*mm_results).err
 	}
-	if mmRefresh.funcRefresh != nil {
-		return mmRefresh.funcRefresh(ctx, req)
+	if mmRevokeAllOtherSessions.funcRevokeAllOtherSessions != nil {
+		return mmRevokeAllOtherSessions.funcRevokeAllOtherSessions(ctx, req)
 	}
-	mmRefresh.t.Fatalf("Unexpected call to UsecaseMock.Refresh. %v %v", ctx, req)
+	mmRevokeAllOtherSessions.t.Fatalf("Unexpected call to UsecaseMock.RevokeAllOtherSessions. %v %v", ctx, req)
 	return
 }
 
-// RefreshAfterCounter returns a count of finished UsecaseMock.Refresh invocations
-func (mmRefresh *UsecaseMock) RefreshAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmRefresh.afterRefreshCounter)
+// RevokeAllOtherSessionsAfterCounter returns a count of finished UsecaseMock.RevokeAllOtherSessions invocations
+func (mmRevokeAllOtherSessions *UsecaseMock) RevokeAllOtherSessionsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeAllOtherSessions.afterRevokeAllOtherSessionsCounter)
 }
 
-// RefreshBeforeCounter returns a count of UsecaseMock.Refresh invocations
-func (mmRefresh *UsecaseMock) RefreshBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmRefresh.beforeRefreshCounter)
+// RevokeAllOtherSessionsBeforeCounter returns a count of UsecaseMock.RevokeAllOtherSessions invocations
+func (mmRevokeAllOtherSessions *UsecaseMock) RevokeAllOtherSessionsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeAllOtherSessions.beforeRevokeAllOtherSessionsCounter)
 }
 
-// Calls returns a list of arguments used in each call to UsecaseMock.Refresh.
+// Calls returns a list of arguments used in each call to UsecaseMock.RevokeAllOtherSessions.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmRefresh *mUsecaseMockRefresh) Calls() []*UsecaseMockRefreshParams {
-	mmRefresh.mutex.RLock()
+func (mmRevokeAllOtherSessions *mUsecaseMockRevokeAllOtherSessions) Calls() []*UsecaseMockRevokeAllOtherSessionsParams {
+	mmRevokeAllOtherSessions.mutex.RLock()
 
-	argCopy := make([]*UsecaseMockRefreshParams, len(mmRefresh.callArgs))
-	copy(argCopy, mmRefresh.callArgs)
+	argCopy := make([]*UsecaseMockRevokeAllOtherSessionsParams, len(mmRevokeAllOtherSessions.callArgs))
+	copy(argCopy, mmRevokeAllOtherSessions.callArgs)
 
-	mmRefresh.mutex.RUnlock()
+	mmRevokeAllOtherSessions.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockRefreshDone returns true if the count of the Refresh invocations corresponds
+// MinimockRevokeAllOtherSessionsDone returns true if the count of the RevokeAllOtherSessions invocations corresponds
 // the number of defined expectations
-func (m *UsecaseMock) MinimockRefreshDone() bool {
-	if m.RefreshMock.optional {
+func (m *UsecaseMock) MinimockRevokeAllOtherSessionsDone() bool {
+	if m.RevokeAllOtherSessionsMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.RefreshMock.expectations {
+	for _, e := range m.RevokeAllOtherSessionsMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.RefreshMock.invocationsDone()
+	return m.RevokeAllOtherSessionsMock.invocationsDone()
 }
 
-// MinimockRefreshInspect logs each unmet expectation
-func (m *UsecaseMock) MinimockRefreshInspect() {
-	for _, e := range m.RefreshMock.expectations {
+// MinimockRevokeAllOtherSessionsInspect logs each unmet expectation
+func (m *UsecaseMock) MinimockRevokeAllOtherSessionsInspect() {
+	for _, e := range m.RevokeAllOtherSessionsMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to UsecaseMock.Refresh at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to UsecaseMock.RevokeAllOtherSessions at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterRefreshCounter := mm_atomic.LoadUint64(&m.afterRefreshCounter)
+	afterRevokeAllOtherSessionsCounter := mm_atomic.LoadUint64(&m.afterRevokeAllOtherSessionsCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.RefreshMock.defaultExpectation != nil && afterRefreshCounter < 1 {
-		if m.RefreshMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to UsecaseMock.Refresh at\n%s", m.RefreshMock.defaultExpectation.returnOrigin)
+	if m.RevokeAllOtherSessionsMock.defaultExpectation != nil && afterRevokeAllOtherSessionsCounter < 1 {
+		if m.RevokeAllOtherSessionsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UsecaseMock.RevokeAllOtherSessions at\n%s", m.RevokeAllOtherSessionsMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to UsecaseMock.Refresh at\n%s with params: %#v", m.RefreshMock.defaultExpectation.expectationOrigins.origin, *m.RefreshMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to UsecaseMock.RevokeAllOtherSessions at\n%s with params: %#v", m.RevokeAllOtherSessionsMock.defaultExpectation.expectationOrigins.origin, *m.RevokeAllOtherSessionsMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcRefresh != nil && afterRefreshCounter < 1 {
-		m.t.Errorf("Expected call to UsecaseMock.Refresh at\n%s", m.funcRefreshOrigin)
+	if m.funcRevokeAllOtherSessions != nil && afterRevokeAllOtherSessionsCounter < 1 {
+		m.t.Errorf("Expected call to UsecaseMock.RevokeAllOtherSessions at\n%s", m.funcRevokeAllOtherSessionsOrigin)
 	}
 
-	if !m.RefreshMock.invocationsDone() && afterRefreshCounter > 0 {
-		m.t.Errorf("Expected %d calls to UsecaseMock.Refresh at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.RefreshMock.expectedInvocations), m.RefreshMock.expectedInvocationsOrigin, afterRefreshCounter)
+	if !m.RevokeAllOtherSessionsMock.invocationsDone() && afterRevokeAllOtherSessionsCounter > 0 {
+		m.t.Errorf("Expected %d calls to UsecaseMock.RevokeAllOtherSessions at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RevokeAllOtherSessionsMock.expectedInvocations), m.RevokeAllOtherSessionsMock.expectedInvocationsOrigin, afterRevokeAllOtherSessionsCounter)
 	}
 }
 
-type mUsecaseMockRegister struct {
+type mUsecaseMockRevokeSession struct {
 	optional           bool
 	mock               *UsecaseMock
-	defaultExpectation *UsecaseMockRegisterExpectation
-	expectations       []*UsecaseMockRegisterExpectation
+	defaultExpectation *UsecaseMockRevokeSessionExpectation
+	expectations       []*UsecaseMockRevokeSessionExpectation
 
-	callArgs []*UsecaseMockRegisterParams
+	callArgs []*UsecaseMockRevokeSessionParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// UsecaseMockRegisterExpectation specifies expectation struct of the Usecase.Register
-type UsecaseMockRegisterExpectation struct {
+// UsecaseMockRevokeSessionExpectation specifies expectation struct of the Usecase.RevokeSession
+type UsecaseMockRevokeSessionExpectation struct {
 	mock               *UsecaseMock
-	params             *UsecaseMockRegisterParams
-	paramPtrs          *UsecaseMockRegisterParamPtrs
-	expectationOrigins UsecaseMockRegisterExpectationOrigins
-	results            *UsecaseMockRegisterResults
+	params             *UsecaseMockRevokeSessionParams
+	paramPtrs          *UsecaseMockRevokeSessionParamPtrs
+	expectationOrigins UsecaseMockRevokeSessionExpectationOrigins
+	results            *UsecaseMockRevokeSessionResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// UsecaseMockRegisterParams contains parameters of the Usecase.Register
-type UsecaseMockRegisterParams struct {
+// UsecaseMockRevokeSessionParams contains parameters of the Usecase.RevokeSession
+type UsecaseMockRevokeSessionParams struct {
 	ctx context.Context
-	req dto.RegisterRequest
+	req dto.RevokeSessionRequest
 }
 
-// UsecaseMockRegisterParamPtrs contains pointers to parameters of the Usecase.Register
-type UsecaseMockRegisterParamPtrs struct {
+// UsecaseMockRevokeSessionParamPtrs contains pointers to parameters of the Usecase.RevokeSession
+type UsecaseMockRevokeSessionParamPtrs struct {
 	ctx *context.Context
-	req *dto.RegisterRequest
+	req *dto.RevokeSessionRequest
 }
 
-// UsecaseMockRegisterResults contains results of the Usecase.Register
-type UsecaseMockRegisterResults struct {
-	up1 *models.User
+// UsecaseMockRevokeSessionResults contains results of the Usecase.RevokeSession
+type UsecaseMockRevokeSessionResults struct {
+	i1  int64
 	err error
 }
 
-// UsecaseMockRegisterOrigins contains origins of expectations of the Usecase.Register
-type UsecaseMockRegisterExpectationOrigins struct {
+// UsecaseMockRevokeSessionOrigins contains origins of expectations of the Usecase.RevokeSession
+type UsecaseMockRevokeSessionExpectationOrigins struct {
 	origin    string
 	originCtx string
 	originReq string
@@ -802,292 +4970,292 @@ type UsecaseMockRegisterExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmRegister *mUsecaseMockRegister) Optional() *mUsecaseMockRegister {
-	mmRegister.optional = true
-	return mmRegister
+func (mmRevokeSession *mUsecaseMockRevokeSession) Optional() *mUsecaseMockRevokeSession {
+	mmRevokeSession.optional = true
+	return mmRevokeSession
 }
 
-// Expect sets up expected params for Usecase.Register
-func (mmRegister *mUsecaseMockRegister) Expect(ctx context.Context, req dto.RegisterRequest) *mUsecaseMockRegister {
-	if mmRegister.mock.funcRegister != nil {
-		mmRegister.mock.t.Fatalf("UsecaseMock.Register mock is already set by Set")
+// Expect sets up expected params for Usecase.RevokeSession
+func (mmRevokeSession *mUsecaseMockRevokeSession) Expect(ctx context.Context, req dto.RevokeSessionRequest) *mUsecaseMockRevokeSession {
+	if mmRevokeSession.mock.funcRevokeSession != nil {
+		mmRevokeSession.mock.t.Fatalf("UsecaseMock.RevokeSession mock is already set by Set")
 	}
 
-	if mmRegister.defaultExpectation == nil {
-		mmRegister.defaultExpectation = &UsecaseMockRegisterExpectation{}
+	if mmRevokeSession.defaultExpectation == nil {
+		mmRevokeSession.defaultExpectation = &UsecaseMockRevokeSessionExpectation{}
 	}
 
-	if mmRegister.defaultExpectation.paramPtrs != nil {
-		mmRegister.mock.t.Fatalf("UsecaseMock.Register mock is already set by ExpectParams functions")
+	if mmRevokeSession.defaultExpectation.paramPtrs != nil {
+		mmRevokeSession.mock.t.Fatalf("UsecaseMock.RevokeSession mock is already set by ExpectParams functions")
 	}
 
-	mmRegister.defaultExpectation.params = &UsecaseMockRegisterParams{ctx, req}
-	mmRegister.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmRegister.expectations {
-		if minimock.Equal(e.params, mmRegister.defaultExpectation.params) {
-			mmRegister.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRegister.defaultExpectation.params)
+	mmRevokeSession.defaultExpectation.params = &UsecaseMockRevokeSessionParams{ctx, req}
+	mmRevokeSession.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRevokeSession.expectations {
+		if minimock.Equal(e.params, mmRevokeSession.defaultExpectation.params) {
+			mmRevokeSession.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRevokeSession.defaultExpectation.params)
 		}
 	}
 
-	return mmRegister
+	return mmRevokeSession
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Usecase.Register
-func (mmRegister *mUsecaseMockRegister) ExpectCtxParam1(ctx context.Context) *mUsecaseMockRegister {
-	if mmRegister.mock.funcRegister != nil {
-		mmRegister.mock.t.Fatalf("UsecaseMock.Register mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Usecase.RevokeSession
+func (mmRevokeSession *mUsecaseMockRevokeSession) ExpectCtxParam1(ctx context.Context) *mUsecaseMockRevokeSession {
+	if mmRevokeSession.mock.funcRevokeSession != nil {
+		mmRevokeSession.mock.t.Fatalf("UsecaseMock.RevokeSession mock is already set by Set")
 	}
 
-	if mmRegister.defaultExpectation == nil {
-		mmRegister.defaultExpectation = &UsecaseMockRegisterExpectation{}
+	if mmRevokeSession.defaultExpectation == nil {
+		mmRevokeSession.defaultExpectation = &UsecaseMockRevokeSessionExpectation{}
 	}
 
-	if mmRegister.defaultExpectation.params != nil {
-		mmRegister.mock.t.Fatalf("UsecaseMock.Register mock is already set by Expect")
+	if mmRevokeSession.defaultExpectation.params != nil {
+		mmRevokeSession.mock.t.Fatalf("UsecaseMock.RevokeSession mock is already set by Expect")
 	}
 
-	if mmRegister.defaultExpectation.paramPtrs == nil {
-		mmRegister.defaultExpectation.paramPtrs = &UsecaseMockRegisterParamPtrs{}
+	if mmRevokeSession.defaultExpectation.paramPtrs == nil {
+		mmRevokeSession.defaultExpectation.paramPtrs = &UsecaseMockRevokeSessionParamPtrs{}
 	}
-	mmRegister.defaultExpectation.paramPtrs.ctx = &ctx
-	mmRegister.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmRevokeSession.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRevokeSession.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmRegister
+	return mmRevokeSession
 }
 
-// ExpectReqParam2 sets up expected param req for Usecase.Register
-func (mmRegister *mUsecaseMockRegister) ExpectReqParam2(req dto.RegisterRequest) *mUsecaseMockRegister {
-	if mmRegister.mock.funcRegister != nil {
-		mmRegister.mock.t.Fatalf("UsecaseMock.Register mock is already set by Set")
+// ExpectReqParam2 sets up expected param req for Usecase.RevokeSession
+func (mmRevokeSession *mUsecaseMockRevokeSession) ExpectReqParam2(req dto.RevokeSessionRequest) *mUsecaseMockRevokeSession {
+	if mmRevokeSession.mock.funcRevokeSession != nil {
+		mmRevokeSession.mock.t.Fatalf("UsecaseMock.RevokeSession mock is already set by Set")
 	}
 
-	if mmRegister.defaultExpectation == nil {
-		mmRegister.defaultExpectation = &UsecaseMockRegisterExpectation{}
+	if mmRevokeSession.defaultExpectation == nil {
+		mmRevokeSession.defaultExpectation = &UsecaseMockRevokeSessionExpectation{}
 	}
 
-	if mmRegister.defaultExpectation.params != nil {
-		mmRegister.mock.t.Fatalf("UsecaseMock.Register mock is already set by Expect")
+	if mmRevokeSession.defaultExpectation.params != nil {
+		mmRevokeSession.mock.t.Fatalf("UsecaseMock.RevokeSession mock is already set by Expect")
 	}
 
-	if mmRegister.defaultExpectation.paramPtrs == nil {
-		mmRegister.defaultExpectation.paramPtrs = &UsecaseMockRegisterParamPtrs{}
+	if mmRevokeSession.defaultExpectation.paramPtrs == nil {
+		mmRevokeSession.defaultExpectation.paramPtrs = &UsecaseMockRevokeSessionParamPtrs{}
 	}
-	mmRegister.defaultExpectation.paramPtrs.req = &req
-	mmRegister.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+	mmRevokeSession.defaultExpectation.paramPtrs.req = &req
+	mmRevokeSession.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
 
-	return mmRegister
+	return mmRevokeSession
 }
 
-// Inspect accepts an inspector function that has same arguments as the Usecase.Register
-func (mmRegister *mUsecaseMockRegister) Inspect(f func(ctx context.Context, req dto.RegisterRequest)) *mUsecaseMockRegister {
-	if mmRegister.mock.inspectFuncRegister != nil {
-		mmRegister.mock.t.Fatalf("Inspect function is already set for UsecaseMock.Register")
+// Inspect accepts an inspector function that has same arguments as the Usecase.RevokeSession
+func (mmRevokeSession *mUsecaseMockRevokeSession) Inspect(f func(ctx context.Context, req dto.RevokeSessionRequest)) *mUsecaseMockRevokeSession {
+	if mmRevokeSession.mock.inspectFuncRevokeSession != nil {
+		mmRevokeSession.mock.t.Fatalf("Inspect function is already set for UsecaseMock.RevokeSession")
 	}
 
-	mmRegister.mock.inspectFuncRegister = f
+	mmRevokeSession.mock.inspectFuncRevokeSession = f
 
-	return mmRegister
+	return mmRevokeSession
 }
 
-// Return sets up results that will be returned by Usecase.Register
-func (mmRegister *mUsecaseMockRegister) Return(up1 *models.User, err error) *UsecaseMock {
-	if mmRegister.mock.funcRegister != nil {
-		mmRegister.mock.t.Fatalf("UsecaseMock.Register mock is already set by Set")
+// Return sets up results that will be returned by Usecase.RevokeSession
+func (mmRevokeSession *mUsecaseMockRevokeSession) Return(i1 int64, err error) *UsecaseMock {
+	if mmRevokeSession.mock.funcRevokeSession != nil {
+		mmRevokeSession.mock.t.Fatalf("UsecaseMock.RevokeSession mock is already set by Set")
 	}
 
-	if mmRegister.defaultExpectation == nil {
-		mmRegister.defaultExpectation = &UsecaseMockRegisterExpectation{mock: mmRegister.mock}
+	if mmRevokeSession.defaultExpectation == nil {
+		mmRevokeSession.defaultExpectation = &UsecaseMockRevokeSessionExpectation{mock: mmRevokeSession.mock}
 	}
-	mmRegister.defaultExpectation.results = &UsecaseMockRegisterResults{up1, err}
-	mmRegister.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmRegister.mock
+	mmRevokeSession.defaultExpectation.results = &UsecaseMockRevokeSessionResults{i1, err}
+	mmRevokeSession.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRevokeSession.mock
 }
 
-// Set uses given function f to mock the Usecase.Register method
-func (mmRegister *mUsecaseMockRegister) Set(f func(ctx context.Context, req dto.RegisterRequest) (up1 *models.User, err error)) *UsecaseMock {
-	if mmRegister.defaultExpectation != nil {
-		mmRegister.mock.t.Fatalf("Default expectation is already set for the Usecase.Register method")
+// Set uses given function f to mock the Usecase.RevokeSession method
+func (mmRevokeSession *mUsecaseMockRevokeSession) Set(f func(ctx context.Context, req dto.RevokeSessionRequest) (i1 int64, err error)) *UsecaseMock {
+	if mmRevokeSession.defaultExpectation != nil {
+		mmRevokeSession.mock.t.Fatalf("Default expectation is already set for the Usecase.RevokeSession method")
 	}
 
-	if len(mmRegister.expectations) > 0 {
-		mmRegister.mock.t.Fatalf("Some expectations are already set for the Usecase.Register method")
+	if len(mmRevokeSession.expectations) > 0 {
+		mmRevokeSession.mock.t.Fatalf("Some expectations are already set for the Usecase.RevokeSession method")
 	}
 
-	mmRegister.mock.funcRegister = f
-	mmRegister.mock.funcRegisterOrigin = minimock.CallerInfo(1)
-	return mmRegister.mock
+	mmRevokeSession.mock.funcRevokeSession = f
+	mmRevokeSession.mock.funcRevokeSessionOrigin = minimock.CallerInfo(1)
+	return mmRevokeSession.mock
 }
 
-// When sets expectation for the Usecase.Register which will trigger the result defined by the following
+// When sets expectation for the Usecase.RevokeSession which will trigger the result defined by the following
 // Then helper
-func (mmRegister *mUsecaseMockRegister) When(ctx context.Context, req dto.RegisterRequest) *UsecaseMockRegisterExpectation {
-	if mmRegister.mock.funcRegister != nil {
-		mmRegister.mock.t.Fatalf("UsecaseMock.Register mock is already set by Set")
+func (mmRevokeSession *mUsecaseMockRevokeSession) When(ctx context.Context, req dto.RevokeSessionRequest) *UsecaseMockRevokeSessionExpectation {
+	if mmRevokeSession.mock.funcRevokeSession != nil {
+		mmRevokeSession.mock.t.Fatalf("UsecaseMock.RevokeSession mock is already set by Set")
 	}
 
-	expectation := &UsecaseMockRegisterExpectation{
-		mock:               mmRegister.mock,
-		params:             &UsecaseMockRegisterParams{ctx, req},
-		expectationOrigins: UsecaseMockRegisterExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &UsecaseMockRevokeSessionExpectation{
+		mock:               mmRevokeSession.mock,
+		params:             &UsecaseMockRevokeSessionParams{ctx, req},
+		expectationOrigins: UsecaseMockRevokeSessionExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmRegister.expectations = append(mmRegister.expectations, expectation)
+	mmRevokeSession.expectations = append(mmRevokeSession.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Usecase.Register return parameters for the expectation previously defined by the When method
-func (e *UsecaseMockRegisterExpectation) Then(up1 *models.User, err error) *UsecaseMock {
-	e.results = &UsecaseMockRegisterResults{up1, err}
+// Then sets up Usecase.RevokeSession return parameters for the expectation previously defined by the When method
+func (e *UsecaseMockRevokeSessionExpectation) Then(i1 int64, err error) *UsecaseMock {
+	e.results = &UsecaseMockRevokeSessionResults{i1, err}
 	return e.mock
 }
 
-// Times sets number of times Usecase.Register should be invoked
-func (mmRegister *mUsecaseMockRegister) Times(n uint64) *mUsecaseMockRegister {
+// Times sets number of times Usecase.RevokeSession should be invoked
+func (mmRevokeSession *mUsecaseMockRevokeSession) Times(n uint64) *mUsecaseMockRevokeSession {
 	if n == 0 {
-		mmRegister.mock.t.Fatalf("Times of UsecaseMock.Register mock can not be zero")
+		mmRevokeSession.mock.t.Fatalf("Times of UsecaseMock.RevokeSession mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmRegister.expectedInvocations, n)
-	mmRegister.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmRegister
+	mm_atomic.StoreUint64(&mmRevokeSession.expectedInvocations, n)
+	mmRevokeSession.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRevokeSession
 }
 
-func (mmRegister *mUsecaseMockRegister) invocationsDone() bool {
-	if len(mmRegister.expectations) == 0 && mmRegister.defaultExpectation == nil && mmRegister.mock.funcRegister == nil {
+func (mmRevokeSession *mUsecaseMockRevokeSession) invocationsDone() bool {
+	if len(mmRevokeSession.expectations) == 0 && mmRevokeSession.defaultExpectation == nil && mmRevokeSession.mock.funcRevokeSession == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmRegister.mock.afterRegisterCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmRegister.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmRevokeSession.mock.afterRevokeSessionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRevokeSession.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// Register implements mm_usecase.Usecase
-func (mmRegister *UsecaseMock) Register(ctx context.Context, req dto.RegisterRequest) (up1 *models.User, err error) {
-	mm_atomic.AddUint64(&mmRegister.beforeRegisterCounter, 1)
-	defer mm_atomic.AddUint64(&mmRegister.afterRegisterCounter, 1)
+// RevokeSession implements mm_usecase.Usecase
+func (mmRevokeSession *UsecaseMock) RevokeSession(ctx context.Context, req dto.RevokeSessionRequest) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmRevokeSession.beforeRevokeSessionCounter, 1)
+	defer mm_atomic.AddUint64(&mmRevokeSession.afterRevokeSessionCounter, 1)
 
-	mmRegister.t.Helper()
+	mmRevokeSession.t.Helper()
 
-	if mmRegister.inspectFuncRegister != nil {
-		mmRegister.inspectFuncRegister(ctx, req)
+	if mmRevokeSession.inspectFuncRevokeSession != nil {
+		mmRevokeSession.inspectFuncRevokeSession(ctx, req)
 	}
 
-	mm_params := UsecaseMockRegisterParams{ctx, req}
+	mm_params := UsecaseMockRevokeSessionParams{ctx, req}
 
 	// Record call args
-	mmRegister.RegisterMock.mutex.Lock()
-	mmRegister.RegisterMock.callArgs = append(mmRegister.RegisterMock.callArgs, &mm_params)
-	mmRegister.RegisterMock.mutex.Unlock()
+	mmRevokeSession.RevokeSessionMock.mutex.Lock()
+	mmRevokeSession.RevokeSessionMock.callArgs = append(mmRevokeSession.RevokeSessionMock.callArgs, &mm_params)
+	mmRevokeSession.RevokeSessionMock.mutex.Unlock()
 
-	for _, e := range mmRegister.RegisterMock.expectations {
+	for _, e := range mmRevokeSession.RevokeSessionMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.up1, e.results.err
+			return e.results.i1, e.results.err
 		}
 	}
 
-	if mmRegister.RegisterMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmRegister.RegisterMock.defaultExpectation.Counter, 1)
-		mm_want := mmRegister.RegisterMock.defaultExpectation.params
-		mm_want_ptrs := mmRegister.RegisterMock.defaultExpectation.paramPtrs
+	if mmRevokeSession.RevokeSessionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRevokeSession.RevokeSessionMock.defaultExpectation.Counter, 1)
+		mm_want := mmRevokeSession.RevokeSessionMock.defaultExpectation.params
+		mm_want_ptrs := mmRevokeSession.RevokeSessionMock.defaultExpectation.paramPtrs
 
-		mm_got := UsecaseMockRegisterParams{ctx, req}
+		mm_got := UsecaseMockRevokeSessionParams{ctx, req}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmRegister.t.Errorf("UsecaseMock.Register got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmRegister.RegisterMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmRevokeSession.t.Errorf("UsecaseMock.RevokeSession got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevokeSession.RevokeSessionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
 			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
-				mmRegister.t.Errorf("UsecaseMock.Register got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmRegister.RegisterMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+				mmRevokeSession.t.Errorf("UsecaseMock.RevokeSession got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevokeSession.RevokeSessionMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmRegister.t.Errorf("UsecaseMock.Register got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmRegister.RegisterMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmRevokeSession.t.Errorf("UsecaseMock.RevokeSession got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRevokeSession.RevokeSessionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmRegister.RegisterMock.defaultExpectation.results
+		mm_results := mmRevokeSession.RevokeSessionMock.defaultExpectation.results
 		if mm_results == nil {
-			mmRegister.t.Fatal("No results are set for the UsecaseMock.Register")
+			mmRevokeSession.t.Fatal("No results are set for the UsecaseMock.RevokeSession")
 		}
-		return (*mm_results).up1, (*mm_results).err
+		return (*mm_results).i1, (*mm_results).err
 	}
-	if mmRegister.funcRegister != nil {
-		return mmRegister.funcRegister(ctx, req)
+	if mmRevokeSession.funcRevokeSession != nil {
+		return mmRevokeSession.funcRevokeSession(ctx, req)
 	}
-	mmRegister.t.Fatalf("Unexpected call to UsecaseMock.Register. %v %v", ctx, req)
+	mmRevokeSession.t.Fatalf("Unexpected call to UsecaseMock.RevokeSession. %v %v", ctx, req)
 	return
 }
 
-// RegisterAfterCounter returns a count of finished UsecaseMock.Register invocations
-func (mmRegister *UsecaseMock) RegisterAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmRegister.afterRegisterCounter)
+// RevokeSessionAfterCounter returns a count of finished UsecaseMock.RevokeSession invocations
+func (mmRevokeSession *UsecaseMock) RevokeSessionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeSession.afterRevokeSessionCounter)
 }
 
-// RegisterBeforeCounter returns a count of UsecaseMock.Register invocations
-func (mmRegister *UsecaseMock) RegisterBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmRegister.beforeRegisterCounter)
+// RevokeSessionBeforeCounter returns a count of UsecaseMock.RevokeSession invocations
+func (mmRevokeSession *UsecaseMock) RevokeSessionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeSession.beforeRevokeSessionCounter)
 }
 
-// Calls returns a list of arguments used in each call to UsecaseMock.Register.
+// Calls returns a list of arguments used in each call to UsecaseMock.RevokeSession.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmRegister *mUsecaseMockRegister) Calls() []*UsecaseMockRegisterParams {
-	mmRegister.mutex.RLock()
+func (mmRevokeSession *mUsecaseMockRevokeSession) Calls() []*UsecaseMockRevokeSessionParams {
+	mmRevokeSession.mutex.RLock()
 
-	argCopy := make([]*UsecaseMockRegisterParams, len(mmRegister.callArgs))
-	copy(argCopy, mmRegister.callArgs)
+	argCopy := make([]*UsecaseMockRevokeSessionParams, len(mmRevokeSession.callArgs))
+	copy(argCopy, mmRevokeSession.callArgs)
 
-	mmRegister.mutex.RUnlock()
+	mmRevokeSession.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockRegisterDone returns true if the count of the Register invocations corresponds
+// MinimockRevokeSessionDone returns true if the count of the RevokeSession invocations corresponds
 // the number of defined expectations
-func (m *UsecaseMock) MinimockRegisterDone() bool {
-	if m.RegisterMock.optional {
+func (m *UsecaseMock) MinimockRevokeSessionDone() bool {
+	if m.RevokeSessionMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.RegisterMock.expectations {
+	for _, e := range m.RevokeSessionMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.RegisterMock.invocationsDone()
+	return m.RevokeSessionMock.invocationsDone()
 }
 
-// MinimockRegisterInspect logs each unmet expectation
-func (m *UsecaseMock) MinimockRegisterInspect() {
-	for _, e := range m.RegisterMock.expectations {
+// MinimockRevokeSessionInspect logs each unmet expectation
+func (m *UsecaseMock) MinimockRevokeSessionInspect() {
+	for _, e := range m.RevokeSessionMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to UsecaseMock.Register at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to UsecaseMock.RevokeSession at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterRegisterCounter := mm_atomic.LoadUint64(&m.afterRegisterCounter)
+	afterRevokeSessionCounter := mm_atomic.LoadUint64(&m.afterRevokeSessionCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.RegisterMock.defaultExpectation != nil && afterRegisterCounter < 1 {
-		if m.RegisterMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to UsecaseMock.Register at\n%s", m.RegisterMock.defaultExpectation.returnOrigin)
+	if m.RevokeSessionMock.defaultExpectation != nil && afterRevokeSessionCounter < 1 {
+		if m.RevokeSessionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UsecaseMock.RevokeSession at\n%s", m.RevokeSessionMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to UsecaseMock.Register at\n%s with params: %#v", m.RegisterMock.defaultExpectation.expectationOrigins.origin, *m.RegisterMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to UsecaseMock.RevokeSession at\n%s with params: %#v", m.RevokeSessionMock.defaultExpectation.expectationOrigins.origin, *m.RevokeSessionMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcRegister != nil && afterRegisterCounter < 1 {
-		m.t.Errorf("Expected call to UsecaseMock.Register at\n%s", m.funcRegisterOrigin)
+	if m.funcRevokeSession != nil && afterRevokeSessionCounter < 1 {
+		m.t.Errorf("Expected call to UsecaseMock.RevokeSession at\n%s", m.funcRevokeSessionOrigin)
 	}
 
-	if !m.RegisterMock.invocationsDone() && afterRegisterCounter > 0 {
-		m.t.Errorf("Expected %d calls to UsecaseMock.Register at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.RegisterMock.expectedInvocations), m.RegisterMock.expectedInvocationsOrigin, afterRegisterCounter)
+	if !m.RevokeSessionMock.invocationsDone() && afterRevokeSessionCounter > 0 {
+		m.t.Errorf("Expected %d calls to UsecaseMock.RevokeSession at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RevokeSessionMock.expectedInvocations), m.RevokeSessionMock.expectedInvocationsOrigin, afterRevokeSessionCounter)
 	}
 }
 
@@ -1095,11 +5263,35 @@ func (m *UsecaseMock) MinimockRegisterInspect() {
 func (m *UsecaseMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
+			m.MinimockChangeEmailInspect()
+
+			m.MinimockChangePasswordInspect()
+
+			m.MinimockConfirmEmailInspect()
+
+			m.MinimockGetJWKSInspect()
+
+			m.MinimockGetRevocationsInspect()
+
+			m.MinimockListSessionsInspect()
+
 			m.MinimockLoginInspect()
 
+			m.MinimockLogoutInspect()
+
 			m.MinimockRefreshInspect()
 
 			m.MinimockRegisterInspect()
+
+			m.MinimockRequestEmailVerificationInspect()
+
+			m.MinimockRequestPasswordResetInspect()
+
+			m.MinimockResetPasswordInspect()
+
+			m.MinimockRevokeAllOtherSessionsInspect()
+
+			m.MinimockRevokeSessionInspect()
 		}
 	})
 }
@@ -1123,7 +5315,19 @@ func (m *UsecaseMock) MinimockWait(timeout mm_time.Duration) {
 func (m *UsecaseMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockChangeEmailDone() &&
+		m.MinimockChangePasswordDone() &&
+		m.MinimockConfirmEmailDone() &&
+		m.MinimockGetJWKSDone() &&
+		m.MinimockGetRevocationsDone() &&
+		m.MinimockListSessionsDone() &&
 		m.MinimockLoginDone() &&
+		m.MinimockLogoutDone() &&
 		m.MinimockRefreshDone() &&
-		m.MinimockRegisterDone()
+		m.MinimockRegisterDone() &&
+		m.MinimockRequestEmailVerificationDone() &&
+		m.MinimockRequestPasswordResetDone() &&
+		m.MinimockResetPasswordDone() &&
+		m.MinimockRevokeAllOtherSessionsDone() &&
+		m.MinimockRevokeSessionDone()
 }
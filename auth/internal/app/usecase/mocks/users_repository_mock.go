@@ -17,6 +17,34 @@ type UsersRepositoryMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
+	funcApplyPendingEmail          func(ctx context.Context, userID string) (err error)
+	funcApplyPendingEmailOrigin    string
+	inspectFuncApplyPendingEmail   func(ctx context.Context, userID string)
+	afterApplyPendingEmailCounter  uint64
+	beforeApplyPendingEmailCounter uint64
+	ApplyPendingEmailMock          mUsersRepositoryMockApplyPendingEmail
+
+	funcCompleteRegistration          func(ctx context.Context, userID string) (err error)
+	funcCompleteRegistrationOrigin    string
+	inspectFuncCompleteRegistration   func(ctx context.Context, userID string)
+	afterCompleteRegistrationCounter  uint64
+	beforeCompleteRegistrationCounter uint64
+	CompleteRegistrationMock          mUsersRepositoryMockCompleteRegistration
+
+	funcCreateUser          func(ctx context.Context, email string, passwordHash string) (up1 *models.User, err error)
+	funcCreateUserOrigin    string
+	inspectFuncCreateUser   func(ctx context.Context, email string, passwordHash string)
+	afterCreateUserCounter  uint64
+	beforeCreateUserCounter uint64
+	CreateUserMock          mUsersRepositoryMockCreateUser
+
+	funcDeletePendingUser          func(ctx context.Context, userID string) (err error)
+	funcDeletePendingUserOrigin    string
+	inspectFuncDeletePendingUser   func(ctx context.Context, userID string)
+	afterDeletePendingUserCounter  uint64
+	beforeDeletePendingUserCounter uint64
+	DeletePendingUserMock          mUsersRepositoryMockDeletePendingUser
+
 	funcGetUserByEmail          func(ctx context.Context, email string) (up1 *models.User, err error)
 	funcGetUserByEmailOrigin    string
 	inspectFuncGetUserByEmail   func(ctx context.Context, email string)
@@ -31,19 +59,33 @@ type UsersRepositoryMock struct {
 	beforeGetUserByIDCounter uint64
 	GetUserByIDMock          mUsersRepositoryMockGetUserByID
 
-	funcSaveUser          func(ctx context.Context, email string, password string) (up1 *models.User, err error)
-	funcSaveUserOrigin    string
-	inspectFuncSaveUser   func(ctx context.Context, email string, password string)
-	afterSaveUserCounter  uint64
-	beforeSaveUserCounter uint64
-	SaveUserMock          mUsersRepositoryMockSaveUser
-
-	funcUpdateUser          func(ctx context.Context, user *models.User) (err error)
-	funcUpdateUserOrigin    string
-	inspectFuncUpdateUser   func(ctx context.Context, user *models.User)
-	afterUpdateUserCounter  uint64
-	beforeUpdateUserCounter uint64
-	UpdateUserMock          mUsersRepositoryMockUpdateUser
+	funcMarkEmailVerified          func(ctx context.Context, userID string) (err error)
+	funcMarkEmailVerifiedOrigin    string
+	inspectFuncMarkEmailVerified   func(ctx context.Context, userID string)
+	afterMarkEmailVerifiedCounter  uint64
+	beforeMarkEmailVerifiedCounter uint64
+	MarkEmailVerifiedMock          mUsersRepositoryMockMarkEmailVerified
+
+	funcSetPasswordHash          func(ctx context.Context, userID string, passwordHash string) (err error)
+	funcSetPasswordHashOrigin    string
+	inspectFuncSetPasswordHash   func(ctx context.Context, userID string, passwordHash string)
+	afterSetPasswordHashCounter  uint64
+	beforeSetPasswordHashCounter uint64
+	SetPasswordHashMock          mUsersRepositoryMockSetPasswordHash
+
+	funcSetPendingEmail          func(ctx context.Context, userID string, email *string) (err error)
+	funcSetPendingEmailOrigin    string
+	inspectFuncSetPendingEmail   func(ctx context.Context, userID string, email *string)
+	afterSetPendingEmailCounter  uint64
+	beforeSetPendingEmailCounter uint64
+	SetPendingEmailMock          mUsersRepositoryMockSetPendingEmail
+
+	funcUpdatePasswordHash          func(ctx context.Context, userID string, oldHash string, newHash string) (b1 bool, err error)
+	funcUpdatePasswordHashOrigin    string
+	inspectFuncUpdatePasswordHash   func(ctx context.Context, userID string, oldHash string, newHash string)
+	afterUpdatePasswordHashCounter  uint64
+	beforeUpdatePasswordHashCounter uint64
+	UpdatePasswordHashMock          mUsersRepositoryMockUpdatePasswordHash
 }
 
 // NewUsersRepositoryMock returns a mock for mm_usecase.UsersRepository
@@ -54,70 +96,87 @@ func NewUsersRepositoryMock(t minimock.Tester) *UsersRepositoryMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.ApplyPendingEmailMock = mUsersRepositoryMockApplyPendingEmail{mock: m}
+	m.ApplyPendingEmailMock.callArgs = []*UsersRepositoryMockApplyPendingEmailParams{}
+
+	m.CompleteRegistrationMock = mUsersRepositoryMockCompleteRegistration{mock: m}
+	m.CompleteRegistrationMock.callArgs = []*UsersRepositoryMockCompleteRegistrationParams{}
+
+	m.CreateUserMock = mUsersRepositoryMockCreateUser{mock: m}
+	m.CreateUserMock.callArgs = []*UsersRepositoryMockCreateUserParams{}
+
+	m.DeletePendingUserMock = mUsersRepositoryMockDeletePendingUser{mock: m}
+	m.DeletePendingUserMock.callArgs = []*UsersRepositoryMockDeletePendingUserParams{}
+
 	m.GetUserByEmailMock = mUsersRepositoryMockGetUserByEmail{mock: m}
 	m.GetUserByEmailMock.callArgs = []*UsersRepositoryMockGetUserByEmailParams{}
 
 	m.GetUserByIDMock = mUsersRepositoryMockGetUserByID{mock: m}
 	m.GetUserByIDMock.callArgs = []*UsersRepositoryMockGetUserByIDParams{}
 
-	m.SaveUserMock = mUsersRepositoryMockSaveUser{mock: m}
-	m.SaveUserMock.callArgs = []*UsersRepositoryMockSaveUserParams{}
+	m.MarkEmailVerifiedMock = mUsersRepositoryMockMarkEmailVerified{mock: m}
+	m.MarkEmailVerifiedMock.callArgs = []*UsersRepositoryMockMarkEmailVerifiedParams{}
+
+	m.SetPasswordHashMock = mUsersRepositoryMockSetPasswordHash{mock: m}
+	m.SetPasswordHashMock.callArgs = []*UsersRepositoryMockSetPasswordHashParams{}
+
+	m.SetPendingEmailMock = mUsersRepositoryMockSetPendingEmail{mock: m}
+	m.SetPendingEmailMock.callArgs = []*UsersRepositoryMockSetPendingEmailParams{}
 
-	m.UpdateUserMock = mUsersRepositoryMockUpdateUser{mock: m}
-	m.UpdateUserMock.callArgs = []*UsersRepositoryMockUpdateUserParams{}
+	m.UpdatePasswordHashMock = mUsersRepositoryMockUpdatePasswordHash{mock: m}
+	m.UpdatePasswordHashMock.callArgs = []*UsersRepositoryMockUpdatePasswordHashParams{}
 
 	t.Cleanup(m.MinimockFinish)
 
 	return m
 }
 
-type mUsersRepositoryMockGetUserByEmail struct {
+type mUsersRepositoryMockApplyPendingEmail struct {
 	optional           bool
 	mock               *UsersRepositoryMock
-	defaultExpectation *UsersRepositoryMockGetUserByEmailExpectation
-	expectations       []*UsersRepositoryMockGetUserByEmailExpectation
+	defaultExpectation *UsersRepositoryMockApplyPendingEmailExpectation
+	expectations       []*UsersRepositoryMockApplyPendingEmailExpectation
 
-	callArgs []*UsersRepositoryMockGetUserByEmailParams
+	callArgs []*UsersRepositoryMockApplyPendingEmailParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// UsersRepositoryMockGetUserByEmailExpectation specifies expectation struct of the UsersRepository.GetUserByEmail
-type UsersRepositoryMockGetUserByEmailExpectation struct {
+// UsersRepositoryMockApplyPendingEmailExpectation specifies expectation struct of the UsersRepository.ApplyPendingEmail
+type UsersRepositoryMockApplyPendingEmailExpectation struct {
 	mock               *UsersRepositoryMock
-	params             *UsersRepositoryMockGetUserByEmailParams
-	paramPtrs          *UsersRepositoryMockGetUserByEmailParamPtrs
-	expectationOrigins UsersRepositoryMockGetUserByEmailExpectationOrigins
-	results            *UsersRepositoryMockGetUserByEmailResults
+	params             *UsersRepositoryMockApplyPendingEmailParams
+	paramPtrs          *UsersRepositoryMockApplyPendingEmailParamPtrs
+	expectationOrigins UsersRepositoryMockApplyPendingEmailExpectationOrigins
+	results            *UsersRepositoryMockApplyPendingEmailResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// UsersRepositoryMockGetUserByEmailParams contains parameters of the UsersRepository.GetUserByEmail
-type UsersRepositoryMockGetUserByEmailParams struct {
-	ctx   context.Context
-	email string
+// UsersRepositoryMockApplyPendingEmailParams contains parameters of the UsersRepository.ApplyPendingEmail
+type UsersRepositoryMockApplyPendingEmailParams struct {
+	ctx    context.Context
+	userID string
 }
 
-// UsersRepositoryMockGetUserByEmailParamPtrs contains pointers to parameters of the UsersRepository.GetUserByEmail
-type UsersRepositoryMockGetUserByEmailParamPtrs struct {
-	ctx   *context.Context
-	email *string
+// UsersRepositoryMockApplyPendingEmailParamPtrs contains pointers to parameters of the UsersRepository.ApplyPendingEmail
+type UsersRepositoryMockApplyPendingEmailParamPtrs struct {
+	ctx    *context.Context
+	userID *string
 }
 
-// UsersRepositoryMockGetUserByEmailResults contains results of the UsersRepository.GetUserByEmail
-type UsersRepositoryMockGetUserByEmailResults struct {
-	up1 *models.User
+// UsersRepositoryMockApplyPendingEmailResults contains results of the UsersRepository.ApplyPendingEmail
+type UsersRepositoryMockApplyPendingEmailResults struct {
 	err error
 }
 
-// UsersRepositoryMockGetUserByEmailOrigins contains origins of expectations of the UsersRepository.GetUserByEmail
-type UsersRepositoryMockGetUserByEmailExpectationOrigins struct {
-	origin      string
-	originCtx   string
-	originEmail string
+// UsersRepositoryMockApplyPendingEmailOrigins contains origins of expectations of the UsersRepository.ApplyPendingEmail
+type UsersRepositoryMockApplyPendingEmailExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -125,342 +184,2430 @@ type UsersRepositoryMockGetUserByEmailExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetUserByEmail *mUsersRepositoryMockGetUserByEmail) Optional() *mUsersRepositoryMockGetUserByEmail {
-	mmGetUserByEmail.optional = true
-	return mmGetUserByEmail
+func (mmApplyPendingEmail *mUsersRepositoryMockApplyPendingEmail) Optional() *mUsersRepositoryMockApplyPendingEmail {
+	mmApplyPendingEmail.optional = true
+	return mmApplyPendingEmail
 }
 
-// Expect sets up expected params for UsersRepository.GetUserByEmail
-func (mmGetUserByEmail *mUsersRepositoryMockGetUserByEmail) Expect(ctx context.Context, email string) *mUsersRepositoryMockGetUserByEmail {
-	if mmGetUserByEmail.mock.funcGetUserByEmail != nil {
-		mmGetUserByEmail.mock.t.Fatalf("UsersRepositoryMock.GetUserByEmail mock is already set by Set")
+// Expect sets up expected params for UsersRepository.ApplyPendingEmail
+func (mmApplyPendingEmail *mUsersRepositoryMockApplyPendingEmail) Expect(ctx context.Context, userID string) *mUsersRepositoryMockApplyPendingEmail {
+	if mmApplyPendingEmail.mock.funcApplyPendingEmail != nil {
+		mmApplyPendingEmail.mock.t.Fatalf("UsersRepositoryMock.ApplyPendingEmail mock is already set by Set")
 	}
 
-	if mmGetUserByEmail.defaultExpectation == nil {
-		mmGetUserByEmail.defaultExpectation = &UsersRepositoryMockGetUserByEmailExpectation{}
+	if mmApplyPendingEmail.defaultExpectation == nil {
+		mmApplyPendingEmail.defaultExpectation = &UsersRepositoryMockApplyPendingEmailExpectation{}
 	}
 
-	if mmGetUserByEmail.defaultExpectation.paramPtrs != nil {
-		mmGetUserByEmail.mock.t.Fatalf("UsersRepositoryMock.GetUserByEmail mock is already set by ExpectParams functions")
+	if mmApplyPendingEmail.defaultExpectation.paramPtrs != nil {
+		mmApplyPendingEmail.mock.t.Fatalf("UsersRepositoryMock.ApplyPendingEmail mock is already set by ExpectParams functions")
 	}
 
-	mmGetUserByEmail.defaultExpectation.params = &UsersRepositoryMockGetUserByEmailParams{ctx, email}
-	mmGetUserByEmail.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetUserByEmail.expectations {
-		if minimock.Equal(e.params, mmGetUserByEmail.defaultExpectation.params) {
-			mmGetUserByEmail.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetUserByEmail.defaultExpectation.params)
+	mmApplyPendingEmail.defaultExpectation.params = &UsersRepositoryMockApplyPendingEmailParams{ctx, userID}
+	mmApplyPendingEmail.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmApplyPendingEmail.expectations {
+		if minimock.Equal(e.params, mmApplyPendingEmail.defaultExpectation.params) {
+			mmApplyPendingEmail.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmApplyPendingEmail.defaultExpectation.params)
 		}
 	}
 
-	return mmGetUserByEmail
+	return mmApplyPendingEmail
 }
 
-// ExpectCtxParam1 sets up expected param ctx for UsersRepository.GetUserByEmail
-func (mmGetUserByEmail *mUsersRepositoryMockGetUserByEmail) ExpectCtxParam1(ctx context.Context) *mUsersRepositoryMockGetUserByEmail {
-	if mmGetUserByEmail.mock.funcGetUserByEmail != nil {
-		mmGetUserByEmail.mock.t.Fatalf("UsersRepositoryMock.GetUserByEmail mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for UsersRepository.ApplyPendingEmail
+func (mmApplyPendingEmail *mUsersRepositoryMockApplyPendingEmail) ExpectCtxParam1(ctx context.Context) *mUsersRepositoryMockApplyPendingEmail {
+	if mmApplyPendingEmail.mock.funcApplyPendingEmail != nil {
+		mmApplyPendingEmail.mock.t.Fatalf("UsersRepositoryMock.ApplyPendingEmail mock is already set by Set")
 	}
 
-	if mmGetUserByEmail.defaultExpectation == nil {
-		mmGetUserByEmail.defaultExpectation = &UsersRepositoryMockGetUserByEmailExpectation{}
+	if mmApplyPendingEmail.defaultExpectation == nil {
+		mmApplyPendingEmail.defaultExpectation = &UsersRepositoryMockApplyPendingEmailExpectation{}
 	}
 
-	if mmGetUserByEmail.defaultExpectation.params != nil {
-		mmGetUserByEmail.mock.t.Fatalf("UsersRepositoryMock.GetUserByEmail mock is already set by Expect")
+	if mmApplyPendingEmail.defaultExpectation.params != nil {
+		mmApplyPendingEmail.mock.t.Fatalf("UsersRepositoryMock.ApplyPendingEmail mock is already set by Expect")
 	}
 
-	if mmGetUserByEmail.defaultExpectation.paramPtrs == nil {
-		mmGetUserByEmail.defaultExpectation.paramPtrs = &UsersRepositoryMockGetUserByEmailParamPtrs{}
+	if mmApplyPendingEmail.defaultExpectation.paramPtrs == nil {
+		mmApplyPendingEmail.defaultExpectation.paramPtrs = &UsersRepositoryMockApplyPendingEmailParamPtrs{}
 	}
-	mmGetUserByEmail.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGetUserByEmail.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmApplyPendingEmail.defaultExpectation.paramPtrs.ctx = &ctx
+	mmApplyPendingEmail.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetUserByEmail
+	return mmApplyPendingEmail
 }
 
-// ExpectEmailParam2 sets up expected param email for UsersRepository.GetUserByEmail
-func (mmGetUserByEmail *mUsersRepositoryMockGetUserByEmail) ExpectEmailParam2(email string) *mUsersRepositoryMockGetUserByEmail {
-	if mmGetUserByEmail.mock.funcGetUserByEmail != nil {
-		mmGetUserByEmail.mock.t.Fatalf("UsersRepositoryMock.GetUserByEmail mock is already set by Set")
+// ExpectUserIDParam2 sets up expected param userID for UsersRepository.ApplyPendingEmail
+func (mmApplyPendingEmail *mUsersRepositoryMockApplyPendingEmail) ExpectUserIDParam2(userID string) *mUsersRepositoryMockApplyPendingEmail {
+	if mmApplyPendingEmail.mock.funcApplyPendingEmail != nil {
+		mmApplyPendingEmail.mock.t.Fatalf("UsersRepositoryMock.ApplyPendingEmail mock is already set by Set")
 	}
 
-	if mmGetUserByEmail.defaultExpectation == nil {
-		mmGetUserByEmail.defaultExpectation = &UsersRepositoryMockGetUserByEmailExpectation{}
+	if mmApplyPendingEmail.defaultExpectation == nil {
+		mmApplyPendingEmail.defaultExpectation = &UsersRepositoryMockApplyPendingEmailExpectation{}
 	}
 
-	if mmGetUserByEmail.defaultExpectation.params != nil {
-		mmGetUserByEmail.mock.t.Fatalf("UsersRepositoryMock.GetUserByEmail mock is already set by Expect")
+	if mmApplyPendingEmail.defaultExpectation.params != nil {
+		mmApplyPendingEmail.mock.t.Fatalf("UsersRepositoryMock.ApplyPendingEmail mock is already set by Expect")
 	}
 
-	if mmGetUserByEmail.defaultExpectation.paramPtrs == nil {
-		mmGetUserByEmail.defaultExpectation.paramPtrs = &UsersRepositoryMockGetUserByEmailParamPtrs{}
+	if mmApplyPendingEmail.defaultExpectation.paramPtrs == nil {
+		mmApplyPendingEmail.defaultExpectation.paramPtrs = &UsersRepositoryMockApplyPendingEmailParamPtrs{}
 	}
-	mmGetUserByEmail.defaultExpectation.paramPtrs.email = &email
-	mmGetUserByEmail.defaultExpectation.expectationOrigins.originEmail = minimock.CallerInfo(1)
+	mmApplyPendingEmail.defaultExpectation.paramPtrs.userID = &userID
+	mmApplyPendingEmail.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
 
-	return mmGetUserByEmail
+	return mmApplyPendingEmail
 }
 
-// Inspect accepts an inspector function that has same arguments as the UsersRepository.GetUserByEmail
-func (mmGetUserByEmail *mUsersRepositoryMockGetUserByEmail) Inspect(f func(ctx context.Context, email string)) *mUsersRepositoryMockGetUserByEmail {
-	if mmGetUserByEmail.mock.inspectFuncGetUserByEmail != nil {
-		mmGetUserByEmail.mock.t.Fatalf("Inspect function is already set for UsersRepositoryMock.GetUserByEmail")
+// Inspect accepts an inspector function that has same arguments as the UsersRepository.ApplyPendingEmail
+func (mmApplyPendingEmail *mUsersRepositoryMockApplyPendingEmail) Inspect(f func(ctx context.Context, userID string)) *mUsersRepositoryMockApplyPendingEmail {
+	if mmApplyPendingEmail.mock.inspectFuncApplyPendingEmail != nil {
+		mmApplyPendingEmail.mock.t.Fatalf("Inspect function is already set for UsersRepositoryMock.ApplyPendingEmail")
 	}
 
-	mmGetUserByEmail.mock.inspectFuncGetUserByEmail = f
+	mmApplyPendingEmail.mock.inspectFuncApplyPendingEmail = f
 
-	return mmGetUserByEmail
+	return mmApplyPendingEmail
 }
 
-// Return sets up results that will be returned by UsersRepository.GetUserByEmail
-func (mmGetUserByEmail *mUsersRepositoryMockGetUserByEmail) Return(up1 *models.User, err error) *UsersRepositoryMock {
-	if mmGetUserByEmail.mock.funcGetUserByEmail != nil {
-		mmGetUserByEmail.mock.t.Fatalf("UsersRepositoryMock.GetUserByEmail mock is already set by Set")
+// Return sets up results that will be returned by UsersRepository.ApplyPendingEmail
+func (mmApplyPendingEmail *mUsersRepositoryMockApplyPendingEmail) Return(err error) *UsersRepositoryMock {
+	if mmApplyPendingEmail.mock.funcApplyPendingEmail != nil {
+		mmApplyPendingEmail.mock.t.Fatalf("UsersRepositoryMock.ApplyPendingEmail mock is already set by Set")
 	}
 
-	if mmGetUserByEmail.defaultExpectation == nil {
-		mmGetUserByEmail.defaultExpectation = &UsersRepositoryMockGetUserByEmailExpectation{mock: mmGetUserByEmail.mock}
+	if mmApplyPendingEmail.defaultExpectation == nil {
+		mmApplyPendingEmail.defaultExpectation = &UsersRepositoryMockApplyPendingEmailExpectation{mock: mmApplyPendingEmail.mock}
 	}
-	mmGetUserByEmail.defaultExpectation.results = &UsersRepositoryMockGetUserByEmailResults{up1, err}
-	mmGetUserByEmail.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetUserByEmail.mock
+	mmApplyPendingEmail.defaultExpectation.results = &UsersRepositoryMockApplyPendingEmailResults{err}
+	mmApplyPendingEmail.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmApplyPendingEmail.mock
 }
 
-// Set uses given function f to mock the UsersRepository.GetUserByEmail method
-func (mmGetUserByEmail *mUsersRepositoryMockGetUserByEmail) Set(f func(ctx context.Context, email string) (up1 *models.User, err error)) *UsersRepositoryMock {
-	if mmGetUserByEmail.defaultExpectation != nil {
-		mmGetUserByEmail.mock.t.Fatalf("Default expectation is already set for the UsersRepository.GetUserByEmail method")
+// Set uses given function f to mock the UsersRepository.ApplyPendingEmail method
+func (mmApplyPendingEmail *mUsersRepositoryMockApplyPendingEmail) Set(f func(ctx context.Context, userID string) (err error)) *UsersRepositoryMock {
+	if mmApplyPendingEmail.defaultExpectation != nil {
+		mmApplyPendingEmail.mock.t.Fatalf("Default expectation is already set for the UsersRepository.ApplyPendingEmail method")
 	}
 
-	if len(mmGetUserByEmail.expectations) > 0 {
-		mmGetUserByEmail.mock.t.Fatalf("Some expectations are already set for the UsersRepository.GetUserByEmail method")
+	if len(mmApplyPendingEmail.expectations) > 0 {
+		mmApplyPendingEmail.mock.t.Fatalf("Some expectations are already set for the UsersRepository.ApplyPendingEmail method")
 	}
 
-	mmGetUserByEmail.mock.funcGetUserByEmail = f
-	mmGetUserByEmail.mock.funcGetUserByEmailOrigin = minimock.CallerInfo(1)
-	return mmGetUserByEmail.mock
+	mmApplyPendingEmail.mock.funcApplyPendingEmail = f
+	mmApplyPendingEmail.mock.funcApplyPendingEmailOrigin = minimock.CallerInfo(1)
+	return mmApplyPendingEmail.mock
 }
 
-// When sets expectation for the UsersRepository.GetUserByEmail which will trigger the result defined by the following
+// When sets expectation for the UsersRepository.ApplyPendingEmail which will trigger the result defined by the following
 // Then helper
-func (mmGetUserByEmail *mUsersRepositoryMockGetUserByEmail) When(ctx context.Context, email string) *UsersRepositoryMockGetUserByEmailExpectation {
-	if mmGetUserByEmail.mock.funcGetUserByEmail != nil {
-		mmGetUserByEmail.mock.t.Fatalf("UsersRepositoryMock.GetUserByEmail mock is already set by Set")
+func (mmApplyPendingEmail *mUsersRepositoryMockApplyPendingEmail) When(ctx context.Context, userID string) *UsersRepositoryMockApplyPendingEmailExpectation {
+	if mmApplyPendingEmail.mock.funcApplyPendingEmail != nil {
+		mmApplyPendingEmail.mock.t.Fatalf("UsersRepositoryMock.ApplyPendingEmail mock is already set by Set")
 	}
 
-	expectation := &UsersRepositoryMockGetUserByEmailExpectation{
-		mock:               mmGetUserByEmail.mock,
-		params:             &UsersRepositoryMockGetUserByEmailParams{ctx, email},
-		expectationOrigins: UsersRepositoryMockGetUserByEmailExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &UsersRepositoryMockApplyPendingEmailExpectation{
+		mock:               mmApplyPendingEmail.mock,
+		params:             &UsersRepositoryMockApplyPendingEmailParams{ctx, userID},
+		expectationOrigins: UsersRepositoryMockApplyPendingEmailExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetUserByEmail.expectations = append(mmGetUserByEmail.expectations, expectation)
+	mmApplyPendingEmail.expectations = append(mmApplyPendingEmail.expectations, expectation)
 	return expectation
 }
 
-// Then sets up UsersRepository.GetUserByEmail return parameters for the expectation previously defined by the When method
-func (e *UsersRepositoryMockGetUserByEmailExpectation) Then(up1 *models.User, err error) *UsersRepositoryMock {
-	e.results = &UsersRepositoryMockGetUserByEmailResults{up1, err}
+// Then sets up UsersRepository.ApplyPendingEmail return parameters for the expectation previously defined by the When method
+func (e *UsersRepositoryMockApplyPendingEmailExpectation) Then(err error) *UsersRepositoryMock {
+	e.results = &UsersRepositoryMockApplyPendingEmailResults{err}
 	return e.mock
 }
 
-// Times sets number of times UsersRepository.GetUserByEmail should be invoked
-func (mmGetUserByEmail *mUsersRepositoryMockGetUserByEmail) Times(n uint64) *mUsersRepositoryMockGetUserByEmail {
+// Times sets number of times UsersRepository.ApplyPendingEmail should be invoked
+func (mmApplyPendingEmail *mUsersRepositoryMockApplyPendingEmail) Times(n uint64) *mUsersRepositoryMockApplyPendingEmail {
 	if n == 0 {
-		mmGetUserByEmail.mock.t.Fatalf("Times of UsersRepositoryMock.GetUserByEmail mock can not be zero")
+		mmApplyPendingEmail.mock.t.Fatalf("Times of UsersRepositoryMock.ApplyPendingEmail mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetUserByEmail.expectedInvocations, n)
-	mmGetUserByEmail.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetUserByEmail
+	mm_atomic.StoreUint64(&mmApplyPendingEmail.expectedInvocations, n)
+	mmApplyPendingEmail.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmApplyPendingEmail
 }
 
-func (mmGetUserByEmail *mUsersRepositoryMockGetUserByEmail) invocationsDone() bool {
-	if len(mmGetUserByEmail.expectations) == 0 && mmGetUserByEmail.defaultExpectation == nil && mmGetUserByEmail.mock.funcGetUserByEmail == nil {
+func (mmApplyPendingEmail *mUsersRepositoryMockApplyPendingEmail) invocationsDone() bool {
+	if len(mmApplyPendingEmail.expectations) == 0 && mmApplyPendingEmail.defaultExpectation == nil && mmApplyPendingEmail.mock.funcApplyPendingEmail == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetUserByEmail.mock.afterGetUserByEmailCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetUserByEmail.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmApplyPendingEmail.mock.afterApplyPendingEmailCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmApplyPendingEmail.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetUserByEmail implements mm_usecase.UsersRepository
-func (mmGetUserByEmail *UsersRepositoryMock) GetUserByEmail(ctx context.Context, email string) (up1 *models.User, err error) {
-	mm_atomic.AddUint64(&mmGetUserByEmail.beforeGetUserByEmailCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetUserByEmail.afterGetUserByEmailCounter, 1)
+// ApplyPendingEmail implements mm_usecase.UsersRepository
+func (mmApplyPendingEmail *UsersRepositoryMock) ApplyPendingEmail(ctx context.Context, userID string) (err error) {
+	mm_atomic.AddUint64(&mmApplyPendingEmail.beforeApplyPendingEmailCounter, 1)
+	defer mm_atomic.AddUint64(&mmApplyPendingEmail.afterApplyPendingEmailCounter, 1)
 
-	mmGetUserByEmail.t.Helper()
+	mmApplyPendingEmail.t.Helper()
 
-	if mmGetUserByEmail.inspectFuncGetUserByEmail != nil {
-		mmGetUserByEmail.inspectFuncGetUserByEmail(ctx, email)
+	if mmApplyPendingEmail.inspectFuncApplyPendingEmail != nil {
+		mmApplyPendingEmail.inspectFuncApplyPendingEmail(ctx, userID)
 	}
 
-	mm_params := UsersRepositoryMockGetUserByEmailParams{ctx, email}
+	mm_params := UsersRepositoryMockApplyPendingEmailParams{ctx, userID}
 
 	// Record call args
-	mmGetUserByEmail.GetUserByEmailMock.mutex.Lock()
-	mmGetUserByEmail.GetUserByEmailMock.callArgs = append(mmGetUserByEmail.GetUserByEmailMock.callArgs, &mm_params)
-	mmGetUserByEmail.GetUserByEmailMock.mutex.Unlock()
+	mmApplyPendingEmail.ApplyPendingEmailMock.mutex.Lock()
+	mmApplyPendingEmail.ApplyPendingEmailMock.callArgs = append(mmApplyPendingEmail.ApplyPendingEmailMock.callArgs, &mm_params)
+	mmApplyPendingEmail.ApplyPendingEmailMock.mutex.Unlock()
 
-	for _, e := range mmGetUserByEmail.GetUserByEmailMock.expectations {
+	for _, e := range mmApplyPendingEmail.ApplyPendingEmailMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.up1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmGetUserByEmail.GetUserByEmailMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetUserByEmail.GetUserByEmailMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetUserByEmail.GetUserByEmailMock.defaultExpectation.params
-		mm_want_ptrs := mmGetUserByEmail.GetUserByEmailMock.defaultExpectation.paramPtrs
+	if mmApplyPendingEmail.ApplyPendingEmailMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmApplyPendingEmail.ApplyPendingEmailMock.defaultExpectation.Counter, 1)
+		mm_want := mmApplyPendingEmail.ApplyPendingEmailMock.defaultExpectation.params
+		mm_want_ptrs := mmApplyPendingEmail.ApplyPendingEmailMock.defaultExpectation.paramPtrs
 
-		mm_got := UsersRepositoryMockGetUserByEmailParams{ctx, email}
+		mm_got := UsersRepositoryMockApplyPendingEmailParams{ctx, userID}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGetUserByEmail.t.Errorf("UsersRepositoryMock.GetUserByEmail got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetUserByEmail.GetUserByEmailMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmApplyPendingEmail.t.Errorf("UsersRepositoryMock.ApplyPendingEmail got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmApplyPendingEmail.ApplyPendingEmailMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.email != nil && !minimock.Equal(*mm_want_ptrs.email, mm_got.email) {
-				mmGetUserByEmail.t.Errorf("UsersRepositoryMock.GetUserByEmail got unexpected parameter email, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetUserByEmail.GetUserByEmailMock.defaultExpectation.expectationOrigins.originEmail, *mm_want_ptrs.email, mm_got.email, minimock.Diff(*mm_want_ptrs.email, mm_got.email))
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmApplyPendingEmail.t.Errorf("UsersRepositoryMock.ApplyPendingEmail got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmApplyPendingEmail.ApplyPendingEmailMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetUserByEmail.t.Errorf("UsersRepositoryMock.GetUserByEmail got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetUserByEmail.GetUserByEmailMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmApplyPendingEmail.t.Errorf("UsersRepositoryMock.ApplyPendingEmail got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmApplyPendingEmail.ApplyPendingEmailMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetUserByEmail.GetUserByEmailMock.defaultExpectation.results
+		mm_results := mmApplyPendingEmail.ApplyPendingEmailMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetUserByEmail.t.Fatal("No results are set for the UsersRepositoryMock.GetUserByEmail")
+			mmApplyPendingEmail.t.Fatal("No results are set for the UsersRepositoryMock.ApplyPendingEmail")
 		}
-		return (*mm_results).up1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmGetUserByEmail.funcGetUserByEmail != nil {
-		return mmGetUserByEmail.funcGetUserByEmail(ctx, email)
+	if mmApplyPendingEmail.funcApplyPendingEmail != nil {
+		return mmApplyPendingEmail.funcApplyPendingEmail(ctx, userID)
 	}
-	mmGetUserByEmail.t.Fatalf("Unexpected call to UsersRepositoryMock.GetUserByEmail. %v %v", ctx, email)
+	mmApplyPendingEmail.t.Fatalf("Unexpected call to UsersRepositoryMock.ApplyPendingEmail. %v %v", ctx, userID)
 	return
 }
 
-// GetUserByEmailAfterCounter returns a count of finished UsersRepositoryMock.GetUserByEmail invocations
-func (mmGetUserByEmail *UsersRepositoryMock) GetUserByEmailAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetUserByEmail.afterGetUserByEmailCounter)
+// ApplyPendingEmailAfterCounter returns a count of finished UsersRepositoryMock.ApplyPendingEmail invocations
+func (mmApplyPendingEmail *UsersRepositoryMock) ApplyPendingEmailAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmApplyPendingEmail.afterApplyPendingEmailCounter)
 }
 
-// GetUserByEmailBeforeCounter returns a count of UsersRepositoryMock.GetUserByEmail invocations
-func (mmGetUserByEmail *UsersRepositoryMock) GetUserByEmailBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetUserByEmail.beforeGetUserByEmailCounter)
+// ApplyPendingEmailBeforeCounter returns a count of UsersRepositoryMock.ApplyPendingEmail invocations
+func (mmApplyPendingEmail *UsersRepositoryMock) ApplyPendingEmailBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmApplyPendingEmail.beforeApplyPendingEmailCounter)
 }
 
-// Calls returns a list of arguments used in each call to UsersRepositoryMock.GetUserByEmail.
+// Calls returns a list of arguments used in each call to UsersRepositoryMock.ApplyPendingEmail.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetUserByEmail *mUsersRepositoryMockGetUserByEmail) Calls() []*UsersRepositoryMockGetUserByEmailParams {
-	mmGetUserByEmail.mutex.RLock()
+func (mmApplyPendingEmail *mUsersRepositoryMockApplyPendingEmail) Calls() []*UsersRepositoryMockApplyPendingEmailParams {
+	mmApplyPendingEmail.mutex.RLock()
 
-	argCopy := make([]*UsersRepositoryMockGetUserByEmailParams, len(mmGetUserByEmail.callArgs))
-	copy(argCopy, mmGetUserByEmail.callArgs)
+	argCopy := make([]*UsersRepositoryMockApplyPendingEmailParams, len(mmApplyPendingEmail.callArgs))
+	copy(argCopy, mmApplyPendingEmail.callArgs)
 
-	mmGetUserByEmail.mutex.RUnlock()
+	mmApplyPendingEmail.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetUserByEmailDone returns true if the count of the GetUserByEmail invocations corresponds
+// MinimockApplyPendingEmailDone returns true if the count of the ApplyPendingEmail invocations corresponds
+// the number of defined expectations
+func (m *UsersRepositoryMock) MinimockApplyPendingEmailDone() bool {
+	if m.ApplyPendingEmailMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ApplyPendingEmailMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ApplyPendingEmailMock.invocationsDone()
+}
+
+// MinimockApplyPendingEmailInspect logs each unmet expectation
+func (m *UsersRepositoryMock) MinimockApplyPendingEmailInspect() {
+	for _, e := range m.ApplyPendingEmailMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UsersRepositoryMock.ApplyPendingEmail at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterApplyPendingEmailCounter := mm_atomic.LoadUint64(&m.afterApplyPendingEmailCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ApplyPendingEmailMock.defaultExpectation != nil && afterApplyPendingEmailCounter < 1 {
+		if m.ApplyPendingEmailMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UsersRepositoryMock.ApplyPendingEmail at\n%s", m.ApplyPendingEmailMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UsersRepositoryMock.ApplyPendingEmail at\n%s with params: %#v", m.ApplyPendingEmailMock.defaultExpectation.expectationOrigins.origin, *m.ApplyPendingEmailMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcApplyPendingEmail != nil && afterApplyPendingEmailCounter < 1 {
+		m.t.Errorf("Expected call to UsersRepositoryMock.ApplyPendingEmail at\n%s", m.funcApplyPendingEmailOrigin)
+	}
+
+	if !m.ApplyPendingEmailMock.invocationsDone() && afterApplyPendingEmailCounter > 0 {
+		m.t.Errorf("Expected %d calls to UsersRepositoryMock.ApplyPendingEmail at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ApplyPendingEmailMock.expectedInvocations), m.ApplyPendingEmailMock.expectedInvocationsOrigin, afterApplyPendingEmailCounter)
+	}
+}
+
+type mUsersRepositoryMockCompleteRegistration struct {
+	optional           bool
+	mock               *UsersRepositoryMock
+	defaultExpectation *UsersRepositoryMockCompleteRegistrationExpectation
+	expectations       []*UsersRepositoryMockCompleteRegistrationExpectation
+
+	callArgs []*UsersRepositoryMockCompleteRegistrationParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UsersRepositoryMockCompleteRegistrationExpectation specifies expectation struct of the UsersRepository.CompleteRegistration
+type UsersRepositoryMockCompleteRegistrationExpectation struct {
+	mock               *UsersRepositoryMock
+	params             *UsersRepositoryMockCompleteRegistrationParams
+	paramPtrs          *UsersRepositoryMockCompleteRegistrationParamPtrs
+	expectationOrigins UsersRepositoryMockCompleteRegistrationExpectationOrigins
+	results            *UsersRepositoryMockCompleteRegistrationResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UsersRepositoryMockCompleteRegistrationParams contains parameters of the UsersRepository.CompleteRegistration
+type UsersRepositoryMockCompleteRegistrationParams struct {
+	ctx    context.Context
+	userID string
+}
+
+// UsersRepositoryMockCompleteRegistrationParamPtrs contains pointers to parameters of the UsersRepository.CompleteRegistration
+type UsersRepositoryMockCompleteRegistrationParamPtrs struct {
+	ctx    *context.Context
+	userID *string
+}
+
+// UsersRepositoryMockCompleteRegistrationResults contains results of the UsersRepository.CompleteRegistration
+type UsersRepositoryMockCompleteRegistrationResults struct {
+	err error
+}
+
+// UsersRepositoryMockCompleteRegistrationOrigins contains origins of expectations of the UsersRepository.CompleteRegistration
+type UsersRepositoryMockCompleteRegistrationExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCompleteRegistration *mUsersRepositoryMockCompleteRegistration) Optional() *mUsersRepositoryMockCompleteRegistration {
+	mmCompleteRegistration.optional = true
+	return mmCompleteRegistration
+}
+
+// Expect sets up expected params for UsersRepository.CompleteRegistration
+func (mmCompleteRegistration *mUsersRepositoryMockCompleteRegistration) Expect(ctx context.Context, userID string) *mUsersRepositoryMockCompleteRegistration {
+	if mmCompleteRegistration.mock.funcCompleteRegistration != nil {
+		mmCompleteRegistration.mock.t.Fatalf("UsersRepositoryMock.CompleteRegistration mock is already set by Set")
+	}
+
+	if mmCompleteRegistration.defaultExpectation == nil {
+		mmCompleteRegistration.defaultExpectation = &UsersRepositoryMockCompleteRegistrationExpectation{}
+	}
+
+	if mmCompleteRegistration.defaultExpectation.paramPtrs != nil {
+		mmCompleteRegistration.mock.t.Fatalf("UsersRepositoryMock.CompleteRegistration mock is already set by ExpectParams functions")
+	}
+
+	mmCompleteRegistration.defaultExpectation.params = &UsersRepositoryMockCompleteRegistrationParams{ctx, userID}
+	mmCompleteRegistration.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCompleteRegistration.expectations {
+		if minimock.Equal(e.params, mmCompleteRegistration.defaultExpectation.params) {
+			mmCompleteRegistration.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCompleteRegistration.defaultExpectation.params)
+		}
+	}
+
+	return mmCompleteRegistration
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UsersRepository.CompleteRegistration
+func (mmCompleteRegistration *mUsersRepositoryMockCompleteRegistration) ExpectCtxParam1(ctx context.Context) *mUsersRepositoryMockCompleteRegistration {
+	if mmCompleteRegistration.mock.funcCompleteRegistration != nil {
+		mmCompleteRegistration.mock.t.Fatalf("UsersRepositoryMock.CompleteRegistration mock is already set by Set")
+	}
+
+	if mmCompleteRegistration.defaultExpectation == nil {
+		mmCompleteRegistration.defaultExpectation = &UsersRepositoryMockCompleteRegistrationExpectation{}
+	}
+
+	if mmCompleteRegistration.defaultExpectation.params != nil {
+		mmCompleteRegistration.mock.t.Fatalf("UsersRepositoryMock.CompleteRegistration mock is already set by Expect")
+	}
+
+	if mmCompleteRegistration.defaultExpectation.paramPtrs == nil {
+		mmCompleteRegistration.defaultExpectation.paramPtrs = &UsersRepositoryMockCompleteRegistrationParamPtrs{}
+	}
+	mmCompleteRegistration.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCompleteRegistration.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCompleteRegistration
+}
+
+// ExpectUserIDParam2 sets up expected param userID for UsersRepository.CompleteRegistration
+func (mmCompleteRegistration *mUsersRepositoryMockCompleteRegistration) ExpectUserIDParam2(userID string) *mUsersRepositoryMockCompleteRegistration {
+	if mmCompleteRegistration.mock.funcCompleteRegistration != nil {
+		mmCompleteRegistration.mock.t.Fatalf("UsersRepositoryMock.CompleteRegistration mock is already set by Set")
+	}
+
+	if mmCompleteRegistration.defaultExpectation == nil {
+		mmCompleteRegistration.defaultExpectation = &UsersRepositoryMockCompleteRegistrationExpectation{}
+	}
+
+	if mmCompleteRegistration.defaultExpectation.params != nil {
+		mmCompleteRegistration.mock.t.Fatalf("UsersRepositoryMock.CompleteRegistration mock is already set by Expect")
+	}
+
+	if mmCompleteRegistration.defaultExpectation.paramPtrs == nil {
+		mmCompleteRegistration.defaultExpectation.paramPtrs = &UsersRepositoryMockCompleteRegistrationParamPtrs{}
+	}
+	mmCompleteRegistration.defaultExpectation.paramPtrs.userID = &userID
+	mmCompleteRegistration.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmCompleteRegistration
+}
+
+// Inspect accepts an inspector function that has same arguments as the UsersRepository.CompleteRegistration
+func (mmCompleteRegistration *mUsersRepositoryMockCompleteRegistration) Inspect(f func(ctx context.Context, userID string)) *mUsersRepositoryMockCompleteRegistration {
+	if mmCompleteRegistration.mock.inspectFuncCompleteRegistration != nil {
+		mmCompleteRegistration.mock.t.Fatalf("Inspect function is already set for UsersRepositoryMock.CompleteRegistration")
+	}
+
+	mmCompleteRegistration.mock.inspectFuncCompleteRegistration = f
+
+	return mmCompleteRegistration
+}
+
+// Return sets up results that will be returned by UsersRepository.CompleteRegistration
+func (mmCompleteRegistration *mUsersRepositoryMockCompleteRegistration) Return(err error) *UsersRepositoryMock {
+	if mmCompleteRegistration.mock.funcCompleteRegistration != nil {
+		mmCompleteRegistration.mock.t.Fatalf("UsersRepositoryMock.CompleteRegistration mock is already set by Set")
+	}
+
+	if mmCompleteRegistration.defaultExpectation == nil {
+		mmCompleteRegistration.defaultExpectation = &UsersRepositoryMockCompleteRegistrationExpectation{mock: mmCompleteRegistration.mock}
+	}
+	mmCompleteRegistration.defaultExpectation.results = &UsersRepositoryMockCompleteRegistrationResults{err}
+	mmCompleteRegistration.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCompleteRegistration.mock
+}
+
+// Set uses given function f to mock the UsersRepository.CompleteRegistration method
+func (mmCompleteRegistration *mUsersRepositoryMockCompleteRegistration) Set(f func(ctx context.Context, userID string) (err error)) *UsersRepositoryMock {
+	if mmCompleteRegistration.defaultExpectation != nil {
+		mmCompleteRegistration.mock.t.Fatalf("Default expectation is already set for the UsersRepository.CompleteRegistration method")
+	}
+
+	if len(mmCompleteRegistration.expectations) > 0 {
+		mmCompleteRegistration.mock.t.Fatalf("Some expectations are already set for the UsersRepository.CompleteRegistration method")
+	}
+
+	mmCompleteRegistration.mock.funcCompleteRegistration = f
+	mmCompleteRegistration.mock.funcCompleteRegistrationOrigin = minimock.CallerInfo(1)
+	return mmCompleteRegistration.mock
+}
+
+// When sets expectation for the UsersRepository.CompleteRegistration which will trigger the result defined by the following
+// Then helper
+func (mmCompleteRegistration *mUsersRepositoryMockCompleteRegistration) When(ctx context.Context, userID string) *UsersRepositoryMockCompleteRegistrationExpectation {
+	if mmCompleteRegistration.mock.funcCompleteRegistration != nil {
+		mmCompleteRegistration.mock.t.Fatalf("UsersRepositoryMock.CompleteRegistration mock is already set by Set")
+	}
+
+	expectation := &UsersRepositoryMockCompleteRegistrationExpectation{
+		mock:               mmCompleteRegistration.mock,
+		params:             &UsersRepositoryMockCompleteRegistrationParams{ctx, userID},
+		expectationOrigins: UsersRepositoryMockCompleteRegistrationExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCompleteRegistration.expectations = append(mmCompleteRegistration.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UsersRepository.CompleteRegistration return parameters for the expectation previously defined by the When method
+func (e *UsersRepositoryMockCompleteRegistrationExpectation) Then(err error) *UsersRepositoryMock {
+	e.results = &UsersRepositoryMockCompleteRegistrationResults{err}
+	return e.mock
+}
+
+// Times sets number of times UsersRepository.CompleteRegistration should be invoked
+func (mmCompleteRegistration *mUsersRepositoryMockCompleteRegistration) Times(n uint64) *mUsersRepositoryMockCompleteRegistration {
+	if n == 0 {
+		mmCompleteRegistration.mock.t.Fatalf("Times of UsersRepositoryMock.CompleteRegistration mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCompleteRegistration.expectedInvocations, n)
+	mmCompleteRegistration.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCompleteRegistration
+}
+
+func (mmCompleteRegistration *mUsersRepositoryMockCompleteRegistration) invocationsDone() bool {
+	if len(mmCompleteRegistration.expectations) == 0 && mmCompleteRegistration.defaultExpectation == nil && mmCompleteRegistration.mock.funcCompleteRegistration == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCompleteRegistration.mock.afterCompleteRegistrationCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCompleteRegistration.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CompleteRegistration implements mm_usecase.UsersRepository
+func (mmCompleteRegistration *UsersRepositoryMock) CompleteRegistration(ctx context.Context, userID string) (err error) {
+	mm_atomic.AddUint64(&mmCompleteRegistration.beforeCompleteRegistrationCounter, 1)
+	defer mm_atomic.AddUint64(&mmCompleteRegistration.afterCompleteRegistrationCounter, 1)
+
+	mmCompleteRegistration.t.Helper()
+
+	if mmCompleteRegistration.inspectFuncCompleteRegistration != nil {
+		mmCompleteRegistration.inspectFuncCompleteRegistration(ctx, userID)
+	}
+
+	mm_params := UsersRepositoryMockCompleteRegistrationParams{ctx, userID}
+
+	// Record call args
+	mmCompleteRegistration.CompleteRegistrationMock.mutex.Lock()
+	mmCompleteRegistration.CompleteRegistrationMock.callArgs = append(mmCompleteRegistration.CompleteRegistrationMock.callArgs, &mm_params)
+	mmCompleteRegistration.CompleteRegistrationMock.mutex.Unlock()
+
+	for _, e := range mmCompleteRegistration.CompleteRegistrationMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCompleteRegistration.CompleteRegistrationMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCompleteRegistration.CompleteRegistrationMock.defaultExpectation.Counter, 1)
+		mm_want := mmCompleteRegistration.CompleteRegistrationMock.defaultExpectation.params
+		mm_want_ptrs := mmCompleteRegistration.CompleteRegistrationMock.defaultExpectation.paramPtrs
+
+		mm_got := UsersRepositoryMockCompleteRegistrationParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCompleteRegistration.t.Errorf("UsersRepositoryMock.CompleteRegistration got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCompleteRegistration.CompleteRegistrationMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmCompleteRegistration.t.Errorf("UsersRepositoryMock.CompleteRegistration got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCompleteRegistration.CompleteRegistrationMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCompleteRegistration.t.Errorf("UsersRepositoryMock.CompleteRegistration got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCompleteRegistration.CompleteRegistrationMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCompleteRegistration.CompleteRegistrationMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCompleteRegistration.t.Fatal("No results are set for the UsersRepositoryMock.CompleteRegistration")
+		}
+		return (*mm_results).err
+	}
+	if mmCompleteRegistration.funcCompleteRegistration != nil {
+		return mmCompleteRegistration.funcCompleteRegistration(ctx, userID)
+	}
+	mmCompleteRegistration.t.Fatalf("Unexpected call to UsersRepositoryMock.CompleteRegistration. %v %v", ctx, userID)
+	return
+}
+
+// CompleteRegistrationAfterCounter returns a count of finished UsersRepositoryMock.CompleteRegistration invocations
+func (mmCompleteRegistration *UsersRepositoryMock) CompleteRegistrationAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCompleteRegistration.afterCompleteRegistrationCounter)
+}
+
+// CompleteRegistrationBeforeCounter returns a count of UsersRepositoryMock.CompleteRegistration invocations
+func (mmCompleteRegistration *UsersRepositoryMock) CompleteRegistrationBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCompleteRegistration.beforeCompleteRegistrationCounter)
+}
+
+// Calls returns a list of arguments used in each call to UsersRepositoryMock.CompleteRegistration.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCompleteRegistration *mUsersRepositoryMockCompleteRegistration) Calls() []*UsersRepositoryMockCompleteRegistrationParams {
+	mmCompleteRegistration.mutex.RLock()
+
+	argCopy := make([]*UsersRepositoryMockCompleteRegistrationParams, len(mmCompleteRegistration.callArgs))
+	copy(argCopy, mmCompleteRegistration.callArgs)
+
+	mmCompleteRegistration.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCompleteRegistrationDone returns true if the count of the CompleteRegistration invocations corresponds
+// the number of defined expectations
+func (m *UsersRepositoryMock) MinimockCompleteRegistrationDone() bool {
+	if m.CompleteRegistrationMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CompleteRegistrationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CompleteRegistrationMock.invocationsDone()
+}
+
+// MinimockCompleteRegistrationInspect logs each unmet expectation
+func (m *UsersRepositoryMock) MinimockCompleteRegistrationInspect() {
+	for _, e := range m.CompleteRegistrationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UsersRepositoryMock.CompleteRegistration at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCompleteRegistrationCounter := mm_atomic.LoadUint64(&m.afterCompleteRegistrationCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CompleteRegistrationMock.defaultExpectation != nil && afterCompleteRegistrationCounter < 1 {
+		if m.CompleteRegistrationMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UsersRepositoryMock.CompleteRegistration at\n%s", m.CompleteRegistrationMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UsersRepositoryMock.CompleteRegistration at\n%s with params: %#v", m.CompleteRegistrationMock.defaultExpectation.expectationOrigins.origin, *m.CompleteRegistrationMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCompleteRegistration != nil && afterCompleteRegistrationCounter < 1 {
+		m.t.Errorf("Expected call to UsersRepositoryMock.CompleteRegistration at\n%s", m.funcCompleteRegistrationOrigin)
+	}
+
+	if !m.CompleteRegistrationMock.invocationsDone() && afterCompleteRegistrationCounter > 0 {
+		m.t.Errorf("Expected %d calls to UsersRepositoryMock.CompleteRegistration at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CompleteRegistrationMock.expectedInvocations), m.CompleteRegistrationMock.expectedInvocationsOrigin, afterCompleteRegistrationCounter)
+	}
+}
+
+type mUsersRepositoryMockCreateUser struct {
+	optional           bool
+	mock               *UsersRepositoryMock
+	defaultExpectation *UsersRepositoryMockCreateUserExpectation
+	expectations       []*UsersRepositoryMockCreateUserExpectation
+
+	callArgs []*UsersRepositoryMockCreateUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UsersRepositoryMockCreateUserExpectation specifies expectation struct of the UsersRepository.CreateUser
+type UsersRepositoryMockCreateUserExpectation struct {
+	mock               *UsersRepositoryMock
+	params             *UsersRepositoryMockCreateUserParams
+	paramPtrs          *UsersRepositoryMockCreateUserParamPtrs
+	expectationOrigins UsersRepositoryMockCreateUserExpectationOrigins
+	results            *UsersRepositoryMockCreateUserResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UsersRepositoryMockCreateUserParams contains parameters of the UsersRepository.CreateUser
+type UsersRepositoryMockCreateUserParams struct {
+	ctx          context.Context
+	email        string
+	passwordHash string
+}
+
+// UsersRepositoryMockCreateUserParamPtrs contains pointers to parameters of the UsersRepository.CreateUser
+type UsersRepositoryMockCreateUserParamPtrs struct {
+	ctx          *context.Context
+	email        *string
+	passwordHash *string
+}
+
+// UsersRepositoryMockCreateUserResults contains results of the UsersRepository.CreateUser
+type UsersRepositoryMockCreateUserResults struct {
+	up1 *models.User
+	err error
+}
+
+// UsersRepositoryMockCreateUserOrigins contains origins of expectations of the UsersRepository.CreateUser
+type UsersRepositoryMockCreateUserExpectationOrigins struct {
+	origin             string
+	originCtx          string
+	originEmail        string
+	originPasswordHash string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateUser *mUsersRepositoryMockCreateUser) Optional() *mUsersRepositoryMockCreateUser {
+	mmCreateUser.optional = true
+	return mmCreateUser
+}
+
+// Expect sets up expected params for UsersRepository.CreateUser
+func (mmCreateUser *mUsersRepositoryMockCreateUser) Expect(ctx context.Context, email string, passwordHash string) *mUsersRepositoryMockCreateUser {
+	if mmCreateUser.mock.funcCreateUser != nil {
+		mmCreateUser.mock.t.Fatalf("UsersRepositoryMock.CreateUser mock is already set by Set")
+	}
+
+	if mmCreateUser.defaultExpectation == nil {
+		mmCreateUser.defaultExpectation = &UsersRepositoryMockCreateUserExpectation{}
+	}
+
+	if mmCreateUser.defaultExpectation.paramPtrs != nil {
+		mmCreateUser.mock.t.Fatalf("UsersRepositoryMock.CreateUser mock is already set by ExpectParams functions")
+	}
+
+	mmCreateUser.defaultExpectation.params = &UsersRepositoryMockCreateUserParams{ctx, email, passwordHash}
+	mmCreateUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateUser.expectations {
+		if minimock.Equal(e.params, mmCreateUser.defaultExpectation.params) {
+			mmCreateUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateUser.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UsersRepository.CreateUser
+func (mmCreateUser *mUsersRepositoryMockCreateUser) ExpectCtxParam1(ctx context.Context) *mUsersRepositoryMockCreateUser {
+	if mmCreateUser.mock.funcCreateUser != nil {
+		mmCreateUser.mock.t.Fatalf("UsersRepositoryMock.CreateUser mock is already set by Set")
+	}
+
+	if mmCreateUser.defaultExpectation == nil {
+		mmCreateUser.defaultExpectation = &UsersRepositoryMockCreateUserExpectation{}
+	}
+
+	if mmCreateUser.defaultExpectation.params != nil {
+		mmCreateUser.mock.t.Fatalf("UsersRepositoryMock.CreateUser mock is already set by Expect")
+	}
+
+	if mmCreateUser.defaultExpectation.paramPtrs == nil {
+		mmCreateUser.defaultExpectation.paramPtrs = &UsersRepositoryMockCreateUserParamPtrs{}
+	}
+	mmCreateUser.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateUser
+}
+
+// ExpectEmailParam2 sets up expected param email for UsersRepository.CreateUser
+func (mmCreateUser *mUsersRepositoryMockCreateUser) ExpectEmailParam2(email string) *mUsersRepositoryMockCreateUser {
+	if mmCreateUser.mock.funcCreateUser != nil {
+		mmCreateUser.mock.t.Fatalf("UsersRepositoryMock.CreateUser mock is already set by Set")
+	}
+
+	if mmCreateUser.defaultExpectation == nil {
+		mmCreateUser.defaultExpectation = &UsersRepositoryMockCreateUserExpectation{}
+	}
+
+	if mmCreateUser.defaultExpectation.params != nil {
+		mmCreateUser.mock.t.Fatalf("UsersRepositoryMock.CreateUser mock is already set by Expect")
+	}
+
+	if mmCreateUser.defaultExpectation.paramPtrs == nil {
+		mmCreateUser.defaultExpectation.paramPtrs = &UsersRepositoryMockCreateUserParamPtrs{}
+	}
+	mmCreateUser.defaultExpectation.paramPtrs.email = &email
+	mmCreateUser.defaultExpectation.expectationOrigins.originEmail = minimock.CallerInfo(1)
+
+	return mmCreateUser
+}
+
+// ExpectPasswordHashParam3 sets up expected param passwordHash for UsersRepository.CreateUser
+func (mmCreateUser *mUsersRepositoryMockCreateUser) ExpectPasswordHashParam3(passwordHash string) *mUsersRepositoryMockCreateUser {
+	if mmCreateUser.mock.funcCreateUser != nil {
+		mmCreateUser.mock.t.Fatalf("UsersRepositoryMock.CreateUser mock is already set by Set")
+	}
+
+	if mmCreateUser.defaultExpectation == nil {
+		mmCreateUser.defaultExpectation = &UsersRepositoryMockCreateUserExpectation{}
+	}
+
+	if mmCreateUser.defaultExpectation.params != nil {
+		mmCreateUser.mock.t.Fatalf("UsersRepositoryMock.CreateUser mock is already set by Expect")
+	}
+
+	if mmCreateUser.defaultExpectation.paramPtrs == nil {
+		mmCreateUser.defaultExpectation.paramPtrs = &UsersRepositoryMockCreateUserParamPtrs{}
+	}
+	mmCreateUser.defaultExpectation.paramPtrs.passwordHash = &passwordHash
+	mmCreateUser.defaultExpectation.expectationOrigins.originPasswordHash = minimock.CallerInfo(1)
+
+	return mmCreateUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the UsersRepository.CreateUser
+func (mmCreateUser *mUsersRepositoryMockCreateUser) Inspect(f func(ctx context.Context, email string, passwordHash string)) *mUsersRepositoryMockCreateUser {
+	if mmCreateUser.mock.inspectFuncCreateUser != nil {
+		mmCreateUser.mock.t.Fatalf("Inspect function is already set for UsersRepositoryMock.CreateUser")
+	}
+
+	mmCreateUser.mock.inspectFuncCreateUser = f
+
+	return mmCreateUser
+}
+
+// Return sets up results that will be returned by UsersRepository.CreateUser
+func (mmCreateUser *mUsersRepositoryMockCreateUser) Return(up1 *models.User, err error) *UsersRepositoryMock {
+	if mmCreateUser.mock.funcCreateUser != nil {
+		mmCreateUser.mock.t.Fatalf("UsersRepositoryMock.CreateUser mock is already set by Set")
+	}
+
+	if mmCreateUser.defaultExpectation == nil {
+		mmCreateUser.defaultExpectation = &UsersRepositoryMockCreateUserExpectation{mock: mmCreateUser.mock}
+	}
+	mmCreateUser.defaultExpectation.results = &UsersRepositoryMockCreateUserResults{up1, err}
+	mmCreateUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateUser.mock
+}
+
+// Set uses given function f to mock the UsersRepository.CreateUser method
+func (mmCreateUser *mUsersRepositoryMockCreateUser) Set(f func(ctx context.Context, email string, passwordHash string) (up1 *models.User, err error)) *UsersRepositoryMock {
+	if mmCreateUser.defaultExpectation != nil {
+		mmCreateUser.mock.t.Fatalf("Default expectation is already set for the UsersRepository.CreateUser method")
+	}
+
+	if len(mmCreateUser.expectations) > 0 {
+		mmCreateUser.mock.t.Fatalf("Some expectations are already set for the UsersRepository.CreateUser method")
+	}
+
+	mmCreateUser.mock.funcCreateUser = f
+	mmCreateUser.mock.funcCreateUserOrigin = minimock.CallerInfo(1)
+	return mmCreateUser.mock
+}
+
+// When sets expectation for the UsersRepository.CreateUser which will trigger the result defined by the following
+// Then helper
+func (mmCreateUser *mUsersRepositoryMockCreateUser) When(ctx context.Context, email string, passwordHash string) *UsersRepositoryMockCreateUserExpectation {
+	if mmCreateUser.mock.funcCreateUser != nil {
+		mmCreateUser.mock.t.Fatalf("UsersRepositoryMock.CreateUser mock is already set by Set")
+	}
+
+	expectation := &UsersRepositoryMockCreateUserExpectation{
+		mock:               mmCreateUser.mock,
+		params:             &UsersRepositoryMockCreateUserParams{ctx, email, passwordHash},
+		expectationOrigins: UsersRepositoryMockCreateUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateUser.expectations = append(mmCreateUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UsersRepository.CreateUser return parameters for the expectation previously defined by the When method
+func (e *UsersRepositoryMockCreateUserExpectation) Then(up1 *models.User, err error) *UsersRepositoryMock {
+	e.results = &UsersRepositoryMockCreateUserResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times UsersRepository.CreateUser should be invoked
+func (mmCreateUser *mUsersRepositoryMockCreateUser) Times(n uint64) *mUsersRepositoryMockCreateUser {
+	if n == 0 {
+		mmCreateUser.mock.t.Fatalf("Times of UsersRepositoryMock.CreateUser mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateUser.expectedInvocations, n)
+	mmCreateUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateUser
+}
+
+func (mmCreateUser *mUsersRepositoryMockCreateUser) invocationsDone() bool {
+	if len(mmCreateUser.expectations) == 0 && mmCreateUser.defaultExpectation == nil && mmCreateUser.mock.funcCreateUser == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateUser.mock.afterCreateUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateUser.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateUser implements mm_usecase.UsersRepository
+func (mmCreateUser *UsersRepositoryMock) CreateUser(ctx context.Context, email string, passwordHash string) (up1 *models.User, err error) {
+	mm_atomic.AddUint64(&mmCreateUser.beforeCreateUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateUser.afterCreateUserCounter, 1)
+
+	mmCreateUser.t.Helper()
+
+	if mmCreateUser.inspectFuncCreateUser != nil {
+		mmCreateUser.inspectFuncCreateUser(ctx, email, passwordHash)
+	}
+
+	mm_params := UsersRepositoryMockCreateUserParams{ctx, email, passwordHash}
+
+	// Record call args
+	mmCreateUser.CreateUserMock.mutex.Lock()
+	mmCreateUser.CreateUserMock.callArgs = append(mmCreateUser.CreateUserMock.callArgs, &mm_params)
+	mmCreateUser.CreateUserMock.mutex.Unlock()
+
+	for _, e := range mmCreateUser.CreateUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmCreateUser.CreateUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateUser.CreateUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateUser.CreateUserMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateUser.CreateUserMock.defaultExpectation.paramPtrs
+
+		mm_got := UsersRepositoryMockCreateUserParams{ctx, email, passwordHash}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateUser.t.Errorf("UsersRepositoryMock.CreateUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateUser.CreateUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.email != nil && !minimock.Equal(*mm_want_ptrs.email, mm_got.email) {
+				mmCreateUser.t.Errorf("UsersRepositoryMock.CreateUser got unexpected parameter email, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateUser.CreateUserMock.defaultExpectation.expectationOrigins.originEmail, *mm_want_ptrs.email, mm_got.email, minimock.Diff(*mm_want_ptrs.email, mm_got.email))
+			}
+
+			if mm_want_ptrs.passwordHash != nil && !minimock.Equal(*mm_want_ptrs.passwordHash, mm_got.passwordHash) {
+				mmCreateUser.t.Errorf("UsersRepositoryMock.CreateUser got unexpected parameter passwordHash, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateUser.CreateUserMock.defaultExpectation.expectationOrigins.originPasswordHash, *mm_want_ptrs.passwordHash, mm_got.passwordHash, minimock.Diff(*mm_want_ptrs.passwordHash, mm_got.passwordHash))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateUser.t.Errorf("UsersRepositoryMock.CreateUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateUser.CreateUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateUser.CreateUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateUser.t.Fatal("No results are set for the UsersRepositoryMock.CreateUser")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmCreateUser.funcCreateUser != nil {
+		return mmCreateUser.funcCreateUser(ctx, email, passwordHash)
+	}
+	mmCreateUser.t.Fatalf("Unexpected call to UsersRepositoryMock.CreateUser. %v %v %v", ctx, email, passwordHash)
+	return
+}
+
+// CreateUserAfterCounter returns a count of finished UsersRepositoryMock.CreateUser invocations
+func (mmCreateUser *UsersRepositoryMock) CreateUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateUser.afterCreateUserCounter)
+}
+
+// CreateUserBeforeCounter returns a count of UsersRepositoryMock.CreateUser invocations
+func (mmCreateUser *UsersRepositoryMock) CreateUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateUser.beforeCreateUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to UsersRepositoryMock.CreateUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateUser *mUsersRepositoryMockCreateUser) Calls() []*UsersRepositoryMockCreateUserParams {
+	mmCreateUser.mutex.RLock()
+
+	argCopy := make([]*UsersRepositoryMockCreateUserParams, len(mmCreateUser.callArgs))
+	copy(argCopy, mmCreateUser.callArgs)
+
+	mmCreateUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateUserDone returns true if the count of the CreateUser invocations corresponds
+// the number of defined expectations
+func (m *UsersRepositoryMock) MinimockCreateUserDone() bool {
+	if m.CreateUserMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateUserMock.invocationsDone()
+}
+
+// MinimockCreateUserInspect logs each unmet expectation
+func (m *UsersRepositoryMock) MinimockCreateUserInspect() {
+	for _, e := range m.CreateUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UsersRepositoryMock.CreateUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateUserCounter := mm_atomic.LoadUint64(&m.afterCreateUserCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateUserMock.defaultExpectation != nil && afterCreateUserCounter < 1 {
+		if m.CreateUserMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UsersRepositoryMock.CreateUser at\n%s", m.CreateUserMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UsersRepositoryMock.CreateUser at\n%s with params: %#v", m.CreateUserMock.defaultExpectation.expectationOrigins.origin, *m.CreateUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateUser != nil && afterCreateUserCounter < 1 {
+		m.t.Errorf("Expected call to UsersRepositoryMock.CreateUser at\n%s", m.funcCreateUserOrigin)
+	}
+
+	if !m.CreateUserMock.invocationsDone() && afterCreateUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to UsersRepositoryMock.CreateUser at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateUserMock.expectedInvocations), m.CreateUserMock.expectedInvocationsOrigin, afterCreateUserCounter)
+	}
+}
+
+type mUsersRepositoryMockDeletePendingUser struct {
+	optional           bool
+	mock               *UsersRepositoryMock
+	defaultExpectation *UsersRepositoryMockDeletePendingUserExpectation
+	expectations       []*UsersRepositoryMockDeletePendingUserExpectation
+
+	callArgs []*UsersRepositoryMockDeletePendingUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UsersRepositoryMockDeletePendingUserExpectation specifies expectation struct of the UsersRepository.DeletePendingUser
+type UsersRepositoryMockDeletePendingUserExpectation struct {
+	mock               *UsersRepositoryMock
+	params             *UsersRepositoryMockDeletePendingUserParams
+	paramPtrs          *UsersRepositoryMockDeletePendingUserParamPtrs
+	expectationOrigins UsersRepositoryMockDeletePendingUserExpectationOrigins
+	results            *UsersRepositoryMockDeletePendingUserResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UsersRepositoryMockDeletePendingUserParams contains parameters of the UsersRepository.DeletePendingUser
+type UsersRepositoryMockDeletePendingUserParams struct {
+	ctx    context.Context
+	userID string
+}
+
+// UsersRepositoryMockDeletePendingUserParamPtrs contains pointers to parameters of the UsersRepository.DeletePendingUser
+type UsersRepositoryMockDeletePendingUserParamPtrs struct {
+	ctx    *context.Context
+	userID *string
+}
+
+// UsersRepositoryMockDeletePendingUserResults contains results of the UsersRepository.DeletePendingUser
+type UsersRepositoryMockDeletePendingUserResults struct {
+	err error
+}
+
+// UsersRepositoryMockDeletePendingUserOrigins contains origins of expectations of the UsersRepository.DeletePendingUser
+type UsersRepositoryMockDeletePendingUserExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeletePendingUser *mUsersRepositoryMockDeletePendingUser) Optional() *mUsersRepositoryMockDeletePendingUser {
+	mmDeletePendingUser.optional = true
+	return mmDeletePendingUser
+}
+
+// Expect sets up expected params for UsersRepository.DeletePendingUser
+func (mmDeletePendingUser *mUsersRepositoryMockDeletePendingUser) Expect(ctx context.Context, userID string) *mUsersRepositoryMockDeletePendingUser {
+	if mmDeletePendingUser.mock.funcDeletePendingUser != nil {
+		mmDeletePendingUser.mock.t.Fatalf("UsersRepositoryMock.DeletePendingUser mock is already set by Set")
+	}
+
+	if mmDeletePendingUser.defaultExpectation == nil {
+		mmDeletePendingUser.defaultExpectation = &UsersRepositoryMockDeletePendingUserExpectation{}
+	}
+
+	if mmDeletePendingUser.defaultExpectation.paramPtrs != nil {
+		mmDeletePendingUser.mock.t.Fatalf("UsersRepositoryMock.DeletePendingUser mock is already set by ExpectParams functions")
+	}
+
+	mmDeletePendingUser.defaultExpectation.params = &UsersRepositoryMockDeletePendingUserParams{ctx, userID}
+	mmDeletePendingUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeletePendingUser.expectations {
+		if minimock.Equal(e.params, mmDeletePendingUser.defaultExpectation.params) {
+			mmDeletePendingUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeletePendingUser.defaultExpectation.params)
+		}
+	}
+
+	return mmDeletePendingUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UsersRepository.DeletePendingUser
+func (mmDeletePendingUser *mUsersRepositoryMockDeletePendingUser) ExpectCtxParam1(ctx context.Context) *mUsersRepositoryMockDeletePendingUser {
+	if mmDeletePendingUser.mock.funcDeletePendingUser != nil {
+		mmDeletePendingUser.mock.t.Fatalf("UsersRepositoryMock.DeletePendingUser mock is already set by Set")
+	}
+
+	if mmDeletePendingUser.defaultExpectation == nil {
+		mmDeletePendingUser.defaultExpectation = &UsersRepositoryMockDeletePendingUserExpectation{}
+	}
+
+	if mmDeletePendingUser.defaultExpectation.params != nil {
+		mmDeletePendingUser.mock.t.Fatalf("UsersRepositoryMock.DeletePendingUser mock is already set by Expect")
+	}
+
+	if mmDeletePendingUser.defaultExpectation.paramPtrs == nil {
+		mmDeletePendingUser.defaultExpectation.paramPtrs = &UsersRepositoryMockDeletePendingUserParamPtrs{}
+	}
+	mmDeletePendingUser.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeletePendingUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeletePendingUser
+}
+
+// ExpectUserIDParam2 sets up expected param userID for UsersRepository.DeletePendingUser
+func (mmDeletePendingUser *mUsersRepositoryMockDeletePendingUser) ExpectUserIDParam2(userID string) *mUsersRepositoryMockDeletePendingUser {
+	if mmDeletePendingUser.mock.funcDeletePendingUser != nil {
+		mmDeletePendingUser.mock.t.Fatalf("UsersRepositoryMock.DeletePendingUser mock is already set by Set")
+	}
+
+	if mmDeletePendingUser.defaultExpectation == nil {
+		mmDeletePendingUser.defaultExpectation = &UsersRepositoryMockDeletePendingUserExpectation{}
+	}
+
+	if mmDeletePendingUser.defaultExpectation.params != nil {
+		mmDeletePendingUser.mock.t.Fatalf("UsersRepositoryMock.DeletePendingUser mock is already set by Expect")
+	}
+
+	if mmDeletePendingUser.defaultExpectation.paramPtrs == nil {
+		mmDeletePendingUser.defaultExpectation.paramPtrs = &UsersRepositoryMockDeletePendingUserParamPtrs{}
+	}
+	mmDeletePendingUser.defaultExpectation.paramPtrs.userID = &userID
+	mmDeletePendingUser.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeletePendingUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the UsersRepository.DeletePendingUser
+func (mmDeletePendingUser *mUsersRepositoryMockDeletePendingUser) Inspect(f func(ctx context.Context, userID string)) *mUsersRepositoryMockDeletePendingUser {
+	if mmDeletePendingUser.mock.inspectFuncDeletePendingUser != nil {
+		mmDeletePendingUser.mock.t.Fatalf("Inspect function is already set for UsersRepositoryMock.DeletePendingUser")
+	}
+
+	mmDeletePendingUser.mock.inspectFuncDeletePendingUser = f
+
+	return mmDeletePendingUser
+}
+
+// Return sets up results that will be returned by UsersRepository.DeletePendingUser
+func (mmDeletePendingUser *mUsersRepositoryMockDeletePendingUser) Return(err error) *UsersRepositoryMock {
+	if mmDeletePendingUser.mock.funcDeletePendingUser != nil {
+		mmDeletePendingUser.mock.t.Fatalf("UsersRepositoryMock.DeletePendingUser mock is already set by Set")
+	}
+
+	if mmDeletePendingUser.defaultExpectation == nil {
+		mmDeletePendingUser.defaultExpectation = &UsersRepositoryMockDeletePendingUserExpectation{mock: mmDeletePendingUser.mock}
+	}
+	mmDeletePendingUser.defaultExpectation.results = &UsersRepositoryMockDeletePendingUserResults{err}
+	mmDeletePendingUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeletePendingUser.mock
+}
+
+// Set uses given function f to mock the UsersRepository.DeletePendingUser method
+func (mmDeletePendingUser *mUsersRepositoryMockDeletePendingUser) Set(f func(ctx context.Context, userID string) (err error)) *UsersRepositoryMock {
+	if mmDeletePendingUser.defaultExpectation != nil {
+		mmDeletePendingUser.mock.t.Fatalf("Default expectation is already set for the UsersRepository.DeletePendingUser method")
+	}
+
+	if len(mmDeletePendingUser.expectations) > 0 {
+		mmDeletePendingUser.mock.t.Fatalf("Some expectations are already set for the UsersRepository.DeletePendingUser method")
+	}
+
+	mmDeletePendingUser.mock.funcDeletePendingUser = f
+	mmDeletePendingUser.mock.funcDeletePendingUserOrigin = minimock.CallerInfo(1)
+	return mmDeletePendingUser.mock
+}
+
+// When sets expectation for the UsersRepository.DeletePendingUser which will trigger the result defined by the following
+// Then helper
+func (mmDeletePendingUser *mUsersRepositoryMockDeletePendingUser) When(ctx context.Context, userID string) *UsersRepositoryMockDeletePendingUserExpectation {
+	if mmDeletePendingUser.mock.funcDeletePendingUser != nil {
+		mmDeletePendingUser.mock.t.Fatalf("UsersRepositoryMock.DeletePendingUser mock is already set by Set")
+	}
+
+	expectation := &UsersRepositoryMockDeletePendingUserExpectation{
+		mock:               mmDeletePendingUser.mock,
+		params:             &UsersRepositoryMockDeletePendingUserParams{ctx, userID},
+		expectationOrigins: UsersRepositoryMockDeletePendingUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeletePendingUser.expectations = append(mmDeletePendingUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UsersRepository.DeletePendingUser return parameters for the expectation previously defined by the When method
+func (e *UsersRepositoryMockDeletePendingUserExpectation) Then(err error) *UsersRepositoryMock {
+	e.results = &UsersRepositoryMockDeletePendingUserResults{err}
+	return e.mock
+}
+
+// Times sets number of times UsersRepository.DeletePendingUser should be invoked
+func (mmDeletePendingUser *mUsersRepositoryMockDeletePendingUser) Times(n uint64) *mUsersRepositoryMockDeletePendingUser {
+	if n == 0 {
+		mmDeletePendingUser.mock.t.Fatalf("Times of UsersRepositoryMock.DeletePendingUser mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeletePendingUser.expectedInvocations, n)
+	mmDeletePendingUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeletePendingUser
+}
+
+func (mmDeletePendingUser *mUsersRepositoryMockDeletePendingUser) invocationsDone() bool {
+	if len(mmDeletePendingUser.expectations) == 0 && mmDeletePendingUser.defaultExpectation == nil && mmDeletePendingUser.mock.funcDeletePendingUser == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeletePendingUser.mock.afterDeletePendingUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeletePendingUser.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeletePendingUser implements mm_usecase.UsersRepository
+func (mmDeletePendingUser *UsersRepositoryMock) DeletePendingUser(ctx context.Context, userID string) (err error) {
+	mm_atomic.AddUint64(&mmDeletePendingUser.beforeDeletePendingUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeletePendingUser.afterDeletePendingUserCounter, 1)
+
+	mmDeletePendingUser.t.Helper()
+
+	if mmDeletePendingUser.inspectFuncDeletePendingUser != nil {
+		mmDeletePendingUser.inspectFuncDeletePendingUser(ctx, userID)
+	}
+
+	mm_params := UsersRepositoryMockDeletePendingUserParams{ctx, userID}
+
+	// Record call args
+	mmDeletePendingUser.DeletePendingUserMock.mutex.Lock()
+	mmDeletePendingUser.DeletePendingUserMock.callArgs = append(mmDeletePendingUser.DeletePendingUserMock.callArgs, &mm_params)
+	mmDeletePendingUser.DeletePendingUserMock.mutex.Unlock()
+
+	for _, e := range mmDeletePendingUser.DeletePendingUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeletePendingUser.DeletePendingUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeletePendingUser.DeletePendingUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeletePendingUser.DeletePendingUserMock.defaultExpectation.params
+		mm_want_ptrs := mmDeletePendingUser.DeletePendingUserMock.defaultExpectation.paramPtrs
+
+		mm_got := UsersRepositoryMockDeletePendingUserParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeletePendingUser.t.Errorf("UsersRepositoryMock.DeletePendingUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeletePendingUser.DeletePendingUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeletePendingUser.t.Errorf("UsersRepositoryMock.DeletePendingUser got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeletePendingUser.DeletePendingUserMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeletePendingUser.t.Errorf("UsersRepositoryMock.DeletePendingUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeletePendingUser.DeletePendingUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeletePendingUser.DeletePendingUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeletePendingUser.t.Fatal("No results are set for the UsersRepositoryMock.DeletePendingUser")
+		}
+		return (*mm_results).err
+	}
+	if mmDeletePendingUser.funcDeletePendingUser != nil {
+		return mmDeletePendingUser.funcDeletePendingUser(ctx, userID)
+	}
+	mmDeletePendingUser.t.Fatalf("Unexpected call to UsersRepositoryMock.DeletePendingUser. %v %v", ctx, userID)
+	return
+}
+
+// DeletePendingUserAfterCounter returns a count of finished UsersRepositoryMock.DeletePendingUser invocations
+func (mmDeletePendingUser *UsersRepositoryMock) DeletePendingUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeletePendingUser.afterDeletePendingUserCounter)
+}
+
+// DeletePendingUserBeforeCounter returns a count of UsersRepositoryMock.DeletePendingUser invocations
+func (mmDeletePendingUser *UsersRepositoryMock) DeletePendingUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeletePendingUser.beforeDeletePendingUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to UsersRepositoryMock.DeletePendingUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeletePendingUser *mUsersRepositoryMockDeletePendingUser) Calls() []*UsersRepositoryMockDeletePendingUserParams {
+	mmDeletePendingUser.mutex.RLock()
+
+	argCopy := make([]*UsersRepositoryMockDeletePendingUserParams, len(mmDeletePendingUser.callArgs))
+	copy(argCopy, mmDeletePendingUser.callArgs)
+
+	mmDeletePendingUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeletePendingUserDone returns true if the count of the DeletePendingUser invocations corresponds
+// the number of defined expectations
+func (m *UsersRepositoryMock) MinimockDeletePendingUserDone() bool {
+	if m.DeletePendingUserMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeletePendingUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeletePendingUserMock.invocationsDone()
+}
+
+// MinimockDeletePendingUserInspect logs each unmet expectation
+func (m *UsersRepositoryMock) MinimockDeletePendingUserInspect() {
+	for _, e := range m.DeletePendingUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UsersRepositoryMock.DeletePendingUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeletePendingUserCounter := mm_atomic.LoadUint64(&m.afterDeletePendingUserCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeletePendingUserMock.defaultExpectation != nil && afterDeletePendingUserCounter < 1 {
+		if m.DeletePendingUserMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UsersRepositoryMock.DeletePendingUser at\n%s", m.DeletePendingUserMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UsersRepositoryMock.DeletePendingUser at\n%s with params: %#v", m.DeletePendingUserMock.defaultExpectation.expectationOrigins.origin, *m.DeletePendingUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeletePendingUser != nil && afterDeletePendingUserCounter < 1 {
+		m.t.Errorf("Expected call to UsersRepositoryMock.DeletePendingUser at\n%s", m.funcDeletePendingUserOrigin)
+	}
+
+	if !m.DeletePendingUserMock.invocationsDone() && afterDeletePendingUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to UsersRepositoryMock.DeletePendingUser at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeletePendingUserMock.expectedInvocations), m.DeletePendingUserMock.expectedInvocationsOrigin, afterDeletePendingUserCounter)
+	}
+}
+
+type mUsersRepositoryMockGetUserByEmail struct {
+	optional           bool
+	mock               *UsersRepositoryMock
+	defaultExpectation *UsersRepositoryMockGetUserByEmailExpectation
+	expectations       []*UsersRepositoryMockGetUserByEmailExpectation
+
+	callArgs []*UsersRepositoryMockGetUserByEmailParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UsersRepositoryMockGetUserByEmailExpectation specifies expectation struct of the UsersRepository.GetUserByEmail
+type UsersRepositoryMockGetUserByEmailExpectation struct {
+	mock               *UsersRepositoryMock
+	params             *UsersRepositoryMockGetUserByEmailParams
+	paramPtrs          *UsersRepositoryMockGetUserByEmailParamPtrs
+	expectationOrigins UsersRepositoryMockGetUserByEmailExpectationOrigins
+	results            *UsersRepositoryMockGetUserByEmailResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UsersRepositoryMockGetUserByEmailParams contains parameters of the UsersRepository.GetUserByEmail
+type UsersRepositoryMockGetUserByEmailParams struct {
+	ctx   context.Context
+	email string
+}
+
+// UsersRepositoryMockGetUserByEmailParamPtrs contains pointers to parameters of the UsersRepository.GetUserByEmail
+type UsersRepositoryMockGetUserByEmailParamPtrs struct {
+	ctx   *context.Context
+	email *string
+}
+
+// UsersRepositoryMockGetUserByEmailResults contains results of the UsersRepository.GetUserByEmail
+type UsersRepositoryMockGetUserByEmailResults struct {
+	up1 *models.User
+	err error
+}
+
+// UsersRepositoryMockGetUserByEmailOrigins contains origins of expectations of the UsersRepository.GetUserByEmail
+type UsersRepositoryMockGetUserByEmailExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originEmail string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetUserByEmail *mUsersRepositoryMockGetUserByEmail) Optional() *mUsersRepositoryMockGetUserByEmail {
+	mmGetUserByEmail.optional = true
+	return mmGetUserByEmail
+}
+
+// Expect sets up expected params for UsersRepository.GetUserByEmail
+func (mmGetUserByEmail *mUsersRepositoryMockGetUserByEmail) Expect(ctx context.Context, email string) *mUsersRepositoryMockGetUserByEmail {
+	if mmGetUserByEmail.mock.funcGetUserByEmail != nil {
+		mmGetUserByEmail.mock.t.Fatalf("UsersRepositoryMock.GetUserByEmail mock is already set by Set")
+	}
+
+	if mmGetUserByEmail.defaultExpectation == nil {
+		mmGetUserByEmail.defaultExpectation = &UsersRepositoryMockGetUserByEmailExpectation{}
+	}
+
+	if mmGetUserByEmail.defaultExpectation.paramPtrs != nil {
+		mmGetUserByEmail.mock.t.Fatalf("UsersRepositoryMock.GetUserByEmail mock is already set by ExpectParams functions")
+	}
+
+	mmGetUserByEmail.defaultExpectation.params = &UsersRepositoryMockGetUserByEmailParams{ctx, email}
+	mmGetUserByEmail.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetUserByEmail.expectations {
+		if minimock.Equal(e.params, mmGetUserByEmail.defaultExpectation.params) {
+			mmGetUserByEmail.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetUserByEmail.defaultExpectation.params)
+		}
+	}
+
+	return mmGetUserByEmail
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UsersRepository.GetUserByEmail
+func (mmGetUserByEmail *mUsersRepositoryMockGetUserByEmail) ExpectCtxParam1(ctx context.Context) *mUsersRepositoryMockGetUserByEmail {
+	if mmGetUserByEmail.mock.funcGetUserByEmail != nil {
+		mmGetUserByEmail.mock.t.Fatalf("UsersRepositoryMock.GetUserByEmail mock is already set by Set")
+	}
+
+	if mmGetUserByEmail.defaultExpectation == nil {
+		mmGetUserByEmail.defaultExpectation = &UsersRepositoryMockGetUserByEmailExpectation{}
+	}
+
+	if mmGetUserByEmail.defaultExpectation.params != nil {
+		mmGetUserByEmail.mock.t.Fatalf("UsersRepositoryMock.GetUserByEmail mock is already set by Expect")
+	}
+
+	if mmGetUserByEmail.defaultExpectation.paramPtrs == nil {
+		mmGetUserByEmail.defaultExpectation.paramPtrs = &UsersRepositoryMockGetUserByEmailParamPtrs{}
+	}
+	mmGetUserByEmail.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetUserByEmail.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetUserByEmail
+}
+
+// ExpectEmailParam2 sets up expected param email for UsersRepository.GetUserByEmail
+func (mmGetUserByEmail *mUsersRepositoryMockGetUserByEmail) ExpectEmailParam2(email string) *mUsersRepositoryMockGetUserByEmail {
+	if mmGetUserByEmail.mock.funcGetUserByEmail != nil {
+		mmGetUserByEmail.mock.t.Fatalf("UsersRepositoryMock.GetUserByEmail mock is already set by Set")
+	}
+
+	if mmGetUserByEmail.defaultExpectation == nil {
+		mmGetUserByEmail.defaultExpectation = &UsersRepositoryMockGetUserByEmailExpectation{}
+	}
+
+	if mmGetUserByEmail.defaultExpectation.params != nil {
+		mmGetUserByEmail.mock.t.Fatalf("UsersRepositoryMock.GetUserByEmail mock is already set by Expect")
+	}
+
+	if mmGetUserByEmail.defaultExpectation.paramPtrs == nil {
+		mmGetUserByEmail.defaultExpectation.paramPtrs = &UsersRepositoryMockGetUserByEmailParamPtrs{}
+	}
+	mmGetUserByEmail.defaultExpectation.paramPtrs.email = &email
+	mmGetUserByEmail.defaultExpectation.expectationOrigins.originEmail = minimock.CallerInfo(1)
+
+	return mmGetUserByEmail
+}
+
+// Inspect accepts an inspector function that has same arguments as the UsersRepository.GetUserByEmail
+func (mmGetUserByEmail *mUsersRepositoryMockGetUserByEmail) Inspect(f func(ctx context.Context, email string)) *mUsersRepositoryMockGetUserByEmail {
+	if mmGetUserByEmail.mock.inspectFuncGetUserByEmail != nil {
+		mmGetUserByEmail.mock.t.Fatalf("Inspect function is already set for UsersRepositoryMock.GetUserByEmail")
+	}
+
+	mmGetUserByEmail.mock.inspectFuncGetUserByEmail = f
+
+	return mmGetUserByEmail
+}
+
+// Return sets up results that will be returned by UsersRepository.GetUserByEmail
+func (mmGetUserByEmail *mUsersRepositoryMockGetUserByEmail) Return(up1 *models.User, err error) *UsersRepositoryMock {
+	if mmGetUserByEmail.mock.funcGetUserByEmail != nil {
+		mmGetUserByEmail.mock.t.Fatalf("UsersRepositoryMock.GetUserByEmail mock is already set by Set")
+	}
+
+	if mmGetUserByEmail.defaultExpectation == nil {
+		mmGetUserByEmail.defaultExpectation = &UsersRepositoryMockGetUserByEmailExpectation{mock: mmGetUserByEmail.mock}
+	}
+	mmGetUserByEmail.defaultExpectation.results = &UsersRepositoryMockGetUserByEmailResults{up1, err}
+	mmGetUserByEmail.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetUserByEmail.mock
+}
+
+// Set uses given function f to mock the UsersRepository.GetUserByEmail method
+func (mmGetUserByEmail *mUsersRepositoryMockGetUserByEmail) Set(f func(ctx context.Context, email string) (up1 *models.User, err error)) *UsersRepositoryMock {
+	if mmGetUserByEmail.defaultExpectation != nil {
+		mmGetUserByEmail.mock.t.Fatalf("Default expectation is already set for the UsersRepository.GetUserByEmail method")
+	}
+
+	if len(mmGetUserByEmail.expectations) > 0 {
+		mmGetUserByEmail.mock.t.Fatalf("Some expectations are already set for the UsersRepository.GetUserByEmail method")
+	}
+
+	mmGetUserByEmail.mock.funcGetUserByEmail = f
+	mmGetUserByEmail.mock.funcGetUserByEmailOrigin = minimock.CallerInfo(1)
+	return mmGetUserByEmail.mock
+}
+
+// When sets expectation for the UsersRepository.GetUserByEmail which will trigger the result defined by the following
+// Then helper
+func (mmGetUserByEmail *mUsersRepositoryMockGetUserByEmail) When(ctx context.Context, email string) *UsersRepositoryMockGetUserByEmailExpectation {
+	if mmGetUserByEmail.mock.funcGetUserByEmail != nil {
+		mmGetUserByEmail.mock.t.Fatalf("UsersRepositoryMock.GetUserByEmail mock is already set by Set")
+	}
+
+	expectation := &UsersRepositoryMockGetUserByEmailExpectation{
+		mock:               mmGetUserByEmail.mock,
+		params:             &UsersRepositoryMockGetUserByEmailParams{ctx, email},
+		expectationOrigins: UsersRepositoryMockGetUserByEmailExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetUserByEmail.expectations = append(mmGetUserByEmail.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UsersRepository.GetUserByEmail return parameters for the expectation previously defined by the When method
+func (e *UsersRepositoryMockGetUserByEmailExpectation) Then(up1 *models.User, err error) *UsersRepositoryMock {
+	e.results = &UsersRepositoryMockGetUserByEmailResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times UsersRepository.GetUserByEmail should be invoked
+func (mmGetUserByEmail *mUsersRepositoryMockGetUserByEmail) Times(n uint64) *mUsersRepositoryMockGetUserByEmail {
+	if n == 0 {
+		mmGetUserByEmail.mock.t.Fatalf("Times of UsersRepositoryMock.GetUserByEmail mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetUserByEmail.expectedInvocations, n)
+	mmGetUserByEmail.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetUserByEmail
+}
+
+func (mmGetUserByEmail *mUsersRepositoryMockGetUserByEmail) invocationsDone() bool {
+	if len(mmGetUserByEmail.expectations) == 0 && mmGetUserByEmail.defaultExpectation == nil && mmGetUserByEmail.mock.funcGetUserByEmail == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetUserByEmail.mock.afterGetUserByEmailCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetUserByEmail.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetUserByEmail implements mm_usecase.UsersRepository
+func (mmGetUserByEmail *UsersRepositoryMock) GetUserByEmail(ctx context.Context, email string) (up1 *models.User, err error) {
+	mm_atomic.AddUint64(&mmGetUserByEmail.beforeGetUserByEmailCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetUserByEmail.afterGetUserByEmailCounter, 1)
+
+	mmGetUserByEmail.t.Helper()
+
+	if mmGetUserByEmail.inspectFuncGetUserByEmail != nil {
+		mmGetUserByEmail.inspectFuncGetUserByEmail(ctx, email)
+	}
+
+	mm_params := UsersRepositoryMockGetUserByEmailParams{ctx, email}
+
+	// Record call args
+	mmGetUserByEmail.GetUserByEmailMock.mutex.Lock()
+	mmGetUserByEmail.GetUserByEmailMock.callArgs = append(mmGetUserByEmail.GetUserByEmailMock.callArgs, &mm_params)
+	mmGetUserByEmail.GetUserByEmailMock.mutex.Unlock()
+
+	for _, e := range mmGetUserByEmail.GetUserByEmailMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmGetUserByEmail.GetUserByEmailMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetUserByEmail.GetUserByEmailMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetUserByEmail.GetUserByEmailMock.defaultExpectation.params
+		mm_want_ptrs := mmGetUserByEmail.GetUserByEmailMock.defaultExpectation.paramPtrs
+
+		mm_got := UsersRepositoryMockGetUserByEmailParams{ctx, email}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetUserByEmail.t.Errorf("UsersRepositoryMock.GetUserByEmail got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUserByEmail.GetUserByEmailMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.email != nil && !minimock.Equal(*mm_want_ptrs.email, mm_got.email) {
+				mmGetUserByEmail.t.Errorf("UsersRepositoryMock.GetUserByEmail got unexpected parameter email, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUserByEmail.GetUserByEmailMock.defaultExpectation.expectationOrigins.originEmail, *mm_want_ptrs.email, mm_got.email, minimock.Diff(*mm_want_ptrs.email, mm_got.email))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetUserByEmail.t.Errorf("UsersRepositoryMock.GetUserByEmail got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetUserByEmail.GetUserByEmailMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetUserByEmail.GetUserByEmailMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetUserByEmail.t.Fatal("No results are set for the UsersRepositoryMock.GetUserByEmail")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmGetUserByEmail.funcGetUserByEmail != nil {
+		return mmGetUserByEmail.funcGetUserByEmail(ctx, email)
+	}
+	mmGetUserByEmail.t.Fatalf("Unexpected call to UsersRepositoryMock.GetUserByEmail. %v %v", ctx, email)
+	return
+}
+
+// GetUserByEmailAfterCounter returns a count of finished UsersRepositoryMock.GetUserByEmail invocations
+func (mmGetUserByEmail *UsersRepositoryMock) GetUserByEmailAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUserByEmail.afterGetUserByEmailCounter)
+}
+
+// GetUserByEmailBeforeCounter returns a count of UsersRepositoryMock.GetUserByEmail invocations
+func (mmGetUserByEmail *UsersRepositoryMock) GetUserByEmailBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUserByEmail.beforeGetUserByEmailCounter)
+}
+
+// Calls returns a list of arguments used in each call to UsersRepositoryMock.GetUserByEmail.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetUserByEmail *mUsersRepositoryMockGetUserByEmail) Calls() []*UsersRepositoryMockGetUserByEmailParams {
+	mmGetUserByEmail.mutex.RLock()
+
+	argCopy := make([]*UsersRepositoryMockGetUserByEmailParams, len(mmGetUserByEmail.callArgs))
+	copy(argCopy, mmGetUserByEmail.callArgs)
+
+	mmGetUserByEmail.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetUserByEmailDone returns true if the count of the GetUserByEmail invocations corresponds
+// the number of defined expectations
+func (m *UsersRepositoryMock) MinimockGetUserByEmailDone() bool {
+	if m.GetUserByEmailMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetUserByEmailMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetUserByEmailMock.invocationsDone()
+}
+
+// MinimockGetUserByEmailInspect logs each unmet expectation
+func (m *UsersRepositoryMock) MinimockGetUserByEmailInspect() {
+	for _, e := range m.GetUserByEmailMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UsersRepositoryMock.GetUserByEmail at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetUserByEmailCounter := mm_atomic.LoadUint64(&m.afterGetUserByEmailCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetUserByEmailMock.defaultExpectation != nil && afterGetUserByEmailCounter < 1 {
+		if m.GetUserByEmailMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UsersRepositoryMock.GetUserByEmail at\n%s", m.GetUserByEmailMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UsersRepositoryMock.GetUserByEmail at\n%s with params: %#v", m.GetUserByEmailMock.defaultExpectation.expectationOrigins.origin, *m.GetUserByEmailMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetUserByEmail != nil && afterGetUserByEmailCounter < 1 {
+		m.t.Errorf("Expected call to UsersRepositoryMock.GetUserByEmail at\n%s", m.funcGetUserByEmailOrigin)
+	}
+
+	if !m.GetUserByEmailMock.invocationsDone() && afterGetUserByEmailCounter > 0 {
+		m.t.Errorf("Expected %d calls to UsersRepositoryMock.GetUserByEmail at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetUserByEmailMock.expectedInvocations), m.GetUserByEmailMock.expectedInvocationsOrigin, afterGetUserByEmailCounter)
+	}
+}
+
+type mUsersRepositoryMockGetUserByID struct {
+	optional           bool
+	mock               *UsersRepositoryMock
+	defaultExpectation *UsersRepositoryMockGetUserByIDExpectation
+	expectations       []*UsersRepositoryMockGetUserByIDExpectation
+
+	callArgs []*UsersRepositoryMockGetUserByIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UsersRepositoryMockGetUserByIDExpectation specifies expectation struct of the UsersRepository.GetUserByID
+type UsersRepositoryMockGetUserByIDExpectation struct {
+	mock               *UsersRepositoryMock
+	params             *UsersRepositoryMockGetUserByIDParams
+	paramPtrs          *UsersRepositoryMockGetUserByIDParamPtrs
+	expectationOrigins UsersRepositoryMockGetUserByIDExpectationOrigins
+	results            *UsersRepositoryMockGetUserByIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UsersRepositoryMockGetUserByIDParams contains parameters of the UsersRepository.GetUserByID
+type UsersRepositoryMockGetUserByIDParams struct {
+	ctx    context.Context
+	userID string
+}
+
+// UsersRepositoryMockGetUserByIDParamPtrs contains pointers to parameters of the UsersRepository.GetUserByID
+type UsersRepositoryMockGetUserByIDParamPtrs struct {
+	ctx    *context.Context
+	userID *string
+}
+
+// UsersRepositoryMockGetUserByIDResults contains results of the UsersRepository.GetUserByID
+type UsersRepositoryMockGetUserByIDResults struct {
+	up1 *models.User
+	err error
+}
+
+// UsersRepositoryMockGetUserByIDOrigins contains origins of expectations of the UsersRepository.GetUserByID
+type UsersRepositoryMockGetUserByIDExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetUserByID *mUsersRepositoryMockGetUserByID) Optional() *mUsersRepositoryMockGetUserByID {
+	mmGetUserByID.optional = true
+	return mmGetUserByID
+}
+
+// Expect sets up expected params for UsersRepository.GetUserByID
+func (mmGetUserByID *mUsersRepositoryMockGetUserByID) Expect(ctx context.Context, userID string) *mUsersRepositoryMockGetUserByID {
+	if mmGetUserByID.mock.funcGetUserByID != nil {
+		mmGetUserByID.mock.t.Fatalf("UsersRepositoryMock.GetUserByID mock is already set by Set")
+	}
+
+	if mmGetUserByID.defaultExpectation == nil {
+		mmGetUserByID.defaultExpectation = &UsersRepositoryMockGetUserByIDExpectation{}
+	}
+
+	if mmGetUserByID.defaultExpectation.paramPtrs != nil {
+		mmGetUserByID.mock.t.Fatalf("UsersRepositoryMock.GetUserByID mock is already set by ExpectParams functions")
+	}
+
+	mmGetUserByID.defaultExpectation.params = &UsersRepositoryMockGetUserByIDParams{ctx, userID}
+	mmGetUserByID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetUserByID.expectations {
+		if minimock.Equal(e.params, mmGetUserByID.defaultExpectation.params) {
+			mmGetUserByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetUserByID.defaultExpectation.params)
+		}
+	}
+
+	return mmGetUserByID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UsersRepository.GetUserByID
+func (mmGetUserByID *mUsersRepositoryMockGetUserByID) ExpectCtxParam1(ctx context.Context) *mUsersRepositoryMockGetUserByID {
+	if mmGetUserByID.mock.funcGetUserByID != nil {
+		mmGetUserByID.mock.t.Fatalf("UsersRepositoryMock.GetUserByID mock is already set by Set")
+	}
+
+	if mmGetUserByID.defaultExpectation == nil {
+		mmGetUserByID.defaultExpectation = &UsersRepositoryMockGetUserByIDExpectation{}
+	}
+
+	if mmGetUserByID.defaultExpectation.params != nil {
+		mmGetUserByID.mock.t.Fatalf("UsersRepositoryMock.GetUserByID mock is already set by Expect")
+	}
+
+	if mmGetUserByID.defaultExpectation.paramPtrs == nil {
+		mmGetUserByID.defaultExpectation.paramPtrs = &UsersRepositoryMockGetUserByIDParamPtrs{}
+	}
+	mmGetUserByID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetUserByID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetUserByID
+}
+
+// ExpectUserIDParam2 sets up expected param userID for UsersRepository.GetUserByID
+func (mmGetUserByID *mUsersRepositoryMockGetUserByID) ExpectUserIDParam2(userID string) *mUsersRepositoryMockGetUserByID {
+	if mmGetUserByID.mock.funcGetUserByID != nil {
+		mmGetUserByID.mock.t.Fatalf("UsersRepositoryMock.GetUserByID mock is already set by Set")
+	}
+
+	if mmGetUserByID.defaultExpectation == nil {
+		mmGetUserByID.defaultExpectation = &UsersRepositoryMockGetUserByIDExpectation{}
+	}
+
+	if mmGetUserByID.defaultExpectation.params != nil {
+		mmGetUserByID.mock.t.Fatalf("UsersRepositoryMock.GetUserByID mock is already set by Expect")
+	}
+
+	if mmGetUserByID.defaultExpectation.paramPtrs == nil {
+		mmGetUserByID.defaultExpectation.paramPtrs = &UsersRepositoryMockGetUserByIDParamPtrs{}
+	}
+	mmGetUserByID.defaultExpectation.paramPtrs.userID = &userID
+	mmGetUserByID.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetUserByID
+}
+
+// Inspect accepts an inspector function that has same arguments as the UsersRepository.GetUserByID
+func (mmGetUserByID *mUsersRepositoryMockGetUserByID) Inspect(f func(ctx context.Context, userID string)) *mUsersRepositoryMockGetUserByID {
+	if mmGetUserByID.mock.inspectFuncGetUserByID != nil {
+		mmGetUserByID.mock.t.Fatalf("Inspect function is already set for UsersRepositoryMock.GetUserByID")
+	}
+
+	mmGetUserByID.mock.inspectFuncGetUserByID = f
+
+	return mmGetUserByID
+}
+
+// Return sets up results that will be returned by UsersRepository.GetUserByID
+func (mmGetUserByID *mUsersRepositoryMockGetUserByID) Return(up1 *models.User, err error) *UsersRepositoryMock {
+	if mmGetUserByID.mock.funcGetUserByID != nil {
+		mmGetUserByID.mock.t.Fatalf("UsersRepositoryMock.GetUserByID mock is already set by Set")
+	}
+
+	if mmGetUserByID.defaultExpectation == nil {
+		mmGetUserByID.defaultExpectation = &UsersRepositoryMockGetUserByIDExpectation{mock: mmGetUserByID.mock}
+	}
+	mmGetUserByID.defaultExpectation.results = &UsersRepositoryMockGetUserByIDResults{up1, err}
+	mmGetUserByID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetUserByID.mock
+}
+
+// Set uses given function f to mock the UsersRepository.GetUserByID method
+func (mmGetUserByID *mUsersRepositoryMockGetUserByID) Set(f func(ctx context.Context, userID string) (up1 *models.User, err error)) *UsersRepositoryMock {
+	if mmGetUserByID.defaultExpectation != nil {
+		mmGetUserByID.mock.t.Fatalf("Default expectation is already set for the UsersRepository.GetUserByID method")
+	}
+
+	if len(mmGetUserByID.expectations) > 0 {
+		mmGetUserByID.mock.t.Fatalf("Some expectations are already set for the UsersRepository.GetUserByID method")
+	}
+
+	mmGetUserByID.mock.funcGetUserByID = f
+	mmGetUserByID.mock.funcGetUserByIDOrigin = minimock.CallerInfo(1)
+	return mmGetUserByID.mock
+}
+
+// When sets expectation for the UsersRepository.GetUserByID which will trigger the result defined by the following
+// Then helper
+func (mmGetUserByID *mUsersRepositoryMockGetUserByID) When(ctx context.Context, userID string) *UsersRepositoryMockGetUserByIDExpectation {
+	if mmGetUserByID.mock.funcGetUserByID != nil {
+		mmGetUserByID.mock.t.Fatalf("UsersRepositoryMock.GetUserByID mock is already set by Set")
+	}
+
+	expectation := &UsersRepositoryMockGetUserByIDExpectation{
+		mock:               mmGetUserByID.mock,
+		params:             &UsersRepositoryMockGetUserByIDParams{ctx, userID},
+		expectationOrigins: UsersRepositoryMockGetUserByIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetUserByID.expectations = append(mmGetUserByID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UsersRepository.GetUserByID return parameters for the expectation previously defined by the When method
+func (e *UsersRepositoryMockGetUserByIDExpectation) Then(up1 *models.User, err error) *UsersRepositoryMock {
+	e.results = &UsersRepositoryMockGetUserByIDResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times UsersRepository.GetUserByID should be invoked
+func (mmGetUserByID *mUsersRepositoryMockGetUserByID) Times(n uint64) *mUsersRepositoryMockGetUserByID {
+	if n == 0 {
+		mmGetUserByID.mock.t.Fatalf("Times of UsersRepositoryMock.GetUserByID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetUserByID.expectedInvocations, n)
+	mmGetUserByID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetUserByID
+}
+
+func (mmGetUserByID *mUsersRepositoryMockGetUserByID) invocationsDone() bool {
+	if len(mmGetUserByID.expectations) == 0 && mmGetUserByID.defaultExpectation == nil && mmGetUserByID.mock.funcGetUserByID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetUserByID.mock.afterGetUserByIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetUserByID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetUserByID implements mm_usecase.UsersRepository
+func (mmGetUserByID *UsersRepositoryMock) GetUserByID(ctx context.Context, userID string) (up1 *models.User, err error) {
+	mm_atomic.AddUint64(&mmGetUserByID.beforeGetUserByIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetUserByID.afterGetUserByIDCounter, 1)
+
+	mmGetUserByID.t.Helper()
+
+	if mmGetUserByID.inspectFuncGetUserByID != nil {
+		mmGetUserByID.inspectFuncGetUserByID(ctx, userID)
+	}
+
+	mm_params := UsersRepositoryMockGetUserByIDParams{ctx, userID}
+
+	// Record call args
+	mmGetUserByID.GetUserByIDMock.mutex.Lock()
+	mmGetUserByID.GetUserByIDMock.callArgs = append(mmGetUserByID.GetUserByIDMock.callArgs, &mm_params)
+	mmGetUserByID.GetUserByIDMock.mutex.Unlock()
+
+	for _, e := range mmGetUserByID.GetUserByIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmGetUserByID.GetUserByIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetUserByID.GetUserByIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetUserByID.GetUserByIDMock.defaultExpectation.params
+		mm_want_ptrs := mmGetUserByID.GetUserByIDMock.defaultExpectation.paramPtrs
+
+		mm_got := UsersRepositoryMockGetUserByIDParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetUserByID.t.Errorf("UsersRepositoryMock.GetUserByID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUserByID.GetUserByIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetUserByID.t.Errorf("UsersRepositoryMock.GetUserByID got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUserByID.GetUserByIDMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetUserByID.t.Errorf("UsersRepositoryMock.GetUserByID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetUserByID.GetUserByIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetUserByID.GetUserByIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetUserByID.t.Fatal("No results are set for the UsersRepositoryMock.GetUserByID")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmGetUserByID.funcGetUserByID != nil {
+		return mmGetUserByID.funcGetUserByID(ctx, userID)
+	}
+	mmGetUserByID.t.Fatalf("Unexpected call to UsersRepositoryMock.GetUserByID. %v %v", ctx, userID)
+	return
+}
+
+// GetUserByIDAfterCounter returns a count of finished UsersRepositoryMock.GetUserByID invocations
+func (mmGetUserByID *UsersRepositoryMock) GetUserByIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUserByID.afterGetUserByIDCounter)
+}
+
+// GetUserByIDBeforeCounter returns a count of UsersRepositoryMock.GetUserByID invocations
+func (mmGetUserByID *UsersRepositoryMock) GetUserByIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUserByID.beforeGetUserByIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to UsersRepositoryMock.GetUserByID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetUserByID *mUsersRepositoryMockGetUserByID) Calls() []*UsersRepositoryMockGetUserByIDParams {
+	mmGetUserByID.mutex.RLock()
+
+	argCopy := make([]*UsersRepositoryMockGetUserByIDParams, len(mmGetUserByID.callArgs))
+	copy(argCopy, mmGetUserByID.callArgs)
+
+	mmGetUserByID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetUserByIDDone returns true if the count of the GetUserByID invocations corresponds
+// the number of defined expectations
+func (m *UsersRepositoryMock) MinimockGetUserByIDDone() bool {
+	if m.GetUserByIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetUserByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetUserByIDMock.invocationsDone()
+}
+
+// MinimockGetUserByIDInspect logs each unmet expectation
+func (m *UsersRepositoryMock) MinimockGetUserByIDInspect() {
+	for _, e := range m.GetUserByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UsersRepositoryMock.GetUserByID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetUserByIDCounter := mm_atomic.LoadUint64(&m.afterGetUserByIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetUserByIDMock.defaultExpectation != nil && afterGetUserByIDCounter < 1 {
+		if m.GetUserByIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UsersRepositoryMock.GetUserByID at\n%s", m.GetUserByIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UsersRepositoryMock.GetUserByID at\n%s with params: %#v", m.GetUserByIDMock.defaultExpectation.expectationOrigins.origin, *m.GetUserByIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetUserByID != nil && afterGetUserByIDCounter < 1 {
+		m.t.Errorf("Expected call to UsersRepositoryMock.GetUserByID at\n%s", m.funcGetUserByIDOrigin)
+	}
+
+	if !m.GetUserByIDMock.invocationsDone() && afterGetUserByIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to UsersRepositoryMock.GetUserByID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetUserByIDMock.expectedInvocations), m.GetUserByIDMock.expectedInvocationsOrigin, afterGetUserByIDCounter)
+	}
+}
+
+type mUsersRepositoryMockMarkEmailVerified struct {
+	optional           bool
+	mock               *UsersRepositoryMock
+	defaultExpectation *UsersRepositoryMockMarkEmailVerifiedExpectation
+	expectations       []*UsersRepositoryMockMarkEmailVerifiedExpectation
+
+	callArgs []*UsersRepositoryMockMarkEmailVerifiedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UsersRepositoryMockMarkEmailVerifiedExpectation specifies expectation struct of the UsersRepository.MarkEmailVerified
+type UsersRepositoryMockMarkEmailVerifiedExpectation struct {
+	mock               *UsersRepositoryMock
+	params             *UsersRepositoryMockMarkEmailVerifiedParams
+	paramPtrs          *UsersRepositoryMockMarkEmailVerifiedParamPtrs
+	expectationOrigins UsersRepositoryMockMarkEmailVerifiedExpectationOrigins
+	results            *UsersRepositoryMockMarkEmailVerifiedResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UsersRepositoryMockMarkEmailVerifiedParams contains parameters of the UsersRepository.MarkEmailVerified
+type UsersRepositoryMockMarkEmailVerifiedParams struct {
+	ctx    context.Context
+	userID string
+}
+
+// UsersRepositoryMockMarkEmailVerifiedParamPtrs contains pointers to parameters of the UsersRepository.MarkEmailVerified
+type UsersRepositoryMockMarkEmailVerifiedParamPtrs struct {
+	ctx    *context.Context
+	userID *string
+}
+
+// UsersRepositoryMockMarkEmailVerifiedResults contains results of the UsersRepository.MarkEmailVerified
+type UsersRepositoryMockMarkEmailVerifiedResults struct {
+	err error
+}
+
+// UsersRepositoryMockMarkEmailVerifiedOrigins contains origins of expectations of the UsersRepository.MarkEmailVerified
+type UsersRepositoryMockMarkEmailVerifiedExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMarkEmailVerified *mUsersRepositoryMockMarkEmailVerified) Optional() *mUsersRepositoryMockMarkEmailVerified {
+	mmMarkEmailVerified.optional = true
+	return mmMarkEmailVerified
+}
+
+// Expect sets up expected params for UsersRepository.MarkEmailVerified
+func (mmMarkEmailVerified *mUsersRepositoryMockMarkEmailVerified) Expect(ctx context.Context, userID string) *mUsersRepositoryMockMarkEmailVerified {
+	if mmMarkEmailVerified.mock.funcMarkEmailVerified != nil {
+		mmMarkEmailVerified.mock.t.Fatalf("UsersRepositoryMock.MarkEmailVerified mock is already set by Set")
+	}
+
+	if mmMarkEmailVerified.defaultExpectation == nil {
+		mmMarkEmailVerified.defaultExpectation = &UsersRepositoryMockMarkEmailVerifiedExpectation{}
+	}
+
+	if mmMarkEmailVerified.defaultExpectation.paramPtrs != nil {
+		mmMarkEmailVerified.mock.t.Fatalf("UsersRepositoryMock.MarkEmailVerified mock is already set by ExpectParams functions")
+	}
+
+	mmMarkEmailVerified.defaultExpectation.params = &UsersRepositoryMockMarkEmailVerifiedParams{ctx, userID}
+	mmMarkEmailVerified.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMarkEmailVerified.expectations {
+		if minimock.Equal(e.params, mmMarkEmailVerified.defaultExpectation.params) {
+			mmMarkEmailVerified.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMarkEmailVerified.defaultExpectation.params)
+		}
+	}
+
+	return mmMarkEmailVerified
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UsersRepository.MarkEmailVerified
+func (mmMarkEmailVerified *mUsersRepositoryMockMarkEmailVerified) ExpectCtxParam1(ctx context.Context) *mUsersRepositoryMockMarkEmailVerified {
+	if mmMarkEmailVerified.mock.funcMarkEmailVerified != nil {
+		mmMarkEmailVerified.mock.t.Fatalf("UsersRepositoryMock.MarkEmailVerified mock is already set by Set")
+	}
+
+	if mmMarkEmailVerified.defaultExpectation == nil {
+		mmMarkEmailVerified.defaultExpectation = &UsersRepositoryMockMarkEmailVerifiedExpectation{}
+	}
+
+	if mmMarkEmailVerified.defaultExpectation.params != nil {
+		mmMarkEmailVerified.mock.t.Fatalf("UsersRepositoryMock.MarkEmailVerified mock is already set by Expect")
+	}
+
+	if mmMarkEmailVerified.defaultExpectation.paramPtrs == nil {
+		mmMarkEmailVerified.defaultExpectation.paramPtrs = &UsersRepositoryMockMarkEmailVerifiedParamPtrs{}
+	}
+	mmMarkEmailVerified.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMarkEmailVerified.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMarkEmailVerified
+}
+
+// ExpectUserIDParam2 sets up expected param userID for UsersRepository.MarkEmailVerified
+func (mmMarkEmailVerified *mUsersRepositoryMockMarkEmailVerified) ExpectUserIDParam2(userID string) *mUsersRepositoryMockMarkEmailVerified {
+	if mmMarkEmailVerified.mock.funcMarkEmailVerified != nil {
+		mmMarkEmailVerified.mock.t.Fatalf("UsersRepositoryMock.MarkEmailVerified mock is already set by Set")
+	}
+
+	if mmMarkEmailVerified.defaultExpectation == nil {
+		mmMarkEmailVerified.defaultExpectation = &UsersRepositoryMockMarkEmailVerifiedExpectation{}
+	}
+
+	if mmMarkEmailVerified.defaultExpectation.params != nil {
+		mmMarkEmailVerified.mock.t.Fatalf("UsersRepositoryMock.MarkEmailVerified mock is already set by Expect")
+	}
+
+	if mmMarkEmailVerified.defaultExpectation.paramPtrs == nil {
+		mmMarkEmailVerified.defaultExpectation.paramPtrs = &UsersRepositoryMockMarkEmailVerifiedParamPtrs{}
+	}
+	mmMarkEmailVerified.defaultExpectation.paramPtrs.userID = &userID
+	mmMarkEmailVerified.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmMarkEmailVerified
+}
+
+// Inspect accepts an inspector function that has same arguments as the UsersRepository.MarkEmailVerified
+func (mmMarkEmailVerified *mUsersRepositoryMockMarkEmailVerified) Inspect(f func(ctx context.Context, userID string)) *mUsersRepositoryMockMarkEmailVerified {
+	if mmMarkEmailVerified.mock.inspectFuncMarkEmailVerified != nil {
+		mmMarkEmailVerified.mock.t.Fatalf("Inspect function is already set for UsersRepositoryMock.MarkEmailVerified")
+	}
+
+	mmMarkEmailVerified.mock.inspectFuncMarkEmailVerified = f
+
+	return mmMarkEmailVerified
+}
+
+// Return sets up results that will be returned by UsersRepository.MarkEmailVerified
+func (mmMarkEmailVerified *mUsersRepositoryMockMarkEmailVerified) Return(err error) *UsersRepositoryMock {
+	if mmMarkEmailVerified.mock.funcMarkEmailVerified != nil {
+		mmMarkEmailVerified.mock.t.Fatalf("UsersRepositoryMock.MarkEmailVerified mock is already set by Set")
+	}
+
+	if mmMarkEmailVerified.defaultExpectation == nil {
+		mmMarkEmailVerified.defaultExpectation = &UsersRepositoryMockMarkEmailVerifiedExpectation{mock: mmMarkEmailVerified.mock}
+	}
+	mmMarkEmailVerified.defaultExpectation.results = &UsersRepositoryMockMarkEmailVerifiedResults{err}
+	mmMarkEmailVerified.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMarkEmailVerified.mock
+}
+
+// Set uses given function f to mock the UsersRepository.MarkEmailVerified method
+func (mmMarkEmailVerified *mUsersRepositoryMockMarkEmailVerified) Set(f func(ctx context.Context, userID string) (err error)) *UsersRepositoryMock {
+	if mmMarkEmailVerified.defaultExpectation != nil {
+		mmMarkEmailVerified.mock.t.Fatalf("Default expectation is already set for the UsersRepository.MarkEmailVerified method")
+	}
+
+	if len(mmMarkEmailVerified.expectations) > 0 {
+		mmMarkEmailVerified.mock.t.Fatalf("Some expectations are already set for the UsersRepository.MarkEmailVerified method")
+	}
+
+	mmMarkEmailVerified.mock.funcMarkEmailVerified = f
+	mmMarkEmailVerified.mock.funcMarkEmailVerifiedOrigin = minimock.CallerInfo(1)
+	return mmMarkEmailVerified.mock
+}
+
+// When sets expectation for the UsersRepository.MarkEmailVerified which will trigger the result defined by the following
+// Then helper
+func (mmMarkEmailVerified *mUsersRepositoryMockMarkEmailVerified) When(ctx context.Context, userID string) *UsersRepositoryMockMarkEmailVerifiedExpectation {
+	if mmMarkEmailVerified.mock.funcMarkEmailVerified != nil {
+		mmMarkEmailVerified.mock.t.Fatalf("UsersRepositoryMock.MarkEmailVerified mock is already set by Set")
+	}
+
+	expectation := &UsersRepositoryMockMarkEmailVerifiedExpectation{
+		mock:               mmMarkEmailVerified.mock,
+		params:             &UsersRepositoryMockMarkEmailVerifiedParams{ctx, userID},
+		expectationOrigins: UsersRepositoryMockMarkEmailVerifiedExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMarkEmailVerified.expectations = append(mmMarkEmailVerified.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UsersRepository.MarkEmailVerified return parameters for the expectation previously defined by the When method
+func (e *UsersRepositoryMockMarkEmailVerifiedExpectation) Then(err error) *UsersRepositoryMock {
+	e.results = &UsersRepositoryMockMarkEmailVerifiedResults{err}
+	return e.mock
+}
+
+// Times sets number of times UsersRepository.MarkEmailVerified should be invoked
+func (mmMarkEmailVerified *mUsersRepositoryMockMarkEmailVerified) Times(n uint64) *mUsersRepositoryMockMarkEmailVerified {
+	if n == 0 {
+		mmMarkEmailVerified.mock.t.Fatalf("Times of UsersRepositoryMock.MarkEmailVerified mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMarkEmailVerified.expectedInvocations, n)
+	mmMarkEmailVerified.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMarkEmailVerified
+}
+
+func (mmMarkEmailVerified *mUsersRepositoryMockMarkEmailVerified) invocationsDone() bool {
+	if len(mmMarkEmailVerified.expectations) == 0 && mmMarkEmailVerified.defaultExpectation == nil && mmMarkEmailVerified.mock.funcMarkEmailVerified == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMarkEmailVerified.mock.afterMarkEmailVerifiedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMarkEmailVerified.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MarkEmailVerified implements mm_usecase.UsersRepository
+func (mmMarkEmailVerified *UsersRepositoryMock) MarkEmailVerified(ctx context.Context, userID string) (err error) {
+	mm_atomic.AddUint64(&mmMarkEmailVerified.beforeMarkEmailVerifiedCounter, 1)
+	defer mm_atomic.AddUint64(&mmMarkEmailVerified.afterMarkEmailVerifiedCounter, 1)
+
+	mmMarkEmailVerified.t.Helper()
+
+	if mmMarkEmailVerified.inspectFuncMarkEmailVerified != nil {
+		mmMarkEmailVerified.inspectFuncMarkEmailVerified(ctx, userID)
+	}
+
+	mm_params := UsersRepositoryMockMarkEmailVerifiedParams{ctx, userID}
+
+	// Record call args
+	mmMarkEmailVerified.MarkEmailVerifiedMock.mutex.Lock()
+	mmMarkEmailVerified.MarkEmailVerifiedMock.callArgs = append(mmMarkEmailVerified.MarkEmailVerifiedMock.callArgs, &mm_params)
+	mmMarkEmailVerified.MarkEmailVerifiedMock.mutex.Unlock()
+
+	for _, e := range mmMarkEmailVerified.MarkEmailVerifiedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmMarkEmailVerified.MarkEmailVerifiedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMarkEmailVerified.MarkEmailVerifiedMock.defaultExpectation.Counter, 1)
+		mm_want := mmMarkEmailVerified.MarkEmailVerifiedMock.defaultExpectation.params
+		mm_want_ptrs := mmMarkEmailVerified.MarkEmailVerifiedMock.defaultExpectation.paramPtrs
+
+		mm_got := UsersRepositoryMockMarkEmailVerifiedParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMarkEmailVerified.t.Errorf("UsersRepositoryMock.MarkEmailVerified got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkEmailVerified.MarkEmailVerifiedMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmMarkEmailVerified.t.Errorf("UsersRepositoryMock.MarkEmailVerified got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkEmailVerified.MarkEmailVerifiedMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMarkEmailVerified.t.Errorf("UsersRepositoryMock.MarkEmailVerified got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMarkEmailVerified.MarkEmailVerifiedMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMarkEmailVerified.MarkEmailVerifiedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMarkEmailVerified.t.Fatal("No results are set for the UsersRepositoryMock.MarkEmailVerified")
+		}
+		return (*mm_results).err
+	}
+	if mmMarkEmailVerified.funcMarkEmailVerified != nil {
+		return mmMarkEmailVerified.funcMarkEmailVerified(ctx, userID)
+	}
+	mmMarkEmailVerified.t.Fatalf("Unexpected call to UsersRepositoryMock.MarkEmailVerified. %v %v", ctx, userID)
+	return
+}
+
+// MarkEmailVerifiedAfterCounter returns a count of finished UsersRepositoryMock.MarkEmailVerified invocations
+func (mmMarkEmailVerified *UsersRepositoryMock) MarkEmailVerifiedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkEmailVerified.afterMarkEmailVerifiedCounter)
+}
+
+// MarkEmailVerifiedBeforeCounter returns a count of UsersRepositoryMock.MarkEmailVerified invocations
+func (mmMarkEmailVerified *UsersRepositoryMock) MarkEmailVerifiedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkEmailVerified.beforeMarkEmailVerifiedCounter)
+}
+
+// Calls returns a list of arguments used in each call to UsersRepositoryMock.MarkEmailVerified.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMarkEmailVerified *mUsersRepositoryMockMarkEmailVerified) Calls() []*UsersRepositoryMockMarkEmailVerifiedParams {
+	mmMarkEmailVerified.mutex.RLock()
+
+	argCopy := make([]*UsersRepositoryMockMarkEmailVerifiedParams, len(mmMarkEmailVerified.callArgs))
+	copy(argCopy, mmMarkEmailVerified.callArgs)
+
+	mmMarkEmailVerified.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMarkEmailVerifiedDone returns true if the count of the MarkEmailVerified invocations corresponds
 // the number of defined expectations
-func (m *UsersRepositoryMock) MinimockGetUserByEmailDone() bool {
-	if m.GetUserByEmailMock.optional {
+func (m *UsersRepositoryMock) MinimockMarkEmailVerifiedDone() bool {
+	if m.MarkEmailVerifiedMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetUserByEmailMock.expectations {
+	for _, e := range m.MarkEmailVerifiedMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetUserByEmailMock.invocationsDone()
+	return m.MarkEmailVerifiedMock.invocationsDone()
 }
 
-// MinimockGetUserByEmailInspect logs each unmet expectation
-func (m *UsersRepositoryMock) MinimockGetUserByEmailInspect() {
-	for _, e := range m.GetUserByEmailMock.expectations {
+// MinimockMarkEmailVerifiedInspect logs each unmet expectation
+func (m *UsersRepositoryMock) MinimockMarkEmailVerifiedInspect() {
+	for _, e := range m.MarkEmailVerifiedMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to UsersRepositoryMock.GetUserByEmail at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to UsersRepositoryMock.MarkEmailVerified at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetUserByEmailCounter := mm_atomic.LoadUint64(&m.afterGetUserByEmailCounter)
+	afterMarkEmailVerifiedCounter := mm_atomic.LoadUint64(&m.afterMarkEmailVerifiedCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetUserByEmailMock.defaultExpectation != nil && afterGetUserByEmailCounter < 1 {
-		if m.GetUserByEmailMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to UsersRepositoryMock.GetUserByEmail at\n%s", m.GetUserByEmailMock.defaultExpectation.returnOrigin)
+	if m.MarkEmailVerifiedMock.defaultExpectation != nil && afterMarkEmailVerifiedCounter < 1 {
+		if m.MarkEmailVerifiedMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UsersRepositoryMock.MarkEmailVerified at\n%s", m.MarkEmailVerifiedMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to UsersRepositoryMock.GetUserByEmail at\n%s with params: %#v", m.GetUserByEmailMock.defaultExpectation.expectationOrigins.origin, *m.GetUserByEmailMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to UsersRepositoryMock.MarkEmailVerified at\n%s with params: %#v", m.MarkEmailVerifiedMock.defaultExpectation.expectationOrigins.origin, *m.MarkEmailVerifiedMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetUserByEmail != nil && afterGetUserByEmailCounter < 1 {
-		m.t.Errorf("Expected call to UsersRepositoryMock.GetUserByEmail at\n%s", m.funcGetUserByEmailOrigin)
+	if m.funcMarkEmailVerified != nil && afterMarkEmailVerifiedCounter < 1 {
+		m.t.Errorf("Expected call to UsersRepositoryMock.MarkEmailVerified at\n%s", m.funcMarkEmailVerifiedOrigin)
 	}
 
-	if !m.GetUserByEmailMock.invocationsDone() && afterGetUserByEmailCounter > 0 {
-		m.t.Errorf("Expected %d calls to UsersRepositoryMock.GetUserByEmail at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetUserByEmailMock.expectedInvocations), m.GetUserByEmailMock.expectedInvocationsOrigin, afterGetUserByEmailCounter)
+	if !m.MarkEmailVerifiedMock.invocationsDone() && afterMarkEmailVerifiedCounter > 0 {
+		m.t.Errorf("Expected %d calls to UsersRepositoryMock.MarkEmailVerified at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MarkEmailVerifiedMock.expectedInvocations), m.MarkEmailVerifiedMock.expectedInvocationsOrigin, afterMarkEmailVerifiedCounter)
 	}
 }
 
-type mUsersRepositoryMockGetUserByID struct {
+type mUsersRepositoryMockSetPasswordHash struct {
 	optional           bool
 	mock               *UsersRepositoryMock
-	defaultExpectation *UsersRepositoryMockGetUserByIDExpectation
-	expectations       []*UsersRepositoryMockGetUserByIDExpectation
+	defaultExpectation *UsersRepositoryMockSetPasswordHashExpectation
+	expectations       []*UsersRepositoryMockSetPasswordHashExpectation
 
-	callArgs []*UsersRepositoryMockGetUserByIDParams
+	callArgs []*UsersRepositoryMockSetPasswordHashParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// UsersRepositoryMockGetUserByIDExpectation specifies expectation struct of the UsersRepository.GetUserByID
-type UsersRepositoryMockGetUserByIDExpectation struct {
+// UsersRepositoryMockSetPasswordHashExpectation specifies expectation struct of the UsersRepository.SetPasswordHash
+type UsersRepositoryMockSetPasswordHashExpectation struct {
 	mock               *UsersRepositoryMock
-	params             *UsersRepositoryMockGetUserByIDParams
-	paramPtrs          *UsersRepositoryMockGetUserByIDParamPtrs
-	expectationOrigins UsersRepositoryMockGetUserByIDExpectationOrigins
-	results            *UsersRepositoryMockGetUserByIDResults
+	params             *UsersRepositoryMockSetPasswordHashParams
+	paramPtrs          *UsersRepositoryMockSetPasswordHashParamPtrs
+	expectationOrigins UsersRepositoryMockSetPasswordHashExpectationOrigins
+	results            *UsersRepositoryMockSetPasswordHashResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// UsersRepositoryMockGetUserByIDParams contains parameters of the UsersRepository.GetUserByID
-type UsersRepositoryMockGetUserByIDParams struct {
-	ctx    context.Context
-	userID string
+// UsersRepositoryMockSetPasswordHashParams contains parameters of the UsersRepository.SetPasswordHash
+type UsersRepositoryMockSetPasswordHashParams struct {
+	ctx          context.Context
+	userID       string
+	passwordHash string
 }
 
-// UsersRepositoryMockGetUserByIDParamPtrs contains pointers to parameters of the UsersRepository.GetUserByID
-type UsersRepositoryMockGetUserByIDParamPtrs struct {
-	ctx    *context.Context
-	userID *string
+// UsersRepositoryMockSetPasswordHashParamPtrs contains pointers to parameters of the UsersRepository.SetPasswordHash
+type UsersRepositoryMockSetPasswordHashParamPtrs struct {
+	ctx          *context.Context
+	userID       *string
+	passwordHash *string
 }
 
-// UsersRepositoryMockGetUserByIDResults contains results of the UsersRepository.GetUserByID
-type UsersRepositoryMockGetUserByIDResults struct {
-	up1 *models.User
+// UsersRepositoryMockSetPasswordHashResults contains results of the UsersRepository.SetPasswordHash
+type UsersRepositoryMockSetPasswordHashResults struct {
 	err error
 }
 
-// UsersRepositoryMockGetUserByIDOrigins contains origins of expectations of the UsersRepository.GetUserByID
-type UsersRepositoryMockGetUserByIDExpectationOrigins struct {
-	origin       string
-	originCtx    string
-	originUserID string
+// UsersRepositoryMockSetPasswordHashOrigins contains origins of expectations of the UsersRepository.SetPasswordHash
+type UsersRepositoryMockSetPasswordHashExpectationOrigins struct {
+	origin             string
+	originCtx          string
+	originUserID       string
+	originPasswordHash string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -468,345 +2615,372 @@ type UsersRepositoryMockGetUserByIDExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetUserByID *mUsersRepositoryMockGetUserByID) Optional() *mUsersRepositoryMockGetUserByID {
-	mmGetUserByID.optional = true
-	return mmGetUserByID
+func (mmSetPasswordHash *mUsersRepositoryMockSetPasswordHash) Optional() *mUsersRepositoryMockSetPasswordHash {
+	mmSetPasswordHash.optional = true
+	return mmSetPasswordHash
 }
 
-// Expect sets up expected params for UsersRepository.GetUserByID
-func (mmGetUserByID *mUsersRepositoryMockGetUserByID) Expect(ctx context.Context, userID string) *mUsersRepositoryMockGetUserByID {
-	if mmGetUserByID.mock.funcGetUserByID != nil {
-		mmGetUserByID.mock.t.Fatalf("UsersRepositoryMock.GetUserByID mock is already set by Set")
+// Expect sets up expected params for UsersRepository.SetPasswordHash
+func (mmSetPasswordHash *mUsersRepositoryMockSetPasswordHash) Expect(ctx context.Context, userID string, passwordHash string) *mUsersRepositoryMockSetPasswordHash {
+	if mmSetPasswordHash.mock.funcSetPasswordHash != nil {
+		mmSetPasswordHash.mock.t.Fatalf("UsersRepositoryMock.SetPasswordHash mock is already set by Set")
 	}
 
-	if mmGetUserByID.defaultExpectation == nil {
-		mmGetUserByID.defaultExpectation = &UsersRepositoryMockGetUserByIDExpectation{}
+	if mmSetPasswordHash.defaultExpectation == nil {
+		mmSetPasswordHash.defaultExpectation = &UsersRepositoryMockSetPasswordHashExpectation{}
 	}
 
-	if mmGetUserByID.defaultExpectation.paramPtrs != nil {
-		mmGetUserByID.mock.t.Fatalf("UsersRepositoryMock.GetUserByID mock is already set by ExpectParams functions")
+	if mmSetPasswordHash.defaultExpectation.paramPtrs != nil {
+		mmSetPasswordHash.mock.t.Fatalf("UsersRepositoryMock.SetPasswordHash mock is already set by ExpectParams functions")
 	}
 
-	mmGetUserByID.defaultExpectation.params = &UsersRepositoryMockGetUserByIDParams{ctx, userID}
-	mmGetUserByID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetUserByID.expectations {
-		if minimock.Equal(e.params, mmGetUserByID.defaultExpectation.params) {
-			mmGetUserByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetUserByID.defaultExpectation.params)
+	mmSetPasswordHash.defaultExpectation.params = &UsersRepositoryMockSetPasswordHashParams{ctx, userID, passwordHash}
+	mmSetPasswordHash.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetPasswordHash.expectations {
+		if minimock.Equal(e.params, mmSetPasswordHash.defaultExpectation.params) {
+			mmSetPasswordHash.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetPasswordHash.defaultExpectation.params)
 		}
 	}
 
-	return mmGetUserByID
+	return mmSetPasswordHash
 }
 
-// ExpectCtxParam1 sets up expected param ctx for UsersRepository.GetUserByID
-func (mmGetUserByID *mUsersRepositoryMockGetUserByID) ExpectCtxParam1(ctx context.Context) *mUsersRepositoryMockGetUserByID {
-	if mmGetUserByID.mock.funcGetUserByID != nil {
-		mmGetUserByID.mock.t.Fatalf("UsersRepositoryMock.GetUserByID mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for UsersRepository.SetPasswordHash
+func (mmSetPasswordHash *mUsersRepositoryMockSetPasswordHash) ExpectCtxParam1(ctx context.Context) *mUsersRepositoryMockSetPasswordHash {
+	if mmSetPasswordHash.mock.funcSetPasswordHash != nil {
+		mmSetPasswordHash.mock.t.Fatalf("UsersRepositoryMock.SetPasswordHash mock is already set by Set")
 	}
 
-	if mmGetUserByID.defaultExpectation == nil {
-		mmGetUserByID.defaultExpectation = &UsersRepositoryMockGetUserByIDExpectation{}
+	if mmSetPasswordHash.defaultExpectation == nil {
+		mmSetPasswordHash.defaultExpectation = &UsersRepositoryMockSetPasswordHashExpectation{}
 	}
 
-	if mmGetUserByID.defaultExpectation.params != nil {
-		mmGetUserByID.mock.t.Fatalf("UsersRepositoryMock.GetUserByID mock is already set by Expect")
+	if mmSetPasswordHash.defaultExpectation.params != nil {
+		mmSetPasswordHash.mock.t.Fatalf("UsersRepositoryMock.SetPasswordHash mock is already set by Expect")
 	}
 
-	if mmGetUserByID.defaultExpectation.paramPtrs == nil {
-		mmGetUserByID.defaultExpectation.paramPtrs = &UsersRepositoryMockGetUserByIDParamPtrs{}
+	if mmSetPasswordHash.defaultExpectation.paramPtrs == nil {
+		mmSetPasswordHash.defaultExpectation.paramPtrs = &UsersRepositoryMockSetPasswordHashParamPtrs{}
 	}
-	mmGetUserByID.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGetUserByID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmSetPasswordHash.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetPasswordHash.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetUserByID
+	return mmSetPasswordHash
 }
 
-// ExpectUserIDParam2 sets up expected param userID for UsersRepository.GetUserByID
-func (mmGetUserByID *mUsersRepositoryMockGetUserByID) ExpectUserIDParam2(userID string) *mUsersRepositoryMockGetUserByID {
-	if mmGetUserByID.mock.funcGetUserByID != nil {
-		mmGetUserByID.mock.t.Fatalf("UsersRepositoryMock.GetUserByID mock is already set by Set")
+// ExpectUserIDParam2 sets up expected param userID for UsersRepository.SetPasswordHash
+func (mmSetPasswordHash *mUsersRepositoryMockSetPasswordHash) ExpectUserIDParam2(userID string) *mUsersRepositoryMockSetPasswordHash {
+	if mmSetPasswordHash.mock.funcSetPasswordHash != nil {
+		mmSetPasswordHash.mock.t.Fatalf("UsersRepositoryMock.SetPasswordHash mock is already set by Set")
 	}
 
-	if mmGetUserByID.defaultExpectation == nil {
-		mmGetUserByID.defaultExpectation = &UsersRepositoryMockGetUserByIDExpectation{}
+	if mmSetPasswordHash.defaultExpectation == nil {
+		mmSetPasswordHash.defaultExpectation = &UsersRepositoryMockSetPasswordHashExpectation{}
 	}
 
-	if mmGetUserByID.defaultExpectation.params != nil {
-		mmGetUserByID.mock.t.Fatalf("UsersRepositoryMock.GetUserByID mock is already set by Expect")
+	if mmSetPasswordHash.defaultExpectation.params != nil {
+		mmSetPasswordHash.mock.t.Fatalf("UsersRepositoryMock.SetPasswordHash mock is already set by Expect")
 	}
 
-	if mmGetUserByID.defaultExpectation.paramPtrs == nil {
-		mmGetUserByID.defaultExpectation.paramPtrs = &UsersRepositoryMockGetUserByIDParamPtrs{}
+	if mmSetPasswordHash.defaultExpectation.paramPtrs == nil {
+		mmSetPasswordHash.defaultExpectation.paramPtrs = &UsersRepositoryMockSetPasswordHashParamPtrs{}
 	}
-	mmGetUserByID.defaultExpectation.paramPtrs.userID = &userID
-	mmGetUserByID.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+	mmSetPasswordHash.defaultExpectation.paramPtrs.userID = &userID
+	mmSetPasswordHash.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
 
-	return mmGetUserByID
+	return mmSetPasswordHash
 }
 
-// Inspect accepts an inspector function that has same arguments as the UsersRepository.GetUserByID
-func (mmGetUserByID *mUsersRepositoryMockGetUserByID) Inspect(f func(ctx context.Context, userID string)) *mUsersRepositoryMockGetUserByID {
-	if mmGetUserByID.mock.inspectFuncGetUserByID != nil {
-		mmGetUserByID.mock.t.Fatalf("Inspect function is already set for UsersRepositoryMock.GetUserByID")
+// ExpectPasswordHashParam3 sets up expected param passwordHash for UsersRepository.SetPasswordHash
+func (mmSetPasswordHash *mUsersRepositoryMockSetPasswordHash) ExpectPasswordHashParam3(passwordHash string) *mUsersRepositoryMockSetPasswordHash {
+	if mmSetPasswordHash.mock.funcSetPasswordHash != nil {
+		mmSetPasswordHash.mock.t.Fatalf("UsersRepositoryMock.SetPasswordHash mock is already set by Set")
 	}
 
-	mmGetUserByID.mock.inspectFuncGetUserByID = f
+	if mmSetPasswordHash.defaultExpectation == nil {
+		mmSetPasswordHash.defaultExpectation = &UsersRepositoryMockSetPasswordHashExpectation{}
+	}
 
-	return mmGetUserByID
+	if mmSetPasswordHash.defaultExpectation.params != nil {
+		mmSetPasswordHash.mock.t.Fatalf("UsersRepositoryMock.SetPasswordHash mock is already set by Expect")
+	}
+
+	if mmSetPasswordHash.defaultExpectation.paramPtrs == nil {
+		mmSetPasswordHash.defaultExpectation.paramPtrs = &UsersRepositoryMockSetPasswordHashParamPtrs{}
+	}
+	mmSetPasswordHash.defaultExpectation.paramPtrs.passwordHash = &passwordHash
+	mmSetPasswordHash.defaultExpectation.expectationOrigins.originPasswordHash = minimock.CallerInfo(1)
+
+	return mmSetPasswordHash
 }
 
-// Return sets up results that will be returned by UsersRepository.GetUserByID
-func (mmGetUserByID *mUsersRepositoryMockGetUserByID) Return(up1 *models.User, err error) *UsersRepositoryMock {
-	if mmGetUserByID.mock.funcGetUserByID != nil {
-		mmGetUserByID.mock.t.Fatalf("UsersRepositoryMock.GetUserByID mock is already set by Set")
+// Inspect accepts an inspector function that has same arguments as the UsersRepository.SetPasswordHash
+func (mmSetPasswordHash *mUsersRepositoryMockSetPasswordHash) Inspect(f func(ctx context.Context, userID string, passwordHash string)) *mUsersRepositoryMockSetPasswordHash {
+	if mmSetPasswordHash.mock.inspectFuncSetPasswordHash != nil {
+		mmSetPasswordHash.mock.t.Fatalf("Inspect function is already set for UsersRepositoryMock.SetPasswordHash")
 	}
 
-	if mmGetUserByID.defaultExpectation == nil {
-		mmGetUserByID.defaultExpectation = &UsersRepositoryMockGetUserByIDExpectation{mock: mmGetUserByID.mock}
+	mmSetPasswordHash.mock.inspectFuncSetPasswordHash = f
+
+	return mmSetPasswordHash
+}
+
+// Return sets up results that will be returned by UsersRepository.SetPasswordHash
+func (mmSetPasswordHash *mUsersRepositoryMockSetPasswordHash) Return(err error) *UsersRepositoryMock {
+	if mmSetPasswordHash.mock.funcSetPasswordHash != nil {
+		mmSetPasswordHash.mock.t.Fatalf("UsersRepositoryMock.SetPasswordHash mock is already set by Set")
 	}
-	mmGetUserByID.defaultExpectation.results = &UsersRepositoryMockGetUserByIDResults{up1, err}
-	mmGetUserByID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetUserByID.mock
+
+	if mmSetPasswordHash.defaultExpectation == nil {
+		mmSetPasswordHash.defaultExpectation = &UsersRepositoryMockSetPasswordHashExpectation{mock: mmSetPasswordHash.mock}
+	}
+	mmSetPasswordHash.defaultExpectation.results = &UsersRepositoryMockSetPasswordHashResults{err}
+	mmSetPasswordHash.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetPasswordHash.mock
 }
 
-// Set uses given function f to mock the UsersRepository.GetUserByID method
-func (mmGetUserByID *mUsersRepositoryMockGetUserByID) Set(f func(ctx context.Context, userID string) (up1 *models.User, err error)) *UsersRepositoryMock {
-	if mmGetUserByID.defaultExpectation != nil {
-		mmGetUserByID.mock.t.Fatalf("Default expectation is already set for the UsersRepository.GetUserByID method")
+// Set uses given function f to mock the UsersRepository.SetPasswordHash method
+func (mmSetPasswordHash *mUsersRepositoryMockSetPasswordHash) Set(f func(ctx context.Context, userID string, passwordHash string) (err error)) *UsersRepositoryMock {
+	if mmSetPasswordHash.defaultExpectation != nil {
+		mmSetPasswordHash.mock.t.Fatalf("Default expectation is already set for the UsersRepository.SetPasswordHash method")
 	}
 
-	if len(mmGetUserByID.expectations) > 0 {
-		mmGetUserByID.mock.t.Fatalf("Some expectations are already set for the UsersRepository.GetUserByID method")
+	if len(mmSetPasswordHash.expectations) > 0 {
+		mmSetPasswordHash.mock.t.Fatalf("Some expectations are already set for the UsersRepository.SetPasswordHash method")
 	}
 
-	mmGetUserByID.mock.funcGetUserByID = f
-	mmGetUserByID.mock.funcGetUserByIDOrigin = minimock.CallerInfo(1)
-	return mmGetUserByID.mock
+	mmSetPasswordHash.mock.funcSetPasswordHash = f
+	mmSetPasswordHash.mock.funcSetPasswordHashOrigin = minimock.CallerInfo(1)
+	return mmSetPasswordHash.mock
 }
 
-// When sets expectation for the UsersRepository.GetUserByID which will trigger the result defined by the following
+// When sets expectation for the UsersRepository.SetPasswordHash which will trigger the result defined by the following
 // Then helper
-func (mmGetUserByID *mUsersRepositoryMockGetUserByID) When(ctx context.Context, userID string) *UsersRepositoryMockGetUserByIDExpectation {
-	if mmGetUserByID.mock.funcGetUserByID != nil {
-		mmGetUserByID.mock.t.Fatalf("UsersRepositoryMock.GetUserByID mock is already set by Set")
+func (mmSetPasswordHash *mUsersRepositoryMockSetPasswordHash) When(ctx context.Context, userID string, passwordHash string) *UsersRepositoryMockSetPasswordHashExpectation {
+	if mmSetPasswordHash.mock.funcSetPasswordHash != nil {
+		mmSetPasswordHash.mock.t.Fatalf("UsersRepositoryMock.SetPasswordHash mock is already set by Set")
 	}
 
-	expectation := &UsersRepositoryMockGetUserByIDExpectation{
-		mock:               mmGetUserByID.mock,
-		params:             &UsersRepositoryMockGetUserByIDParams{ctx, userID},
-		expectationOrigins: UsersRepositoryMockGetUserByIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &UsersRepositoryMockSetPasswordHashExpectation{
+		mock:               mmSetPasswordHash.mock,
+		params:             &UsersRepositoryMockSetPasswordHashParams{ctx, userID, passwordHash},
+		expectationOrigins: UsersRepositoryMockSetPasswordHashExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetUserByID.expectations = append(mmGetUserByID.expectations, expectation)
+	mmSetPasswordHash.expectations = append(mmSetPasswordHash.expectations, expectation)
 	return expectation
 }
 
-// Then sets up UsersRepository.GetUserByID return parameters for the expectation previously defined by the When method
-func (e *UsersRepositoryMockGetUserByIDExpectation) Then(up1 *models.User, err error) *UsersRepositoryMock {
-	e.results = &UsersRepositoryMockGetUserByIDResults{up1, err}
+// Then sets up UsersRepository.SetPasswordHash return parameters for the expectation previously defined by the When method
+func (e *UsersRepositoryMockSetPasswordHashExpectation) Then(err error) *UsersRepositoryMock {
+	e.results = &UsersRepositoryMockSetPasswordHashResults{err}
 	return e.mock
 }
 
-// Times sets number of times UsersRepository.GetUserByID should be invoked
-func (mmGetUserByID *mUsersRepositoryMockGetUserByID) Times(n uint64) *mUsersRepositoryMockGetUserByID {
+// Times sets number of times UsersRepository.SetPasswordHash should be invoked
+func (mmSetPasswordHash *mUsersRepositoryMockSetPasswordHash) Times(n uint64) *mUsersRepositoryMockSetPasswordHash {
 	if n == 0 {
-		mmGetUserByID.mock.t.Fatalf("Times of UsersRepositoryMock.GetUserByID mock can not be zero")
+		mmSetPasswordHash.mock.t.Fatalf("Times of UsersRepositoryMock.SetPasswordHash mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetUserByID.expectedInvocations, n)
-	mmGetUserByID.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetUserByID
+	mm_atomic.StoreUint64(&mmSetPasswordHash.expectedInvocations, n)
+	mmSetPasswordHash.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetPasswordHash
 }
 
-func (mmGetUserByID *mUsersRepositoryMockGetUserByID) invocationsDone() bool {
-	if len(mmGetUserByID.expectations) == 0 && mmGetUserByID.defaultExpectation == nil && mmGetUserByID.mock.funcGetUserByID == nil {
+func (mmSetPasswordHash *mUsersRepositoryMockSetPasswordHash) invocationsDone() bool {
+	if len(mmSetPasswordHash.expectations) == 0 && mmSetPasswordHash.defaultExpectation == nil && mmSetPasswordHash.mock.funcSetPasswordHash == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetUserByID.mock.afterGetUserByIDCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetUserByID.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmSetPasswordHash.mock.afterSetPasswordHashCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetPasswordHash.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetUserByID implements mm_usecase.UsersRepository
-func (mmGetUserByID *UsersRepositoryMock) GetUserByID(ctx context.Context, userID string) (up1 *models.User, err error) {
-	mm_atomic.AddUint64(&mmGetUserByID.beforeGetUserByIDCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetUserByID.afterGetUserByIDCounter, 1)
+// SetPasswordHash implements mm_usecase.UsersRepository
+func (mmSetPasswordHash *UsersRepositoryMock) SetPasswordHash(ctx context.Context, userID string, passwordHash string) (err error) {
+	mm_atomic.AddUint64(&mmSetPasswordHash.beforeSetPasswordHashCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetPasswordHash.afterSetPasswordHashCounter, 1)
 
-	mmGetUserByID.t.Helper()
+	mmSetPasswordHash.t.Helper()
 
-	if mmGetUserByID.inspectFuncGetUserByID != nil {
-		mmGetUserByID.inspectFuncGetUserByID(ctx, userID)
+	if mmSetPasswordHash.inspectFuncSetPasswordHash != nil {
+		mmSetPasswordHash.inspectFuncSetPasswordHash(ctx, userID, passwordHash)
 	}
 
-	mm_params := UsersRepositoryMockGetUserByIDParams{ctx, userID}
+	mm_params := UsersRepositoryMockSetPasswordHashParams{ctx, userID, passwordHash}
 
 	// Record call args
-	mmGetUserByID.GetUserByIDMock.mutex.Lock()
-	mmGetUserByID.GetUserByIDMock.callArgs = append(mmGetUserByID.GetUserByIDMock.callArgs, &mm_params)
-	mmGetUserByID.GetUserByIDMock.mutex.Unlock()
+	mmSetPasswordHash.SetPasswordHashMock.mutex.Lock()
+	mmSetPasswordHash.SetPasswordHashMock.callArgs = append(mmSetPasswordHash.SetPasswordHashMock.callArgs, &mm_params)
+	mmSetPasswordHash.SetPasswordHashMock.mutex.Unlock()
 
-	for _, e := range mmGetUserByID.GetUserByIDMock.expectations {
+	for _, e := range mmSetPasswordHash.SetPasswordHashMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.up1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmGetUserByID.GetUserByIDMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetUserByID.GetUserByIDMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetUserByID.GetUserByIDMock.defaultExpectation.params
-		mm_want_ptrs := mmGetUserByID.GetUserByIDMock.defaultExpectation.paramPtrs
+	if mmSetPasswordHash.SetPasswordHashMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetPasswordHash.SetPasswordHashMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetPasswordHash.SetPasswordHashMock.defaultExpectation.params
+		mm_want_ptrs := mmSetPasswordHash.SetPasswordHashMock.defaultExpectation.paramPtrs
 
-		mm_got := UsersRepositoryMockGetUserByIDParams{ctx, userID}
+		mm_got := UsersRepositoryMockSetPasswordHashParams{ctx, userID, passwordHash}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGetUserByID.t.Errorf("UsersRepositoryMock.GetUserByID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetUserByID.GetUserByIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmSetPasswordHash.t.Errorf("UsersRepositoryMock.SetPasswordHash got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetPasswordHash.SetPasswordHashMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
 			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
-				mmGetUserByID.t.Errorf("UsersRepositoryMock.GetUserByID got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetUserByID.GetUserByIDMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+				mmSetPasswordHash.t.Errorf("UsersRepositoryMock.SetPasswordHash got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetPasswordHash.SetPasswordHashMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.passwordHash != nil && !minimock.Equal(*mm_want_ptrs.passwordHash, mm_got.passwordHash) {
+				mmSetPasswordHash.t.Errorf("UsersRepositoryMock.SetPasswordHash got unexpected parameter passwordHash, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetPasswordHash.SetPasswordHashMock.defaultExpectation.expectationOrigins.originPasswordHash, *mm_want_ptrs.passwordHash, mm_got.passwordHash, minimock.Diff(*mm_want_ptrs.passwordHash, mm_got.passwordHash))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetUserByID.t.Errorf("UsersRepositoryMock.GetUserByID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetUserByID.GetUserByIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmSetPasswordHash.t.Errorf("UsersRepositoryMock.SetPasswordHash got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetPasswordHash.SetPasswordHashMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetUserByID.GetUserByIDMock.defaultExpectation.results
+		mm_results := mmSetPasswordHash.SetPasswordHashMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetUserByID.t.Fatal("No results are set for the UsersRepositoryMock.GetUserByID")
+			mmSetPasswordHash.t.Fatal("No results are set for the UsersRepositoryMock.SetPasswordHash")
 		}
-		return (*mm_results).up1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmGetUserByID.funcGetUserByID != nil {
-		return mmGetUserByID.funcGetUserByID(ctx, userID)
+	if mmSetPasswordHash.funcSetPasswordHash != nil {
+		return mmSetPasswordHash.funcSetPasswordHash(ctx, userID, passwordHash)
 	}
-	mmGetUserByID.t.Fatalf("Unexpected call to UsersRepositoryMock.GetUserByID. %v %v", ctx, userID)
+	mmSetPasswordHash.t.Fatalf("Unexpected call to UsersRepositoryMock.SetPasswordHash. %v %v %v", ctx, userID, passwordHash)
 	return
 }
 
-// GetUserByIDAfterCounter returns a count of finished UsersRepositoryMock.GetUserByID invocations
-func (mmGetUserByID *UsersRepositoryMock) GetUserByIDAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetUserByID.afterGetUserByIDCounter)
+// SetPasswordHashAfterCounter returns a count of finished UsersRepositoryMock.SetPasswordHash invocations
+func (mmSetPasswordHash *UsersRepositoryMock) SetPasswordHashAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetPasswordHash.afterSetPasswordHashCounter)
 }
 
-// GetUserByIDBeforeCounter returns a count of UsersRepositoryMock.GetUserByID invocations
-func (mmGetUserByID *UsersRepositoryMock) GetUserByIDBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetUserByID.beforeGetUserByIDCounter)
+// SetPasswordHashBeforeCounter returns a count of UsersRepositoryMock.SetPasswordHash invocations
+func (mmSetPasswordHash *UsersRepositoryMock) SetPasswordHashBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetPasswordHash.beforeSetPasswordHashCounter)
 }
 
-// Calls returns a list of arguments used in each call to UsersRepositoryMock.GetUserByID.
+// Calls returns a list of arguments used in each call to UsersRepositoryMock.SetPasswordHash.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetUserByID *mUsersRepositoryMockGetUserByID) Calls() []*UsersRepositoryMockGetUserByIDParams {
-	mmGetUserByID.mutex.RLock()
+func (mmSetPasswordHash *mUsersRepositoryMockSetPasswordHash) Calls() []*UsersRepositoryMockSetPasswordHashParams {
+	mmSetPasswordHash.mutex.RLock()
 
-	argCopy := make([]*UsersRepositoryMockGetUserByIDParams, len(mmGetUserByID.callArgs))
-	copy(argCopy, mmGetUserByID.callArgs)
+	argCopy := make([]*UsersRepositoryMockSetPasswordHashParams, len(mmSetPasswordHash.callArgs))
+	copy(argCopy, mmSetPasswordHash.callArgs)
 
-	mmGetUserByID.mutex.RUnlock()
+	mmSetPasswordHash.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetUserByIDDone returns true if the count of the GetUserByID invocations corresponds
+// MinimockSetPasswordHashDone returns true if the count of the SetPasswordHash invocations corresponds
 // the number of defined expectations
-func (m *UsersRepositoryMock) MinimockGetUserByIDDone() bool {
-	if m.GetUserByIDMock.optional {
+func (m *UsersRepositoryMock) MinimockSetPasswordHashDone() bool {
+	if m.SetPasswordHashMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetUserByIDMock.expectations {
+	for _, e := range m.SetPasswordHashMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetUserByIDMock.invocationsDone()
+	return m.SetPasswordHashMock.invocationsDone()
 }
 
-// MinimockGetUserByIDInspect logs each unmet expectation
-func (m *UsersRepositoryMock) MinimockGetUserByIDInspect() {
-	for _, e := range m.GetUserByIDMock.expectations {
+// MinimockSetPasswordHashInspect logs each unmet expectation
+func (m *UsersRepositoryMock) MinimockSetPasswordHashInspect() {
+	for _, e := range m.SetPasswordHashMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to UsersRepositoryMock.GetUserByID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to UsersRepositoryMock.SetPasswordHash at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetUserByIDCounter := mm_atomic.LoadUint64(&m.afterGetUserByIDCounter)
+	afterSetPasswordHashCounter := mm_atomic.LoadUint64(&m.afterSetPasswordHashCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetUserByIDMock.defaultExpectation != nil && afterGetUserByIDCounter < 1 {
-		if m.GetUserByIDMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to UsersRepositoryMock.GetUserByID at\n%s", m.GetUserByIDMock.defaultExpectation.returnOrigin)
+	if m.SetPasswordHashMock.defaultExpectation != nil && afterSetPasswordHashCounter < 1 {
+		if m.SetPasswordHashMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UsersRepositoryMock.SetPasswordHash at\n%s", m.SetPasswordHashMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to UsersRepositoryMock.GetUserByID at\n%s with params: %#v", m.GetUserByIDMock.defaultExpectation.expectationOrigins.origin, *m.GetUserByIDMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to UsersRepositoryMock.SetPasswordHash at\n%s with params: %#v", m.SetPasswordHashMock.defaultExpectation.expectationOrigins.origin, *m.SetPasswordHashMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetUserByID != nil && afterGetUserByIDCounter < 1 {
-		m.t.Errorf("Expected call to UsersRepositoryMock.GetUserByID at\n%s", m.funcGetUserByIDOrigin)
+	if m.funcSetPasswordHash != nil && afterSetPasswordHashCounter < 1 {
+		m.t.Errorf("Expected call to UsersRepositoryMock.SetPasswordHash at\n%s", m.funcSetPasswordHashOrigin)
 	}
 
-	if !m.GetUserByIDMock.invocationsDone() && afterGetUserByIDCounter > 0 {
-		m.t.Errorf("Expected %d calls to UsersRepositoryMock.GetUserByID at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetUserByIDMock.expectedInvocations), m.GetUserByIDMock.expectedInvocationsOrigin, afterGetUserByIDCounter)
+	if !m.SetPasswordHashMock.invocationsDone() && afterSetPasswordHashCounter > 0 {
+		m.t.Errorf("Expected %d calls to UsersRepositoryMock.SetPasswordHash at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetPasswordHashMock.expectedInvocations), m.SetPasswordHashMock.expectedInvocationsOrigin, afterSetPasswordHashCounter)
 	}
 }
 
-type mUsersRepositoryMockSaveUser struct {
+type mUsersRepositoryMockSetPendingEmail struct {
 	optional           bool
 	mock               *UsersRepositoryMock
-	defaultExpectation *UsersRepositoryMockSaveUserExpectation
-	expectations       []*UsersRepositoryMockSaveUserExpectation
+	defaultExpectation *UsersRepositoryMockSetPendingEmailExpectation
+	expectations       []*UsersRepositoryMockSetPendingEmailExpectation
 
-	callArgs []*UsersRepositoryMockSaveUserParams
+	callArgs []*UsersRepositoryMockSetPendingEmailParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// UsersRepositoryMockSaveUserExpectation specifies expectation struct of the UsersRepository.SaveUser
-type UsersRepositoryMockSaveUserExpectation struct {
+// UsersRepositoryMockSetPendingEmailExpectation specifies expectation struct of the UsersRepository.SetPendingEmail
+type UsersRepositoryMockSetPendingEmailExpectation struct {
 	mock               *UsersRepositoryMock
-	params             *UsersRepositoryMockSaveUserParams
-	paramPtrs          *UsersRepositoryMockSaveUserParamPtrs
-	expectationOrigins UsersRepositoryMockSaveUserExpectationOrigins
-	results            *UsersRepositoryMockSaveUserResults
+	params             *UsersRepositoryMockSetPendingEmailParams
+	paramPtrs          *UsersRepositoryMockSetPendingEmailParamPtrs
+	expectationOrigins UsersRepositoryMockSetPendingEmailExpectationOrigins
+	results            *UsersRepositoryMockSetPendingEmailResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// UsersRepositoryMockSaveUserParams contains parameters of the UsersRepository.SaveUser
-type UsersRepositoryMockSaveUserParams struct {
-	ctx      context.Context
-	email    string
-	password string
+// UsersRepositoryMockSetPendingEmailParams contains parameters of the UsersRepository.SetPendingEmail
+type UsersRepositoryMockSetPendingEmailParams struct {
+	ctx    context.Context
+	userID string
+	email  *string
 }
 
-// UsersRepositoryMockSaveUserParamPtrs contains pointers to parameters of the UsersRepository.SaveUser
-type UsersRepositoryMockSaveUserParamPtrs struct {
-	ctx      *context.Context
-	email    *string
-	password *string
+// UsersRepositoryMockSetPendingEmailParamPtrs contains pointers to parameters of the UsersRepository.SetPendingEmail
+type UsersRepositoryMockSetPendingEmailParamPtrs struct {
+	ctx    *context.Context
+	userID *string
+	email  **string
 }
 
-// UsersRepositoryMockSaveUserResults contains results of the UsersRepository.SaveUser
-type UsersRepositoryMockSaveUserResults struct {
-	up1 *models.User
+// UsersRepositoryMockSetPendingEmailResults contains results of the UsersRepository.SetPendingEmail
+type UsersRepositoryMockSetPendingEmailResults struct {
 	err error
 }
 
-// UsersRepositoryMockSaveUserOrigins contains origins of expectations of the UsersRepository.SaveUser
-type UsersRepositoryMockSaveUserExpectationOrigins struct {
-	origin         string
-	originCtx      string
-	originEmail    string
-	originPassword string
+// UsersRepositoryMockSetPendingEmailOrigins contains origins of expectations of the UsersRepository.SetPendingEmail
+type UsersRepositoryMockSetPendingEmailExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originEmail  string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -814,369 +2988,376 @@ type UsersRepositoryMockSaveUserExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmSaveUser *mUsersRepositoryMockSaveUser) Optional() *mUsersRepositoryMockSaveUser {
-	mmSaveUser.optional = true
-	return mmSaveUser
+func (mmSetPendingEmail *mUsersRepositoryMockSetPendingEmail) Optional() *mUsersRepositoryMockSetPendingEmail {
+	mmSetPendingEmail.optional = true
+	return mmSetPendingEmail
 }
 
-// Expect sets up expected params for UsersRepository.SaveUser
-func (mmSaveUser *mUsersRepositoryMockSaveUser) Expect(ctx context.Context, email string, password string) *mUsersRepositoryMockSaveUser {
-	if mmSaveUser.mock.funcSaveUser != nil {
-		mmSaveUser.mock.t.Fatalf("UsersRepositoryMock.SaveUser mock is already set by Set")
+// Expect sets up expected params for UsersRepository.SetPendingEmail
+func (mmSetPendingEmail *mUsersRepositoryMockSetPendingEmail) Expect(ctx context.Context, userID string, email *string) *mUsersRepositoryMockSetPendingEmail {
+	if mmSetPendingEmail.mock.funcSetPendingEmail != nil {
+		mmSetPendingEmail.mock.t.Fatalf("UsersRepositoryMock.SetPendingEmail mock is already set by Set")
 	}
 
-	if mmSaveUser.defaultExpectation == nil {
-		mmSaveUser.defaultExpectation = &UsersRepositoryMockSaveUserExpectation{}
+	if mmSetPendingEmail.defaultExpectation == nil {
+		mmSetPendingEmail.defaultExpectation = &UsersRepositoryMockSetPendingEmailExpectation{}
 	}
 
-	if mmSaveUser.defaultExpectation.paramPtrs != nil {
-		mmSaveUser.mock.t.Fatalf("UsersRepositoryMock.SaveUser mock is already set by ExpectParams functions")
+	if mmSetPendingEmail.defaultExpectation.paramPtrs != nil {
+		mmSetPendingEmail.mock.t.Fatalf("UsersRepositoryMock.SetPendingEmail mock is already set by ExpectParams functions")
 	}
 
-	mmSaveUser.defaultExpectation.params = &UsersRepositoryMockSaveUserParams{ctx, email, password}
-	mmSaveUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmSaveUser.expectations {
-		if minimock.Equal(e.params, mmSaveUser.defaultExpectation.params) {
-			mmSaveUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSaveUser.defaultExpectation.params)
+	mmSetPendingEmail.defaultExpectation.params = &UsersRepositoryMockSetPendingEmailParams{ctx, userID, email}
+	mmSetPendingEmail.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetPendingEmail.expectations {
+		if minimock.Equal(e.params, mmSetPendingEmail.defaultExpectation.params) {
+			mmSetPendingEmail.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetPendingEmail.defaultExpectation.params)
 		}
 	}
 
-	return mmSaveUser
+	return mmSetPendingEmail
 }
 
-// ExpectCtxParam1 sets up expected param ctx for UsersRepository.SaveUser
-func (mmSaveUser *mUsersRepositoryMockSaveUser) ExpectCtxParam1(ctx context.Context) *mUsersRepositoryMockSaveUser {
-	if mmSaveUser.mock.funcSaveUser != nil {
-		mmSaveUser.mock.t.Fatalf("UsersRepositoryMock.SaveUser mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for UsersRepository.SetPendingEmail
+func (mmSetPendingEmail *mUsersRepositoryMockSetPendingEmail) ExpectCtxParam1(ctx context.Context) *mUsersRepositoryMockSetPendingEmail {
+	if mmSetPendingEmail.mock.funcSetPendingEmail != nil {
+		mmSetPendingEmail.mock.t.Fatalf("UsersRepositoryMock.SetPendingEmail mock is already set by Set")
 	}
 
-	if mmSaveUser.defaultExpectation == nil {
-		mmSaveUser.defaultExpectation = &UsersRepositoryMockSaveUserExpectation{}
+	if mmSetPendingEmail.defaultExpectation == nil {
+		mmSetPendingEmail.defaultExpectation = &UsersRepositoryMockSetPendingEmailExpectation{}
 	}
 
-	if mmSaveUser.defaultExpectation.params != nil {
-		mmSaveUser.mock.t.Fatalf("UsersRepositoryMock.SaveUser mock is already set by Expect")
+	if mmSetPendingEmail.defaultExpectation.params != nil {
+		mmSetPendingEmail.mock.t.Fatalf("UsersRepositoryMock.SetPendingEmail mock is already set by Expect")
 	}
 
-	if mmSaveUser.defaultExpectation.paramPtrs == nil {
-		mmSaveUser.defaultExpectation.paramPtrs = &UsersRepositoryMockSaveUserParamPtrs{}
+	if mmSetPendingEmail.defaultExpectation.paramPtrs == nil {
+		mmSetPendingEmail.defaultExpectation.paramPtrs = &UsersRepositoryMockSetPendingEmailParamPtrs{}
 	}
-	mmSaveUser.defaultExpectation.paramPtrs.ctx = &ctx
-	mmSaveUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmSetPendingEmail.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetPendingEmail.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmSaveUser
+	return mmSetPendingEmail
 }
 
-// ExpectEmailParam2 sets up expected param email for UsersRepository.SaveUser
-func (mmSaveUser *mUsersRepositoryMockSaveUser) ExpectEmailParam2(email string) *mUsersRepositoryMockSaveUser {
-	if mmSaveUser.mock.funcSaveUser != nil {
-		mmSaveUser.mock.t.Fatalf("UsersRepositoryMock.SaveUser mock is already set by Set")
+// ExpectUserIDParam2 sets up expected param userID for UsersRepository.SetPendingEmail
+func (mmSetPendingEmail *mUsersRepositoryMockSetPendingEmail) ExpectUserIDParam2(userID string) *mUsersRepositoryMockSetPendingEmail {
+	if mmSetPendingEmail.mock.funcSetPendingEmail != nil {
+		mmSetPendingEmail.mock.t.Fatalf("UsersRepositoryMock.SetPendingEmail mock is already set by Set")
 	}
 
-	if mmSaveUser.defaultExpectation == nil {
-		mmSaveUser.defaultExpectation = &UsersRepositoryMockSaveUserExpectation{}
+	if mmSetPendingEmail.defaultExpectation == nil {
+		mmSetPendingEmail.defaultExpectation = &UsersRepositoryMockSetPendingEmailExpectation{}
 	}
 
-	if mmSaveUser.defaultExpectation.params != nil {
-		mmSaveUser.mock.t.Fatalf("UsersRepositoryMock.SaveUser mock is already set by Expect")
+	if mmSetPendingEmail.defaultExpectation.params != nil {
+		mmSetPendingEmail.mock.t.Fatalf("UsersRepositoryMock.SetPendingEmail mock is already set by Expect")
 	}
 
-	if mmSaveUser.defaultExpectation.paramPtrs == nil {
-		mmSaveUser.defaultExpectation.paramPtrs = &UsersRepositoryMockSaveUserParamPtrs{}
+	if mmSetPendingEmail.defaultExpectation.paramPtrs == nil {
+		mmSetPendingEmail.defaultExpectation.paramPtrs = &UsersRepositoryMockSetPendingEmailParamPtrs{}
 	}
-	mmSaveUser.defaultExpectation.paramPtrs.email = &email
-	mmSaveUser.defaultExpectation.expectationOrigins.originEmail = minimock.CallerInfo(1)
+	mmSetPendingEmail.defaultExpectation.paramPtrs.userID = &userID
+	mmSetPendingEmail.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
 
-	return mmSaveUser
+	return mmSetPendingEmail
 }
 
-// ExpectPasswordParam3 sets up expected param password for UsersRepository.SaveUser
-func (mmSaveUser *mUsersRepositoryMockSaveUser) ExpectPasswordParam3(password string) *mUsersRepositoryMockSaveUser {
-	if mmSaveUser.mock.funcSaveUser != nil {
-		mmSaveUser.mock.t.Fatalf("UsersRepositoryMock.SaveUser mock is already set by Set")
+// ExpectEmailParam3 sets up expected param email for UsersRepository.SetPendingEmail
+func (mmSetPendingEmail *mUsersRepositoryMockSetPendingEmail) ExpectEmailParam3(email *string) *mUsersRepositoryMockSetPendingEmail {
+	if mmSetPendingEmail.mock.funcSetPendingEmail != nil {
+		mmSetPendingEmail.mock.t.Fatalf("UsersRepositoryMock.SetPendingEmail mock is already set by Set")
 	}
 
-	if mmSaveUser.defaultExpectation == nil {
-		mmSaveUser.defaultExpectation = &UsersRepositoryMockSaveUserExpectation{}
+	if mmSetPendingEmail.defaultExpectation == nil {
+		mmSetPendingEmail.defaultExpectation = &UsersRepositoryMockSetPendingEmailExpectation{}
 	}
 
-	if mmSaveUser.defaultExpectation.params != nil {
-		mmSaveUser.mock.t.Fatalf("UsersRepositoryMock.SaveUser mock is already set by Expect")
+	if mmSetPendingEmail.defaultExpectation.params != nil {
+		mmSetPendingEmail.mock.t.Fatalf("UsersRepositoryMock.SetPendingEmail mock is already set by Expect")
 	}
 
-	if mmSaveUser.defaultExpectation.paramPtrs == nil {
-		mmSaveUser.defaultExpectation.paramPtrs = &UsersRepositoryMockSaveUserParamPtrs{}
+	if mmSetPendingEmail.defaultExpectation.paramPtrs == nil {
+		mmSetPendingEmail.defaultExpectation.paramPtrs = &UsersRepositoryMockSetPendingEmailParamPtrs{}
 	}
-	mmSaveUser.defaultExpectation.paramPtrs.password = &password
-	mmSaveUser.defaultExpectation.expectationOrigins.originPassword = minimock.CallerInfo(1)
+	mmSetPendingEmail.defaultExpectation.paramPtrs.email = &email
+	mmSetPendingEmail.defaultExpectation.expectationOrigins.originEmail = minimock.CallerInfo(1)
 
-	return mmSaveUser
+	return mmSetPendingEmail
 }
 
-// Inspect accepts an inspector function that has same arguments as the UsersRepository.SaveUser
-func (mmSaveUser *mUsersRepositoryMockSaveUser) Inspect(f func(ctx context.Context, email string, password string)) *mUsersRepositoryMockSaveUser {
-	if mmSaveUser.mock.inspectFuncSaveUser != nil {
-		mmSaveUser.mock.t.Fatalf("Inspect function is already set for UsersRepositoryMock.SaveUser")
+// Inspect accepts an inspector function that has same arguments as the UsersRepository.SetPendingEmail
+func (mmSetPendingEmail *mUsersRepositoryMockSetPendingEmail) Inspect(f func(ctx context.Context, userID string, email *string)) *mUsersRepositoryMockSetPendingEmail {
+	if mmSetPendingEmail.mock.inspectFuncSetPendingEmail != nil {
+		mmSetPendingEmail.mock.t.Fatalf("Inspect function is already set for UsersRepositoryMock.SetPendingEmail")
 	}
 
-	mmSaveUser.mock.inspectFuncSaveUser = f
+	mmSetPendingEmail.mock.inspectFuncSetPendingEmail = f
 
-	return mmSaveUser
+	return mmSetPendingEmail
 }
 
-// Return sets up results that will be returned by UsersRepository.SaveUser
-func (mmSaveUser *mUsersRepositoryMockSaveUser) Return(up1 *models.User, err error) *UsersRepositoryMock {
-	if mmSaveUser.mock.funcSaveUser != nil {
-		mmSaveUser.mock.t.Fatalf("UsersRepositoryMock.SaveUser mock is already set by Set")
+// Return sets up results that will be returned by UsersRepository.SetPendingEmail
+func (mmSetPendingEmail *mUsersRepositoryMockSetPendingEmail) Return(err error) *UsersRepositoryMock {
+	if mmSetPendingEmail.mock.funcSetPendingEmail != nil {
+		mmSetPendingEmail.mock.t.Fatalf("UsersRepositoryMock.SetPendingEmail mock is already set by Set")
 	}
 
-	if mmSaveUser.defaultExpectation == nil {
-		mmSaveUser.defaultExpectation = &UsersRepositoryMockSaveUserExpectation{mock: mmSaveUser.mock}
+	if mmSetPendingEmail.defaultExpectation == nil {
+		mmSetPendingEmail.defaultExpectation = &UsersRepositoryMockSetPendingEmailExpectation{mock: mmSetPendingEmail.mock}
 	}
-	mmSaveUser.defaultExpectation.results = &UsersRepositoryMockSaveUserResults{up1, err}
-	mmSaveUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmSaveUser.mock
+	mmSetPendingEmail.defaultExpectation.results = &UsersRepositoryMockSetPendingEmailResults{err}
+	mmSetPendingEmail.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetPendingEmail.mock
 }
 
-// Set uses given function f to mock the UsersRepository.SaveUser method
-func (mmSaveUser *mUsersRepositoryMockSaveUser) Set(f func(ctx context.Context, email string, password string) (up1 *models.User, err error)) *UsersRepositoryMock {
-	if mmSaveUser.defaultExpectation != nil {
-		mmSaveUser.mock.t.Fatalf("Default expectation is already set for the UsersRepository.SaveUser method")
+// Set uses given function f to mock the UsersRepository.SetPendingEmail method
+func (mmSetPendingEmail *mUsersRepositoryMockSetPendingEmail) Set(f func(ctx context.Context, userID string, email *string) (err error)) *UsersRepositoryMock {
+	if mmSetPendingEmail.defaultExpectation != nil {
+		mmSetPendingEmail.mock.t.Fatalf("Default expectation is already set for the UsersRepository.SetPendingEmail method")
 	}
 
-	if len(mmSaveUser.expectations) > 0 {
-		mmSaveUser.mock.t.Fatalf("Some expectations are already set for the UsersRepository.SaveUser method")
+	if len(mmSetPendingEmail.expectations) > 0 {
+		mmSetPendingEmail.mock.t.Fatalf("Some expectations are already set for the UsersRepository.SetPendingEmail method")
 	}
 
-	mmSaveUser.mock.funcSaveUser = f
-	mmSaveUser.mock.funcSaveUserOrigin = minimock.CallerInfo(1)
-	return mmSaveUser.mock
+	mmSetPendingEmail.mock.funcSetPendingEmail = f
+	mmSetPendingEmail.mock.funcSetPendingEmailOrigin = minimock.CallerInfo(1)
+	return mmSetPendingEmail.mock
 }
 
-// When sets expectation for the UsersRepository.SaveUser which will trigger the result defined by the following
+// When sets expectation for the UsersRepository.SetPendingEmail which will trigger the result defined by the following
 // Then helper
-func (mmSaveUser *mUsersRepositoryMockSaveUser) When(ctx context.Context, email string, password string) *UsersRepositoryMockSaveUserExpectation {
-	if mmSaveUser.mock.funcSaveUser != nil {
-		mmSaveUser.mock.t.Fatalf("UsersRepositoryMock.SaveUser mock is already set by Set")
+func (mmSetPendingEmail *mUsersRepositoryMockSetPendingEmail) When(ctx context.Context, userID string, email *string) *UsersRepositoryMockSetPendingEmailExpectation {
+	if mmSetPendingEmail.mock.funcSetPendingEmail != nil {
+		mmSetPendingEmail.mock.t.Fatalf("UsersRepositoryMock.SetPendingEmail mock is already set by Set")
 	}
 
-	expectation := &UsersRepositoryMockSaveUserExpectation{
-		mock:               mmSaveUser.mock,
-		params:             &UsersRepositoryMockSaveUserParams{ctx, email, password},
-		expectationOrigins: UsersRepositoryMockSaveUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &UsersRepositoryMockSetPendingEmailExpectation{
+		mock:               mmSetPendingEmail.mock,
+		params:             &UsersRepositoryMockSetPendingEmailParams{ctx, userID, email},
+		expectationOrigins: UsersRepositoryMockSetPendingEmailExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmSaveUser.expectations = append(mmSaveUser.expectations, expectation)
+	mmSetPendingEmail.expectations = append(mmSetPendingEmail.expectations, expectation)
 	return expectation
 }
 
-// Then sets up UsersRepository.SaveUser return parameters for the expectation previously defined by the When method
-func (e *UsersRepositoryMockSaveUserExpectation) Then(up1 *models.User, err error) *UsersRepositoryMock {
-	e.results = &UsersRepositoryMockSaveUserResults{up1, err}
+// Then sets up UsersRepository.SetPendingEmail return parameters for the expectation previously defined by the When method
+func (e *UsersRepositoryMockSetPendingEmailExpectation) Then(err error) *UsersRepositoryMock {
+	e.results = &UsersRepositoryMockSetPendingEmailResults{err}
 	return e.mock
 }
 
-// Times sets number of times UsersRepository.SaveUser should be invoked
-func (mmSaveUser *mUsersRepositoryMockSaveUser) Times(n uint64) *mUsersRepositoryMockSaveUser {
+// Times sets number of times UsersRepository.SetPendingEmail should be invoked
+func (mmSetPendingEmail *mUsersRepositoryMockSetPendingEmail) Times(n uint64) *mUsersRepositoryMockSetPendingEmail {
 	if n == 0 {
-		mmSaveUser.mock.t.Fatalf("Times of UsersRepositoryMock.SaveUser mock can not be zero")
+		mmSetPendingEmail.mock.t.Fatalf("Times of UsersRepositoryMock.SetPendingEmail mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmSaveUser.expectedInvocations, n)
-	mmSaveUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmSaveUser
+	mm_atomic.StoreUint64(&mmSetPendingEmail.expectedInvocations, n)
+	mmSetPendingEmail.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetPendingEmail
 }
 
-func (mmSaveUser *mUsersRepositoryMockSaveUser) invocationsDone() bool {
-	if len(mmSaveUser.expectations) == 0 && mmSaveUser.defaultExpectation == nil && mmSaveUser.mock.funcSaveUser == nil {
+func (mmSetPendingEmail *mUsersRepositoryMockSetPendingEmail) invocationsDone() bool {
+	if len(mmSetPendingEmail.expectations) == 0 && mmSetPendingEmail.defaultExpectation == nil && mmSetPendingEmail.mock.funcSetPendingEmail == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmSaveUser.mock.afterSaveUserCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmSaveUser.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmSetPendingEmail.mock.afterSetPendingEmailCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetPendingEmail.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// SaveUser implements mm_usecase.UsersRepository
-func (mmSaveUser *UsersRepositoryMock) SaveUser(ctx context.Context, email string, password string) (up1 *models.User, err error) {
-	mm_atomic.AddUint64(&mmSaveUser.beforeSaveUserCounter, 1)
-	defer mm_atomic.AddUint64(&mmSaveUser.afterSaveUserCounter, 1)
+// SetPendingEmail implements mm_usecase.UsersRepository
+func (mmSetPendingEmail *UsersRepositoryMock) SetPendingEmail(ctx context.Context, userID string, email *string) (err error) {
+	mm_atomic.AddUint64(&mmSetPendingEmail.beforeSetPendingEmailCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetPendingEmail.afterSetPendingEmailCounter, 1)
 
-	mmSaveUser.t.Helper()
+	mmSetPendingEmail.t.Helper()
 
-	if mmSaveUser.inspectFuncSaveUser != nil {
-		mmSaveUser.inspectFuncSaveUser(ctx, email, password)
+	if mmSetPendingEmail.inspectFuncSetPendingEmail != nil {
+		mmSetPendingEmail.inspectFuncSetPendingEmail(ctx, userID, email)
 	}
 
-	mm_params := UsersRepositoryMockSaveUserParams{ctx, email, password}
+	mm_params := UsersRepositoryMockSetPendingEmailParams{ctx, userID, email}
 
 	// Record call args
-	mmSaveUser.SaveUserMock.mutex.Lock()
-	mmSaveUser.SaveUserMock.callArgs = append(mmSaveUser.SaveUserMock.callArgs, &mm_params)
-	mmSaveUser.SaveUserMock.mutex.Unlock()
+	mmSetPendingEmail.SetPendingEmailMock.mutex.Lock()
+	mmSetPendingEmail.SetPendingEmailMock.callArgs = append(mmSetPendingEmail.SetPendingEmailMock.callArgs, &mm_params)
+	mmSetPendingEmail.SetPendingEmailMock.mutex.Unlock()
 
-	for _, e := range mmSaveUser.SaveUserMock.expectations {
+	for _, e := range mmSetPendingEmail.SetPendingEmailMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.up1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmSaveUser.SaveUserMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmSaveUser.SaveUserMock.defaultExpectation.Counter, 1)
-		mm_want := mmSaveUser.SaveUserMock.defaultExpectation.params
-		mm_want_ptrs := mmSaveUser.SaveUserMock.defaultExpectation.paramPtrs
+	if mmSetPendingEmail.SetPendingEmailMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetPendingEmail.SetPendingEmailMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetPendingEmail.SetPendingEmailMock.defaultExpectation.params
+		mm_want_ptrs := mmSetPendingEmail.SetPendingEmailMock.defaultExpectation.paramPtrs
 
-		mm_got := UsersRepositoryMockSaveUserParams{ctx, email, password}
+		mm_got := UsersRepositoryMockSetPendingEmailParams{ctx, userID, email}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmSaveUser.t.Errorf("UsersRepositoryMock.SaveUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmSaveUser.SaveUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmSetPendingEmail.t.Errorf("UsersRepositoryMock.SetPendingEmail got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetPendingEmail.SetPendingEmailMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.email != nil && !minimock.Equal(*mm_want_ptrs.email, mm_got.email) {
-				mmSaveUser.t.Errorf("UsersRepositoryMock.SaveUser got unexpected parameter email, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmSaveUser.SaveUserMock.defaultExpectation.expectationOrigins.originEmail, *mm_want_ptrs.email, mm_got.email, minimock.Diff(*mm_want_ptrs.email, mm_got.email))
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmSetPendingEmail.t.Errorf("UsersRepositoryMock.SetPendingEmail got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetPendingEmail.SetPendingEmailMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
 			}
 
-			if mm_want_ptrs.password != nil && !minimock.Equal(*mm_want_ptrs.password, mm_got.password) {
-				mmSaveUser.t.Errorf("UsersRepositoryMock.SaveUser got unexpected parameter password, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmSaveUser.SaveUserMock.defaultExpectation.expectationOrigins.originPassword, *mm_want_ptrs.password, mm_got.password, minimock.Diff(*mm_want_ptrs.password, mm_got.password))
+			if mm_want_ptrs.email != nil && !minimock.Equal(*mm_want_ptrs.email, mm_got.email) {
+				mmSetPendingEmail.t.Errorf("UsersRepositoryMock.SetPendingEmail got unexpected parameter email, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetPendingEmail.SetPendingEmailMock.defaultExpectation.expectationOrigins.originEmail, *mm_want_ptrs.email, mm_got.email, minimock.Diff(*mm_want_ptrs.email, mm_got.email))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmSaveUser.t.Errorf("UsersRepositoryMock.SaveUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmSaveUser.SaveUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmSetPendingEmail.t.Errorf("UsersRepositoryMock.SetPendingEmail got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetPendingEmail.SetPendingEmailMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmSaveUser.SaveUserMock.defaultExpectation.results
+		mm_results := mmSetPendingEmail.SetPendingEmailMock.defaultExpectation.results
 		if mm_results == nil {
-			mmSaveUser.t.Fatal("No results are set for the UsersRepositoryMock.SaveUser")
+			mmSetPendingEmail.t.Fatal("No results are set for the UsersRepositoryMock.SetPendingEmail")
 		}
-		return (*mm_results).up1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmSaveUser.funcSaveUser != nil {
-		return mmSaveUser.funcSaveUser(ctx, email, password)
+	if mmSetPendingEmail.funcSetPendingEmail != nil {
+		return mmSetPendingEmail.funcSetPendingEmail(ctx, userID, email)
 	}
-	mmSaveUser.t.Fatalf("Unexpected call to UsersRepositoryMock.SaveUser. %v %v %v", ctx, email, password)
+	mmSetPendingEmail.t.Fatalf("Unexpected call to UsersRepositoryMock.SetPendingEmail. %v %v %v", ctx, userID, email)
 	return
 }
 
-// SaveUserAfterCounter returns a count of finished UsersRepositoryMock.SaveUser invocations
-func (mmSaveUser *UsersRepositoryMock) SaveUserAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmSaveUser.afterSaveUserCounter)
+// SetPendingEmailAfterCounter returns a count of finished UsersRepositoryMock.SetPendingEmail invocations
+func (mmSetPendingEmail *UsersRepositoryMock) SetPendingEmailAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetPendingEmail.afterSetPendingEmailCounter)
 }
 
-// SaveUserBeforeCounter returns a count of UsersRepositoryMock.SaveUser invocations
-func (mmSaveUser *UsersRepositoryMock) SaveUserBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmSaveUser.beforeSaveUserCounter)
+// SetPendingEmailBeforeCounter returns a count of UsersRepositoryMock.SetPendingEmail invocations
+func (mmSetPendingEmail *UsersRepositoryMock) SetPendingEmailBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetPendingEmail.beforeSetPendingEmailCounter)
 }
 
-// Calls returns a list of arguments used in each call to UsersRepositoryMock.SaveUser.
+// Calls returns a list of arguments used in each call to UsersRepositoryMock.SetPendingEmail.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmSaveUser *mUsersRepositoryMockSaveUser) Calls() []*UsersRepositoryMockSaveUserParams {
-	mmSaveUser.mutex.RLock()
+func (mmSetPendingEmail *mUsersRepositoryMockSetPendingEmail) Calls() []*UsersRepositoryMockSetPendingEmailParams {
+	mmSetPendingEmail.mutex.RLock()
 
-	argCopy := make([]*UsersRepositoryMockSaveUserParams, len(mmSaveUser.callArgs))
-	copy(argCopy, mmSaveUser.callArgs)
+	argCopy := make([]*UsersRepositoryMockSetPendingEmailParams, len(mmSetPendingEmail.callArgs))
+	copy(argCopy, mmSetPendingEmail.callArgs)
 
-	mmSaveUser.mutex.RUnlock()
+	mmSetPendingEmail.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockSaveUserDone returns true if the count of the SaveUser invocations corresponds
+// MinimockSetPendingEmailDone returns true if the count of the SetPendingEmail invocations corresponds
 // the number of defined expectations
-func (m *UsersRepositoryMock) MinimockSaveUserDone() bool {
-	if m.SaveUserMock.optional {
+func (m *UsersRepositoryMock) MinimockSetPendingEmailDone() bool {
+	if m.SetPendingEmailMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.SaveUserMock.expectations {
+	for _, e := range m.SetPendingEmailMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.SaveUserMock.invocationsDone()
+	return m.SetPendingEmailMock.invocationsDone()
 }
 
-// MinimockSaveUserInspect logs each unmet expectation
-func (m *UsersRepositoryMock) MinimockSaveUserInspect() {
-	for _, e := range m.SaveUserMock.expectations {
+// MinimockSetPendingEmailInspect logs each unmet expectation
+func (m *UsersRepositoryMock) MinimockSetPendingEmailInspect() {
+	for _, e := range m.SetPendingEmailMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to UsersRepositoryMock.SaveUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to UsersRepositoryMock.SetPendingEmail at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterSaveUserCounter := mm_atomic.LoadUint64(&m.afterSaveUserCounter)
+	afterSetPendingEmailCounter := mm_atomic.LoadUint64(&m.afterSetPendingEmailCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.SaveUserMock.defaultExpectation != nil && afterSaveUserCounter < 1 {
-		if m.SaveUserMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to UsersRepositoryMock.SaveUser at\n%s", m.SaveUserMock.defaultExpectation.returnOrigin)
+	if m.SetPendingEmailMock.defaultExpectation != nil && afterSetPendingEmailCounter < 1 {
+		if m.SetPendingEmailMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UsersRepositoryMock.SetPendingEmail at\n%s", m.SetPendingEmailMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to UsersRepositoryMock.SaveUser at\n%s with params: %#v", m.SaveUserMock.defaultExpectation.expectationOrigins.origin, *m.SaveUserMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to UsersRepositoryMock.SetPendingEmail at\n%s with params: %#v", m.SetPendingEmailMock.defaultExpectation.expectationOrigins.origin, *m.SetPendingEmailMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcSaveUser != nil && afterSaveUserCounter < 1 {
-		m.t.Errorf("Expected call to UsersRepositoryMock.SaveUser at\n%s", m.funcSaveUserOrigin)
+	if m.funcSetPendingEmail != nil && afterSetPendingEmailCounter < 1 {
+		m.t.Errorf("Expected call to UsersRepositoryMock.SetPendingEmail at\n%s", m.funcSetPendingEmailOrigin)
 	}
 
-	if !m.SaveUserMock.invocationsDone() && afterSaveUserCounter > 0 {
-		m.t.Errorf("Expected %d calls to UsersRepositoryMock.SaveUser at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.SaveUserMock.expectedInvocations), m.SaveUserMock.expectedInvocationsOrigin, afterSaveUserCounter)
+	if !m.SetPendingEmailMock.invocationsDone() && afterSetPendingEmailCounter > 0 {
+		m.t.Errorf("Expected %d calls to UsersRepositoryMock.SetPendingEmail at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetPendingEmailMock.expectedInvocations), m.SetPendingEmailMock.expectedInvocationsOrigin, afterSetPendingEmailCounter)
 	}
 }
 
-type mUsersRepositoryMockUpdateUser struct {
+type mUsersRepositoryMockUpdatePasswordHash struct {
 	optional           bool
 	mock               *UsersRepositoryMock
-	defaultExpectation *UsersRepositoryMockUpdateUserExpectation
-	expectations       []*UsersRepositoryMockUpdateUserExpectation
+	defaultExpectation *UsersRepositoryMockUpdatePasswordHashExpectation
+	expectations       []*UsersRepositoryMockUpdatePasswordHashExpectation
 
-	callArgs []*UsersRepositoryMockUpdateUserParams
+	callArgs []*UsersRepositoryMockUpdatePasswordHashParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// UsersRepositoryMockUpdateUserExpectation specifies expectation struct of the UsersRepository.UpdateUser
-type UsersRepositoryMockUpdateUserExpectation struct {
+// UsersRepositoryMockUpdatePasswordHashExpectation specifies expectation struct of the UsersRepository.UpdatePasswordHash
+type UsersRepositoryMockUpdatePasswordHashExpectation struct {
 	mock               *UsersRepositoryMock
-	params             *UsersRepositoryMockUpdateUserParams
-	paramPtrs          *UsersRepositoryMockUpdateUserParamPtrs
-	expectationOrigins UsersRepositoryMockUpdateUserExpectationOrigins
-	results            *UsersRepositoryMockUpdateUserResults
+	params             *UsersRepositoryMockUpdatePasswordHashParams
+	paramPtrs          *UsersRepositoryMockUpdatePasswordHashParamPtrs
+	expectationOrigins UsersRepositoryMockUpdatePasswordHashExpectationOrigins
+	results            *UsersRepositoryMockUpdatePasswordHashResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// UsersRepositoryMockUpdateUserParams contains parameters of the UsersRepository.UpdateUser
-type UsersRepositoryMockUpdateUserParams struct {
-	ctx  context.Context
-	user *models.User
+// UsersRepositoryMockUpdatePasswordHashParams contains parameters of the UsersRepository.UpdatePasswordHash
+type UsersRepositoryMockUpdatePasswordHashParams struct {
+	ctx     context.Context
+	userID  string
+	oldHash string
+	newHash string
 }
 
-// UsersRepositoryMockUpdateUserParamPtrs contains pointers to parameters of the UsersRepository.UpdateUser
-type UsersRepositoryMockUpdateUserParamPtrs struct {
-	ctx  *context.Context
-	user **models.User
+// UsersRepositoryMockUpdatePasswordHashParamPtrs contains pointers to parameters of the UsersRepository.UpdatePasswordHash
+type UsersRepositoryMockUpdatePasswordHashParamPtrs struct {
+	ctx     *context.Context
+	userID  *string
+	oldHash *string
+	newHash *string
 }
 
-// UsersRepositoryMockUpdateUserResults contains results of the UsersRepository.UpdateUser
-type UsersRepositoryMockUpdateUserResults struct {
+// UsersRepositoryMockUpdatePasswordHashResults contains results of the UsersRepository.UpdatePasswordHash
+type UsersRepositoryMockUpdatePasswordHashResults struct {
+	b1  bool
 	err error
 }
 
-// UsersRepositoryMockUpdateUserOrigins contains origins of expectations of the UsersRepository.UpdateUser
-type UsersRepositoryMockUpdateUserExpectationOrigins struct {
-	origin     string
-	originCtx  string
-	originUser string
+// UsersRepositoryMockUpdatePasswordHashOrigins contains origins of expectations of the UsersRepository.UpdatePasswordHash
+type UsersRepositoryMockUpdatePasswordHashExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originUserID  string
+	originOldHash string
+	originNewHash string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1184,292 +3365,348 @@ type UsersRepositoryMockUpdateUserExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmUpdateUser *mUsersRepositoryMockUpdateUser) Optional() *mUsersRepositoryMockUpdateUser {
-	mmUpdateUser.optional = true
-	return mmUpdateUser
+func (mmUpdatePasswordHash *mUsersRepositoryMockUpdatePasswordHash) Optional() *mUsersRepositoryMockUpdatePasswordHash {
+	mmUpdatePasswordHash.optional = true
+	return mmUpdatePasswordHash
 }
 
-// Expect sets up expected params for UsersRepository.UpdateUser
-func (mmUpdateUser *mUsersRepositoryMockUpdateUser) Expect(ctx context.Context, user *models.User) *mUsersRepositoryMockUpdateUser {
-	if mmUpdateUser.mock.funcUpdateUser != nil {
-		mmUpdateUser.mock.t.Fatalf("UsersRepositoryMock.UpdateUser mock is already set by Set")
+// Expect sets up expected params for UsersRepository.UpdatePasswordHash
+func (mmUpdatePasswordHash *mUsersRepositoryMockUpdatePasswordHash) Expect(ctx context.Context, userID string, oldHash string, newHash string) *mUsersRepositoryMockUpdatePasswordHash {
+	if mmUpdatePasswordHash.mock.funcUpdatePasswordHash != nil {
+		mmUpdatePasswordHash.mock.t.Fatalf("UsersRepositoryMock.UpdatePasswordHash mock is already set by Set")
 	}
 
-	if mmUpdateUser.defaultExpectation == nil {
-		mmUpdateUser.defaultExpectation = &UsersRepositoryMockUpdateUserExpectation{}
+	if mmUpdatePasswordHash.defaultExpectation == nil {
+		mmUpdatePasswordHash.defaultExpectation = &UsersRepositoryMockUpdatePasswordHashExpectation{}
 	}
 
-	if mmUpdateUser.defaultExpectation.paramPtrs != nil {
-		mmUpdateUser.mock.t.Fatalf("UsersRepositoryMock.UpdateUser mock is already set by ExpectParams functions")
+	if mmUpdatePasswordHash.defaultExpectation.paramPtrs != nil {
+		mmUpdatePasswordHash.mock.t.Fatalf("UsersRepositoryMock.UpdatePasswordHash mock is already set by ExpectParams functions")
 	}
 
-	mmUpdateUser.defaultExpectation.params = &UsersRepositoryMockUpdateUserParams{ctx, user}
-	mmUpdateUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmUpdateUser.expectations {
-		if minimock.Equal(e.params, mmUpdateUser.defaultExpectation.params) {
-			mmUpdateUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateUser.defaultExpectation.params)
+	mmUpdatePasswordHash.defaultExpectation.params = &UsersRepositoryMockUpdatePasswordHashParams{ctx, userID, oldHash, newHash}
+	mmUpdatePasswordHash.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdatePasswordHash.expectations {
+		if minimock.Equal(e.params, mmUpdatePasswordHash.defaultExpectation.params) {
+			mmUpdatePasswordHash.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdatePasswordHash.defaultExpectation.params)
 		}
 	}
 
-	return mmUpdateUser
+	return mmUpdatePasswordHash
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UsersRepository.UpdatePasswordHash
+func (mmUpdatePasswordHash *mUsersRepositoryMockUpdatePasswordHash) ExpectCtxParam1(ctx context.Context) *mUsersRepositoryMockUpdatePasswordHash {
+	if mmUpdatePasswordHash.mock.funcUpdatePasswordHash != nil {
+		mmUpdatePasswordHash.mock.t.Fatalf("UsersRepositoryMock.UpdatePasswordHash mock is already set by Set")
+	}
+
+	if mmUpdatePasswordHash.defaultExpectation == nil {
+		mmUpdatePasswordHash.defaultExpectation = &UsersRepositoryMockUpdatePasswordHashExpectation{}
+	}
+
+	if mmUpdatePasswordHash.defaultExpectation.params != nil {
+		mmUpdatePasswordHash.mock.t.Fatalf("UsersRepositoryMock.UpdatePasswordHash mock is already set by Expect")
+	}
+
+	if mmUpdatePasswordHash.defaultExpectation.paramPtrs == nil {
+		mmUpdatePasswordHash.defaultExpectation.paramPtrs = &UsersRepositoryMockUpdatePasswordHashParamPtrs{}
+	}
+	mmUpdatePasswordHash.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdatePasswordHash.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdatePasswordHash
+}
+
+// ExpectUserIDParam2 sets up expected param userID for UsersRepository.UpdatePasswordHash
+func (mmUpdatePasswordHash *mUsersRepositoryMockUpdatePasswordHash) ExpectUserIDParam2(userID string) *mUsersRepositoryMockUpdatePasswordHash {
+	if mmUpdatePasswordHash.mock.funcUpdatePasswordHash != nil {
+		mmUpdatePasswordHash.mock.t.Fatalf("UsersRepositoryMock.UpdatePasswordHash mock is already set by Set")
+	}
+
+	if mmUpdatePasswordHash.defaultExpectation == nil {
+		mmUpdatePasswordHash.defaultExpectation = &UsersRepositoryMockUpdatePasswordHashExpectation{}
+	}
+
+	if mmUpdatePasswordHash.defaultExpectation.params != nil {
+		mmUpdatePasswordHash.mock.t.Fatalf("UsersRepositoryMock.UpdatePasswordHash mock is already set by Expect")
+	}
+
+	if mmUpdatePasswordHash.defaultExpectation.paramPtrs == nil {
+		mmUpdatePasswordHash.defaultExpectation.paramPtrs = &UsersRepositoryMockUpdatePasswordHashParamPtrs{}
+	}
+	mmUpdatePasswordHash.defaultExpectation.paramPtrs.userID = &userID
+	mmUpdatePasswordHash.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmUpdatePasswordHash
 }
 
-// ExpectCtxParam1 sets up expected param ctx for UsersRepository.UpdateUser
-func (mmUpdateUser *mUsersRepositoryMockUpdateUser) ExpectCtxParam1(ctx context.Context) *mUsersRepositoryMockUpdateUser {
-	if mmUpdateUser.mock.funcUpdateUser != nil {
-		mmUpdateUser.mock.t.Fatalf("UsersRepositoryMock.UpdateUser mock is already set by Set")
+// ExpectOldHashParam3 sets up expected param oldHash for UsersRepository.UpdatePasswordHash
+func (mmUpdatePasswordHash *mUsersRepositoryMockUpdatePasswordHash) ExpectOldHashParam3(oldHash string) *mUsersRepositoryMockUpdatePasswordHash {
+	if mmUpdatePasswordHash.mock.funcUpdatePasswordHash != nil {
+		mmUpdatePasswordHash.mock.t.Fatalf("UsersRepositoryMock.UpdatePasswordHash mock is already set by Set")
 	}
 
-	if mmUpdateUser.defaultExpectation == nil {
-		mmUpdateUser.defaultExpectation = &UsersRepositoryMockUpdateUserExpectation{}
+	if mmUpdatePasswordHash.defaultExpectation == nil {
+		mmUpdatePasswordHash.defaultExpectation = &UsersRepositoryMockUpdatePasswordHashExpectation{}
 	}
 
-	if mmUpdateUser.defaultExpectation.params != nil {
-		mmUpdateUser.mock.t.Fatalf("UsersRepositoryMock.UpdateUser mock is already set by Expect")
+	if mmUpdatePasswordHash.defaultExpectation.params != nil {
+		mmUpdatePasswordHash.mock.t.Fatalf("UsersRepositoryMock.UpdatePasswordHash mock is already set by Expect")
 	}
 
-	if mmUpdateUser.defaultExpectation.paramPtrs == nil {
-		mmUpdateUser.defaultExpectation.paramPtrs = &UsersRepositoryMockUpdateUserParamPtrs{}
+	if mmUpdatePasswordHash.defaultExpectation.paramPtrs == nil {
+		mmUpdatePasswordHash.defaultExpectation.paramPtrs = &UsersRepositoryMockUpdatePasswordHashParamPtrs{}
 	}
-	mmUpdateUser.defaultExpectation.paramPtrs.ctx = &ctx
-	mmUpdateUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmUpdatePasswordHash.defaultExpectation.paramPtrs.oldHash = &oldHash
+	mmUpdatePasswordHash.defaultExpectation.expectationOrigins.originOldHash = minimock.CallerInfo(1)
 
-	return mmUpdateUser
+	return mmUpdatePasswordHash
 }
 
-// ExpectUserParam2 sets up expected param user for UsersRepository.UpdateUser
-func (mmUpdateUser *mUsersRepositoryMockUpdateUser) ExpectUserParam2(user *models.User) *mUsersRepositoryMockUpdateUser {
-	if mmUpdateUser.mock.funcUpdateUser != nil {
-		mmUpdateUser.mock.t.Fatalf("UsersRepositoryMock.UpdateUser mock is already set by Set")
+// ExpectNewHashParam4 sets up expected param newHash for UsersRepository.UpdatePasswordHash
+func (mmUpdatePasswordHash *mUsersRepositoryMockUpdatePasswordHash) ExpectNewHashParam4(newHash string) *mUsersRepositoryMockUpdatePasswordHash {
+	if mmUpdatePasswordHash.mock.funcUpdatePasswordHash != nil {
+		mmUpdatePasswordHash.mock.t.Fatalf("UsersRepositoryMock.UpdatePasswordHash mock is already set by Set")
 	}
 
-	if mmUpdateUser.defaultExpectation == nil {
-		mmUpdateUser.defaultExpectation = &UsersRepositoryMockUpdateUserExpectation{}
+	if mmUpdatePasswordHash.defaultExpectation == nil {
+		mmUpdatePasswordHash.defaultExpectation = &UsersRepositoryMockUpdatePasswordHashExpectation{}
 	}
 
-	if mmUpdateUser.defaultExpectation.params != nil {
-		mmUpdateUser.mock.t.Fatalf("UsersRepositoryMock.UpdateUser mock is already set by Expect")
+	if mmUpdatePasswordHash.defaultExpectation.params != nil {
+		mmUpdatePasswordHash.mock.t.Fatalf("UsersRepositoryMock.UpdatePasswordHash mock is already set by Expect")
 	}
 
-	if mmUpdateUser.defaultExpectation.paramPtrs == nil {
-		mmUpdateUser.defaultExpectation.paramPtrs = &UsersRepositoryMockUpdateUserParamPtrs{}
+	if mmUpdatePasswordHash.defaultExpectation.paramPtrs == nil {
+		mmUpdatePasswordHash.defaultExpectation.paramPtrs = &UsersRepositoryMockUpdatePasswordHashParamPtrs{}
 	}
-	mmUpdateUser.defaultExpectation.paramPtrs.user = &user
-	mmUpdateUser.defaultExpectation.expectationOrigins.originUser = minimock.CallerInfo(1)
+	mmUpdatePasswordHash.defaultExpectation.paramPtrs.newHash = &newHash
+	mmUpdatePasswordHash.defaultExpectation.expectationOrigins.originNewHash = minimock.CallerInfo(1)
 
-	return mmUpdateUser
+	return mmUpdatePasswordHash
 }
 
-// Inspect accepts an inspector function that has same arguments as the UsersRepository.UpdateUser
-func (mmUpdateUser *mUsersRepositoryMockUpdateUser) Inspect(f func(ctx context.Context, user *models.User)) *mUsersRepositoryMockUpdateUser {
-	if mmUpdateUser.mock.inspectFuncUpdateUser != nil {
-		mmUpdateUser.mock.t.Fatalf("Inspect function is already set for UsersRepositoryMock.UpdateUser")
+// Inspect accepts an inspector function that has same arguments as the UsersRepository.UpdatePasswordHash
+func (mmUpdatePasswordHash *mUsersRepositoryMockUpdatePasswordHash) Inspect(f func(ctx context.Context, userID string, oldHash string, newHash string)) *mUsersRepositoryMockUpdatePasswordHash {
+	if mmUpdatePasswordHash.mock.inspectFuncUpdatePasswordHash != nil {
+		mmUpdatePasswordHash.mock.t.Fatalf("Inspect function is already set for UsersRepositoryMock.UpdatePasswordHash")
 	}
 
-	mmUpdateUser.mock.inspectFuncUpdateUser = f
+	mmUpdatePasswordHash.mock.inspectFuncUpdatePasswordHash = f
 
-	return mmUpdateUser
+	return mmUpdatePasswordHash
 }
 
-// Return sets up results that will be returned by UsersRepository.UpdateUser
-func (mmUpdateUser *mUsersRepositoryMockUpdateUser) Return(err error) *UsersRepositoryMock {
-	if mmUpdateUser.mock.funcUpdateUser != nil {
-		mmUpdateUser.mock.t.Fatalf("UsersRepositoryMock.UpdateUser mock is already set by Set")
+// Return sets up results that will be returned by UsersRepository.UpdatePasswordHash
+func (mmUpdatePasswordHash *mUsersRepositoryMockUpdatePasswordHash) Return(b1 bool, err error) *UsersRepositoryMock {
+	if mmUpdatePasswordHash.mock.funcUpdatePasswordHash != nil {
+		mmUpdatePasswordHash.mock.t.Fatalf("UsersRepositoryMock.UpdatePasswordHash mock is already set by Set")
 	}
 
-	if mmUpdateUser.defaultExpectation == nil {
-		mmUpdateUser.defaultExpectation = &UsersRepositoryMockUpdateUserExpectation{mock: mmUpdateUser.mock}
+	if mmUpdatePasswordHash.defaultExpectation == nil {
+		mmUpdatePasswordHash.defaultExpectation = &UsersRepositoryMockUpdatePasswordHashExpectation{mock: mmUpdatePasswordHash.mock}
 	}
-	mmUpdateUser.defaultExpectation.results = &UsersRepositoryMockUpdateUserResults{err}
-	mmUpdateUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmUpdateUser.mock
+	mmUpdatePasswordHash.defaultExpectation.results = &UsersRepositoryMockUpdatePasswordHashResults{b1, err}
+	mmUpdatePasswordHash.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdatePasswordHash.mock
 }
 
-// Set uses given function f to mock the UsersRepository.UpdateUser method
-func (mmUpdateUser *mUsersRepositoryMockUpdateUser) Set(f func(ctx context.Context, user *models.User) (err error)) *UsersRepositoryMock {
-	if mmUpdateUser.defaultExpectation != nil {
-		mmUpdateUser.mock.t.Fatalf("Default expectation is already set for the UsersRepository.UpdateUser method")
+// Set uses given function f to mock the UsersRepository.UpdatePasswordHash method
+func (mmUpdatePasswordHash *mUsersRepositoryMockUpdatePasswordHash) Set(f func(ctx context.Context, userID string, oldHash string, newHash string) (b1 bool, err error)) *UsersRepositoryMock {
+	if mmUpdatePasswordHash.defaultExpectation != nil {
+		mmUpdatePasswordHash.mock.t.Fatalf("Default expectation is already set for the UsersRepository.UpdatePasswordHash method")
 	}
 
-	if len(mmUpdateUser.expectations) > 0 {
-		mmUpdateUser.mock.t.Fatalf("Some expectations are already set for the UsersRepository.UpdateUser method")
+	if len(mmUpdatePasswordHash.expectations) > 0 {
+		mmUpdatePasswordHash.mock.t.Fatalf("Some expectations are already set for the UsersRepository.UpdatePasswordHash method")
 	}
 
-	mmUpdateUser.mock.funcUpdateUser = f
-	mmUpdateUser.mock.funcUpdateUserOrigin = minimock.CallerInfo(1)
-	return mmUpdateUser.mock
+	mmUpdatePasswordHash.mock.funcUpdatePasswordHash = f
+	mmUpdatePasswordHash.mock.funcUpdatePasswordHashOrigin = minimock.CallerInfo(1)
+	return mmUpdatePasswordHash.mock
 }
 
-// When sets expectation for the UsersRepository.UpdateUser which will trigger the result defined by the following
+// When sets expectation for the UsersRepository.UpdatePasswordHash which will trigger the result defined by the following
 // Then helper
-func (mmUpdateUser *mUsersRepositoryMockUpdateUser) When(ctx context.Context, user *models.User) *UsersRepositoryMockUpdateUserExpectation {
-	if mmUpdateUser.mock.funcUpdateUser != nil {
-		mmUpdateUser.mock.t.Fatalf("UsersRepositoryMock.UpdateUser mock is already set by Set")
+func (mmUpdatePasswordHash *mUsersRepositoryMockUpdatePasswordHash) When(ctx context.Context, userID string, oldHash string, newHash string) *UsersRepositoryMockUpdatePasswordHashExpectation {
+	if mmUpdatePasswordHash.mock.funcUpdatePasswordHash != nil {
+		mmUpdatePasswordHash.mock.t.Fatalf("UsersRepositoryMock.UpdatePasswordHash mock is already set by Set")
 	}
 
-	expectation := &UsersRepositoryMockUpdateUserExpectation{
-		mock:               mmUpdateUser.mock,
-		params:             &UsersRepositoryMockUpdateUserParams{ctx, user},
-		expectationOrigins: UsersRepositoryMockUpdateUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &UsersRepositoryMockUpdatePasswordHashExpectation{
+		mock:               mmUpdatePasswordHash.mock,
+		params:             &UsersRepositoryMockUpdatePasswordHashParams{ctx, userID, oldHash, newHash},
+		expectationOrigins: UsersRepositoryMockUpdatePasswordHashExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmUpdateUser.expectations = append(mmUpdateUser.expectations, expectation)
+	mmUpdatePasswordHash.expectations = append(mmUpdatePasswordHash.expectations, expectation)
 	return expectation
 }
 
-// Then sets up UsersRepository.UpdateUser return parameters for the expectation previously defined by the When method
-func (e *UsersRepositoryMockUpdateUserExpectation) Then(err error) *UsersRepositoryMock {
-	e.results = &UsersRepositoryMockUpdateUserResults{err}
+// Then sets up UsersRepository.UpdatePasswordHash return parameters for the expectation previously defined by the When method
+func (e *UsersRepositoryMockUpdatePasswordHashExpectation) Then(b1 bool, err error) *UsersRepositoryMock {
+	e.results = &UsersRepositoryMockUpdatePasswordHashResults{b1, err}
 	return e.mock
 }
 
-// Times sets number of times UsersRepository.UpdateUser should be invoked
-func (mmUpdateUser *mUsersRepositoryMockUpdateUser) Times(n uint64) *mUsersRepositoryMockUpdateUser {
+// Times sets number of times UsersRepository.UpdatePasswordHash should be invoked
+func (mmUpdatePasswordHash *mUsersRepositoryMockUpdatePasswordHash) Times(n uint64) *mUsersRepositoryMockUpdatePasswordHash {
 	if n == 0 {
-		mmUpdateUser.mock.t.Fatalf("Times of UsersRepositoryMock.UpdateUser mock can not be zero")
+		mmUpdatePasswordHash.mock.t.Fatalf("Times of UsersRepositoryMock.UpdatePasswordHash mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmUpdateUser.expectedInvocations, n)
-	mmUpdateUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmUpdateUser
+	mm_atomic.StoreUint64(&mmUpdatePasswordHash.expectedInvocations, n)
+	mmUpdatePasswordHash.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdatePasswordHash
 }
 
-func (mmUpdateUser *mUsersRepositoryMockUpdateUser) invocationsDone() bool {
-	if len(mmUpdateUser.expectations) == 0 && mmUpdateUser.defaultExpectation == nil && mmUpdateUser.mock.funcUpdateUser == nil {
+func (mmUpdatePasswordHash *mUsersRepositoryMockUpdatePasswordHash) invocationsDone() bool {
+	if len(mmUpdatePasswordHash.expectations) == 0 && mmUpdatePasswordHash.defaultExpectation == nil && mmUpdatePasswordHash.mock.funcUpdatePasswordHash == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmUpdateUser.mock.afterUpdateUserCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateUser.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdatePasswordHash.mock.afterUpdatePasswordHashCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdatePasswordHash.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// UpdateUser implements mm_usecase.UsersRepository
-func (mmUpdateUser *UsersRepositoryMock) UpdateUser(ctx context.Context, user *models.User) (err error) {
-	mm_atomic.AddUint64(&mmUpdateUser.beforeUpdateUserCounter, 1)
-	defer mm_atomic.AddUint64(&mmUpdateUser.afterUpdateUserCounter, 1)
+// UpdatePasswordHash implements mm_usecase.UsersRepository
+func (mmUpdatePasswordHash *UsersRepositoryMock) UpdatePasswordHash(ctx context.Context, userID string, oldHash string, newHash string) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmUpdatePasswordHash.beforeUpdatePasswordHashCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdatePasswordHash.afterUpdatePasswordHashCounter, 1)
 
-	mmUpdateUser.t.Helper()
+	mmUpdatePasswordHash.t.Helper()
 
-	if mmUpdateUser.inspectFuncUpdateUser != nil {
-		mmUpdateUser.inspectFuncUpdateUser(ctx, user)
+	if mmUpdatePasswordHash.inspectFuncUpdatePasswordHash != nil {
+		mmUpdatePasswordHash.inspectFuncUpdatePasswordHash(ctx, userID, oldHash, newHash)
 	}
 
-	mm_params := UsersRepositoryMockUpdateUserParams{ctx, user}
+	mm_params := UsersRepositoryMockUpdatePasswordHashParams{ctx, userID, oldHash, newHash}
 
 	// Record call args
-	mmUpdateUser.UpdateUserMock.mutex.Lock()
-	mmUpdateUser.UpdateUserMock.callArgs = append(mmUpdateUser.UpdateUserMock.callArgs, &mm_params)
-	mmUpdateUser.UpdateUserMock.mutex.Unlock()
+	mmUpdatePasswordHash.UpdatePasswordHashMock.mutex.Lock()
+	mmUpdatePasswordHash.UpdatePasswordHashMock.callArgs = append(mmUpdatePasswordHash.UpdatePasswordHashMock.callArgs, &mm_params)
+	mmUpdatePasswordHash.UpdatePasswordHashMock.mutex.Unlock()
 
-	for _, e := range mmUpdateUser.UpdateUserMock.expectations {
+	for _, e := range mmUpdatePasswordHash.UpdatePasswordHashMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.b1, e.results.err
 		}
 	}
 
-	if mmUpdateUser.UpdateUserMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmUpdateUser.UpdateUserMock.defaultExpectation.Counter, 1)
-		mm_want := mmUpdateUser.UpdateUserMock.defaultExpectation.params
-		mm_want_ptrs := mmUpdateUser.UpdateUserMock.defaultExpectation.paramPtrs
+	if mmUpdatePasswordHash.UpdatePasswordHashMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdatePasswordHash.UpdatePasswordHashMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdatePasswordHash.UpdatePasswordHashMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdatePasswordHash.UpdatePasswordHashMock.defaultExpectation.paramPtrs
 
-		mm_got := UsersRepositoryMockUpdateUserParams{ctx, user}
+		mm_got := UsersRepositoryMockUpdatePasswordHashParams{ctx, userID, oldHash, newHash}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmUpdateUser.t.Errorf("UsersRepositoryMock.UpdateUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmUpdateUser.UpdateUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmUpdatePasswordHash.t.Errorf("UsersRepositoryMock.UpdatePasswordHash got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdatePasswordHash.UpdatePasswordHashMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmUpdatePasswordHash.t.Errorf("UsersRepositoryMock.UpdatePasswordHash got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdatePasswordHash.UpdatePasswordHashMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.oldHash != nil && !minimock.Equal(*mm_want_ptrs.oldHash, mm_got.oldHash) {
+				mmUpdatePasswordHash.t.Errorf("UsersRepositoryMock.UpdatePasswordHash got unexpected parameter oldHash, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdatePasswordHash.UpdatePasswordHashMock.defaultExpectation.expectationOrigins.originOldHash, *mm_want_ptrs.oldHash, mm_got.oldHash, minimock.Diff(*mm_want_ptrs.oldHash, mm_got.oldHash))
 			}
 
-			if mm_want_ptrs.user != nil && !minimock.Equal(*mm_want_ptrs.user, mm_got.user) {
-				mmUpdateUser.t.Errorf("UsersRepositoryMock.UpdateUser got unexpected parameter user, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmUpdateUser.UpdateUserMock.defaultExpectation.expectationOrigins.originUser, *mm_want_ptrs.user, mm_got.user, minimock.Diff(*mm_want_ptrs.user, mm_got.user))
+			if mm_want_ptrs.newHash != nil && !minimock.Equal(*mm_want_ptrs.newHash, mm_got.newHash) {
+				mmUpdatePasswordHash.t.Errorf("UsersRepositoryMock.UpdatePasswordHash got unexpected parameter newHash, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdatePasswordHash.UpdatePasswordHashMock.defaultExpectation.expectationOrigins.originNewHash, *mm_want_ptrs.newHash, mm_got.newHash, minimock.Diff(*mm_want_ptrs.newHash, mm_got.newHash))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmUpdateUser.t.Errorf("UsersRepositoryMock.UpdateUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmUpdateUser.UpdateUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmUpdatePasswordHash.t.Errorf("UsersRepositoryMock.UpdatePasswordHash got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdatePasswordHash.UpdatePasswordHashMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmUpdateUser.UpdateUserMock.defaultExpectation.results
+		mm_results := mmUpdatePasswordHash.UpdatePasswordHashMock.defaultExpectation.results
 		if mm_results == nil {
-			mmUpdateUser.t.Fatal("No results are set for the UsersRepositoryMock.UpdateUser")
+			mmUpdatePasswordHash.t.Fatal("No results are set for the UsersRepositoryMock.UpdatePasswordHash")
 		}
-		return (*mm_results).err
+		return (*mm_results).b1, (*mm_results).err
 	}
-	if mmUpdateUser.funcUpdateUser != nil {
-		return mmUpdateUser.funcUpdateUser(ctx, user)
+	if mmUpdatePasswordHash.funcUpdatePasswordHash != nil {
+		return mmUpdatePasswordHash.funcUpdatePasswordHash(ctx, userID, oldHash, newHash)
 	}
-	mmUpdateUser.t.Fatalf("Unexpected call to UsersRepositoryMock.UpdateUser. %v %v", ctx, user)
+	mmUpdatePasswordHash.t.Fatalf("Unexpected call to UsersRepositoryMock.UpdatePasswordHash. %v %v %v %v", ctx, userID, oldHash, newHash)
 	return
 }
 
-// UpdateUserAfterCounter returns a count of finished UsersRepositoryMock.UpdateUser invocations
-func (mmUpdateUser *UsersRepositoryMock) UpdateUserAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmUpdateUser.afterUpdateUserCounter)
+// UpdatePasswordHashAfterCounter returns a count of finished UsersRepositoryMock.UpdatePasswordHash invocations
+func (mmUpdatePasswordHash *UsersRepositoryMock) UpdatePasswordHashAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdatePasswordHash.afterUpdatePasswordHashCounter)
 }
 
-// UpdateUserBeforeCounter returns a count of UsersRepositoryMock.UpdateUser invocations
-func (mmUpdateUser *UsersRepositoryMock) UpdateUserBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmUpdateUser.beforeUpdateUserCounter)
+// UpdatePasswordHashBeforeCounter returns a count of UsersRepositoryMock.UpdatePasswordHash invocations
+func (mmUpdatePasswordHash *UsersRepositoryMock) UpdatePasswordHashBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdatePasswordHash.beforeUpdatePasswordHashCounter)
 }
 
-// Calls returns a list of arguments used in each call to UsersRepositoryMock.UpdateUser.
+// Calls returns a list of arguments used in each call to UsersRepositoryMock.UpdatePasswordHash.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmUpdateUser *mUsersRepositoryMockUpdateUser) Calls() []*UsersRepositoryMockUpdateUserParams {
-	mmUpdateUser.mutex.RLock()
+func (mmUpdatePasswordHash *mUsersRepositoryMockUpdatePasswordHash) Calls() []*UsersRepositoryMockUpdatePasswordHashParams {
+	mmUpdatePasswordHash.mutex.RLock()
 
-	argCopy := make([]*UsersRepositoryMockUpdateUserParams, len(mmUpdateUser.callArgs))
-	copy(argCopy, mmUpdateUser.callArgs)
+	argCopy := make([]*UsersRepositoryMockUpdatePasswordHashParams, len(mmUpdatePasswordHash.callArgs))
+	copy(argCopy, mmUpdatePasswordHash.callArgs)
 
-	mmUpdateUser.mutex.RUnlock()
+	mmUpdatePasswordHash.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockUpdateUserDone returns true if the count of the UpdateUser invocations corresponds
+// MinimockUpdatePasswordHashDone returns true if the count of the UpdatePasswordHash invocations corresponds
 // the number of defined expectations
-func (m *UsersRepositoryMock) MinimockUpdateUserDone() bool {
-	if m.UpdateUserMock.optional {
+func (m *UsersRepositoryMock) MinimockUpdatePasswordHashDone() bool {
+	if m.UpdatePasswordHashMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.UpdateUserMock.expectations {
+	for _, e := range m.UpdatePasswordHashMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.UpdateUserMock.invocationsDone()
+	return m.UpdatePasswordHashMock.invocationsDone()
 }
 
-// MinimockUpdateUserInspect logs each unmet expectation
-func (m *UsersRepositoryMock) MinimockUpdateUserInspect() {
-	for _, e := range m.UpdateUserMock.expectations {
+// MinimockUpdatePasswordHashInspect logs each unmet expectation
+func (m *UsersRepositoryMock) MinimockUpdatePasswordHashInspect() {
+	for _, e := range m.UpdatePasswordHashMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to UsersRepositoryMock.UpdateUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to UsersRepositoryMock.UpdatePasswordHash at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterUpdateUserCounter := mm_atomic.LoadUint64(&m.afterUpdateUserCounter)
+	afterUpdatePasswordHashCounter := mm_atomic.LoadUint64(&m.afterUpdatePasswordHashCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.UpdateUserMock.defaultExpectation != nil && afterUpdateUserCounter < 1 {
-		if m.UpdateUserMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to UsersRepositoryMock.UpdateUser at\n%s", m.UpdateUserMock.defaultExpectation.returnOrigin)
+	if m.UpdatePasswordHashMock.defaultExpectation != nil && afterUpdatePasswordHashCounter < 1 {
+		if m.UpdatePasswordHashMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UsersRepositoryMock.UpdatePasswordHash at\n%s", m.UpdatePasswordHashMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to UsersRepositoryMock.UpdateUser at\n%s with params: %#v", m.UpdateUserMock.defaultExpectation.expectationOrigins.origin, *m.UpdateUserMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to UsersRepositoryMock.UpdatePasswordHash at\n%s with params: %#v", m.UpdatePasswordHashMock.defaultExpectation.expectationOrigins.origin, *m.UpdatePasswordHashMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcUpdateUser != nil && afterUpdateUserCounter < 1 {
-		m.t.Errorf("Expected call to UsersRepositoryMock.UpdateUser at\n%s", m.funcUpdateUserOrigin)
+	if m.funcUpdatePasswordHash != nil && afterUpdatePasswordHashCounter < 1 {
+		m.t.Errorf("Expected call to UsersRepositoryMock.UpdatePasswordHash at\n%s", m.funcUpdatePasswordHashOrigin)
 	}
 
-	if !m.UpdateUserMock.invocationsDone() && afterUpdateUserCounter > 0 {
-		m.t.Errorf("Expected %d calls to UsersRepositoryMock.UpdateUser at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.UpdateUserMock.expectedInvocations), m.UpdateUserMock.expectedInvocationsOrigin, afterUpdateUserCounter)
+	if !m.UpdatePasswordHashMock.invocationsDone() && afterUpdatePasswordHashCounter > 0 {
+		m.t.Errorf("Expected %d calls to UsersRepositoryMock.UpdatePasswordHash at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdatePasswordHashMock.expectedInvocations), m.UpdatePasswordHashMock.expectedInvocationsOrigin, afterUpdatePasswordHashCounter)
 	}
 }
 
@@ -1477,13 +3714,25 @@ func (m *UsersRepositoryMock) MinimockUpdateUserInspect() {
 func (m *UsersRepositoryMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
+			m.MinimockApplyPendingEmailInspect()
+
+			m.MinimockCompleteRegistrationInspect()
+
+			m.MinimockCreateUserInspect()
+
+			m.MinimockDeletePendingUserInspect()
+
 			m.MinimockGetUserByEmailInspect()
 
 			m.MinimockGetUserByIDInspect()
 
-			m.MinimockSaveUserInspect()
+			m.MinimockMarkEmailVerifiedInspect()
+
+			m.MinimockSetPasswordHashInspect()
+
+			m.MinimockSetPendingEmailInspect()
 
-			m.MinimockUpdateUserInspect()
+			m.MinimockUpdatePasswordHashInspect()
 		}
 	})
 }
@@ -1507,8 +3756,14 @@ func (m *UsersRepositoryMock) MinimockWait(timeout mm_time.Duration) {
 func (m *UsersRepositoryMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockApplyPendingEmailDone() &&
+		m.MinimockCompleteRegistrationDone() &&
+		m.MinimockCreateUserDone() &&
+		m.MinimockDeletePendingUserDone() &&
 		m.MinimockGetUserByEmailDone() &&
 		m.MinimockGetUserByIDDone() &&
-		m.MinimockSaveUserDone() &&
-		m.MinimockUpdateUserDone()
+		m.MinimockMarkEmailVerifiedDone() &&
+		m.MinimockSetPasswordHashDone() &&
+		m.MinimockSetPendingEmailDone() &&
+		m.MinimockUpdatePasswordHashDone()
 }
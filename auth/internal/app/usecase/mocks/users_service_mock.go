@@ -16,12 +16,19 @@ type UsersServiceMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
-	funcCreateUser          func(ctx context.Context, userID string, nickname string) (err error)
+	funcCreateUser          func(ctx context.Context, accessToken string, userID string, nickname string) (err error)
 	funcCreateUserOrigin    string
-	inspectFuncCreateUser   func(ctx context.Context, userID string, nickname string)
+	inspectFuncCreateUser   func(ctx context.Context, accessToken string, userID string, nickname string)
 	afterCreateUserCounter  uint64
 	beforeCreateUserCounter uint64
 	CreateUserMock          mUsersServiceMockCreateUser
+
+	funcProfileExists          func(ctx context.Context, accessToken string, userID string) (b1 bool, err error)
+	funcProfileExistsOrigin    string
+	inspectFuncProfileExists   func(ctx context.Context, accessToken string, userID string)
+	afterProfileExistsCounter  uint64
+	beforeProfileExistsCounter uint64
+	ProfileExistsMock          mUsersServiceMockProfileExists
 }
 
 // NewUsersServiceMock returns a mock for mm_usecase.UsersService
@@ -35,6 +42,9 @@ func NewUsersServiceMock(t minimock.Tester) *UsersServiceMock {
 	m.CreateUserMock = mUsersServiceMockCreateUser{mock: m}
 	m.CreateUserMock.callArgs = []*UsersServiceMockCreateUserParams{}
 
+	m.ProfileExistsMock = mUsersServiceMockProfileExists{mock: m}
+	m.ProfileExistsMock.callArgs = []*UsersServiceMockProfileExistsParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
@@ -66,16 +76,18 @@ type UsersServiceMockCreateUserExpectation struct {
 
 // UsersServiceMockCreateUserParams contains parameters of the UsersService.CreateUser
 type UsersServiceMockCreateUserParams struct {
-	ctx      context.Context
-	userID   string
-	nickname string
+	ctx         context.Context
+	accessToken string
+	userID      string
+	nickname    string
 }
 
 // UsersServiceMockCreateUserParamPtrs contains pointers to parameters of the UsersService.CreateUser
 type UsersServiceMockCreateUserParamPtrs struct {
-	ctx      *context.Context
-	userID   *string
-	nickname *string
+	ctx         *context.Context
+	accessToken *string
+	userID      *string
+	nickname    *string
 }
 
 // UsersServiceMockCreateUserResults contains results of the UsersService.CreateUser
@@ -85,10 +97,11 @@ type UsersServiceMockCreateUserResults struct {
 
 // UsersServiceMockCreateUserOrigins contains origins of expectations of the UsersService.CreateUser
 type UsersServiceMockCreateUserExpectationOrigins struct {
-	origin         string
-	originCtx      string
-	originUserID   string
-	originNickname string
+	origin            string
+	originCtx         string
+	originAccessToken string
+	originUserID      string
+	originNickname    string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -102,7 +115,7 @@ func (mmCreateUser *mUsersServiceMockCreateUser) Optional() *mUsersServiceMockCr
 }
 
 // Expect sets up expected params for UsersService.CreateUser
-func (mmCreateUser *mUsersServiceMockCreateUser) Expect(ctx context.Context, userID string, nickname string) *mUsersServiceMockCreateUser {
+func (mmCreateUser *mUsersServiceMockCreateUser) Expect(ctx context.Context, accessToken string, userID string, nickname string) *mUsersServiceMockCreateUser {
 	if mmCreateUser.mock.funcCreateUser != nil {
 		mmCreateUser.mock.t.Fatalf("UsersServiceMock.CreateUser mock is already set by Set")
 	}
@@ -115,7 +128,7 @@ func (mmCreateUser *mUsersServiceMockCreateUser) Expect(ctx context.Context, use
 		mmCreateUser.mock.t.Fatalf("UsersServiceMock.CreateUser mock is already set by ExpectParams functions")
 	}
 
-	mmCreateUser.defaultExpectation.params = &UsersServiceMockCreateUserParams{ctx, userID, nickname}
+	mmCreateUser.defaultExpectation.params = &UsersServiceMockCreateUserParams{ctx, accessToken, userID, nickname}
 	mmCreateUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmCreateUser.expectations {
 		if minimock.Equal(e.params, mmCreateUser.defaultExpectation.params) {
@@ -149,8 +162,31 @@ func (mmCreateUser *mUsersServiceMockCreateUser) ExpectCtxParam1(ctx context.Con
 	return mmCreateUser
 }
 
-// ExpectUserIDParam2 sets up expected param userID for UsersService.CreateUser
-func (mmCreateUser *mUsersServiceMockCreateUser) ExpectUserIDParam2(userID string) *mUsersServiceMockCreateUser {
+// ExpectAccessTokenParam2 sets up expected param accessToken for UsersService.CreateUser
+func (mmCreateUser *mUsersServiceMockCreateUser) ExpectAccessTokenParam2(accessToken string) *mUsersServiceMockCreateUser {
+	if mmCreateUser.mock.funcCreateUser != nil {
+		mmCreateUser.mock.t.Fatalf("UsersServiceMock.CreateUser mock is already set by Set")
+	}
+
+	if mmCreateUser.defaultExpectation == nil {
+		mmCreateUser.defaultExpectation = &UsersServiceMockCreateUserExpectation{}
+	}
+
+	if mmCreateUser.defaultExpectation.params != nil {
+		mmCreateUser.mock.t.Fatalf("UsersServiceMock.CreateUser mock is already set by Expect")
+	}
+
+	if mmCreateUser.defaultExpectation.paramPtrs == nil {
+		mmCreateUser.defaultExpectation.paramPtrs = &UsersServiceMockCreateUserParamPtrs{}
+	}
+	mmCreateUser.defaultExpectation.paramPtrs.accessToken = &accessToken
+	mmCreateUser.defaultExpectation.expectationOrigins.originAccessToken = minimock.CallerInfo(1)
+
+	return mmCreateUser
+}
+
+// ExpectUserIDParam3 sets up expected param userID for UsersService.CreateUser
+func (mmCreateUser *mUsersServiceMockCreateUser) ExpectUserIDParam3(userID string) *mUsersServiceMockCreateUser {
 	if mmCreateUser.mock.funcCreateUser != nil {
 		mmCreateUser.mock.t.Fatalf("UsersServiceMock.CreateUser mock is already set by Set")
 	}
@@ -172,8 +208,8 @@ func (mmCreateUser *mUsersServiceMockCreateUser) ExpectUserIDParam2(userID strin
 	return mmCreateUser
 }
 
-// ExpectNicknameParam3 sets up expected param nickname for UsersService.CreateUser
-func (mmCreateUser *mUsersServiceMockCreateUser) ExpectNicknameParam3(nickname string) *mUsersServiceMockCreateUser {
+// ExpectNicknameParam4 sets up expected param nickname for UsersService.CreateUser
+func (mmCreateUser *mUsersServiceMockCreateUser) ExpectNicknameParam4(nickname string) *mUsersServiceMockCreateUser {
 	if mmCreateUser.mock.funcCreateUser != nil {
 		mmCreateUser.mock.t.Fatalf("UsersServiceMock.CreateUser mock is already set by Set")
 	}
@@ -196,7 +232,7 @@ func (mmCreateUser *mUsersServiceMockCreateUser) ExpectNicknameParam3(nickname s
 }
 
 // Inspect accepts an inspector function that has same arguments as the UsersService.CreateUser
-func (mmCreateUser *mUsersServiceMockCreateUser) Inspect(f func(ctx context.Context, userID string, nickname string)) *mUsersServiceMockCreateUser {
+func (mmCreateUser *mUsersServiceMockCreateUser) Inspect(f func(ctx context.Context, accessToken string, userID string, nickname string)) *mUsersServiceMockCreateUser {
 	if mmCreateUser.mock.inspectFuncCreateUser != nil {
 		mmCreateUser.mock.t.Fatalf("Inspect function is already set for UsersServiceMock.CreateUser")
 	}
@@ -221,7 +257,7 @@ func (mmCreateUser *mUsersServiceMockCreateUser) Return(err error) *UsersService
 }
 
 // Set uses given function f to mock the UsersService.CreateUser method
-func (mmCreateUser *mUsersServiceMockCreateUser) Set(f func(ctx context.Context, userID string, nickname string) (err error)) *UsersServiceMock {
+func (mmCreateUser *mUsersServiceMockCreateUser) Set(f func(ctx context.Context, accessToken string, userID string, nickname string) (err error)) *UsersServiceMock {
 	if mmCreateUser.defaultExpectation != nil {
 		mmCreateUser.mock.t.Fatalf("Default expectation is already set for the UsersService.CreateUser method")
 	}
@@ -237,14 +273,14 @@ func (mmCreateUser *mUsersServiceMockCreateUser) Set(f func(ctx context.Context,
 
 // When sets expectation for the UsersService.CreateUser which will trigger the result defined by the following
 // Then helper
-func (mmCreateUser *mUsersServiceMockCreateUser) When(ctx context.Context, userID string, nickname string) *UsersServiceMockCreateUserExpectation {
+func (mmCreateUser *mUsersServiceMockCreateUser) When(ctx context.Context, accessToken string, userID string, nickname string) *UsersServiceMockCreateUserExpectation {
 	if mmCreateUser.mock.funcCreateUser != nil {
 		mmCreateUser.mock.t.Fatalf("UsersServiceMock.CreateUser mock is already set by Set")
 	}
 
 	expectation := &UsersServiceMockCreateUserExpectation{
 		mock:               mmCreateUser.mock,
-		params:             &UsersServiceMockCreateUserParams{ctx, userID, nickname},
+		params:             &UsersServiceMockCreateUserParams{ctx, accessToken, userID, nickname},
 		expectationOrigins: UsersServiceMockCreateUserExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmCreateUser.expectations = append(mmCreateUser.expectations, expectation)
@@ -279,17 +315,17 @@ func (mmCreateUser *mUsersServiceMockCreateUser) invocationsDone() bool {
 }
 
 // CreateUser implements mm_usecase.UsersService
-func (mmCreateUser *UsersServiceMock) CreateUser(ctx context.Context, userID string, nickname string) (err error) {
+func (mmCreateUser *UsersServiceMock) CreateUser(ctx context.Context, accessToken string, userID string, nickname string) (err error) {
 	mm_atomic.AddUint64(&mmCreateUser.beforeCreateUserCounter, 1)
 	defer mm_atomic.AddUint64(&mmCreateUser.afterCreateUserCounter, 1)
 
 	mmCreateUser.t.Helper()
 
 	if mmCreateUser.inspectFuncCreateUser != nil {
-		mmCreateUser.inspectFuncCreateUser(ctx, userID, nickname)
+		mmCreateUser.inspectFuncCreateUser(ctx, accessToken, userID, nickname)
 	}
 
-	mm_params := UsersServiceMockCreateUserParams{ctx, userID, nickname}
+	mm_params := UsersServiceMockCreateUserParams{ctx, accessToken, userID, nickname}
 
 	// Record call args
 	mmCreateUser.CreateUserMock.mutex.Lock()
@@ -308,7 +344,7 @@ func (mmCreateUser *UsersServiceMock) CreateUser(ctx context.Context, userID str
 		mm_want := mmCreateUser.CreateUserMock.defaultExpectation.params
 		mm_want_ptrs := mmCreateUser.CreateUserMock.defaultExpectation.paramPtrs
 
-		mm_got := UsersServiceMockCreateUserParams{ctx, userID, nickname}
+		mm_got := UsersServiceMockCreateUserParams{ctx, accessToken, userID, nickname}
 
 		if mm_want_ptrs != nil {
 
@@ -317,6 +353,11 @@ func (mmCreateUser *UsersServiceMock) CreateUser(ctx context.Context, userID str
 					mmCreateUser.CreateUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
+			if mm_want_ptrs.accessToken != nil && !minimock.Equal(*mm_want_ptrs.accessToken, mm_got.accessToken) {
+				mmCreateUser.t.Errorf("UsersServiceMock.CreateUser got unexpected parameter accessToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateUser.CreateUserMock.defaultExpectation.expectationOrigins.originAccessToken, *mm_want_ptrs.accessToken, mm_got.accessToken, minimock.Diff(*mm_want_ptrs.accessToken, mm_got.accessToken))
+			}
+
 			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
 				mmCreateUser.t.Errorf("UsersServiceMock.CreateUser got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
 					mmCreateUser.CreateUserMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
@@ -339,9 +380,9 @@ func (mmCreateUser *UsersServiceMock) CreateUser(ctx context.Context, userID str
 		return (*mm_results).err
 	}
 	if mmCreateUser.funcCreateUser != nil {
-		return mmCreateUser.funcCreateUser(ctx, userID, nickname)
+		return mmCreateUser.funcCreateUser(ctx, accessToken, userID, nickname)
 	}
-	mmCreateUser.t.Fatalf("Unexpected call to UsersServiceMock.CreateUser. %v %v %v", ctx, userID, nickname)
+	mmCreateUser.t.Fatalf("Unexpected call to UsersServiceMock.CreateUser. %v %v %v %v", ctx, accessToken, userID, nickname)
 	return
 }
 
@@ -413,11 +454,387 @@ func (m *UsersServiceMock) MinimockCreateUserInspect() {
 	}
 }
 
+type mUsersServiceMockProfileExists struct {
+	optional           bool
+	mock               *UsersServiceMock
+	defaultExpectation *UsersServiceMockProfileExistsExpectation
+	expectations       []*UsersServiceMockProfileExistsExpectation
+
+	callArgs []*UsersServiceMockProfileExistsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UsersServiceMockProfileExistsExpectation specifies expectation struct of the UsersService.ProfileExists
+type UsersServiceMockProfileExistsExpectation struct {
+	mock               *UsersServiceMock
+	params             *UsersServiceMockProfileExistsParams
+	paramPtrs          *UsersServiceMockProfileExistsParamPtrs
+	expectationOrigins UsersServiceMockProfileExistsExpectationOrigins
+	results            *UsersServiceMockProfileExistsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UsersServiceMockProfileExistsParams contains parameters of the UsersService.ProfileExists
+type UsersServiceMockProfileExistsParams struct {
+	ctx         context.Context
+	accessToken string
+	userID      string
+}
+
+// UsersServiceMockProfileExistsParamPtrs contains pointers to parameters of the UsersService.ProfileExists
+type UsersServiceMockProfileExistsParamPtrs struct {
+	ctx         *context.Context
+	accessToken *string
+	userID      *string
+}
+
+// UsersServiceMockProfileExistsResults contains results of the UsersService.ProfileExists
+type UsersServiceMockProfileExistsResults struct {
+	b1  bool
+	err error
+}
+
+// UsersServiceMockProfileExistsOrigins contains origins of expectations of the UsersService.ProfileExists
+type UsersServiceMockProfileExistsExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originAccessToken string
+	originUserID      string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmProfileExists *mUsersServiceMockProfileExists) Optional() *mUsersServiceMockProfileExists {
+	mmProfileExists.optional = true
+	return mmProfileExists
+}
+
+// Expect sets up expected params for UsersService.ProfileExists
+func (mmProfileExists *mUsersServiceMockProfileExists) Expect(ctx context.Context, accessToken string, userID string) *mUsersServiceMockProfileExists {
+	if mmProfileExists.mock.funcProfileExists != nil {
+		mmProfileExists.mock.t.Fatalf("UsersServiceMock.ProfileExists mock is already set by Set")
+	}
+
+	if mmProfileExists.defaultExpectation == nil {
+		mmProfileExists.defaultExpectation = &UsersServiceMockProfileExistsExpectation{}
+	}
+
+	if mmProfileExists.defaultExpectation.paramPtrs != nil {
+		mmProfileExists.mock.t.Fatalf("UsersServiceMock.ProfileExists mock is already set by ExpectParams functions")
+	}
+
+	mmProfileExists.defaultExpectation.params = &UsersServiceMockProfileExistsParams{ctx, accessToken, userID}
+	mmProfileExists.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmProfileExists.expectations {
+		if minimock.Equal(e.params, mmProfileExists.defaultExpectation.params) {
+			mmProfileExists.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmProfileExists.defaultExpectation.params)
+		}
+	}
+
+	return mmProfileExists
+}
+
+// ExpectCtxParam1 sets up expected param ctx for UsersService.ProfileExists
+func (mmProfileExists *mUsersServiceMockProfileExists) ExpectCtxParam1(ctx context.Context) *mUsersServiceMockProfileExists {
+	if mmProfileExists.mock.funcProfileExists != nil {
+		mmProfileExists.mock.t.Fatalf("UsersServiceMock.ProfileExists mock is already set by Set")
+	}
+
+	if mmProfileExists.defaultExpectation == nil {
+		mmProfileExists.defaultExpectation = &UsersServiceMockProfileExistsExpectation{}
+	}
+
+	if mmProfileExists.defaultExpectation.params != nil {
+		mmProfileExists.mock.t.Fatalf("UsersServiceMock.ProfileExists mock is already set by Expect")
+	}
+
+	if mmProfileExists.defaultExpectation.paramPtrs == nil {
+		mmProfileExists.defaultExpectation.paramPtrs = &UsersServiceMockProfileExistsParamPtrs{}
+	}
+	mmProfileExists.defaultExpectation.paramPtrs.ctx = &ctx
+	mmProfileExists.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmProfileExists
+}
+
+// ExpectAccessTokenParam2 sets up expected param accessToken for UsersService.ProfileExists
+func (mmProfileExists *mUsersServiceMockProfileExists) ExpectAccessTokenParam2(accessToken string) *mUsersServiceMockProfileExists {
+	if mmProfileExists.mock.funcProfileExists != nil {
+		mmProfileExists.mock.t.Fatalf("UsersServiceMock.ProfileExists mock is already set by Set")
+	}
+
+	if mmProfileExists.defaultExpectation == nil {
+		mmProfileExists.defaultExpectation = &UsersServiceMockProfileExistsExpectation{}
+	}
+
+	if mmProfileExists.defaultExpectation.params != nil {
+		mmProfileExists.mock.t.Fatalf("UsersServiceMock.ProfileExists mock is already set by Expect")
+	}
+
+	if mmProfileExists.defaultExpectation.paramPtrs == nil {
+		mmProfileExists.defaultExpectation.paramPtrs = &UsersServiceMockProfileExistsParamPtrs{}
+	}
+	mmProfileExists.defaultExpectation.paramPtrs.accessToken = &accessToken
+	mmProfileExists.defaultExpectation.expectationOrigins.originAccessToken = minimock.CallerInfo(1)
+
+	return mmProfileExists
+}
+
+// ExpectUserIDParam3 sets up expected param userID for UsersService.ProfileExists
+func (mmProfileExists *mUsersServiceMockProfileExists) ExpectUserIDParam3(userID string) *mUsersServiceMockProfileExists {
+	if mmProfileExists.mock.funcProfileExists != nil {
+		mmProfileExists.mock.t.Fatalf("UsersServiceMock.ProfileExists mock is already set by Set")
+	}
+
+	if mmProfileExists.defaultExpectation == nil {
+		mmProfileExists.defaultExpectation = &UsersServiceMockProfileExistsExpectation{}
+	}
+
+	if mmProfileExists.defaultExpectation.params != nil {
+		mmProfileExists.mock.t.Fatalf("UsersServiceMock.ProfileExists mock is already set by Expect")
+	}
+
+	if mmProfileExists.defaultExpectation.paramPtrs == nil {
+		mmProfileExists.defaultExpectation.paramPtrs = &UsersServiceMockProfileExistsParamPtrs{}
+	}
+	mmProfileExists.defaultExpectation.paramPtrs.userID = &userID
+	mmProfileExists.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmProfileExists
+}
+
+// Inspect accepts an inspector function that has same arguments as the UsersService.ProfileExists
+func (mmProfileExists *mUsersServiceMockProfileExists) Inspect(f func(ctx context.Context, accessToken string, userID string)) *mUsersServiceMockProfileExists {
+	if mmProfileExists.mock.inspectFuncProfileExists != nil {
+		mmProfileExists.mock.t.Fatalf("Inspect function is already set for UsersServiceMock.ProfileExists")
+	}
+
+	mmProfileExists.mock.inspectFuncProfileExists = f
+
+	return mmProfileExists
+}
+
+// Return sets up results that will be returned by UsersService.ProfileExists
+func (mmProfileExists *mUsersServiceMockProfileExists) Return(b1 bool, err error) *UsersServiceMock {
+	if mmProfileExists.mock.funcProfileExists != nil {
+		mmProfileExists.mock.t.Fatalf("UsersServiceMock.ProfileExists mock is already set by Set")
+	}
+
+	if mmProfileExists.defaultExpectation == nil {
+		mmProfileExists.defaultExpectation = &UsersServiceMockProfileExistsExpectation{mock: mmProfileExists.mock}
+	}
+	mmProfileExists.defaultExpectation.results = &UsersServiceMockProfileExistsResults{b1, err}
+	mmProfileExists.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmProfileExists.mock
+}
+
+// Set uses given function f to mock the UsersService.ProfileExists method
+func (mmProfileExists *mUsersServiceMockProfileExists) Set(f func(ctx context.Context, accessToken string, userID string) (b1 bool, err error)) *UsersServiceMock {
+	if mmProfileExists.defaultExpectation != nil {
+		mmProfileExists.mock.t.Fatalf("Default expectation is already set for the UsersService.ProfileExists method")
+	}
+
+	if len(mmProfileExists.expectations) > 0 {
+		mmProfileExists.mock.t.Fatalf("Some expectations are already set for the UsersService.ProfileExists method")
+	}
+
+	mmProfileExists.mock.funcProfileExists = f
+	mmProfileExists.mock.funcProfileExistsOrigin = minimock.CallerInfo(1)
+	return mmProfileExists.mock
+}
+
+// When sets expectation for the UsersService.ProfileExists which will trigger the result defined by the following
+// Then helper
+func (mmProfileExists *mUsersServiceMockProfileExists) When(ctx context.Context, accessToken string, userID string) *UsersServiceMockProfileExistsExpectation {
+	if mmProfileExists.mock.funcProfileExists != nil {
+		mmProfileExists.mock.t.Fatalf("UsersServiceMock.ProfileExists mock is already set by Set")
+	}
+
+	expectation := &UsersServiceMockProfileExistsExpectation{
+		mock:               mmProfileExists.mock,
+		params:             &UsersServiceMockProfileExistsParams{ctx, accessToken, userID},
+		expectationOrigins: UsersServiceMockProfileExistsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmProfileExists.expectations = append(mmProfileExists.expectations, expectation)
+	return expectation
+}
+
+// Then sets up UsersService.ProfileExists return parameters for the expectation previously defined by the When method
+func (e *UsersServiceMockProfileExistsExpectation) Then(b1 bool, err error) *UsersServiceMock {
+	e.results = &UsersServiceMockProfileExistsResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times UsersService.ProfileExists should be invoked
+func (mmProfileExists *mUsersServiceMockProfileExists) Times(n uint64) *mUsersServiceMockProfileExists {
+	if n == 0 {
+		mmProfileExists.mock.t.Fatalf("Times of UsersServiceMock.ProfileExists mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmProfileExists.expectedInvocations, n)
+	mmProfileExists.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmProfileExists
+}
+
+func (mmProfileExists *mUsersServiceMockProfileExists) invocationsDone() bool {
+	if len(mmProfileExists.expectations) == 0 && mmProfileExists.defaultExpectation == nil && mmProfileExists.mock.funcProfileExists == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmProfileExists.mock.afterProfileExistsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmProfileExists.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ProfileExists implements mm_usecase.UsersService
+func (mmProfileExists *UsersServiceMock) ProfileExists(ctx context.Context, accessToken string, userID string) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmProfileExists.beforeProfileExistsCounter, 1)
+	defer mm_atomic.AddUint64(&mmProfileExists.afterProfileExistsCounter, 1)
+
+	mmProfileExists.t.Helper()
+
+	if mmProfileExists.inspectFuncProfileExists != nil {
+		mmProfileExists.inspectFuncProfileExists(ctx, accessToken, userID)
+	}
+
+	mm_params := UsersServiceMockProfileExistsParams{ctx, accessToken, userID}
+
+	// Record call args
+	mmProfileExists.ProfileExistsMock.mutex.Lock()
+	mmProfileExists.ProfileExistsMock.callArgs = append(mmProfileExists.ProfileExistsMock.callArgs, &mm_params)
+	mmProfileExists.ProfileExistsMock.mutex.Unlock()
+
+	for _, e := range mmProfileExists.ProfileExistsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmProfileExists.ProfileExistsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmProfileExists.ProfileExistsMock.defaultExpectation.Counter, 1)
+		mm_want := mmProfileExists.ProfileExistsMock.defaultExpectation.params
+		mm_want_ptrs := mmProfileExists.ProfileExistsMock.defaultExpectation.paramPtrs
+
+		mm_got := UsersServiceMockProfileExistsParams{ctx, accessToken, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmProfileExists.t.Errorf("UsersServiceMock.ProfileExists got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmProfileExists.ProfileExistsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.accessToken != nil && !minimock.Equal(*mm_want_ptrs.accessToken, mm_got.accessToken) {
+				mmProfileExists.t.Errorf("UsersServiceMock.ProfileExists got unexpected parameter accessToken, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmProfileExists.ProfileExistsMock.defaultExpectation.expectationOrigins.originAccessToken, *mm_want_ptrs.accessToken, mm_got.accessToken, minimock.Diff(*mm_want_ptrs.accessToken, mm_got.accessToken))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmProfileExists.t.Errorf("UsersServiceMock.ProfileExists got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmProfileExists.ProfileExistsMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmProfileExists.t.Errorf("UsersServiceMock.ProfileExists got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmProfileExists.ProfileExistsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmProfileExists.ProfileExistsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmProfileExists.t.Fatal("No results are set for the UsersServiceMock.ProfileExists")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmProfileExists.funcProfileExists != nil {
+		return mmProfileExists.funcProfileExists(ctx, accessToken, userID)
+	}
+	mmProfileExists.t.Fatalf("Unexpected call to UsersServiceMock.ProfileExists. %v %v %v", ctx, accessToken, userID)
+	return
+}
+
+// ProfileExistsAfterCounter returns a count of finished UsersServiceMock.ProfileExists invocations
+func (mmProfileExists *UsersServiceMock) ProfileExistsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmProfileExists.afterProfileExistsCounter)
+}
+
+// ProfileExistsBeforeCounter returns a count of UsersServiceMock.ProfileExists invocations
+func (mmProfileExists *UsersServiceMock) ProfileExistsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmProfileExists.beforeProfileExistsCounter)
+}
+
+// Calls returns a list of arguments used in each call to UsersServiceMock.ProfileExists.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmProfileExists *mUsersServiceMockProfileExists) Calls() []*UsersServiceMockProfileExistsParams {
+	mmProfileExists.mutex.RLock()
+
+	argCopy := make([]*UsersServiceMockProfileExistsParams, len(mmProfileExists.callArgs))
+	copy(argCopy, mmProfileExists.callArgs)
+
+	mmProfileExists.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockProfileExistsDone returns true if the count of the ProfileExists invocations corresponds
+// the number of defined expectations
+func (m *UsersServiceMock) MinimockProfileExistsDone() bool {
+	if m.ProfileExistsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ProfileExistsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ProfileExistsMock.invocationsDone()
+}
+
+// MinimockProfileExistsInspect logs each unmet expectation
+func (m *UsersServiceMock) MinimockProfileExistsInspect() {
+	for _, e := range m.ProfileExistsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UsersServiceMock.ProfileExists at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterProfileExistsCounter := mm_atomic.LoadUint64(&m.afterProfileExistsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ProfileExistsMock.defaultExpectation != nil && afterProfileExistsCounter < 1 {
+		if m.ProfileExistsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UsersServiceMock.ProfileExists at\n%s", m.ProfileExistsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UsersServiceMock.ProfileExists at\n%s with params: %#v", m.ProfileExistsMock.defaultExpectation.expectationOrigins.origin, *m.ProfileExistsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcProfileExists != nil && afterProfileExistsCounter < 1 {
+		m.t.Errorf("Expected call to UsersServiceMock.ProfileExists at\n%s", m.funcProfileExistsOrigin)
+	}
+
+	if !m.ProfileExistsMock.invocationsDone() && afterProfileExistsCounter > 0 {
+		m.t.Errorf("Expected %d calls to UsersServiceMock.ProfileExists at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ProfileExistsMock.expectedInvocations), m.ProfileExistsMock.expectedInvocationsOrigin, afterProfileExistsCounter)
+	}
+}
+
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *UsersServiceMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
 			m.MinimockCreateUserInspect()
+
+			m.MinimockProfileExistsInspect()
 		}
 	})
 }
@@ -441,5 +858,6 @@ func (m *UsersServiceMock) MinimockWait(timeout mm_time.Duration) {
 func (m *UsersServiceMock) minimockDone() bool {
 	done := true
 	return done &&
-		m.MinimockCreateUserDone()
+		m.MinimockCreateUserDone() &&
+		m.MinimockProfileExistsDone()
 }
@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/sskorolev/balun_microservices/lib/logger"
+
+	"auth/internal/app/mail"
+	"auth/internal/app/models"
+	"auth/internal/app/usecase/dto"
+)
+
+const (
+	apiRequestPasswordReset = "[AuthService][RequestPasswordReset]"
+	apiResetPassword        = "[AuthService][ResetPassword]"
+)
+
+func (s *AuthService) RequestPasswordReset(ctx context.Context, email string) error {
+	user, err := s.usersRepo.GetUserByEmail(ctx, email)
+	if err != nil && !errors.Is(err, models.ErrNotFound) {
+		return fmt.Errorf("%s: userRepo GetUserByEmail error: %w", apiRequestPasswordReset, err)
+	}
+	// Ответ не должен раскрывать, зарегистрирован ли email
+	if user == nil || user.RegistrationStatus == models.RegistrationStatusPending {
+		logger.InfoKV(ctx, "password reset requested for unknown email")
+		return nil
+	}
+
+	token, err := s.issueActionToken(ctx, user.ID, models.ActionTokenPasswordReset, s.cfg.PasswordReset.TTL)
+	if err != nil {
+		return fmt.Errorf("%s: failed to issue reset token: %w", apiRequestPasswordReset, err)
+	}
+
+	err = s.mailSender.Send(ctx, mail.Message{
+		To:      user.Email,
+		Subject: "Сброс пароля",
+		Body: fmt.Sprintf("Чтобы задать новый пароль, перейдите по ссылке:\n%s\n\n"+
+			"Ссылка действует %s. Если вы не запрашивали сброс пароля, проигнорируйте это письмо.\n",
+			actionLink(s.cfg.PasswordReset.URL, token), s.cfg.PasswordReset.TTL),
+	})
+	if err != nil {
+		return fmt.Errorf("%s: failed to send reset mail: %w", apiRequestPasswordReset, err)
+	}
+
+	logger.InfoKV(ctx, "password reset sent", "user_id", user.ID)
+	return nil
+}
+
+func (s *AuthService) ResetPassword(ctx context.Context, req dto.ResetPasswordRequest) error {
+	// Хешируем до погашения токена, чтобы слишком короткий пароль не сжигал ссылку
+	passwordHash, err := s.passwordHasher.Hash(req.NewPassword)
+	if err != nil {
+		return fmt.Errorf("%s: failed to hash password: %w", apiResetPassword, err)
+	}
+
+	var userID string
+	err = s.txManager.RunReadCommitted(ctx, func(txCtx context.Context) error {
+		actionToken, err := s.useActionToken(txCtx, models.ActionTokenPasswordReset, req.Token)
+		if err != nil {
+			return err
+		}
+		userID = actionToken.UserID
+
+		// Обновляется только хеш: email мог смениться параллельно через ConfirmEmail
+		if err := s.usersRepo.SetPasswordHash(txCtx, userID, passwordHash); err != nil {
+			return err
+		}
+
+		// Ссылка пришла на email пользователя, значит email подтвержден
+		if err := s.usersRepo.MarkEmailVerified(txCtx, userID); err != nil {
+			return err
+		}
+		if err := s.actionTokensRepo.InvalidateActionTokens(txCtx, userID, models.ActionTokenPasswordReset); err != nil {
+			return err
+		}
+
+		_, err = s.revokeAllUserTokens(txCtx, userID)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", apiResetPassword, err)
+	}
+
+	logger.InfoKV(ctx, "password reset completed, sessions revoked", "user_id", userID)
+	return nil
+}
+
+// revokeAllUserTokens отзывает refresh токены пользователя на всех устройствах и уже выданные access токены,
+// возвращает количество отозванных refresh токенов
+func (s *AuthService) revokeAllUserTokens(ctx context.Context, userID string) (int64, error) {
+	revoked, err := s.refreshTokensRepo.RevokeUserTokens(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now()
+	err = s.revocationsRepo.RevokeUserAccessTokens(ctx, &models.AccessTokenWatermark{
+		UserID:    userID,
+		NotBefore: now,
+		ExpiresAt: now.Add(s.cfg.AccessTokenTTL),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return revoked, nil
+}
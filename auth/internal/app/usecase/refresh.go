@@ -2,9 +2,12 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/sskorolev/balun_microservices/lib/logger"
+
 	"auth/internal/app/crypto"
 	"auth/internal/app/models"
 	"auth/internal/app/usecase/dto"
@@ -28,45 +31,47 @@ func (s *AuthService) Refresh(ctx context.Context, req dto.RefreshRequest) (*mod
 		return nil, fmt.Errorf("%s: failed to get token from DB: %w", apiRefresh, err)
 	}
 
-	// 3. Проверка: токен уже использован (anti-reuse)
+	// 3. Проверка: хеш совпадает
+	if storedToken.TokenHash != tokenHash {
+		return nil, ErrInvalidToken
+	}
+
+	// 4. Проверка: токен отозван (logout или отзыв семейства)
+	if storedToken.RevokedAt != nil {
+		return nil, ErrTokenRevoked
+	}
+
+	// 5. Проверка: токен уже использован (reuse detection)
 	if storedToken.UsedAt != nil {
+		if err := s.revokeTokenFamily(ctx, storedToken); err != nil {
+			return nil, fmt.Errorf("%s: failed to revoke token family: %w", apiRefresh, err)
+		}
 		return nil, ErrTokenUsed
 	}
 
-	// 4. Проверка: токен истек
+	// 6. Проверка: токен истек
 	if storedToken.ExpiresAt.Before(time.Now()) {
 		return nil, ErrTokenExpired
 	}
 
-	// 5. Проверка: хеш совпадает
-	if storedToken.TokenHash != tokenHash {
-		return nil, ErrInvalidToken
-	}
-
-	// 6. Получаем пользователя
+	// 7. Получаем пользователя
 	user, err := s.usersRepo.GetUserByID(ctx, claims.Subject)
 	if err != nil {
 		return nil, fmt.Errorf("%s: failed to get user: %w", apiRefresh, err)
 	}
 
-	// 7. Создаем новый access token
+	// 8. Создаем новый access token
 	newAccessToken, err := s.tokenManager.CreateAccessToken(ctx, user.ID)
 	if err != nil {
 		return nil, fmt.Errorf("%s: failed to create access token: %w", apiRefresh, err)
 	}
 
-	// 8. Создаем новый refresh token
+	// 9. Создаем новый refresh token
 	newRefreshToken, newJTI, err := s.tokenManager.CreateRefreshToken(ctx, user.ID, req.DeviceID)
 	if err != nil {
 		return nil, fmt.Errorf("%s: failed to create refresh token: %w", apiRefresh, err)
 	}
 
-	// 9. Помечаем старый токен как использованный
-	if err := s.refreshTokensRepo.MarkAsUsed(ctx, claims.JWTID, newJTI); err != nil {
-		return nil, fmt.Errorf("%s: failed to mark token as used: %w", apiRefresh, err)
-	}
-
-	// 10. Сохраняем новый refresh token
 	var deviceIDPtr *string
 	if req.DeviceID != "" {
 		deviceIDPtr = &req.DeviceID
@@ -74,16 +79,31 @@ func (s *AuthService) Refresh(ctx context.Context, req dto.RefreshRequest) (*mod
 
 	newTokenHash := crypto.HashToken(newRefreshToken)
 	newRefreshTokenModel := &models.RefreshToken{
-		UserID:    user.ID,
-		TokenHash: newTokenHash,
-		JTI:       newJTI,
-		DeviceID:  deviceIDPtr,
-		ExpiresAt: time.Now().Add(s.cfg.RefreshTokenTTL),
-		CreatedAt: time.Now(),
+		UserID:           user.ID,
+		TokenHash:        newTokenHash,
+		JTI:              newJTI,
+		DeviceID:         deviceIDPtr,
+		ExpiresAt:        time.Now().Add(s.cfg.RefreshTokenTTL),
+		SessionStartedAt: storedToken.SessionStartedAt,
+		CreatedAt:        time.Now(),
 	}
 
-	if err := s.refreshTokensRepo.CreateToken(ctx, newRefreshTokenModel); err != nil {
-		return nil, fmt.Errorf("%s: failed to save new refresh token: %w", apiRefresh, err)
+	// 10. Помечаем старый токен как использованный и сохраняем новый в одной транзакции
+	err = s.txManager.RunReadCommitted(ctx, func(txCtx context.Context) error {
+		if err := s.refreshTokensRepo.MarkAsUsed(txCtx, claims.JWTID, newJTI); err != nil {
+			return fmt.Errorf("failed to mark token as used: %w", err)
+		}
+		if err := s.refreshTokensRepo.CreateToken(txCtx, newRefreshTokenModel); err != nil {
+			return fmt.Errorf("failed to save new refresh token: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		// Токен успели использовать параллельным запросом
+		if errors.Is(err, models.ErrTokenAlreadyUsed) {
+			return nil, ErrTokenUsed
+		}
+		return nil, fmt.Errorf("%s: %w", apiRefresh, err)
 	}
 
 	// 11. Заполняем токены в пользователя
@@ -95,3 +115,52 @@ func (s *AuthService) Refresh(ctx context.Context, req dto.RefreshRequest) (*mod
 
 	return user, nil
 }
+
+// revokeTokenFamily отзывает использованный токен и всех его потомков по цепочке ротации,
+// уже выданные access токены пользователя и записывает событие в журнал аудита
+func (s *AuthService) revokeTokenFamily(ctx context.Context, token *models.RefreshToken) error {
+	return s.txManager.RunReadCommitted(ctx, func(txCtx context.Context) error {
+		revoked, err := s.refreshTokensRepo.RevokeTokenFamily(txCtx, token.JTI)
+		if err != nil {
+			return err
+		}
+
+		// Access токен мог быть выдан по украденному refresh токену, отзываем все
+		now := time.Now()
+		if err := s.revocationsRepo.RevokeUserAccessTokens(txCtx, &models.AccessTokenWatermark{
+			UserID:    token.UserID,
+			NotBefore: now,
+			ExpiresAt: now.Add(s.cfg.AccessTokenTTL),
+		}); err != nil {
+			return err
+		}
+
+		details := map[string]any{
+			"jti":          token.JTI,
+			"revoked_jtis": revoked,
+		}
+		if token.DeviceID != nil {
+			details["device_id"] = *token.DeviceID
+		}
+		if token.UsedAt != nil {
+			details["used_at"] = token.UsedAt.UTC().Format(time.RFC3339Nano)
+		}
+
+		userID := token.UserID
+		if err := s.auditLogRepo.SaveAuditEvent(txCtx, &models.AuditEvent{
+			UserID:    &userID,
+			Type:      models.AuditEventRefreshTokenReuse,
+			Details:   details,
+			CreatedAt: now,
+		}); err != nil {
+			return err
+		}
+
+		logger.WarnKV(txCtx, "refresh token reuse detected, token family revoked",
+			"user_id", token.UserID,
+			"jti", token.JTI,
+			"revoked", len(revoked),
+		)
+		return nil
+	})
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
+
+	"github.com/sskorolev/balun_microservices/lib/logger"
 
 	"auth/internal/app/usecase/dto"
 
@@ -12,6 +15,10 @@ import (
 
 const (
 	apiRegister = "[AuthService][Register]"
+
+	// pendingRegistrationTimeout - после этого незавершенная регистрация считается брошенной
+	// и разбирается при следующей попытке зарегистрировать тот же email
+	pendingRegistrationTimeout = time.Minute
 )
 
 func (s *AuthService) Register(ctx context.Context, req dto.RegisterRequest) (*models.User, error) {
@@ -21,7 +28,9 @@ func (s *AuthService) Register(ctx context.Context, req dto.RegisterRequest) (*m
 		return nil, fmt.Errorf("%s: userRepo GetUserByEmail error: %w", apiRegister, err)
 	}
 	if existingUser != nil {
-		return nil, models.ErrAlreadyExists
+		if err := s.resolvePendingRegistration(ctx, existingUser); err != nil {
+			return nil, err
+		}
 	}
 
 	// Хешируем пароль
@@ -30,17 +39,110 @@ func (s *AuthService) Register(ctx context.Context, req dto.RegisterRequest) (*m
 		return nil, fmt.Errorf("%s: failed to hash password: %w", apiRegister, err)
 	}
 
-	// Создаем пользователя в БД
+	// Шаг 1: пользователь в auth в статусе pending
 	user, err := s.usersRepo.CreateUser(ctx, req.Email, passwordHash)
 	if err != nil {
 		return nil, fmt.Errorf("%s: userRepo CreateUser error: %w", apiRegister, err)
 	}
 
-	// Создаем профиль в users сервисе
-	err = s.usersService.CreateUser(ctx, user.ID, user.Email)
-	if err != nil {
-		return nil, fmt.Errorf("%s: usersService CreateUser error: %w", apiRegister, err)
+	// Шаг 2: профиль в users сервисе
+	if err := s.createProfile(ctx, user, req.Nickname); err != nil {
+		return nil, err
+	}
+
+	// Шаг 3: регистрация завершена
+	if err := s.usersRepo.CompleteRegistration(ctx, user.ID); err != nil {
+		return nil, fmt.Errorf("%s: userRepo CompleteRegistration error: %w", apiRegister, err)
+	}
+	user.RegistrationStatus = models.RegistrationStatusCompleted
+
+	// Письмо можно запросить повторно через RequestEmailVerification, регистрацию не откатываем
+	if err := s.sendEmailVerification(ctx, user); err != nil {
+		logger.WarnKV(ctx, "registration: failed to send email verification",
+			"user_id", user.ID,
+			"error", err.Error(),
+		)
 	}
 
 	return user, nil
 }
+
+// createProfile создает профиль в users, при неудаче удаляет пользователя из auth
+func (s *AuthService) createProfile(ctx context.Context, user *models.User, nickname string) error {
+	// users принимает только запросы с JWT, выпускаем его для нового пользователя
+	accessToken, err := s.tokenManager.CreateAccessToken(ctx, user.ID)
+	if err != nil {
+		s.compensateRegistration(ctx, user.ID)
+		return fmt.Errorf("%s: failed to create access token: %w", apiRegister, err)
+	}
+
+	createErr := s.usersService.CreateUser(ctx, accessToken, user.ID, nickname)
+	if createErr == nil {
+		return nil
+	}
+
+	// Ответ мог потеряться после создания профиля (таймаут), поэтому проверяем его наличие
+	exists, err := s.usersService.ProfileExists(ctx, accessToken, user.ID)
+	if err != nil {
+		// Исход неизвестен: пользователь остается pending и будет разобран следующей регистрацией
+		logger.ErrorKV(ctx, "registration: failed to check users profile, leaving pending",
+			"user_id", user.ID,
+			"error", err.Error(),
+		)
+		return fmt.Errorf("%s: usersService CreateUser error: %w", apiRegister, createErr)
+	}
+	if exists {
+		return nil
+	}
+
+	s.compensateRegistration(ctx, user.ID)
+
+	if errors.Is(createErr, models.ErrAlreadyExists) {
+		return models.ErrNicknameTaken
+	}
+	return fmt.Errorf("%s: usersService CreateUser error: %w", apiRegister, createErr)
+}
+
+// compensateRegistration удаляет пользователя с незавершенной регистрацией, чтобы email освободился
+func (s *AuthService) compensateRegistration(ctx context.Context, userID string) {
+	if err := s.usersRepo.DeletePendingUser(ctx, userID); err != nil {
+		logger.ErrorKV(ctx, "registration: compensation failed", "user_id", userID, "error", err.Error())
+		return
+	}
+	logger.InfoKV(ctx, "registration: compensated", "user_id", userID)
+}
+
+// resolvePendingRegistration разбирает существующего пользователя с тем же email:
+// брошенную регистрацию завершает, если профиль создан, иначе удаляет
+//
+// Возвращает nil, если email свободен для новой регистрации.
+func (s *AuthService) resolvePendingRegistration(ctx context.Context, user *models.User) error {
+	if user.RegistrationStatus != models.RegistrationStatusPending ||
+		time.Since(user.CreatedAt) < pendingRegistrationTimeout {
+		return models.ErrAlreadyExists
+	}
+
+	accessToken, err := s.tokenManager.CreateAccessToken(ctx, user.ID)
+	if err != nil {
+		return fmt.Errorf("%s: failed to create access token: %w", apiRegister, err)
+	}
+
+	exists, err := s.usersService.ProfileExists(ctx, accessToken, user.ID)
+	if err != nil {
+		return fmt.Errorf("%s: usersService ProfileExists error: %w", apiRegister, err)
+	}
+
+	if exists {
+		if err := s.usersRepo.CompleteRegistration(ctx, user.ID); err != nil {
+			return fmt.Errorf("%s: userRepo CompleteRegistration error: %w", apiRegister, err)
+		}
+		return models.ErrAlreadyExists
+	}
+
+	if err := s.usersRepo.DeletePendingUser(ctx, user.ID); err != nil {
+		return fmt.Errorf("%s: userRepo DeletePendingUser error: %w", apiRegister, err)
+	}
+	logger.InfoKV(ctx, "registration: abandoned pending user removed", "user_id", user.ID)
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"auth/internal/app/models"
+	"auth/internal/app/usecase/dto"
+	"auth/internal/app/usecase/mocks"
+)
+
+func TestAuthService_RegisterSaga(t *testing.T) {
+	const abandonedUserID = "0b6f1d2e-9c35-4b8a-8f3e-2a7d4c1e5f60"
+
+	errUsers := errors.New("users unavailable")
+
+	pendingUser := func(createdAt time.Time) *models.User {
+		return &models.User{
+			ID:                 abandonedUserID,
+			Email:              testEmail,
+			RegistrationStatus: models.RegistrationStatusPending,
+			CreatedAt:          createdAt,
+		}
+	}
+	// createUser - шаг 1 саги для нового пользователя
+	createUser := func(repo *mocks.UsersRepositoryMock) {
+		repo.CreateUserMock.Return(&models.User{
+			ID:                 testUserID,
+			Email:              testEmail,
+			RegistrationStatus: models.RegistrationStatusPending,
+		}, nil)
+	}
+
+	tests := []struct {
+		name          string
+		setupMocks    func(context.Context, *mocks.UsersRepositoryMock, *mocks.UsersServiceMock)
+		expectedError error
+	}{
+		{
+			name: "профиль не создан - пользователь в auth удаляется",
+			setupMocks: func(ctx context.Context, repo *mocks.UsersRepositoryMock, service *mocks.UsersServiceMock) {
+				repo.GetUserByEmailMock.Return(nil, models.ErrNotFound)
+				createUser(repo)
+				service.CreateUserMock.Return(errUsers)
+				service.ProfileExistsMock.ExpectUserIDParam3(testUserID).Return(false, nil)
+				repo.DeletePendingUserMock.Expect(ctx, testUserID).Return(nil)
+			},
+			expectedError: errUsers,
+		},
+		{
+			name: "никнейм занят - пользователь в auth удаляется",
+			setupMocks: func(ctx context.Context, repo *mocks.UsersRepositoryMock, service *mocks.UsersServiceMock) {
+				repo.GetUserByEmailMock.Return(nil, models.ErrNotFound)
+				createUser(repo)
+				service.CreateUserMock.Return(models.ErrAlreadyExists)
+				service.ProfileExistsMock.ExpectUserIDParam3(testUserID).Return(false, nil)
+				repo.DeletePendingUserMock.Expect(ctx, testUserID).Return(nil)
+			},
+			expectedError: models.ErrNicknameTaken,
+		},
+		{
+			name: "ответ users потерян, но профиль создан - регистрация завершается",
+			setupMocks: func(ctx context.Context, repo *mocks.UsersRepositoryMock, service *mocks.UsersServiceMock) {
+				repo.GetUserByEmailMock.Return(nil, models.ErrNotFound)
+				createUser(repo)
+				service.CreateUserMock.Return(errUsers)
+				service.ProfileExistsMock.ExpectUserIDParam3(testUserID).Return(true, nil)
+				repo.CompleteRegistrationMock.Expect(ctx, testUserID).Return(nil)
+			},
+		},
+		{
+			name: "исход неизвестен - пользователь остается pending",
+			setupMocks: func(ctx context.Context, repo *mocks.UsersRepositoryMock, service *mocks.UsersServiceMock) {
+				repo.GetUserByEmailMock.Return(nil, models.ErrNotFound)
+				createUser(repo)
+				service.CreateUserMock.Return(errUsers)
+				service.ProfileExistsMock.Return(false, errors.New("timeout"))
+			},
+			expectedError: errUsers,
+		},
+		{
+			name: "свежая pending регистрация занимает email",
+			setupMocks: func(ctx context.Context, repo *mocks.UsersRepositoryMock, service *mocks.UsersServiceMock) {
+				repo.GetUserByEmailMock.Return(pendingUser(time.Now()), nil)
+			},
+			expectedError: models.ErrAlreadyExists,
+		},
+		{
+			name: "брошенная регистрация с профилем завершается",
+			setupMocks: func(ctx context.Context, repo *mocks.UsersRepositoryMock, service *mocks.UsersServiceMock) {
+				repo.GetUserByEmailMock.Return(pendingUser(time.Now().Add(-2*pendingRegistrationTimeout)), nil)
+				service.ProfileExistsMock.ExpectUserIDParam3(abandonedUserID).Return(true, nil)
+				repo.CompleteRegistrationMock.Expect(ctx, abandonedUserID).Return(nil)
+			},
+			expectedError: models.ErrAlreadyExists,
+		},
+		{
+			name: "брошенная регистрация без профиля удаляется, email регистрируется заново",
+			setupMocks: func(ctx context.Context, repo *mocks.UsersRepositoryMock, service *mocks.UsersServiceMock) {
+				repo.GetUserByEmailMock.Return(pendingUser(time.Now().Add(-2*pendingRegistrationTimeout)), nil)
+				service.ProfileExistsMock.ExpectUserIDParam3(abandonedUserID).Return(false, nil)
+				repo.DeletePendingUserMock.Expect(ctx, abandonedUserID).Return(nil)
+				createUser(repo)
+				service.CreateUserMock.ExpectUserIDParam3(testUserID).Return(nil)
+				repo.CompleteRegistrationMock.Expect(ctx, testUserID).Return(nil)
+			},
+		},
+		{
+			name: "не удалось проверить профиль брошенной регистрации",
+			setupMocks: func(ctx context.Context, repo *mocks.UsersRepositoryMock, service *mocks.UsersServiceMock) {
+				repo.GetUserByEmailMock.Return(pendingUser(time.Now().Add(-2*pendingRegistrationTimeout)), nil)
+				service.ProfileExistsMock.Return(false, errUsers)
+			},
+			expectedError: errUsers,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			s, env := newTestService(t, testConfig())
+			tt.setupMocks(ctx, env.usersRepo, env.usersService)
+
+			user, err := s.Register(ctx, dto.RegisterRequest{
+				Email:    testEmail,
+				Password: testPassword,
+				Nickname: "tester",
+			})
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, user)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testUserID, user.ID)
+			assert.Equal(t, models.RegistrationStatusCompleted, user.RegistrationStatus)
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"auth/internal/app/usecase/dto"
+)
+
+const (
+	apiGetRevocations = "[AuthService][GetRevocations]"
+)
+
+func (s *AuthService) GetRevocations(ctx context.Context) (*dto.RevocationsResponse, error) {
+	tokens, watermarks, err := s.revocationsRepo.ListActiveRevocations(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to list revocations: %w", apiGetRevocations, err)
+	}
+
+	return &dto.RevocationsResponse{
+		Tokens:     tokens,
+		Watermarks: watermarks,
+	}, nil
+}
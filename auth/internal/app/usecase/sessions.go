@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"auth/internal/app/models"
+	"auth/internal/app/usecase/dto"
+)
+
+const (
+	apiListSessions           = "[AuthService][ListSessions]"
+	apiRevokeSession          = "[AuthService][RevokeSession]"
+	apiRevokeAllOtherSessions = "[AuthService][RevokeAllOtherSessions]"
+)
+
+func (s *AuthService) ListSessions(ctx context.Context, userID string) ([]*models.Session, error) {
+	sessions, err := s.refreshTokensRepo.ListActiveSessions(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to list sessions: %w", apiListSessions, err)
+	}
+
+	return sessions, nil
+}
+
+func (s *AuthService) RevokeSession(ctx context.Context, req dto.RevokeSessionRequest) (int64, error) {
+	revoked, err := s.refreshTokensRepo.RevokeDeviceTokens(ctx, req.UserID, req.DeviceID)
+	if err != nil {
+		return 0, fmt.Errorf("%s: failed to revoke device tokens: %w", apiRevokeSession, err)
+	}
+
+	if revoked == 0 {
+		return 0, ErrSessionNotFound
+	}
+
+	return revoked, nil
+}
+
+func (s *AuthService) RevokeAllOtherSessions(ctx context.Context, req dto.RevokeAllOtherSessionsRequest) (int64, error) {
+	revoked, err := s.refreshTokensRepo.RevokeOtherDeviceTokens(ctx, req.UserID, req.CurrentDeviceID)
+	if err != nil {
+		return 0, fmt.Errorf("%s: failed to revoke other device tokens: %w", apiRevokeAllOtherSessions, err)
+	}
+
+	return revoked, nil
+}
@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"auth/internal/app/usecase/dto"
+)
+
+func TestAuthService_Sessions(t *testing.T) {
+	ctx := context.Background()
+
+	// Вход с телефона, ноутбука и без device_id
+	setup := func(t *testing.T) (*AuthService, *testEnv) {
+		s, env := newTestService(t, testConfig())
+		user := env.testUser(t)
+		for _, deviceID := range []string{"phone", "laptop", ""} {
+			env.login(t, s, user, deviceID)
+		}
+		return s, env
+	}
+
+	deviceIDs := func(t *testing.T, s *AuthService) []string {
+		sessions, err := s.ListSessions(ctx, testUserID)
+		require.NoError(t, err)
+		ids := make([]string, 0, len(sessions))
+		for _, session := range sessions {
+			ids = append(ids, session.DeviceID)
+		}
+		return ids
+	}
+
+	t.Run("сессии по устройствам", func(t *testing.T) {
+		s, _ := setup(t)
+		assert.ElementsMatch(t, []string{"phone", "laptop", ""}, deviceIDs(t, s))
+	})
+
+	tests := []struct {
+		name          string
+		revoke        func(*AuthService) (int64, error)
+		expectedError error
+		revoked       int64
+		remaining     []string
+	}{
+		{
+			name: "отзыв сессии устройства",
+			revoke: func(s *AuthService) (int64, error) {
+				return s.RevokeSession(ctx, dto.RevokeSessionRequest{UserID: testUserID, DeviceID: "laptop"})
+			},
+			revoked:   1,
+			remaining: []string{"phone", ""},
+		},
+		{
+			name: "отзыв неизвестного устройства",
+			revoke: func(s *AuthService) (int64, error) {
+				return s.RevokeSession(ctx, dto.RevokeSessionRequest{UserID: testUserID, DeviceID: "tablet"})
+			},
+			expectedError: ErrSessionNotFound,
+			remaining:     []string{"phone", "laptop", ""},
+		},
+		{
+			name: "чужое устройство не отзывается",
+			revoke: func(s *AuthService) (int64, error) {
+				return s.RevokeSession(ctx, dto.RevokeSessionRequest{UserID: "other-user", DeviceID: "phone"})
+			},
+			expectedError: ErrSessionNotFound,
+			remaining:     []string{"phone", "laptop", ""},
+		},
+		{
+			name: "отзыв всех, кроме текущего, включая вход без device_id",
+			revoke: func(s *AuthService) (int64, error) {
+				return s.RevokeAllOtherSessions(ctx, dto.RevokeAllOtherSessionsRequest{UserID: testUserID, CurrentDeviceID: "phone"})
+			},
+			revoked:   2,
+			remaining: []string{"phone"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := setup(t)
+
+			revoked, err := tt.revoke(s)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.revoked, revoked)
+			assert.ElementsMatch(t, tt.remaining, deviceIDs(t, s))
+		})
+	}
+}
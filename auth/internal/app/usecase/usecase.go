@@ -4,10 +4,12 @@ package usecase
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	"auth/internal/app/crypto"
 	"auth/internal/app/keystore"
+	"auth/internal/app/mail"
 	"auth/internal/app/models"
 	"auth/internal/app/token"
 	"auth/internal/app/usecase/dto"
@@ -16,12 +18,20 @@ import (
 // Порты вторичные
 type (
 	UsersService interface {
-		CreateUser(ctx context.Context, userID string, nickname string) error
+		// CreateUser создает профиль, models.ErrAlreadyExists - профиль или никнейм уже существуют
+		CreateUser(ctx context.Context, accessToken, userID, nickname string) error
+		ProfileExists(ctx context.Context, accessToken, userID string) (bool, error)
 	}
 
 	UsersRepository interface {
 		CreateUser(ctx context.Context, email, passwordHash string) (*models.User, error)
-		UpdateUser(ctx context.Context, user *models.User) error
+		CompleteRegistration(ctx context.Context, userID string) error
+		DeletePendingUser(ctx context.Context, userID string) error
+		MarkEmailVerified(ctx context.Context, userID string) error
+		SetPendingEmail(ctx context.Context, userID string, email *string) error
+		ApplyPendingEmail(ctx context.Context, userID string) error
+		SetPasswordHash(ctx context.Context, userID, passwordHash string) error
+		UpdatePasswordHash(ctx context.Context, userID, oldHash, newHash string) (bool, error)
 		GetUserByEmail(ctx context.Context, email string) (*models.User, error)
 		GetUserByID(ctx context.Context, userID string) (*models.User, error)
 	}
@@ -31,49 +41,214 @@ type (
 		GetTokenByJTI(ctx context.Context, jti string) (*models.RefreshToken, error)
 		MarkAsUsed(ctx context.Context, jti, replacedByJTI string) error
 		RevokeTokenByJTI(ctx context.Context, jti string) error
+		RevokeTokenFamily(ctx context.Context, jti string) ([]string, error)
+		ListActiveSessions(ctx context.Context, userID string) ([]*models.Session, error)
+		RevokeDeviceTokens(ctx context.Context, userID, deviceID string) (int64, error)
+		RevokeOtherDeviceTokens(ctx context.Context, userID, keepDeviceID string) (int64, error)
+		RevokeUserTokens(ctx context.Context, userID string) (int64, error)
+	}
+
+	AccessTokenRevocationsRepository interface {
+		RevokeAccessToken(ctx context.Context, token *models.RevokedAccessToken) error
+		RevokeUserAccessTokens(ctx context.Context, watermark *models.AccessTokenWatermark) error
+		ListActiveRevocations(ctx context.Context) ([]*models.RevokedAccessToken, []*models.AccessTokenWatermark, error)
+	}
+
+	LoginAttemptsRepository interface {
+		ListLoginLocks(ctx context.Context, keys []models.LoginAttemptKey) ([]*models.LoginAttempt, error)
+		RegisterFailedLogin(ctx context.Context, key models.LoginAttemptKey, windowStart time.Time) (int, error)
+		LockLogin(ctx context.Context, key models.LoginAttemptKey, lockedUntil time.Time) error
+		ResetLoginAttempts(ctx context.Context, key models.LoginAttemptKey) error
+	}
+
+	// LoginMetrics - счетчики защиты входа от перебора
+	LoginMetrics interface {
+		IncLockout(scope models.LoginAttemptScope)
+		IncRejected(scope models.LoginAttemptScope)
+	}
+
+	ActionTokensRepository interface {
+		CreateActionToken(ctx context.Context, token *models.ActionToken) error
+		UseActionToken(ctx context.Context, purpose models.ActionTokenPurpose, tokenHash string) (*models.ActionToken, error)
+		InvalidateActionTokens(ctx context.Context, userID string, purpose models.ActionTokenPurpose) error
+	}
+
+	// MailSender - отправка писем пользователю
+	MailSender interface {
+		Send(ctx context.Context, msg mail.Message) error
+	}
+
+	AuditLogRepository interface {
+		SaveAuditEvent(ctx context.Context, event *models.AuditEvent) error
+	}
+
+	TransactionManager interface {
+		RunReadCommitted(ctx context.Context, f func(txCtx context.Context) error) error
 	}
 )
 
 type Usecase interface {
 	// Register создание пользователя
 	//
-	// ErrAlreadyExists
+	// Сага: пользователь в auth создается в статусе pending, затем создается профиль в users.
+	// Если профиль создать не удалось, пользователь в auth удаляется (компенсация).
+	//
+	// ErrAlreadyExists, ErrNicknameTaken
 	Register(ctx context.Context, req dto.RegisterRequest) (*models.User, error)
 
 	// Login аутентификация пользователя
 	//
-	// ErrNotFound, ErrWrongPassword
+	// После серии неудачных попыток по email или IP клиента вход временно отклоняется.
+	// Хеш пароля старого алгоритма или с устаревшими параметрами пересчитывается после успешной проверки.
+	//
+	// ErrNotFound, ErrWrongPassword, *LoginLockedError (ErrLoginLocked)
 	Login(ctx context.Context, req dto.LoginRequest) (*models.User, error)
 
 	// Refresh обновление access token
 	//
-	// ErrNotFound, ErrTokenUsed, ErrTokenExpired
+	// При повторном предъявлении использованного токена отзывает все его семейство.
+	//
+	// ErrNotFound, ErrTokenUsed, ErrTokenRevoked, ErrTokenExpired
 	Refresh(ctx context.Context, req dto.RefreshRequest) (*models.User, error)
 
-	// Logout отзыв refresh токена
+	// Logout отзыв refresh токена и, если передан, текущего access токена
 	Logout(ctx context.Context, req dto.LogoutRequest) error
 
 	// GetJWKS получение публичных ключей
 	GetJWKS(ctx context.Context) (*dto.JWKSResponse, error)
+
+	// ListSessions активные сессии пользователя по устройствам
+	ListSessions(ctx context.Context, userID string) ([]*models.Session, error)
+
+	// RevokeSession отзыв refresh токенов устройства
+	//
+	// ErrSessionNotFound
+	RevokeSession(ctx context.Context, req dto.RevokeSessionRequest) (int64, error)
+
+	// RevokeAllOtherSessions отзыв refresh токенов всех устройств, кроме текущего
+	RevokeAllOtherSessions(ctx context.Context, req dto.RevokeAllOtherSessionsRequest) (int64, error)
+
+	// GetRevocations отозванные access токены, которые еще не истекли
+	GetRevocations(ctx context.Context) (*dto.RevocationsResponse, error)
+
+	// RequestEmailVerification отправка письма со ссылкой подтверждения email
+	//
+	// Если запрошена смена email, письмо отправляется на новый адрес.
+	// Ранее отправленные ссылки перестают действовать.
+	//
+	// ErrNotFound, ErrEmailAlreadyVerified
+	RequestEmailVerification(ctx context.Context, userID string) error
+
+	// ConfirmEmail подтверждение email по токену из письма
+	//
+	// Если запрошена смена email, новый адрес заменяет прежний.
+	//
+	// ErrInvalidActionToken, ErrAlreadyExists
+	ConfirmEmail(ctx context.Context, token string) error
+
+	// RequestPasswordReset отправка письма со ссылкой сброса пароля
+	//
+	// Для неизвестного email письмо не отправляется, но ошибка не возвращается,
+	// чтобы по ответу нельзя было проверить наличие пользователя.
+	RequestPasswordReset(ctx context.Context, email string) error
+
+	// ResetPassword установка нового пароля по токену из письма
+	//
+	// Отзывает все refresh и access токены пользователя.
+	//
+	// ErrInvalidActionToken
+	ResetPassword(ctx context.Context, req dto.ResetPasswordRequest) error
+
+	// ChangePassword смена пароля с проверкой текущего
+	//
+	// Отзывает все refresh и access токены пользователя, как ResetPassword,
+	// и выдает новые токены для текущего устройства.
+	// Неверный текущий пароль учитывается защитой входа по email пользователя.
+	//
+	// ErrNotFound, ErrWrongPassword, *LoginLockedError (ErrLoginLocked)
+	ChangePassword(ctx context.Context, req dto.ChangePasswordRequest) (*dto.ChangePasswordResponse, error)
+
+	// ChangeEmail смена email с проверкой текущего пароля
+	//
+	// Email меняется только после перехода по ссылке из письма, отправленного на новый адрес,
+	// до этого вход и сброс пароля работают по прежнему адресу. На прежний адрес отправляется уведомление.
+	// Неверный текущий пароль учитывается защитой входа по email пользователя.
+	//
+	// ErrNotFound, ErrWrongPassword, ErrAlreadyExists, *LoginLockedError (ErrLoginLocked)
+	ChangeEmail(ctx context.Context, req dto.ChangeEmailRequest) error
 }
 
 var (
 	ErrWrongPassword = errors.New("wrong password")
 	ErrWrongToken    = errors.New("wrong token")
 	ErrTokenUsed     = errors.New("token already used")
+	ErrTokenRevoked  = errors.New("token revoked")
 	ErrTokenExpired  = errors.New("token expired")
 	ErrInvalidToken  = errors.New("invalid token")
+
+	ErrSessionNotFound = errors.New("session not found")
+	ErrLoginLocked     = errors.New("too many failed login attempts")
+
+	ErrInvalidActionToken   = errors.New("token is invalid, expired or already used")
+	ErrEmailAlreadyVerified = errors.New("email already verified")
 )
 
+// LoginLockedError - вход временно отклоняется после неудачных попыток
+type LoginLockedError struct {
+	RetryAfter time.Duration
+}
+
+func (e *LoginLockedError) Error() string {
+	return fmt.Sprintf("%s, retry after %s", ErrLoginLocked, e.RetryAfter.Round(time.Second))
+}
+
+func (e *LoginLockedError) Unwrap() error {
+	return ErrLoginLocked
+}
+
 type Config struct {
 	AccessTokenTTL  time.Duration
 	RefreshTokenTTL time.Duration
+	LoginProtection LoginProtectionConfig
+	// EmailVerification, PasswordReset - одноразовые токены из писем
+	EmailVerification ActionTokenConfig
+	PasswordReset     ActionTokenConfig
+}
+
+// ActionTokenConfig - одноразовый токен, отправляемый ссылкой в письме
+type ActionTokenConfig struct {
+	TTL time.Duration
+	// URL - адрес ссылки в письме, токен добавляется параметром token
+	URL string
+}
+
+// LoginProtectionConfig - защита входа от перебора паролей
+type LoginProtectionConfig struct {
+	Enabled bool
+	// Window - неудачные попытки считаются, пока между ними проходит меньше Window
+	Window time.Duration
+	// FreeAttempts - сколько неудачных попыток допускается без задержки
+	FreeAttempts int
+	// BaseDelay, MaxDelay - задержка после каждой следующей попытки удваивается от BaseDelay до MaxDelay
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+	// EmailLockoutThreshold, IPLockoutThreshold - после стольких попыток вход блокируется на LockoutDuration
+	EmailLockoutThreshold int
+	IPLockoutThreshold    int
+	LockoutDuration       time.Duration
 }
 
 type AuthService struct {
 	usersService      UsersService
 	usersRepo         UsersRepository
 	refreshTokensRepo RefreshTokensRepository
+	revocationsRepo   AccessTokenRevocationsRepository
+	loginAttemptsRepo LoginAttemptsRepository
+	actionTokensRepo  ActionTokensRepository
+	auditLogRepo      AuditLogRepository
+	loginMetrics      LoginMetrics
+	mailSender        MailSender
+	txManager         TransactionManager
 	passwordHasher    crypto.PasswordHasher
 	tokenManager      *token.TokenManager
 	keyStore          keystore.KeyStore
@@ -86,6 +261,13 @@ func NewUsecase(
 	usersService UsersService,
 	usersRepo UsersRepository,
 	refreshTokensRepo RefreshTokensRepository,
+	revocationsRepo AccessTokenRevocationsRepository,
+	loginAttemptsRepo LoginAttemptsRepository,
+	actionTokensRepo ActionTokensRepository,
+	auditLogRepo AuditLogRepository,
+	loginMetrics LoginMetrics,
+	mailSender MailSender,
+	txManager TransactionManager,
 	passwordHasher crypto.PasswordHasher,
 	tokenManager *token.TokenManager,
 	keyStore keystore.KeyStore,
@@ -95,6 +277,13 @@ func NewUsecase(
 		usersService:      usersService,
 		usersRepo:         usersRepo,
 		refreshTokensRepo: refreshTokensRepo,
+		revocationsRepo:   revocationsRepo,
+		loginAttemptsRepo: loginAttemptsRepo,
+		actionTokensRepo:  actionTokensRepo,
+		auditLogRepo:      auditLogRepo,
+		loginMetrics:      loginMetrics,
+		mailSender:        mailSender,
+		txManager:         txManager,
 		passwordHasher:    passwordHasher,
 		tokenManager:      tokenManager,
 		keyStore:          keyStore,
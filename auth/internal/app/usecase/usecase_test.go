@@ -3,130 +3,497 @@ package usecase
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+
+	"auth/internal/app/crypto"
+	"auth/internal/app/keystore"
+	"auth/internal/app/mail"
 	"auth/internal/app/models"
+	"auth/internal/app/token"
 	"auth/internal/app/usecase/dto"
 	"auth/internal/app/usecase/mocks"
+)
 
-	"github.com/stretchr/testify/assert"
-	"github.com/stretchr/testify/require"
+const (
+	testUserID   = "550e8400-e29b-41d4-a716-446655440000"
+	testEmail    = "test@example.com"
+	testPassword = "password123"
 )
 
+var errDatabase = errors.New("database error")
+
+// memoryKeyStore - один ключ подписи в памяти
+type memoryKeyStore struct {
+	keystore.KeyStore
+	key *keystore.RSAKey
+}
+
+func newMemoryKeyStore(t *testing.T) *memoryKeyStore {
+	t.Helper()
+
+	generator := keystore.NewKeyGenerator(keystore.WithAlgorithm(keystore.KeyAlgorithmES256))
+	privateKeyPEM, publicKeyPEM, err := generator.GeneratePEM()
+	require.NoError(t, err)
+
+	return &memoryKeyStore{key: &keystore.RSAKey{
+		KID:           "kid-1",
+		Algorithm:     generator.Algorithm(),
+		PrivateKeyPEM: privateKeyPEM,
+		PublicKeyPEM:  publicKeyPEM,
+		Status:        keystore.KeyStatusActive,
+		CreatedAt:     time.Now(),
+	}}
+}
+
+func (s *memoryKeyStore) GetActiveKey(context.Context) (*keystore.RSAKey, error) {
+	return s.key, nil
+}
+
+func (s *memoryKeyStore) GetKeyByKID(_ context.Context, kid string) (*keystore.RSAKey, error) {
+	if kid != s.key.KID {
+		return nil, keystore.ErrKeyNotFound
+	}
+	return s.key, nil
+}
+
+// memoryRefreshTokens - refresh токены в памяти по JTI с теми же условиями, что в репозитории
+type memoryRefreshTokens struct {
+	tokens map[string]*models.RefreshToken
+}
+
+func newMemoryRefreshTokens() *memoryRefreshTokens {
+	return &memoryRefreshTokens{tokens: make(map[string]*models.RefreshToken)}
+}
+
+func (r *memoryRefreshTokens) CreateToken(_ context.Context, token *models.RefreshToken) error {
+	stored := *token
+	stored.ID = fmt.Sprintf("token-%d", len(r.tokens)+1)
+	r.tokens[token.JTI] = &stored
+	return nil
+}
+
+func (r *memoryRefreshTokens) GetTokenByJTI(_ context.Context, jti string) (*models.RefreshToken, error) {
+	token, ok := r.tokens[jti]
+	if !ok {
+		return nil, models.ErrNotFound
+	}
+	stored := *token
+	return &stored, nil
+}
+
+func (r *memoryRefreshTokens) MarkAsUsed(_ context.Context, jti, replacedByJTI string) error {
+	token, ok := r.tokens[jti]
+	if !ok || token.UsedAt != nil {
+		return models.ErrTokenAlreadyUsed
+	}
+	now := time.Now()
+	token.UsedAt = &now
+	token.ReplacedByJTI = &replacedByJTI
+	return nil
+}
+
+func (r *memoryRefreshTokens) RevokeTokenByJTI(_ context.Context, jti string) error {
+	if token, ok := r.tokens[jti]; ok && token.RevokedAt == nil {
+		r.revoke(token, time.Now())
+	}
+	return nil
+}
+
+func (r *memoryRefreshTokens) RevokeTokenFamily(_ context.Context, jti string) ([]string, error) {
+	now := time.Now()
+	var revoked []string
+	for token, ok := r.tokens[jti]; ok; {
+		if token.RevokedAt == nil {
+			r.revoke(token, now)
+			revoked = append(revoked, token.JTI)
+		}
+		if token.ReplacedByJTI == nil {
+			break
+		}
+		token, ok = r.tokens[*token.ReplacedByJTI]
+	}
+	return revoked, nil
+}
+
+func (r *memoryRefreshTokens) ListActiveSessions(_ context.Context, userID string) ([]*models.Session, error) {
+	now := time.Now()
+	byDevice := make(map[string]*models.Session)
+	var sessions []*models.Session
+	for _, token := range r.tokens {
+		if token.UserID != userID || !isActiveToken(token, now) {
+			continue
+		}
+		var deviceID string
+		if token.DeviceID != nil {
+			deviceID = *token.DeviceID
+		}
+		session, ok := byDevice[deviceID]
+		if !ok {
+			session = &models.Session{DeviceID: deviceID, CreatedAt: token.SessionStartedAt}
+			byDevice[deviceID] = session
+			sessions = append(sessions, session)
+		}
+		if token.SessionStartedAt.Before(session.CreatedAt) {
+			session.CreatedAt = token.SessionStartedAt
+		}
+		if token.CreatedAt.After(session.LastRefreshAt) {
+			session.LastRefreshAt = token.CreatedAt
+		}
+		if token.ExpiresAt.After(session.ExpiresAt) {
+			session.ExpiresAt = token.ExpiresAt
+		}
+	}
+	return sessions, nil
+}
+
+func (r *memoryRefreshTokens) RevokeDeviceTokens(_ context.Context, userID, deviceID string) (int64, error) {
+	return r.revokeActive(func(token *models.RefreshToken) bool {
+		return token.UserID == userID && token.DeviceID != nil && *token.DeviceID == deviceID
+	}), nil
+}
+
+func (r *memoryRefreshTokens) RevokeOtherDeviceTokens(_ context.Context, userID, keepDeviceID string) (int64, error) {
+	return r.revokeActive(func(token *models.RefreshToken) bool {
+		return token.UserID == userID && (token.DeviceID == nil || *token.DeviceID != keepDeviceID)
+	}), nil
+}
+
+func (r *memoryRefreshTokens) RevokeUserTokens(_ context.Context, userID string) (int64, error) {
+	return r.revokeActive(func(token *models.RefreshToken) bool {
+		return token.UserID == userID
+	}), nil
+}
+
+func (r *memoryRefreshTokens) revokeActive(match func(*models.RefreshToken) bool) int64 {
+	now := time.Now()
+	var revoked int64
+	for _, token := range r.tokens {
+		if match(token) && isActiveToken(token, now) {
+			r.revoke(token, now)
+			revoked++
+		}
+	}
+	return revoked
+}
+
+func (r *memoryRefreshTokens) revoke(token *models.RefreshToken, now time.Time) {
+	token.RevokedAt = &now
+	if token.UsedAt == nil {
+		token.UsedAt = &now
+	}
+}
+
+func isActiveToken(token *models.RefreshToken, now time.Time) bool {
+	return token.UsedAt == nil && token.RevokedAt == nil && token.ExpiresAt.After(now)
+}
+
+// memoryRevocations - отозванные access токены в памяти
+type memoryRevocations struct {
+	tokens     []*models.RevokedAccessToken
+	watermarks map[string]*models.AccessTokenWatermark
+}
+
+func newMemoryRevocations() *memoryRevocations {
+	return &memoryRevocations{watermarks: make(map[string]*models.AccessTokenWatermark)}
+}
+
+func (r *memoryRevocations) RevokeAccessToken(_ context.Context, token *models.RevokedAccessToken) error {
+	r.tokens = append(r.tokens, token)
+	return nil
+}
+
+func (r *memoryRevocations) RevokeUserAccessTokens(_ context.Context, watermark *models.AccessTokenWatermark) error {
+	r.watermarks[watermark.UserID] = watermark
+	return nil
+}
+
+func (r *memoryRevocations) ListActiveRevocations(context.Context) ([]*models.RevokedAccessToken, []*models.AccessTokenWatermark, error) {
+	watermarks := make([]*models.AccessTokenWatermark, 0, len(r.watermarks))
+	for _, watermark := range r.watermarks {
+		watermarks = append(watermarks, watermark)
+	}
+	return r.tokens, watermarks, nil
+}
+
+// memoryLoginAttempts - счетчики неудачных попыток входа в памяти
+type memoryLoginAttempts struct {
+	attempts map[models.LoginAttemptKey]*models.LoginAttempt
+}
+
+func newMemoryLoginAttempts() *memoryLoginAttempts {
+	return &memoryLoginAttempts{attempts: make(map[models.LoginAttemptKey]*models.LoginAttempt)}
+}
+
+func (r *memoryLoginAttempts) ListLoginLocks(_ context.Context, keys []models.LoginAttemptKey) ([]*models.LoginAttempt, error) {
+	now := time.Now()
+	var locks []*models.LoginAttempt
+	for _, key := range keys {
+		attempt, ok := r.attempts[key]
+		if ok && attempt.LockedUntil != nil && attempt.LockedUntil.After(now) {
+			locks = append(locks, attempt)
+		}
+	}
+	return locks, nil
+}
+
+func (r *memoryLoginAttempts) RegisterFailedLogin(_ context.Context, key models.LoginAttemptKey, windowStart time.Time) (int, error) {
+	attempt, ok := r.attempts[key]
+	if !ok || attempt.LastFailedAt.Before(windowStart) {
+		attempt = &models.LoginAttempt{Scope: key.Scope, Key: key.Key}
+		r.attempts[key] = attempt
+	}
+	attempt.FailedCount++
+	attempt.LastFailedAt = time.Now()
+	return attempt.FailedCount, nil
+}
+
+func (r *memoryLoginAttempts) LockLogin(_ context.Context, key models.LoginAttemptKey, lockedUntil time.Time) error {
+	if attempt, ok := r.attempts[key]; ok {
+		attempt.LockedUntil = &lockedUntil
+	}
+	return nil
+}
+
+func (r *memoryLoginAttempts) ResetLoginAttempts(_ context.Context, key models.LoginAttemptKey) error {
+	delete(r.attempts, key)
+	return nil
+}
+
+// memoryActionTokens - одноразовые токены из писем в памяти
+type memoryActionTokens struct {
+	tokens []*models.ActionToken
+}
+
+func (r *memoryActionTokens) CreateActionToken(_ context.Context, token *models.ActionToken) error {
+	stored := *token
+	stored.ID = fmt.Sprintf("action-%d", len(r.tokens)+1)
+	r.tokens = append(r.tokens, &stored)
+	return nil
+}
+
+func (r *memoryActionTokens) UseActionToken(_ context.Context, purpose models.ActionTokenPurpose, tokenHash string) (*models.ActionToken, error) {
+	now := time.Now()
+	for _, token := range r.tokens {
+		if token.Purpose == purpose && token.TokenHash == tokenHash && token.UsedAt == nil && token.ExpiresAt.After(now) {
+			token.UsedAt = &now
+			return token, nil
+		}
+	}
+	return nil, models.ErrNotFound
+}
+
+func (r *memoryActionTokens) InvalidateActionTokens(_ context.Context, userID string, purpose models.ActionTokenPurpose) error {
+	now := time.Now()
+	for _, token := range r.tokens {
+		if token.UserID == userID && token.Purpose == purpose && token.UsedAt == nil {
+			token.UsedAt = &now
+		}
+	}
+	return nil
+}
+
+type memoryAuditLog struct {
+	events []*models.AuditEvent
+}
+
+func (r *memoryAuditLog) SaveAuditEvent(_ context.Context, event *models.AuditEvent) error {
+	r.events = append(r.events, event)
+	return nil
+}
+
+type memoryMailSender struct {
+	messages []mail.Message
+}
+
+func (s *memoryMailSender) Send(_ context.Context, msg mail.Message) error {
+	s.messages = append(s.messages, msg)
+	return nil
+}
+
+type stubLoginMetrics struct {
+	lockouts, rejected int
+}
+
+func (m *stubLoginMetrics) IncLockout(models.LoginAttemptScope)  { m.lockouts++ }
+func (m *stubLoginMetrics) IncRejected(models.LoginAttemptScope) { m.rejected++ }
+
+type stubTxManager struct{}
+
+func (stubTxManager) RunReadCommitted(ctx context.Context, f func(txCtx context.Context) error) error {
+	return f(ctx)
+}
+
+// testEnv - зависимости AuthService в тестах
+type testEnv struct {
+	usersService  *mocks.UsersServiceMock
+	usersRepo     *mocks.UsersRepositoryMock
+	refreshTokens *memoryRefreshTokens
+	revocations   *memoryRevocations
+	loginAttempts *memoryLoginAttempts
+	actionTokens  *memoryActionTokens
+	auditLog      *memoryAuditLog
+	loginMetrics  *stubLoginMetrics
+	mailSender    *memoryMailSender
+	hasher        crypto.PasswordHasher
+	tokenManager  *token.TokenManager
+}
+
+func testConfig() Config {
+	return Config{
+		AccessTokenTTL:    15 * time.Minute,
+		RefreshTokenTTL:   24 * time.Hour,
+		EmailVerification: ActionTokenConfig{TTL: time.Hour, URL: "https://example.com/verify"},
+		PasswordReset:     ActionTokenConfig{TTL: time.Hour, URL: "https://example.com/reset"},
+	}
+}
+
+func newTestService(t *testing.T, cfg Config) (*AuthService, *testEnv) {
+	t.Helper()
+
+	keyStore := newMemoryKeyStore(t)
+	env := &testEnv{
+		usersService:  mocks.NewUsersServiceMock(t),
+		usersRepo:     mocks.NewUsersRepositoryMock(t),
+		refreshTokens: newMemoryRefreshTokens(),
+		revocations:   newMemoryRevocations(),
+		loginAttempts: newMemoryLoginAttempts(),
+		actionTokens:  &memoryActionTokens{},
+		auditLog:      &memoryAuditLog{},
+		loginMetrics:  &stubLoginMetrics{},
+		mailSender:    &memoryMailSender{},
+		hasher:        crypto.NewBcryptHasher(bcrypt.MinCost, 8),
+		tokenManager: token.NewTokenManager(token.Config{
+			Issuer:          "auth-test",
+			AccessTokenTTL:  cfg.AccessTokenTTL,
+			RefreshTokenTTL: cfg.RefreshTokenTTL,
+		}, keyStore),
+	}
+
+	service := NewUsecase(
+		env.usersService,
+		env.usersRepo,
+		env.refreshTokens,
+		env.revocations,
+		env.loginAttempts,
+		env.actionTokens,
+		env.auditLog,
+		env.loginMetrics,
+		env.mailSender,
+		stubTxManager{},
+		env.hasher,
+		env.tokenManager,
+		keyStore,
+		cfg,
+	)
+	return service, env
+}
+
+// testUser пользователь с завершенной регистрацией и паролем testPassword
+func (env *testEnv) testUser(t *testing.T) *models.User {
+	t.Helper()
+
+	passwordHash, err := env.hasher.Hash(testPassword)
+	require.NoError(t, err)
+
+	return &models.User{
+		ID:                 testUserID,
+		Email:              testEmail,
+		PasswordHash:       passwordHash,
+		RegistrationStatus: models.RegistrationStatusCompleted,
+		CreatedAt:          time.Now().Add(-time.Hour),
+	}
+}
+
+// login входит пользователем user с устройства deviceID
+func (env *testEnv) login(t *testing.T, s *AuthService, user *models.User, deviceID string) *models.UserToken {
+	t.Helper()
+
+	env.usersRepo.GetUserByEmailMock.Return(user, nil)
+	loggedIn, err := s.Login(context.Background(), dto.LoginRequest{
+		Email:    user.Email,
+		Password: testPassword,
+		DeviceID: deviceID,
+	})
+	require.NoError(t, err)
+	return loggedIn.Token
+}
+
 func TestAuthService_Register(t *testing.T) {
 	tests := []struct {
 		name          string
-		req           dto.RegisterRequest
 		setupMocks    func(context.Context, *mocks.UsersRepositoryMock, *mocks.UsersServiceMock)
-		expectedUser  *models.User
 		expectedError error
+		expectedMails int
 	}{
 		{
 			name: "успешная регистрация",
-			req: dto.RegisterRequest{
-				Email:    "test@example.com",
-				Password: "password123",
-			},
 			setupMocks: func(ctx context.Context, repo *mocks.UsersRepositoryMock, service *mocks.UsersServiceMock) {
-				repo.GetUserByEmailMock.Expect(ctx, "test@example.com").Return(nil, nil)
-				repo.SaveUserMock.Expect(ctx, "test@example.com", "password123").Return(&models.User{
-					ID:       "550e8400-e29b-41d4-a716-446655440000",
-					Email:    "test@example.com",
-					Password: "password123",
+				repo.GetUserByEmailMock.Expect(ctx, testEmail).Return(nil, models.ErrNotFound)
+				repo.CreateUserMock.Return(&models.User{
+					ID:                 testUserID,
+					Email:              testEmail,
+					RegistrationStatus: models.RegistrationStatusPending,
 				}, nil)
-				service.CreateUserMock.Expect(ctx, "550e8400-e29b-41d4-a716-446655440000", "test@example.com").Return(nil)
-			},
-			expectedUser: &models.User{
-				ID:       "550e8400-e29b-41d4-a716-446655440000",
-				Email:    "test@example.com",
-				Password: "password123",
+				service.CreateUserMock.ExpectUserIDParam3(testUserID).ExpectNicknameParam4("tester").Return(nil)
+				repo.CompleteRegistrationMock.Expect(ctx, testUserID).Return(nil)
 			},
-			expectedError: nil,
+			expectedMails: 1,
 		},
 		{
 			name: "пользователь уже существует",
-			req: dto.RegisterRequest{
-				Email:    "existing@example.com",
-				Password: "password123",
-			},
 			setupMocks: func(ctx context.Context, repo *mocks.UsersRepositoryMock, service *mocks.UsersServiceMock) {
-				repo.GetUserByEmailMock.Expect(ctx, "existing@example.com").Return(&models.User{
-					ID:    "550e8400-e29b-41d4-a716-446655440000",
-					Email: "existing@example.com",
+				repo.GetUserByEmailMock.Expect(ctx, testEmail).Return(&models.User{
+					ID:                 testUserID,
+					Email:              testEmail,
+					RegistrationStatus: models.RegistrationStatusCompleted,
 				}, nil)
 			},
-			expectedUser:  nil,
 			expectedError: models.ErrAlreadyExists,
 		},
 		{
 			name: "ошибка при проверке существования пользователя",
-			req: dto.RegisterRequest{
-				Email:    "test@example.com",
-				Password: "password123",
-			},
 			setupMocks: func(ctx context.Context, repo *mocks.UsersRepositoryMock, service *mocks.UsersServiceMock) {
-				repo.GetUserByEmailMock.Expect(ctx, "test@example.com").Return(nil, errors.New("database error"))
+				repo.GetUserByEmailMock.Expect(ctx, testEmail).Return(nil, errDatabase)
 			},
-			expectedUser:  nil,
-			expectedError: errors.New("database error"),
+			expectedError: errDatabase,
 		},
 		{
 			name: "ошибка при сохранении пользователя",
-			req: dto.RegisterRequest{
-				Email:    "test@example.com",
-				Password: "password123",
-			},
 			setupMocks: func(ctx context.Context, repo *mocks.UsersRepositoryMock, service *mocks.UsersServiceMock) {
-				repo.GetUserByEmailMock.Expect(ctx, "test@example.com").Return(nil, nil)
-				repo.SaveUserMock.Expect(ctx, "test@example.com", "password123").Return(nil, errors.New("save error"))
+				repo.GetUserByEmailMock.Expect(ctx, testEmail).Return(nil, models.ErrNotFound)
+				repo.CreateUserMock.Return(nil, errDatabase)
 			},
-			expectedUser:  nil,
-			expectedError: errors.New("save error"),
-		},
-		{
-			name: "ошибка при создании пользователя в Users Service",
-			req: dto.RegisterRequest{
-				Email:    "test@example.com",
-				Password: "password123",
-			},
-			setupMocks: func(ctx context.Context, repo *mocks.UsersRepositoryMock, service *mocks.UsersServiceMock) {
-				repo.GetUserByEmailMock.Expect(ctx, "test@example.com").Return(nil, nil)
-				repo.SaveUserMock.Expect(ctx, "test@example.com", "password123").Return(&models.User{
-					ID:       "550e8400-e29b-41d4-a716-446655440000",
-					Email:    "test@example.com",
-					Password: "password123",
-				}, nil)
-				service.CreateUserMock.Expect(ctx, "550e8400-e29b-41d4-a716-446655440000", "test@example.com").Return(errors.New("service error"))
-			},
-			expectedUser:  nil,
-			expectedError: errors.New("service error"),
+			expectedError: errDatabase,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			ctx := t.Context()
-
-			repoMock := mocks.NewUsersRepositoryMock(t)
-			serviceMock := mocks.NewUsersServiceMock(t)
-
-			tt.setupMocks(ctx, repoMock, serviceMock)
+			ctx := context.Background()
+			s, env := newTestService(t, testConfig())
+			tt.setupMocks(ctx, env.usersRepo, env.usersService)
 
-			authService := NewUsecase(serviceMock, repoMock)
-
-			user, err := authService.Register(ctx, tt.req)
+			user, err := s.Register(ctx, dto.RegisterRequest{
+				Email:    testEmail,
+				Password: testPassword,
+				Nickname: "tester",
+			})
 
 			if tt.expectedError != nil {
-				require.Error(t, err)
-				assert.Contains(t, err.Error(), tt.expectedError.Error())
+				require.ErrorIs(t, err, tt.expectedError)
 				assert.Nil(t, user)
 			} else {
 				require.NoError(t, err)
-				assert.Equal(t, tt.expectedUser.ID, user.ID)
-				assert.Equal(t, tt.expectedUser.Email, user.Email)
-				assert.Equal(t, tt.expectedUser.Password, user.Password)
+				assert.Equal(t, testUserID, user.ID)
+				assert.Equal(t, models.RegistrationStatusCompleted, user.RegistrationStatus)
 			}
+			assert.Len(t, env.mailSender.messages, tt.expectedMails)
 		})
 	}
 }
@@ -134,265 +501,290 @@ func TestAuthService_Register(t *testing.T) {
 func TestAuthService_Login(t *testing.T) {
 	tests := []struct {
 		name          string
-		req           dto.LoginRequest
-		setupMocks    func(context.Context, *mocks.UsersRepositoryMock)
-		validateUser  func(*testing.T, *models.User)
+		password      string
+		setupUser     func(*models.User) *models.User
+		repoErr       error
 		expectedError error
 	}{
 		{
-			name: "успешный логин",
-			req: dto.LoginRequest{
-				Email:    "test@example.com",
-				Password: "password123",
-			},
-			setupMocks: func(ctx context.Context, repo *mocks.UsersRepositoryMock) {
-				repo.GetUserByEmailMock.Expect(ctx, "test@example.com").Return(&models.User{
-					ID:       "550e8400-e29b-41d4-a716-446655440000",
-					Email:    "test@example.com",
-					Password: "password123",
-				}, nil)
-				repo.UpdateUserMock.Set(func(ctx context.Context, user *models.User) error {
-					require.NotNil(t, user.Token)
-					require.NotEmpty(t, user.Token.AccessToken)
-					require.NotEmpty(t, user.Token.RefreshToken)
-					require.True(t, user.Token.TokenExpiresAt.After(time.Now()))
-					return nil
-				})
-			},
-			validateUser: func(t *testing.T, user *models.User) {
-				assert.Equal(t, "550e8400-e29b-41d4-a716-446655440000", user.ID)
-				assert.Equal(t, "test@example.com", user.Email)
-				require.NotNil(t, user.Token)
-				assert.NotEmpty(t, user.Token.AccessToken)
-				assert.NotEmpty(t, user.Token.RefreshToken)
-				assert.True(t, user.Token.TokenExpiresAt.After(time.Now()))
-			},
-			expectedError: nil,
+			name:     "успешный логин",
+			password: testPassword,
 		},
 		{
-			name: "пользователь не найден",
-			req: dto.LoginRequest{
-				Email:    "notfound@example.com",
-				Password: "password123",
-			},
-			setupMocks: func(ctx context.Context, repo *mocks.UsersRepositoryMock) {
-				repo.GetUserByEmailMock.Expect(ctx, "notfound@example.com").Return(nil, nil)
-			},
-			validateUser:  nil,
+			name:          "пользователь не найден",
+			password:      testPassword,
+			setupUser:     func(*models.User) *models.User { return nil },
+			repoErr:       models.ErrNotFound,
 			expectedError: models.ErrNotFound,
 		},
 		{
-			name: "неверный пароль",
-			req: dto.LoginRequest{
-				Email:    "test@example.com",
-				Password: "wrongpassword",
+			name:     "регистрация не завершена",
+			password: testPassword,
+			setupUser: func(user *models.User) *models.User {
+				user.RegistrationStatus = models.RegistrationStatusPending
+				return user
 			},
-			setupMocks: func(ctx context.Context, repo *mocks.UsersRepositoryMock) {
-				repo.GetUserByEmailMock.Expect(ctx, "test@example.com").Return(&models.User{
-					ID:       "550e8400-e29b-41d4-a716-446655440000",
-					Email:    "test@example.com",
-					Password: "password123",
-				}, nil)
-			},
-			validateUser:  nil,
-			expectedError: ErrWrongPassword,
+			expectedError: models.ErrNotFound,
 		},
 		{
-			name: "ошибка получения пользователя",
-			req: dto.LoginRequest{
-				Email:    "test@example.com",
-				Password: "password123",
-			},
-			setupMocks: func(ctx context.Context, repo *mocks.UsersRepositoryMock) {
-				repo.GetUserByEmailMock.Expect(ctx, "test@example.com").Return(nil, errors.New("database error"))
-			},
-			validateUser:  nil,
-			expectedError: errors.New("database error"),
+			name:          "неверный пароль",
+			password:      "wrongpassword",
+			expectedError: ErrWrongPassword,
 		},
 		{
-			name: "ошибка обновления токена",
-			req: dto.LoginRequest{
-				Email:    "test@example.com",
-				Password: "password123",
-			},
-			setupMocks: func(ctx context.Context, repo *mocks.UsersRepositoryMock) {
-				repo.GetUserByEmailMock.Expect(ctx, "test@example.com").Return(&models.User{
-					ID:       "550e8400-e29b-41d4-a716-446655440000",
-					Email:    "test@example.com",
-					Password: "password123",
-				}, nil)
-				repo.UpdateUserMock.Set(func(ctx context.Context, user *models.User) error {
-					return errors.New("update error")
-				})
-			},
-			validateUser:  nil,
-			expectedError: errors.New("update error"),
+			name:          "ошибка от репозитория",
+			password:      testPassword,
+			setupUser:     func(*models.User) *models.User { return nil },
+			repoErr:       errDatabase,
+			expectedError: errDatabase,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			ctx := t.Context()
-
-			repoMock := mocks.NewUsersRepositoryMock(t)
+			ctx := context.Background()
+			s, env := newTestService(t, testConfig())
 
-			tt.setupMocks(ctx, repoMock)
-
-			authService := NewUsecase(nil, repoMock)
+			user := env.testUser(t)
+			if tt.setupUser != nil {
+				user = tt.setupUser(user)
+			}
+			env.usersRepo.GetUserByEmailMock.Expect(ctx, testEmail).Return(user, tt.repoErr)
 
-			user, err := authService.Login(ctx, tt.req)
+			loggedIn, err := s.Login(ctx, dto.LoginRequest{
+				Email:    testEmail,
+				Password: tt.password,
+				DeviceID: "phone",
+			})
 
 			if tt.expectedError != nil {
-				require.Error(t, err)
-				assert.Contains(t, err.Error(), tt.expectedError.Error())
-				assert.Nil(t, user)
-			} else {
-				require.NoError(t, err)
-				require.NotNil(t, user)
-				tt.validateUser(t, user)
+				require.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, loggedIn)
+				assert.Empty(t, env.refreshTokens.tokens)
+				return
 			}
+
+			require.NoError(t, err)
+			require.NotNil(t, loggedIn.Token)
+
+			claims, err := env.tokenManager.VerifyRefreshToken(ctx, loggedIn.Token.RefreshToken)
+			require.NoError(t, err)
+			stored, err := env.refreshTokens.GetTokenByJTI(ctx, claims.JWTID)
+			require.NoError(t, err)
+			assert.Equal(t, crypto.HashToken(loggedIn.Token.RefreshToken), stored.TokenHash)
+			assert.Equal(t, "phone", *stored.DeviceID)
+
+			accessClaims, err := env.tokenManager.VerifyAccessToken(ctx, loggedIn.Token.AccessToken)
+			require.NoError(t, err)
+			assert.Equal(t, testUserID, accessClaims.Subject)
 		})
 	}
 }
 
-func TestAuthService_Refresh(t *testing.T) {
-	validRefreshToken := "valid-refresh-token"
+func TestAuthService_LoginRehash(t *testing.T) {
+	ctx := context.Background()
 
 	tests := []struct {
-		name          string
-		req           dto.RefreshRequest
-		setupMocks    func(context.Context, *mocks.UsersRepositoryMock)
-		validateUser  func(*testing.T, *models.User)
-		expectedError error
+		name      string
+		updated   bool
+		updateErr error
+		wantHash  bool // хеш пользователя заменен пересчитанным
 	}{
-		{
-			name: "успешное обновление токена",
-			req: dto.RefreshRequest{
-				UserID:       "550e8400-e29b-41d4-a716-446655440000",
-				RefreshToken: validRefreshToken,
-			},
-			setupMocks: func(ctx context.Context, repo *mocks.UsersRepositoryMock) {
-				repo.GetUserByIDMock.Expect(ctx, "550e8400-e29b-41d4-a716-446655440000").Return(&models.User{
-					ID:       "550e8400-e29b-41d4-a716-446655440000",
-					Email:    "test@example.com",
-					Password: "password123",
-					Token: &models.UserToken{
-						AccessToken:    "old-access-token",
-						RefreshToken:   validRefreshToken,
-						TokenExpiresAt: time.Now().Add(time.Hour),
-					},
-				}, nil)
-				repo.UpdateUserMock.Set(func(ctx context.Context, user *models.User) error {
-					require.NotNil(t, user.Token)
-					require.NotEmpty(t, user.Token.AccessToken)
-					require.NotEmpty(t, user.Token.RefreshToken)
-					require.True(t, user.Token.TokenExpiresAt.After(time.Now()))
-					return nil
-				})
-			},
-			validateUser: func(t *testing.T, user *models.User) {
-				assert.Equal(t, "550e8400-e29b-41d4-a716-446655440000", user.ID)
-				assert.Equal(t, "test@example.com", user.Email)
-				require.NotNil(t, user.Token)
-				assert.NotEmpty(t, user.Token.AccessToken)
-				assert.NotEmpty(t, user.Token.RefreshToken)
-				assert.NotEqual(t, "old-access-token", user.Token.AccessToken)
-				assert.True(t, user.Token.TokenExpiresAt.After(time.Now()))
-			},
-			expectedError: nil,
-		},
-		{
-			name: "пользователь не найден",
-			req: dto.RefreshRequest{
-				UserID:       "999e8400-e29b-41d4-a716-446655440099",
-				RefreshToken: validRefreshToken,
-			},
-			setupMocks: func(ctx context.Context, repo *mocks.UsersRepositoryMock) {
-				repo.GetUserByIDMock.Expect(ctx, "999e8400-e29b-41d4-a716-446655440099").Return(nil, nil)
-			},
-			validateUser:  nil,
-			expectedError: models.ErrNotFound,
-		},
-		{
-			name: "неверный refresh token",
-			req: dto.RefreshRequest{
-				UserID:       "550e8400-e29b-41d4-a716-446655440000",
-				RefreshToken: "invalid-token",
-			},
-			setupMocks: func(ctx context.Context, repo *mocks.UsersRepositoryMock) {
-				repo.GetUserByIDMock.Expect(ctx, "550e8400-e29b-41d4-a716-446655440000").Return(&models.User{
-					ID:       "550e8400-e29b-41d4-a716-446655440000",
-					Email:    "test@example.com",
-					Password: "password123",
-					Token: &models.UserToken{
-						AccessToken:    "access-token",
-						RefreshToken:   validRefreshToken,
-						TokenExpiresAt: time.Now().Add(time.Hour),
-					},
-				}, nil)
-			},
-			validateUser:  nil,
-			expectedError: ErrWrongToken,
-		},
-		{
-			name: "ошибка получения пользователя",
-			req: dto.RefreshRequest{
-				UserID:       "550e8400-e29b-41d4-a716-446655440000",
-				RefreshToken: validRefreshToken,
-			},
-			setupMocks: func(ctx context.Context, repo *mocks.UsersRepositoryMock) {
-				repo.GetUserByIDMock.Expect(ctx, "550e8400-e29b-41d4-a716-446655440000").Return(nil, errors.New("database error"))
-			},
-			validateUser:  nil,
-			expectedError: errors.New("database error"),
-		},
-		{
-			name: "ошибка обновления токена",
-			req: dto.RefreshRequest{
-				UserID:       "550e8400-e29b-41d4-a716-446655440000",
-				RefreshToken: validRefreshToken,
-			},
-			setupMocks: func(ctx context.Context, repo *mocks.UsersRepositoryMock) {
-				repo.GetUserByIDMock.Expect(ctx, "550e8400-e29b-41d4-a716-446655440000").Return(&models.User{
-					ID:       "550e8400-e29b-41d4-a716-446655440000",
-					Email:    "test@example.com",
-					Password: "password123",
-					Token: &models.UserToken{
-						AccessToken:    "access-token",
-						RefreshToken:   validRefreshToken,
-						TokenExpiresAt: time.Now().Add(time.Hour),
-					},
-				}, nil)
-				repo.UpdateUserMock.Set(func(ctx context.Context, user *models.User) error {
-					return errors.New("update error")
-				})
-			},
-			validateUser:  nil,
-			expectedError: errors.New("update error"),
-		},
+		{name: "хеш пересчитывается", updated: true, wantHash: true},
+		{name: "пароль сменили параллельно - хеш не перезаписывается"},
+		{name: "ошибка сохранения не мешает входу", updateErr: errDatabase},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			ctx := t.Context()
+			s, env := newTestService(t, testConfig())
 
-			repoMock := mocks.NewUsersRepositoryMock(t)
+			// Хеш с устаревшей стоимостью bcrypt
+			oldHash, err := crypto.NewBcryptHasher(bcrypt.MinCost+1, 8).Hash(testPassword)
+			require.NoError(t, err)
+			user := env.testUser(t)
+			user.PasswordHash = oldHash
+			env.usersRepo.GetUserByEmailMock.Return(user, nil)
 
-			tt.setupMocks(ctx, repoMock)
+			var newHash string
+			env.usersRepo.UpdatePasswordHashMock.Set(func(_ context.Context, userID, currentHash, passwordHash string) (bool, error) {
+				assert.Equal(t, testUserID, userID)
+				assert.Equal(t, oldHash, currentHash)
+				newHash = passwordHash
+				return tt.updated, tt.updateErr
+			})
 
-			authService := NewUsecase(nil, repoMock)
+			loggedIn, err := s.Login(ctx, dto.LoginRequest{Email: testEmail, Password: testPassword})
+			require.NoError(t, err)
 
-			user, err := authService.Refresh(ctx, tt.req)
-
-			if tt.expectedError != nil {
-				require.Error(t, err)
-				assert.Contains(t, err.Error(), tt.expectedError.Error())
-				assert.Nil(t, user)
+			require.NoError(t, env.hasher.Verify(newHash, testPassword))
+			assert.False(t, env.hasher.NeedsRehash(newHash))
+			if tt.wantHash {
+				assert.Equal(t, newHash, loggedIn.PasswordHash)
 			} else {
-				require.NoError(t, err)
-				require.NotNil(t, user)
-				tt.validateUser(t, user)
+				assert.Equal(t, oldHash, loggedIn.PasswordHash)
 			}
 		})
 	}
+
+	t.Run("актуальный хеш не пересчитывается", func(t *testing.T) {
+		s, env := newTestService(t, testConfig())
+		env.usersRepo.GetUserByEmailMock.Return(env.testUser(t), nil)
+
+		_, err := s.Login(ctx, dto.LoginRequest{Email: testEmail, Password: testPassword})
+		require.NoError(t, err)
+		assert.Zero(t, env.usersRepo.UpdatePasswordHashAfterCounter())
+	})
+}
+
+func TestAuthService_Refresh(t *testing.T) {
+	ctx := context.Background()
+
+	refresh := func(s *AuthService, refreshToken string) (*models.User, error) {
+		return s.Refresh(ctx, dto.RefreshRequest{RefreshToken: refreshToken, DeviceID: "phone"})
+	}
+
+	t.Run("успешное обновление токена", func(t *testing.T) {
+		s, env := newTestService(t, testConfig())
+		user := env.testUser(t)
+		tokens := env.login(t, s, user, "phone")
+		env.usersRepo.GetUserByIDMock.Expect(ctx, testUserID).Return(user, nil)
+
+		refreshed, err := refresh(s, tokens.RefreshToken)
+		require.NoError(t, err)
+		assert.NotEqual(t, tokens.RefreshToken, refreshed.Token.RefreshToken)
+
+		oldClaims, err := env.tokenManager.VerifyRefreshToken(ctx, tokens.RefreshToken)
+		require.NoError(t, err)
+		newClaims, err := env.tokenManager.VerifyRefreshToken(ctx, refreshed.Token.RefreshToken)
+		require.NoError(t, err)
+
+		old := env.refreshTokens.tokens[oldClaims.JWTID]
+		require.NotNil(t, old.UsedAt)
+		assert.Equal(t, newClaims.JWTID, *old.ReplacedByJTI)
+		// Время входа переходит в новый токен цепочки
+		assert.True(t, old.SessionStartedAt.Equal(env.refreshTokens.tokens[newClaims.JWTID].SessionStartedAt))
+	})
+
+	t.Run("неверный refresh token", func(t *testing.T) {
+		s, _ := newTestService(t, testConfig())
+
+		_, err := refresh(s, "invalid-token")
+		require.ErrorIs(t, err, ErrInvalidToken)
+	})
+
+	t.Run("токен не совпадает с сохраненным хешем", func(t *testing.T) {
+		s, env := newTestService(t, testConfig())
+		tokens := env.login(t, s, env.testUser(t), "phone")
+
+		claims, err := env.tokenManager.VerifyRefreshToken(ctx, tokens.RefreshToken)
+		require.NoError(t, err)
+		env.refreshTokens.tokens[claims.JWTID].TokenHash = crypto.HashToken("other")
+
+		_, err = refresh(s, tokens.RefreshToken)
+		require.ErrorIs(t, err, ErrInvalidToken)
+	})
+
+	t.Run("токен после logout отозван", func(t *testing.T) {
+		s, env := newTestService(t, testConfig())
+		tokens := env.login(t, s, env.testUser(t), "phone")
+		require.NoError(t, s.Logout(ctx, dto.LogoutRequest{RefreshToken: tokens.RefreshToken}))
+
+		_, err := refresh(s, tokens.RefreshToken)
+		require.ErrorIs(t, err, ErrTokenRevoked)
+		assert.Empty(t, env.auditLog.events)
+	})
+
+	t.Run("истекший токен", func(t *testing.T) {
+		s, env := newTestService(t, testConfig())
+		tokens := env.login(t, s, env.testUser(t), "phone")
+
+		claims, err := env.tokenManager.VerifyRefreshToken(ctx, tokens.RefreshToken)
+		require.NoError(t, err)
+		env.refreshTokens.tokens[claims.JWTID].ExpiresAt = time.Now().Add(-time.Minute)
+
+		_, err = refresh(s, tokens.RefreshToken)
+		require.ErrorIs(t, err, ErrTokenExpired)
+	})
+}
+
+func TestAuthService_RefreshTokenReuse(t *testing.T) {
+	ctx := context.Background()
+
+	// refresh возвращает токены сразу: Refresh заполняет их в общий объект пользователя из мока
+	refresh := func(s *AuthService, refreshToken string) (*models.UserToken, error) {
+		user, err := s.Refresh(ctx, dto.RefreshRequest{RefreshToken: refreshToken, DeviceID: "phone"})
+		if err != nil {
+			return nil, err
+		}
+		return user.Token, nil
+	}
+	jti := func(t *testing.T, env *testEnv, refreshToken string) string {
+		claims, err := env.tokenManager.VerifyRefreshToken(ctx, refreshToken)
+		require.NoError(t, err)
+		return claims.JWTID
+	}
+
+	t.Run("повторное предъявление отзывает все семейство", func(t *testing.T) {
+		s, env := newTestService(t, testConfig())
+		user := env.testUser(t)
+		env.usersRepo.GetUserByIDMock.Return(user, nil)
+
+		// Цепочка ротации: first -> second -> third
+		first := env.login(t, s, user, "phone")
+		second, err := refresh(s, first.RefreshToken)
+		require.NoError(t, err)
+		third, err := refresh(s, second.RefreshToken)
+		require.NoError(t, err)
+
+		// Соседняя сессия того же пользователя не относится к семейству
+		other := env.login(t, s, user, "laptop")
+
+		// Украденный second предъявлен повторно
+		_, err = refresh(s, second.RefreshToken)
+		require.ErrorIs(t, err, ErrTokenUsed)
+
+		assert.NotNil(t, env.refreshTokens.tokens[jti(t, env, second.RefreshToken)].RevokedAt)
+		assert.NotNil(t, env.refreshTokens.tokens[jti(t, env, third.RefreshToken)].RevokedAt)
+		assert.Nil(t, env.refreshTokens.tokens[jti(t, env, first.RefreshToken)].RevokedAt)
+		assert.Nil(t, env.refreshTokens.tokens[jti(t, env, other.RefreshToken)].RevokedAt)
+
+		// Последний токен цепочки больше не обновляется
+		_, err = refresh(s, third.RefreshToken)
+		require.ErrorIs(t, err, ErrTokenRevoked)
+
+		// Уже выданные access токены пользователя отозваны
+		watermark := env.revocations.watermarks[testUserID]
+		require.NotNil(t, watermark)
+		assert.WithinDuration(t, time.Now(), watermark.NotBefore, time.Minute)
+
+		require.Len(t, env.auditLog.events, 1)
+		event := env.auditLog.events[0]
+		assert.Equal(t, models.AuditEventRefreshTokenReuse, event.Type)
+		assert.Equal(t, testUserID, *event.UserID)
+		assert.Equal(t, "phone", event.Details["device_id"])
+		assert.ElementsMatch(t,
+			[]string{jti(t, env, second.RefreshToken), jti(t, env, third.RefreshToken)},
+			event.Details["revoked_jtis"],
+		)
+	})
+
+	t.Run("семейство отзывается один раз", func(t *testing.T) {
+		s, env := newTestService(t, testConfig())
+		user := env.testUser(t)
+		env.usersRepo.GetUserByIDMock.Return(user, nil)
+
+		first := env.login(t, s, user, "phone")
+		_, err := refresh(s, first.RefreshToken)
+		require.NoError(t, err)
+
+		_, err = refresh(s, first.RefreshToken)
+		require.ErrorIs(t, err, ErrTokenUsed)
+		// first уже отозван, второе предъявление отклоняется как отозванный токен
+		_, err = refresh(s, first.RefreshToken)
+		require.ErrorIs(t, err, ErrTokenRevoked)
+
+		require.Len(t, env.auditLog.events, 1)
+		assert.Len(t, env.auditLog.events[0].Details["revoked_jtis"], 2)
+	})
 }
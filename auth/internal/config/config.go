@@ -3,11 +3,14 @@ package config
 import (
 	"context"
 	"fmt"
+	"net/netip"
 	"strings"
 	"time"
 
 	"github.com/spf13/viper"
 	libconfig "github.com/sskorolev/balun_microservices/lib/config"
+
+	authPb "auth/pkg/api"
 )
 
 type Config struct {
@@ -15,6 +18,7 @@ type Config struct {
 	Auth   AuthConfig
 	Keys   KeysConfig
 	Crypto CryptoConfig
+	Mail   MailConfig
 }
 
 type AuthConfig struct {
@@ -22,12 +26,55 @@ type AuthConfig struct {
 	Audience        []string
 	AccessTokenTTL  time.Duration
 	RefreshTokenTTL time.Duration
+	LoginProtection LoginProtectionConfig
+	// TrustedProxies - адреса прокси (gateway), от которых принимается x-forwarded-for
+	TrustedProxies []netip.Prefix
+	// EmailVerification, PasswordReset - одноразовые ссылки из писем
+	EmailVerification ActionTokenConfig
+	PasswordReset     ActionTokenConfig
+}
+
+// ActionTokenConfig - одноразовый токен, отправляемый ссылкой в письме
+type ActionTokenConfig struct {
+	TTL time.Duration
+	URL string
+}
+
+// MailConfig - отправка писем: log - в лог, file - в .eml файлы каталога Dir
+type MailConfig struct {
+	Sender string
+	Dir    string
+}
+
+// LoginProtectionConfig - защита входа от перебора: задержки и временная блокировка по email и IP
+type LoginProtectionConfig struct {
+	Enabled               bool
+	Window                time.Duration
+	FreeAttempts          int
+	BaseDelay             time.Duration
+	MaxDelay              time.Duration
+	EmailLockoutThreshold int
+	IPLockoutThreshold    int
+	LockoutDuration       time.Duration
 }
 
 type KeysConfig struct {
 	Storage string
-	Vault   VaultKeysConfig
-	DB      DBKeysConfig
+	// Algorithm - алгоритм подписи новых ключей (RS256, ES256, EdDSA), существующие ключи его сохраняют
+	Algorithm string
+	Vault     VaultKeysConfig
+	DB        DBKeysConfig
+	Rotation  KeyRotationConfig
+}
+
+// KeyRotationConfig - настройки автоматической ротации RSA ключей
+type KeyRotationConfig struct {
+	Enabled bool
+	// Period - как долго ключ подписывает токены
+	Period time.Duration
+	// Overlap - сколько next ключ публикуется в JWKS до перевода в active (не меньше TTL кэша JWKS)
+	Overlap       time.Duration
+	CheckInterval time.Duration
 }
 
 type VaultKeysConfig struct {
@@ -43,8 +90,18 @@ type CryptoConfig struct {
 }
 
 type PasswordConfig struct {
+	// Algorithm - алгоритм новых хешей (argon2id, bcrypt), старые хеши пересчитываются при входе
+	Algorithm  string
 	BcryptCost int
 	MinLength  int
+	Argon2id   Argon2idConfig
+}
+
+// Argon2idConfig - параметры argon2id, нулевые значения берутся по умолчанию
+type Argon2idConfig struct {
+	MemoryKiB   uint32
+	Iterations  uint32
+	Parallelism uint8
 }
 
 func LoadConfig(ctx context.Context) (*Config, error) {
@@ -67,6 +124,7 @@ func LoadConfig(ctx context.Context) (*Config, error) {
 	// Для Vault в production окружении нужно добавить secrets конфигурацию
 	serviceCfg, err := libconfig.LoadServiceConfig(ctx, "auth",
 		libconfig.WithUsersService("users", 8082),
+		libconfig.WithIdempotency(24*time.Hour, authPb.AuthService_Register_FullMethodName),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to load service config: %w", err)
@@ -91,6 +149,24 @@ func loadAuthConfig(cfg *Config) error {
 		Audience:        viper.GetStringSlice("auth.audience"),
 		AccessTokenTTL:  viper.GetDuration("auth.access_token_ttl"),
 		RefreshTokenTTL: viper.GetDuration("auth.refresh_token_ttl"),
+		LoginProtection: LoginProtectionConfig{
+			Enabled:               viper.GetBool("auth.login_protection.enabled"),
+			Window:                viper.GetDuration("auth.login_protection.window"),
+			FreeAttempts:          viper.GetInt("auth.login_protection.free_attempts"),
+			BaseDelay:             viper.GetDuration("auth.login_protection.base_delay"),
+			MaxDelay:              viper.GetDuration("auth.login_protection.max_delay"),
+			EmailLockoutThreshold: viper.GetInt("auth.login_protection.email_lockout_threshold"),
+			IPLockoutThreshold:    viper.GetInt("auth.login_protection.ip_lockout_threshold"),
+			LockoutDuration:       viper.GetDuration("auth.login_protection.lockout_duration"),
+		},
+		EmailVerification: ActionTokenConfig{
+			TTL: viper.GetDuration("auth.email_verification.ttl"),
+			URL: viper.GetString("auth.email_verification.url"),
+		},
+		PasswordReset: ActionTokenConfig{
+			TTL: viper.GetDuration("auth.password_reset.ttl"),
+			URL: viper.GetString("auth.password_reset.url"),
+		},
 	}
 
 	// Валидация auth
@@ -107,15 +183,71 @@ func loadAuthConfig(cfg *Config) error {
 		cfg.Auth.RefreshTokenTTL = 720 * time.Hour // default 30 days
 	}
 
+	// Валидация login_protection
+	loginProtection := &cfg.Auth.LoginProtection
+	if loginProtection.Window == 0 {
+		loginProtection.Window = 15 * time.Minute // default
+	}
+	if loginProtection.FreeAttempts == 0 {
+		loginProtection.FreeAttempts = 3 // default
+	}
+	if loginProtection.BaseDelay == 0 {
+		loginProtection.BaseDelay = time.Second // default
+	}
+	if loginProtection.MaxDelay == 0 {
+		loginProtection.MaxDelay = 30 * time.Second // default
+	}
+	if loginProtection.EmailLockoutThreshold == 0 {
+		loginProtection.EmailLockoutThreshold = 10 // default
+	}
+	if loginProtection.IPLockoutThreshold == 0 {
+		loginProtection.IPLockoutThreshold = 50 // default, за одним IP может быть много пользователей
+	}
+	if loginProtection.LockoutDuration == 0 {
+		loginProtection.LockoutDuration = 15 * time.Minute // default
+	}
+	if loginProtection.EmailLockoutThreshold <= loginProtection.FreeAttempts ||
+		loginProtection.IPLockoutThreshold <= loginProtection.FreeAttempts {
+		return fmt.Errorf("auth.login_protection lockout thresholds must be greater than free_attempts")
+	}
+
+	// Валидация trusted_proxies
+	trustedProxies, err := parseTrustedProxies(viper.GetStringSlice("auth.trusted_proxies"))
+	if err != nil {
+		return err
+	}
+	cfg.Auth.TrustedProxies = trustedProxies
+
+	// Валидация email_verification и password_reset
+	if cfg.Auth.EmailVerification.TTL == 0 {
+		cfg.Auth.EmailVerification.TTL = 24 * time.Hour // default
+	}
+	if cfg.Auth.PasswordReset.TTL == 0 {
+		cfg.Auth.PasswordReset.TTL = time.Hour // default
+	}
+	if cfg.Auth.EmailVerification.URL == "" {
+		cfg.Auth.EmailVerification.URL = "http://localhost:8080/verify-email" // default
+	}
+	if cfg.Auth.PasswordReset.URL == "" {
+		cfg.Auth.PasswordReset.URL = "http://localhost:8080/reset-password" // default
+	}
+
 	// Keys
 	cfg.Keys = KeysConfig{
-		Storage: viper.GetString("keys.storage"),
+		Storage:   viper.GetString("keys.storage"),
+		Algorithm: viper.GetString("keys.algorithm"),
 		Vault: VaultKeysConfig{
 			Path: viper.GetString("keys.vault.path"),
 		},
 		DB: DBKeysConfig{
 			AutoCreateOnStart: viper.GetBool("keys.db.auto_create_on_start"),
 		},
+		Rotation: KeyRotationConfig{
+			Enabled:       viper.GetBool("keys.rotation.enabled"),
+			Period:        viper.GetDuration("keys.rotation.period"),
+			Overlap:       viper.GetDuration("keys.rotation.overlap"),
+			CheckInterval: viper.GetDuration("keys.rotation.check_interval"),
+		},
 	}
 
 	// Валидация keys
@@ -127,11 +259,38 @@ func loadAuthConfig(cfg *Config) error {
 		return fmt.Errorf("keys.vault.path is required when storage is vault")
 	}
 
+	switch cfg.Keys.Algorithm {
+	case "":
+		cfg.Keys.Algorithm = "RS256" // default
+	case "RS256", "ES256", "EdDSA":
+	default:
+		return fmt.Errorf("keys.algorithm must be one of RS256, ES256, EdDSA")
+	}
+
+	if cfg.Keys.Rotation.Period == 0 {
+		cfg.Keys.Rotation.Period = 720 * time.Hour // default 30 days
+	}
+	if cfg.Keys.Rotation.Overlap == 0 {
+		cfg.Keys.Rotation.Overlap = 10 * time.Minute // default, TTL кэша JWKS 5 минут
+	}
+	if cfg.Keys.Rotation.CheckInterval == 0 {
+		cfg.Keys.Rotation.CheckInterval = time.Minute // default
+	}
+	if cfg.Keys.Rotation.Overlap >= cfg.Keys.Rotation.Period {
+		return fmt.Errorf("keys.rotation.overlap must be less than keys.rotation.period")
+	}
+
 	// Crypto
 	cfg.Crypto = CryptoConfig{
 		Password: PasswordConfig{
+			Algorithm:  viper.GetString("crypto.password.algorithm"),
 			BcryptCost: viper.GetInt("crypto.password.bcrypt_cost"),
 			MinLength:  viper.GetInt("crypto.password.min_length"),
+			Argon2id: Argon2idConfig{
+				MemoryKiB:   viper.GetUint32("crypto.password.argon2id.memory_kib"),
+				Iterations:  viper.GetUint32("crypto.password.argon2id.iterations"),
+				Parallelism: uint8(viper.GetUint("crypto.password.argon2id.parallelism")),
+			},
 		},
 	}
 
@@ -142,6 +301,49 @@ func loadAuthConfig(cfg *Config) error {
 	if cfg.Crypto.Password.MinLength == 0 {
 		cfg.Crypto.Password.MinLength = 6 // default
 	}
+	switch cfg.Crypto.Password.Algorithm {
+	case "":
+		cfg.Crypto.Password.Algorithm = "argon2id" // default
+	case "argon2id", "bcrypt":
+	default:
+		return fmt.Errorf("crypto.password.algorithm must be one of argon2id, bcrypt")
+	}
+
+	// Mail
+	cfg.Mail = MailConfig{
+		Sender: viper.GetString("mail.sender"),
+		Dir:    viper.GetString("mail.dir"),
+	}
+
+	// Валидация mail
+	switch cfg.Mail.Sender {
+	case "":
+		cfg.Mail.Sender = "log" // default
+	case "log", "file":
+	default:
+		return fmt.Errorf("mail.sender must be one of log, file")
+	}
+	if cfg.Mail.Sender == "file" && cfg.Mail.Dir == "" {
+		return fmt.Errorf("mail.dir is required when sender is file")
+	}
 
 	return nil
 }
+
+// parseTrustedProxies разбирает адреса доверенных прокси: подсети (10.0.0.0/8) или отдельные IP
+func parseTrustedProxies(values []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(values))
+	for _, value := range values {
+		if addr, err := netip.ParseAddr(value); err == nil {
+			prefixes = append(prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+			continue
+		}
+
+		prefix, err := netip.ParsePrefix(value)
+		if err != nil {
+			return nil, fmt.Errorf("auth.trusted_proxies: invalid address %q", value)
+		}
+		prefixes = append(prefixes, prefix.Masked())
+	}
+	return prefixes, nil
+}
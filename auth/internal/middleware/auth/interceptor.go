@@ -0,0 +1,100 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"auth/internal/app/token"
+)
+
+const (
+	authorizationHeader = "authorization"
+	bearerPrefix        = "Bearer "
+)
+
+type userIDKey struct{}
+
+// AccessTokenVerifier - проверка access токена
+type AccessTokenVerifier interface {
+	VerifyAccessToken(ctx context.Context, tokenString string) (*token.AccessTokenClaims, error)
+}
+
+// RevocationChecker - проверка отзыва access токена (logout, отзыв всех токенов пользователя)
+type RevocationChecker interface {
+	IsAccessTokenRevoked(ctx context.Context, jti, userID string, issuedAt time.Time) (bool, error)
+}
+
+// UnaryServerInterceptor проверяет access токен для перечисленных методов.
+//
+// Большая часть методов auth (Register, Login, Refresh) вызывается без токена,
+// поэтому аутентификация включается только для методов из списка.
+// Отзыв проверяется по БД auth, а не по копии списка, как в authmw.
+func UnaryServerInterceptor(verifier AccessTokenVerifier, revocations RevocationChecker, methods ...string) grpc.UnaryServerInterceptor {
+	protected := make(map[string]struct{}, len(methods))
+	for _, method := range methods {
+		protected[method] = struct{}{}
+	}
+
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if _, ok := protected[info.FullMethod]; !ok {
+			return handler(ctx, req)
+		}
+
+		md, _ := metadata.FromIncomingContext(ctx)
+		authHeaders := md.Get(authorizationHeader)
+		if len(authHeaders) == 0 {
+			return nil, status.Error(codes.Unauthenticated, "missing authorization header")
+		}
+
+		tokenString, ok := BearerToken(ctx)
+		if !ok {
+			return nil, status.Error(codes.Unauthenticated, "invalid authorization header format")
+		}
+
+		claims, err := verifier.VerifyAccessToken(ctx, tokenString)
+		if err != nil {
+			if errors.Is(err, token.ErrTokenExpired) {
+				return nil, status.Error(codes.Unauthenticated, "token expired")
+			}
+			return nil, status.Error(codes.Unauthenticated, "invalid token")
+		}
+
+		revoked, err := revocations.IsAccessTokenRevoked(ctx, claims.JWTID, claims.Subject, time.Unix(claims.IssuedAt, 0))
+		if err != nil {
+			return nil, status.Error(codes.Internal, "failed to check token revocation")
+		}
+		if revoked {
+			return nil, status.Error(codes.Unauthenticated, "token revoked")
+		}
+
+		return handler(context.WithValue(ctx, userIDKey{}, claims.Subject), req)
+	}
+}
+
+// BearerToken возвращает access токен из заголовка authorization без проверки
+func BearerToken(ctx context.Context) (string, bool) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	authHeaders := md.Get(authorizationHeader)
+	if len(authHeaders) == 0 || !strings.HasPrefix(authHeaders[0], bearerPrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(authHeaders[0], bearerPrefix), true
+}
+
+// GetUserID возвращает пользователя, аутентифицированного интерсептором
+func GetUserID(ctx context.Context) (string, bool) {
+	userID, ok := ctx.Value(userIDKey{}).(string)
+	return userID, ok && userID != ""
+}
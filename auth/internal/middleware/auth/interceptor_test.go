@@ -0,0 +1,108 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"auth/internal/app/token"
+)
+
+type stubVerifier struct{}
+
+func (stubVerifier) VerifyAccessToken(_ context.Context, tokenString string) (*token.AccessTokenClaims, error) {
+	if tokenString != "valid" {
+		return nil, token.ErrInvalidToken
+	}
+	return &token.AccessTokenClaims{Subject: "user", IssuedAt: 1000, JWTID: "jti"}, nil
+}
+
+type stubRevocations struct {
+	revoked bool
+	err     error
+}
+
+func (r stubRevocations) IsAccessTokenRevoked(_ context.Context, jti, userID string, issuedAt time.Time) (bool, error) {
+	if jti != "jti" || userID != "user" || issuedAt.Unix() != 1000 {
+		return false, errors.New("unexpected claims")
+	}
+	return r.revoked, r.err
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	const method = "/auth.AuthService/ListSessions"
+
+	handler := func(ctx context.Context, _ any) (any, error) {
+		userID, _ := GetUserID(ctx)
+		return userID, nil
+	}
+	call := func(revocations stubRevocations, fullMethod, accessToken string) (any, error) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(authorizationHeader, bearerPrefix+accessToken))
+		interceptor := UnaryServerInterceptor(stubVerifier{}, revocations, method)
+		return interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: fullMethod}, handler)
+	}
+
+	tests := []struct {
+		name        string
+		revocations stubRevocations
+		fullMethod  string
+		accessToken string
+		wantUserID  any
+		wantCode    codes.Code
+	}{
+		{
+			name:        "действующий токен",
+			fullMethod:  method,
+			accessToken: "valid",
+			wantUserID:  "user",
+		},
+		{
+			name:        "отозванный токен",
+			revocations: stubRevocations{revoked: true},
+			fullMethod:  method,
+			accessToken: "valid",
+			wantCode:    codes.Unauthenticated,
+		},
+		{
+			name:        "ошибка проверки отзыва",
+			revocations: stubRevocations{err: errors.New("database error")},
+			fullMethod:  method,
+			accessToken: "valid",
+			wantCode:    codes.Internal,
+		},
+		{
+			name:        "невалидный токен",
+			fullMethod:  method,
+			accessToken: "invalid",
+			wantCode:    codes.Unauthenticated,
+		},
+		{
+			name:        "публичный метод без проверки",
+			revocations: stubRevocations{revoked: true},
+			fullMethod:  "/auth.AuthService/Login",
+			accessToken: "invalid",
+			wantUserID:  "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := call(tt.revocations, tt.fullMethod, tt.accessToken)
+			if tt.wantCode != codes.OK {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantCode, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantUserID, resp)
+		})
+	}
+}
@@ -3,14 +3,18 @@ package errors
 import (
 	"context"
 	"errors"
+	"time"
 
+	"auth/internal/app/crypto"
 	"auth/internal/app/usecase"
 
 	"auth/internal/app/models"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 // ErrorsUnaryInterceptor - convert any arror to rpc error
@@ -27,13 +31,24 @@ func ErrorsUnaryInterceptor() grpc.UnaryServerInterceptor {
 			return resp, err
 		}
 
+		var lockedErr *usecase.LoginLockedError
+
 		switch {
-		case errors.Is(err, models.ErrNotFound):
+		case errors.As(err, &lockedErr):
+			err = loginLockedStatus(lockedErr)
+		case errors.Is(err, models.ErrNotFound) || errors.Is(err, usecase.ErrSessionNotFound):
 			err = status.Error(codes.NotFound, err.Error())
-		case errors.Is(err, models.ErrAlreadyExists):
+		case errors.Is(err, models.ErrAlreadyExists) || errors.Is(err, models.ErrNicknameTaken):
 			err = status.Error(codes.AlreadyExists, err.Error())
 		case errors.Is(err, usecase.ErrWrongPassword) || errors.Is(err, usecase.ErrWrongToken):
 			err = status.Error(codes.Unauthenticated, err.Error())
+		case errors.Is(err, usecase.ErrTokenUsed) || errors.Is(err, usecase.ErrTokenRevoked) ||
+			errors.Is(err, usecase.ErrTokenExpired) || errors.Is(err, usecase.ErrInvalidToken):
+			err = status.Error(codes.Unauthenticated, err.Error())
+		case errors.Is(err, usecase.ErrInvalidActionToken) || errors.Is(err, crypto.ErrPasswordTooShort):
+			err = status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, usecase.ErrEmailAlreadyVerified):
+			err = status.Error(codes.FailedPrecondition, err.Error())
 		default:
 			err = status.Error(codes.Unknown, err.Error())
 		}
@@ -41,3 +56,13 @@ func ErrorsUnaryInterceptor() grpc.UnaryServerInterceptor {
 		return resp, err
 	}
 }
+
+// loginLockedStatus - ResourceExhausted с RetryInfo, через сколько можно повторить вход
+func loginLockedStatus(err *usecase.LoginLockedError) error {
+	st := status.New(codes.ResourceExhausted, err.Error())
+	retryAfter := max(err.RetryAfter, time.Second)
+	if withDetails, derr := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(retryAfter)}); derr == nil {
+		st = withDetails
+	}
+	return st.Err()
+}
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE public.refresh_tokens ADD COLUMN IF NOT EXISTS revoked_at TIMESTAMPTZ;
+
+COMMENT ON COLUMN public.refresh_tokens.revoked_at IS 'Время отзыва токена (logout или отзыв семейства при переиспользовании)';
+
+CREATE TABLE IF NOT EXISTS public.auth_audit_log (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID,
+    event_type TEXT NOT NULL,
+    details JSONB NOT NULL DEFAULT '{}'::jsonb,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_auth_audit_log_user_id_created_at ON public.auth_audit_log(user_id, created_at DESC);
+CREATE INDEX idx_auth_audit_log_event_type_created_at ON public.auth_audit_log(event_type, created_at DESC);
+
+COMMENT ON TABLE public.auth_audit_log IS 'Журнал событий безопасности auth сервиса';
+COMMENT ON COLUMN public.auth_audit_log.event_type IS 'Тип события (refresh_token_reuse_detected и т.п.)';
+COMMENT ON COLUMN public.auth_audit_log.details IS 'Параметры события';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS public.auth_audit_log;
+ALTER TABLE public.refresh_tokens DROP COLUMN IF EXISTS revoked_at;
+-- +goose StatementEnd
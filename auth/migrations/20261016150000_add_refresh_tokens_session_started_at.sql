@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE public.refresh_tokens ADD COLUMN IF NOT EXISTS session_started_at TIMESTAMPTZ;
+UPDATE public.refresh_tokens SET session_started_at = created_at WHERE session_started_at IS NULL;
+ALTER TABLE public.refresh_tokens ALTER COLUMN session_started_at SET DEFAULT now();
+ALTER TABLE public.refresh_tokens ALTER COLUMN session_started_at SET NOT NULL;
+
+-- Индекс для выборки активных сессий пользователя
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_active_sessions
+    ON public.refresh_tokens(user_id, device_id)
+    WHERE used_at IS NULL AND revoked_at IS NULL;
+
+COMMENT ON COLUMN public.refresh_tokens.session_started_at IS 'Время входа, переносится на новые токены при ротации';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS public.idx_refresh_tokens_active_sessions;
+ALTER TABLE public.refresh_tokens DROP COLUMN IF EXISTS session_started_at;
+-- +goose StatementEnd
@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS public.revoked_access_tokens (
+    jti TEXT PRIMARY KEY,
+    user_id UUID NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_revoked_access_tokens_expires_at ON public.revoked_access_tokens(expires_at);
+
+COMMENT ON TABLE public.revoked_access_tokens IS 'Отозванные access токены, хранятся до истечения токена';
+
+CREATE TABLE IF NOT EXISTS public.access_token_watermarks (
+    user_id UUID PRIMARY KEY,
+    not_before TIMESTAMPTZ NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_access_token_watermarks_expires_at ON public.access_token_watermarks(expires_at);
+
+COMMENT ON TABLE public.access_token_watermarks IS 'Отзыв всех access токенов пользователя, выпущенных до not_before';
+COMMENT ON COLUMN public.access_token_watermarks.expires_at IS 'Время, когда все затронутые токены истекли и запись можно удалить';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS public.access_token_watermarks;
+DROP TABLE IF EXISTS public.revoked_access_tokens;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Алгоритм подписи задается для каждого ключа: существующие ключи остаются RS256
+ALTER TABLE public.rsa_keys ADD COLUMN algorithm TEXT NOT NULL DEFAULT 'RS256'
+    CHECK (algorithm IN ('RS256', 'ES256', 'EdDSA'));
+
+COMMENT ON COLUMN public.rsa_keys.algorithm IS 'Алгоритм подписи JWT: RS256, ES256 или EdDSA';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE public.rsa_keys DROP COLUMN IF EXISTS algorithm;
+-- +goose StatementEnd
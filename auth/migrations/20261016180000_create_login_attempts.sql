@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS public.login_attempts (
+    scope TEXT NOT NULL CHECK (scope IN ('email', 'ip')),
+    key TEXT NOT NULL,
+    failed_count INT NOT NULL DEFAULT 0,
+    last_failed_at TIMESTAMPTZ NOT NULL,
+    locked_until TIMESTAMPTZ,
+    PRIMARY KEY (scope, key)
+);
+
+CREATE INDEX idx_login_attempts_last_failed_at ON public.login_attempts(last_failed_at);
+
+COMMENT ON TABLE public.login_attempts IS 'Неудачные попытки входа по email и IP клиента за текущее окно';
+COMMENT ON COLUMN public.login_attempts.locked_until IS 'До этого момента попытки входа отклоняются (задержка или блокировка)';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS public.login_attempts;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Регистрация - сага auth -> users: пользователь создается в статусе pending
+-- и становится completed после создания профиля в users. Существующие пользователи завершены
+ALTER TABLE public.users ADD COLUMN registration_status TEXT NOT NULL DEFAULT 'completed'
+    CHECK (registration_status IN ('pending', 'completed'));
+
+COMMENT ON COLUMN public.users.registration_status IS 'Статус саги регистрации: pending - профиль в users еще не создан';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE public.users DROP COLUMN IF EXISTS registration_status;
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE public.users ADD COLUMN email_verified_at TIMESTAMPTZ;
+
+COMMENT ON COLUMN public.users.email_verified_at IS 'Время подтверждения email, NULL - email не подтвержден';
+
+CREATE TABLE IF NOT EXISTS public.user_action_tokens (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES public.users(id) ON DELETE CASCADE,
+    purpose TEXT NOT NULL CHECK (purpose IN ('email_verification', 'password_reset')),
+    token_hash TEXT NOT NULL UNIQUE,
+    expires_at TIMESTAMPTZ NOT NULL,
+    used_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_user_action_tokens_user_id ON public.user_action_tokens(user_id, purpose);
+CREATE INDEX idx_user_action_tokens_expires_at ON public.user_action_tokens(expires_at);
+
+COMMENT ON TABLE public.user_action_tokens IS 'Одноразовые токены подтверждения email и сброса пароля';
+COMMENT ON COLUMN public.user_action_tokens.token_hash IS 'SHA-256 хеш токена, сам токен отправляется только в письме';
+COMMENT ON COLUMN public.user_action_tokens.used_at IS 'Время использования или аннулирования токена';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS public.user_action_tokens;
+ALTER TABLE public.users DROP COLUMN IF EXISTS email_verified_at;
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Смена email: новый адрес хранится отдельно и заменяет email только после подтверждения по ссылке
+ALTER TABLE public.users ADD COLUMN pending_email TEXT;
+
+COMMENT ON COLUMN public.users.pending_email IS 'Новый email, ожидающий подтверждения, NULL - смена email не запрошена';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE public.users DROP COLUMN IF EXISTS pending_email;
+-- +goose StatementEnd
@@ -26,8 +26,10 @@ type RegisterRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// email -электронная почта
 	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	// password - пароль пользователя
-	Password      string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	//  password - пароль пользователя
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	// nickname - никнейм профиля, правило users: ^[a-z0-9_]{3,20}$
+	Nickname      string `protobuf:"bytes,3,opt,name=nickname,proto3" json:"nickname,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -76,6 +78,13 @@ func (x *RegisterRequest) GetPassword() string {
 	return ""
 }
 
+func (x *RegisterRequest) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
 // RegisterResponse - ответ Register
 type RegisterResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -127,7 +136,7 @@ type LoginRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// email -электронная почта
 	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	// password - пароль пользователя
+	//  password - пароль пользователя
 	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 	// device_id - id устройства
 	DeviceId      *string `protobuf:"bytes,3,opt,name=device_id,json=deviceId,proto3,oneof" json:"device_id,omitempty"`
@@ -388,6 +397,8 @@ func (x *RefreshResponse) GetExpiresInS() string {
 }
 
 // LogoutRequest - запрос Logout
+//
+// Если передан заголовок authorization, текущий access токен тоже отзывается.
 type LogoutRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// refreshToken - токен после протухания accessToken
@@ -552,46 +563,101 @@ func (x *GetJWKSResponse) GetJwks() []*JWK {
 	return nil
 }
 
-// JWK - JSON Web Key для валидации JWT токенов
-type JWK struct {
+// Session - сессия пользователя на устройстве
+type Session struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// kty - тип ключа (например, "RSA", "EC")
-	Kty string `protobuf:"bytes,1,opt,name=kty,proto3" json:"kty,omitempty"`
-	// use - назначение ключа (например, "sig" для подписи, "enc" для шифрования)
-	Use string `protobuf:"bytes,2,opt,name=use,proto3" json:"use,omitempty"`
-	// kid - уникальный идентификатор ключа
-	Kid string `protobuf:"bytes,3,opt,name=kid,proto3" json:"kid,omitempty"`
-	// alg - алгоритм (например, "RS256", "ES256")
-	Alg string `protobuf:"bytes,4,opt,name=alg,proto3" json:"alg,omitempty"`
-	// n - модуль RSA ключа (base64url encoded) - для RSA ключей
-	N string `protobuf:"bytes,5,opt,name=n,proto3" json:"n,omitempty"`
-	// e - экспонента RSA ключа (base64url encoded) - для RSA ключей
-	E string `protobuf:"bytes,6,opt,name=e,proto3" json:"e,omitempty"`
-	// x - X координата - для EC (Elliptic Curve) ключей
-	X string `protobuf:"bytes,7,opt,name=x,proto3" json:"x,omitempty"`
-	// y - Y координата - для EC (Elliptic Curve) ключей
-	Y string `protobuf:"bytes,8,opt,name=y,proto3" json:"y,omitempty"`
-	// crv - тип эллиптической кривой (например, "P-256") - для EC ключей
-	Crv           string `protobuf:"bytes,9,opt,name=crv,proto3" json:"crv,omitempty"`
+	// deviceId - id устройства, пусто для входа без device_id
+	DeviceId string `protobuf:"bytes,1,opt,name=deviceId,proto3" json:"deviceId,omitempty"`
+	// createdAtUnixMs - время входа в миллисекундах
+	CreatedAtUnixMs int64 `protobuf:"varint,2,opt,name=createdAtUnixMs,proto3" json:"createdAtUnixMs,omitempty"`
+	// lastRefreshAtUnixMs - время последнего обновления токенов в миллисекундах
+	LastRefreshAtUnixMs int64 `protobuf:"varint,3,opt,name=lastRefreshAtUnixMs,proto3" json:"lastRefreshAtUnixMs,omitempty"`
+	// expiresAtUnixMs - время истечения refresh токена в миллисекундах
+	ExpiresAtUnixMs int64 `protobuf:"varint,4,opt,name=expiresAtUnixMs,proto3" json:"expiresAtUnixMs,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Session) Reset() {
+	*x = Session{}
+	mi := &file_api_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Session) ProtoMessage() {}
+
+func (x *Session) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Session.ProtoReflect.Descriptor instead.
+func (*Session) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Session) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *Session) GetCreatedAtUnixMs() int64 {
+	if x != nil {
+		return x.CreatedAtUnixMs
+	}
+	return 0
+}
+
+func (x *Session) GetLastRefreshAtUnixMs() int64 {
+	if x != nil {
+		return x.LastRefreshAtUnixMs
+	}
+	return 0
+}
+
+func (x *Session) GetExpiresAtUnixMs() int64 {
+	if x != nil {
+		return x.ExpiresAtUnixMs
+	}
+	return 0
+}
+
+// ListSessionsRequest - запрос ListSessions
+type ListSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *JWK) Reset() {
-	*x = JWK{}
-	mi := &file_api_service_proto_msgTypes[10]
+func (x *ListSessionsRequest) Reset() {
+	*x = ListSessionsRequest{}
+	mi := &file_api_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *JWK) String() string {
+func (x *ListSessionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JWK) ProtoMessage() {}
+func (*ListSessionsRequest) ProtoMessage() {}
 
-func (x *JWK) ProtoReflect() protoreflect.Message {
-	mi := &file_api_service_proto_msgTypes[10]
+func (x *ListSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -602,9 +668,1059 @@ func (x *JWK) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JWK.ProtoReflect.Descriptor instead.
-func (*JWK) Descriptor() ([]byte, []int) {
-	return file_api_service_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ListSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{11}
+}
+
+// ListSessionsResponse - ответ ListSessions
+type ListSessionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// sessions - активные сессии, от последней активной
+	Sessions      []*Session `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsResponse) Reset() {
+	*x = ListSessionsResponse{}
+	mi := &file_api_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsResponse) ProtoMessage() {}
+
+func (x *ListSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListSessionsResponse) GetSessions() []*Session {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+// RevokeSessionRequest - запрос RevokeSession
+type RevokeSessionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// deviceId - id устройства
+	DeviceId      string `protobuf:"bytes,1,opt,name=deviceId,proto3" json:"deviceId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionRequest) Reset() {
+	*x = RevokeSessionRequest{}
+	mi := &file_api_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionRequest) ProtoMessage() {}
+
+func (x *RevokeSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionRequest.ProtoReflect.Descriptor instead.
+func (*RevokeSessionRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RevokeSessionRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+// RevokeSessionResponse - ответ RevokeSession
+type RevokeSessionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// revoked - количество отозванных refresh токенов
+	Revoked       int64 `protobuf:"varint,1,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionResponse) Reset() {
+	*x = RevokeSessionResponse{}
+	mi := &file_api_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionResponse) ProtoMessage() {}
+
+func (x *RevokeSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionResponse.ProtoReflect.Descriptor instead.
+func (*RevokeSessionResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RevokeSessionResponse) GetRevoked() int64 {
+	if x != nil {
+		return x.Revoked
+	}
+	return 0
+}
+
+// RevokeAllOtherSessionsRequest - запрос RevokeAllOtherSessions
+type RevokeAllOtherSessionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// currentDeviceId - id текущего устройства, его сессия сохраняется
+	CurrentDeviceId string `protobuf:"bytes,1,opt,name=currentDeviceId,proto3" json:"currentDeviceId,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RevokeAllOtherSessionsRequest) Reset() {
+	*x = RevokeAllOtherSessionsRequest{}
+	mi := &file_api_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAllOtherSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAllOtherSessionsRequest) ProtoMessage() {}
+
+func (x *RevokeAllOtherSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAllOtherSessionsRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAllOtherSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RevokeAllOtherSessionsRequest) GetCurrentDeviceId() string {
+	if x != nil {
+		return x.CurrentDeviceId
+	}
+	return ""
+}
+
+// RevokeAllOtherSessionsResponse - ответ RevokeAllOtherSessions
+type RevokeAllOtherSessionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// revoked - количество отозванных refresh токенов
+	Revoked       int64 `protobuf:"varint,1,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAllOtherSessionsResponse) Reset() {
+	*x = RevokeAllOtherSessionsResponse{}
+	mi := &file_api_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAllOtherSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAllOtherSessionsResponse) ProtoMessage() {}
+
+func (x *RevokeAllOtherSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAllOtherSessionsResponse.ProtoReflect.Descriptor instead.
+func (*RevokeAllOtherSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RevokeAllOtherSessionsResponse) GetRevoked() int64 {
+	if x != nil {
+		return x.Revoked
+	}
+	return 0
+}
+
+// GetRevocationsRequest - запрос GetRevocations
+type GetRevocationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRevocationsRequest) Reset() {
+	*x = GetRevocationsRequest{}
+	mi := &file_api_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRevocationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRevocationsRequest) ProtoMessage() {}
+
+func (x *GetRevocationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRevocationsRequest.ProtoReflect.Descriptor instead.
+func (*GetRevocationsRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{17}
+}
+
+// GetRevocationsResponse - ответ GetRevocations
+type GetRevocationsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// revokedTokens - отозванные access токены
+	RevokedTokens []*RevokedAccessToken `protobuf:"bytes,1,rep,name=revokedTokens,proto3" json:"revokedTokens,omitempty"`
+	// userWatermarks - отзыв всех access токенов пользователя, выпущенных до notBeforeUnix
+	UserWatermarks []*UserTokenWatermark `protobuf:"bytes,2,rep,name=userWatermarks,proto3" json:"userWatermarks,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetRevocationsResponse) Reset() {
+	*x = GetRevocationsResponse{}
+	mi := &file_api_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRevocationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRevocationsResponse) ProtoMessage() {}
+
+func (x *GetRevocationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRevocationsResponse.ProtoReflect.Descriptor instead.
+func (*GetRevocationsResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetRevocationsResponse) GetRevokedTokens() []*RevokedAccessToken {
+	if x != nil {
+		return x.RevokedTokens
+	}
+	return nil
+}
+
+func (x *GetRevocationsResponse) GetUserWatermarks() []*UserTokenWatermark {
+	if x != nil {
+		return x.UserWatermarks
+	}
+	return nil
+}
+
+// RequestEmailVerificationRequest - запрос RequestEmailVerification
+type RequestEmailVerificationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestEmailVerificationRequest) Reset() {
+	*x = RequestEmailVerificationRequest{}
+	mi := &file_api_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestEmailVerificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestEmailVerificationRequest) ProtoMessage() {}
+
+func (x *RequestEmailVerificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestEmailVerificationRequest.ProtoReflect.Descriptor instead.
+func (*RequestEmailVerificationRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{19}
+}
+
+// RequestEmailVerificationResponse - ответ RequestEmailVerification
+type RequestEmailVerificationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestEmailVerificationResponse) Reset() {
+	*x = RequestEmailVerificationResponse{}
+	mi := &file_api_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestEmailVerificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestEmailVerificationResponse) ProtoMessage() {}
+
+func (x *RequestEmailVerificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestEmailVerificationResponse.ProtoReflect.Descriptor instead.
+func (*RequestEmailVerificationResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{20}
+}
+
+// ConfirmEmailRequest - запрос ConfirmEmail
+type ConfirmEmailRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// token - одноразовый токен из письма
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmEmailRequest) Reset() {
+	*x = ConfirmEmailRequest{}
+	mi := &file_api_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmEmailRequest) ProtoMessage() {}
+
+func (x *ConfirmEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmEmailRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmEmailRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ConfirmEmailRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// ConfirmEmailResponse - ответ ConfirmEmail
+type ConfirmEmailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmEmailResponse) Reset() {
+	*x = ConfirmEmailResponse{}
+	mi := &file_api_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmEmailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmEmailResponse) ProtoMessage() {}
+
+func (x *ConfirmEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmEmailResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmEmailResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{22}
+}
+
+// RequestPasswordResetRequest - запрос RequestPasswordReset
+type RequestPasswordResetRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// email - электронная почта
+	Email         string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPasswordResetRequest) Reset() {
+	*x = RequestPasswordResetRequest{}
+	mi := &file_api_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetRequest) ProtoMessage() {}
+
+func (x *RequestPasswordResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetRequest.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RequestPasswordResetRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+// RequestPasswordResetResponse - ответ RequestPasswordReset
+//
+// Ответ одинаковый для зарегистрированного и неизвестного email.
+type RequestPasswordResetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPasswordResetResponse) Reset() {
+	*x = RequestPasswordResetResponse{}
+	mi := &file_api_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetResponse) ProtoMessage() {}
+
+func (x *RequestPasswordResetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetResponse.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{24}
+}
+
+// ResetPasswordRequest - запрос ResetPassword
+type ResetPasswordRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// token - одноразовый токен из письма
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// newPassword - новый пароль
+	NewPassword   string `protobuf:"bytes,2,opt,name=newPassword,proto3" json:"newPassword,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPasswordRequest) Reset() {
+	*x = ResetPasswordRequest{}
+	mi := &file_api_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordRequest) ProtoMessage() {}
+
+func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ResetPasswordRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ResetPasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+// ResetPasswordResponse - ответ ResetPassword
+type ResetPasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPasswordResponse) Reset() {
+	*x = ResetPasswordResponse{}
+	mi := &file_api_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordResponse) ProtoMessage() {}
+
+func (x *ResetPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordResponse.ProtoReflect.Descriptor instead.
+func (*ResetPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{26}
+}
+
+// ChangePasswordRequest - запрос ChangePassword
+type ChangePasswordRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// currentPassword - текущий пароль
+	CurrentPassword string `protobuf:"bytes,1,opt,name=currentPassword,proto3" json:"currentPassword,omitempty"`
+	// newPassword - новый пароль
+	NewPassword string `protobuf:"bytes,2,opt,name=newPassword,proto3" json:"newPassword,omitempty"`
+	// currentDeviceId - id текущего устройства, для него выдаются новые токены
+	CurrentDeviceId string `protobuf:"bytes,3,opt,name=currentDeviceId,proto3" json:"currentDeviceId,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ChangePasswordRequest) Reset() {
+	*x = ChangePasswordRequest{}
+	mi := &file_api_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordRequest) ProtoMessage() {}
+
+func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
+func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ChangePasswordRequest) GetCurrentPassword() string {
+	if x != nil {
+		return x.CurrentPassword
+	}
+	return ""
+}
+
+func (x *ChangePasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+func (x *ChangePasswordRequest) GetCurrentDeviceId() string {
+	if x != nil {
+		return x.CurrentDeviceId
+	}
+	return ""
+}
+
+// ChangePasswordResponse - ответ ChangePassword
+type ChangePasswordResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// revoked - количество отозванных refresh токенов
+	Revoked int64 `protobuf:"varint,1,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	// accessToken - новый токен доступа текущего устройства
+	AccessToken string `protobuf:"bytes,2,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	// refreshToken - новый refresh токен текущего устройства
+	RefreshToken  string `protobuf:"bytes,3,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangePasswordResponse) Reset() {
+	*x = ChangePasswordResponse{}
+	mi := &file_api_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordResponse) ProtoMessage() {}
+
+func (x *ChangePasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordResponse.ProtoReflect.Descriptor instead.
+func (*ChangePasswordResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ChangePasswordResponse) GetRevoked() int64 {
+	if x != nil {
+		return x.Revoked
+	}
+	return 0
+}
+
+func (x *ChangePasswordResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *ChangePasswordResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+// ChangeEmailRequest - запрос ChangeEmail
+type ChangeEmailRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// currentPassword - текущий пароль
+	CurrentPassword string `protobuf:"bytes,1,opt,name=currentPassword,proto3" json:"currentPassword,omitempty"`
+	// newEmail - новая электронная почта
+	NewEmail      string `protobuf:"bytes,2,opt,name=newEmail,proto3" json:"newEmail,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeEmailRequest) Reset() {
+	*x = ChangeEmailRequest{}
+	mi := &file_api_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeEmailRequest) ProtoMessage() {}
+
+func (x *ChangeEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeEmailRequest.ProtoReflect.Descriptor instead.
+func (*ChangeEmailRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ChangeEmailRequest) GetCurrentPassword() string {
+	if x != nil {
+		return x.CurrentPassword
+	}
+	return ""
+}
+
+func (x *ChangeEmailRequest) GetNewEmail() string {
+	if x != nil {
+		return x.NewEmail
+	}
+	return ""
+}
+
+// ChangeEmailResponse - ответ ChangeEmail
+type ChangeEmailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeEmailResponse) Reset() {
+	*x = ChangeEmailResponse{}
+	mi := &file_api_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeEmailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeEmailResponse) ProtoMessage() {}
+
+func (x *ChangeEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeEmailResponse.ProtoReflect.Descriptor instead.
+func (*ChangeEmailResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{30}
+}
+
+// RevokedAccessToken - отозванный access токен
+type RevokedAccessToken struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// jti - JWT ID токена
+	Jti string `protobuf:"bytes,1,opt,name=jti,proto3" json:"jti,omitempty"`
+	// expiresAtUnix - время истечения токена в секундах, после него запись не нужна
+	ExpiresAtUnix int64 `protobuf:"varint,2,opt,name=expiresAtUnix,proto3" json:"expiresAtUnix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokedAccessToken) Reset() {
+	*x = RevokedAccessToken{}
+	mi := &file_api_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokedAccessToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokedAccessToken) ProtoMessage() {}
+
+func (x *RevokedAccessToken) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokedAccessToken.ProtoReflect.Descriptor instead.
+func (*RevokedAccessToken) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RevokedAccessToken) GetJti() string {
+	if x != nil {
+		return x.Jti
+	}
+	return ""
+}
+
+func (x *RevokedAccessToken) GetExpiresAtUnix() int64 {
+	if x != nil {
+		return x.ExpiresAtUnix
+	}
+	return 0
+}
+
+// UserTokenWatermark - граница отзыва access токенов пользователя
+type UserTokenWatermark struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// userId - идентификатор пользователя
+	UserId string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	// notBeforeUnix - токены с iat раньше этого времени (в секундах) недействительны
+	NotBeforeUnix int64 `protobuf:"varint,2,opt,name=notBeforeUnix,proto3" json:"notBeforeUnix,omitempty"`
+	// expiresAtUnix - время в секундах, после которого все затронутые токены истекли
+	ExpiresAtUnix int64 `protobuf:"varint,3,opt,name=expiresAtUnix,proto3" json:"expiresAtUnix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserTokenWatermark) Reset() {
+	*x = UserTokenWatermark{}
+	mi := &file_api_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserTokenWatermark) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserTokenWatermark) ProtoMessage() {}
+
+func (x *UserTokenWatermark) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserTokenWatermark.ProtoReflect.Descriptor instead.
+func (*UserTokenWatermark) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UserTokenWatermark) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserTokenWatermark) GetNotBeforeUnix() int64 {
+	if x != nil {
+		return x.NotBeforeUnix
+	}
+	return 0
+}
+
+func (x *UserTokenWatermark) GetExpiresAtUnix() int64 {
+	if x != nil {
+		return x.ExpiresAtUnix
+	}
+	return 0
+}
+
+// JWK - JSON Web Key для валидации JWT токенов
+type JWK struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// kty - тип ключа (например, "RSA", "EC")
+	Kty string `protobuf:"bytes,1,opt,name=kty,proto3" json:"kty,omitempty"`
+	// use - назначение ключа (например, "sig" для подписи, "enc" для шифрования)
+	Use string `protobuf:"bytes,2,opt,name=use,proto3" json:"use,omitempty"`
+	// kid - уникальный идентификатор ключа
+	Kid string `protobuf:"bytes,3,opt,name=kid,proto3" json:"kid,omitempty"`
+	// alg - алгоритм (например, "RS256", "ES256")
+	Alg string `protobuf:"bytes,4,opt,name=alg,proto3" json:"alg,omitempty"`
+	// n - модуль RSA ключа (base64url encoded) - для RSA ключей
+	N string `protobuf:"bytes,5,opt,name=n,proto3" json:"n,omitempty"`
+	// e - экспонента RSA ключа (base64url encoded) - для RSA ключей
+	E string `protobuf:"bytes,6,opt,name=e,proto3" json:"e,omitempty"`
+	// x - X координата - для EC (Elliptic Curve) ключей
+	X string `protobuf:"bytes,7,opt,name=x,proto3" json:"x,omitempty"`
+	// y - Y координата - для EC (Elliptic Curve) ключей
+	Y string `protobuf:"bytes,8,opt,name=y,proto3" json:"y,omitempty"`
+	// crv - тип эллиптической кривой (например, "P-256") - для EC ключей
+	Crv           string `protobuf:"bytes,9,opt,name=crv,proto3" json:"crv,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JWK) Reset() {
+	*x = JWK{}
+	mi := &file_api_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JWK) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JWK) ProtoMessage() {}
+
+func (x *JWK) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JWK.ProtoReflect.Descriptor instead.
+func (*JWK) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *JWK) GetKty() string {
@@ -674,10 +1790,11 @@ var File_api_service_proto protoreflect.FileDescriptor
 
 const file_api_service_proto_rawDesc = "" +
 	"\n" +
-	"\x11api/service.proto\x12=github.com.krus210.balun_microservices.protobuf.auth.v1.proto\"C\n" +
+	"\x11api/service.proto\x12=github.com.krus210.balun_microservices.protobuf.auth.v1.proto\"_\n" +
 	"\x0fRegisterRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"*\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x1a\n" +
+	"\bnickname\x18\x03 \x01(\tR\bnickname\"*\n" +
 	"\x10RegisterResponse\x12\x16\n" +
 	"\x06userId\x18\x01 \x01(\tR\x06userId\"p\n" +
 	"\fLoginRequest\x12\x14\n" +
@@ -708,7 +1825,58 @@ const file_api_service_proto_rawDesc = "" +
 	"\x0eLogoutResponse\"\x10\n" +
 	"\x0eGetJWKSRequest\"i\n" +
 	"\x0fGetJWKSResponse\x12V\n" +
-	"\x04jwks\x18\x01 \x03(\v2B.github.com.krus210.balun_microservices.protobuf.auth.v1.proto.JWKR\x04jwks\"\x97\x01\n" +
+	"\x04jwks\x18\x01 \x03(\v2B.github.com.krus210.balun_microservices.protobuf.auth.v1.proto.JWKR\x04jwks\"\xab\x01\n" +
+	"\aSession\x12\x1a\n" +
+	"\bdeviceId\x18\x01 \x01(\tR\bdeviceId\x12(\n" +
+	"\x0fcreatedAtUnixMs\x18\x02 \x01(\x03R\x0fcreatedAtUnixMs\x120\n" +
+	"\x13lastRefreshAtUnixMs\x18\x03 \x01(\x03R\x13lastRefreshAtUnixMs\x12(\n" +
+	"\x0fexpiresAtUnixMs\x18\x04 \x01(\x03R\x0fexpiresAtUnixMs\"\x15\n" +
+	"\x13ListSessionsRequest\"z\n" +
+	"\x14ListSessionsResponse\x12b\n" +
+	"\bsessions\x18\x01 \x03(\v2F.github.com.krus210.balun_microservices.protobuf.auth.v1.proto.SessionR\bsessions\"2\n" +
+	"\x14RevokeSessionRequest\x12\x1a\n" +
+	"\bdeviceId\x18\x01 \x01(\tR\bdeviceId\"1\n" +
+	"\x15RevokeSessionResponse\x12\x18\n" +
+	"\arevoked\x18\x01 \x01(\x03R\arevoked\"I\n" +
+	"\x1dRevokeAllOtherSessionsRequest\x12(\n" +
+	"\x0fcurrentDeviceId\x18\x01 \x01(\tR\x0fcurrentDeviceId\":\n" +
+	"\x1eRevokeAllOtherSessionsResponse\x12\x18\n" +
+	"\arevoked\x18\x01 \x01(\x03R\arevoked\"\x17\n" +
+	"\x15GetRevocationsRequest\"\x8c\x02\n" +
+	"\x16GetRevocationsResponse\x12w\n" +
+	"\rrevokedTokens\x18\x01 \x03(\v2Q.github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RevokedAccessTokenR\rrevokedTokens\x12y\n" +
+	"\x0euserWatermarks\x18\x02 \x03(\v2Q.github.com.krus210.balun_microservices.protobuf.auth.v1.proto.UserTokenWatermarkR\x0euserWatermarks\"!\n" +
+	"\x1fRequestEmailVerificationRequest\"\"\n" +
+	" RequestEmailVerificationResponse\"+\n" +
+	"\x13ConfirmEmailRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\x16\n" +
+	"\x14ConfirmEmailResponse\"3\n" +
+	"\x1bRequestPasswordResetRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\"\x1e\n" +
+	"\x1cRequestPasswordResetResponse\"N\n" +
+	"\x14ResetPasswordRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12 \n" +
+	"\vnewPassword\x18\x02 \x01(\tR\vnewPassword\"\x17\n" +
+	"\x15ResetPasswordResponse\"\x8d\x01\n" +
+	"\x15ChangePasswordRequest\x12(\n" +
+	"\x0fcurrentPassword\x18\x01 \x01(\tR\x0fcurrentPassword\x12 \n" +
+	"\vnewPassword\x18\x02 \x01(\tR\vnewPassword\x12(\n" +
+	"\x0fcurrentDeviceId\x18\x03 \x01(\tR\x0fcurrentDeviceId\"x\n" +
+	"\x16ChangePasswordResponse\x12\x18\n" +
+	"\arevoked\x18\x01 \x01(\x03R\arevoked\x12 \n" +
+	"\vaccessToken\x18\x02 \x01(\tR\vaccessToken\x12\"\n" +
+	"\frefreshToken\x18\x03 \x01(\tR\frefreshToken\"Z\n" +
+	"\x12ChangeEmailRequest\x12(\n" +
+	"\x0fcurrentPassword\x18\x01 \x01(\tR\x0fcurrentPassword\x12\x1a\n" +
+	"\bnewEmail\x18\x02 \x01(\tR\bnewEmail\"\x15\n" +
+	"\x13ChangeEmailResponse\"L\n" +
+	"\x12RevokedAccessToken\x12\x10\n" +
+	"\x03jti\x18\x01 \x01(\tR\x03jti\x12$\n" +
+	"\rexpiresAtUnix\x18\x02 \x01(\x03R\rexpiresAtUnix\"x\n" +
+	"\x12UserTokenWatermark\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12$\n" +
+	"\rnotBeforeUnix\x18\x02 \x01(\x03R\rnotBeforeUnix\x12$\n" +
+	"\rexpiresAtUnix\x18\x03 \x01(\x03R\rexpiresAtUnix\"\x97\x01\n" +
 	"\x03JWK\x12\x10\n" +
 	"\x03kty\x18\x01 \x01(\tR\x03kty\x12\x10\n" +
 	"\x03use\x18\x02 \x01(\tR\x03use\x12\x10\n" +
@@ -718,13 +1886,24 @@ const file_api_service_proto_rawDesc = "" +
 	"\x01e\x18\x06 \x01(\tR\x01e\x12\f\n" +
 	"\x01x\x18\a \x01(\tR\x01x\x12\f\n" +
 	"\x01y\x18\b \x01(\tR\x01y\x12\x10\n" +
-	"\x03crv\x18\t \x01(\tR\x03crv2\xe8\x06\n" +
+	"\x03crv\x18\t \x01(\tR\x03crv2\xe7\x14\n" +
 	"\vAuthService\x12\xad\x01\n" +
 	"\bRegister\x12N.github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RegisterRequest\x1aO.github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RegisterResponse\"\x00\x12\xa4\x01\n" +
 	"\x05Login\x12K.github.com.krus210.balun_microservices.protobuf.auth.v1.proto.LoginRequest\x1aL.github.com.krus210.balun_microservices.protobuf.auth.v1.proto.LoginResponse\"\x00\x12\xaa\x01\n" +
 	"\aRefresh\x12M.github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RefreshRequest\x1aN.github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RefreshResponse\"\x00\x12\xa7\x01\n" +
 	"\x06Logout\x12L.github.com.krus210.balun_microservices.protobuf.auth.v1.proto.LogoutRequest\x1aM.github.com.krus210.balun_microservices.protobuf.auth.v1.proto.LogoutResponse\"\x00\x12\xaa\x01\n" +
-	"\aGetJWKS\x12M.github.com.krus210.balun_microservices.protobuf.auth.v1.proto.GetJWKSRequest\x1aN.github.com.krus210.balun_microservices.protobuf.auth.v1.proto.GetJWKSResponse\"\x00B\x18Z\x16pkg/gen/proto;proto_v1b\x06proto3"
+	"\aGetJWKS\x12M.github.com.krus210.balun_microservices.protobuf.auth.v1.proto.GetJWKSRequest\x1aN.github.com.krus210.balun_microservices.protobuf.auth.v1.proto.GetJWKSResponse\"\x00\x12\xb9\x01\n" +
+	"\fListSessions\x12R.github.com.krus210.balun_microservices.protobuf.auth.v1.proto.ListSessionsRequest\x1aS.github.com.krus210.balun_microservices.protobuf.auth.v1.proto.ListSessionsResponse\"\x00\x12\xbc\x01\n" +
+	"\rRevokeSession\x12S.github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RevokeSessionRequest\x1aT.github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RevokeSessionResponse\"\x00\x12\xd7\x01\n" +
+	"\x16RevokeAllOtherSessions\x12\\.github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RevokeAllOtherSessionsRequest\x1a].github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RevokeAllOtherSessionsResponse\"\x00\x12\xdd\x01\n" +
+	"\x18RequestEmailVerification\x12^.github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RequestEmailVerificationRequest\x1a_.github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RequestEmailVerificationResponse\"\x00\x12\xb9\x01\n" +
+	"\fConfirmEmail\x12R.github.com.krus210.balun_microservices.protobuf.auth.v1.proto.ConfirmEmailRequest\x1aS.github.com.krus210.balun_microservices.protobuf.auth.v1.proto.ConfirmEmailResponse\"\x00\x12\xd1\x01\n" +
+	"\x14RequestPasswordReset\x12Z.github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RequestPasswordResetRequest\x1a[.github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RequestPasswordResetResponse\"\x00\x12\xbc\x01\n" +
+	"\rResetPassword\x12S.github.com.krus210.balun_microservices.protobuf.auth.v1.proto.ResetPasswordRequest\x1aT.github.com.krus210.balun_microservices.protobuf.auth.v1.proto.ResetPasswordResponse\"\x00\x12\xbf\x01\n" +
+	"\x0eChangePassword\x12T.github.com.krus210.balun_microservices.protobuf.auth.v1.proto.ChangePasswordRequest\x1aU.github.com.krus210.balun_microservices.protobuf.auth.v1.proto.ChangePasswordResponse\"\x00\x12\xb6\x01\n" +
+	"\vChangeEmail\x12Q.github.com.krus210.balun_microservices.protobuf.auth.v1.proto.ChangeEmailRequest\x1aR.github.com.krus210.balun_microservices.protobuf.auth.v1.proto.ChangeEmailResponse\"\x002\xd7\x01\n" +
+	"\x13AuthInternalService\x12\xbf\x01\n" +
+	"\x0eGetRevocations\x12T.github.com.krus210.balun_microservices.protobuf.auth.v1.proto.GetRevocationsRequest\x1aU.github.com.krus210.balun_microservices.protobuf.auth.v1.proto.GetRevocationsResponse\"\x00B\x18Z\x16pkg/gen/proto;proto_v1b\x06proto3"
 
 var (
 	file_api_service_proto_rawDescOnce sync.Once
@@ -738,37 +1917,83 @@ func file_api_service_proto_rawDescGZIP() []byte {
 	return file_api_service_proto_rawDescData
 }
 
-var file_api_service_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_api_service_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
 var file_api_service_proto_goTypes = []any{
-	(*RegisterRequest)(nil),  // 0: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RegisterRequest
-	(*RegisterResponse)(nil), // 1: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RegisterResponse
-	(*LoginRequest)(nil),     // 2: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.LoginRequest
-	(*LoginResponse)(nil),    // 3: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.LoginResponse
-	(*RefreshRequest)(nil),   // 4: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RefreshRequest
-	(*RefreshResponse)(nil),  // 5: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RefreshResponse
-	(*LogoutRequest)(nil),    // 6: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.LogoutRequest
-	(*LogoutResponse)(nil),   // 7: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.LogoutResponse
-	(*GetJWKSRequest)(nil),   // 8: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.GetJWKSRequest
-	(*GetJWKSResponse)(nil),  // 9: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.GetJWKSResponse
-	(*JWK)(nil),              // 10: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.JWK
+	(*RegisterRequest)(nil),                  // 0: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RegisterRequest
+	(*RegisterResponse)(nil),                 // 1: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RegisterResponse
+	(*LoginRequest)(nil),                     // 2: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.LoginRequest
+	(*LoginResponse)(nil),                    // 3: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.LoginResponse
+	(*RefreshRequest)(nil),                   // 4: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RefreshRequest
+	(*RefreshResponse)(nil),                  // 5: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RefreshResponse
+	(*LogoutRequest)(nil),                    // 6: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.LogoutRequest
+	(*LogoutResponse)(nil),                   // 7: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.LogoutResponse
+	(*GetJWKSRequest)(nil),                   // 8: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.GetJWKSRequest
+	(*GetJWKSResponse)(nil),                  // 9: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.GetJWKSResponse
+	(*Session)(nil),                          // 10: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.Session
+	(*ListSessionsRequest)(nil),              // 11: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.ListSessionsRequest
+	(*ListSessionsResponse)(nil),             // 12: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.ListSessionsResponse
+	(*RevokeSessionRequest)(nil),             // 13: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RevokeSessionRequest
+	(*RevokeSessionResponse)(nil),            // 14: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RevokeSessionResponse
+	(*RevokeAllOtherSessionsRequest)(nil),    // 15: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RevokeAllOtherSessionsRequest
+	(*RevokeAllOtherSessionsResponse)(nil),   // 16: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RevokeAllOtherSessionsResponse
+	(*GetRevocationsRequest)(nil),            // 17: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.GetRevocationsRequest
+	(*GetRevocationsResponse)(nil),           // 18: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.GetRevocationsResponse
+	(*RequestEmailVerificationRequest)(nil),  // 19: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RequestEmailVerificationRequest
+	(*RequestEmailVerificationResponse)(nil), // 20: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RequestEmailVerificationResponse
+	(*ConfirmEmailRequest)(nil),              // 21: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.ConfirmEmailRequest
+	(*ConfirmEmailResponse)(nil),             // 22: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.ConfirmEmailResponse
+	(*RequestPasswordResetRequest)(nil),      // 23: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RequestPasswordResetRequest
+	(*RequestPasswordResetResponse)(nil),     // 24: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RequestPasswordResetResponse
+	(*ResetPasswordRequest)(nil),             // 25: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.ResetPasswordRequest
+	(*ResetPasswordResponse)(nil),            // 26: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.ResetPasswordResponse
+	(*ChangePasswordRequest)(nil),            // 27: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.ChangePasswordRequest
+	(*ChangePasswordResponse)(nil),           // 28: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.ChangePasswordResponse
+	(*ChangeEmailRequest)(nil),               // 29: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.ChangeEmailRequest
+	(*ChangeEmailResponse)(nil),              // 30: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.ChangeEmailResponse
+	(*RevokedAccessToken)(nil),               // 31: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RevokedAccessToken
+	(*UserTokenWatermark)(nil),               // 32: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.UserTokenWatermark
+	(*JWK)(nil),                              // 33: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.JWK
 }
 var file_api_service_proto_depIdxs = []int32{
-	10, // 0: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.GetJWKSResponse.jwks:type_name -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.JWK
-	0,  // 1: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.AuthService.Register:input_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RegisterRequest
-	2,  // 2: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.AuthService.Login:input_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.LoginRequest
-	4,  // 3: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.AuthService.Refresh:input_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RefreshRequest
-	6,  // 4: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.AuthService.Logout:input_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.LogoutRequest
-	8,  // 5: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.AuthService.GetJWKS:input_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.GetJWKSRequest
-	1,  // 6: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.AuthService.Register:output_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RegisterResponse
-	3,  // 7: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.AuthService.Login:output_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.LoginResponse
-	5,  // 8: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.AuthService.Refresh:output_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RefreshResponse
-	7,  // 9: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.AuthService.Logout:output_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.LogoutResponse
-	9,  // 10: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.AuthService.GetJWKS:output_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.GetJWKSResponse
-	6,  // [6:11] is the sub-list for method output_type
-	1,  // [1:6] is the sub-list for method input_type
-	1,  // [1:1] is the sub-list for extension type_name
-	1,  // [1:1] is the sub-list for extension extendee
-	0,  // [0:1] is the sub-list for field type_name
+	33, // 0: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.GetJWKSResponse.jwks:type_name -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.JWK
+	10, // 1: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.ListSessionsResponse.sessions:type_name -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.Session
+	31, // 2: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.GetRevocationsResponse.revokedTokens:type_name -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RevokedAccessToken
+	32, // 3: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.GetRevocationsResponse.userWatermarks:type_name -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.UserTokenWatermark
+	0,  // 4: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.AuthService.Register:input_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RegisterRequest
+	2,  // 5: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.AuthService.Login:input_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.LoginRequest
+	4,  // 6: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.AuthService.Refresh:input_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RefreshRequest
+	6,  // 7: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.AuthService.Logout:input_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.LogoutRequest
+	8,  // 8: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.AuthService.GetJWKS:input_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.GetJWKSRequest
+	11, // 9: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.AuthService.ListSessions:input_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.ListSessionsRequest
+	13, // 10: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.AuthService.RevokeSession:input_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RevokeSessionRequest
+	15, // 11: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.AuthService.RevokeAllOtherSessions:input_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RevokeAllOtherSessionsRequest
+	19, // 12: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.AuthService.RequestEmailVerification:input_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RequestEmailVerificationRequest
+	21, // 13: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.AuthService.ConfirmEmail:input_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.ConfirmEmailRequest
+	23, // 14: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.AuthService.RequestPasswordReset:input_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RequestPasswordResetRequest
+	25, // 15: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.AuthService.ResetPassword:input_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.ResetPasswordRequest
+	27, // 16: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.AuthService.ChangePassword:input_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.ChangePasswordRequest
+	29, // 17: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.AuthService.ChangeEmail:input_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.ChangeEmailRequest
+	17, // 18: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.AuthInternalService.GetRevocations:input_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.GetRevocationsRequest
+	1,  // 19: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.AuthService.Register:output_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RegisterResponse
+	3,  // 20: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.AuthService.Login:output_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.LoginResponse
+	5,  // 21: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.AuthService.Refresh:output_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RefreshResponse
+	7,  // 22: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.AuthService.Logout:output_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.LogoutResponse
+	9,  // 23: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.AuthService.GetJWKS:output_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.GetJWKSResponse
+	12, // 24: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.AuthService.ListSessions:output_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.ListSessionsResponse
+	14, // 25: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.AuthService.RevokeSession:output_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RevokeSessionResponse
+	16, // 26: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.AuthService.RevokeAllOtherSessions:output_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RevokeAllOtherSessionsResponse
+	20, // 27: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.AuthService.RequestEmailVerification:output_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RequestEmailVerificationResponse
+	22, // 28: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.AuthService.ConfirmEmail:output_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.ConfirmEmailResponse
+	24, // 29: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.AuthService.RequestPasswordReset:output_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RequestPasswordResetResponse
+	26, // 30: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.AuthService.ResetPassword:output_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.ResetPasswordResponse
+	28, // 31: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.AuthService.ChangePassword:output_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.ChangePasswordResponse
+	30, // 32: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.AuthService.ChangeEmail:output_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.ChangeEmailResponse
+	18, // 33: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.AuthInternalService.GetRevocations:output_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.GetRevocationsResponse
+	19, // [19:34] is the sub-list for method output_type
+	4,  // [4:19] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_api_service_proto_init() }
@@ -784,9 +2009,9 @@ func file_api_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_service_proto_rawDesc), len(file_api_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   34,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_api_service_proto_goTypes,
 		DependencyIndexes: file_api_service_proto_depIdxs,
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
@@ -12,15 +13,18 @@ import (
 	"github.com/sskorolev/balun_microservices/lib/app"
 	"github.com/sskorolev/balun_microservices/lib/authmw"
 	"github.com/sskorolev/balun_microservices/lib/config"
+	"github.com/sskorolev/balun_microservices/lib/idempotency"
 	"github.com/sskorolev/balun_microservices/lib/logger"
 
 	"chat/internal/app/adapters"
+	"chat/internal/app/hub"
 	"chat/internal/app/repository"
 	"chat/internal/app/usecase"
 
 	deliveryGrpc "chat/internal/app/delivery/grpc"
 	errorsMiddleware "chat/internal/middleware/errors"
 	chatPb "chat/pkg/api"
+	socialPb "chat/pkg/social/api"
 	usersPb "chat/pkg/users/api"
 )
 
@@ -31,6 +35,11 @@ func main() {
 	// Загружаем конфигурацию через lib/config
 	cfg, err := config.LoadServiceConfig(ctx, "chat",
 		config.WithUsersService("users", 8082),
+		config.WithSocialService("social", 8082),
+		config.WithIdempotency(24*time.Hour,
+			chatPb.ChatService_CreateDirectChat_FullMethodName,
+			chatPb.ChatService_SendMessage_FullMethodName,
+		),
 	)
 	if err != nil {
 		logger.FatalKV(ctx, "failed to load config", "error", err.Error())
@@ -82,6 +91,13 @@ func main() {
 
 	usersClient := adapters.NewUsersClient(usersPb.NewUsersServiceClient(application.GetGRPCClient("users")))
 
+	// Подключаемся к Social сервису, через него проверяются блокировки
+	if err := application.InitGRPCClient(ctx, "social", cfg.SocialService); err != nil {
+		logger.FatalKV(ctx, "failed to connect to social service", "error", err.Error())
+	}
+
+	socialClient := adapters.NewSocialClient(socialPb.NewSocialServiceClient(application.GetGRPCClient("social")))
+
 	// Инициализируем auth компоненты (JWKS кеш и JWT validator)
 	authComponents, authCleanup, err := app.InitAuthComponents(
 		ctx,
@@ -95,15 +111,38 @@ func main() {
 
 	repo := repository.NewRepository(application.TransactionManager())
 
-	chatUsecase := usecase.NewUsecase(usersClient, repo)
+	// Хаб подписок StreamMessages, рассылка между репликами через Postgres LISTEN/NOTIFY
+	messageHub := hub.NewHub(hub.NewPostgresBackend(application.Postgres(), application.TransactionManager()))
+
+	chatUsecase := usecase.NewUsecase(usersClient, socialClient, repo, messageHub, application.TransactionManager())
 
 	controller := deliveryGrpc.NewChatController(chatUsecase)
 
-	// Инициализируем gRPC сервер с JWT и errors middleware
-	application.InitGRPCServer(
-		cfg.Server,
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
 		errorsMiddleware.ErrorsUnaryInterceptor(),
 		authmw.UnaryServerInterceptor(authComponents.JWTValidator),
+	}
+
+	// Идемпотентность по idempotency-key, ключи хранятся в Postgres
+	var idempotencyStore *idempotency.PostgresStore
+	if cfg.Idempotency != nil && cfg.Idempotency.Enabled {
+		idempotencyStore = idempotency.NewPostgresStore(application.Postgres())
+		unaryInterceptors = append(unaryInterceptors, idempotency.UnaryServerInterceptor(idempotencyStore,
+			idempotency.WithTTL(cfg.Idempotency.TTL),
+			idempotency.WithLockTimeout(cfg.Idempotency.LockTimeout),
+			idempotency.WithMethods(cfg.Idempotency.Methods...),
+			idempotency.WithSubject(authmw.GetUserID),
+		))
+	}
+
+	// Инициализируем gRPC сервер с JWT, errors и idempotency middleware
+	application.InitGRPCServerWithStreams(
+		cfg.Server,
+		unaryInterceptors,
+		[]grpc.StreamServerInterceptor{
+			errorsMiddleware.ErrorsStreamInterceptor(),
+			authmw.StreamServerInterceptor(authComponents.JWTValidator),
+		},
 	)
 
 	// Регистрируем gRPC сервисы
@@ -122,6 +161,22 @@ func main() {
 		return nil
 	})
 
+	// Запускаем хаб подписок StreamMessages
+	g.Go(func() error {
+		logger.InfoKV(gCtx, "starting chat messages hub")
+		if err := messageHub.Run(gCtx); err != nil && !errors.Is(err, context.Canceled) {
+			return err
+		}
+		return nil
+	})
+
+	// Запускаем очистку истекших ключей идемпотентности
+	if idempotencyStore != nil {
+		g.Go(func() error {
+			return idempotency.RunCleaner(gCtx, idempotencyStore, cfg.Idempotency.CleanupInterval)
+		})
+	}
+
 	// Запускаем admin HTTP сервер
 	if cfg.Server.Admin != nil {
 		g.Go(func() error {
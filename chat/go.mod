@@ -8,15 +8,22 @@ require (
 	github.com/google/uuid v1.6.0
 	github.com/sskorolev/balun_microservices/lib/app v0.0.0
 	github.com/sskorolev/balun_microservices/lib/config v0.0.0
+	github.com/sskorolev/balun_microservices/lib/hub v0.0.0
+	github.com/sskorolev/balun_microservices/lib/idempotency v0.0.0
 	github.com/sskorolev/balun_microservices/lib/logger v0.0.0
 	github.com/sskorolev/balun_microservices/lib/postgres v0.0.0
+	github.com/stretchr/testify v1.11.1
 	golang.org/x/sync v0.17.0
-	google.golang.org/genproto/googleapis/rpc v0.0.0-20251014184007-4626949a642f
 	google.golang.org/grpc v1.76.0
 	google.golang.org/protobuf v1.36.10
 )
 
-require github.com/golang-jwt/jwt/v5 v5.3.0 // indirect
+require (
+	github.com/davecgh/go-spew v1.1.2-0.20180830191138-d8f796af33cc // indirect
+	github.com/golang-jwt/jwt/v5 v5.3.0 // indirect
+	github.com/pmezard/go-difflib v1.0.1-0.20181226105442-5d4384ee4fb2 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20251014184007-4626949a642f // indirect
+)
 
 require (
 	github.com/benbjohnson/clock v1.3.5 // indirect
@@ -107,6 +114,8 @@ replace github.com/sskorolev/balun_microservices/lib/config => ../lib/config
 
 replace github.com/sskorolev/balun_microservices/lib/postgres => ../lib/postgres
 
+replace github.com/sskorolev/balun_microservices/lib/hub => ../lib/hub
+
 replace github.com/sskorolev/balun_microservices/lib/secrets => ../lib/secrets
 
 replace github.com/sskorolev/balun_microservices/lib/grpc => ../lib/grpc
@@ -120,3 +129,5 @@ replace github.com/sskorolev/balun_microservices/lib/admin => ../lib/admin
 replace github.com/sskorolev/balun_microservices/lib/logger => ../lib/logger
 
 replace github.com/sskorolev/balun_microservices/lib/authmw => ../lib/authmw
+
+replace github.com/sskorolev/balun_microservices/lib/idempotency => ../lib/idempotency
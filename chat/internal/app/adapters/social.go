@@ -0,0 +1,117 @@
+package adapters
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/sskorolev/balun_microservices/lib/authmw"
+
+	"chat/internal/app/models"
+	pb "chat/pkg/social/api"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// DefaultBlockCacheTTL - сколько ответ social о блокировке считается актуальным
+const DefaultBlockCacheTTL = 30 * time.Second
+
+// SocialOption - опция SocialClient
+type SocialOption func(*SocialClient)
+
+// WithBlockCacheTTL задает время жизни закешированного ответа о блокировке
+func WithBlockCacheTTL(ttl time.Duration) SocialOption {
+	return func(c *SocialClient) {
+		if ttl > 0 {
+			c.ttl = ttl
+		}
+	}
+}
+
+type blockCacheEntry struct {
+	blocked   bool
+	expiresAt time.Time
+}
+
+// SocialClient - клиент social сервиса с кешем блокировок
+//
+// Блокировка в чате применяется с задержкой до ttl: кеш не сбрасывается
+// по событиям, зато SendMessage не ходит в social на каждое сообщение.
+type SocialClient struct {
+	client pb.SocialServiceClient
+	ttl    time.Duration
+	now    func() time.Time
+
+	mu        sync.Mutex
+	cache     map[[2]models.UserID]blockCacheEntry
+	lastSweep time.Time
+}
+
+func NewSocialClient(client pb.SocialServiceClient, opts ...SocialOption) *SocialClient {
+	c := &SocialClient{
+		client: client,
+		ttl:    DefaultBlockCacheTTL,
+		now:    time.Now,
+		cache:  make(map[[2]models.UserID]blockCacheEntry),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// IsBlocked - Проверка блокировки между пользователями в любую сторону
+func (c *SocialClient) IsBlocked(ctx context.Context, firstUserID, secondUserID models.UserID) (bool, error) {
+	// Блокировка симметрична, поэтому пара хранится в одном порядке
+	key := [2]models.UserID{firstUserID, secondUserID}
+	if key[0] > key[1] {
+		key[0], key[1] = key[1], key[0]
+	}
+
+	now := c.now()
+
+	c.mu.Lock()
+	entry, ok := c.cache[key]
+	c.mu.Unlock()
+	if ok && now.Before(entry.expiresAt) {
+		return entry.blocked, nil
+	}
+
+	resp, err := c.client.CheckBlocked(forwardAuthorization(ctx), &pb.CheckBlockedRequest{
+		FirstUserId:  string(key[0]),
+		SecondUserId: string(key[1]),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	c.mu.Lock()
+	// Не чаще раза в ttl выбрасываем устаревшие записи, чтобы кеш не рос бесконечно
+	if now.Sub(c.lastSweep) >= c.ttl {
+		for k, e := range c.cache {
+			if !now.Before(e.expiresAt) {
+				delete(c.cache, k)
+			}
+		}
+		c.lastSweep = now
+	}
+	c.cache[key] = blockCacheEntry{blocked: resp.Blocked, expiresAt: now.Add(c.ttl)}
+	c.mu.Unlock()
+
+	return resp.Blocked, nil
+}
+
+// forwardAuthorization передает JWT пользователя в social, он принимает только аутентифицированные запросы
+func forwardAuthorization(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+
+	authorization := md.Get(authmw.AuthorizationHeader)
+	if len(authorization) == 0 {
+		return ctx
+	}
+
+	return metadata.AppendToOutgoingContext(ctx, authmw.AuthorizationHeader, authorization[0])
+}
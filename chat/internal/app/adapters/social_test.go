@@ -0,0 +1,52 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	pb "chat/pkg/social/api"
+)
+
+type stubSocialClient struct {
+	pb.SocialServiceClient
+	blocked bool
+	calls   int
+}
+
+func (c *stubSocialClient) CheckBlocked(context.Context, *pb.CheckBlockedRequest, ...grpc.CallOption) (*pb.CheckBlockedResponse, error) {
+	c.calls++
+	return &pb.CheckBlockedResponse{Blocked: c.blocked}, nil
+}
+
+func TestSocialClient_IsBlocked(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ответ кешируется для пары в обе стороны до истечения ttl", func(t *testing.T) {
+		now := time.Date(2026, time.October, 16, 12, 0, 0, 0, time.UTC)
+		stub := &stubSocialClient{blocked: true}
+		client := NewSocialClient(stub, WithBlockCacheTTL(time.Minute))
+		client.now = func() time.Time { return now }
+
+		blocked, err := client.IsBlocked(ctx, "1", "2")
+		require.NoError(t, err)
+		assert.True(t, blocked)
+
+		blocked, err = client.IsBlocked(ctx, "2", "1")
+		require.NoError(t, err)
+		assert.True(t, blocked)
+		assert.Equal(t, 1, stub.calls)
+
+		// После ttl ответ запрашивается заново
+		stub.blocked = false
+		now = now.Add(time.Minute)
+		blocked, err = client.IsBlocked(ctx, "1", "2")
+		require.NoError(t, err)
+		assert.False(t, blocked)
+		assert.Equal(t, 2, stub.calls)
+	})
+}
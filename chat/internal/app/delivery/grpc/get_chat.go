@@ -21,8 +21,13 @@ func (h *ChatController) GetChat(ctx context.Context, req *pb.GetChatRequest) (*
 		log.Println(key, md.Get(key))
 	}
 
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	chat, err := h.usecase.GetChat(ctx, dto.GetChatDto{
-		UserID: "1", // TODO: брать из хедера
+		UserID: userID,
 		ChatID: models.ChatID(req.ChatId),
 	})
 	if err != nil {
@@ -21,8 +21,13 @@ func (h *ChatController) ListChatMembers(ctx context.Context, req *pb.ListChatMe
 		log.Println(key, md.Get(key))
 	}
 
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	userIDs, err := h.usecase.ListChatMembers(ctx, dto.ListChatMembersDto{
-		UserID: "1", // TODO: брать из хедера
+		UserID: userID,
 		ChatID: models.ChatID(req.ChatId),
 	})
 	if err != nil {
@@ -21,8 +21,13 @@ func (h *ChatController) ListMessages(ctx context.Context, req *pb.ListMessagesR
 		log.Println(key, md.Get(key))
 	}
 
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	response, err := h.usecase.ListMessages(ctx, dto.ListMessagesDto{
-		UserID: "1", // TODO: брать из хедера
+		UserID: userID,
 		ChatID: models.ChatID(req.ChatId),
 		Limit:  req.Limit,
 		Cursor: req.Cursor,
@@ -21,8 +21,13 @@ func (h *ChatController) SendMessage(ctx context.Context, req *pb.SendMessageReq
 		log.Println(key, md.Get(key))
 	}
 
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	message, err := h.usecase.SendMessage(ctx, dto.SendMessageDto{
-		UserID: "1", // TODO: брать из хедера
+		UserID: userID,
 		ChatID: models.ChatID(req.ChatId),
 		Text:   req.Text,
 	})
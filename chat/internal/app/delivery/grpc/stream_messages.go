@@ -1,13 +1,43 @@
 package grpc
 
 import (
+	"chat/internal/app/models"
+	"chat/internal/app/usecase/dto"
+
 	pb "chat/pkg/api"
 
-	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 func (h *ChatController) StreamMessages(req *pb.StreamMessagesRequest, stream pb.ChatService_StreamMessagesServer) error {
-	// TODO: реализовать серверный стрим новых сообщений
-	return status.Errorf(codes.Unimplemented, "метод StreamMessages пока не реализован")
+	ctx := stream.Context()
+
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return err
+	}
+
+	messages, err := h.usecase.StreamMessages(ctx, dto.StreamMessagesDto{
+		UserID:      userID,
+		ChatID:      models.ChatID(req.ChatId),
+		SinceUnixMs: req.SinceUnixMs,
+	})
+	if err != nil {
+		return err
+	}
+
+	for message := range messages {
+		if err := stream.Send(&pb.StreamMessagesResponse{
+			Message: newPbMessageFromMessage(message),
+		}); err != nil {
+			return err
+		}
+	}
+
+	// Канал закрыт: либо клиент отключился, либо хаб отключил медленного подписчика
+	if err := ctx.Err(); err != nil {
+		return status.FromContextError(err).Err()
+	}
+
+	return models.ErrSlowSubscriber
 }
@@ -0,0 +1,24 @@
+package hub
+
+import (
+	libhub "github.com/sskorolev/balun_microservices/lib/hub"
+
+	"chat/internal/app/models"
+	"chat/internal/app/usecase"
+)
+
+// Hub хаб подписок на новые сообщения, сгруппированных по чатам
+type Hub = libhub.Hub[models.ChatID, models.Message]
+
+// Проверка удовлетворению интерфейсу usecase.MessageHub
+var _ usecase.MessageHub = (*Hub)(nil)
+
+// NewHub конструктор Hub
+func NewHub(backend libhub.Backend[models.Message], opts ...libhub.Option) *Hub {
+	return libhub.NewHub(backend, messageChatID, opts...)
+}
+
+// messageChatID сообщение раздается подписчикам его чата
+func messageChatID(msg *models.Message) models.ChatID {
+	return msg.ChatID
+}
@@ -0,0 +1,70 @@
+package hub
+
+import (
+	"encoding/json"
+	"time"
+
+	libhub "github.com/sskorolev/balun_microservices/lib/hub"
+	"github.com/sskorolev/balun_microservices/lib/postgres"
+
+	"chat/internal/app/models"
+)
+
+// DefaultNotifyChannel канал LISTEN/NOTIFY для новых сообщений
+const DefaultNotifyChannel = "chat_messages"
+
+// NewPostgresBackend backend рассылки новых сообщений через Postgres LISTEN/NOTIFY
+//
+// Сообщение публикуется в транзакции его сохранения и доставляется только после COMMIT.
+// Размер payload pg_notify ограничен 8000 байт, чего достаточно для сообщения с текстом до 1000 символов.
+// Сообщения, отправленные во время переподключения, клиенты догоняют через повторный StreamMessages с sinceUnixMs.
+func NewPostgresBackend(
+	conn *postgres.Connection,
+	tm postgres.TransactionManagerAPI,
+	opts ...libhub.PostgresBackendOption,
+) *libhub.PostgresBackend[models.Message] {
+	return libhub.NewPostgresBackend(conn, tm, DefaultNotifyChannel, messageCodec, opts...)
+}
+
+// messageCodec кодирование сообщения в payload pg_notify
+var messageCodec = libhub.Codec[models.Message]{
+	Encode: encodeMessage,
+	Decode: decodeMessage,
+}
+
+// notificationPayload payload уведомления о новом сообщении
+type notificationPayload struct {
+	ID        string    `json:"id"`
+	Text      string    `json:"text"`
+	ChatID    string    `json:"chat_id"`
+	OwnerID   string    `json:"owner_id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func encodeMessage(msg *models.Message) ([]byte, error) {
+	return json.Marshal(notificationPayload{
+		ID:        string(msg.ID),
+		Text:      msg.Text,
+		ChatID:    string(msg.ChatID),
+		OwnerID:   string(msg.OwnerID),
+		CreatedAt: msg.CreatedAt,
+		UpdatedAt: msg.UpdatedAt,
+	})
+}
+
+func decodeMessage(data []byte) (*models.Message, error) {
+	var payload notificationPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, err
+	}
+
+	return &models.Message{
+		ID:        models.MessageID(payload.ID),
+		Text:      payload.Text,
+		ChatID:    models.ChatID(payload.ChatID),
+		OwnerID:   models.UserID(payload.OwnerID),
+		CreatedAt: payload.CreatedAt,
+		UpdatedAt: payload.UpdatedAt,
+	}, nil
+}
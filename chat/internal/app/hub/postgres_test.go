@@ -0,0 +1,34 @@
+package hub
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"chat/internal/app/models"
+)
+
+func TestMessageCodec(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	msg := &models.Message{
+		ID:        "m1",
+		Text:      "привет",
+		ChatID:    "chat-1",
+		OwnerID:   "u-1",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	payload, err := messageCodec.Encode(msg)
+	require.NoError(t, err)
+
+	got, err := messageCodec.Decode(payload)
+	require.NoError(t, err)
+	assert.Equal(t, msg, got)
+	assert.Equal(t, models.ChatID("chat-1"), messageChatID(got))
+
+	_, err = messageCodec.Decode([]byte("not json"))
+	assert.Error(t, err)
+}
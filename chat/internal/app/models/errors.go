@@ -6,4 +6,8 @@ var (
 	ErrNotFound         = errors.New("chat not found")
 	ErrAlreadyExists    = errors.New("chat already exists")
 	ErrPermissionDenied = errors.New("permission denied")
+	ErrSlowSubscriber   = errors.New("subscriber is too slow")
+	ErrInvalidCursor    = errors.New("invalid cursor")
+	ErrBlocked          = errors.New("user is blocked")
+	ErrReplayTooLarge   = errors.New("too many messages to replay, resync history with ListMessages")
 )
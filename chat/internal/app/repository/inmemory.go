@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"chat/internal/app/models"
+	"chat/internal/app/repository/message"
 
 	"github.com/google/uuid"
 )
@@ -176,7 +177,11 @@ func (r *InMemoryChatRepository) SaveMessage(ctx context.Context, msg *models.Me
 	}
 
 	if msg.ID == "" {
-		msg.ID = models.MessageID(uuid.New().String())
+		messageID, err := uuid.NewV7()
+		if err != nil {
+			return nil, err
+		}
+		msg.ID = models.MessageID(messageID.String())
 		msg.CreatedAt = time.Now()
 	}
 	msg.UpdatedAt = time.Now()
@@ -209,22 +214,25 @@ func (r *InMemoryChatRepository) ListMessages(ctx context.Context, chatID models
 		return []*models.Message{}, nil, nil
 	}
 
-	// Sort messages by ID (ascending - oldest first)
+	// Sort messages by (created_at, id) descending - newest first, as in Repository.ListMessages
 	sortedMessages := make([]*models.Message, len(chatMessages))
 	copy(sortedMessages, chatMessages)
 	sort.Slice(sortedMessages, func(i, j int) bool {
-		return sortedMessages[i].ID < sortedMessages[j].ID
+		return messageBefore(sortedMessages[j], sortedMessages[i])
 	})
 
 	// Find start position based on cursor
 	startIdx := 0
 	if cursor != nil && *cursor != "" {
-		// Find the position after the cursor
-		for i, msg := range sortedMessages {
-			if msg.ID == models.MessageID(*cursor) {
-				startIdx = i + 1
-				break
-			}
+		position, err := message.DecodeCursor(*cursor)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// Skip messages up to and including the cursor position
+		cursorMsg := &models.Message{ID: models.MessageID(position.ID), CreatedAt: position.CreatedAt}
+		for startIdx < len(sortedMessages) && !messageBefore(sortedMessages[startIdx], cursorMsg) {
+			startIdx++
 		}
 	}
 
@@ -249,10 +257,52 @@ func (r *InMemoryChatRepository) ListMessages(ctx context.Context, chatID models
 	}
 
 	// Set next cursor if there are more messages
-	if endIdx < len(sortedMessages) {
-		lastID := string(result[len(result)-1].ID)
-		nextCursor = &lastID
+	if endIdx < len(sortedMessages) && len(result) > 0 {
+		next := message.EncodeCursor(result[len(result)-1])
+		nextCursor = &next
 	}
 
 	return result, nextCursor, nil
 }
+
+func (r *InMemoryChatRepository) ListMessagesSince(ctx context.Context, chatID models.ChatID, since time.Time, limit int64) ([]*models.Message, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	// Check chat exists
+	if _, exists := r.chats[chatID]; !exists {
+		return nil, nil
+	}
+
+	// Messages are stored in send order
+	var result []*models.Message
+	for _, msg := range r.messages[chatID] {
+		if !msg.CreatedAt.After(since) {
+			continue
+		}
+		if int64(len(result)) >= limit {
+			break
+		}
+
+		// Deep copy
+		msgCopy := &models.Message{
+			ID:        msg.ID,
+			Text:      msg.Text,
+			ChatID:    msg.ChatID,
+			OwnerID:   msg.OwnerID,
+			CreatedAt: msg.CreatedAt,
+			UpdatedAt: msg.UpdatedAt,
+		}
+		result = append(result, msgCopy)
+	}
+
+	return result, nil
+}
+
+// messageBefore reports whether a was sent before b, ordering by (created_at, id)
+func messageBefore(a, b *models.Message) bool {
+	if !a.CreatedAt.Equal(b.CreatedAt) {
+		return a.CreatedAt.Before(b.CreatedAt)
+	}
+	return a.ID < b.ID
+}
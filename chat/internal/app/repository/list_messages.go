@@ -13,6 +13,10 @@ import (
 )
 
 // ListMessages получает список сообщений чата с cursor-based пагинацией
+//
+// Сообщения возвращаются в строгом порядке отправки, от новых к старым: сортировка по (created_at, id),
+// где id - UUIDv7. Курсор - непрозрачная строка с позицией последнего сообщения страницы,
+// запрос обслуживается индексом idx_messages_chat_id_created_at_id.
 func (r *Repository) ListMessages(ctx context.Context, chatID models.ChatID, limit int64, cursor *string) (messages []*models.Message, nextCursor *string, err error) {
 	const api = "[Repository][ListMessages]"
 
@@ -23,11 +27,24 @@ func (r *Repository) ListMessages(ctx context.Context, chatID models.ChatID, lim
 	listMessagesQuery := r.sb.Select(message.MessagesTableColumns...).
 		From(message.MessagesTable).
 		Where(squirrel.Eq{message.MessagesTableColumnChatID: chatID}).
-		OrderBy(message.MessagesTableColumnID + " DESC")
+		OrderBy(
+			message.MessagesTableColumnCreatedAt+" DESC",
+			message.MessagesTableColumnID+" DESC",
+		)
 
-	// Если есть cursor, добавляем фильтр по ID
+	// Если есть cursor, продолжаем со следующего после него сообщения
 	if cursor != nil && *cursor != "" {
-		listMessagesQuery = listMessagesQuery.Where(squirrel.Lt{message.MessagesTableColumnID: *cursor})
+		position, err := message.DecodeCursor(*cursor)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", api, err)
+		}
+
+		listMessagesQuery = listMessagesQuery.Where(
+			squirrel.Expr(
+				"("+message.MessagesTableColumnCreatedAt+", "+message.MessagesTableColumnID+") < (?, ?)",
+				position.CreatedAt, position.ID,
+			),
+		)
 	}
 
 	// Запрашиваем limit + 1 сообщений, чтобы понять, есть ли еще данные
@@ -63,8 +80,8 @@ func (r *Repository) ListMessages(ctx context.Context, chatID models.ChatID, lim
 
 	// Если есть еще сообщения, устанавливаем nextCursor
 	if hasMore && len(result) > 0 {
-		lastID := string(result[len(result)-1].ID)
-		nextCursor = &lastID
+		next := message.EncodeCursor(result[len(result)-1])
+		nextCursor = &next
 	}
 
 	return result, nextCursor, nil
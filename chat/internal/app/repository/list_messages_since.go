@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sskorolev/balun_microservices/lib/postgres"
+
+	"chat/internal/app/models"
+	"chat/internal/app/repository/message"
+
+	"github.com/Masterminds/squirrel"
+)
+
+// ListMessagesSince получает сообщения чата, созданные позже since, в порядке отправки
+func (r *Repository) ListMessagesSince(ctx context.Context, chatID models.ChatID, since time.Time, limit int64) ([]*models.Message, error) {
+	const api = "[Repository][ListMessagesSince]"
+
+	// Получаем QueryEngine из контекста (может быть транзакция или обычное соединение)
+	conn := r.tm.GetQueryEngine(ctx)
+
+	listMessagesQuery := r.sb.Select(message.MessagesTableColumns...).
+		From(message.MessagesTable).
+		Where(squirrel.Eq{message.MessagesTableColumnChatID: chatID}).
+		Where(squirrel.Gt{message.MessagesTableColumnCreatedAt: since}).
+		OrderBy(
+			message.MessagesTableColumnCreatedAt+" ASC",
+			message.MessagesTableColumnID+" ASC",
+		).
+		Limit(uint64(limit))
+
+	var messageRows []message.Row
+	if err := conn.Selectx(ctx, &messageRows, listMessagesQuery); err != nil {
+		return nil, fmt.Errorf("%s: %w", api, postgres.ConvertPGError(err))
+	}
+
+	result := make([]*models.Message, 0, len(messageRows))
+	for _, msgRow := range messageRows {
+		msg := message.ToModel(&msgRow)
+		if msg != nil {
+			result = append(result, msg)
+		}
+	}
+
+	return result, nil
+}
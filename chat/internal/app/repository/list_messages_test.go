@@ -0,0 +1,151 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/sskorolev/balun_microservices/lib/postgres"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"chat/internal/app/models"
+	"chat/internal/app/repository/message"
+)
+
+// fakeQueryEngine запоминает запрос Selectx и возвращает заданные строки
+type fakeQueryEngine struct {
+	postgres.QueryEngine
+	rows  []message.Row
+	sql   string
+	args  []any
+	calls int
+}
+
+func (e *fakeQueryEngine) Selectx(_ context.Context, dest any, sqlizer postgres.Sqlizer) error {
+	e.calls++
+
+	var err error
+	e.sql, e.args, err = sqlizer.ToSql()
+	if err != nil {
+		return err
+	}
+	*dest.(*[]message.Row) = e.rows
+	return nil
+}
+
+type fakeTxManager struct {
+	postgres.TransactionManagerAPI
+	engine *fakeQueryEngine
+}
+
+func (m fakeTxManager) GetQueryEngine(context.Context) postgres.QueryEngine {
+	return m.engine
+}
+
+func TestRepository_ListMessages(t *testing.T) {
+	ctx := context.Background()
+	createdAt := time.Date(2026, time.October, 16, 12, 30, 0, 0, time.UTC)
+	rows := func(n int) []message.Row {
+		result := make([]message.Row, 0, n)
+		for i := range n {
+			result = append(result, message.Row{
+				ID:        fmt.Sprintf("01920f6e-8c3a-7b4e-9d21-5f0a3c6e8b%02d", 20-i),
+				ChatID:    "chat",
+				CreatedAt: createdAt,
+			})
+		}
+		return result
+	}
+
+	t.Run("продолжение после курсора сравнивает пару (created_at, id)", func(t *testing.T) {
+		engine := &fakeQueryEngine{}
+		r := NewRepository(fakeTxManager{engine: engine})
+		cursor := message.EncodeCursor(&models.Message{ID: "01920f6e-8c3a-7b4e-9d21-5f0a3c6e8b17", CreatedAt: createdAt})
+
+		_, _, err := r.ListMessages(ctx, "chat", 2, &cursor)
+		require.NoError(t, err)
+
+		// При равном created_at порядок и граница страницы определяются id
+		assert.Contains(t, engine.sql, "(created_at, id) < ($2, $3)")
+		assert.Contains(t, engine.sql, "ORDER BY created_at DESC, id DESC")
+		assert.Contains(t, engine.sql, "LIMIT 3")
+		require.Len(t, engine.args, 3)
+		assert.True(t, createdAt.Equal(engine.args[1].(time.Time)))
+		assert.Equal(t, "01920f6e-8c3a-7b4e-9d21-5f0a3c6e8b17", engine.args[2])
+	})
+
+	t.Run("неполная страница последняя", func(t *testing.T) {
+		engine := &fakeQueryEngine{rows: rows(2)}
+		r := NewRepository(fakeTxManager{engine: engine})
+
+		messages, nextCursor, err := r.ListMessages(ctx, "chat", 2, nil)
+		require.NoError(t, err)
+		assert.Len(t, messages, 2)
+		assert.Nil(t, nextCursor)
+	})
+
+	t.Run("курсор указывает на последнее сообщение страницы", func(t *testing.T) {
+		engine := &fakeQueryEngine{rows: rows(3)}
+		r := NewRepository(fakeTxManager{engine: engine})
+
+		messages, nextCursor, err := r.ListMessages(ctx, "chat", 2, nil)
+		require.NoError(t, err)
+		require.Len(t, messages, 2)
+		require.NotNil(t, nextCursor)
+
+		position, err := message.DecodeCursor(*nextCursor)
+		require.NoError(t, err)
+		assert.Equal(t, string(messages[1].ID), position.ID)
+		assert.True(t, messages[1].CreatedAt.Equal(position.CreatedAt))
+	})
+
+	t.Run("некорректный курсор не доходит до базы", func(t *testing.T) {
+		engine := &fakeQueryEngine{}
+		r := NewRepository(fakeTxManager{engine: engine})
+		cursor := "garbage"
+
+		_, _, err := r.ListMessages(ctx, "chat", 2, &cursor)
+		require.ErrorIs(t, err, models.ErrInvalidCursor)
+		assert.Zero(t, engine.calls)
+	})
+}
+
+func TestInMemoryChatRepository_ListMessages(t *testing.T) {
+	ctx := context.Background()
+	r := NewInMemoryChatRepository()
+	chat, err := r.SaveChat(ctx, &models.Chat{ParticipantIDs: []models.UserID{"alice", "bob"}})
+	require.NoError(t, err)
+
+	// Сообщения с одинаковым created_at различаются только id
+	createdAt := time.Date(2026, time.October, 16, 12, 30, 0, 0, time.UTC)
+	var want []models.MessageID
+	for i := range 5 {
+		id := models.MessageID(fmt.Sprintf("01920f6e-8c3a-7b4e-9d21-5f0a3c6e8b%02d", 10+i))
+		r.messages[chat.ID] = append(r.messages[chat.ID], &models.Message{ID: id, ChatID: chat.ID, CreatedAt: createdAt})
+		want = append([]models.MessageID{id}, want...)
+	}
+
+	var (
+		got    []models.MessageID
+		cursor *string
+		pages  int
+	)
+	for {
+		messages, nextCursor, err := r.ListMessages(ctx, chat.ID, 2, cursor)
+		require.NoError(t, err)
+		pages++
+		for _, msg := range messages {
+			got = append(got, msg.ID)
+		}
+		if nextCursor == nil {
+			break
+		}
+		cursor = nextCursor
+	}
+
+	// Страницы без пропусков и повторов, от новых к старым
+	assert.Equal(t, want, got)
+	assert.Equal(t, 3, pages)
+}
@@ -0,0 +1,46 @@
+package message
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"chat/internal/app/models"
+
+	"github.com/google/uuid"
+)
+
+// Cursor позиция в истории сообщений для keyset пагинации по (created_at, id)
+type Cursor struct {
+	CreatedAt time.Time `json:"t"`
+	ID        string    `json:"id"`
+}
+
+// EncodeCursor кодирует позицию сообщения в непрозрачную для клиента строку
+func EncodeCursor(m *models.Message) string {
+	data, _ := json.Marshal(Cursor{
+		CreatedAt: m.CreatedAt,
+		ID:        string(m.ID),
+	})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor декодирует курсор, полученный от клиента
+func DecodeCursor(cursor string) (*Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrInvalidCursor, err)
+	}
+
+	var c Cursor
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrInvalidCursor, err)
+	}
+	// id сравнивается с uuid колонкой, невалидное значение сломало бы запрос
+	if _, err := uuid.Parse(c.ID); err != nil || c.CreatedAt.IsZero() {
+		return nil, models.ErrInvalidCursor
+	}
+
+	return &c, nil
+}
@@ -0,0 +1,48 @@
+package message
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"chat/internal/app/models"
+)
+
+func TestCursor(t *testing.T) {
+	t.Run("курсор восстанавливает позицию сообщения", func(t *testing.T) {
+		msg := &models.Message{
+			ID:        "01920f6e-8c3a-7b4e-9d21-5f0a3c6e8b17",
+			CreatedAt: time.Date(2026, time.October, 16, 12, 30, 0, 123456000, time.UTC),
+		}
+
+		cursor, err := DecodeCursor(EncodeCursor(msg))
+		require.NoError(t, err)
+		assert.True(t, msg.CreatedAt.Equal(cursor.CreatedAt))
+		assert.Equal(t, string(msg.ID), cursor.ID)
+	})
+
+	t.Run("некорректный курсор", func(t *testing.T) {
+		encode := func(s string) string {
+			return base64.RawURLEncoding.EncodeToString([]byte(s))
+		}
+
+		for _, s := range []string{
+			"not base64!",
+			encode("not json"),
+			// нет id
+			encode(`{"t":"2026-10-16T12:30:00Z"}`),
+			// id не uuid
+			encode(`{"t":"2026-10-16T12:30:00Z","id":"friend"}`),
+			// нет времени
+			encode(`{"id":"01920f6e-8c3a-7b4e-9d21-5f0a3c6e8b17"}`),
+			// время не в формате RFC 3339
+			encode(`{"t":"yesterday","id":"01920f6e-8c3a-7b4e-9d21-5f0a3c6e8b17"}`),
+		} {
+			_, err := DecodeCursor(s)
+			assert.ErrorIs(t, err, models.ErrInvalidCursor, s)
+		}
+	})
+}
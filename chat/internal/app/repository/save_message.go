@@ -9,13 +9,24 @@ import (
 
 	"chat/internal/app/models"
 	"chat/internal/app/repository/message"
+
+	"github.com/google/uuid"
 )
 
 // SaveMessage сохраняет новое сообщение в базе данных
 func (r *Repository) SaveMessage(ctx context.Context, msg *models.Message) (*models.Message, error) {
 	const api = "[Repository][SaveMessage]"
 
-	now := time.Now()
+	// UUIDv7 сортируется по времени создания, что дает стабильный порядок истории
+	messageID, err := uuid.NewV7()
+	if err != nil {
+		return nil, fmt.Errorf("%s: generate message id: %w", api, err)
+	}
+
+	// Postgres хранит timestamptz с точностью до микросекунд - приводим время заранее,
+	// чтобы модель совпадала с сохраненной строкой
+	now := time.Now().Truncate(time.Microsecond)
+	msg.ID = models.MessageID(messageID.String())
 	msg.CreatedAt = now
 	msg.UpdatedAt = now
 
@@ -25,28 +36,25 @@ func (r *Repository) SaveMessage(ctx context.Context, msg *models.Message) (*mod
 	// Собираем запрос для вставки сообщения
 	insertMessageQuery := r.sb.Insert(message.MessagesTable).
 		Columns(
+			message.MessagesTableColumnID,
 			message.MessagesTableColumnText,
 			message.MessagesTableColumnChatID,
 			message.MessagesTableColumnOwnerID,
 			message.MessagesTableColumnCreatedAt,
 			message.MessagesTableColumnUpdatedAt,
 		).
-		Values(row.Text, row.ChatID, row.OwnerID, row.CreatedAt, row.UpdatedAt).
-		Suffix("RETURNING id")
+		Values(row.ID, row.Text, row.ChatID, row.OwnerID, row.CreatedAt, row.UpdatedAt)
 
 	// Оборачиваем операцию в транзакцию Read Committed
-	err := r.tm.RunReadCommitted(ctx, func(txCtx context.Context) error {
+	err = r.tm.RunReadCommitted(ctx, func(txCtx context.Context) error {
 		// Получаем QueryEngine из контекста транзакции
 		conn := r.tm.GetQueryEngine(txCtx)
 
-		// Выполняем вставку сообщения и получаем сгенерированный ID
-		var messageID string
-		if err := conn.Getx(txCtx, &messageID, insertMessageQuery); err != nil {
+		// Выполняем вставку сообщения
+		if _, err := conn.Execx(txCtx, insertMessageQuery); err != nil {
 			return fmt.Errorf("%s: %w", api, postgres.ConvertPGError(err))
 		}
 
-		msg.ID = models.MessageID(messageID)
-
 		// Возвращаем nil для COMMIT, любая ошибка выше вызовет ROLLBACK
 		return nil
 	})
@@ -22,6 +22,15 @@ func (c *ChatService) CreateDirectChat(ctx context.Context, req dto.CreateDirect
 		return nil, fmt.Errorf("%s: %w", api, models.ErrNotFound)
 	}
 
+	// Личный чат с пользователем, заблокировавшим или заблокированным, не создается
+	blocked, err := c.socialService.IsBlocked(ctx, req.UserID, req.ParticipantID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: socialService IsBlocked error: %w", api, err)
+	}
+	if blocked {
+		return nil, models.ErrBlocked
+	}
+
 	// Проверяем, что чат еще не существует
 	existingChat, err := c.chatRepo.GetDirectChatByParticipants(ctx, req.UserID, req.ParticipantID)
 	if err != nil {
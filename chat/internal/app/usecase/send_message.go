@@ -30,6 +30,20 @@ func (c *ChatService) SendMessage(ctx context.Context, req dto.SendMessageDto) (
 		return nil, models.ErrPermissionDenied
 	}
 
+	// Сообщения между пользователями с блокировкой в любую сторону запрещены
+	for _, participantID := range chat.ParticipantIDs {
+		if participantID == req.UserID {
+			continue
+		}
+		blocked, err := c.socialService.IsBlocked(ctx, req.UserID, participantID)
+		if err != nil {
+			return nil, fmt.Errorf("%s: socialService IsBlocked error: %w", apiSendMessage, err)
+		}
+		if blocked {
+			return nil, models.ErrBlocked
+		}
+	}
+
 	// Создаем сообщение
 	message := &models.Message{
 		ChatID:  req.ChatID,
@@ -37,9 +51,24 @@ func (c *ChatService) SendMessage(ctx context.Context, req dto.SendMessageDto) (
 		Text:    req.Text,
 	}
 
-	savedMessage, err := c.chatRepo.SaveMessage(ctx, message)
+	// Сохраняем сообщение и публикуем его подписчикам в одной транзакции,
+	// чтобы доставка и сохранение не расходились
+	var savedMessage *models.Message
+	err = c.txManager.RunReadCommitted(ctx, func(txCtx context.Context) error {
+		savedMessage, err = c.chatRepo.SaveMessage(txCtx, message)
+		if err != nil {
+			return fmt.Errorf("%s: chatRepo SaveMessage error: %w", apiSendMessage, err)
+		}
+
+		// Рассылаем сообщение подписчикам StreamMessages
+		if err := c.messageHub.Publish(txCtx, savedMessage); err != nil {
+			return fmt.Errorf("%s: messageHub Publish error: %w", apiSendMessage, err)
+		}
+
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("%s: chatRepo SaveMessage error: %w", apiSendMessage, err)
+		return nil, err
 	}
 
 	return savedMessage, nil
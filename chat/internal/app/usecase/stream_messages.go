@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"chat/internal/app/models"
+	"chat/internal/app/usecase/dto"
+)
+
+const (
+	apiStreamMessages = "[ChatService][StreamMessages]"
+
+	// streamReplayLimit максимальное количество сообщений, досылаемых из истории перед live-режимом
+	streamReplayLimit = 1000
+)
+
+// StreamMessages подписывает пользователя на новые сообщения чата
+//
+// Если передан SinceUnixMs, сначала досылаются сообщения из истории, созданные позже этого момента,
+// затем стрим переключается на live-доставку. Если таких сообщений больше streamReplayLimit,
+// возвращается models.ErrReplayTooLarge: клиент должен догрузить историю через ListMessages
+// и переподключиться с более поздним SinceUnixMs. Канал закрывается при отмене ctx или при отключении
+// медленного подписчика хабом.
+func (c *ChatService) StreamMessages(ctx context.Context, req dto.StreamMessagesDto) (<-chan *models.Message, error) {
+	chat, err := c.chatRepo.GetChat(ctx, req.ChatID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: chatRepo GetChat error: %w", apiStreamMessages, err)
+	}
+	if chat == nil {
+		return nil, models.ErrNotFound
+	}
+
+	// Проверяем, что пользователь является участником чата
+	isMember, err := c.chatRepo.IsChatMember(ctx, req.ChatID, req.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: chatRepo IsChatMember error: %w", apiStreamMessages, err)
+	}
+	if !isMember {
+		return nil, models.ErrPermissionDenied
+	}
+
+	streamCtx, cancel := context.WithCancel(ctx)
+
+	// Подписываемся до чтения истории, чтобы не потерять сообщения, отправленные между replay и live
+	live := c.messageHub.Subscribe(streamCtx, req.ChatID)
+
+	var replay []*models.Message
+	if req.SinceUnixMs != nil {
+		// Запрашиваем на одно сообщение больше лимита, чтобы отличить полную историю от обрезанной
+		replay, err = c.chatRepo.ListMessagesSince(ctx, req.ChatID, time.UnixMilli(*req.SinceUnixMs), streamReplayLimit+1)
+		if err != nil {
+			cancel()
+			return nil, fmt.Errorf("%s: chatRepo ListMessagesSince error: %w", apiStreamMessages, err)
+		}
+		if len(replay) > streamReplayLimit {
+			cancel()
+			return nil, models.ErrReplayTooLarge
+		}
+	}
+
+	out := make(chan *models.Message)
+
+	go func() {
+		defer cancel()
+		defer close(out)
+
+		// Запоминаем досланные из истории сообщения, чтобы не продублировать их из live
+		replayed := make(map[models.MessageID]struct{}, len(replay))
+		for _, msg := range replay {
+			replayed[msg.ID] = struct{}{}
+
+			select {
+			case out <- msg:
+			case <-streamCtx.Done():
+				return
+			}
+		}
+
+		for {
+			select {
+			case msg, ok := <-live:
+				if !ok {
+					return
+				}
+				if _, ok := replayed[msg.ID]; ok {
+					continue
+				}
+
+				select {
+				case out <- msg:
+				case <-streamCtx.Done():
+					return
+				}
+			case <-streamCtx.Done():
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
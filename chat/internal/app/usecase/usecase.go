@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	"chat/internal/app/models"
 	"chat/internal/app/usecase/dto"
@@ -13,6 +14,11 @@ type (
 		CheckUserExists(ctx context.Context, id models.UserID) (bool, error)
 	}
 
+	SocialService interface {
+		// IsBlocked - заблокировал ли один из пользователей другого
+		IsBlocked(ctx context.Context, firstUserID, secondUserID models.UserID) (bool, error)
+	}
+
 	ChatRepository interface {
 		SaveChat(ctx context.Context, chat *models.Chat) (*models.Chat, error)
 		GetChat(ctx context.Context, chatID models.ChatID) (*models.Chat, error)
@@ -23,6 +29,17 @@ type (
 
 		SaveMessage(ctx context.Context, msg *models.Message) (*models.Message, error)
 		ListMessages(ctx context.Context, chatID models.ChatID, limit int64, cursor *string) (messages []*models.Message, nextCursor *string, err error)
+		ListMessagesSince(ctx context.Context, chatID models.ChatID, since time.Time, limit int64) ([]*models.Message, error)
+	}
+
+	MessageHub interface {
+		Subscribe(ctx context.Context, chatID models.ChatID) <-chan *models.Message
+		Publish(ctx context.Context, msg *models.Message) error
+	}
+
+	// TransactionManager
+	TransactionManager interface {
+		RunReadCommitted(ctx context.Context, f func(ctx context.Context) error) error
 	}
 )
 
@@ -39,20 +56,32 @@ type Usecase interface {
 	SendMessage(ctx context.Context, req dto.SendMessageDto) (*models.Message, error)
 	// ListMessages получение истории сообщений
 	ListMessages(ctx context.Context, req dto.ListMessagesDto) (*dto.ListMessagesResponse, error)
-	// StreamMessages серверный стрим новых сообщений (будет реализован позже)
-	// StreamMessages(ctx context.Context, req dto.StreamMessagesDto) (<-chan *models.Message, error)
+	// StreamMessages серверный стрим новых сообщений
+	StreamMessages(ctx context.Context, req dto.StreamMessagesDto) (<-chan *models.Message, error)
 }
 
 type ChatService struct {
-	usersService UsersService
-	chatRepo     ChatRepository
+	usersService  UsersService
+	socialService SocialService
+	chatRepo      ChatRepository
+	messageHub    MessageHub
+	txManager     TransactionManager
 }
 
 var _ Usecase = (*ChatService)(nil)
 
-func NewUsecase(usersService UsersService, chatRepo ChatRepository) *ChatService {
+func NewUsecase(
+	usersService UsersService,
+	socialService SocialService,
+	chatRepo ChatRepository,
+	messageHub MessageHub,
+	txManager TransactionManager,
+) *ChatService {
 	return &ChatService{
-		usersService: usersService,
-		chatRepo:     chatRepo,
+		usersService:  usersService,
+		socialService: socialService,
+		chatRepo:      chatRepo,
+		messageHub:    messageHub,
+		txManager:     txManager,
 	}
 }
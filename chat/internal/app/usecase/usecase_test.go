@@ -0,0 +1,252 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"chat/internal/app/models"
+	"chat/internal/app/usecase/dto"
+)
+
+type stubUsersService struct{}
+
+func (stubUsersService) CheckUserExists(context.Context, models.UserID) (bool, error) {
+	return true, nil
+}
+
+// stubSocialService - блокировки между blocker и blocked
+type stubSocialService struct {
+	blocks map[[2]models.UserID]bool
+}
+
+func (s stubSocialService) IsBlocked(_ context.Context, firstUserID, secondUserID models.UserID) (bool, error) {
+	return s.blocks[[2]models.UserID{firstUserID, secondUserID}] || s.blocks[[2]models.UserID{secondUserID, firstUserID}], nil
+}
+
+type stubChatRepository struct {
+	ChatRepository
+	chat     *models.Chat
+	messages []*models.Message
+	chats    []*models.Chat
+	// history - сообщения, которые вернет ListMessagesSince
+	history      []*models.Message
+	historyCalls int
+}
+
+func (r *stubChatRepository) GetChat(context.Context, models.ChatID) (*models.Chat, error) {
+	return r.chat, nil
+}
+
+func (r *stubChatRepository) IsChatMember(_ context.Context, _ models.ChatID, userID models.UserID) (bool, error) {
+	for _, participantID := range r.chat.ParticipantIDs {
+		if participantID == userID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *stubChatRepository) ListMessagesSince(_ context.Context, _ models.ChatID, _ time.Time, limit int64) ([]*models.Message, error) {
+	r.historyCalls++
+	return r.history[:min(int(limit), len(r.history))], nil
+}
+
+func (r *stubChatRepository) SaveMessage(_ context.Context, msg *models.Message) (*models.Message, error) {
+	r.messages = append(r.messages, msg)
+	return msg, nil
+}
+
+func (r *stubChatRepository) GetDirectChatByParticipants(context.Context, models.UserID, models.UserID) (*models.Chat, error) {
+	return nil, nil
+}
+
+func (r *stubChatRepository) SaveChat(_ context.Context, chat *models.Chat) (*models.Chat, error) {
+	r.chats = append(r.chats, chat)
+	return chat, nil
+}
+
+type stubMessageHub struct {
+	MessageHub
+}
+
+func (stubMessageHub) Publish(context.Context, *models.Message) error { return nil }
+
+// stubStreamHub - хаб с единственной подпиской, live-сообщения в которую отправляет тест
+type stubStreamHub struct {
+	MessageHub
+	live   chan *models.Message
+	subCtx context.Context
+}
+
+func (h *stubStreamHub) Subscribe(ctx context.Context, _ models.ChatID) <-chan *models.Message {
+	h.subCtx = ctx
+	return h.live
+}
+
+type stubTxManager struct{}
+
+func (stubTxManager) RunReadCommitted(ctx context.Context, f func(ctx context.Context) error) error {
+	return f(ctx)
+}
+
+func TestChatService_Blocks(t *testing.T) {
+	ctx := context.Background()
+	social := stubSocialService{blocks: map[[2]models.UserID]bool{{"bob", "alice"}: true}}
+
+	t.Run("заблокированный отправитель получает ErrBlocked", func(t *testing.T) {
+		repo := &stubChatRepository{chat: &models.Chat{ID: "chat", ParticipantIDs: []models.UserID{"alice", "bob"}}}
+		c := NewUsecase(stubUsersService{}, social, repo, stubMessageHub{}, stubTxManager{})
+
+		_, err := c.SendMessage(ctx, dto.SendMessageDto{UserID: "alice", ChatID: "chat", Text: "привет"})
+		require.ErrorIs(t, err, models.ErrBlocked)
+		assert.Empty(t, repo.messages)
+	})
+
+	t.Run("без блокировки сообщение сохраняется", func(t *testing.T) {
+		repo := &stubChatRepository{chat: &models.Chat{ID: "chat", ParticipantIDs: []models.UserID{"alice", "carol"}}}
+		c := NewUsecase(stubUsersService{}, social, repo, stubMessageHub{}, stubTxManager{})
+
+		_, err := c.SendMessage(ctx, dto.SendMessageDto{UserID: "alice", ChatID: "chat", Text: "привет"})
+		require.NoError(t, err)
+		assert.Len(t, repo.messages, 1)
+	})
+
+	t.Run("личный чат с заблокировавшим пользователем не создается", func(t *testing.T) {
+		repo := &stubChatRepository{}
+		c := NewUsecase(stubUsersService{}, social, repo, stubMessageHub{}, stubTxManager{})
+
+		_, err := c.CreateDirectChat(ctx, dto.CreateDirectChatDto{UserID: "alice", ParticipantID: "bob"})
+		require.ErrorIs(t, err, models.ErrBlocked)
+		assert.Empty(t, repo.chats)
+	})
+}
+
+// receive читает n сообщений из стрима
+func receive(t *testing.T, stream <-chan *models.Message, n int) []models.MessageID {
+	t.Helper()
+
+	ids := make([]models.MessageID, 0, n)
+	for range n {
+		select {
+		case msg, ok := <-stream:
+			require.True(t, ok, "stream closed")
+			ids = append(ids, msg.ID)
+		case <-time.After(time.Second):
+			require.FailNow(t, "no message in stream")
+		}
+	}
+	return ids
+}
+
+func TestChatService_StreamMessages(t *testing.T) {
+	since := time.Now().Add(-time.Hour).UnixMilli()
+	chat := &models.Chat{ID: "chat", ParticipantIDs: []models.UserID{"alice", "bob"}}
+	history := func(ids ...models.MessageID) []*models.Message {
+		messages := make([]*models.Message, 0, len(ids))
+		for _, id := range ids {
+			messages = append(messages, &models.Message{ID: id, ChatID: "chat"})
+		}
+		return messages
+	}
+
+	t.Run("не участник чата не подписывается", func(t *testing.T) {
+		hub := &stubStreamHub{}
+		c := NewUsecase(stubUsersService{}, stubSocialService{}, &stubChatRepository{chat: chat}, hub, stubTxManager{})
+
+		_, err := c.StreamMessages(context.Background(), dto.StreamMessagesDto{UserID: "carol", ChatID: "chat"})
+		require.ErrorIs(t, err, models.ErrPermissionDenied)
+		assert.Nil(t, hub.subCtx)
+	})
+
+	t.Run("несуществующий чат", func(t *testing.T) {
+		hub := &stubStreamHub{}
+		c := NewUsecase(stubUsersService{}, stubSocialService{}, &stubChatRepository{}, hub, stubTxManager{})
+
+		_, err := c.StreamMessages(context.Background(), dto.StreamMessagesDto{UserID: "alice", ChatID: "chat"})
+		require.ErrorIs(t, err, models.ErrNotFound)
+		assert.Nil(t, hub.subCtx)
+	})
+
+	t.Run("без since история не читается", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		hub := &stubStreamHub{live: make(chan *models.Message, 1)}
+		repo := &stubChatRepository{chat: chat, history: history("m1")}
+		c := NewUsecase(stubUsersService{}, stubSocialService{}, repo, hub, stubTxManager{})
+
+		stream, err := c.StreamMessages(ctx, dto.StreamMessagesDto{UserID: "alice", ChatID: "chat"})
+		require.NoError(t, err)
+
+		hub.live <- &models.Message{ID: "m2"}
+		assert.Equal(t, []models.MessageID{"m2"}, receive(t, stream, 1))
+		assert.Zero(t, repo.historyCalls)
+	})
+
+	t.Run("история, затем live", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		hub := &stubStreamHub{live: make(chan *models.Message, 1)}
+		repo := &stubChatRepository{chat: chat, history: history("m1", "m2")}
+		c := NewUsecase(stubUsersService{}, stubSocialService{}, repo, hub, stubTxManager{})
+
+		stream, err := c.StreamMessages(ctx, dto.StreamMessagesDto{UserID: "alice", ChatID: "chat", SinceUnixMs: &since})
+		require.NoError(t, err)
+
+		// Сообщение, отправленное во время чтения истории, уже в подписке
+		hub.live <- &models.Message{ID: "m3"}
+		assert.Equal(t, []models.MessageID{"m1", "m2", "m3"}, receive(t, stream, 3))
+	})
+
+	t.Run("сообщение из истории и live доставляется один раз", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		hub := &stubStreamHub{live: make(chan *models.Message, 2)}
+		repo := &stubChatRepository{chat: chat, history: history("m1", "m2")}
+		c := NewUsecase(stubUsersService{}, stubSocialService{}, repo, hub, stubTxManager{})
+
+		stream, err := c.StreamMessages(ctx, dto.StreamMessagesDto{UserID: "alice", ChatID: "chat", SinceUnixMs: &since})
+		require.NoError(t, err)
+
+		hub.live <- &models.Message{ID: "m2"}
+		hub.live <- &models.Message{ID: "m3"}
+		assert.Equal(t, []models.MessageID{"m1", "m2", "m3"}, receive(t, stream, 3))
+	})
+
+	t.Run("отмена контекста закрывает стрим", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		hub := &stubStreamHub{live: make(chan *models.Message)}
+		c := NewUsecase(stubUsersService{}, stubSocialService{}, &stubChatRepository{chat: chat}, hub, stubTxManager{})
+
+		stream, err := c.StreamMessages(ctx, dto.StreamMessagesDto{UserID: "alice", ChatID: "chat"})
+		require.NoError(t, err)
+
+		cancel()
+		select {
+		case _, ok := <-stream:
+			assert.False(t, ok)
+		case <-time.After(time.Second):
+			require.FailNow(t, "stream is not closed")
+		}
+	})
+
+	t.Run("история длиннее лимита не обрезается молча", func(t *testing.T) {
+		ids := make([]models.MessageID, 0, streamReplayLimit+1)
+		for i := range streamReplayLimit + 1 {
+			ids = append(ids, models.MessageID(fmt.Sprintf("m%d", i)))
+		}
+		hub := &stubStreamHub{live: make(chan *models.Message)}
+		repo := &stubChatRepository{chat: chat, history: history(ids...)}
+		c := NewUsecase(stubUsersService{}, stubSocialService{}, repo, hub, stubTxManager{})
+
+		_, err := c.StreamMessages(context.Background(), dto.StreamMessagesDto{UserID: "alice", ChatID: "chat", SinceUnixMs: &since})
+		require.ErrorIs(t, err, models.ErrReplayTooLarge)
+		// Подписка отменена
+		require.NotNil(t, hub.subCtx)
+		assert.Error(t, hub.subCtx.Err())
+	})
+}
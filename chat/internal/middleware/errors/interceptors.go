@@ -25,17 +25,43 @@ func ErrorsUnaryInterceptor() grpc.UnaryServerInterceptor {
 			return resp, err
 		}
 
-		switch {
-		case errors.Is(err, models.ErrNotFound):
-			err = status.Error(codes.NotFound, err.Error())
-		case errors.Is(err, models.ErrAlreadyExists):
-			err = status.Error(codes.AlreadyExists, err.Error())
-		case errors.Is(err, models.ErrPermissionDenied):
-			err = status.Error(codes.PermissionDenied, err.Error())
-		default:
-			err = status.Error(codes.Unknown, err.Error())
+		return resp, convertError(err)
+	}
+}
+
+// ErrorsStreamInterceptor - convert any arror to rpc error for server streams
+func ErrorsStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		err := handler(srv, ss)
+		//
+		if _, ok := status.FromError(err); ok {
+			return err
 		}
 
-		return resp, err
+		return convertError(err)
+	}
+}
+
+func convertError(err error) error {
+	switch {
+	case errors.Is(err, models.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, models.ErrAlreadyExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, models.ErrPermissionDenied), errors.Is(err, models.ErrBlocked):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, models.ErrInvalidCursor):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, models.ErrReplayTooLarge):
+		return status.Error(codes.OutOfRange, err.Error())
+	case errors.Is(err, models.ErrSlowSubscriber):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	default:
+		return status.Error(codes.Unknown, err.Error())
 	}
 }
@@ -0,0 +1,37 @@
+package errors
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"chat/internal/app/models"
+)
+
+func TestErrorsUnaryInterceptor(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantCode codes.Code
+	}{
+		{name: "некорректный курсор", err: fmt.Errorf("[Repository][ListMessages]: %w", models.ErrInvalidCursor), wantCode: codes.InvalidArgument},
+		{name: "слишком длинная история стрима", err: models.ErrReplayTooLarge, wantCode: codes.OutOfRange},
+		{name: "чат не найден", err: models.ErrNotFound, wantCode: codes.NotFound},
+		{name: "gRPC статус не меняется", err: status.Error(codes.Unavailable, "unavailable"), wantCode: codes.Unavailable},
+	}
+
+	interceptor := ErrorsUnaryInterceptor()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{}, func(context.Context, any) (any, error) {
+				return nil, tt.err
+			})
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
@@ -0,0 +1,65 @@
+-- +goose NO TRANSACTION
+
+-- +goose Up
+-- +goose StatementBegin
+-- Генерация UUIDv7 (RFC 9562): первые 48 бит - unix время в миллисекундах, остальное - случайные биты
+CREATE OR REPLACE FUNCTION public.uuid_generate_v7(ts TIMESTAMPTZ DEFAULT clock_timestamp())
+RETURNS UUID AS $$
+DECLARE
+    unix_ms BIGINT := floor(extract(epoch FROM ts) * 1000);
+    bytes   BYTEA  := uuid_send(gen_random_uuid());
+BEGIN
+    -- 48 бит времени вместо первых 6 байт случайного UUIDv4
+    bytes := overlay(bytes PLACING substring(int8send(unix_ms) FROM 3) FROM 1 FOR 6);
+    -- версия 7 в старших 4 битах 7-го байта, вариант RFC 4122 уже выставлен gen_random_uuid
+    bytes := set_byte(bytes, 6, (get_byte(bytes, 6) & 15) | 112);
+    RETURN encode(bytes, 'hex')::UUID;
+END
+$$ LANGUAGE plpgsql VOLATILE;
+
+COMMENT ON FUNCTION public.uuid_generate_v7(TIMESTAMPTZ) IS 'Генерация сортируемого по времени UUIDv7';
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- Новые сообщения получают UUIDv7, даже если id не передан приложением.
+-- Существующие строки сохраняют свои id: порядок истории определяется парой (created_at, id),
+-- поэтому старые случайные UUID влияют только на порядок сообщений с одинаковым created_at.
+ALTER TABLE public.messages ALTER COLUMN id SET DEFAULT public.uuid_generate_v7();
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- Индекс под keyset пагинацию ListMessages: WHERE chat_id = ? AND (created_at, id) < (?, ?)
+-- ORDER BY created_at DESC, id DESC. Строится без блокировки записи в таблицу.
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_messages_chat_id_created_at_id
+    ON public.messages (chat_id, created_at DESC, id DESC);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- Новый индекс покрывает все запросы старого (chat_id, created_at)
+DROP INDEX CONCURRENTLY IF EXISTS public.idx_messages_chat_id_created_at;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+COMMENT ON COLUMN public.messages.id IS 'Уникальный идентификатор сообщения (UUIDv7 для новых сообщений)';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_messages_chat_id_created_at ON public.messages (chat_id, created_at);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP INDEX CONCURRENTLY IF EXISTS public.idx_messages_chat_id_created_at_id;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE public.messages ALTER COLUMN id SET DEFAULT gen_random_uuid();
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP FUNCTION IF EXISTS public.uuid_generate_v7(TIMESTAMPTZ);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+COMMENT ON COLUMN public.messages.id IS 'Уникальный идентификатор сообщения';
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Владелец резерва: Complete и Release обработчика, чей ключ перехватил повтор, не должны трогать чужую запись
+ALTER TABLE public.idempotency_keys ADD COLUMN owner TEXT NOT NULL DEFAULT '';
+
+COMMENT ON COLUMN public.idempotency_keys.owner IS 'Случайный идентификатор обработчика, зарезервировавшего ключ';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE public.idempotency_keys DROP COLUMN IF EXISTS owner;
+-- +goose StatementEnd
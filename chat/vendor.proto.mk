@@ -2,7 +2,7 @@
 VENDOR_PROTO_PATH := $(CURDIR)/vendor.protobuf
 
 # vendor
-vendor:	.vendor-reset .vendor-googleapis .vendor-google-protobuf .vendor-protovalidate .vendor-protoc-gen-openapiv2 .vendor-users .vendor-tidy
+vendor:	.vendor-reset .vendor-googleapis .vendor-google-protobuf .vendor-protovalidate .vendor-protoc-gen-openapiv2 .vendor-users .vendor-social .vendor-tidy
 
 # delete VENDOR_PROTO_PATH
 .vendor-reset:
@@ -56,6 +56,10 @@ vendor:	.vendor-reset .vendor-googleapis .vendor-google-protobuf .vendor-protova
 	mkdir -p $(VENDOR_PROTO_PATH)/users/api
 	cp -f ../users/api/service.proto $(VENDOR_PROTO_PATH)/users/api/
 
+.vendor-social:
+	mkdir -p $(VENDOR_PROTO_PATH)/social/api
+	cp -f ../social/api/service.proto $(VENDOR_PROTO_PATH)/social/api/
+
 # delete all non .proto files
 .vendor-tidy:
 	find $(VENDOR_PROTO_PATH) -type f ! -name "*.proto" -delete
@@ -3,24 +3,30 @@ package main
 import (
 	"context"
 	"errors"
+	"math"
 	"net/http"
 	"os/signal"
+	"strconv"
 	"strings"
 	"syscall"
 
 	"google.golang.org/grpc"
 
 	"github.com/sskorolev/balun_microservices/lib/app"
+	"github.com/sskorolev/balun_microservices/lib/authmw"
 	"github.com/sskorolev/balun_microservices/lib/config"
 	"github.com/sskorolev/balun_microservices/lib/logger"
 
+	"gateway/internal/stream"
 	"gateway/pkg/api/auth"
 	"gateway/pkg/api/chat"
 	pb "gateway/pkg/api/gateway"
+	"gateway/pkg/api/notifications"
 	"gateway/pkg/api/social"
 	"gateway/pkg/api/users"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
@@ -33,6 +39,8 @@ type Server struct {
 	usersClient  users.UsersServiceClient
 	socialClient social.SocialServiceClient
 	chatClient   chat.ChatServiceClient
+
+	notificationsClient notifications.NotificationServiceClient
 }
 
 func NewServer(application *app.App) *Server {
@@ -41,6 +49,8 @@ func NewServer(application *app.App) *Server {
 		usersClient:  users.NewUsersServiceClient(application.GetGRPCClient("users")),
 		socialClient: social.NewSocialServiceClient(application.GetGRPCClient("social")),
 		chatClient:   chat.NewChatServiceClient(application.GetGRPCClient("chat")),
+
+		notificationsClient: notifications.NewNotificationServiceClient(application.GetGRPCClient("notifications")),
 	}
 }
 
@@ -56,6 +66,15 @@ func customHTTPError(ctx context.Context, mux *runtime.ServeMux, marshaler runti
 
 	// Мапим gRPC код в HTTP статус
 	httpStatus := runtime.HTTPStatusFromCode(s.Code())
+
+	// RetryInfo (например, при блокировке входа) отдаем клиенту заголовком Retry-After
+	for _, detail := range s.Details() {
+		if retryInfo, ok := detail.(*errdetails.RetryInfo); ok && retryInfo.GetRetryDelay() != nil {
+			seconds := int64(math.Ceil(retryInfo.GetRetryDelay().AsDuration().Seconds()))
+			w.Header().Set("Retry-After", strconv.FormatInt(seconds, 10))
+		}
+	}
+
 	w.WriteHeader(httpStatus)
 
 	// Формируем JSON ответ
@@ -84,10 +103,62 @@ func customHTTPError(ctx context.Context, mux *runtime.ServeMux, marshaler runti
 	}
 }
 
+// forwardIdempotencyKey переносит idempotency-key из входящих метаданных в исходящие
+//
+// Сервисы сами хранят ключи и при повторе возвращают сохраненный ответ.
+func forwardIdempotencyKey(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+
+	idempotencyKeys := md.Get("idempotency-key")
+	if len(idempotencyKeys) == 0 {
+		return ctx
+	}
+
+	logger.InfoKV(ctx, "Gateway: Forwarding Idempotency-Key", "key", idempotencyKeys[0])
+	return metadata.AppendToOutgoingContext(ctx, "idempotency-key", idempotencyKeys[0])
+}
+
+// forwardClientAddress переносит x-forwarded-for из входящих метаданных в исходящие
+//
+// grpc-gateway дописывает в него адрес клиента, auth считает по нему неудачные попытки входа.
+func forwardClientAddress(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+
+	forwardedFor := md.Get("x-forwarded-for")
+	if len(forwardedFor) == 0 {
+		return ctx
+	}
+
+	return metadata.AppendToOutgoingContext(ctx, "x-forwarded-for", forwardedFor[len(forwardedFor)-1])
+}
+
+// forwardAuthorization переносит JWT из входящих метаданных в исходящие
+//
+// Сервис сам валидирует токен и берет из него пользователя.
+func forwardAuthorization(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+
+	authorization := md.Get(authmw.AuthorizationHeader)
+	if len(authorization) == 0 {
+		return ctx
+	}
+
+	return metadata.AppendToOutgoingContext(ctx, authmw.AuthorizationHeader, authorization[0])
+}
+
 func (s *Server) Register(ctx context.Context, req *auth.RegisterRequest) (*auth.RegisterResponse, error) {
 	logger.InfoKV(ctx, "Gateway: Register request", "email", req.GetEmail())
 
-	resp, err := s.authClient.Register(ctx, req)
+	resp, err := s.authClient.Register(forwardIdempotencyKey(ctx), req)
 	if err != nil {
 		logger.ErrorKV(ctx, "Gateway: Register error", "error", err.Error())
 		return nil, err
@@ -99,7 +170,7 @@ func (s *Server) Register(ctx context.Context, req *auth.RegisterRequest) (*auth
 func (s *Server) Login(ctx context.Context, req *auth.LoginRequest) (*auth.LoginResponse, error) {
 	logger.InfoKV(ctx, "Gateway: Login request", "email", req.GetEmail())
 
-	resp, err := s.authClient.Login(ctx, req)
+	resp, err := s.authClient.Login(forwardClientAddress(ctx), req)
 	if err != nil {
 		logger.ErrorKV(ctx, "Gateway: Login error", "error", err.Error())
 		return nil, err
@@ -123,7 +194,8 @@ func (s *Server) Refresh(ctx context.Context, req *auth.RefreshRequest) (*auth.R
 func (s *Server) Logout(ctx context.Context, req *auth.LogoutRequest) (*auth.LogoutResponse, error) {
 	logger.InfoKV(ctx, "Gateway: Logout request")
 
-	resp, err := s.authClient.Logout(ctx, req)
+	// Access токен пользователя отзывается вместе с refresh токеном
+	resp, err := s.authClient.Logout(forwardAuthorization(ctx), req)
 	if err != nil {
 		logger.ErrorKV(ctx, "Gateway: Logout error", "error", err.Error())
 		return nil, err
@@ -144,6 +216,66 @@ func (s *Server) GetJWKS(ctx context.Context, req *auth.GetJWKSRequest) (*auth.G
 	return resp, nil
 }
 
+func (s *Server) ListSessions(ctx context.Context, req *auth.ListSessionsRequest) (*auth.ListSessionsResponse, error) {
+	logger.InfoKV(ctx, "Gateway: ListSessions request")
+
+	resp, err := s.authClient.ListSessions(forwardAuthorization(ctx), req)
+	if err != nil {
+		logger.ErrorKV(ctx, "Gateway: ListSessions error", "error", err.Error())
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *Server) RevokeSession(ctx context.Context, req *auth.RevokeSessionRequest) (*auth.RevokeSessionResponse, error) {
+	logger.InfoKV(ctx, "Gateway: RevokeSession request", "device_id", req.GetDeviceId())
+
+	resp, err := s.authClient.RevokeSession(forwardAuthorization(ctx), req)
+	if err != nil {
+		logger.ErrorKV(ctx, "Gateway: RevokeSession error", "error", err.Error())
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *Server) RevokeAllOtherSessions(ctx context.Context, req *auth.RevokeAllOtherSessionsRequest) (*auth.RevokeAllOtherSessionsResponse, error) {
+	logger.InfoKV(ctx, "Gateway: RevokeAllOtherSessions request", "current_device_id", req.GetCurrentDeviceId())
+
+	resp, err := s.authClient.RevokeAllOtherSessions(forwardAuthorization(ctx), req)
+	if err != nil {
+		logger.ErrorKV(ctx, "Gateway: RevokeAllOtherSessions error", "error", err.Error())
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *Server) ChangePassword(ctx context.Context, req *auth.ChangePasswordRequest) (*auth.ChangePasswordResponse, error) {
+	logger.InfoKV(ctx, "Gateway: ChangePassword request", "current_device_id", req.GetCurrentDeviceId())
+
+	resp, err := s.authClient.ChangePassword(forwardAuthorization(ctx), req)
+	if err != nil {
+		logger.ErrorKV(ctx, "Gateway: ChangePassword error", "error", err.Error())
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *Server) ChangeEmail(ctx context.Context, req *auth.ChangeEmailRequest) (*auth.ChangeEmailResponse, error) {
+	logger.InfoKV(ctx, "Gateway: ChangeEmail request")
+
+	resp, err := s.authClient.ChangeEmail(forwardAuthorization(ctx), req)
+	if err != nil {
+		logger.ErrorKV(ctx, "Gateway: ChangeEmail error", "error", err.Error())
+		return nil, err
+	}
+
+	return resp, nil
+}
+
 func (s *Server) CreateProfile(ctx context.Context, req *users.CreateProfileRequest) (*users.CreateProfileResponse, error) {
 	logger.InfoKV(ctx, "Gateway: CreateProfile request", "nickname", req.GetNickname())
 
@@ -207,7 +339,7 @@ func (s *Server) SearchByNickname(ctx context.Context, req *users.SearchByNickna
 func (s *Server) SendFriendRequest(ctx context.Context, req *social.SendFriendRequestRequest) (*social.SendFriendRequestResponse, error) {
 	logger.InfoKV(ctx, "Gateway: SendFriendRequest", "to_user_id", req.GetToUserId())
 
-	resp, err := s.socialClient.SendFriendRequest(ctx, req)
+	resp, err := s.socialClient.SendFriendRequest(forwardIdempotencyKey(ctx), req)
 	if err != nil {
 		logger.ErrorKV(ctx, "Gateway: SendFriendRequest error", "error", err.Error())
 		return nil, err
@@ -217,7 +349,11 @@ func (s *Server) SendFriendRequest(ctx context.Context, req *social.SendFriendRe
 }
 
 func (s *Server) ListRequests(ctx context.Context, req *social.ListRequestsRequest) (*social.ListRequestsResponse, error) {
-	logger.InfoKV(ctx, "Gateway: ListRequests", "to_user_id", req.GetToUserId())
+	logger.InfoKV(ctx, "Gateway: ListRequests",
+		"direction", req.GetDirection().String(),
+		"statuses", req.GetStatuses(),
+		"limit", req.GetLimit(),
+	)
 
 	resp, err := s.socialClient.ListRequests(ctx, req)
 	if err != nil {
@@ -252,6 +388,30 @@ func (s *Server) DeclineFriendRequest(ctx context.Context, req *social.DeclineFr
 	return resp, nil
 }
 
+func (s *Server) CancelFriendRequest(ctx context.Context, req *social.CancelFriendRequestRequest) (*social.CancelFriendRequestResponse, error) {
+	logger.InfoKV(ctx, "Gateway: CancelFriendRequest", "request_id", req.GetRequestId())
+
+	resp, err := s.socialClient.CancelFriendRequest(ctx, req)
+	if err != nil {
+		logger.ErrorKV(ctx, "Gateway: CancelFriendRequest error", "error", err.Error())
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *Server) ListOutgoingRequests(ctx context.Context, req *social.ListOutgoingRequestsRequest) (*social.ListOutgoingRequestsResponse, error) {
+	logger.InfoKV(ctx, "Gateway: ListOutgoingRequests", "limit", req.GetLimit())
+
+	resp, err := s.socialClient.ListOutgoingRequests(ctx, req)
+	if err != nil {
+		logger.ErrorKV(ctx, "Gateway: ListOutgoingRequests error", "error", err.Error())
+		return nil, err
+	}
+
+	return resp, nil
+}
+
 func (s *Server) RemoveFriend(ctx context.Context, req *social.RemoveFriendRequest) (*social.RemoveFriendResponse, error) {
 	logger.InfoKV(ctx, "Gateway: RemoveFriend", "user_id", req.GetUserId())
 
@@ -276,20 +436,70 @@ func (s *Server) ListFriends(ctx context.Context, req *social.ListFriendsRequest
 	return resp, nil
 }
 
-func (s *Server) CreateDirectChat(ctx context.Context, req *chat.CreateDirectChatRequest) (*chat.CreateDirectChatResponse, error) {
-	logger.InfoKV(ctx, "Gateway: CreateDirectChat", "participant_id", req.GetParticipantId())
+func (s *Server) BlockUser(ctx context.Context, req *social.BlockUserRequest) (*social.BlockUserResponse, error) {
+	logger.InfoKV(ctx, "Gateway: BlockUser", "user_id", req.GetUserId())
 
-	// Извлекаем Idempotency-Key из входящих метаданных
-	md, ok := metadata.FromIncomingContext(ctx)
-	if ok {
-		if idempotencyKeys := md.Get("idempotency-key"); len(idempotencyKeys) > 0 {
-			// Добавляем idempotency-key в исходящие метаданные
-			ctx = metadata.AppendToOutgoingContext(ctx, "idempotency-key", idempotencyKeys[0])
-			logger.InfoKV(ctx, "Gateway: Forwarding Idempotency-Key", "key", idempotencyKeys[0])
-		}
+	resp, err := s.socialClient.BlockUser(ctx, req)
+	if err != nil {
+		logger.ErrorKV(ctx, "Gateway: BlockUser error", "error", err.Error())
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *Server) UnblockUser(ctx context.Context, req *social.UnblockUserRequest) (*social.UnblockUserResponse, error) {
+	logger.InfoKV(ctx, "Gateway: UnblockUser", "user_id", req.GetUserId())
+
+	resp, err := s.socialClient.UnblockUser(ctx, req)
+	if err != nil {
+		logger.ErrorKV(ctx, "Gateway: UnblockUser error", "error", err.Error())
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *Server) ListBlockedUsers(ctx context.Context, req *social.ListBlockedUsersRequest) (*social.ListBlockedUsersResponse, error) {
+	logger.InfoKV(ctx, "Gateway: ListBlockedUsers", "limit", req.GetLimit())
+
+	resp, err := s.socialClient.ListBlockedUsers(ctx, req)
+	if err != nil {
+		logger.ErrorKV(ctx, "Gateway: ListBlockedUsers error", "error", err.Error())
+		return nil, err
 	}
 
-	resp, err := s.chatClient.CreateDirectChat(ctx, req)
+	return resp, nil
+}
+
+func (s *Server) ListMutualFriends(ctx context.Context, req *social.ListMutualFriendsRequest) (*social.ListMutualFriendsResponse, error) {
+	logger.InfoKV(ctx, "Gateway: ListMutualFriends", "user_id", req.GetUserId())
+
+	resp, err := s.socialClient.ListMutualFriends(ctx, req)
+	if err != nil {
+		logger.ErrorKV(ctx, "Gateway: ListMutualFriends error", "error", err.Error())
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *Server) SuggestFriends(ctx context.Context, req *social.SuggestFriendsRequest) (*social.SuggestFriendsResponse, error) {
+	logger.InfoKV(ctx, "Gateway: SuggestFriends", "limit", req.GetLimit())
+
+	resp, err := s.socialClient.SuggestFriends(ctx, req)
+	if err != nil {
+		logger.ErrorKV(ctx, "Gateway: SuggestFriends error", "error", err.Error())
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *Server) CreateDirectChat(ctx context.Context, req *chat.CreateDirectChatRequest) (*chat.CreateDirectChatResponse, error) {
+	logger.InfoKV(ctx, "Gateway: CreateDirectChat", "participant_id", req.GetParticipantId())
+
+	resp, err := s.chatClient.CreateDirectChat(forwardIdempotencyKey(ctx), req)
 	if err != nil {
 		logger.ErrorKV(ctx, "Gateway: CreateDirectChat error", "error", err.Error())
 		return nil, err
@@ -337,7 +547,7 @@ func (s *Server) ListChatMembers(ctx context.Context, req *chat.ListChatMembersR
 func (s *Server) SendMessage(ctx context.Context, req *chat.SendMessageRequest) (*chat.SendMessageResponse, error) {
 	logger.InfoKV(ctx, "Gateway: SendMessage", "chat_id", req.GetChatId(), "text", req.GetText())
 
-	resp, err := s.chatClient.SendMessage(ctx, req)
+	resp, err := s.chatClient.SendMessage(forwardIdempotencyKey(ctx), req)
 	if err != nil {
 		logger.ErrorKV(ctx, "Gateway: SendMessage error", "error", err.Error())
 		return nil, err
@@ -358,6 +568,54 @@ func (s *Server) ListMessages(ctx context.Context, req *chat.ListMessagesRequest
 	return resp, nil
 }
 
+func (s *Server) ListNotifications(ctx context.Context, req *notifications.ListNotificationsRequest) (*notifications.ListNotificationsResponse, error) {
+	logger.InfoKV(ctx, "Gateway: ListNotifications", "limit", req.GetLimit(), "unread_only", req.GetUnreadOnly())
+
+	resp, err := s.notificationsClient.ListNotifications(forwardAuthorization(ctx), req)
+	if err != nil {
+		logger.ErrorKV(ctx, "Gateway: ListNotifications error", "error", err.Error())
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *Server) MarkRead(ctx context.Context, req *notifications.MarkReadRequest) (*notifications.MarkReadResponse, error) {
+	logger.InfoKV(ctx, "Gateway: MarkRead", "count", len(req.GetNotificationIds()))
+
+	resp, err := s.notificationsClient.MarkRead(forwardAuthorization(ctx), req)
+	if err != nil {
+		logger.ErrorKV(ctx, "Gateway: MarkRead error", "error", err.Error())
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *Server) MarkAllRead(ctx context.Context, req *notifications.MarkAllReadRequest) (*notifications.MarkAllReadResponse, error) {
+	logger.InfoKV(ctx, "Gateway: MarkAllRead")
+
+	resp, err := s.notificationsClient.MarkAllRead(forwardAuthorization(ctx), req)
+	if err != nil {
+		logger.ErrorKV(ctx, "Gateway: MarkAllRead error", "error", err.Error())
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *Server) GetUnreadCount(ctx context.Context, req *notifications.GetUnreadCountRequest) (*notifications.GetUnreadCountResponse, error) {
+	logger.InfoKV(ctx, "Gateway: GetUnreadCount")
+
+	resp, err := s.notificationsClient.GetUnreadCount(forwardAuthorization(ctx), req)
+	if err != nil {
+		logger.ErrorKV(ctx, "Gateway: GetUnreadCount error", "error", err.Error())
+		return nil, err
+	}
+
+	return resp, nil
+}
+
 func main() {
 	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer cancel()
@@ -369,6 +627,7 @@ func main() {
 		config.WithUsersService("users", 8082),
 		config.WithSocialService("social", 8082),
 		config.WithChatService("chat", 8082),
+		config.WithNotificationsService("notifications", 8082),
 	)
 	if err != nil {
 		logger.FatalKV(ctx, "failed to load config", "error", err.Error())
@@ -419,6 +678,21 @@ func main() {
 		logger.FatalKV(ctx, "failed to connect to chat service", "error", err.Error())
 	}
 
+	if err := application.InitGRPCClient(ctx, "notifications", cfg.NotificationsService); err != nil {
+		logger.FatalKV(ctx, "failed to connect to notifications service", "error", err.Error())
+	}
+
+	// Инициализируем auth компоненты (JWKS кеш и JWT validator) для стриминговых эндпоинтов
+	authComponents, authCleanup, err := app.InitAuthComponents(
+		ctx,
+		cfg.AuthService,
+		"gateway", // audience для gateway
+	)
+	if err != nil {
+		logger.FatalKV(ctx, "failed to initialize auth components", "error", err.Error())
+	}
+	defer authCleanup()
+
 	// Создаем Server с клиентами
 	server := NewServer(application)
 
@@ -448,8 +722,27 @@ func main() {
 		logger.FatalKV(ctx, "failed to register gateway handler", "error", err.Error())
 	}
 
+	// grpc-gateway не умеет отдавать браузеру серверные стримы, поэтому StreamMessages и StreamNotifications
+	// проксируются отдельными WebSocket и SSE эндпоинтами с той же JWT аутентификацией
+	var streamOpts []stream.Option
+	if cfg.Stream != nil {
+		streamOpts = append(streamOpts, stream.WithAllowedOrigins(cfg.Stream.AllowedOrigins))
+	}
+	messagesBridge := stream.NewBridge(stream.NewChatMessagesSource(server.chatClient), streamOpts...)
+	notificationsBridge := stream.NewBridge(stream.NewNotificationsSource(server.notificationsClient), streamOpts...)
+	streamAuth := func(h http.HandlerFunc) http.Handler {
+		return stream.QueryTokenMiddleware(authmw.HTTPMiddleware(authComponents.JWTValidator)(h))
+	}
+
+	httpMux := http.NewServeMux()
+	httpMux.Handle("GET /api/v1/chat/chats/{chatId}/messages/ws", streamAuth(messagesBridge.ServeWebSocket))
+	httpMux.Handle("GET /api/v1/chat/chats/{chatId}/messages/sse", streamAuth(messagesBridge.ServeSSE))
+	httpMux.Handle("GET /api/v1/notifications/ws", streamAuth(notificationsBridge.ServeWebSocket))
+	httpMux.Handle("GET /api/v1/notifications/sse", streamAuth(notificationsBridge.ServeSSE))
+	httpMux.Handle("/", mux)
+
 	// Инициализируем HTTP handler
-	application.InitHTTPServer(mux)
+	application.InitHTTPServer(httpMux)
 
 	// Запускаем все три сервера через новый метод
 	logger.InfoKV(ctx, "starting gateway service",
@@ -0,0 +1,39 @@
+package stream
+
+import (
+	"net/http"
+
+	"github.com/sskorolev/balun_microservices/lib/authmw"
+)
+
+// AccessTokenQueryParam query параметр с JWT для браузерных клиентов
+//
+// Браузерные WebSocket и EventSource не умеют передавать заголовок Authorization,
+// поэтому токен можно передать как ?access_token=<jwt>.
+const AccessTokenQueryParam = "access_token"
+
+// QueryTokenMiddleware переносит JWT из query параметра в заголовок Authorization,
+// чтобы его провалидировал authmw.HTTPMiddleware
+//
+// Если заголовок Authorization уже передан, query параметр игнорируется.
+// В обоих случаях параметр удаляется из URL запроса, чтобы токен не попал в логи и трейсы.
+func QueryTokenMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if !query.Has(AccessTokenQueryParam) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		r = r.Clone(r.Context())
+		if token := query.Get(AccessTokenQueryParam); token != "" && r.Header.Get(authmw.AuthorizationHeader) == "" {
+			r.Header.Set(authmw.AuthorizationHeader, authmw.BearerPrefix+token)
+		}
+
+		query.Del(AccessTokenQueryParam)
+		r.URL.RawQuery = query.Encode()
+		r.RequestURI = r.URL.RequestURI()
+
+		next.ServeHTTP(w, r)
+	})
+}
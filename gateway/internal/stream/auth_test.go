@@ -0,0 +1,196 @@
+package stream
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/sskorolev/balun_microservices/lib/authmw"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testIssuer = "balun-auth-service"
+	testKID    = "rsa-1"
+)
+
+// staticJWKS - JWKSProvider с фиксированным набором ключей
+type staticJWKS struct {
+	jwks *authmw.JWKS
+}
+
+func (s staticJWKS) GetJWKS() *authmw.JWKS {
+	return s.jwks
+}
+
+func (s staticJWKS) GetKeyByKID(kid string) (*authmw.InternalJWK, error) {
+	return s.jwks.GetKeyByKID(kid)
+}
+
+func (s staticJWKS) Stop() {}
+
+// newTestValidator валидатор с одним RSA ключом, возвращает ключ для подписи токенов
+func newTestValidator(t *testing.T) (*authmw.Validator, *rsa.PrivateKey) {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	jwks := &authmw.JWKS{Keys: []authmw.InternalJWK{{
+		KTY: "RSA",
+		Use: "sig",
+		KID: testKID,
+		Alg: jwt.SigningMethodRS256.Alg(),
+		N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}}}
+
+	return authmw.NewValidator(authmw.ValidatorConfig{
+		JWKSCache:        staticJWKS{jwks: jwks},
+		ExpectedIssuer:   testIssuer,
+		ExpectedAudience: "gateway",
+	}), key
+}
+
+func signTestToken(t *testing.T, key *rsa.PrivateKey, exp time.Time) string {
+	t.Helper()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss": testIssuer,
+		"sub": "user-1",
+		"aud": []string{"gateway"},
+		"iat": time.Now().Add(-time.Minute).Unix(),
+		"exp": exp.Unix(),
+		"jti": "jti-1",
+	})
+	token.Header["kid"] = testKID
+
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+	return signed
+}
+
+func TestQueryTokenMiddleware(t *testing.T) {
+	var got *http.Request
+	handler := QueryTokenMiddleware(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		got = r
+	}))
+
+	tests := []struct {
+		name              string
+		url               string
+		authorization     string
+		wantAuthorization string
+		wantRequestURI    string
+	}{
+		{
+			name:              "токен из query переносится в заголовок",
+			url:               "/api/v1/notifications/sse?access_token=secret",
+			wantAuthorization: "Bearer secret",
+			wantRequestURI:    "/api/v1/notifications/sse",
+		},
+		{
+			name:              "остальные параметры сохраняются",
+			url:               "/api/v1/chat/chats/chat-1/messages/ws?sinceUnixMs=42&access_token=secret",
+			wantAuthorization: "Bearer secret",
+			wantRequestURI:    "/api/v1/chat/chats/chat-1/messages/ws?sinceUnixMs=42",
+		},
+		{
+			name:              "заголовок важнее query, но токен из URL все равно удаляется",
+			url:               "/api/v1/notifications/sse?access_token=secret",
+			authorization:     "Bearer header",
+			wantAuthorization: "Bearer header",
+			wantRequestURI:    "/api/v1/notifications/sse",
+		},
+		{
+			name:           "без токена запрос не меняется",
+			url:            "/api/v1/notifications/sse?sinceUnixMs=42",
+			wantRequestURI: "/api/v1/notifications/sse?sinceUnixMs=42",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, tt.url, nil)
+			if tt.authorization != "" {
+				r.Header.Set(authmw.AuthorizationHeader, tt.authorization)
+			}
+
+			handler.ServeHTTP(httptest.NewRecorder(), r)
+
+			require.NotNil(t, got)
+			assert.Equal(t, tt.wantAuthorization, got.Header.Get(authmw.AuthorizationHeader))
+			// Токен не остается ни в URL, ни в RequestURI, которые попадают в логи
+			assert.Equal(t, tt.wantRequestURI, got.RequestURI)
+			assert.Equal(t, tt.wantRequestURI, got.URL.RequestURI())
+			assert.NotContains(t, got.URL.String(), "secret")
+		})
+	}
+}
+
+func TestStreamAuth(t *testing.T) {
+	validator, key := newTestValidator(t)
+
+	newServer := func(source *fakeSource) *httptest.Server {
+		bridge := NewBridge(source)
+		mux := http.NewServeMux()
+		mux.Handle("GET /sse", QueryTokenMiddleware(authmw.HTTPMiddleware(validator)(http.HandlerFunc(bridge.ServeSSE))))
+		mux.Handle("GET /ws", QueryTokenMiddleware(authmw.HTTPMiddleware(validator)(http.HandlerFunc(bridge.ServeWebSocket))))
+		return httptest.NewServer(mux)
+	}
+
+	expired := signTestToken(t, key, time.Now().Add(-time.Minute))
+	_, otherKey := newTestValidator(t)
+	foreign := signTestToken(t, otherKey, time.Now().Add(time.Hour))
+
+	tests := []struct {
+		name  string
+		query string
+	}{
+		{name: "без токена"},
+		{name: "пустой токен", query: "?access_token="},
+		{name: "не JWT", query: "?access_token=garbage"},
+		{name: "истекший токен", query: "?access_token=" + expired},
+		{name: "токен подписан чужим ключом", query: "?access_token=" + foreign},
+	}
+
+	for _, path := range []string{"/sse", "/ws"} {
+		for _, tt := range tests {
+			t.Run(path+" "+tt.name, func(t *testing.T) {
+				source := newFakeSource()
+				server := newServer(source)
+				defer server.Close()
+
+				resp, err := http.Get(server.URL + path + tt.query)
+				require.NoError(t, err)
+				defer resp.Body.Close()
+
+				assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+				assert.Zero(t, source.openedCount())
+			})
+		}
+	}
+
+	t.Run("валидный токен из query пробрасывается в сервис", func(t *testing.T) {
+		source := newFakeSource()
+		close(source.items)
+		server := newServer(source)
+		defer server.Close()
+
+		token := signTestToken(t, key, time.Now().Add(time.Hour))
+		resp, err := http.Get(server.URL + "/sse?access_token=" + token)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, 1, source.openedCount())
+		assert.Equal(t, []string{"Bearer " + token}, source.authorization)
+	})
+}
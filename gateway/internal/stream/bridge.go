@@ -0,0 +1,197 @@
+package stream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/sskorolev/balun_microservices/lib/authmw"
+	"github.com/sskorolev/balun_microservices/lib/logger"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// DefaultHeartbeatInterval интервал heartbeat фреймов по умолчанию
+const DefaultHeartbeatInterval = 15 * time.Second
+
+// Stream открытый серверный стрим сервиса
+type Stream interface {
+	// Recv возвращает следующий элемент стрима, io.EOF при штатном завершении
+	Recv() (proto.Message, error)
+}
+
+// Source серверный стрим сервиса, который Bridge отдает браузеру
+type Source interface {
+	// Method имя стрима для логов
+	Method() string
+	// Open проверяет HTTP запрос и открывает стрим в сервисе
+	//
+	// Ошибка вызывается до установки соединения с клиентом и отдается ему HTTP ошибкой.
+	Open(ctx context.Context, r *http.Request) (Stream, error)
+}
+
+// Bridge мост между браузерными транспортами (WebSocket, SSE) и серверным стримом сервиса
+type Bridge struct {
+	source            Source
+	heartbeatInterval time.Duration
+	allowedOrigins    map[string]struct{}
+	marshaler         protojson.MarshalOptions
+}
+
+// Option функциональная опция для Bridge
+type Option func(*Bridge)
+
+// WithHeartbeatInterval задает интервал heartbeat фреймов
+func WithHeartbeatInterval(interval time.Duration) Option {
+	return func(b *Bridge) {
+		if interval > 0 {
+			b.heartbeatInterval = interval
+		}
+	}
+}
+
+// WithAllowedOrigins задает Origin страниц, с которых разрешены WebSocket соединения
+//
+// Без этой опции браузерные WebSocket соединения запрещены, разрешены только клиенты без заголовка Origin.
+func WithAllowedOrigins(origins []string) Option {
+	return func(b *Bridge) {
+		for _, origin := range origins {
+			b.allowedOrigins[strings.ToLower(origin)] = struct{}{}
+		}
+	}
+}
+
+// NewBridge конструктор Bridge
+func NewBridge(source Source, opts ...Option) *Bridge {
+	b := &Bridge{
+		source:            source,
+		heartbeatInterval: DefaultHeartbeatInterval,
+		allowedOrigins:    make(map[string]struct{}),
+		marshaler:         protojson.MarshalOptions{},
+	}
+
+	for _, opt := range opts {
+		opt(b)
+	}
+
+	return b
+}
+
+// open открывает стрим в сервисе
+//
+// JWT из запроса пробрасывается в сервис, который валидирует его своим stream interceptor.
+func (b *Bridge) open(ctx context.Context, r *http.Request) (Stream, error) {
+	ctx = metadata.AppendToOutgoingContext(ctx, authmw.AuthorizationHeader, r.Header.Get(authmw.AuthorizationHeader))
+	return b.source.Open(ctx, r)
+}
+
+// checkOrigin разрешает запросы без Origin и с Origin из allowedOrigins
+//
+// Браузер отправляет WebSocket запрос на любой адрес и не применяет к нему CORS,
+// поэтому без проверки Origin стрим пользователя можно открыть со стороннего сайта.
+func (b *Bridge) checkOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+
+	_, ok := b.allowedOrigins[strings.ToLower(origin)]
+	return ok
+}
+
+// receive читает стрим в отдельной горутине
+//
+// Канал ошибок получает ровно одно значение: io.EOF при штатном завершении стрима или ошибку gRPC.
+func (b *Bridge) receive(ctx context.Context, stream Stream) (<-chan proto.Message, <-chan error) {
+	items := make(chan proto.Message)
+	errs := make(chan error, 1)
+
+	go func() {
+		for {
+			item, err := stream.Recv()
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			select {
+			case items <- item:
+			case <-ctx.Done():
+				errs <- ctx.Err()
+				return
+			}
+		}
+	}()
+
+	return items, errs
+}
+
+// writeOpenError отвечает клиенту HTTP ошибкой, если стрим не удалось открыть
+func writeOpenError(w http.ResponseWriter, err error) {
+	s := status.Convert(err)
+	http.Error(w, s.Message(), runtime.HTTPStatusFromCode(s.Code()))
+}
+
+// streamError описание ошибки стрима для клиента
+type streamError struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+// newStreamError конвертирует ошибку gRPC в описание для клиента, nil для штатного завершения
+func newStreamError(err error) *streamError {
+	if errors.Is(err, io.EOF) || errors.Is(err, context.Canceled) {
+		return nil
+	}
+
+	s, ok := status.FromError(err)
+	if !ok {
+		s = status.New(codes.Unknown, err.Error())
+	}
+	if s.Code() == codes.Canceled {
+		return nil
+	}
+
+	return &streamError{
+		Code:    int32(s.Code()),
+		Message: s.Message(),
+	}
+}
+
+// marshalItem сериализует элемент стрима в JSON в формате grpc-gateway
+func (b *Bridge) marshalItem(item proto.Message) ([]byte, error) {
+	data, err := b.marshaler.Marshal(item)
+	if err != nil {
+		return nil, fmt.Errorf("marshal message: %w", err)
+	}
+	return data, nil
+}
+
+// logStreamStart логирует открытие стрима
+func (b *Bridge) logStreamStart(ctx context.Context, transport string, r *http.Request) {
+	logger.InfoKV(ctx, "Gateway: "+b.source.Method(), "transport", transport, "path", r.URL.Path)
+}
+
+// logStreamEnd логирует завершение стрима
+func (b *Bridge) logStreamEnd(ctx context.Context, transport string, r *http.Request, streamErr *streamError) {
+	if streamErr == nil {
+		logger.InfoKV(ctx, "Gateway: "+b.source.Method()+" closed", "transport", transport, "path", r.URL.Path)
+		return
+	}
+
+	logger.ErrorKV(ctx, "Gateway: "+b.source.Method()+" error",
+		"transport", transport,
+		"path", r.URL.Path,
+		"code", streamErr.Code,
+		"error", streamErr.Message,
+	)
+}
@@ -0,0 +1,76 @@
+package stream
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/sskorolev/balun_microservices/lib/authmw"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	"gateway/pkg/api/chat"
+)
+
+// fakeSource стрим сервиса, элементы которого тест отправляет в items
+type fakeSource struct {
+	items chan proto.Message
+	// endErr - ошибка стрима после закрытия items, по умолчанию io.EOF
+	endErr error
+
+	mu            sync.Mutex
+	opened        int
+	authorization []string
+}
+
+var _ Source = (*fakeSource)(nil)
+
+func newFakeSource() *fakeSource {
+	return &fakeSource{items: make(chan proto.Message)}
+}
+
+func (s *fakeSource) Method() string {
+	return "FakeStream"
+}
+
+func (s *fakeSource) Open(ctx context.Context, _ *http.Request) (Stream, error) {
+	md, _ := metadata.FromOutgoingContext(ctx)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.opened++
+	s.authorization = md.Get(authmw.AuthorizationHeader)
+
+	return fakeStream{ctx: ctx, source: s}, nil
+}
+
+func (s *fakeSource) openedCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.opened
+}
+
+type fakeStream struct {
+	ctx    context.Context
+	source *fakeSource
+}
+
+func (s fakeStream) Recv() (proto.Message, error) {
+	select {
+	case item, ok := <-s.source.items:
+		if ok {
+			return item, nil
+		}
+		if s.source.endErr != nil {
+			return nil, s.source.endErr
+		}
+		return nil, io.EOF
+	case <-s.ctx.Done():
+		return nil, s.ctx.Err()
+	}
+}
+
+func testMessage(text string) *chat.Message {
+	return &chat.Message{MessageId: "message-1", ChatId: "chat-1", UserId: "user-1", Text: text}
+}
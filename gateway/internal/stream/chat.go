@@ -0,0 +1,78 @@
+package stream
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"gateway/pkg/api/chat"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// chatIDPathValue имя параметра пути с идентификатором чата
+	chatIDPathValue = "chatId"
+	// sinceUnixMsQueryParam query параметр для догрузки истории
+	sinceUnixMsQueryParam = "sinceUnixMs"
+)
+
+// Проверка удовлетворению интерфейсу Source
+var _ Source = (*ChatMessagesSource)(nil)
+
+// ChatMessagesSource стрим новых сообщений чата ChatService.StreamMessages
+type ChatMessagesSource struct {
+	chatClient chat.ChatServiceClient
+}
+
+// NewChatMessagesSource конструктор ChatMessagesSource
+func NewChatMessagesSource(chatClient chat.ChatServiceClient) *ChatMessagesSource {
+	return &ChatMessagesSource{
+		chatClient: chatClient,
+	}
+}
+
+// Method имя стрима для логов
+func (s *ChatMessagesSource) Method() string {
+	return "StreamMessages"
+}
+
+// Open собирает StreamMessagesRequest из пути и query параметров и открывает стрим
+func (s *ChatMessagesSource) Open(ctx context.Context, r *http.Request) (Stream, error) {
+	req := &chat.StreamMessagesRequest{
+		ChatId: r.PathValue(chatIDPathValue),
+	}
+	if req.ChatId == "" {
+		return nil, status.Error(codes.InvalidArgument, "chatId is required")
+	}
+
+	if raw := r.URL.Query().Get(sinceUnixMsQueryParam); raw != "" {
+		sinceUnixMs, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid %s: %v", sinceUnixMsQueryParam, err)
+		}
+		req.SinceUnixMs = &sinceUnixMs
+	}
+
+	stream, err := s.chatClient.StreamMessages(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return chatMessagesStream{stream: stream}, nil
+}
+
+// chatMessagesStream отдает сообщения из StreamMessagesResponse
+type chatMessagesStream struct {
+	stream chat.ChatService_StreamMessagesClient
+}
+
+func (s chatMessagesStream) Recv() (proto.Message, error) {
+	resp, err := s.stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetMessage(), nil
+}
@@ -0,0 +1,53 @@
+package stream
+
+import (
+	"context"
+	"net/http"
+
+	"gateway/pkg/api/notifications"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// Проверка удовлетворению интерфейсу Source
+var _ Source = (*NotificationsSource)(nil)
+
+// NotificationsSource стрим новых уведомлений пользователя NotificationService.StreamNotifications
+type NotificationsSource struct {
+	notificationsClient notifications.NotificationServiceClient
+}
+
+// NewNotificationsSource конструктор NotificationsSource
+func NewNotificationsSource(notificationsClient notifications.NotificationServiceClient) *NotificationsSource {
+	return &NotificationsSource{
+		notificationsClient: notificationsClient,
+	}
+}
+
+// Method имя стрима для логов
+func (s *NotificationsSource) Method() string {
+	return "StreamNotifications"
+}
+
+// Open открывает стрим уведомлений пользователя из JWT
+func (s *NotificationsSource) Open(ctx context.Context, _ *http.Request) (Stream, error) {
+	stream, err := s.notificationsClient.StreamNotifications(ctx, &notifications.StreamNotificationsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return notificationsStream{stream: stream}, nil
+}
+
+// notificationsStream отдает уведомления из StreamNotificationsResponse
+type notificationsStream struct {
+	stream notifications.NotificationService_StreamNotificationsClient
+}
+
+func (s notificationsStream) Recv() (proto.Message, error) {
+	resp, err := s.stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetNotification(), nil
+}
@@ -0,0 +1,94 @@
+package stream
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/sskorolev/balun_microservices/lib/logger"
+)
+
+const transportSSE = "sse"
+
+// SSE события
+const (
+	sseEventMessage   = "message"
+	sseEventHeartbeat = "heartbeat"
+	sseEventError     = "error"
+)
+
+// ServeSSE отдает элементы стрима как Server-Sent Events
+//
+// Формат событий:
+//
+//	event: message    data: элемент стрима (Message, Notification) в JSON
+//	event: heartbeat  data: {"unixMs": ...}
+//	event: error      data: {"code": ..., "message": ...}, после чего стрим закрывается
+func (b *Bridge) ServeSSE(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	stream, err := b.open(ctx, r)
+	if err != nil {
+		writeOpenError(w, err)
+		return
+	}
+
+	b.logStreamStart(ctx, transportSSE, r)
+
+	items, errs := b.receive(ctx, stream)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	heartbeat := time.NewTicker(b.heartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case item := <-items:
+			data, err := b.marshalItem(item)
+			if err != nil {
+				logger.ErrorKV(ctx, "Gateway: "+b.source.Method()+" marshal error", "error", err.Error())
+				continue
+			}
+			if err := writeSSE(w, flusher, sseEventMessage, data); err != nil {
+				return
+			}
+		case <-heartbeat.C:
+			data, _ := json.Marshal(map[string]int64{"unixMs": time.Now().UnixMilli()})
+			if err := writeSSE(w, flusher, sseEventHeartbeat, data); err != nil {
+				return
+			}
+		case err := <-errs:
+			streamErr := newStreamError(err)
+			b.logStreamEnd(ctx, transportSSE, r, streamErr)
+			if streamErr != nil {
+				data, _ := json.Marshal(streamErr)
+				_ = writeSSE(w, flusher, sseEventError, data)
+			}
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// writeSSE пишет одно событие и сразу отправляет его клиенту
+func writeSSE(w http.ResponseWriter, flusher http.Flusher, event string, data []byte) error {
+	if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, data); err != nil {
+		return err
+	}
+	flusher.Flush()
+	return nil
+}
@@ -0,0 +1,110 @@
+package stream
+
+import (
+	"bufio"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// sseEvent - событие Server-Sent Events
+type sseEvent struct {
+	event string
+	data  string
+}
+
+// readSSE читает события из тела ответа
+func readSSE(t *testing.T, resp *http.Response) <-chan sseEvent {
+	t.Helper()
+
+	events := make(chan sseEvent)
+	go func() {
+		defer close(events)
+
+		var event sseEvent
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			line := scanner.Text()
+			switch {
+			case strings.HasPrefix(line, "event: "):
+				event.event = strings.TrimPrefix(line, "event: ")
+			case strings.HasPrefix(line, "data: "):
+				event.data = strings.TrimPrefix(line, "data: ")
+			case line == "":
+				events <- event
+				event = sseEvent{}
+			}
+		}
+	}()
+
+	return events
+}
+
+func nextSSE(t *testing.T, events <-chan sseEvent) sseEvent {
+	t.Helper()
+
+	select {
+	case event, ok := <-events:
+		require.True(t, ok, "stream closed")
+		return event
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "no event")
+		return sseEvent{}
+	}
+}
+
+func TestBridge_ServeSSE(t *testing.T) {
+	t.Run("сообщения и heartbeat", func(t *testing.T) {
+		source := newFakeSource()
+		server := httptest.NewServer(http.HandlerFunc(NewBridge(source, WithHeartbeatInterval(20*time.Millisecond)).ServeSSE))
+		defer server.Close()
+
+		resp, err := http.Get(server.URL)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+		assert.Equal(t, "no-cache", resp.Header.Get("Cache-Control"))
+		events := readSSE(t, resp)
+
+		// Без сообщений соединение поддерживается heartbeat событиями
+		event := nextSSE(t, events)
+		assert.Equal(t, sseEventHeartbeat, event.event)
+		assert.Contains(t, event.data, `"unixMs":`)
+
+		source.items <- testMessage("hello")
+		for event = nextSSE(t, events); event.event == sseEventHeartbeat; event = nextSSE(t, events) {
+		}
+		assert.Equal(t, sseEventMessage, event.event)
+		assert.JSONEq(t, `{"messageId":"message-1","chatId":"chat-1","userId":"user-1","text":"hello"}`, event.data)
+	})
+
+	t.Run("ошибка стрима отдается событием error", func(t *testing.T) {
+		source := newFakeSource()
+		source.endErr = status.Error(codes.PermissionDenied, "not a chat member")
+		close(source.items)
+		server := httptest.NewServer(http.HandlerFunc(NewBridge(source).ServeSSE))
+		defer server.Close()
+
+		resp, err := http.Get(server.URL)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		events := readSSE(t, resp)
+
+		event := nextSSE(t, events)
+		assert.Equal(t, sseEventError, event.event)
+		assert.JSONEq(t, `{"code":7,"message":"not a chat member"}`, event.data)
+
+		// После ошибки стрим закрывается
+		_, ok := <-events
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,133 @@
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/sskorolev/balun_microservices/lib/logger"
+
+	"github.com/gorilla/websocket"
+)
+
+const (
+	transportWebSocket = "websocket"
+
+	// wsWriteTimeout таймаут на запись одного фрейма
+	wsWriteTimeout = 10 * time.Second
+	// wsMaxMessageSize максимальный размер входящего фрейма, клиент ничего кроме control фреймов не шлет
+	wsMaxMessageSize = 512
+)
+
+// WebSocket фреймы
+const (
+	wsFrameMessage   = "message"
+	wsFrameHeartbeat = "heartbeat"
+	wsFrameError     = "error"
+)
+
+// wsFrame JSON фрейм, отправляемый клиенту
+type wsFrame struct {
+	Type    string          `json:"type"`
+	Message json.RawMessage `json:"message,omitempty"`
+	UnixMs  int64           `json:"unixMs,omitempty"`
+	Error   *streamError    `json:"error,omitempty"`
+}
+
+// ServeWebSocket отдает элементы стрима через WebSocket
+//
+// Каждый фрейм - JSON вида {"type": "message"|"heartbeat"|"error", ...},
+// элемент стрима (Message, Notification) передается в поле message.
+// После фрейма error соединение закрывается.
+// Запросы с Origin, не разрешенным WithAllowedOrigins, отклоняются с 403.
+func (b *Bridge) ServeWebSocket(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	// Проверяем Origin до открытия стрима в сервисе
+	if !b.checkOrigin(r) {
+		logger.WarnKV(ctx, "Gateway: websocket origin not allowed", "origin", r.Header.Get("Origin"))
+		http.Error(w, "origin not allowed", http.StatusForbidden)
+		return
+	}
+
+	stream, err := b.open(ctx, r)
+	if err != nil {
+		writeOpenError(w, err)
+		return
+	}
+
+	upgrader := websocket.Upgrader{
+		ReadBufferSize:  1024,
+		WriteBufferSize: 4096,
+		CheckOrigin:     b.checkOrigin,
+	}
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// Upgrade сам отвечает клиенту ошибкой
+		logger.ErrorKV(ctx, "Gateway: websocket upgrade error", "error", err.Error())
+		return
+	}
+	defer conn.Close()
+
+	b.logStreamStart(ctx, transportWebSocket, r)
+
+	// Читаем входящие фреймы, чтобы обрабатывать close/ping и заметить отключение клиента
+	conn.SetReadLimit(wsMaxMessageSize)
+	go func() {
+		defer cancel()
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+
+	items, errs := b.receive(ctx, stream)
+
+	heartbeat := time.NewTicker(b.heartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case item := <-items:
+			data, err := b.marshalItem(item)
+			if err != nil {
+				logger.ErrorKV(ctx, "Gateway: "+b.source.Method()+" marshal error", "error", err.Error())
+				continue
+			}
+			if err := writeFrame(conn, wsFrame{Type: wsFrameMessage, Message: data}); err != nil {
+				return
+			}
+		case <-heartbeat.C:
+			if err := writeFrame(conn, wsFrame{Type: wsFrameHeartbeat, UnixMs: time.Now().UnixMilli()}); err != nil {
+				return
+			}
+		case err := <-errs:
+			streamErr := newStreamError(err)
+			b.logStreamEnd(ctx, transportWebSocket, r, streamErr)
+
+			closeCode := websocket.CloseNormalClosure
+			if streamErr != nil {
+				_ = writeFrame(conn, wsFrame{Type: wsFrameError, Error: streamErr})
+				closeCode = websocket.CloseInternalServerErr
+			}
+			_ = conn.WriteControl(websocket.CloseMessage,
+				websocket.FormatCloseMessage(closeCode, ""),
+				time.Now().Add(wsWriteTimeout),
+			)
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// writeFrame пишет JSON фрейм с таймаутом на запись
+func writeFrame(conn *websocket.Conn, frame wsFrame) error {
+	if err := conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout)); err != nil {
+		return err
+	}
+	return conn.WriteJSON(frame)
+}
@@ -0,0 +1,115 @@
+package stream
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func wsURL(server *httptest.Server) string {
+	return "ws" + strings.TrimPrefix(server.URL, "http")
+}
+
+func readFrame(t *testing.T, conn *websocket.Conn) wsFrame {
+	t.Helper()
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	var frame wsFrame
+	require.NoError(t, conn.ReadJSON(&frame))
+	return frame
+}
+
+func TestBridge_ServeWebSocket(t *testing.T) {
+	t.Run("upgrade, сообщения и heartbeat", func(t *testing.T) {
+		source := newFakeSource()
+		server := httptest.NewServer(http.HandlerFunc(NewBridge(source, WithHeartbeatInterval(20*time.Millisecond)).ServeWebSocket))
+		defer server.Close()
+
+		conn, resp, err := websocket.DefaultDialer.Dial(wsURL(server), nil)
+		require.NoError(t, err)
+		defer conn.Close()
+		assert.Equal(t, http.StatusSwitchingProtocols, resp.StatusCode)
+
+		frame := readFrame(t, conn)
+		assert.Equal(t, wsFrameHeartbeat, frame.Type)
+		assert.NotZero(t, frame.UnixMs)
+
+		source.items <- testMessage("hello")
+		for frame = readFrame(t, conn); frame.Type == wsFrameHeartbeat; frame = readFrame(t, conn) {
+		}
+		assert.Equal(t, wsFrameMessage, frame.Type)
+		assert.JSONEq(t, `{"messageId":"message-1","chatId":"chat-1","userId":"user-1","text":"hello"}`, string(frame.Message))
+	})
+
+	t.Run("штатное завершение стрима закрывает соединение", func(t *testing.T) {
+		source := newFakeSource()
+		close(source.items)
+		server := httptest.NewServer(http.HandlerFunc(NewBridge(source).ServeWebSocket))
+		defer server.Close()
+
+		conn, _, err := websocket.DefaultDialer.Dial(wsURL(server), nil)
+		require.NoError(t, err)
+		defer conn.Close()
+
+		require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+		_, _, err = conn.ReadMessage()
+		assert.True(t, websocket.IsCloseError(err, websocket.CloseNormalClosure), err)
+	})
+
+	tests := []struct {
+		name           string
+		allowedOrigins []string
+		origin         string
+		wantStatus     int
+	}{
+		{name: "клиент без Origin", wantStatus: http.StatusSwitchingProtocols},
+		{
+			name:           "разрешенный Origin",
+			allowedOrigins: []string{"https://app.example.com"},
+			origin:         "https://APP.example.com",
+			wantStatus:     http.StatusSwitchingProtocols,
+		},
+		{
+			name:           "чужой Origin",
+			allowedOrigins: []string{"https://app.example.com"},
+			origin:         "https://evil.example.com",
+			wantStatus:     http.StatusForbidden,
+		},
+		{
+			name:       "без списка Origin браузерные соединения запрещены",
+			origin:     "https://app.example.com",
+			wantStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source := newFakeSource()
+			server := httptest.NewServer(http.HandlerFunc(NewBridge(source, WithAllowedOrigins(tt.allowedOrigins)).ServeWebSocket))
+			defer server.Close()
+
+			header := http.Header{}
+			if tt.origin != "" {
+				header.Set("Origin", tt.origin)
+			}
+			conn, resp, err := websocket.DefaultDialer.Dial(wsURL(server), header)
+			if conn != nil {
+				defer conn.Close()
+			}
+			require.NotNil(t, resp)
+			assert.Equal(t, tt.wantStatus, resp.StatusCode)
+
+			if tt.wantStatus == http.StatusForbidden {
+				require.ErrorIs(t, err, websocket.ErrBadHandshake)
+				// Стрим в сервисе не открывается
+				assert.Zero(t, source.openedCount())
+			}
+		})
+	}
+}
@@ -768,7 +768,9 @@ type StreamMessagesRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// chatId - идентификатор чата
 	ChatId string `protobuf:"bytes,1,opt,name=chatId,proto3" json:"chatId,omitempty"`
-	// sinceUnixMs - время с которого получать сообщения в миллисекундах
+	// sinceUnixMs - время с которого получать сообщения в миллисекундах.
+	// Если после него отправлено больше 1000 сообщений, стрим завершается с OUT_OF_RANGE:
+	// историю нужно догрузить через ListMessages и переподключиться с более поздним sinceUnixMs
 	SinceUnixMs   *int64 `protobuf:"varint,2,opt,name=sinceUnixMs,proto3,oneof" json:"sinceUnixMs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
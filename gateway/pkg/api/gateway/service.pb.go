@@ -9,6 +9,7 @@ package gateway
 import (
 	auth "gateway/pkg/api/auth"
 	chat "gateway/pkg/api/chat"
+	notifications "gateway/pkg/api/notifications"
 	social "gateway/pkg/api/social"
 	users "gateway/pkg/api/users"
 	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
@@ -30,7 +31,7 @@ var File_api_gateway_service_proto protoreflect.FileDescriptor
 
 const file_api_gateway_service_proto_rawDesc = "" +
 	"\n" +
-	"\x19api/gateway/service.proto\x12@github.com.krus210.balun_microservices.protobuf.gateway.v1.proto\x1a\x13api/auth/auth.proto\x1a\x13api/chat/chat.proto\x1a\x17api/social/social.proto\x1a\x15api/users/users.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto2\x8d4\n" +
+	"\x19api/gateway/service.proto\x12@github.com.krus210.balun_microservices.protobuf.gateway.v1.proto\x1a\x13api/auth/auth.proto\x1a\x13api/chat/chat.proto\x1a%api/notifications/notifications.proto\x1a\x17api/social/social.proto\x1a\x15api/users/users.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto2\xcfX\n" +
 	"\x0eGatewayService\x12\xc4\x02\n" +
 	"\bRegister\x12N.github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RegisterRequest\x1aO.github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RegisterResponse\"\x96\x01\x92AsJ6\n" +
 	"\x03400\x12/\n" +
@@ -57,7 +58,33 @@ const file_api_gateway_service_proto_rawDesc = "" +
 	"\x03401\x12.\n" +
 	"\x0fUnauthenticated\x12\x1b\n" +
 	"\x19\x1a\x17#/definitions/rpcStatus\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/api/v1/auth/logout\x12\xc3\x01\n" +
-	"\aGetJWKS\x12M.github.com.krus210.balun_microservices.protobuf.auth.v1.proto.GetJWKSRequest\x1aN.github.com.krus210.balun_microservices.protobuf.auth.v1.proto.GetJWKSResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/api/v1/auth/jwks\x12\xd9\x02\n" +
+	"\aGetJWKS\x12M.github.com.krus210.balun_microservices.protobuf.auth.v1.proto.GetJWKSRequest\x1aN.github.com.krus210.balun_microservices.protobuf.auth.v1.proto.GetJWKSResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/api/v1/auth/jwks\x12\x90\x02\n" +
+	"\fListSessions\x12R.github.com.krus210.balun_microservices.protobuf.auth.v1.proto.ListSessionsRequest\x1aS.github.com.krus210.balun_microservices.protobuf.auth.v1.proto.ListSessionsResponse\"W\x92A7J5\n" +
+	"\x03401\x12.\n" +
+	"\x0fUnauthenticated\x12\x1b\n" +
+	"\x19\x1a\x17#/definitions/rpcStatus\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/auth/sessions\x12\xa0\x02\n" +
+	"\rRevokeSession\x12S.github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RevokeSessionRequest\x1aT.github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RevokeSessionResponse\"d\x92A9J7\n" +
+	"\x03404\x120\n" +
+	"\x11Session not found\x12\x1b\n" +
+	"\x19\x1a\x17#/definitions/rpcStatus\x82\xd3\xe4\x93\x02\"* /api/v1/auth/sessions/{deviceId}\x12\xc0\x02\n" +
+	"\x16RevokeAllOtherSessions\x12\\.github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RevokeAllOtherSessionsRequest\x1a].github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RevokeAllOtherSessionsResponse\"i\x92A8J6\n" +
+	"\x03400\x12/\n" +
+	"\x10Invalid argument\x12\x1b\n" +
+	"\x19\x1a\x17#/definitions/rpcStatus\x82\xd3\xe4\x93\x02(:\x01*\"#/api/v1/auth/sessions/revoke-others\x12\xf4\x02\n" +
+	"\x0eChangePassword\x12T.github.com.krus210.balun_microservices.protobuf.auth.v1.proto.ChangePasswordRequest\x1aU.github.com.krus210.balun_microservices.protobuf.auth.v1.proto.ChangePasswordResponse\"\xb4\x01\x92A\x89\x01J6\n" +
+	"\x03400\x12/\n" +
+	"\x10Invalid argument\x12\x1b\n" +
+	"\x19\x1a\x17#/definitions/rpcStatusJO\n" +
+	"\x03401\x12H\n" +
+	")Unauthenticated or wrong current password\x12\x1b\n" +
+	"\x19\x1a\x17#/definitions/rpcStatus\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/v1/auth/password/change\x12\xec\x02\n" +
+	"\vChangeEmail\x12Q.github.com.krus210.balun_microservices.protobuf.auth.v1.proto.ChangeEmailRequest\x1aR.github.com.krus210.balun_microservices.protobuf.auth.v1.proto.ChangeEmailResponse\"\xb5\x01\x92A\x8d\x01JO\n" +
+	"\x03401\x12H\n" +
+	")Unauthenticated or wrong current password\x12\x1b\n" +
+	"\x19\x1a\x17#/definitions/rpcStatusJ:\n" +
+	"\x03409\x123\n" +
+	"\x14Email already in use\x12\x1b\n" +
+	"\x19\x1a\x17#/definitions/rpcStatus\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/v1/auth/email/change\x12\xd9\x02\n" +
 	"\rCreateProfile\x12T.github.com.krus210.balun_microservices.protobuf.users.v1.proto.CreateProfileRequest\x1aU.github.com.krus210.balun_microservices.protobuf.users.v1.proto.CreateProfileResponse\"\x9a\x01\x92AvJ6\n" +
 	"\x03400\x12/\n" +
 	"\x10Invalid argument\x12\x1b\n" +
@@ -105,12 +132,31 @@ const file_api_gateway_service_proto_rawDesc = "" +
 	"\x19\x1a\x17#/definitions/rpcStatusJ>\n" +
 	"\x03404\x127\n" +
 	"\x18Friend request not found\x12\x1b\n" +
-	"\x19\x1a\x17#/definitions/rpcStatus\x82\xd3\xe4\x93\x024\x1a2/api/v1/social/friend-requests/{requestId}/decline\x12\x9f\x02\n" +
+	"\x19\x1a\x17#/definitions/rpcStatus\x82\xd3\xe4\x93\x024\x1a2/api/v1/social/friend-requests/{requestId}/decline\x12\x88\x03\n" +
+	"\x13CancelFriendRequest\x12[.github.com.krus210.balun_microservices.protobuf.social.v1.proto.CancelFriendRequestRequest\x1a\\.github.com.krus210.balun_microservices.protobuf.social.v1.proto.CancelFriendRequestResponse\"\xb5\x01\x92AyJ7\n" +
+	"\x03403\x120\n" +
+	"\x11Permission denied\x12\x1b\n" +
+	"\x19\x1a\x17#/definitions/rpcStatusJ>\n" +
+	"\x03404\x127\n" +
+	"\x18Friend request not found\x12\x1b\n" +
+	"\x19\x1a\x17#/definitions/rpcStatus\x82\xd3\xe4\x93\x023\x1a1/api/v1/social/friend-requests/{requestId}/cancel\x12\x84\x02\n" +
+	"\x14ListOutgoingRequests\x12\\.github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListOutgoingRequestsRequest\x1a].github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListOutgoingRequestsResponse\"/\x82\xd3\xe4\x93\x02)\x12'/api/v1/social/friend-requests/outgoing\x12\x9f\x02\n" +
 	"\fRemoveFriend\x12T.github.com.krus210.balun_microservices.protobuf.social.v1.proto.RemoveFriendRequest\x1aU.github.com.krus210.balun_microservices.protobuf.social.v1.proto.RemoveFriendResponse\"b\x92A8J6\n" +
 	"\x03404\x12/\n" +
 	"\x10Friend not found\x12\x1b\n" +
 	"\x19\x1a\x17#/definitions/rpcStatus\x82\xd3\xe4\x93\x02!*\x1f/api/v1/social/friends/{userId}\x12\xd8\x01\n" +
-	"\vListFriends\x12S.github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListFriendsRequest\x1aT.github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListFriendsResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/social/friends\x12\xe0\x02\n" +
+	"\vListFriends\x12S.github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListFriendsRequest\x1aT.github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListFriendsResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/social/friends\x12\x8d\x02\n" +
+	"\tBlockUser\x12Q.github.com.krus210.balun_microservices.protobuf.social.v1.proto.BlockUserRequest\x1aR.github.com.krus210.balun_microservices.protobuf.social.v1.proto.BlockUserResponse\"Y\x92A6J4\n" +
+	"\x03404\x12-\n" +
+	"\x0eUser not found\x12\x1b\n" +
+	"\x19\x1a\x17#/definitions/rpcStatus\x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/api/v1/social/blocks\x12\x9a\x02\n" +
+	"\vUnblockUser\x12S.github.com.krus210.balun_microservices.protobuf.social.v1.proto.UnblockUserRequest\x1aT.github.com.krus210.balun_microservices.protobuf.social.v1.proto.UnblockUserResponse\"`\x92A7J5\n" +
+	"\x03404\x12.\n" +
+	"\x0fBlock not found\x12\x1b\n" +
+	"\x19\x1a\x17#/definitions/rpcStatus\x82\xd3\xe4\x93\x02 *\x1e/api/v1/social/blocks/{userId}\x12\xe6\x01\n" +
+	"\x10ListBlockedUsers\x12X.github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListBlockedUsersRequest\x1aY.github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListBlockedUsersResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/social/blocks\x12\xfa\x01\n" +
+	"\x11ListMutualFriends\x12Y.github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListMutualFriendsRequest\x1aZ.github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListMutualFriendsResponse\".\x82\xd3\xe4\x93\x02(\x12&/api/v1/social/friends/{userId}/mutual\x12\xed\x01\n" +
+	"\x0eSuggestFriends\x12V.github.com.krus210.balun_microservices.protobuf.social.v1.proto.SuggestFriendsRequest\x1aW.github.com.krus210.balun_microservices.protobuf.social.v1.proto.SuggestFriendsResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/api/v1/social/friends/suggestions\x12\xe0\x02\n" +
 	"\x10CreateDirectChat\x12V.github.com.krus210.balun_microservices.protobuf.chat.v1.proto.CreateDirectChatRequest\x1aW.github.com.krus210.balun_microservices.protobuf.chat.v1.proto.CreateDirectChatResponse\"\x9a\x01\x92AsJ6\n" +
 	"\x03400\x12/\n" +
 	"\x10Invalid argument\x12\x1b\n" +
@@ -134,56 +180,98 @@ const file_api_gateway_service_proto_rawDesc = "" +
 	"\x03403\x120\n" +
 	"\x11Permission denied\x12\x1b\n" +
 	"\x19\x1a\x17#/definitions/rpcStatus\x82\xd3\xe4\x93\x02):\x01*\"$/api/v1/chat/chats/{chatId}/messages\x12\xe5\x01\n" +
-	"\fListMessages\x12R.github.com.krus210.balun_microservices.protobuf.chat.v1.proto.ListMessagesRequest\x1aS.github.com.krus210.balun_microservices.protobuf.chat.v1.proto.ListMessagesResponse\",\x82\xd3\xe4\x93\x02&\x12$/api/v1/chat/chats/{chatId}/messagesB\xd9\x04\x92A\xb8\x01\x12\x8d\x01\n" +
+	"\fListMessages\x12R.github.com.krus210.balun_microservices.protobuf.chat.v1.proto.ListMessagesRequest\x1aS.github.com.krus210.balun_microservices.protobuf.chat.v1.proto.ListMessagesResponse\",\x82\xd3\xe4\x93\x02&\x12$/api/v1/chat/chats/{chatId}/messages\x12\xb0\x02\n" +
+	"\x11ListNotifications\x12`.github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.ListNotificationsRequest\x1aa.github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.ListNotificationsResponse\"V\x92A6J4\n" +
+	"\x03400\x12-\n" +
+	"\x0eInvalid cursor\x12\x1b\n" +
+	"\x19\x1a\x17#/definitions/rpcStatus\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/notifications\x12\xa6\x02\n" +
+	"\bMarkRead\x12W.github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.MarkReadRequest\x1aX.github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.MarkReadResponse\"g\x92A?J=\n" +
+	"\x03400\x126\n" +
+	"\x17Invalid notification id\x12\x1b\n" +
+	"\x19\x1a\x17#/definitions/rpcStatus\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/notifications/read\x12\xf1\x01\n" +
+	"\vMarkAllRead\x12Z.github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.MarkAllReadRequest\x1a[.github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.MarkAllReadResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/v1/notifications/read-all\x12\xfb\x01\n" +
+	"\x0eGetUnreadCount\x12].github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.GetUnreadCountRequest\x1a^.github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.GetUnreadCountResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/api/v1/notifications/unread-countB\xd9\x04\x92A\xb8\x01\x12\x8d\x01\n" +
 	"\x19Microservices Gateway API\x12GHTTP Gateway для микросервисной архитектуры\"\"\n" +
 	"\vAPI Support\x1a\x13support@example.com2\x031.0*\x02\x01\x022\x10application/json:\x10application/json\n" +
 	"Dcom.github.com.krus210.balun_microservices.protobuf.gateway.v1.protoB\fServiceProtoP\x01Z\x1fgateway/pkg/api/gateway;gateway\xa2\x02\bGCKBPGVP\xaa\x02?Github.Com.Krus210.BalunMicroservices.Protobuf.Gateway.V1.Proto\xca\x02?Github\\Com\\Krus210\\BalunMicroservices\\Protobuf\\Gateway\\V1\\Proto\xe2\x02KGithub\\Com\\Krus210\\BalunMicroservices\\Protobuf\\Gateway\\V1\\Proto\\GPBMetadata\xea\x02FGithub::Com::Krus210::BalunMicroservices::Protobuf::Gateway::V1::Protob\x06proto3"
 
 var file_api_gateway_service_proto_goTypes = []any{
-	(*auth.RegisterRequest)(nil),                // 0: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RegisterRequest
-	(*auth.LoginRequest)(nil),                   // 1: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.LoginRequest
-	(*auth.RefreshRequest)(nil),                 // 2: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RefreshRequest
-	(*auth.LogoutRequest)(nil),                  // 3: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.LogoutRequest
-	(*auth.GetJWKSRequest)(nil),                 // 4: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.GetJWKSRequest
-	(*users.CreateProfileRequest)(nil),          // 5: github.com.krus210.balun_microservices.protobuf.users.v1.proto.CreateProfileRequest
-	(*users.UpdateProfileRequest)(nil),          // 6: github.com.krus210.balun_microservices.protobuf.users.v1.proto.UpdateProfileRequest
-	(*users.GetProfileByIDRequest)(nil),         // 7: github.com.krus210.balun_microservices.protobuf.users.v1.proto.GetProfileByIDRequest
-	(*users.GetProfileByNicknameRequest)(nil),   // 8: github.com.krus210.balun_microservices.protobuf.users.v1.proto.GetProfileByNicknameRequest
-	(*users.SearchByNicknameRequest)(nil),       // 9: github.com.krus210.balun_microservices.protobuf.users.v1.proto.SearchByNicknameRequest
-	(*social.SendFriendRequestRequest)(nil),     // 10: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SendFriendRequestRequest
-	(*social.ListRequestsRequest)(nil),          // 11: github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListRequestsRequest
-	(*social.AcceptFriendRequestRequest)(nil),   // 12: github.com.krus210.balun_microservices.protobuf.social.v1.proto.AcceptFriendRequestRequest
-	(*social.DeclineFriendRequestRequest)(nil),  // 13: github.com.krus210.balun_microservices.protobuf.social.v1.proto.DeclineFriendRequestRequest
-	(*social.RemoveFriendRequest)(nil),          // 14: github.com.krus210.balun_microservices.protobuf.social.v1.proto.RemoveFriendRequest
-	(*social.ListFriendsRequest)(nil),           // 15: github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListFriendsRequest
-	(*chat.CreateDirectChatRequest)(nil),        // 16: github.com.krus210.balun_microservices.protobuf.chat.v1.proto.CreateDirectChatRequest
-	(*chat.GetChatRequest)(nil),                 // 17: github.com.krus210.balun_microservices.protobuf.chat.v1.proto.GetChatRequest
-	(*chat.ListUserChatsRequest)(nil),           // 18: github.com.krus210.balun_microservices.protobuf.chat.v1.proto.ListUserChatsRequest
-	(*chat.ListChatMembersRequest)(nil),         // 19: github.com.krus210.balun_microservices.protobuf.chat.v1.proto.ListChatMembersRequest
-	(*chat.SendMessageRequest)(nil),             // 20: github.com.krus210.balun_microservices.protobuf.chat.v1.proto.SendMessageRequest
-	(*chat.ListMessagesRequest)(nil),            // 21: github.com.krus210.balun_microservices.protobuf.chat.v1.proto.ListMessagesRequest
-	(*auth.RegisterResponse)(nil),               // 22: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RegisterResponse
-	(*auth.LoginResponse)(nil),                  // 23: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.LoginResponse
-	(*auth.RefreshResponse)(nil),                // 24: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RefreshResponse
-	(*auth.LogoutResponse)(nil),                 // 25: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.LogoutResponse
-	(*auth.GetJWKSResponse)(nil),                // 26: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.GetJWKSResponse
-	(*users.CreateProfileResponse)(nil),         // 27: github.com.krus210.balun_microservices.protobuf.users.v1.proto.CreateProfileResponse
-	(*users.UpdateProfileResponse)(nil),         // 28: github.com.krus210.balun_microservices.protobuf.users.v1.proto.UpdateProfileResponse
-	(*users.GetProfileByIDResponse)(nil),        // 29: github.com.krus210.balun_microservices.protobuf.users.v1.proto.GetProfileByIDResponse
-	(*users.GetProfileByNicknameResponse)(nil),  // 30: github.com.krus210.balun_microservices.protobuf.users.v1.proto.GetProfileByNicknameResponse
-	(*users.SearchByNicknameResponse)(nil),      // 31: github.com.krus210.balun_microservices.protobuf.users.v1.proto.SearchByNicknameResponse
-	(*social.SendFriendRequestResponse)(nil),    // 32: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SendFriendRequestResponse
-	(*social.ListRequestsResponse)(nil),         // 33: github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListRequestsResponse
-	(*social.AcceptFriendRequestResponse)(nil),  // 34: github.com.krus210.balun_microservices.protobuf.social.v1.proto.AcceptFriendRequestResponse
-	(*social.DeclineFriendRequestResponse)(nil), // 35: github.com.krus210.balun_microservices.protobuf.social.v1.proto.DeclineFriendRequestResponse
-	(*social.RemoveFriendResponse)(nil),         // 36: github.com.krus210.balun_microservices.protobuf.social.v1.proto.RemoveFriendResponse
-	(*social.ListFriendsResponse)(nil),          // 37: github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListFriendsResponse
-	(*chat.CreateDirectChatResponse)(nil),       // 38: github.com.krus210.balun_microservices.protobuf.chat.v1.proto.CreateDirectChatResponse
-	(*chat.GetChatResponse)(nil),                // 39: github.com.krus210.balun_microservices.protobuf.chat.v1.proto.GetChatResponse
-	(*chat.ListUserChatsResponse)(nil),          // 40: github.com.krus210.balun_microservices.protobuf.chat.v1.proto.ListUserChatsResponse
-	(*chat.ListChatMembersResponse)(nil),        // 41: github.com.krus210.balun_microservices.protobuf.chat.v1.proto.ListChatMembersResponse
-	(*chat.SendMessageResponse)(nil),            // 42: github.com.krus210.balun_microservices.protobuf.chat.v1.proto.SendMessageResponse
-	(*chat.ListMessagesResponse)(nil),           // 43: github.com.krus210.balun_microservices.protobuf.chat.v1.proto.ListMessagesResponse
+	(*auth.RegisterRequest)(nil),                    // 0: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RegisterRequest
+	(*auth.LoginRequest)(nil),                       // 1: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.LoginRequest
+	(*auth.RefreshRequest)(nil),                     // 2: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RefreshRequest
+	(*auth.LogoutRequest)(nil),                      // 3: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.LogoutRequest
+	(*auth.GetJWKSRequest)(nil),                     // 4: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.GetJWKSRequest
+	(*auth.ListSessionsRequest)(nil),                // 5: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.ListSessionsRequest
+	(*auth.RevokeSessionRequest)(nil),               // 6: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RevokeSessionRequest
+	(*auth.RevokeAllOtherSessionsRequest)(nil),      // 7: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RevokeAllOtherSessionsRequest
+	(*auth.ChangePasswordRequest)(nil),              // 8: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.ChangePasswordRequest
+	(*auth.ChangeEmailRequest)(nil),                 // 9: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.ChangeEmailRequest
+	(*users.CreateProfileRequest)(nil),              // 10: github.com.krus210.balun_microservices.protobuf.users.v1.proto.CreateProfileRequest
+	(*users.UpdateProfileRequest)(nil),              // 11: github.com.krus210.balun_microservices.protobuf.users.v1.proto.UpdateProfileRequest
+	(*users.GetProfileByIDRequest)(nil),             // 12: github.com.krus210.balun_microservices.protobuf.users.v1.proto.GetProfileByIDRequest
+	(*users.GetProfileByNicknameRequest)(nil),       // 13: github.com.krus210.balun_microservices.protobuf.users.v1.proto.GetProfileByNicknameRequest
+	(*users.SearchByNicknameRequest)(nil),           // 14: github.com.krus210.balun_microservices.protobuf.users.v1.proto.SearchByNicknameRequest
+	(*social.SendFriendRequestRequest)(nil),         // 15: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SendFriendRequestRequest
+	(*social.ListRequestsRequest)(nil),              // 16: github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListRequestsRequest
+	(*social.AcceptFriendRequestRequest)(nil),       // 17: github.com.krus210.balun_microservices.protobuf.social.v1.proto.AcceptFriendRequestRequest
+	(*social.DeclineFriendRequestRequest)(nil),      // 18: github.com.krus210.balun_microservices.protobuf.social.v1.proto.DeclineFriendRequestRequest
+	(*social.CancelFriendRequestRequest)(nil),       // 19: github.com.krus210.balun_microservices.protobuf.social.v1.proto.CancelFriendRequestRequest
+	(*social.ListOutgoingRequestsRequest)(nil),      // 20: github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListOutgoingRequestsRequest
+	(*social.RemoveFriendRequest)(nil),              // 21: github.com.krus210.balun_microservices.protobuf.social.v1.proto.RemoveFriendRequest
+	(*social.ListFriendsRequest)(nil),               // 22: github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListFriendsRequest
+	(*social.BlockUserRequest)(nil),                 // 23: github.com.krus210.balun_microservices.protobuf.social.v1.proto.BlockUserRequest
+	(*social.UnblockUserRequest)(nil),               // 24: github.com.krus210.balun_microservices.protobuf.social.v1.proto.UnblockUserRequest
+	(*social.ListBlockedUsersRequest)(nil),          // 25: github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListBlockedUsersRequest
+	(*social.ListMutualFriendsRequest)(nil),         // 26: github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListMutualFriendsRequest
+	(*social.SuggestFriendsRequest)(nil),            // 27: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SuggestFriendsRequest
+	(*chat.CreateDirectChatRequest)(nil),            // 28: github.com.krus210.balun_microservices.protobuf.chat.v1.proto.CreateDirectChatRequest
+	(*chat.GetChatRequest)(nil),                     // 29: github.com.krus210.balun_microservices.protobuf.chat.v1.proto.GetChatRequest
+	(*chat.ListUserChatsRequest)(nil),               // 30: github.com.krus210.balun_microservices.protobuf.chat.v1.proto.ListUserChatsRequest
+	(*chat.ListChatMembersRequest)(nil),             // 31: github.com.krus210.balun_microservices.protobuf.chat.v1.proto.ListChatMembersRequest
+	(*chat.SendMessageRequest)(nil),                 // 32: github.com.krus210.balun_microservices.protobuf.chat.v1.proto.SendMessageRequest
+	(*chat.ListMessagesRequest)(nil),                // 33: github.com.krus210.balun_microservices.protobuf.chat.v1.proto.ListMessagesRequest
+	(*notifications.ListNotificationsRequest)(nil),  // 34: github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.ListNotificationsRequest
+	(*notifications.MarkReadRequest)(nil),           // 35: github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.MarkReadRequest
+	(*notifications.MarkAllReadRequest)(nil),        // 36: github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.MarkAllReadRequest
+	(*notifications.GetUnreadCountRequest)(nil),     // 37: github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.GetUnreadCountRequest
+	(*auth.RegisterResponse)(nil),                   // 38: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RegisterResponse
+	(*auth.LoginResponse)(nil),                      // 39: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.LoginResponse
+	(*auth.RefreshResponse)(nil),                    // 40: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RefreshResponse
+	(*auth.LogoutResponse)(nil),                     // 41: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.LogoutResponse
+	(*auth.GetJWKSResponse)(nil),                    // 42: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.GetJWKSResponse
+	(*auth.ListSessionsResponse)(nil),               // 43: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.ListSessionsResponse
+	(*auth.RevokeSessionResponse)(nil),              // 44: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RevokeSessionResponse
+	(*auth.RevokeAllOtherSessionsResponse)(nil),     // 45: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RevokeAllOtherSessionsResponse
+	(*auth.ChangePasswordResponse)(nil),             // 46: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.ChangePasswordResponse
+	(*auth.ChangeEmailResponse)(nil),                // 47: github.com.krus210.balun_microservices.protobuf.auth.v1.proto.ChangeEmailResponse
+	(*users.CreateProfileResponse)(nil),             // 48: github.com.krus210.balun_microservices.protobuf.users.v1.proto.CreateProfileResponse
+	(*users.UpdateProfileResponse)(nil),             // 49: github.com.krus210.balun_microservices.protobuf.users.v1.proto.UpdateProfileResponse
+	(*users.GetProfileByIDResponse)(nil),            // 50: github.com.krus210.balun_microservices.protobuf.users.v1.proto.GetProfileByIDResponse
+	(*users.GetProfileByNicknameResponse)(nil),      // 51: github.com.krus210.balun_microservices.protobuf.users.v1.proto.GetProfileByNicknameResponse
+	(*users.SearchByNicknameResponse)(nil),          // 52: github.com.krus210.balun_microservices.protobuf.users.v1.proto.SearchByNicknameResponse
+	(*social.SendFriendRequestResponse)(nil),        // 53: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SendFriendRequestResponse
+	(*social.ListRequestsResponse)(nil),             // 54: github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListRequestsResponse
+	(*social.AcceptFriendRequestResponse)(nil),      // 55: github.com.krus210.balun_microservices.protobuf.social.v1.proto.AcceptFriendRequestResponse
+	(*social.DeclineFriendRequestResponse)(nil),     // 56: github.com.krus210.balun_microservices.protobuf.social.v1.proto.DeclineFriendRequestResponse
+	(*social.CancelFriendRequestResponse)(nil),      // 57: github.com.krus210.balun_microservices.protobuf.social.v1.proto.CancelFriendRequestResponse
+	(*social.ListOutgoingRequestsResponse)(nil),     // 58: github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListOutgoingRequestsResponse
+	(*social.RemoveFriendResponse)(nil),             // 59: github.com.krus210.balun_microservices.protobuf.social.v1.proto.RemoveFriendResponse
+	(*social.ListFriendsResponse)(nil),              // 60: github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListFriendsResponse
+	(*social.BlockUserResponse)(nil),                // 61: github.com.krus210.balun_microservices.protobuf.social.v1.proto.BlockUserResponse
+	(*social.UnblockUserResponse)(nil),              // 62: github.com.krus210.balun_microservices.protobuf.social.v1.proto.UnblockUserResponse
+	(*social.ListBlockedUsersResponse)(nil),         // 63: github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListBlockedUsersResponse
+	(*social.ListMutualFriendsResponse)(nil),        // 64: github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListMutualFriendsResponse
+	(*social.SuggestFriendsResponse)(nil),           // 65: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SuggestFriendsResponse
+	(*chat.CreateDirectChatResponse)(nil),           // 66: github.com.krus210.balun_microservices.protobuf.chat.v1.proto.CreateDirectChatResponse
+	(*chat.GetChatResponse)(nil),                    // 67: github.com.krus210.balun_microservices.protobuf.chat.v1.proto.GetChatResponse
+	(*chat.ListUserChatsResponse)(nil),              // 68: github.com.krus210.balun_microservices.protobuf.chat.v1.proto.ListUserChatsResponse
+	(*chat.ListChatMembersResponse)(nil),            // 69: github.com.krus210.balun_microservices.protobuf.chat.v1.proto.ListChatMembersResponse
+	(*chat.SendMessageResponse)(nil),                // 70: github.com.krus210.balun_microservices.protobuf.chat.v1.proto.SendMessageResponse
+	(*chat.ListMessagesResponse)(nil),               // 71: github.com.krus210.balun_microservices.protobuf.chat.v1.proto.ListMessagesResponse
+	(*notifications.ListNotificationsResponse)(nil), // 72: github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.ListNotificationsResponse
+	(*notifications.MarkReadResponse)(nil),          // 73: github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.MarkReadResponse
+	(*notifications.MarkAllReadResponse)(nil),       // 74: github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.MarkAllReadResponse
+	(*notifications.GetUnreadCountResponse)(nil),    // 75: github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.GetUnreadCountResponse
 }
 var file_api_gateway_service_proto_depIdxs = []int32{
 	0,  // 0: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.Register:input_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RegisterRequest
@@ -191,47 +279,79 @@ var file_api_gateway_service_proto_depIdxs = []int32{
 	2,  // 2: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.Refresh:input_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RefreshRequest
 	3,  // 3: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.Logout:input_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.LogoutRequest
 	4,  // 4: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.GetJWKS:input_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.GetJWKSRequest
-	5,  // 5: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.CreateProfile:input_type -> github.com.krus210.balun_microservices.protobuf.users.v1.proto.CreateProfileRequest
-	6,  // 6: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.UpdateProfile:input_type -> github.com.krus210.balun_microservices.protobuf.users.v1.proto.UpdateProfileRequest
-	7,  // 7: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.GetProfileByID:input_type -> github.com.krus210.balun_microservices.protobuf.users.v1.proto.GetProfileByIDRequest
-	8,  // 8: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.GetProfileByNickname:input_type -> github.com.krus210.balun_microservices.protobuf.users.v1.proto.GetProfileByNicknameRequest
-	9,  // 9: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.SearchByNickname:input_type -> github.com.krus210.balun_microservices.protobuf.users.v1.proto.SearchByNicknameRequest
-	10, // 10: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.SendFriendRequest:input_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.SendFriendRequestRequest
-	11, // 11: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.ListRequests:input_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListRequestsRequest
-	12, // 12: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.AcceptFriendRequest:input_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.AcceptFriendRequestRequest
-	13, // 13: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.DeclineFriendRequest:input_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.DeclineFriendRequestRequest
-	14, // 14: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.RemoveFriend:input_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.RemoveFriendRequest
-	15, // 15: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.ListFriends:input_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListFriendsRequest
-	16, // 16: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.CreateDirectChat:input_type -> github.com.krus210.balun_microservices.protobuf.chat.v1.proto.CreateDirectChatRequest
-	17, // 17: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.GetChat:input_type -> github.com.krus210.balun_microservices.protobuf.chat.v1.proto.GetChatRequest
-	18, // 18: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.ListUserChats:input_type -> github.com.krus210.balun_microservices.protobuf.chat.v1.proto.ListUserChatsRequest
-	19, // 19: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.ListChatMembers:input_type -> github.com.krus210.balun_microservices.protobuf.chat.v1.proto.ListChatMembersRequest
-	20, // 20: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.SendMessage:input_type -> github.com.krus210.balun_microservices.protobuf.chat.v1.proto.SendMessageRequest
-	21, // 21: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.ListMessages:input_type -> github.com.krus210.balun_microservices.protobuf.chat.v1.proto.ListMessagesRequest
-	22, // 22: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.Register:output_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RegisterResponse
-	23, // 23: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.Login:output_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.LoginResponse
-	24, // 24: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.Refresh:output_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RefreshResponse
-	25, // 25: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.Logout:output_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.LogoutResponse
-	26, // 26: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.GetJWKS:output_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.GetJWKSResponse
-	27, // 27: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.CreateProfile:output_type -> github.com.krus210.balun_microservices.protobuf.users.v1.proto.CreateProfileResponse
-	28, // 28: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.UpdateProfile:output_type -> github.com.krus210.balun_microservices.protobuf.users.v1.proto.UpdateProfileResponse
-	29, // 29: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.GetProfileByID:output_type -> github.com.krus210.balun_microservices.protobuf.users.v1.proto.GetProfileByIDResponse
-	30, // 30: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.GetProfileByNickname:output_type -> github.com.krus210.balun_microservices.protobuf.users.v1.proto.GetProfileByNicknameResponse
-	31, // 31: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.SearchByNickname:output_type -> github.com.krus210.balun_microservices.protobuf.users.v1.proto.SearchByNicknameResponse
-	32, // 32: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.SendFriendRequest:output_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.SendFriendRequestResponse
-	33, // 33: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.ListRequests:output_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListRequestsResponse
-	34, // 34: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.AcceptFriendRequest:output_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.AcceptFriendRequestResponse
-	35, // 35: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.DeclineFriendRequest:output_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.DeclineFriendRequestResponse
-	36, // 36: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.RemoveFriend:output_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.RemoveFriendResponse
-	37, // 37: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.ListFriends:output_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListFriendsResponse
-	38, // 38: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.CreateDirectChat:output_type -> github.com.krus210.balun_microservices.protobuf.chat.v1.proto.CreateDirectChatResponse
-	39, // 39: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.GetChat:output_type -> github.com.krus210.balun_microservices.protobuf.chat.v1.proto.GetChatResponse
-	40, // 40: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.ListUserChats:output_type -> github.com.krus210.balun_microservices.protobuf.chat.v1.proto.ListUserChatsResponse
-	41, // 41: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.ListChatMembers:output_type -> github.com.krus210.balun_microservices.protobuf.chat.v1.proto.ListChatMembersResponse
-	42, // 42: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.SendMessage:output_type -> github.com.krus210.balun_microservices.protobuf.chat.v1.proto.SendMessageResponse
-	43, // 43: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.ListMessages:output_type -> github.com.krus210.balun_microservices.protobuf.chat.v1.proto.ListMessagesResponse
-	22, // [22:44] is the sub-list for method output_type
-	0,  // [0:22] is the sub-list for method input_type
+	5,  // 5: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.ListSessions:input_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.ListSessionsRequest
+	6,  // 6: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.RevokeSession:input_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RevokeSessionRequest
+	7,  // 7: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.RevokeAllOtherSessions:input_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RevokeAllOtherSessionsRequest
+	8,  // 8: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.ChangePassword:input_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.ChangePasswordRequest
+	9,  // 9: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.ChangeEmail:input_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.ChangeEmailRequest
+	10, // 10: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.CreateProfile:input_type -> github.com.krus210.balun_microservices.protobuf.users.v1.proto.CreateProfileRequest
+	11, // 11: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.UpdateProfile:input_type -> github.com.krus210.balun_microservices.protobuf.users.v1.proto.UpdateProfileRequest
+	12, // 12: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.GetProfileByID:input_type -> github.com.krus210.balun_microservices.protobuf.users.v1.proto.GetProfileByIDRequest
+	13, // 13: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.GetProfileByNickname:input_type -> github.com.krus210.balun_microservices.protobuf.users.v1.proto.GetProfileByNicknameRequest
+	14, // 14: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.SearchByNickname:input_type -> github.com.krus210.balun_microservices.protobuf.users.v1.proto.SearchByNicknameRequest
+	15, // 15: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.SendFriendRequest:input_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.SendFriendRequestRequest
+	16, // 16: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.ListRequests:input_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListRequestsRequest
+	17, // 17: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.AcceptFriendRequest:input_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.AcceptFriendRequestRequest
+	18, // 18: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.DeclineFriendRequest:input_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.DeclineFriendRequestRequest
+	19, // 19: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.CancelFriendRequest:input_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.CancelFriendRequestRequest
+	20, // 20: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.ListOutgoingRequests:input_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListOutgoingRequestsRequest
+	21, // 21: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.RemoveFriend:input_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.RemoveFriendRequest
+	22, // 22: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.ListFriends:input_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListFriendsRequest
+	23, // 23: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.BlockUser:input_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.BlockUserRequest
+	24, // 24: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.UnblockUser:input_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.UnblockUserRequest
+	25, // 25: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.ListBlockedUsers:input_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListBlockedUsersRequest
+	26, // 26: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.ListMutualFriends:input_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListMutualFriendsRequest
+	27, // 27: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.SuggestFriends:input_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.SuggestFriendsRequest
+	28, // 28: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.CreateDirectChat:input_type -> github.com.krus210.balun_microservices.protobuf.chat.v1.proto.CreateDirectChatRequest
+	29, // 29: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.GetChat:input_type -> github.com.krus210.balun_microservices.protobuf.chat.v1.proto.GetChatRequest
+	30, // 30: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.ListUserChats:input_type -> github.com.krus210.balun_microservices.protobuf.chat.v1.proto.ListUserChatsRequest
+	31, // 31: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.ListChatMembers:input_type -> github.com.krus210.balun_microservices.protobuf.chat.v1.proto.ListChatMembersRequest
+	32, // 32: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.SendMessage:input_type -> github.com.krus210.balun_microservices.protobuf.chat.v1.proto.SendMessageRequest
+	33, // 33: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.ListMessages:input_type -> github.com.krus210.balun_microservices.protobuf.chat.v1.proto.ListMessagesRequest
+	34, // 34: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.ListNotifications:input_type -> github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.ListNotificationsRequest
+	35, // 35: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.MarkRead:input_type -> github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.MarkReadRequest
+	36, // 36: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.MarkAllRead:input_type -> github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.MarkAllReadRequest
+	37, // 37: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.GetUnreadCount:input_type -> github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.GetUnreadCountRequest
+	38, // 38: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.Register:output_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RegisterResponse
+	39, // 39: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.Login:output_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.LoginResponse
+	40, // 40: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.Refresh:output_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RefreshResponse
+	41, // 41: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.Logout:output_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.LogoutResponse
+	42, // 42: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.GetJWKS:output_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.GetJWKSResponse
+	43, // 43: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.ListSessions:output_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.ListSessionsResponse
+	44, // 44: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.RevokeSession:output_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RevokeSessionResponse
+	45, // 45: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.RevokeAllOtherSessions:output_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.RevokeAllOtherSessionsResponse
+	46, // 46: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.ChangePassword:output_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.ChangePasswordResponse
+	47, // 47: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.ChangeEmail:output_type -> github.com.krus210.balun_microservices.protobuf.auth.v1.proto.ChangeEmailResponse
+	48, // 48: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.CreateProfile:output_type -> github.com.krus210.balun_microservices.protobuf.users.v1.proto.CreateProfileResponse
+	49, // 49: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.UpdateProfile:output_type -> github.com.krus210.balun_microservices.protobuf.users.v1.proto.UpdateProfileResponse
+	50, // 50: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.GetProfileByID:output_type -> github.com.krus210.balun_microservices.protobuf.users.v1.proto.GetProfileByIDResponse
+	51, // 51: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.GetProfileByNickname:output_type -> github.com.krus210.balun_microservices.protobuf.users.v1.proto.GetProfileByNicknameResponse
+	52, // 52: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.SearchByNickname:output_type -> github.com.krus210.balun_microservices.protobuf.users.v1.proto.SearchByNicknameResponse
+	53, // 53: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.SendFriendRequest:output_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.SendFriendRequestResponse
+	54, // 54: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.ListRequests:output_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListRequestsResponse
+	55, // 55: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.AcceptFriendRequest:output_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.AcceptFriendRequestResponse
+	56, // 56: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.DeclineFriendRequest:output_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.DeclineFriendRequestResponse
+	57, // 57: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.CancelFriendRequest:output_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.CancelFriendRequestResponse
+	58, // 58: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.ListOutgoingRequests:output_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListOutgoingRequestsResponse
+	59, // 59: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.RemoveFriend:output_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.RemoveFriendResponse
+	60, // 60: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.ListFriends:output_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListFriendsResponse
+	61, // 61: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.BlockUser:output_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.BlockUserResponse
+	62, // 62: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.UnblockUser:output_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.UnblockUserResponse
+	63, // 63: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.ListBlockedUsers:output_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListBlockedUsersResponse
+	64, // 64: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.ListMutualFriends:output_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListMutualFriendsResponse
+	65, // 65: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.SuggestFriends:output_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.SuggestFriendsResponse
+	66, // 66: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.CreateDirectChat:output_type -> github.com.krus210.balun_microservices.protobuf.chat.v1.proto.CreateDirectChatResponse
+	67, // 67: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.GetChat:output_type -> github.com.krus210.balun_microservices.protobuf.chat.v1.proto.GetChatResponse
+	68, // 68: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.ListUserChats:output_type -> github.com.krus210.balun_microservices.protobuf.chat.v1.proto.ListUserChatsResponse
+	69, // 69: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.ListChatMembers:output_type -> github.com.krus210.balun_microservices.protobuf.chat.v1.proto.ListChatMembersResponse
+	70, // 70: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.SendMessage:output_type -> github.com.krus210.balun_microservices.protobuf.chat.v1.proto.SendMessageResponse
+	71, // 71: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.ListMessages:output_type -> github.com.krus210.balun_microservices.protobuf.chat.v1.proto.ListMessagesResponse
+	72, // 72: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.ListNotifications:output_type -> github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.ListNotificationsResponse
+	73, // 73: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.MarkRead:output_type -> github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.MarkReadResponse
+	74, // 74: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.MarkAllRead:output_type -> github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.MarkAllReadResponse
+	75, // 75: github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService.GetUnreadCount:output_type -> github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.GetUnreadCountResponse
+	38, // [38:76] is the sub-list for method output_type
+	0,  // [0:38] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
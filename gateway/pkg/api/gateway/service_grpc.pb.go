@@ -10,6 +10,7 @@ import (
 	context "context"
 	auth "gateway/pkg/api/auth"
 	chat "gateway/pkg/api/chat"
+	notifications "gateway/pkg/api/notifications"
 	social "gateway/pkg/api/social"
 	users "gateway/pkg/api/users"
 	grpc "google.golang.org/grpc"
@@ -23,28 +24,44 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	GatewayService_Register_FullMethodName             = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/Register"
-	GatewayService_Login_FullMethodName                = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/Login"
-	GatewayService_Refresh_FullMethodName              = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/Refresh"
-	GatewayService_Logout_FullMethodName               = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/Logout"
-	GatewayService_GetJWKS_FullMethodName              = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/GetJWKS"
-	GatewayService_CreateProfile_FullMethodName        = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/CreateProfile"
-	GatewayService_UpdateProfile_FullMethodName        = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/UpdateProfile"
-	GatewayService_GetProfileByID_FullMethodName       = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/GetProfileByID"
-	GatewayService_GetProfileByNickname_FullMethodName = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/GetProfileByNickname"
-	GatewayService_SearchByNickname_FullMethodName     = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/SearchByNickname"
-	GatewayService_SendFriendRequest_FullMethodName    = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/SendFriendRequest"
-	GatewayService_ListRequests_FullMethodName         = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/ListRequests"
-	GatewayService_AcceptFriendRequest_FullMethodName  = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/AcceptFriendRequest"
-	GatewayService_DeclineFriendRequest_FullMethodName = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/DeclineFriendRequest"
-	GatewayService_RemoveFriend_FullMethodName         = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/RemoveFriend"
-	GatewayService_ListFriends_FullMethodName          = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/ListFriends"
-	GatewayService_CreateDirectChat_FullMethodName     = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/CreateDirectChat"
-	GatewayService_GetChat_FullMethodName              = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/GetChat"
-	GatewayService_ListUserChats_FullMethodName        = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/ListUserChats"
-	GatewayService_ListChatMembers_FullMethodName      = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/ListChatMembers"
-	GatewayService_SendMessage_FullMethodName          = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/SendMessage"
-	GatewayService_ListMessages_FullMethodName         = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/ListMessages"
+	GatewayService_Register_FullMethodName               = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/Register"
+	GatewayService_Login_FullMethodName                  = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/Login"
+	GatewayService_Refresh_FullMethodName                = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/Refresh"
+	GatewayService_Logout_FullMethodName                 = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/Logout"
+	GatewayService_GetJWKS_FullMethodName                = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/GetJWKS"
+	GatewayService_ListSessions_FullMethodName           = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/ListSessions"
+	GatewayService_RevokeSession_FullMethodName          = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/RevokeSession"
+	GatewayService_RevokeAllOtherSessions_FullMethodName = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/RevokeAllOtherSessions"
+	GatewayService_ChangePassword_FullMethodName         = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/ChangePassword"
+	GatewayService_ChangeEmail_FullMethodName            = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/ChangeEmail"
+	GatewayService_CreateProfile_FullMethodName          = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/CreateProfile"
+	GatewayService_UpdateProfile_FullMethodName          = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/UpdateProfile"
+	GatewayService_GetProfileByID_FullMethodName         = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/GetProfileByID"
+	GatewayService_GetProfileByNickname_FullMethodName   = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/GetProfileByNickname"
+	GatewayService_SearchByNickname_FullMethodName       = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/SearchByNickname"
+	GatewayService_SendFriendRequest_FullMethodName      = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/SendFriendRequest"
+	GatewayService_ListRequests_FullMethodName           = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/ListRequests"
+	GatewayService_AcceptFriendRequest_FullMethodName    = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/AcceptFriendRequest"
+	GatewayService_DeclineFriendRequest_FullMethodName   = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/DeclineFriendRequest"
+	GatewayService_CancelFriendRequest_FullMethodName    = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/CancelFriendRequest"
+	GatewayService_ListOutgoingRequests_FullMethodName   = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/ListOutgoingRequests"
+	GatewayService_RemoveFriend_FullMethodName           = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/RemoveFriend"
+	GatewayService_ListFriends_FullMethodName            = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/ListFriends"
+	GatewayService_BlockUser_FullMethodName              = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/BlockUser"
+	GatewayService_UnblockUser_FullMethodName            = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/UnblockUser"
+	GatewayService_ListBlockedUsers_FullMethodName       = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/ListBlockedUsers"
+	GatewayService_ListMutualFriends_FullMethodName      = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/ListMutualFriends"
+	GatewayService_SuggestFriends_FullMethodName         = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/SuggestFriends"
+	GatewayService_CreateDirectChat_FullMethodName       = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/CreateDirectChat"
+	GatewayService_GetChat_FullMethodName                = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/GetChat"
+	GatewayService_ListUserChats_FullMethodName          = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/ListUserChats"
+	GatewayService_ListChatMembers_FullMethodName        = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/ListChatMembers"
+	GatewayService_SendMessage_FullMethodName            = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/SendMessage"
+	GatewayService_ListMessages_FullMethodName           = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/ListMessages"
+	GatewayService_ListNotifications_FullMethodName      = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/ListNotifications"
+	GatewayService_MarkRead_FullMethodName               = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/MarkRead"
+	GatewayService_MarkAllRead_FullMethodName            = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/MarkAllRead"
+	GatewayService_GetUnreadCount_FullMethodName         = "/github.com.krus210.balun_microservices.protobuf.gateway.v1.proto.GatewayService/GetUnreadCount"
 )
 
 // GatewayServiceClient is the client API for GatewayService service.
@@ -63,6 +80,16 @@ type GatewayServiceClient interface {
 	Logout(ctx context.Context, in *auth.LogoutRequest, opts ...grpc.CallOption) (*auth.LogoutResponse, error)
 	// GetJWKS - Публичные ключи (JWKS)
 	GetJWKS(ctx context.Context, in *auth.GetJWKSRequest, opts ...grpc.CallOption) (*auth.GetJWKSResponse, error)
+	// ListSessions - Активные сессии пользователя
+	ListSessions(ctx context.Context, in *auth.ListSessionsRequest, opts ...grpc.CallOption) (*auth.ListSessionsResponse, error)
+	// RevokeSession - Завершить сессию устройства
+	RevokeSession(ctx context.Context, in *auth.RevokeSessionRequest, opts ...grpc.CallOption) (*auth.RevokeSessionResponse, error)
+	// RevokeAllOtherSessions - Выйти на всех устройствах, кроме текущего
+	RevokeAllOtherSessions(ctx context.Context, in *auth.RevokeAllOtherSessionsRequest, opts ...grpc.CallOption) (*auth.RevokeAllOtherSessionsResponse, error)
+	// ChangePassword - Сменить пароль, все сессии завершаются, текущее устройство получает новые токены
+	ChangePassword(ctx context.Context, in *auth.ChangePasswordRequest, opts ...grpc.CallOption) (*auth.ChangePasswordResponse, error)
+	// ChangeEmail - Сменить email, email меняется после подтверждения нового адреса
+	ChangeEmail(ctx context.Context, in *auth.ChangeEmailRequest, opts ...grpc.CallOption) (*auth.ChangeEmailResponse, error)
 	// CreateProfile - Создание профиля пользователя
 	CreateProfile(ctx context.Context, in *users.CreateProfileRequest, opts ...grpc.CallOption) (*users.CreateProfileResponse, error)
 	// UpdateProfile - Обновление профиля пользователя
@@ -75,16 +102,31 @@ type GatewayServiceClient interface {
 	SearchByNickname(ctx context.Context, in *users.SearchByNicknameRequest, opts ...grpc.CallOption) (*users.SearchByNicknameResponse, error)
 	// SendFriendRequest - Отправить заявку в друзья
 	SendFriendRequest(ctx context.Context, in *social.SendFriendRequestRequest, opts ...grpc.CallOption) (*social.SendFriendRequestResponse, error)
-	// ListRequests - Список входящих заявок в друзья
+	// ListRequests - Список входящих или исходящих заявок в друзья с фильтром по статусу и пагинацией
 	ListRequests(ctx context.Context, in *social.ListRequestsRequest, opts ...grpc.CallOption) (*social.ListRequestsResponse, error)
 	// AcceptFriendRequest - Принять заявку в друзья
 	AcceptFriendRequest(ctx context.Context, in *social.AcceptFriendRequestRequest, opts ...grpc.CallOption) (*social.AcceptFriendRequestResponse, error)
 	// DeclineFriendRequest - Отклонить заявку в друзья
 	DeclineFriendRequest(ctx context.Context, in *social.DeclineFriendRequestRequest, opts ...grpc.CallOption) (*social.DeclineFriendRequestResponse, error)
+	// CancelFriendRequest - Отозвать отправленную заявку в друзья
+	CancelFriendRequest(ctx context.Context, in *social.CancelFriendRequestRequest, opts ...grpc.CallOption) (*social.CancelFriendRequestResponse, error)
+	// ListOutgoingRequests - Список исходящих заявок в друзья, ожидающих ответа
+	ListOutgoingRequests(ctx context.Context, in *social.ListOutgoingRequestsRequest, opts ...grpc.CallOption) (*social.ListOutgoingRequestsResponse, error)
 	// RemoveFriend - Удалить из друзей
 	RemoveFriend(ctx context.Context, in *social.RemoveFriendRequest, opts ...grpc.CallOption) (*social.RemoveFriendResponse, error)
 	// ListFriends - Список друзей
 	ListFriends(ctx context.Context, in *social.ListFriendsRequest, opts ...grpc.CallOption) (*social.ListFriendsResponse, error)
+	// BlockUser - Заблокировать пользователя
+	BlockUser(ctx context.Context, in *social.BlockUserRequest, opts ...grpc.CallOption) (*social.BlockUserResponse, error)
+	// UnblockUser - Разблокировать пользователя
+	UnblockUser(ctx context.Context, in *social.UnblockUserRequest, opts ...grpc.CallOption) (*social.UnblockUserResponse, error)
+	// ListBlockedUsers - Список заблокированных пользователей
+	ListBlockedUsers(ctx context.Context, in *social.ListBlockedUsersRequest, opts ...grpc.CallOption) (*social.ListBlockedUsersResponse, error)
+	// ListMutualFriends - Общие друзья текущего пользователя и другого пользователя
+	ListMutualFriends(ctx context.Context, in *social.ListMutualFriendsRequest, opts ...grpc.CallOption) (*social.ListMutualFriendsResponse, error)
+	// SuggestFriends - Возможные друзья текущего пользователя: друзья друзей.
+	// У пользователя без друзей список пустой
+	SuggestFriends(ctx context.Context, in *social.SuggestFriendsRequest, opts ...grpc.CallOption) (*social.SuggestFriendsResponse, error)
 	// CreateDirectChat - Создать личный чат
 	CreateDirectChat(ctx context.Context, in *chat.CreateDirectChatRequest, opts ...grpc.CallOption) (*chat.CreateDirectChatResponse, error)
 	// GetChat - Получить информацию о чате
@@ -97,6 +139,14 @@ type GatewayServiceClient interface {
 	SendMessage(ctx context.Context, in *chat.SendMessageRequest, opts ...grpc.CallOption) (*chat.SendMessageResponse, error)
 	// ListMessages - История сообщений
 	ListMessages(ctx context.Context, in *chat.ListMessagesRequest, opts ...grpc.CallOption) (*chat.ListMessagesResponse, error)
+	// ListNotifications - Лента уведомлений
+	ListNotifications(ctx context.Context, in *notifications.ListNotificationsRequest, opts ...grpc.CallOption) (*notifications.ListNotificationsResponse, error)
+	// MarkRead - Отметить уведомления прочитанными
+	MarkRead(ctx context.Context, in *notifications.MarkReadRequest, opts ...grpc.CallOption) (*notifications.MarkReadResponse, error)
+	// MarkAllRead - Отметить все уведомления прочитанными
+	MarkAllRead(ctx context.Context, in *notifications.MarkAllReadRequest, opts ...grpc.CallOption) (*notifications.MarkAllReadResponse, error)
+	// GetUnreadCount - Количество непрочитанных уведомлений
+	GetUnreadCount(ctx context.Context, in *notifications.GetUnreadCountRequest, opts ...grpc.CallOption) (*notifications.GetUnreadCountResponse, error)
 }
 
 type gatewayServiceClient struct {
@@ -157,6 +207,56 @@ func (c *gatewayServiceClient) GetJWKS(ctx context.Context, in *auth.GetJWKSRequ
 	return out, nil
 }
 
+func (c *gatewayServiceClient) ListSessions(ctx context.Context, in *auth.ListSessionsRequest, opts ...grpc.CallOption) (*auth.ListSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(auth.ListSessionsResponse)
+	err := c.cc.Invoke(ctx, GatewayService_ListSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) RevokeSession(ctx context.Context, in *auth.RevokeSessionRequest, opts ...grpc.CallOption) (*auth.RevokeSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(auth.RevokeSessionResponse)
+	err := c.cc.Invoke(ctx, GatewayService_RevokeSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) RevokeAllOtherSessions(ctx context.Context, in *auth.RevokeAllOtherSessionsRequest, opts ...grpc.CallOption) (*auth.RevokeAllOtherSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(auth.RevokeAllOtherSessionsResponse)
+	err := c.cc.Invoke(ctx, GatewayService_RevokeAllOtherSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) ChangePassword(ctx context.Context, in *auth.ChangePasswordRequest, opts ...grpc.CallOption) (*auth.ChangePasswordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(auth.ChangePasswordResponse)
+	err := c.cc.Invoke(ctx, GatewayService_ChangePassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) ChangeEmail(ctx context.Context, in *auth.ChangeEmailRequest, opts ...grpc.CallOption) (*auth.ChangeEmailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(auth.ChangeEmailResponse)
+	err := c.cc.Invoke(ctx, GatewayService_ChangeEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gatewayServiceClient) CreateProfile(ctx context.Context, in *users.CreateProfileRequest, opts ...grpc.CallOption) (*users.CreateProfileResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(users.CreateProfileResponse)
@@ -247,6 +347,26 @@ func (c *gatewayServiceClient) DeclineFriendRequest(ctx context.Context, in *soc
 	return out, nil
 }
 
+func (c *gatewayServiceClient) CancelFriendRequest(ctx context.Context, in *social.CancelFriendRequestRequest, opts ...grpc.CallOption) (*social.CancelFriendRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(social.CancelFriendRequestResponse)
+	err := c.cc.Invoke(ctx, GatewayService_CancelFriendRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) ListOutgoingRequests(ctx context.Context, in *social.ListOutgoingRequestsRequest, opts ...grpc.CallOption) (*social.ListOutgoingRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(social.ListOutgoingRequestsResponse)
+	err := c.cc.Invoke(ctx, GatewayService_ListOutgoingRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gatewayServiceClient) RemoveFriend(ctx context.Context, in *social.RemoveFriendRequest, opts ...grpc.CallOption) (*social.RemoveFriendResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(social.RemoveFriendResponse)
@@ -267,6 +387,56 @@ func (c *gatewayServiceClient) ListFriends(ctx context.Context, in *social.ListF
 	return out, nil
 }
 
+func (c *gatewayServiceClient) BlockUser(ctx context.Context, in *social.BlockUserRequest, opts ...grpc.CallOption) (*social.BlockUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(social.BlockUserResponse)
+	err := c.cc.Invoke(ctx, GatewayService_BlockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) UnblockUser(ctx context.Context, in *social.UnblockUserRequest, opts ...grpc.CallOption) (*social.UnblockUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(social.UnblockUserResponse)
+	err := c.cc.Invoke(ctx, GatewayService_UnblockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) ListBlockedUsers(ctx context.Context, in *social.ListBlockedUsersRequest, opts ...grpc.CallOption) (*social.ListBlockedUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(social.ListBlockedUsersResponse)
+	err := c.cc.Invoke(ctx, GatewayService_ListBlockedUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) ListMutualFriends(ctx context.Context, in *social.ListMutualFriendsRequest, opts ...grpc.CallOption) (*social.ListMutualFriendsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(social.ListMutualFriendsResponse)
+	err := c.cc.Invoke(ctx, GatewayService_ListMutualFriends_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) SuggestFriends(ctx context.Context, in *social.SuggestFriendsRequest, opts ...grpc.CallOption) (*social.SuggestFriendsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(social.SuggestFriendsResponse)
+	err := c.cc.Invoke(ctx, GatewayService_SuggestFriends_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gatewayServiceClient) CreateDirectChat(ctx context.Context, in *chat.CreateDirectChatRequest, opts ...grpc.CallOption) (*chat.CreateDirectChatResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(chat.CreateDirectChatResponse)
@@ -327,6 +497,46 @@ func (c *gatewayServiceClient) ListMessages(ctx context.Context, in *chat.ListMe
 	return out, nil
 }
 
+func (c *gatewayServiceClient) ListNotifications(ctx context.Context, in *notifications.ListNotificationsRequest, opts ...grpc.CallOption) (*notifications.ListNotificationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(notifications.ListNotificationsResponse)
+	err := c.cc.Invoke(ctx, GatewayService_ListNotifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) MarkRead(ctx context.Context, in *notifications.MarkReadRequest, opts ...grpc.CallOption) (*notifications.MarkReadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(notifications.MarkReadResponse)
+	err := c.cc.Invoke(ctx, GatewayService_MarkRead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) MarkAllRead(ctx context.Context, in *notifications.MarkAllReadRequest, opts ...grpc.CallOption) (*notifications.MarkAllReadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(notifications.MarkAllReadResponse)
+	err := c.cc.Invoke(ctx, GatewayService_MarkAllRead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayServiceClient) GetUnreadCount(ctx context.Context, in *notifications.GetUnreadCountRequest, opts ...grpc.CallOption) (*notifications.GetUnreadCountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(notifications.GetUnreadCountResponse)
+	err := c.cc.Invoke(ctx, GatewayService_GetUnreadCount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GatewayServiceServer is the server API for GatewayService service.
 // All implementations must embed UnimplementedGatewayServiceServer
 // for forward compatibility.
@@ -343,6 +553,16 @@ type GatewayServiceServer interface {
 	Logout(context.Context, *auth.LogoutRequest) (*auth.LogoutResponse, error)
 	// GetJWKS - Публичные ключи (JWKS)
 	GetJWKS(context.Context, *auth.GetJWKSRequest) (*auth.GetJWKSResponse, error)
+	// ListSessions - Активные сессии пользователя
+	ListSessions(context.Context, *auth.ListSessionsRequest) (*auth.ListSessionsResponse, error)
+	// RevokeSession - Завершить сессию устройства
+	RevokeSession(context.Context, *auth.RevokeSessionRequest) (*auth.RevokeSessionResponse, error)
+	// RevokeAllOtherSessions - Выйти на всех устройствах, кроме текущего
+	RevokeAllOtherSessions(context.Context, *auth.RevokeAllOtherSessionsRequest) (*auth.RevokeAllOtherSessionsResponse, error)
+	// ChangePassword - Сменить пароль, все сессии завершаются, текущее устройство получает новые токены
+	ChangePassword(context.Context, *auth.ChangePasswordRequest) (*auth.ChangePasswordResponse, error)
+	// ChangeEmail - Сменить email, email меняется после подтверждения нового адреса
+	ChangeEmail(context.Context, *auth.ChangeEmailRequest) (*auth.ChangeEmailResponse, error)
 	// CreateProfile - Создание профиля пользователя
 	CreateProfile(context.Context, *users.CreateProfileRequest) (*users.CreateProfileResponse, error)
 	// UpdateProfile - Обновление профиля пользователя
@@ -355,16 +575,31 @@ type GatewayServiceServer interface {
 	SearchByNickname(context.Context, *users.SearchByNicknameRequest) (*users.SearchByNicknameResponse, error)
 	// SendFriendRequest - Отправить заявку в друзья
 	SendFriendRequest(context.Context, *social.SendFriendRequestRequest) (*social.SendFriendRequestResponse, error)
-	// ListRequests - Список входящих заявок в друзья
+	// ListRequests - Список входящих или исходящих заявок в друзья с фильтром по статусу и пагинацией
 	ListRequests(context.Context, *social.ListRequestsRequest) (*social.ListRequestsResponse, error)
 	// AcceptFriendRequest - Принять заявку в друзья
 	AcceptFriendRequest(context.Context, *social.AcceptFriendRequestRequest) (*social.AcceptFriendRequestResponse, error)
 	// DeclineFriendRequest - Отклонить заявку в друзья
 	DeclineFriendRequest(context.Context, *social.DeclineFriendRequestRequest) (*social.DeclineFriendRequestResponse, error)
+	// CancelFriendRequest - Отозвать отправленную заявку в друзья
+	CancelFriendRequest(context.Context, *social.CancelFriendRequestRequest) (*social.CancelFriendRequestResponse, error)
+	// ListOutgoingRequests - Список исходящих заявок в друзья, ожидающих ответа
+	ListOutgoingRequests(context.Context, *social.ListOutgoingRequestsRequest) (*social.ListOutgoingRequestsResponse, error)
 	// RemoveFriend - Удалить из друзей
 	RemoveFriend(context.Context, *social.RemoveFriendRequest) (*social.RemoveFriendResponse, error)
 	// ListFriends - Список друзей
 	ListFriends(context.Context, *social.ListFriendsRequest) (*social.ListFriendsResponse, error)
+	// BlockUser - Заблокировать пользователя
+	BlockUser(context.Context, *social.BlockUserRequest) (*social.BlockUserResponse, error)
+	// UnblockUser - Разблокировать пользователя
+	UnblockUser(context.Context, *social.UnblockUserRequest) (*social.UnblockUserResponse, error)
+	// ListBlockedUsers - Список заблокированных пользователей
+	ListBlockedUsers(context.Context, *social.ListBlockedUsersRequest) (*social.ListBlockedUsersResponse, error)
+	// ListMutualFriends - Общие друзья текущего пользователя и другого пользователя
+	ListMutualFriends(context.Context, *social.ListMutualFriendsRequest) (*social.ListMutualFriendsResponse, error)
+	// SuggestFriends - Возможные друзья текущего пользователя: друзья друзей.
+	// У пользователя без друзей список пустой
+	SuggestFriends(context.Context, *social.SuggestFriendsRequest) (*social.SuggestFriendsResponse, error)
 	// CreateDirectChat - Создать личный чат
 	CreateDirectChat(context.Context, *chat.CreateDirectChatRequest) (*chat.CreateDirectChatResponse, error)
 	// GetChat - Получить информацию о чате
@@ -377,6 +612,14 @@ type GatewayServiceServer interface {
 	SendMessage(context.Context, *chat.SendMessageRequest) (*chat.SendMessageResponse, error)
 	// ListMessages - История сообщений
 	ListMessages(context.Context, *chat.ListMessagesRequest) (*chat.ListMessagesResponse, error)
+	// ListNotifications - Лента уведомлений
+	ListNotifications(context.Context, *notifications.ListNotificationsRequest) (*notifications.ListNotificationsResponse, error)
+	// MarkRead - Отметить уведомления прочитанными
+	MarkRead(context.Context, *notifications.MarkReadRequest) (*notifications.MarkReadResponse, error)
+	// MarkAllRead - Отметить все уведомления прочитанными
+	MarkAllRead(context.Context, *notifications.MarkAllReadRequest) (*notifications.MarkAllReadResponse, error)
+	// GetUnreadCount - Количество непрочитанных уведомлений
+	GetUnreadCount(context.Context, *notifications.GetUnreadCountRequest) (*notifications.GetUnreadCountResponse, error)
 	mustEmbedUnimplementedGatewayServiceServer()
 }
 
@@ -402,6 +645,21 @@ func (UnimplementedGatewayServiceServer) Logout(context.Context, *auth.LogoutReq
 func (UnimplementedGatewayServiceServer) GetJWKS(context.Context, *auth.GetJWKSRequest) (*auth.GetJWKSResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetJWKS not implemented")
 }
+func (UnimplementedGatewayServiceServer) ListSessions(context.Context, *auth.ListSessionsRequest) (*auth.ListSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedGatewayServiceServer) RevokeSession(context.Context, *auth.RevokeSessionRequest) (*auth.RevokeSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (UnimplementedGatewayServiceServer) RevokeAllOtherSessions(context.Context, *auth.RevokeAllOtherSessionsRequest) (*auth.RevokeAllOtherSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAllOtherSessions not implemented")
+}
+func (UnimplementedGatewayServiceServer) ChangePassword(context.Context, *auth.ChangePasswordRequest) (*auth.ChangePasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+}
+func (UnimplementedGatewayServiceServer) ChangeEmail(context.Context, *auth.ChangeEmailRequest) (*auth.ChangeEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeEmail not implemented")
+}
 func (UnimplementedGatewayServiceServer) CreateProfile(context.Context, *users.CreateProfileRequest) (*users.CreateProfileResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateProfile not implemented")
 }
@@ -429,12 +687,33 @@ func (UnimplementedGatewayServiceServer) AcceptFriendRequest(context.Context, *s
 func (UnimplementedGatewayServiceServer) DeclineFriendRequest(context.Context, *social.DeclineFriendRequestRequest) (*social.DeclineFriendRequestResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeclineFriendRequest not implemented")
 }
+func (UnimplementedGatewayServiceServer) CancelFriendRequest(context.Context, *social.CancelFriendRequestRequest) (*social.CancelFriendRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelFriendRequest not implemented")
+}
+func (UnimplementedGatewayServiceServer) ListOutgoingRequests(context.Context, *social.ListOutgoingRequestsRequest) (*social.ListOutgoingRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOutgoingRequests not implemented")
+}
 func (UnimplementedGatewayServiceServer) RemoveFriend(context.Context, *social.RemoveFriendRequest) (*social.RemoveFriendResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveFriend not implemented")
 }
 func (UnimplementedGatewayServiceServer) ListFriends(context.Context, *social.ListFriendsRequest) (*social.ListFriendsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListFriends not implemented")
 }
+func (UnimplementedGatewayServiceServer) BlockUser(context.Context, *social.BlockUserRequest) (*social.BlockUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockUser not implemented")
+}
+func (UnimplementedGatewayServiceServer) UnblockUser(context.Context, *social.UnblockUserRequest) (*social.UnblockUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockUser not implemented")
+}
+func (UnimplementedGatewayServiceServer) ListBlockedUsers(context.Context, *social.ListBlockedUsersRequest) (*social.ListBlockedUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBlockedUsers not implemented")
+}
+func (UnimplementedGatewayServiceServer) ListMutualFriends(context.Context, *social.ListMutualFriendsRequest) (*social.ListMutualFriendsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMutualFriends not implemented")
+}
+func (UnimplementedGatewayServiceServer) SuggestFriends(context.Context, *social.SuggestFriendsRequest) (*social.SuggestFriendsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestFriends not implemented")
+}
 func (UnimplementedGatewayServiceServer) CreateDirectChat(context.Context, *chat.CreateDirectChatRequest) (*chat.CreateDirectChatResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateDirectChat not implemented")
 }
@@ -453,6 +732,18 @@ func (UnimplementedGatewayServiceServer) SendMessage(context.Context, *chat.Send
 func (UnimplementedGatewayServiceServer) ListMessages(context.Context, *chat.ListMessagesRequest) (*chat.ListMessagesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListMessages not implemented")
 }
+func (UnimplementedGatewayServiceServer) ListNotifications(context.Context, *notifications.ListNotificationsRequest) (*notifications.ListNotificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNotifications not implemented")
+}
+func (UnimplementedGatewayServiceServer) MarkRead(context.Context, *notifications.MarkReadRequest) (*notifications.MarkReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkRead not implemented")
+}
+func (UnimplementedGatewayServiceServer) MarkAllRead(context.Context, *notifications.MarkAllReadRequest) (*notifications.MarkAllReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkAllRead not implemented")
+}
+func (UnimplementedGatewayServiceServer) GetUnreadCount(context.Context, *notifications.GetUnreadCountRequest) (*notifications.GetUnreadCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUnreadCount not implemented")
+}
 func (UnimplementedGatewayServiceServer) mustEmbedUnimplementedGatewayServiceServer() {}
 func (UnimplementedGatewayServiceServer) testEmbeddedByValue()                        {}
 
@@ -564,6 +855,96 @@ func _GatewayService_GetJWKS_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GatewayService_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(auth.ListSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_ListSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).ListSessions(ctx, req.(*auth.ListSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(auth.RevokeSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_RevokeSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).RevokeSession(ctx, req.(*auth.RevokeSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_RevokeAllOtherSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(auth.RevokeAllOtherSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).RevokeAllOtherSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_RevokeAllOtherSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).RevokeAllOtherSessions(ctx, req.(*auth.RevokeAllOtherSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(auth.ChangePasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_ChangePassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).ChangePassword(ctx, req.(*auth.ChangePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_ChangeEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(auth.ChangeEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).ChangeEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_ChangeEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).ChangeEmail(ctx, req.(*auth.ChangeEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GatewayService_CreateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(users.CreateProfileRequest)
 	if err := dec(in); err != nil {
@@ -726,6 +1107,42 @@ func _GatewayService_DeclineFriendRequest_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GatewayService_CancelFriendRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(social.CancelFriendRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).CancelFriendRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_CancelFriendRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).CancelFriendRequest(ctx, req.(*social.CancelFriendRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_ListOutgoingRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(social.ListOutgoingRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).ListOutgoingRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_ListOutgoingRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).ListOutgoingRequests(ctx, req.(*social.ListOutgoingRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GatewayService_RemoveFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(social.RemoveFriendRequest)
 	if err := dec(in); err != nil {
@@ -762,6 +1179,96 @@ func _GatewayService_ListFriends_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GatewayService_BlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(social.BlockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).BlockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_BlockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).BlockUser(ctx, req.(*social.BlockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_UnblockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(social.UnblockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).UnblockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_UnblockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).UnblockUser(ctx, req.(*social.UnblockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_ListBlockedUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(social.ListBlockedUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).ListBlockedUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_ListBlockedUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).ListBlockedUsers(ctx, req.(*social.ListBlockedUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_ListMutualFriends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(social.ListMutualFriendsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).ListMutualFriends(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_ListMutualFriends_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).ListMutualFriends(ctx, req.(*social.ListMutualFriendsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_SuggestFriends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(social.SuggestFriendsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).SuggestFriends(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_SuggestFriends_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).SuggestFriends(ctx, req.(*social.SuggestFriendsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GatewayService_CreateDirectChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(chat.CreateDirectChatRequest)
 	if err := dec(in); err != nil {
@@ -870,6 +1377,78 @@ func _GatewayService_ListMessages_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GatewayService_ListNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(notifications.ListNotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).ListNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_ListNotifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).ListNotifications(ctx, req.(*notifications.ListNotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_MarkRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(notifications.MarkReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).MarkRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_MarkRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).MarkRead(ctx, req.(*notifications.MarkReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_MarkAllRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(notifications.MarkAllReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).MarkAllRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_MarkAllRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).MarkAllRead(ctx, req.(*notifications.MarkAllReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayService_GetUnreadCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(notifications.GetUnreadCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServiceServer).GetUnreadCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayService_GetUnreadCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServiceServer).GetUnreadCount(ctx, req.(*notifications.GetUnreadCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // GatewayService_ServiceDesc is the grpc.ServiceDesc for GatewayService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -897,6 +1476,26 @@ var GatewayService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetJWKS",
 			Handler:    _GatewayService_GetJWKS_Handler,
 		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _GatewayService_ListSessions_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _GatewayService_RevokeSession_Handler,
+		},
+		{
+			MethodName: "RevokeAllOtherSessions",
+			Handler:    _GatewayService_RevokeAllOtherSessions_Handler,
+		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _GatewayService_ChangePassword_Handler,
+		},
+		{
+			MethodName: "ChangeEmail",
+			Handler:    _GatewayService_ChangeEmail_Handler,
+		},
 		{
 			MethodName: "CreateProfile",
 			Handler:    _GatewayService_CreateProfile_Handler,
@@ -933,6 +1532,14 @@ var GatewayService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeclineFriendRequest",
 			Handler:    _GatewayService_DeclineFriendRequest_Handler,
 		},
+		{
+			MethodName: "CancelFriendRequest",
+			Handler:    _GatewayService_CancelFriendRequest_Handler,
+		},
+		{
+			MethodName: "ListOutgoingRequests",
+			Handler:    _GatewayService_ListOutgoingRequests_Handler,
+		},
 		{
 			MethodName: "RemoveFriend",
 			Handler:    _GatewayService_RemoveFriend_Handler,
@@ -941,6 +1548,26 @@ var GatewayService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListFriends",
 			Handler:    _GatewayService_ListFriends_Handler,
 		},
+		{
+			MethodName: "BlockUser",
+			Handler:    _GatewayService_BlockUser_Handler,
+		},
+		{
+			MethodName: "UnblockUser",
+			Handler:    _GatewayService_UnblockUser_Handler,
+		},
+		{
+			MethodName: "ListBlockedUsers",
+			Handler:    _GatewayService_ListBlockedUsers_Handler,
+		},
+		{
+			MethodName: "ListMutualFriends",
+			Handler:    _GatewayService_ListMutualFriends_Handler,
+		},
+		{
+			MethodName: "SuggestFriends",
+			Handler:    _GatewayService_SuggestFriends_Handler,
+		},
 		{
 			MethodName: "CreateDirectChat",
 			Handler:    _GatewayService_CreateDirectChat_Handler,
@@ -965,6 +1592,22 @@ var GatewayService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListMessages",
 			Handler:    _GatewayService_ListMessages_Handler,
 		},
+		{
+			MethodName: "ListNotifications",
+			Handler:    _GatewayService_ListNotifications_Handler,
+		},
+		{
+			MethodName: "MarkRead",
+			Handler:    _GatewayService_MarkRead_Handler,
+		},
+		{
+			MethodName: "MarkAllRead",
+			Handler:    _GatewayService_MarkAllRead_Handler,
+		},
+		{
+			MethodName: "GetUnreadCount",
+			Handler:    _GatewayService_GetUnreadCount_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/gateway/service.proto",
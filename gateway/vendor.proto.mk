@@ -2,7 +2,7 @@
 VENDOR_PROTO_PATH := $(CURDIR)/vendor.protobuf
 
 # vendor
-vendor:	.vendor-reset .vendor-googleapis .vendor-google-protobuf .vendor-protovalidate .vendor-protoc-gen-openapiv2 .vendor-auth-service .vendor-users-service .vendor-social-service .vendor-chat-service .vendor-tidy
+vendor:	.vendor-reset .vendor-googleapis .vendor-google-protobuf .vendor-protovalidate .vendor-protoc-gen-openapiv2 .vendor-auth-service .vendor-users-service .vendor-social-service .vendor-chat-service .vendor-notifications-service .vendor-tidy
 
 # delete VENDOR_PROTO_PATH
 .vendor-reset:
@@ -75,6 +75,12 @@ vendor:	.vendor-reset .vendor-googleapis .vendor-google-protobuf .vendor-protova
 	cp $(CURDIR)/../chat/api/service.proto $(VENDOR_PROTO_PATH)/api/chat/chat.proto
 	sed -i '' 's|option go_package = ".*";|option go_package = "gateway/pkg/api/chat;chat";|' $(VENDOR_PROTO_PATH)/api/chat/chat.proto
 
+# Устанавливаем proto описания notifications service
+.vendor-notifications-service:
+	mkdir -p $(VENDOR_PROTO_PATH)/api/notifications
+	cp $(CURDIR)/../notifications/api/service.proto $(VENDOR_PROTO_PATH)/api/notifications/notifications.proto
+	sed -i '' 's|option go_package = ".*";|option go_package = "gateway/pkg/api/notifications;notifications";|' $(VENDOR_PROTO_PATH)/api/notifications/notifications.proto
+
 # delete all non .proto files
 .vendor-tidy:
 	find $(VENDOR_PROTO_PATH) -type f ! -name "*.proto" -delete
@@ -94,5 +100,6 @@ vendor:	.vendor-reset .vendor-googleapis .vendor-google-protobuf .vendor-protova
 	.vendor-users-service \
 	.vendor-social-service \
 	.vendor-chat-service \
+	.vendor-notifications-service \
 	.vendor-tidy \
 	vendor
\ No newline at end of file
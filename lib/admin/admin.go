@@ -71,17 +71,40 @@ func NewServer(cfg Config) *http.Server {
 
 	addr := fmt.Sprintf("%s:%d", cfg.Host, cfg.Port)
 
+	// HTTP/2 без TLS нужен для gRPC сервисов, зарегистрированных через Handle (grpc.Server.ServeHTTP)
+	protocols := new(http.Protocols)
+	protocols.SetHTTP1(true)
+	protocols.SetUnencryptedHTTP2(true)
+
 	server := &http.Server{
 		Addr:         addr,
 		Handler:      mux,
 		ReadTimeout:  10 * time.Second,
 		WriteTimeout: 10 * time.Second,
 		IdleTimeout:  60 * time.Second,
+		Protocols:    protocols,
 	}
 
 	return server
 }
 
+// Handle регистрирует дополнительный handler на admin сервере, например отладочный эндпоинт сервиса
+//
+// Вызывается до Serve.
+func Handle(server *http.Server, pattern string, handler http.Handler) error {
+	if server == nil {
+		return fmt.Errorf("admin server is not initialized")
+	}
+
+	mux, ok := server.Handler.(*http.ServeMux)
+	if !ok {
+		return fmt.Errorf("admin server handler %T is not *http.ServeMux", server.Handler)
+	}
+
+	mux.Handle(pattern, handler)
+	return nil
+}
+
 // Serve запускает admin HTTP сервер с graceful shutdown
 func Serve(ctx context.Context, server *http.Server) error {
 	if server == nil {
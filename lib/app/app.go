@@ -84,6 +84,16 @@ func (a *App) InitGRPCServer(cfg config.ServerConfig, customInterceptors ...grpc
 	log.Println("gRPC server initialized")
 }
 
+// InitGRPCServerWithStreams инициализирует gRPC сервер с unary и stream interceptors
+func (a *App) InitGRPCServerWithStreams(
+	cfg config.ServerConfig,
+	customInterceptors []grpc.UnaryServerInterceptor,
+	customStreamInterceptors []grpc.StreamServerInterceptor,
+) {
+	a.grpcServer = InitGRPCServerWithStreams(cfg, customInterceptors, customStreamInterceptors)
+	log.Println("gRPC server initialized")
+}
+
 // RegisterGRPC регистрирует gRPC сервисы
 func (a *App) RegisterGRPC(registrar GRPCRegistrar) {
 	if a.grpcServer == nil {
@@ -36,13 +36,35 @@ func InitAuthComponents(
 	// Создаем wrapper для вызова GetJWKS через gRPC
 	authWrapper := authmw.NewGRPCClientWrapper(authConn)
 
-	// Создаем JWKS кеш с автообновлением каждые 5 минут
+	// Список отзывов отдается только admin сервером auth
+	var revocationsClient authmw.RevocationsClient
+	adminConnCleanup := func() {}
+	if authServiceCfg.AdminPort != 0 {
+		adminCfg := authServiceCfg.Admin()
+
+		var adminConn *grpc.ClientConn
+		adminConn, adminConnCleanup, err = InitGRPCClient(ctx, adminCfg)
+		if err != nil {
+			connCleanup()
+			return nil, nil, fmt.Errorf("failed to connect to auth admin server: %w", err)
+		}
+		revocationsClient = authmw.NewGRPCRevocationsClient(adminConn)
+		logger.InfoKV(ctx, "connected to auth admin server", "address", adminCfg.Address())
+	} else {
+		logger.WarnKV(ctx, "auth admin port is not configured, access token revocations are not checked")
+	}
+
+	// Создаем JWKS кеш с автообновлением каждые 5 минут,
+	// список отозванных access токенов обновляется каждые 15 секунд
 	jwksCache, err := authmw.NewJWKSCacheGRPC(authmw.JWKSCacheGRPCConfig{
-		Client:        authWrapper,
-		RefreshPeriod: 5 * time.Minute,
-		GRPCTimeout:   10 * time.Second,
+		Client:                   authWrapper,
+		RevocationsClient:        revocationsClient,
+		RefreshPeriod:            5 * time.Minute,
+		GRPCTimeout:              10 * time.Second,
+		RevocationsRefreshPeriod: 15 * time.Second,
 	})
 	if err != nil {
+		adminConnCleanup()
 		connCleanup()
 		return nil, nil, fmt.Errorf("failed to create JWKS cache: %w", err)
 	}
@@ -53,6 +75,7 @@ func InitAuthComponents(
 		JWKSCache:        jwksCache,
 		ExpectedIssuer:   "balun-auth-service",
 		ExpectedAudience: audience,
+		Revocations:      jwksCache,
 	})
 	logger.InfoKV(ctx, "JWT validator initialized", "audience", audience)
 
@@ -65,6 +88,7 @@ func InitAuthComponents(
 	// Cleanup функция для graceful shutdown
 	cleanup := func() {
 		jwksCache.Stop()
+		adminConnCleanup()
 		connCleanup()
 	}
 
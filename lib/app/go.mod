@@ -5,6 +5,7 @@ go 1.25.1
 require (
 	github.com/spf13/viper v1.21.0
 	github.com/sskorolev/balun_microservices/lib/admin v0.0.0
+	github.com/sskorolev/balun_microservices/lib/authmw v0.0.0
 	github.com/sskorolev/balun_microservices/lib/config v0.0.0
 	github.com/sskorolev/balun_microservices/lib/grpc v0.0.0
 	github.com/sskorolev/balun_microservices/lib/logger v0.0.0
@@ -29,6 +30,7 @@ require (
 	github.com/go-logr/logr v1.4.3 // indirect
 	github.com/go-logr/stdr v1.2.2 // indirect
 	github.com/go-viper/mapstructure/v2 v2.4.0 // indirect
+	github.com/golang-jwt/jwt/v5 v5.3.0 // indirect
 	github.com/google/uuid v1.6.0 // indirect
 	github.com/grpc-ecosystem/go-grpc-middleware/providers/prometheus v1.1.0 // indirect
 	github.com/grpc-ecosystem/go-grpc-middleware/v2 v2.3.2 // indirect
@@ -95,6 +97,7 @@ require (
 
 replace (
 	github.com/sskorolev/balun_microservices/lib/admin => ../admin
+	github.com/sskorolev/balun_microservices/lib/authmw => ../authmw
 	github.com/sskorolev/balun_microservices/lib/config => ../config
 	github.com/sskorolev/balun_microservices/lib/grpc => ../grpc
 	github.com/sskorolev/balun_microservices/lib/logger => ../logger
@@ -30,6 +30,20 @@ type GRPCRegistrar func(*grpc.Server)
 //
 // OpenTelemetry tracing настраивается через stats handler (не через interceptor)
 func InitGRPCServer(cfg config.ServerConfig, customInterceptors ...grpc.UnaryServerInterceptor) *grpc.Server {
+	return InitGRPCServerWithStreams(cfg, customInterceptors, nil)
+}
+
+// InitGRPCServerWithStreams создает gRPC сервер как InitGRPCServer, дополнительно
+// регистрируя цепочку stream interceptors для серверных стримов
+//
+// Порядок stream interceptors:
+// 1. Panic recovery - перехват паник
+// 2. Custom stream interceptors - пользовательские интерсепторы (например, JWT и errors middleware)
+func InitGRPCServerWithStreams(
+	cfg config.ServerConfig,
+	customInterceptors []grpc.UnaryServerInterceptor,
+	customStreamInterceptors []grpc.StreamServerInterceptor,
+) *grpc.Server {
 	var interceptorChain []grpc.UnaryServerInterceptor
 
 	// 1. Panic recovery (всегда первый для перехвата любых паник)
@@ -56,10 +70,16 @@ func InitGRPCServer(cfg config.ServerConfig, customInterceptors ...grpc.UnarySer
 	// 4. Custom interceptors (например, ErrorsUnaryInterceptor)
 	interceptorChain = append(interceptorChain, customInterceptors...)
 
+	streamInterceptorChain := []grpc.StreamServerInterceptor{
+		interceptors.PanicRecoveryStreamInterceptor(),
+	}
+	streamInterceptorChain = append(streamInterceptorChain, customStreamInterceptors...)
+
 	opts := []grpc.ServerOption{
 		// OpenTelemetry tracing через stats handler (современный подход, заменяет deprecated interceptors)
 		grpc.StatsHandler(otelgrpc.NewServerHandler()),
 		grpc.ChainUnaryInterceptor(interceptorChain...),
+		grpc.ChainStreamInterceptor(streamInterceptorChain...),
 	}
 
 	server := grpc.NewServer(opts...)
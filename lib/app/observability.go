@@ -3,6 +3,7 @@ package app
 import (
 	"context"
 	"fmt"
+	"net/http"
 
 	"github.com/sskorolev/balun_microservices/lib/admin"
 	"github.com/sskorolev/balun_microservices/lib/config"
@@ -120,6 +121,15 @@ func (a *App) InitAdminServer(adminCfg config.AdminConfig) error {
 	return nil
 }
 
+// RegisterAdminHandler регистрирует дополнительный handler на admin HTTP сервере
+func (a *App) RegisterAdminHandler(pattern string, handler http.Handler) error {
+	if err := admin.Handle(a.adminServer, pattern, handler); err != nil {
+		return fmt.Errorf("failed to register admin handler %s: %w", pattern, err)
+	}
+
+	return nil
+}
+
 // ServeAdmin запускает admin HTTP сервер
 func (a *App) ServeAdmin(ctx context.Context) error {
 	if a.adminServer == nil {
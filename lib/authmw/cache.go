@@ -8,9 +8,12 @@ import (
 	"net/http"
 	"sync"
 	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
-// JWKSCache кеширует JWKS от auth сервиса с автообновлением
+// JWKSCache кеширует JWKS от auth сервиса с автообновлением.
+// Если задан RevocationsURL, кеш также держит список отозванных токенов
 type JWKSCache struct {
 	mu            sync.RWMutex
 	jwks          *JWKS
@@ -18,6 +21,10 @@ type JWKSCache struct {
 	refreshPeriod time.Duration
 	httpClient    *http.Client
 	stopCh        chan struct{}
+
+	revocationsURL           string
+	revocations              *RevocationList
+	revocationsRefreshPeriod time.Duration
 }
 
 // JWKSCacheConfig конфигурация для JWKSCache
@@ -25,6 +32,9 @@ type JWKSCacheConfig struct {
 	JWKSURL       string        // URL JWKS endpoint (например, "http://auth:8082/jwks")
 	RefreshPeriod time.Duration // Период обновления (по умолчанию 5 минут)
 	HTTPTimeout   time.Duration // Таймаут HTTP запросов (по умолчанию 10 секунд)
+	// RevocationsURL - URL списка отзывов, JSON в формате GetRevocationsResponse (необязательно)
+	RevocationsURL           string
+	RevocationsRefreshPeriod time.Duration // Период обновления списка отзывов (по умолчанию 15 секунд)
 }
 
 // NewJWKSCache создает новый JWKS кеш с автообновлением
@@ -35,6 +45,9 @@ func NewJWKSCache(cfg JWKSCacheConfig) (*JWKSCache, error) {
 	if cfg.HTTPTimeout == 0 {
 		cfg.HTTPTimeout = 10 * time.Second
 	}
+	if cfg.RevocationsRefreshPeriod == 0 {
+		cfg.RevocationsRefreshPeriod = 15 * time.Second
+	}
 
 	cache := &JWKSCache{
 		jwksURL:       cfg.JWKSURL,
@@ -42,7 +55,10 @@ func NewJWKSCache(cfg JWKSCacheConfig) (*JWKSCache, error) {
 		httpClient: &http.Client{
 			Timeout: cfg.HTTPTimeout,
 		},
-		stopCh: make(chan struct{}),
+		stopCh:                   make(chan struct{}),
+		revocationsURL:           cfg.RevocationsURL,
+		revocations:              NewRevocationList(),
+		revocationsRefreshPeriod: cfg.RevocationsRefreshPeriod,
 	}
 
 	// Первоначальная загрузка JWKS
@@ -53,9 +69,20 @@ func NewJWKSCache(cfg JWKSCacheConfig) (*JWKSCache, error) {
 	// Запускаем фоновое обновление
 	go cache.startRefreshLoop()
 
+	if cache.revocationsURL != "" {
+		// Ошибка первой загрузки отзывов не фатальна: список догрузится в фоне
+		_ = cache.refreshRevocations(context.Background())
+		go cache.startRevocationsRefreshLoop()
+	}
+
 	return cache, nil
 }
 
+// IsRevoked проверяет токен по локальному списку отзывов
+func (c *JWKSCache) IsRevoked(claims *Claims) bool {
+	return c.revocations.IsRevoked(claims)
+}
+
 // GetJWKS возвращает актуальный JWKS
 func (c *JWKSCache) GetJWKS() *JWKS {
 	c.mu.RLock()
@@ -112,6 +139,55 @@ func (c *JWKSCache) refresh(ctx context.Context) error {
 	return nil
 }
 
+// refreshRevocations загружает актуальный список отзывов по HTTP
+func (c *JWKSCache) refreshRevocations(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.revocationsURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to fetch revocations: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("revocations endpoint returned status %d: %s", resp.StatusCode, string(body))
+	}
+
+	revocations := &GetRevocationsResponse{}
+	if err := protojson.Unmarshal(body, revocations); err != nil {
+		return fmt.Errorf("failed to unmarshal revocations: %w", err)
+	}
+
+	c.revocations.Replace(revocations)
+	return nil
+}
+
+// startRevocationsRefreshLoop запускает фоновый цикл обновления списка отзывов
+func (c *JWKSCache) startRevocationsRefreshLoop() {
+	ticker := time.NewTicker(c.revocationsRefreshPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(context.Background(), c.httpClient.Timeout)
+			// При ошибке остается предыдущий список
+			_ = c.refreshRevocations(ctx)
+			cancel()
+		case <-c.stopCh:
+			return
+		}
+	}
+}
+
 // startRefreshLoop запускает фоновый цикл обновления JWKS
 func (c *JWKSCache) startRefreshLoop() {
 	ticker := time.NewTicker(c.refreshPeriod)
@@ -13,7 +13,8 @@ type AuthServiceClient interface {
 	GetJWKS(ctx context.Context) (*GetJWKSResponse, error)
 }
 
-// JWKSCacheGRPC кеширует JWKS от auth сервиса через gRPC с автообновлением
+// JWKSCacheGRPC кеширует JWKS от auth сервиса через gRPC с автообновлением.
+// Если задан RevocationsClient, кеш также держит список отозванных токенов
 type JWKSCacheGRPC struct {
 	mu            sync.RWMutex
 	jwks          *JWKS
@@ -21,13 +22,19 @@ type JWKSCacheGRPC struct {
 	refreshPeriod time.Duration
 	grpcTimeout   time.Duration
 	stopCh        chan struct{}
+
+	revocationsClient        RevocationsClient
+	revocations              *RevocationList
+	revocationsRefreshPeriod time.Duration
 }
 
 // JWKSCacheGRPCConfig конфигурация для JWKSCacheGRPC
 type JWKSCacheGRPCConfig struct {
-	Client        AuthServiceClient // Auth service клиент
-	RefreshPeriod time.Duration     // Период обновления (по умолчанию 5 минут)
-	GRPCTimeout   time.Duration     // Таймаут gRPC запросов (по умолчанию 10 секунд)
+	Client                   AuthServiceClient // Auth service клиент
+	RevocationsClient        RevocationsClient // Клиент списка отзывов, nil - отзыв не проверяется
+	RefreshPeriod            time.Duration     // Период обновления (по умолчанию 5 минут)
+	GRPCTimeout              time.Duration     // Таймаут gRPC запросов (по умолчанию 10 секунд)
+	RevocationsRefreshPeriod time.Duration     // Период обновления списка отзывов (по умолчанию 15 секунд)
 }
 
 // NewJWKSCacheGRPC создает новый JWKS кеш с gRPC и автообновлением
@@ -38,15 +45,21 @@ func NewJWKSCacheGRPC(cfg JWKSCacheGRPCConfig) (*JWKSCacheGRPC, error) {
 	if cfg.GRPCTimeout == 0 {
 		cfg.GRPCTimeout = 10 * time.Second
 	}
+	if cfg.RevocationsRefreshPeriod == 0 {
+		cfg.RevocationsRefreshPeriod = 15 * time.Second
+	}
 	if cfg.Client == nil {
 		return nil, fmt.Errorf("auth service client is required")
 	}
 
 	cache := &JWKSCacheGRPC{
-		client:        cfg.Client,
-		refreshPeriod: cfg.RefreshPeriod,
-		grpcTimeout:   cfg.GRPCTimeout,
-		stopCh:        make(chan struct{}),
+		client:                   cfg.Client,
+		revocationsClient:        cfg.RevocationsClient,
+		refreshPeriod:            cfg.RefreshPeriod,
+		grpcTimeout:              cfg.GRPCTimeout,
+		stopCh:                   make(chan struct{}),
+		revocations:              NewRevocationList(),
+		revocationsRefreshPeriod: cfg.RevocationsRefreshPeriod,
 	}
 
 	// Первоначальная загрузка JWKS
@@ -57,9 +70,20 @@ func NewJWKSCacheGRPC(cfg JWKSCacheGRPCConfig) (*JWKSCacheGRPC, error) {
 	// Запускаем фоновое обновление
 	go cache.startRefreshLoop()
 
+	if cache.revocationsClient != nil {
+		// Ошибка первой загрузки отзывов не фатальна: список догрузится в фоне
+		_ = cache.refreshRevocations(context.Background())
+		go cache.startRevocationsRefreshLoop()
+	}
+
 	return cache, nil
 }
 
+// IsRevoked проверяет токен по локальному списку отзывов
+func (c *JWKSCacheGRPC) IsRevoked(claims *Claims) bool {
+	return c.revocations.IsRevoked(claims)
+}
+
 // GetJWKS возвращает актуальный JWKS
 func (c *JWKSCacheGRPC) GetJWKS() *JWKS {
 	c.mu.RLock()
@@ -109,6 +133,9 @@ func (c *JWKSCacheGRPC) refresh(ctx context.Context) error {
 			Alg: protoJWK.Alg,
 			N:   protoJWK.N,
 			E:   protoJWK.E,
+			X:   protoJWK.X,
+			Y:   protoJWK.Y,
+			Crv: protoJWK.Crv,
 		})
 	}
 
@@ -119,6 +146,36 @@ func (c *JWKSCacheGRPC) refresh(ctx context.Context) error {
 	return nil
 }
 
+// refreshRevocations загружает актуальный список отзывов из auth сервиса
+func (c *JWKSCacheGRPC) refreshRevocations(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, c.grpcTimeout)
+	defer cancel()
+
+	resp, err := c.revocationsClient.GetRevocations(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to invoke GetRevocations: %w", err)
+	}
+
+	c.revocations.Replace(resp)
+	return nil
+}
+
+// startRevocationsRefreshLoop запускает фоновый цикл обновления списка отзывов
+func (c *JWKSCacheGRPC) startRevocationsRefreshLoop() {
+	ticker := time.NewTicker(c.revocationsRefreshPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			// При ошибке остается предыдущий список
+			_ = c.refreshRevocations(context.Background())
+		case <-c.stopCh:
+			return
+		}
+	}
+}
+
 // startRefreshLoop запускает фоновый цикл обновления JWKS
 func (c *JWKSCacheGRPC) startRefreshLoop() {
 	ticker := time.NewTicker(c.refreshPeriod)
@@ -46,3 +46,31 @@ func (w *GRPCClientWrapper) GetJWKS(ctx context.Context) (*GetJWKSResponse, erro
 
 // Убеждаемся что GRPCClientWrapper реализует AuthServiceClient
 var _ AuthServiceClient = (*GRPCClientWrapper)(nil)
+
+// GRPCRevocationsClient вызывает AuthInternalService.GetRevocations.
+// Метод доступен только на admin сервере auth, поэтому соединение отдельное от GetJWKS
+type GRPCRevocationsClient struct {
+	conn   *grpc.ClientConn
+	method string
+}
+
+// NewGRPCRevocationsClient создаёт клиент списка отзывов поверх соединения с admin сервером auth
+func NewGRPCRevocationsClient(conn *grpc.ClientConn) *GRPCRevocationsClient {
+	return &GRPCRevocationsClient{
+		conn:   conn,
+		method: "/github.com.krus210.balun_microservices.protobuf.auth.v1.proto.AuthInternalService/GetRevocations",
+	}
+}
+
+// GetRevocations вызывает метод GetRevocations через generic grpc.Invoke
+func (c *GRPCRevocationsClient) GetRevocations(ctx context.Context) (*GetRevocationsResponse, error) {
+	resp := &GetRevocationsResponse{}
+	if err := c.conn.Invoke(ctx, c.method, &GetRevocationsRequest{}, resp); err != nil {
+		return nil, fmt.Errorf("failed to invoke GetRevocations: %w", err)
+	}
+
+	return resp, nil
+}
+
+// Убеждаемся что GRPCRevocationsClient реализует RevocationsClient
+var _ RevocationsClient = (*GRPCRevocationsClient)(nil)
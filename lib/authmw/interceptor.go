@@ -63,6 +63,9 @@ func UnaryServerInterceptor(validator *Validator, skipMethods ...string) grpc.Un
 			if err == ErrTokenExpired {
 				return nil, status.Error(codes.Unauthenticated, "token expired")
 			}
+			if err == ErrTokenRevoked {
+				return nil, status.Error(codes.Unauthenticated, "token revoked")
+			}
 			if err == ErrInvalidAudience {
 				return nil, status.Error(codes.PermissionDenied, "invalid audience")
 			}
@@ -123,6 +126,9 @@ func StreamServerInterceptor(validator *Validator, skipMethods ...string) grpc.S
 			if err == ErrTokenExpired {
 				return status.Error(codes.Unauthenticated, "token expired")
 			}
+			if err == ErrTokenRevoked {
+				return status.Error(codes.Unauthenticated, "token revoked")
+			}
 			if err == ErrInvalidAudience {
 				return status.Error(codes.PermissionDenied, "invalid audience")
 			}
@@ -1,21 +1,28 @@
 package authmw
 
 import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
 	"crypto/rsa"
 	"encoding/base64"
 	"fmt"
 	"math/big"
 )
 
-// InternalJWK представляет JSON Web Key в формате RSA (внутреннее представление)
-// Используется для работы с RSA ключами, отличается от protobuf JWK
+// InternalJWK представляет JSON Web Key (внутреннее представление)
+// Используется для работы с RSA, EC и OKP ключами, отличается от protobuf JWK
 type InternalJWK struct {
-	KTY string `json:"kty"` // Key Type (RSA)
-	Use string `json:"use"` // Public Key Use (sig)
-	KID string `json:"kid"` // Key ID
-	Alg string `json:"alg"` // Algorithm (RS256)
-	N   string `json:"n"`   // Modulus (base64url)
-	E   string `json:"e"`   // Exponent (base64url)
+	KTY string `json:"kty"`           // Key Type (RSA, EC, OKP)
+	Use string `json:"use"`           // Public Key Use (sig)
+	KID string `json:"kid"`           // Key ID
+	Alg string `json:"alg"`           // Algorithm (RS256, ES256, EdDSA)
+	N   string `json:"n,omitempty"`   // Modulus (base64url) - RSA
+	E   string `json:"e,omitempty"`   // Exponent (base64url) - RSA
+	X   string `json:"x,omitempty"`   // X координата (base64url) - EC, публичный ключ - OKP
+	Y   string `json:"y,omitempty"`   // Y координата (base64url) - EC
+	Crv string `json:"crv,omitempty"` // Кривая (P-256, Ed25519) - EC, OKP
 }
 
 // JWKS представляет набор JWK ключей
@@ -52,6 +59,69 @@ func (jwk *InternalJWK) ToRSAPublicKey() (*rsa.PublicKey, error) {
 	}, nil
 }
 
+// PublicKey конвертирует InternalJWK в публичный ключ по его типу:
+// *rsa.PublicKey, *ecdsa.PublicKey (P-256) или ed25519.PublicKey
+func (jwk *InternalJWK) PublicKey() (crypto.PublicKey, error) {
+	switch jwk.KTY {
+	case "RSA":
+		return jwk.ToRSAPublicKey()
+	case "EC":
+		return jwk.ToECDSAPublicKey()
+	case "OKP":
+		return jwk.ToEd25519PublicKey()
+	default:
+		return nil, fmt.Errorf("unsupported key type: %s", jwk.KTY)
+	}
+}
+
+// ToECDSAPublicKey конвертирует InternalJWK в ECDSA P-256 public key
+func (jwk *InternalJWK) ToECDSAPublicKey() (*ecdsa.PublicKey, error) {
+	if jwk.Crv != "P-256" {
+		return nil, fmt.Errorf("unsupported curve: %s", jwk.Crv)
+	}
+
+	xBytes, err := base64.RawURLEncoding.DecodeString(jwk.X)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode x coordinate: %w", err)
+	}
+
+	yBytes, err := base64.RawURLEncoding.DecodeString(jwk.Y)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode y coordinate: %w", err)
+	}
+
+	const coordinateSize = 32
+	if len(xBytes) != coordinateSize || len(yBytes) != coordinateSize {
+		return nil, fmt.Errorf("invalid P-256 coordinate length")
+	}
+
+	// Несжатая точка 0x04 || X || Y, заодно проверяется, что она лежит на кривой
+	point := make([]byte, 0, 1+2*coordinateSize)
+	point = append(point, 0x04)
+	point = append(point, xBytes...)
+	point = append(point, yBytes...)
+
+	return ecdsa.ParseUncompressedPublicKey(elliptic.P256(), point)
+}
+
+// ToEd25519PublicKey конвертирует InternalJWK в Ed25519 public key
+func (jwk *InternalJWK) ToEd25519PublicKey() (ed25519.PublicKey, error) {
+	if jwk.Crv != "Ed25519" {
+		return nil, fmt.Errorf("unsupported curve: %s", jwk.Crv)
+	}
+
+	xBytes, err := base64.RawURLEncoding.DecodeString(jwk.X)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode public key: %w", err)
+	}
+
+	if len(xBytes) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("invalid Ed25519 public key length")
+	}
+
+	return ed25519.PublicKey(xBytes), nil
+}
+
 // GetKeyByKID возвращает InternalJWK по KID
 func (jwks *JWKS) GetKeyByKID(kid string) (*InternalJWK, error) {
 	for i := range jwks.Keys {
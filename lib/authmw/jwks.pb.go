@@ -105,13 +105,17 @@ func (x *GetJWKSResponse) GetJwks() []*JWK {
 
 // JWK - JSON Web Key
 type JWK struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Kty           string                 `protobuf:"bytes,1,opt,name=kty,proto3" json:"kty,omitempty"`
-	Use           string                 `protobuf:"bytes,2,opt,name=use,proto3" json:"use,omitempty"`
-	Kid           string                 `protobuf:"bytes,3,opt,name=kid,proto3" json:"kid,omitempty"`
-	Alg           string                 `protobuf:"bytes,4,opt,name=alg,proto3" json:"alg,omitempty"`
-	N             string                 `protobuf:"bytes,5,opt,name=n,proto3" json:"n,omitempty"`
-	E             string                 `protobuf:"bytes,6,opt,name=e,proto3" json:"e,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Kty   string                 `protobuf:"bytes,1,opt,name=kty,proto3" json:"kty,omitempty"`
+	Use   string                 `protobuf:"bytes,2,opt,name=use,proto3" json:"use,omitempty"`
+	Kid   string                 `protobuf:"bytes,3,opt,name=kid,proto3" json:"kid,omitempty"`
+	Alg   string                 `protobuf:"bytes,4,opt,name=alg,proto3" json:"alg,omitempty"`
+	N     string                 `protobuf:"bytes,5,opt,name=n,proto3" json:"n,omitempty"`
+	E     string                 `protobuf:"bytes,6,opt,name=e,proto3" json:"e,omitempty"`
+	// x, y, crv - параметры EC (P-256) и OKP (Ed25519) ключей
+	X             string `protobuf:"bytes,7,opt,name=x,proto3" json:"x,omitempty"`
+	Y             string `protobuf:"bytes,8,opt,name=y,proto3" json:"y,omitempty"`
+	Crv           string `protobuf:"bytes,9,opt,name=crv,proto3" json:"crv,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -188,6 +192,231 @@ func (x *JWK) GetE() string {
 	return ""
 }
 
+func (x *JWK) GetX() string {
+	if x != nil {
+		return x.X
+	}
+	return ""
+}
+
+func (x *JWK) GetY() string {
+	if x != nil {
+		return x.Y
+	}
+	return ""
+}
+
+func (x *JWK) GetCrv() string {
+	if x != nil {
+		return x.Crv
+	}
+	return ""
+}
+
+// GetRevocationsRequest - пустой запрос для получения отозванных access токенов
+type GetRevocationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRevocationsRequest) Reset() {
+	*x = GetRevocationsRequest{}
+	mi := &file_jwks_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRevocationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRevocationsRequest) ProtoMessage() {}
+
+func (x *GetRevocationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_jwks_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRevocationsRequest.ProtoReflect.Descriptor instead.
+func (*GetRevocationsRequest) Descriptor() ([]byte, []int) {
+	return file_jwks_proto_rawDescGZIP(), []int{3}
+}
+
+// GetRevocationsResponse - отозванные access токены, еще не истекшие по времени жизни
+type GetRevocationsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RevokedTokens  []*RevokedAccessToken  `protobuf:"bytes,1,rep,name=revoked_tokens,json=revokedTokens,proto3" json:"revoked_tokens,omitempty"`
+	UserWatermarks []*UserTokenWatermark  `protobuf:"bytes,2,rep,name=user_watermarks,json=userWatermarks,proto3" json:"user_watermarks,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetRevocationsResponse) Reset() {
+	*x = GetRevocationsResponse{}
+	mi := &file_jwks_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRevocationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRevocationsResponse) ProtoMessage() {}
+
+func (x *GetRevocationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_jwks_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRevocationsResponse.ProtoReflect.Descriptor instead.
+func (*GetRevocationsResponse) Descriptor() ([]byte, []int) {
+	return file_jwks_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetRevocationsResponse) GetRevokedTokens() []*RevokedAccessToken {
+	if x != nil {
+		return x.RevokedTokens
+	}
+	return nil
+}
+
+func (x *GetRevocationsResponse) GetUserWatermarks() []*UserTokenWatermark {
+	if x != nil {
+		return x.UserWatermarks
+	}
+	return nil
+}
+
+// RevokedAccessToken - отозванный access токен
+type RevokedAccessToken struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jti           string                 `protobuf:"bytes,1,opt,name=jti,proto3" json:"jti,omitempty"`
+	ExpiresAtUnix int64                  `protobuf:"varint,2,opt,name=expires_at_unix,json=expiresAtUnix,proto3" json:"expires_at_unix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokedAccessToken) Reset() {
+	*x = RevokedAccessToken{}
+	mi := &file_jwks_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokedAccessToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokedAccessToken) ProtoMessage() {}
+
+func (x *RevokedAccessToken) ProtoReflect() protoreflect.Message {
+	mi := &file_jwks_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokedAccessToken.ProtoReflect.Descriptor instead.
+func (*RevokedAccessToken) Descriptor() ([]byte, []int) {
+	return file_jwks_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RevokedAccessToken) GetJti() string {
+	if x != nil {
+		return x.Jti
+	}
+	return ""
+}
+
+func (x *RevokedAccessToken) GetExpiresAtUnix() int64 {
+	if x != nil {
+		return x.ExpiresAtUnix
+	}
+	return 0
+}
+
+// UserTokenWatermark - токены пользователя с iat раньше not_before_unix недействительны
+type UserTokenWatermark struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	NotBeforeUnix int64                  `protobuf:"varint,2,opt,name=not_before_unix,json=notBeforeUnix,proto3" json:"not_before_unix,omitempty"`
+	ExpiresAtUnix int64                  `protobuf:"varint,3,opt,name=expires_at_unix,json=expiresAtUnix,proto3" json:"expires_at_unix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserTokenWatermark) Reset() {
+	*x = UserTokenWatermark{}
+	mi := &file_jwks_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserTokenWatermark) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserTokenWatermark) ProtoMessage() {}
+
+func (x *UserTokenWatermark) ProtoReflect() protoreflect.Message {
+	mi := &file_jwks_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserTokenWatermark.ProtoReflect.Descriptor instead.
+func (*UserTokenWatermark) Descriptor() ([]byte, []int) {
+	return file_jwks_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UserTokenWatermark) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserTokenWatermark) GetNotBeforeUnix() int64 {
+	if x != nil {
+		return x.NotBeforeUnix
+	}
+	return 0
+}
+
+func (x *UserTokenWatermark) GetExpiresAtUnix() int64 {
+	if x != nil {
+		return x.ExpiresAtUnix
+	}
+	return 0
+}
+
 var File_jwks_proto protoreflect.FileDescriptor
 
 const file_jwks_proto_rawDesc = "" +
@@ -196,14 +425,28 @@ const file_jwks_proto_rawDesc = "" +
 	"jwks.proto\x12\x06authmw\"\x10\n" +
 	"\x0eGetJWKSRequest\"2\n" +
 	"\x0fGetJWKSResponse\x12\x1f\n" +
-	"\x04jwks\x18\x01 \x03(\v2\v.authmw.JWKR\x04jwks\"i\n" +
+	"\x04jwks\x18\x01 \x03(\v2\v.authmw.JWKR\x04jwks\"\x97\x01\n" +
 	"\x03JWK\x12\x10\n" +
 	"\x03kty\x18\x01 \x01(\tR\x03kty\x12\x10\n" +
 	"\x03use\x18\x02 \x01(\tR\x03use\x12\x10\n" +
 	"\x03kid\x18\x03 \x01(\tR\x03kid\x12\x10\n" +
 	"\x03alg\x18\x04 \x01(\tR\x03alg\x12\f\n" +
 	"\x01n\x18\x05 \x01(\tR\x01n\x12\f\n" +
-	"\x01e\x18\x06 \x01(\tR\x01eB5Z3github.com/sskorolev/balun_microservices/lib/authmwb\x06proto3"
+	"\x01e\x18\x06 \x01(\tR\x01e\x12\f\n" +
+	"\x01x\x18\a \x01(\tR\x01x\x12\f\n" +
+	"\x01y\x18\b \x01(\tR\x01y\x12\x10\n" +
+	"\x03crv\x18\t \x01(\tR\x03crv\"\x17\n" +
+	"\x15GetRevocationsRequest\"\xa0\x01\n" +
+	"\x16GetRevocationsResponse\x12A\n" +
+	"\x0erevoked_tokens\x18\x01 \x03(\v2\x1a.authmw.RevokedAccessTokenR\rrevokedTokens\x12C\n" +
+	"\x0fuser_watermarks\x18\x02 \x03(\v2\x1a.authmw.UserTokenWatermarkR\x0euserWatermarks\"N\n" +
+	"\x12RevokedAccessToken\x12\x10\n" +
+	"\x03jti\x18\x01 \x01(\tR\x03jti\x12&\n" +
+	"\x0fexpires_at_unix\x18\x02 \x01(\x03R\rexpiresAtUnix\"}\n" +
+	"\x12UserTokenWatermark\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12&\n" +
+	"\x0fnot_before_unix\x18\x02 \x01(\x03R\rnotBeforeUnix\x12&\n" +
+	"\x0fexpires_at_unix\x18\x03 \x01(\x03R\rexpiresAtUnixB5Z3github.com/sskorolev/balun_microservices/lib/authmwb\x06proto3"
 
 var (
 	file_jwks_proto_rawDescOnce sync.Once
@@ -217,19 +460,25 @@ func file_jwks_proto_rawDescGZIP() []byte {
 	return file_jwks_proto_rawDescData
 }
 
-var file_jwks_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_jwks_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_jwks_proto_goTypes = []any{
-	(*GetJWKSRequest)(nil),  // 0: authmw.GetJWKSRequest
-	(*GetJWKSResponse)(nil), // 1: authmw.GetJWKSResponse
-	(*JWK)(nil),             // 2: authmw.JWK
+	(*GetJWKSRequest)(nil),         // 0: authmw.GetJWKSRequest
+	(*GetJWKSResponse)(nil),        // 1: authmw.GetJWKSResponse
+	(*JWK)(nil),                    // 2: authmw.JWK
+	(*GetRevocationsRequest)(nil),  // 3: authmw.GetRevocationsRequest
+	(*GetRevocationsResponse)(nil), // 4: authmw.GetRevocationsResponse
+	(*RevokedAccessToken)(nil),     // 5: authmw.RevokedAccessToken
+	(*UserTokenWatermark)(nil),     // 6: authmw.UserTokenWatermark
 }
 var file_jwks_proto_depIdxs = []int32{
 	2, // 0: authmw.GetJWKSResponse.jwks:type_name -> authmw.JWK
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	5, // 1: authmw.GetRevocationsResponse.revoked_tokens:type_name -> authmw.RevokedAccessToken
+	6, // 2: authmw.GetRevocationsResponse.user_watermarks:type_name -> authmw.UserTokenWatermark
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_jwks_proto_init() }
@@ -243,7 +492,7 @@ func file_jwks_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_jwks_proto_rawDesc), len(file_jwks_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,183 @@
+package authmw
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInternalJWK_PublicKey(t *testing.T) {
+	_, rsaJWK := newRSAKey(t, "rsa-1")
+	_, ecJWK := newECDSAKey(t, "ec-1")
+	_, edJWK := newEd25519Key(t, "ed-1")
+
+	t.Run("тип ключа определяет тип публичного ключа", func(t *testing.T) {
+		key, err := rsaJWK.PublicKey()
+		require.NoError(t, err)
+		assert.IsType(t, &rsa.PublicKey{}, key)
+
+		key, err = ecJWK.PublicKey()
+		require.NoError(t, err)
+		assert.IsType(t, &ecdsa.PublicKey{}, key)
+
+		key, err = edJWK.PublicKey()
+		require.NoError(t, err)
+		assert.IsType(t, ed25519.PublicKey{}, key)
+	})
+
+	t.Run("неизвестный тип ключа", func(t *testing.T) {
+		jwk := ecJWK
+		jwk.KTY = "oct"
+
+		_, err := jwk.PublicKey()
+		assert.ErrorContains(t, err, "unsupported key type: oct")
+	})
+
+	t.Run("некорректный ключ", func(t *testing.T) {
+		jwk := ecJWK
+		jwk.Crv = "P-384"
+
+		_, err := jwk.PublicKey()
+		assert.Error(t, err)
+	})
+}
+
+func TestInternalJWK_ToECDSAPublicKey(t *testing.T) {
+	_, valid := newECDSAKey(t, "ec-1")
+	// 32 байта нулей - точка (0, 0) не лежит на P-256
+	zeros := base64.RawURLEncoding.EncodeToString(make([]byte, 32))
+	short := base64.RawURLEncoding.EncodeToString(make([]byte, 31))
+
+	tests := []struct {
+		name    string
+		modify  func(jwk *InternalJWK)
+		wantErr string
+	}{
+		{
+			name:   "корректный ключ",
+			modify: func(jwk *InternalJWK) {},
+		},
+		{
+			name:    "неподдерживаемая кривая",
+			modify:  func(jwk *InternalJWK) { jwk.Crv = "P-384" },
+			wantErr: "unsupported curve: P-384",
+		},
+		{
+			name:    "кривая не задана",
+			modify:  func(jwk *InternalJWK) { jwk.Crv = "" },
+			wantErr: "unsupported curve",
+		},
+		{
+			name:    "x не base64url",
+			modify:  func(jwk *InternalJWK) { jwk.X = "not base64!" },
+			wantErr: "failed to decode x coordinate",
+		},
+		{
+			name:    "y не base64url",
+			modify:  func(jwk *InternalJWK) { jwk.Y = "not base64!" },
+			wantErr: "failed to decode y coordinate",
+		},
+		{
+			name:    "x в base64 с паддингом",
+			modify:  func(jwk *InternalJWK) { jwk.X = base64.URLEncoding.EncodeToString(make([]byte, 31)) },
+			wantErr: "failed to decode x coordinate",
+		},
+		{
+			name:    "короткая x",
+			modify:  func(jwk *InternalJWK) { jwk.X = short },
+			wantErr: "invalid P-256 coordinate length",
+		},
+		{
+			name:    "пустая y",
+			modify:  func(jwk *InternalJWK) { jwk.Y = "" },
+			wantErr: "invalid P-256 coordinate length",
+		},
+		{
+			name: "точка не на кривой",
+			modify: func(jwk *InternalJWK) {
+				jwk.X = zeros
+				jwk.Y = zeros
+			},
+			wantErr: "point not on curve",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jwk := valid
+			tt.modify(&jwk)
+
+			key, err := jwk.ToECDSAPublicKey()
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				assert.Nil(t, key)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.NotNil(t, key)
+		})
+	}
+}
+
+func TestInternalJWK_ToEd25519PublicKey(t *testing.T) {
+	_, valid := newEd25519Key(t, "ed-1")
+
+	tests := []struct {
+		name    string
+		modify  func(jwk *InternalJWK)
+		wantErr string
+	}{
+		{
+			name:   "корректный ключ",
+			modify: func(jwk *InternalJWK) {},
+		},
+		{
+			name:    "неподдерживаемая кривая",
+			modify:  func(jwk *InternalJWK) { jwk.Crv = "X25519" },
+			wantErr: "unsupported curve: X25519",
+		},
+		{
+			name:    "x не base64url",
+			modify:  func(jwk *InternalJWK) { jwk.X = "not base64!" },
+			wantErr: "failed to decode public key",
+		},
+		{
+			name:    "короткий ключ",
+			modify:  func(jwk *InternalJWK) { jwk.X = base64.RawURLEncoding.EncodeToString(make([]byte, 31)) },
+			wantErr: "invalid Ed25519 public key length",
+		},
+		{
+			name:    "длинный ключ",
+			modify:  func(jwk *InternalJWK) { jwk.X = base64.RawURLEncoding.EncodeToString(make([]byte, 33)) },
+			wantErr: "invalid Ed25519 public key length",
+		},
+		{
+			name:    "пустой ключ",
+			modify:  func(jwk *InternalJWK) { jwk.X = "" },
+			wantErr: "invalid Ed25519 public key length",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jwk := valid
+			tt.modify(&jwk)
+
+			key, err := jwk.ToEd25519PublicKey()
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				assert.Nil(t, key)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Len(t, key, ed25519.PublicKeySize)
+		})
+	}
+}
@@ -44,6 +44,10 @@ func HTTPMiddleware(validator *Validator, skipPaths ...string) func(http.Handler
 					http.Error(w, "token expired", http.StatusUnauthorized)
 					return
 				}
+				if errors.Is(err, ErrTokenRevoked) {
+					http.Error(w, "token revoked", http.StatusUnauthorized)
+					return
+				}
 				if errors.Is(err, ErrInvalidAudience) {
 					http.Error(w, "invalid audience", http.StatusForbidden)
 					return
@@ -0,0 +1,80 @@
+package authmw
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RevocationsClient интерфейс для получения отозванных access токенов от auth сервиса
+type RevocationsClient interface {
+	GetRevocations(ctx context.Context) (*GetRevocationsResponse, error)
+}
+
+// RevocationChecker проверяет, отозван ли токен
+// Реализуется RevocationList, а также JWKSCache и JWKSCacheGRPC
+type RevocationChecker interface {
+	IsRevoked(claims *Claims) bool
+}
+
+type userWatermark struct {
+	notBefore int64
+	expiresAt int64
+}
+
+// RevocationList локальная копия отозванных access токенов.
+// Проверка выполняется без сетевых вызовов, список целиком заменяется при обновлении
+type RevocationList struct {
+	mu         sync.RWMutex
+	tokens     map[string]int64 // jti -> exp
+	watermarks map[string]userWatermark
+	now        func() time.Time
+}
+
+// NewRevocationList создает пустой список отзывов
+func NewRevocationList() *RevocationList {
+	return &RevocationList{
+		tokens:     make(map[string]int64),
+		watermarks: make(map[string]userWatermark),
+		now:        time.Now,
+	}
+}
+
+// Replace заменяет список отзывов снимком от auth сервиса
+func (l *RevocationList) Replace(resp *GetRevocationsResponse) {
+	tokens := make(map[string]int64, len(resp.GetRevokedTokens()))
+	for _, token := range resp.GetRevokedTokens() {
+		tokens[token.GetJti()] = token.GetExpiresAtUnix()
+	}
+
+	watermarks := make(map[string]userWatermark, len(resp.GetUserWatermarks()))
+	for _, watermark := range resp.GetUserWatermarks() {
+		watermarks[watermark.GetUserId()] = userWatermark{
+			notBefore: watermark.GetNotBeforeUnix(),
+			expiresAt: watermark.GetExpiresAtUnix(),
+		}
+	}
+
+	l.mu.Lock()
+	l.tokens = tokens
+	l.watermarks = watermarks
+	l.mu.Unlock()
+}
+
+// IsRevoked проверяет токен по отозванным jti и границе отзыва пользователя
+func (l *RevocationList) IsRevoked(claims *Claims) bool {
+	now := l.now().Unix()
+
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	if exp, ok := l.tokens[claims.JWTID]; ok && exp >= now {
+		return true
+	}
+
+	if watermark, ok := l.watermarks[claims.Subject]; ok && watermark.expiresAt >= now {
+		return claims.IssuedAt < watermark.notBefore
+	}
+
+	return false
+}
@@ -0,0 +1,155 @@
+package authmw
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRevocationList_IsRevoked(t *testing.T) {
+	now := time.Date(2026, time.October, 17, 12, 0, 0, 0, time.UTC)
+	notBefore := now.Add(-10 * time.Minute).Unix()
+
+	newList := func(resp *GetRevocationsResponse) *RevocationList {
+		l := NewRevocationList()
+		l.now = func() time.Time { return now }
+		l.Replace(resp)
+		return l
+	}
+
+	tests := []struct {
+		name   string
+		resp   *GetRevocationsResponse
+		claims *Claims
+		want   bool
+	}{
+		{
+			name: "отозванный jti",
+			resp: &GetRevocationsResponse{RevokedTokens: []*RevokedAccessToken{
+				{Jti: "jti-1", ExpiresAtUnix: now.Add(time.Minute).Unix()},
+			}},
+			claims: &Claims{Subject: "user-1", JWTID: "jti-1"},
+			want:   true,
+		},
+		{
+			name: "отзыв jti в момент истечения токена еще действует",
+			resp: &GetRevocationsResponse{RevokedTokens: []*RevokedAccessToken{
+				{Jti: "jti-1", ExpiresAtUnix: now.Unix()},
+			}},
+			claims: &Claims{Subject: "user-1", JWTID: "jti-1"},
+			want:   true,
+		},
+		{
+			name: "отзыв jti после истечения токена не учитывается",
+			resp: &GetRevocationsResponse{RevokedTokens: []*RevokedAccessToken{
+				{Jti: "jti-1", ExpiresAtUnix: now.Add(-time.Second).Unix()},
+			}},
+			claims: &Claims{Subject: "user-1", JWTID: "jti-1"},
+		},
+		{
+			name: "другой jti",
+			resp: &GetRevocationsResponse{RevokedTokens: []*RevokedAccessToken{
+				{Jti: "jti-1", ExpiresAtUnix: now.Add(time.Minute).Unix()},
+			}},
+			claims: &Claims{Subject: "user-1", JWTID: "jti-2"},
+		},
+		{
+			name: "токен выдан до границы отзыва",
+			resp: &GetRevocationsResponse{UserWatermarks: []*UserTokenWatermark{
+				{UserId: "user-1", NotBeforeUnix: notBefore, ExpiresAtUnix: now.Add(time.Minute).Unix()},
+			}},
+			claims: &Claims{Subject: "user-1", JWTID: "jti-1", IssuedAt: notBefore - 1},
+			want:   true,
+		},
+		{
+			name: "токен выдан в момент границы отзыва",
+			resp: &GetRevocationsResponse{UserWatermarks: []*UserTokenWatermark{
+				{UserId: "user-1", NotBeforeUnix: notBefore, ExpiresAtUnix: now.Add(time.Minute).Unix()},
+			}},
+			claims: &Claims{Subject: "user-1", JWTID: "jti-1", IssuedAt: notBefore},
+		},
+		{
+			name: "токен выдан после границы отзыва",
+			resp: &GetRevocationsResponse{UserWatermarks: []*UserTokenWatermark{
+				{UserId: "user-1", NotBeforeUnix: notBefore, ExpiresAtUnix: now.Add(time.Minute).Unix()},
+			}},
+			claims: &Claims{Subject: "user-1", JWTID: "jti-1", IssuedAt: notBefore + 1},
+		},
+		{
+			name: "истекшая граница отзыва не учитывается",
+			resp: &GetRevocationsResponse{UserWatermarks: []*UserTokenWatermark{
+				{UserId: "user-1", NotBeforeUnix: notBefore, ExpiresAtUnix: now.Add(-time.Second).Unix()},
+			}},
+			claims: &Claims{Subject: "user-1", JWTID: "jti-1", IssuedAt: notBefore - 1},
+		},
+		{
+			name: "граница отзыва другого пользователя",
+			resp: &GetRevocationsResponse{UserWatermarks: []*UserTokenWatermark{
+				{UserId: "user-2", NotBeforeUnix: notBefore, ExpiresAtUnix: now.Add(time.Minute).Unix()},
+			}},
+			claims: &Claims{Subject: "user-1", JWTID: "jti-1", IssuedAt: notBefore - 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, newList(tt.resp).IsRevoked(tt.claims))
+		})
+	}
+}
+
+func TestRevocationList_Replace(t *testing.T) {
+	now := time.Now()
+	claims := &Claims{Subject: "user-1", JWTID: "jti-1", IssuedAt: now.Add(-time.Hour).Unix()}
+
+	// Оба снимка отзывают claims: первый по jti, второй по границе пользователя
+	byJTI := &GetRevocationsResponse{RevokedTokens: []*RevokedAccessToken{
+		{Jti: "jti-1", ExpiresAtUnix: now.Add(time.Hour).Unix()},
+	}}
+	byWatermark := &GetRevocationsResponse{UserWatermarks: []*UserTokenWatermark{
+		{UserId: "user-1", NotBeforeUnix: now.Unix(), ExpiresAtUnix: now.Add(time.Hour).Unix()},
+	}}
+
+	t.Run("снимок заменяется целиком", func(t *testing.T) {
+		l := NewRevocationList()
+		l.Replace(byJTI)
+		l.Replace(&GetRevocationsResponse{})
+
+		assert.False(t, l.IsRevoked(claims))
+	})
+
+	t.Run("проверка не видит наполовину замененный снимок", func(t *testing.T) {
+		l := NewRevocationList()
+		l.Replace(byJTI)
+
+		var wg sync.WaitGroup
+		stop := make(chan struct{})
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; ; i++ {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				if i%2 == 0 {
+					l.Replace(byWatermark)
+				} else {
+					l.Replace(byJTI)
+				}
+			}
+		}()
+
+		for range 10000 {
+			if !l.IsRevoked(claims) {
+				assert.Fail(t, "token is not revoked during Replace")
+				break
+			}
+		}
+		close(stop)
+		wg.Wait()
+	})
+}
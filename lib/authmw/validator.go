@@ -13,8 +13,16 @@ var (
 	ErrTokenExpired    = errors.New("token expired")
 	ErrMissingKID      = errors.New("missing kid in token header")
 	ErrInvalidAudience = errors.New("invalid audience")
+	ErrTokenRevoked    = errors.New("token revoked")
 )
 
+// validMethods поддерживаемые алгоритмы подписи
+var validMethods = []string{
+	jwt.SigningMethodRS256.Alg(),
+	jwt.SigningMethodES256.Alg(),
+	jwt.SigningMethodEdDSA.Alg(),
+}
+
 // Claims представляет JWT claims
 type Claims struct {
 	Issuer    string   `json:"iss"`
@@ -39,6 +47,7 @@ type Validator struct {
 	cache            JWKSProvider
 	expectedIssuer   string
 	expectedAudience string // Ожидаемый audience для этого сервиса
+	revocations      RevocationChecker
 }
 
 // ValidatorConfig конфигурация для Validator
@@ -46,6 +55,9 @@ type ValidatorConfig struct {
 	JWKSCache        JWKSProvider // Может быть *JWKSCache или *JWKSCacheGRPC
 	ExpectedIssuer   string       // Например, "balun-auth-service"
 	ExpectedAudience string       // Например, "users", "social", "chat"
+	// Revocations - список отозванных токенов, обычно тот же JWKSCacheGRPC.
+	// Если не задан, отзыв не проверяется
+	Revocations RevocationChecker
 }
 
 // NewValidator создает новый JWT validator
@@ -54,6 +66,7 @@ func NewValidator(cfg ValidatorConfig) *Validator {
 		cache:            cfg.JWKSCache,
 		expectedIssuer:   cfg.ExpectedIssuer,
 		expectedAudience: cfg.ExpectedAudience,
+		revocations:      cfg.Revocations,
 	}
 }
 
@@ -61,11 +74,6 @@ func NewValidator(cfg ValidatorConfig) *Validator {
 func (v *Validator) Validate(ctx context.Context, tokenString string) (*Claims, error) {
 	// Парсим токен без валидации для получения KID
 	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-		// Проверяем алгоритм
-		if token.Method.Alg() != jwt.SigningMethodRS256.Alg() {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-
 		// Получаем KID из header
 		kid, ok := token.Header["kid"].(string)
 		if !ok || kid == "" {
@@ -78,15 +86,20 @@ func (v *Validator) Validate(ctx context.Context, tokenString string) (*Claims,
 			return nil, fmt.Errorf("failed to get key by KID: %w", err)
 		}
 
-		// Конвертируем JWK в RSA public key
-		publicKey, err := jwk.ToRSAPublicKey()
+		// Алгоритм задается для каждого ключа, токен должен быть подписан именно им
+		if token.Method.Alg() != jwk.Alg {
+			return nil, fmt.Errorf("unexpected signing method %v for key %s", token.Header["alg"], kid)
+		}
+
+		// Конвертируем JWK в public key
+		publicKey, err := jwk.PublicKey()
 		if err != nil {
-			return nil, fmt.Errorf("failed to convert JWK to RSA public key: %w", err)
+			return nil, fmt.Errorf("failed to convert JWK to public key: %w", err)
 		}
 
 		return publicKey, nil
 	},
-		jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Name}),
+		jwt.WithValidMethods(validMethods),
 		jwt.WithIssuer(v.expectedIssuer),
 		jwt.WithExpirationRequired(),
 	)
@@ -143,7 +156,17 @@ func (v *Validator) Validate(ctx context.Context, tokenString string) (*Claims,
 	}
 
 	// Конвертируем в нашу структуру Claims
-	return mapToClaims(claims)
+	result, err := mapToClaims(claims)
+	if err != nil {
+		return nil, err
+	}
+
+	// Проверяем локальный список отзывов
+	if v.revocations != nil && v.revocations.IsRevoked(result) {
+		return nil, ErrTokenRevoked
+	}
+
+	return result, nil
 }
 
 func mapToClaims(claims jwt.MapClaims) (*Claims, error) {
@@ -0,0 +1,276 @@
+package authmw
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testIssuer   = "balun-auth-service"
+	testAudience = "social"
+)
+
+// staticJWKS - JWKSProvider с фиксированным набором ключей
+type staticJWKS struct {
+	jwks *JWKS
+}
+
+func (s *staticJWKS) GetJWKS() *JWKS {
+	return s.jwks
+}
+
+func (s *staticJWKS) GetKeyByKID(kid string) (*InternalJWK, error) {
+	return s.jwks.GetKeyByKID(kid)
+}
+
+func (s *staticJWKS) Stop() {}
+
+func newTestValidator(revocations RevocationChecker, keys ...InternalJWK) *Validator {
+	return NewValidator(ValidatorConfig{
+		JWKSCache:        &staticJWKS{jwks: &JWKS{Keys: keys}},
+		ExpectedIssuer:   testIssuer,
+		ExpectedAudience: testAudience,
+		Revocations:      revocations,
+	})
+}
+
+func newRSAKey(t *testing.T, kid string) (*rsa.PrivateKey, InternalJWK) {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	return key, InternalJWK{
+		KTY: "RSA",
+		Use: "sig",
+		KID: kid,
+		Alg: jwt.SigningMethodRS256.Alg(),
+		N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}
+}
+
+func newECDSAKey(t *testing.T, kid string) (*ecdsa.PrivateKey, InternalJWK) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	point, err := key.PublicKey.Bytes()
+	require.NoError(t, err)
+
+	// point - несжатая точка 0x04 || X || Y
+	return key, InternalJWK{
+		KTY: "EC",
+		Use: "sig",
+		KID: kid,
+		Alg: jwt.SigningMethodES256.Alg(),
+		X:   base64.RawURLEncoding.EncodeToString(point[1:33]),
+		Y:   base64.RawURLEncoding.EncodeToString(point[33:]),
+		Crv: "P-256",
+	}
+}
+
+func newEd25519Key(t *testing.T, kid string) (ed25519.PrivateKey, InternalJWK) {
+	t.Helper()
+
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	return private, InternalJWK{
+		KTY: "OKP",
+		Use: "sig",
+		KID: kid,
+		Alg: jwt.SigningMethodEdDSA.Alg(),
+		X:   base64.RawURLEncoding.EncodeToString(public),
+		Crv: "Ed25519",
+	}
+}
+
+func testClaims(now time.Time) jwt.MapClaims {
+	return jwt.MapClaims{
+		"iss": testIssuer,
+		"sub": "user-1",
+		"aud": []string{testAudience},
+		"iat": now.Add(-time.Minute).Unix(),
+		"exp": now.Add(time.Hour).Unix(),
+		"jti": "jti-1",
+	}
+}
+
+func signToken(t *testing.T, method jwt.SigningMethod, key any, kid string, claims jwt.MapClaims) string {
+	t.Helper()
+
+	token := jwt.NewWithClaims(method, claims)
+	token.Header["kid"] = kid
+
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+
+	return signed
+}
+
+func TestValidator_Validate(t *testing.T) {
+	now := time.Now()
+	rsaKey, rsaJWK := newRSAKey(t, "rsa-1")
+	ecKey, ecJWK := newECDSAKey(t, "ec-1")
+	edKey, edJWK := newEd25519Key(t, "ed-1")
+	validator := newTestValidator(nil, rsaJWK, ecJWK, edJWK)
+
+	expired := testClaims(now)
+	expired["iat"] = now.Add(-2 * time.Hour).Unix()
+	expired["exp"] = now.Add(-time.Hour).Unix()
+
+	otherAudience := testClaims(now)
+	otherAudience["aud"] = []string{"chat"}
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr error
+	}{
+		{
+			name:  "RS256",
+			token: signToken(t, jwt.SigningMethodRS256, rsaKey, rsaJWK.KID, testClaims(now)),
+		},
+		{
+			name:  "ES256",
+			token: signToken(t, jwt.SigningMethodES256, ecKey, ecJWK.KID, testClaims(now)),
+		},
+		{
+			name:  "EdDSA",
+			token: signToken(t, jwt.SigningMethodEdDSA, edKey, edJWK.KID, testClaims(now)),
+		},
+		{
+			name:    "ES256 токен с kid RSA ключа",
+			token:   signToken(t, jwt.SigningMethodES256, ecKey, rsaJWK.KID, testClaims(now)),
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:    "EdDSA токен с kid ES256 ключа",
+			token:   signToken(t, jwt.SigningMethodEdDSA, edKey, ecJWK.KID, testClaims(now)),
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name: "HS256 токен, подписанный публичным ключом",
+			token: signToken(t, jwt.SigningMethodHS256,
+				[]byte(rsaJWK.N), rsaJWK.KID, testClaims(now)),
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:    "подпись чужим ключом",
+			token:   signToken(t, jwt.SigningMethodES256, mustECDSAKey(t), ecJWK.KID, testClaims(now)),
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:    "неизвестный kid",
+			token:   signToken(t, jwt.SigningMethodEdDSA, edKey, "unknown", testClaims(now)),
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:    "истекший токен",
+			token:   signToken(t, jwt.SigningMethodES256, ecKey, ecJWK.KID, expired),
+			wantErr: ErrTokenExpired,
+		},
+		{
+			name:    "чужой audience",
+			token:   signToken(t, jwt.SigningMethodEdDSA, edKey, edJWK.KID, otherAudience),
+			wantErr: ErrInvalidAudience,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims, err := validator.Validate(context.Background(), tt.token)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, testIssuer, claims.Issuer)
+			assert.Equal(t, "user-1", claims.Subject)
+			assert.Equal(t, []string{testAudience}, claims.Audience)
+			assert.Equal(t, "jti-1", claims.JWTID)
+		})
+	}
+
+	t.Run("алгоритм токена не совпадает с алгоритмом ключа", func(t *testing.T) {
+		token := signToken(t, jwt.SigningMethodES256, ecKey, rsaJWK.KID, testClaims(now))
+
+		_, err := validator.Validate(context.Background(), token)
+		require.ErrorIs(t, err, ErrInvalidToken)
+		assert.ErrorContains(t, err, "unexpected signing method ES256 for key rsa-1")
+	})
+
+	t.Run("токен без kid", func(t *testing.T) {
+		token := signToken(t, jwt.SigningMethodEdDSA, edKey, "", testClaims(now))
+
+		_, err := validator.Validate(context.Background(), token)
+		require.ErrorIs(t, err, ErrInvalidToken)
+		assert.ErrorContains(t, err, ErrMissingKID.Error())
+	})
+}
+
+func mustECDSAKey(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+
+	key, _ := newECDSAKey(t, "")
+	return key
+}
+
+func TestValidator_Revocations(t *testing.T) {
+	now := time.Now()
+	key, jwk := newRSAKey(t, "rsa-1")
+	token := signToken(t, jwt.SigningMethodRS256, key, jwk.KID, testClaims(now))
+
+	t.Run("отозванный jti", func(t *testing.T) {
+		revocations := NewRevocationList()
+		revocations.Replace(&GetRevocationsResponse{RevokedTokens: []*RevokedAccessToken{
+			{Jti: "jti-1", ExpiresAtUnix: now.Add(time.Hour).Unix()},
+		}})
+
+		_, err := newTestValidator(revocations, jwk).Validate(context.Background(), token)
+		assert.ErrorIs(t, err, ErrTokenRevoked)
+	})
+
+	t.Run("токен выдан до границы отзыва пользователя", func(t *testing.T) {
+		revocations := NewRevocationList()
+		revocations.Replace(&GetRevocationsResponse{UserWatermarks: []*UserTokenWatermark{
+			{UserId: "user-1", NotBeforeUnix: now.Unix(), ExpiresAtUnix: now.Add(time.Hour).Unix()},
+		}})
+
+		_, err := newTestValidator(revocations, jwk).Validate(context.Background(), token)
+		assert.ErrorIs(t, err, ErrTokenRevoked)
+	})
+
+	t.Run("токен не отозван", func(t *testing.T) {
+		revocations := NewRevocationList()
+		revocations.Replace(&GetRevocationsResponse{RevokedTokens: []*RevokedAccessToken{
+			{Jti: "jti-2", ExpiresAtUnix: now.Add(time.Hour).Unix()},
+		}})
+
+		claims, err := newTestValidator(revocations, jwk).Validate(context.Background(), token)
+		require.NoError(t, err)
+		assert.Equal(t, "user-1", claims.Subject)
+		assert.Equal(t, "jti-1", claims.JWTID)
+	})
+
+	t.Run("без списка отзывов проверка пропускается", func(t *testing.T) {
+		claims, err := newTestValidator(nil, jwk).Validate(context.Background(), token)
+		require.NoError(t, err)
+		assert.Equal(t, "jti-1", claims.JWTID)
+	})
+}
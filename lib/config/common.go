@@ -95,8 +95,10 @@ type VaultSecretsConfig struct {
 
 // TargetServiceConfig содержит настройки подключения к зависимому сервису
 type TargetServiceConfig struct {
-	Host       string            `mapstructure:"host"`
-	Port       int               `mapstructure:"port"`
+	Host string `mapstructure:"host"`
+	Port int    `mapstructure:"port"`
+	// AdminPort - порт admin сервера с внутренними gRPC методами, 0 - не используется
+	AdminPort  int               `mapstructure:"admin_port,omitempty"`
 	GRPCClient *GRPCClientConfig `mapstructure:"grpc_client,omitempty"`
 }
 
@@ -105,6 +107,15 @@ func (t TargetServiceConfig) Address() string {
 	return fmt.Sprintf("%s:%d", t.Host, t.Port)
 }
 
+// Admin возвращает настройки подключения к admin серверу сервиса
+func (t TargetServiceConfig) Admin() *TargetServiceConfig {
+	return &TargetServiceConfig{
+		Host:       t.Host,
+		Port:       t.AdminPort,
+		GRPCClient: t.GRPCClient,
+	}
+}
+
 // GRPCClientConfig содержит настройки gRPC клиента
 type GRPCClientConfig struct {
 	Timeout        time.Duration         `mapstructure:"timeout"`
@@ -144,6 +155,7 @@ type KafkaConfig struct {
 // KafkaTopics содержит названия топиков Kafka
 type KafkaTopics struct {
 	FriendRequestEvents string `mapstructure:"friend_request_events"`
+	UserBlockEvents     string `mapstructure:"user_block_events"`
 }
 
 // GetBrokers возвращает строку с адресами брокеров Kafka
@@ -153,15 +165,42 @@ func (c KafkaConfig) GetBrokers() string {
 
 // OutboxConfig содержит настройки Transactional Outbox процессора
 type OutboxConfig struct {
-	Processor OutboxProcessorConfig `mapstructure:"processor"`
+	Processor  OutboxProcessorConfig  `mapstructure:"processor"`
+	Partitions OutboxPartitionsConfig `mapstructure:"partitions"`
 }
 
 // OutboxProcessorConfig содержит параметры работы outbox процессора
 type OutboxProcessorConfig struct {
-	BatchSize     int           `mapstructure:"batch_size"`
+	BatchSize int `mapstructure:"batch_size"`
+	// MaxRetry количество неуспешных попыток, после которого событие уходит в dead-letter
 	MaxRetry      int           `mapstructure:"max_retry"`
 	RetryInterval time.Duration `mapstructure:"retry_interval"`
 	Window        time.Duration `mapstructure:"window"`
+	// Backoff задержка между повторами публикации, base по умолчанию равен RetryInterval
+	Backoff RetryBackoffConfig `mapstructure:"backoff"`
+}
+
+// OutboxPartitionsConfig содержит параметры обслуживания месячных партиций outbox
+type OutboxPartitionsConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// Interval период проверки партиций
+	Interval time.Duration `mapstructure:"interval"`
+	// Premake количество будущих месяцев, для которых партиции создаются заранее
+	Premake int `mapstructure:"premake"`
+	// Retention сколько хранить партиции после окончания их месяца, 0 - хранить всегда
+	Retention time.Duration `mapstructure:"retention"`
+	// DropDetached удалять старые партиции вместо DETACH
+	DropDetached bool `mapstructure:"drop_detached"`
+}
+
+// IdempotencyConfig содержит настройки идемпотентности unary gRPC методов
+type IdempotencyConfig struct {
+	Enabled         bool          `mapstructure:"enabled"`
+	TTL             time.Duration `mapstructure:"ttl"`
+	LockTimeout     time.Duration `mapstructure:"lock_timeout"`
+	CleanupInterval time.Duration `mapstructure:"cleanup_interval"`
+	// Methods полные имена gRPC методов (константы *_FullMethodName из сгенерированного кода)
+	Methods []string `mapstructure:"methods"`
 }
 
 // FriendRequestHandlerConfig содержит настройки обработчика заявок в друзья
@@ -169,6 +208,25 @@ type FriendRequestHandlerConfig struct {
 	BatchSize int `mapstructure:"batch_size"`
 }
 
+// FriendRequestsConfig содержит правила жизненного цикла заявок в друзья
+type FriendRequestsConfig struct {
+	// DeclineCooldown - через сколько после отклонения можно отправить заявку повторно
+	DeclineCooldown time.Duration `mapstructure:"decline_cooldown"`
+	// PendingTTL - через сколько ожидающая заявка истекает
+	PendingTTL time.Duration `mapstructure:"pending_ttl"`
+	// ExpireInterval - период запуска задачи истечения заявок
+	ExpireInterval time.Duration `mapstructure:"expire_interval"`
+	// ExpireBatchSize - сколько заявок истекает в одной транзакции
+	ExpireBatchSize int `mapstructure:"expire_batch_size"`
+}
+
+// StreamConfig содержит настройки браузерных стримов gateway (WebSocket, SSE)
+type StreamConfig struct {
+	// AllowedOrigins - Origin страниц, с которых разрешены WebSocket соединения, например https://app.example.com.
+	// Запросы без заголовка Origin (не из браузера) разрешены всегда
+	AllowedOrigins []string `mapstructure:"allowed_origins"`
+}
+
 // KafkaConsumerConfig содержит настройки Kafka consumer
 type KafkaConsumerConfig struct {
 	Brokers         string      `mapstructure:"brokers"`
@@ -15,12 +15,16 @@ type StandardServiceConfig struct {
 	KafkaConsumer        *KafkaConsumerConfig        `mapstructure:"kafka_consumer,omitempty"`
 	Outbox               *OutboxConfig               `mapstructure:"outbox,omitempty"`
 	FriendRequestHandler *FriendRequestHandlerConfig `mapstructure:"friend_request_handler,omitempty"`
+	FriendRequests       *FriendRequestsConfig       `mapstructure:"friend_requests,omitempty"`
+	Idempotency          *IdempotencyConfig          `mapstructure:"idempotency,omitempty"`
+	Stream               *StreamConfig               `mapstructure:"stream,omitempty"`
 
 	// Подключения к другим сервисам
-	AuthService   *TargetServiceConfig `mapstructure:"auth_service,omitempty"`
-	UsersService  *TargetServiceConfig `mapstructure:"users_service,omitempty"`
-	SocialService *TargetServiceConfig `mapstructure:"social_service,omitempty"`
-	ChatService   *TargetServiceConfig `mapstructure:"chat_service,omitempty"`
+	AuthService          *TargetServiceConfig `mapstructure:"auth_service,omitempty"`
+	UsersService         *TargetServiceConfig `mapstructure:"users_service,omitempty"`
+	SocialService        *TargetServiceConfig `mapstructure:"social_service,omitempty"`
+	ChatService          *TargetServiceConfig `mapstructure:"chat_service,omitempty"`
+	NotificationsService *TargetServiceConfig `mapstructure:"notifications_service,omitempty"`
 }
 
 // GetService реализует интерфейс Config
@@ -83,6 +87,18 @@ func (c *StandardServiceConfig) Validate() error {
 		}
 	}
 
+	if c.FriendRequests != nil {
+		if err := ValidateFriendRequestsConfig(*c.FriendRequests); err != nil {
+			return err
+		}
+	}
+
+	if c.Idempotency != nil {
+		if err := ValidateIdempotencyConfig(*c.Idempotency); err != nil {
+			return err
+		}
+	}
+
 	if c.AuthService != nil {
 		if err := ValidateTargetServiceConfig(*c.AuthService, "auth_service"); err != nil {
 			return err
@@ -107,5 +123,11 @@ func (c *StandardServiceConfig) Validate() error {
 		}
 	}
 
+	if c.NotificationsService != nil {
+		if err := ValidateTargetServiceConfig(*c.NotificationsService, "notifications_service"); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
@@ -26,12 +26,14 @@ type serviceOptions struct {
 	kafkaConsumer        *KafkaConsumerConfig
 	outbox               *OutboxConfig
 	friendRequestHandler *FriendRequestHandlerConfig
+	idempotency          *IdempotencyConfig
 
 	// Подключения к другим сервисам
-	authService   *TargetServiceConfig
-	usersService  *TargetServiceConfig
-	socialService *TargetServiceConfig
-	chatService   *TargetServiceConfig
+	authService          *TargetServiceConfig
+	usersService         *TargetServiceConfig
+	socialService        *TargetServiceConfig
+	chatService          *TargetServiceConfig
+	notificationsService *TargetServiceConfig
 }
 
 // WithDatabaseName устанавливает имя базы данных
@@ -106,6 +108,16 @@ func WithOutbox(batchSize, maxRetry int, retryInterval, window time.Duration) Se
 				MaxRetry:      maxRetry,
 				RetryInterval: retryInterval,
 				Window:        window,
+				Backoff: RetryBackoffConfig{
+					Base:   retryInterval,
+					Max:    time.Hour,
+					Jitter: true,
+				},
+			},
+			Partitions: OutboxPartitionsConfig{
+				Enabled:  true,
+				Interval: time.Hour,
+				Premake:  3,
 			},
 		}
 	}
@@ -120,6 +132,21 @@ func WithFriendRequestHandler(batchSize int) ServiceOption {
 	}
 }
 
+// WithIdempotency включает идемпотентность для перечисленных gRPC методов
+//
+// Список методов и параметры можно переопределить в секции idempotency конфигурации.
+func WithIdempotency(ttl time.Duration, methods ...string) ServiceOption {
+	return func(opts *serviceOptions) {
+		opts.idempotency = &IdempotencyConfig{
+			Enabled:         true,
+			TTL:             ttl,
+			LockTimeout:     time.Minute,
+			CleanupInterval: 10 * time.Minute,
+			Methods:         methods,
+		}
+	}
+}
+
 // WithAuthService включает конфигурацию подключения к Auth сервису
 func WithAuthService(host string, port int) ServiceOption {
 	return func(opts *serviceOptions) {
@@ -160,6 +187,16 @@ func WithChatService(host string, port int) ServiceOption {
 	}
 }
 
+// WithNotificationsService включает конфигурацию подключения к Notifications сервису
+func WithNotificationsService(host string, port int) ServiceOption {
+	return func(opts *serviceOptions) {
+		opts.notificationsService = &TargetServiceConfig{
+			Host: host,
+			Port: port,
+		}
+	}
+}
+
 // setGRPCClientDefaults устанавливает defaults для gRPC клиента target сервиса
 func setGRPCClientDefaults(v *viper.Viper, servicePrefix string) {
 	// Timeout
@@ -265,12 +302,28 @@ func LoadServiceConfig(ctx context.Context, serviceName string, opts ...ServiceO
 			v.SetDefault("outbox.processor.max_retry", options.outbox.Processor.MaxRetry)
 			v.SetDefault("outbox.processor.retry_interval", options.outbox.Processor.RetryInterval)
 			v.SetDefault("outbox.processor.window", options.outbox.Processor.Window)
+			v.SetDefault("outbox.processor.backoff.base", options.outbox.Processor.Backoff.Base)
+			v.SetDefault("outbox.processor.backoff.max", options.outbox.Processor.Backoff.Max)
+			v.SetDefault("outbox.processor.backoff.jitter", options.outbox.Processor.Backoff.Jitter)
+			v.SetDefault("outbox.partitions.enabled", options.outbox.Partitions.Enabled)
+			v.SetDefault("outbox.partitions.interval", options.outbox.Partitions.Interval)
+			v.SetDefault("outbox.partitions.premake", options.outbox.Partitions.Premake)
+			v.SetDefault("outbox.partitions.retention", options.outbox.Partitions.Retention)
+			v.SetDefault("outbox.partitions.drop_detached", options.outbox.Partitions.DropDetached)
 		}
 
 		if options.friendRequestHandler != nil {
 			v.SetDefault("friend_request_handler.batch_size", options.friendRequestHandler.BatchSize)
 		}
 
+		if options.idempotency != nil {
+			v.SetDefault("idempotency.enabled", options.idempotency.Enabled)
+			v.SetDefault("idempotency.ttl", options.idempotency.TTL)
+			v.SetDefault("idempotency.lock_timeout", options.idempotency.LockTimeout)
+			v.SetDefault("idempotency.cleanup_interval", options.idempotency.CleanupInterval)
+			v.SetDefault("idempotency.methods", options.idempotency.Methods)
+		}
+
 		if options.authService != nil {
 			v.SetDefault("auth_service.host", options.authService.Host)
 			v.SetDefault("auth_service.port", options.authService.Port)
@@ -295,6 +348,12 @@ func LoadServiceConfig(ctx context.Context, serviceName string, opts ...ServiceO
 			setGRPCClientDefaults(v, "chat_service")
 		}
 
+		if options.notificationsService != nil {
+			v.SetDefault("notifications_service.host", options.notificationsService.Host)
+			v.SetDefault("notifications_service.port", options.notificationsService.Port)
+			setGRPCClientDefaults(v, "notifications_service")
+		}
+
 		// Вызываем кастомную функцию если она есть
 		if options.customDefaults != nil {
 			options.customDefaults(v)
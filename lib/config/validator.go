@@ -100,6 +100,9 @@ func ValidateKafkaConfig(cfg KafkaConfig) error {
 	if err := ValidateRequired(cfg.Topics.FriendRequestEvents, "kafka.topics.friend_request_events"); err != nil {
 		return err
 	}
+	if err := ValidateRequired(cfg.Topics.UserBlockEvents, "kafka.topics.user_block_events"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -111,6 +114,23 @@ func ValidateOutboxConfig(cfg OutboxConfig) error {
 	if err := ValidateNonNegative(cfg.Processor.MaxRetry, "outbox.processor.max_retry"); err != nil {
 		return err
 	}
+	if cfg.Processor.Backoff.Base < 0 || cfg.Processor.Backoff.Max < 0 {
+		return fmt.Errorf("outbox.processor.backoff must be non-negative")
+	}
+	if cfg.Processor.Backoff.Max > 0 && cfg.Processor.Backoff.Base > cfg.Processor.Backoff.Max {
+		return fmt.Errorf("outbox.processor.backoff.base must not exceed backoff.max")
+	}
+	if cfg.Partitions.Enabled {
+		if cfg.Partitions.Interval <= 0 {
+			return fmt.Errorf("outbox.partitions.interval must be positive")
+		}
+		if err := ValidateNonNegative(cfg.Partitions.Premake, "outbox.partitions.premake"); err != nil {
+			return err
+		}
+		if cfg.Partitions.Retention < 0 {
+			return fmt.Errorf("outbox.partitions.retention must be non-negative")
+		}
+	}
 	return nil
 }
 
@@ -122,6 +142,34 @@ func ValidateFriendRequestHandlerConfig(cfg FriendRequestHandlerConfig) error {
 	return nil
 }
 
+// ValidateFriendRequestsConfig валидирует FriendRequestsConfig
+func ValidateFriendRequestsConfig(cfg FriendRequestsConfig) error {
+	if cfg.DeclineCooldown < 0 || cfg.PendingTTL < 0 || cfg.ExpireInterval < 0 {
+		return fmt.Errorf("friend_requests durations must be non-negative")
+	}
+	if err := ValidateNonNegative(cfg.ExpireBatchSize, "friend_requests.expire_batch_size"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ValidateIdempotencyConfig валидирует IdempotencyConfig
+func ValidateIdempotencyConfig(cfg IdempotencyConfig) error {
+	if !cfg.Enabled {
+		return nil
+	}
+	if cfg.TTL <= 0 {
+		return fmt.Errorf("idempotency.ttl must be positive")
+	}
+	if cfg.LockTimeout <= 0 {
+		return fmt.Errorf("idempotency.lock_timeout must be positive")
+	}
+	if len(cfg.Methods) == 0 {
+		return fmt.Errorf("idempotency.methods is required")
+	}
+	return nil
+}
+
 // ValidateKafkaConsumerConfig валидирует KafkaConsumerConfig
 func ValidateKafkaConsumerConfig(cfg KafkaConsumerConfig) error {
 	if err := ValidateRequired(cfg.GetBrokers(), "kafka_consumer.brokers"); err != nil {
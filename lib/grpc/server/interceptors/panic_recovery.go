@@ -35,3 +35,28 @@ func PanicRecoveryUnaryInterceptor() grpc.UnaryServerInterceptor {
 		return handler(ctx, req)
 	}
 }
+
+// PanicRecoveryStreamInterceptor перехватывает panic в стриминговых обработчиках gRPC и возвращает Internal ошибку
+func PanicRecoveryStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) (err error) {
+		defer func() {
+			if v := recover(); v != nil {
+				logger.ErrorKV(ss.Context(), "recover panic",
+					"panic", v,
+					"stacktrace", string(debug.Stack()),
+					"operation", info.FullMethod,
+					"component", "middleware",
+				)
+
+				err = status.Error(codes.Internal, codes.Internal.String()) // return error
+			}
+		}()
+
+		return handler(srv, ss)
+	}
+}
@@ -0,0 +1,16 @@
+package hub
+
+import (
+	"context"
+)
+
+// DeliverFunc доставляет значение локальным подписчикам хаба
+type DeliverFunc[V any] func(v *V)
+
+// Backend транспорт рассылки значений между экземплярами Hub
+type Backend[V any] interface {
+	// Publish отправляет значение всем экземплярам Hub, подключенным к backend
+	Publish(ctx context.Context, v *V) error
+	// Run получает значения и передает их в deliver, блокируется до отмены ctx
+	Run(ctx context.Context, deliver DeliverFunc[V]) error
+}
@@ -0,0 +1,161 @@
+package hub
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/sskorolev/balun_microservices/lib/logger"
+)
+
+// DefaultBufferSize размер буфера подписчика по умолчанию
+const DefaultBufferSize = 64
+
+// KeyFunc возвращает ключ, по которому значение раздается подписчикам
+type KeyFunc[K comparable, V any] func(v *V) K
+
+// Hub хаб подписок на новые значения V, сгруппированных по ключу K
+// (например, сообщения по чатам или уведомления по получателям)
+//
+// Рассылка между репликами выполняется через Backend: Publish передает значение в backend,
+// а Run получает значения от backend и раздает их локальным подписчикам.
+//
+// Каждый подписчик получает собственный буферизированный канал. Если подписчик
+// не успевает вычитывать значения и его буфер переполнен, он отключается:
+// канал закрывается, а доставка никогда не блокируется на медленных клиентах.
+type Hub[K comparable, V any] struct {
+	backend Backend[V]
+	key     KeyFunc[K, V]
+
+	mu          sync.Mutex
+	subscribers map[K]map[*subscriber[V]]struct{}
+	bufferSize  int
+}
+
+type subscriber[V any] struct {
+	ch      chan *V
+	dropped chan struct{}
+}
+
+type options struct {
+	bufferSize int
+}
+
+// Option функциональная опция для Hub
+type Option func(*options)
+
+// WithBufferSize задает размер буфера каждого подписчика
+func WithBufferSize(size int) Option {
+	return func(o *options) {
+		if size > 0 {
+			o.bufferSize = size
+		}
+	}
+}
+
+// NewHub конструктор Hub, key определяет подписчиков, которым раздается значение
+func NewHub[K comparable, V any](backend Backend[V], key KeyFunc[K, V], opts ...Option) *Hub[K, V] {
+	o := options{bufferSize: DefaultBufferSize}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return &Hub[K, V]{
+		backend:     backend,
+		key:         key,
+		subscribers: make(map[K]map[*subscriber[V]]struct{}),
+		bufferSize:  o.bufferSize,
+	}
+}
+
+// Subscribe подписывает на новые значения с ключом key
+//
+// Канал закрывается при отмене ctx или при отключении медленного подписчика.
+func (h *Hub[K, V]) Subscribe(ctx context.Context, key K) <-chan *V {
+	sub := &subscriber[V]{
+		ch:      make(chan *V, h.bufferSize),
+		dropped: make(chan struct{}),
+	}
+
+	h.mu.Lock()
+	keySubscribers, ok := h.subscribers[key]
+	if !ok {
+		keySubscribers = make(map[*subscriber[V]]struct{})
+		h.subscribers[key] = keySubscribers
+	}
+	keySubscribers[sub] = struct{}{}
+	h.mu.Unlock()
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			h.mu.Lock()
+			h.removeLocked(key, sub)
+			h.mu.Unlock()
+		case <-sub.dropped:
+		}
+	}()
+
+	return sub.ch
+}
+
+// Publish передает значение в backend для рассылки подписчикам на всех репликах
+//
+// Если ctx содержит транзакцию, backend может участвовать в ней (см. PostgresBackend).
+func (h *Hub[K, V]) Publish(ctx context.Context, v *V) error {
+	if v == nil {
+		return nil
+	}
+
+	return h.backend.Publish(ctx, v)
+}
+
+// Run получает значения от backend и раздает их локальным подписчикам,
+// блокируется до отмены ctx
+func (h *Hub[K, V]) Run(ctx context.Context) error {
+	return h.backend.Run(ctx, h.deliver)
+}
+
+// deliver раздает значение всем локальным подписчикам его ключа
+func (h *Hub[K, V]) deliver(v *V) {
+	if v == nil {
+		return
+	}
+
+	key := h.key(v)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for sub := range h.subscribers[key] {
+		select {
+		case sub.ch <- v:
+		default:
+			// Буфер переполнен - отключаем подписчика, чтобы не задерживать остальных
+			logger.WarnKV(context.Background(), "dropping slow hub subscriber",
+				"key", fmt.Sprint(key),
+				"buffer_size", h.bufferSize,
+			)
+			h.removeLocked(key, sub)
+			close(sub.dropped)
+		}
+	}
+}
+
+// removeLocked удаляет подписчика и закрывает его канал, вызывается под h.mu
+func (h *Hub[K, V]) removeLocked(key K, sub *subscriber[V]) {
+	keySubscribers, ok := h.subscribers[key]
+	if !ok {
+		return
+	}
+	if _, ok := keySubscribers[sub]; !ok {
+		return
+	}
+
+	delete(keySubscribers, sub)
+	close(sub.ch)
+
+	if len(keySubscribers) == 0 {
+		delete(h.subscribers, key)
+	}
+}
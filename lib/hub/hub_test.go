@@ -0,0 +1,109 @@
+package hub
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type event struct {
+	ID    string
+	Topic string
+}
+
+func runHub(t *testing.T, opts ...Option) (*Hub[string, event], context.Context) {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	h := NewHub(NewInProcessBackend[event](), func(e *event) string { return e.Topic }, opts...)
+	go func() {
+		_ = h.Run(ctx)
+	}()
+
+	return h, ctx
+}
+
+func receive(t *testing.T, ch <-chan *event) (*event, bool) {
+	t.Helper()
+
+	select {
+	case e, ok := <-ch:
+		return e, ok
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for event")
+		return nil, false
+	}
+}
+
+func TestHub_Publish(t *testing.T) {
+	h, ctx := runHub(t)
+
+	sub1 := h.Subscribe(ctx, "topic-1")
+	sub2 := h.Subscribe(ctx, "topic-1")
+	other := h.Subscribe(ctx, "topic-2")
+
+	e := &event{ID: "e1", Topic: "topic-1"}
+	require.NoError(t, h.Publish(ctx, e))
+
+	got, ok := receive(t, sub1)
+	require.True(t, ok)
+	assert.Equal(t, e, got)
+
+	got, ok = receive(t, sub2)
+	require.True(t, ok)
+	assert.Equal(t, e, got)
+
+	select {
+	case got := <-other:
+		t.Fatalf("подписчик другого ключа получил значение: %v", got)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestHub_DropsSlowSubscriber(t *testing.T) {
+	h, ctx := runHub(t, WithBufferSize(1))
+
+	slow := h.Subscribe(ctx, "topic-1")
+
+	require.NoError(t, h.Publish(ctx, &event{ID: "e1", Topic: "topic-1"}))
+	require.NoError(t, h.Publish(ctx, &event{ID: "e2", Topic: "topic-1"}))
+
+	require.Eventually(t, func() bool {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		return len(h.subscribers) == 0
+	}, time.Second, 10*time.Millisecond)
+
+	got, ok := receive(t, slow)
+	require.True(t, ok)
+	assert.Equal(t, "e1", got.ID)
+
+	_, ok = receive(t, slow)
+	assert.False(t, ok, "канал медленного подписчика должен быть закрыт")
+}
+
+func TestHub_UnsubscribeOnContextCancel(t *testing.T) {
+	h, ctx := runHub(t)
+
+	subCtx, cancel := context.WithCancel(ctx)
+	sub := h.Subscribe(subCtx, "topic-1")
+	cancel()
+
+	_, ok := receive(t, sub)
+	assert.False(t, ok)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	assert.Empty(t, h.subscribers)
+}
+
+func TestHub_PublishNil(t *testing.T) {
+	h, ctx := runHub(t)
+
+	require.NoError(t, h.Publish(ctx, nil))
+}
@@ -0,0 +1,47 @@
+package hub
+
+import (
+	"context"
+)
+
+// inProcessQueueSize размер очереди InProcessBackend
+const inProcessQueueSize = 1024
+
+// Проверка удовлетворению интерфейсу Backend
+var _ Backend[struct{}] = (*InProcessBackend[struct{}])(nil)
+
+// InProcessBackend backend в пределах одного процесса, используется в тестах и при одной реплике
+//
+// Значение доставляется сразу после Publish, независимо от исхода транзакции вызывающего.
+type InProcessBackend[V any] struct {
+	queue chan *V
+}
+
+// NewInProcessBackend конструктор InProcessBackend
+func NewInProcessBackend[V any]() *InProcessBackend[V] {
+	return &InProcessBackend[V]{
+		queue: make(chan *V, inProcessQueueSize),
+	}
+}
+
+// Publish ставит значение в очередь доставки
+func (b *InProcessBackend[V]) Publish(ctx context.Context, v *V) error {
+	select {
+	case b.queue <- v:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Run доставляет значения из очереди, блокируется до отмены ctx
+func (b *InProcessBackend[V]) Run(ctx context.Context, deliver DeliverFunc[V]) error {
+	for {
+		select {
+		case v := <-b.queue:
+			deliver(v)
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package hub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/sskorolev/balun_microservices/lib/logger"
+	"github.com/sskorolev/balun_microservices/lib/postgres"
+)
+
+// DefaultReconnectInterval пауза перед повторной подпиской после обрыва соединения
+const DefaultReconnectInterval = time.Second
+
+// Проверка удовлетворению интерфейсу Backend
+var _ Backend[struct{}] = (*PostgresBackend[struct{}])(nil)
+
+// Codec кодирует значение в payload pg_notify и обратно
+//
+// Размер payload pg_notify ограничен 8000 байт.
+type Codec[V any] struct {
+	Encode func(v *V) ([]byte, error)
+	Decode func(payload []byte) (*V, error)
+}
+
+// PostgresBackend backend на основе Postgres LISTEN/NOTIFY
+//
+// Publish вызывает pg_notify через QueryEngine из контекста: если вызов выполняется внутри
+// транзакции, значение будет доставлено только после ее COMMIT и не будет доставлено при ROLLBACK.
+// Значения, отправленные во время переподключения Run, теряются.
+type PostgresBackend[V any] struct {
+	conn              *postgres.Connection
+	tm                postgres.TransactionManagerAPI
+	channel           string
+	codec             Codec[V]
+	reconnectInterval time.Duration
+}
+
+type postgresBackendOptions struct {
+	reconnectInterval time.Duration
+}
+
+// PostgresBackendOption функциональная опция для PostgresBackend
+type PostgresBackendOption func(*postgresBackendOptions)
+
+// WithReconnectInterval задает паузу перед повторной подпиской
+func WithReconnectInterval(interval time.Duration) PostgresBackendOption {
+	return func(o *postgresBackendOptions) {
+		if interval > 0 {
+			o.reconnectInterval = interval
+		}
+	}
+}
+
+// NewPostgresBackend конструктор PostgresBackend, channel - канал LISTEN/NOTIFY
+func NewPostgresBackend[V any](
+	conn *postgres.Connection,
+	tm postgres.TransactionManagerAPI,
+	channel string,
+	codec Codec[V],
+	opts ...PostgresBackendOption,
+) *PostgresBackend[V] {
+	o := postgresBackendOptions{reconnectInterval: DefaultReconnectInterval}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return &PostgresBackend[V]{
+		conn:              conn,
+		tm:                tm,
+		channel:           channel,
+		codec:             codec,
+		reconnectInterval: o.reconnectInterval,
+	}
+}
+
+// Publish отправляет pg_notify, участвуя в транзакции из ctx, если она есть
+func (b *PostgresBackend[V]) Publish(ctx context.Context, v *V) error {
+	const api = "[PostgresBackend][Publish]"
+
+	payload, err := b.codec.Encode(v)
+	if err != nil {
+		return fmt.Errorf("%s: encode payload: %w", api, err)
+	}
+
+	conn := b.tm.GetQueryEngine(ctx)
+	if _, err := conn.Exec(ctx, "SELECT pg_notify($1, $2)", b.channel, string(payload)); err != nil {
+		return fmt.Errorf("%s: %w", api, postgres.ConvertPGError(err))
+	}
+
+	return nil
+}
+
+// Run слушает канал и передает значения в deliver, переподписываясь при обрыве соединения
+func (b *PostgresBackend[V]) Run(ctx context.Context, deliver DeliverFunc[V]) error {
+	for {
+		err := b.conn.Listen(ctx, b.channel, func(ctx context.Context, n postgres.Notification) {
+			v, err := b.codec.Decode([]byte(n.Payload))
+			if err != nil {
+				logger.ErrorKV(ctx, "failed to decode hub notification",
+					"channel", n.Channel,
+					"error", err.Error(),
+				)
+				return
+			}
+
+			deliver(v)
+		})
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err != nil && !errors.Is(err, context.Canceled) {
+			logger.ErrorKV(ctx, "hub listener failed, reconnecting",
+				"channel", b.channel,
+				"error", err.Error(),
+			)
+		}
+
+		select {
+		case <-time.After(b.reconnectInterval):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
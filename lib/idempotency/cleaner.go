@@ -0,0 +1,37 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+
+	"github.com/sskorolev/balun_microservices/lib/logger"
+)
+
+// DefaultCleanupInterval интервал очистки истекших записей по умолчанию
+const DefaultCleanupInterval = 10 * time.Minute
+
+// RunCleaner периодически удаляет истекшие записи до отмены контекста
+func RunCleaner(ctx context.Context, store Store, interval time.Duration) error {
+	if interval <= 0 {
+		interval = DefaultCleanupInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			deleted, err := store.DeleteExpired(ctx)
+			if err != nil {
+				logger.ErrorKV(ctx, "idempotency: delete expired keys", "error", err.Error())
+				continue
+			}
+			if deleted > 0 {
+				logger.InfoKV(ctx, "idempotency: expired keys deleted", "count", deleted)
+			}
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+var (
+	// ErrRecordNotFound запись по ключу отсутствует или зарезервирована другим обработчиком
+	ErrRecordNotFound = errors.New("idempotency record not found")
+)
+
+// Status состояние обработки запроса с idempotency-key
+type Status string
+
+const (
+	// StatusInProgress запрос обрабатывается
+	StatusInProgress Status = "in_progress"
+	// StatusCompleted запрос обработан, ответ сохранен
+	StatusCompleted Status = "completed"
+)
+
+// Key идентификатор записи идемпотентности
+type Key struct {
+	// Subject владелец ключа (user_id из JWT), чтобы ключи разных пользователей не пересекались
+	Subject string
+	// Method полное имя gRPC метода
+	Method string
+	// Key значение заголовка idempotency-key
+	Key string
+}
+
+// Record сохраненное состояние запроса
+type Record struct {
+	Key
+	// Fingerprint отпечаток тела запроса
+	Fingerprint string
+	Status      Status
+	// ResponseType полное имя proto сообщения ответа
+	ResponseType string
+	// Response сериализованный ответ
+	Response  []byte
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+// Store хранилище записей идемпотентности
+type Store interface {
+	// Acquire резервирует ключ под обработку запроса
+	//
+	// Возвращает непустой owner, если ключ свободен, истек или удерживается упавшим обработчиком дольше lockTimeout.
+	// Иначе возвращает текущую запись.
+	Acquire(ctx context.Context, key Key, fingerprint string, ttl, lockTimeout time.Duration) (existing *Record, owner string, err error)
+	// Complete сохраняет ответ и продлевает запись на ttl, если резерв все еще принадлежит owner
+	Complete(ctx context.Context, key Key, owner, responseType string, response []byte, ttl time.Duration) error
+	// Release снимает резерв owner, чтобы повтор запроса выполнился заново
+	//
+	// Резерв, перехваченный повтором после lockTimeout, не снимается.
+	Release(ctx context.Context, key Key, owner string) error
+	// DeleteExpired удаляет истекшие записи, возвращает количество удаленных
+	DeleteExpired(ctx context.Context) (int64, error)
+}
+
+// Fingerprint вычисляет отпечаток запроса: sha256 от имени метода и детерминированной сериализации тела
+func Fingerprint(method string, req any) (string, error) {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return "", fmt.Errorf("request %T is not a proto message", req)
+	}
+
+	body, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return "", fmt.Errorf("marshal request: %w", err)
+	}
+
+	h := sha256.New()
+	h.Write([]byte(method))
+	h.Write([]byte{0})
+	h.Write(body)
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// encodeResponse сериализует ответ для сохранения
+func encodeResponse(resp any) (string, []byte, error) {
+	msg, ok := resp.(proto.Message)
+	if !ok {
+		return "", nil, fmt.Errorf("response %T is not a proto message", resp)
+	}
+
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return "", nil, fmt.Errorf("marshal response: %w", err)
+	}
+
+	return string(proto.MessageName(msg)), data, nil
+}
+
+// decodeResponse восстанавливает сохраненный ответ по имени его типа
+func decodeResponse(responseType string, data []byte) (proto.Message, error) {
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(responseType))
+	if err != nil {
+		return nil, fmt.Errorf("find response type %s: %w", responseType, err)
+	}
+
+	msg := mt.New().Interface()
+	if err := proto.Unmarshal(data, msg); err != nil {
+		return nil, fmt.Errorf("unmarshal response: %w", err)
+	}
+
+	return msg, nil
+}
@@ -0,0 +1,183 @@
+package idempotency
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/sskorolev/balun_microservices/lib/logger"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// MetadataKey ключ метаданных с idempotency-key
+	MetadataKey = "idempotency-key"
+	// ReplayedHeader заголовок ответа, выставляемый при возврате сохраненного ответа
+	ReplayedHeader = "idempotency-replayed"
+
+	// DefaultTTL время хранения ответа по умолчанию
+	DefaultTTL = 24 * time.Hour
+	// DefaultLockTimeout время, после которого незавершенная обработка считается упавшей
+	DefaultLockTimeout = time.Minute
+	// maxKeyLength максимальная длина idempotency-key
+	maxKeyLength = 255
+)
+
+// SubjectFunc извлекает владельца ключа из контекста запроса
+type SubjectFunc func(ctx context.Context) (string, bool)
+
+type interceptorConfig struct {
+	ttl         time.Duration
+	lockTimeout time.Duration
+	methods     map[string]struct{}
+	subject     SubjectFunc
+}
+
+// Option опция интерсептора
+type Option func(*interceptorConfig)
+
+// WithTTL время хранения ответа
+func WithTTL(ttl time.Duration) Option {
+	return func(c *interceptorConfig) {
+		c.ttl = ttl
+	}
+}
+
+// WithLockTimeout время, после которого незавершенная обработка ключа может быть перехвачена повтором
+func WithLockTimeout(timeout time.Duration) Option {
+	return func(c *interceptorConfig) {
+		c.lockTimeout = timeout
+	}
+}
+
+// WithMethods полные имена gRPC методов, для которых включена идемпотентность
+func WithMethods(methods ...string) Option {
+	return func(c *interceptorConfig) {
+		for _, method := range methods {
+			c.methods[method] = struct{}{}
+		}
+	}
+}
+
+// WithSubject функция извлечения владельца ключа, например authmw.GetUserID
+//
+// Интерсептор должен стоять в цепочке после интерсептора аутентификации.
+func WithSubject(fn SubjectFunc) Option {
+	return func(c *interceptorConfig) {
+		c.subject = fn
+	}
+}
+
+// UnaryServerInterceptor возвращает интерсептор, обеспечивающий идемпотентность выбранных методов
+//
+// Запросы без метаданных idempotency-key и методы вне списка обрабатываются как обычно.
+// Повтор с тем же ключом и телом возвращает сохраненный ответ, с другим телом - codes.AlreadyExists,
+// повтор во время обработки первого запроса - codes.Aborted.
+// Ответ сохраняется только при успешной обработке: после ошибки ключ освобождается.
+func UnaryServerInterceptor(store Store, opts ...Option) grpc.UnaryServerInterceptor {
+	cfg := &interceptorConfig{
+		ttl:         DefaultTTL,
+		lockTimeout: DefaultLockTimeout,
+		methods:     make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if _, ok := cfg.methods[info.FullMethod]; !ok {
+			return handler(ctx, req)
+		}
+
+		idempotencyKey, ok := keyFromContext(ctx)
+		if !ok {
+			return handler(ctx, req)
+		}
+		if len(idempotencyKey) > maxKeyLength {
+			return nil, status.Errorf(codes.InvalidArgument, "%s is too long", MetadataKey)
+		}
+
+		key := Key{Method: info.FullMethod, Key: idempotencyKey}
+		if cfg.subject != nil {
+			key.Subject, _ = cfg.subject(ctx)
+		}
+
+		fingerprint, err := Fingerprint(info.FullMethod, req)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		record, owner, err := store.Acquire(ctx, key, fingerprint, cfg.ttl, cfg.lockTimeout)
+		if err != nil {
+			logger.ErrorKV(ctx, "idempotency: acquire key", "method", info.FullMethod, "error", err.Error())
+			return nil, status.Error(codes.Unavailable, "idempotency store is unavailable")
+		}
+
+		if owner == "" {
+			return replay(ctx, record, fingerprint)
+		}
+
+		resp, handlerErr := handler(ctx, req)
+
+		// Сохранение не должно зависеть от отмены контекста клиентом
+		storeCtx := context.WithoutCancel(ctx)
+
+		if handlerErr != nil {
+			if err := store.Release(storeCtx, key, owner); err != nil {
+				logger.ErrorKV(ctx, "idempotency: release key", "method", info.FullMethod, "error", err.Error())
+			}
+			return nil, handlerErr
+		}
+
+		responseType, data, err := encodeResponse(resp)
+		if err == nil {
+			err = store.Complete(storeCtx, key, owner, responseType, data, cfg.ttl)
+		}
+		if err != nil {
+			// Запрос уже выполнен - отдаем ответ, повтор после lockTimeout выполнится заново
+			logger.ErrorKV(ctx, "idempotency: save response", "method", info.FullMethod, "error", err.Error())
+		}
+
+		return resp, nil
+	}
+}
+
+// replay обрабатывает повтор запроса с уже занятым ключом
+func replay(ctx context.Context, record *Record, fingerprint string) (any, error) {
+	if record.Fingerprint != fingerprint {
+		return nil, status.Errorf(codes.AlreadyExists, "%s was already used with a different request", MetadataKey)
+	}
+
+	if record.Status != StatusCompleted {
+		return nil, status.Errorf(codes.Aborted, "request with this %s is in progress", MetadataKey)
+	}
+
+	resp, err := decodeResponse(record.ResponseType, record.Response)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	_ = grpc.SetHeader(ctx, metadata.Pairs(ReplayedHeader, "true"))
+
+	return resp, nil
+}
+
+// keyFromContext извлекает idempotency-key из входящих метаданных
+func keyFromContext(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+
+	values := md.Get(MetadataKey)
+	if len(values) == 0 {
+		return "", false
+	}
+
+	key := strings.TrimSpace(values[0])
+	return key, key != ""
+}
@@ -0,0 +1,269 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const testMethod = "/test.Service/Do"
+
+// memoryRecord запись memoryStore с владельцем резерва
+type memoryRecord struct {
+	Record
+	owner     string
+	updatedAt time.Time
+}
+
+// memoryStore Store в памяти для тестов, повторяет правила перехвата PostgresStore
+type memoryStore struct {
+	mu      sync.Mutex
+	records map[Key]*memoryRecord
+	owners  int
+	// now текущее время, тесты сдвигают его, чтобы истек lockTimeout
+	now time.Time
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{records: make(map[Key]*memoryRecord), now: time.Now()}
+}
+
+// advance сдвигает текущее время хранилища
+func (s *memoryStore) advance(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.now = s.now.Add(d)
+}
+
+func (s *memoryStore) Acquire(_ context.Context, key Key, fingerprint string, ttl, lockTimeout time.Duration) (*Record, string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if record, ok := s.records[key]; ok {
+		stale := record.Status == StatusInProgress && record.updatedAt.Before(s.now.Add(-lockTimeout))
+		if !stale && !record.ExpiresAt.Before(s.now) {
+			copied := record.Record
+			return &copied, "", nil
+		}
+	}
+
+	s.owners++
+	owner := fmt.Sprintf("owner-%d", s.owners)
+	s.records[key] = &memoryRecord{
+		Record:    Record{Key: key, Fingerprint: fingerprint, Status: StatusInProgress, ExpiresAt: s.now.Add(ttl)},
+		owner:     owner,
+		updatedAt: s.now,
+	}
+	return nil, owner, nil
+}
+
+func (s *memoryStore) Complete(_ context.Context, key Key, owner, responseType string, response []byte, _ time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	record, ok := s.records[key]
+	if !ok || record.Status != StatusInProgress || record.owner != owner {
+		return ErrRecordNotFound
+	}
+	record.Status = StatusCompleted
+	record.ResponseType = responseType
+	record.Response = response
+	record.updatedAt = s.now
+	return nil
+}
+
+func (s *memoryStore) Release(_ context.Context, key Key, owner string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if record, ok := s.records[key]; ok && record.Status == StatusInProgress && record.owner == owner {
+		delete(s.records, key)
+	}
+	return nil
+}
+
+func (s *memoryStore) DeleteExpired(context.Context) (int64, error) {
+	return 0, nil
+}
+
+func callWithKey(t *testing.T, interceptor grpc.UnaryServerInterceptor, key string, req proto.Message, handler grpc.UnaryHandler) (any, error) {
+	t.Helper()
+
+	ctx := context.Background()
+	if key != "" {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(MetadataKey, key))
+	}
+	return interceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: testMethod}, handler)
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	t.Run("повтор с тем же телом возвращает сохраненный ответ", func(t *testing.T) {
+		interceptor := UnaryServerInterceptor(newMemoryStore(), WithMethods(testMethod))
+
+		calls := 0
+		handler := func(context.Context, any) (any, error) {
+			calls++
+			return wrapperspb.String("created"), nil
+		}
+
+		first, err := callWithKey(t, interceptor, "key-1", wrapperspb.String("body"), handler)
+		require.NoError(t, err)
+
+		second, err := callWithKey(t, interceptor, "key-1", wrapperspb.String("body"), handler)
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, calls)
+		assert.True(t, proto.Equal(first.(proto.Message), second.(proto.Message)))
+	})
+
+	t.Run("повтор с другим телом возвращает конфликт", func(t *testing.T) {
+		interceptor := UnaryServerInterceptor(newMemoryStore(), WithMethods(testMethod))
+		handler := func(context.Context, any) (any, error) {
+			return wrapperspb.String("created"), nil
+		}
+
+		_, err := callWithKey(t, interceptor, "key-1", wrapperspb.String("body"), handler)
+		require.NoError(t, err)
+
+		_, err = callWithKey(t, interceptor, "key-1", wrapperspb.String("other body"), handler)
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	})
+
+	t.Run("после ошибки обработчика ключ освобождается", func(t *testing.T) {
+		interceptor := UnaryServerInterceptor(newMemoryStore(), WithMethods(testMethod))
+
+		calls := 0
+		handler := func(context.Context, any) (any, error) {
+			calls++
+			if calls == 1 {
+				return nil, errors.New("boom")
+			}
+			return wrapperspb.String("created"), nil
+		}
+
+		_, err := callWithKey(t, interceptor, "key-1", wrapperspb.String("body"), handler)
+		require.Error(t, err)
+
+		_, err = callWithKey(t, interceptor, "key-1", wrapperspb.String("body"), handler)
+		require.NoError(t, err)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("запрос без ключа не кешируется", func(t *testing.T) {
+		interceptor := UnaryServerInterceptor(newMemoryStore(), WithMethods(testMethod))
+
+		calls := 0
+		handler := func(context.Context, any) (any, error) {
+			calls++
+			return wrapperspb.String("created"), nil
+		}
+
+		for i := 0; i < 2; i++ {
+			_, err := callWithKey(t, interceptor, "", wrapperspb.String("body"), handler)
+			require.NoError(t, err)
+		}
+		assert.Equal(t, 2, calls)
+	})
+	t.Run("ошибка обработчика после перехвата ключа не снимает чужой резерв", func(t *testing.T) {
+		store := newMemoryStore()
+		interceptor := UnaryServerInterceptor(store, WithMethods(testMethod), WithLockTimeout(time.Minute))
+
+		var calls atomic.Int32
+		retryStarted := make(chan struct{})
+		retryFinish := make(chan struct{})
+		retryDone := make(chan error, 1)
+
+		// Первый запрос завис дольше lockTimeout, повтор перехватил ключ, затем первый упал
+		_, err := callWithKey(t, interceptor, "key-1", wrapperspb.String("body"), func(context.Context, any) (any, error) {
+			calls.Add(1)
+			store.advance(2 * time.Minute)
+
+			go func() {
+				_, err := callWithKey(t, interceptor, "key-1", wrapperspb.String("body"), func(context.Context, any) (any, error) {
+					calls.Add(1)
+					close(retryStarted)
+					<-retryFinish
+					return wrapperspb.String("retry"), nil
+				})
+				retryDone <- err
+			}()
+			<-retryStarted
+
+			return nil, errors.New("timeout")
+		})
+		require.Error(t, err)
+
+		// Резерв повтора на месте: третий запрос не выполняется
+		_, err = callWithKey(t, interceptor, "key-1", wrapperspb.String("body"), func(context.Context, any) (any, error) {
+			calls.Add(1)
+			return wrapperspb.String("third"), nil
+		})
+		assert.Equal(t, codes.Aborted, status.Code(err))
+
+		close(retryFinish)
+		require.NoError(t, <-retryDone)
+
+		resp, err := callWithKey(t, interceptor, "key-1", wrapperspb.String("body"), func(context.Context, any) (any, error) {
+			calls.Add(1)
+			return wrapperspb.String("fourth"), nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "retry", resp.(*wrapperspb.StringValue).GetValue())
+		assert.Equal(t, int32(2), calls.Load())
+	})
+
+	t.Run("успех после перехвата ключа не перезаписывает резерв повтора", func(t *testing.T) {
+		store := newMemoryStore()
+		interceptor := UnaryServerInterceptor(store, WithMethods(testMethod), WithLockTimeout(time.Minute))
+
+		retryStarted := make(chan struct{})
+		retryFinish := make(chan struct{})
+		retryDone := make(chan error, 1)
+
+		_, err := callWithKey(t, interceptor, "key-1", wrapperspb.String("body"), func(context.Context, any) (any, error) {
+			store.advance(2 * time.Minute)
+
+			go func() {
+				_, err := callWithKey(t, interceptor, "key-1", wrapperspb.String("body"), func(context.Context, any) (any, error) {
+					close(retryStarted)
+					<-retryFinish
+					return wrapperspb.String("retry"), nil
+				})
+				retryDone <- err
+			}()
+			<-retryStarted
+
+			return wrapperspb.String("original"), nil
+		})
+		require.NoError(t, err)
+
+		// Ответ первого обработчика не сохранен поверх резерва повтора
+		_, err = callWithKey(t, interceptor, "key-1", wrapperspb.String("body"), func(context.Context, any) (any, error) {
+			return wrapperspb.String("third"), nil
+		})
+		assert.Equal(t, codes.Aborted, status.Code(err))
+
+		close(retryFinish)
+		require.NoError(t, <-retryDone)
+
+		resp, err := callWithKey(t, interceptor, "key-1", wrapperspb.String("body"), func(context.Context, any) (any, error) {
+			return wrapperspb.String("fourth"), nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "retry", resp.(*wrapperspb.StringValue).GetValue())
+	})
+}
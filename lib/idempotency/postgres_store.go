@@ -0,0 +1,163 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/sskorolev/balun_microservices/lib/postgres"
+)
+
+// acquireAttempts количество попыток Acquire при гонке с Release
+const acquireAttempts = 3
+
+// Проверка удовлетворению интерфейсу Store
+var _ Store = (*PostgresStore)(nil)
+
+// PostgresStore хранилище записей идемпотентности в таблице public.idempotency_keys
+//
+// Схема таблицы приведена в README.md, миграцию нужно добавить в каждый сервис, использующий Store.
+// Запросы выполняются вне транзакций вызывающего кода: запись должна пережить ROLLBACK обработчика.
+type PostgresStore struct {
+	conn postgres.PgxCommonAPI
+}
+
+// NewPostgresStore конструктор PostgresStore
+func NewPostgresStore(conn postgres.PgxCommonAPI) *PostgresStore {
+	return &PostgresStore{conn: conn}
+}
+
+const acquireQuery = `
+INSERT INTO public.idempotency_keys (subject, method, key, fingerprint, status, owner, created_at, updated_at, expires_at)
+VALUES ($1, $2, $3, $4, $5, $8, now(), now(), now() + make_interval(secs => $6))
+ON CONFLICT (subject, method, key) DO UPDATE
+SET fingerprint   = EXCLUDED.fingerprint,
+    status        = EXCLUDED.status,
+    owner         = EXCLUDED.owner,
+    response_type = NULL,
+    response      = NULL,
+    created_at    = EXCLUDED.created_at,
+    updated_at    = EXCLUDED.updated_at,
+    expires_at    = EXCLUDED.expires_at
+WHERE idempotency_keys.expires_at < now()
+   OR (idempotency_keys.status = $5 AND idempotency_keys.updated_at < now() - make_interval(secs => $7))
+RETURNING true`
+
+const getQuery = `
+SELECT fingerprint, status, COALESCE(response_type, ''), response, created_at, expires_at
+FROM public.idempotency_keys
+WHERE subject = $1 AND method = $2 AND key = $3`
+
+// Acquire резервирует ключ под обработку запроса
+//
+// Каждый резерв получает новый owner, по которому Complete и Release отличают его от резерва,
+// перехваченного повтором после lockTimeout.
+func (s *PostgresStore) Acquire(ctx context.Context, key Key, fingerprint string, ttl, lockTimeout time.Duration) (*Record, string, error) {
+	const api = "[PostgresStore][Acquire]"
+
+	owner := uuid.NewString()
+	for attempt := 0; attempt < acquireAttempts; attempt++ {
+		var acquired bool
+		err := s.conn.QueryRow(ctx, acquireQuery,
+			key.Subject, key.Method, key.Key, fingerprint, string(StatusInProgress),
+			ttl.Seconds(), lockTimeout.Seconds(), owner,
+		).Scan(&acquired)
+		if err == nil {
+			return nil, owner, nil
+		}
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return nil, "", fmt.Errorf("%s: %w", api, postgres.ConvertPGError(err))
+		}
+
+		// Ключ занят - читаем текущую запись
+		record, err := s.get(ctx, key)
+		if errors.Is(err, ErrRecordNotFound) {
+			// Запись удалили между INSERT и SELECT (Release или очистка) - пробуем снова
+			continue
+		}
+		if err != nil {
+			return nil, "", fmt.Errorf("%s: %w", api, err)
+		}
+
+		return record, "", nil
+	}
+
+	return nil, "", fmt.Errorf("%s: key is contended, attempts exhausted", api)
+}
+
+func (s *PostgresStore) get(ctx context.Context, key Key) (*Record, error) {
+	record := &Record{Key: key}
+	var status string
+
+	err := s.conn.QueryRow(ctx, getQuery, key.Subject, key.Method, key.Key).Scan(
+		&record.Fingerprint,
+		&status,
+		&record.ResponseType,
+		&record.Response,
+		&record.CreatedAt,
+		&record.ExpiresAt,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrRecordNotFound
+	}
+	if err != nil {
+		return nil, postgres.ConvertPGError(err)
+	}
+
+	record.Status = Status(status)
+	return record, nil
+}
+
+// Complete сохраняет ответ и продлевает запись на ttl, если резерв все еще принадлежит owner
+func (s *PostgresStore) Complete(ctx context.Context, key Key, owner, responseType string, response []byte, ttl time.Duration) error {
+	const api = "[PostgresStore][Complete]"
+
+	tag, err := s.conn.Exec(ctx, `
+UPDATE public.idempotency_keys
+SET status = $4, response_type = $5, response = $6, updated_at = now(), expires_at = now() + make_interval(secs => $7)
+WHERE subject = $1 AND method = $2 AND key = $3 AND status = $8 AND owner = $9`,
+		key.Subject, key.Method, key.Key,
+		string(StatusCompleted), responseType, response, ttl.Seconds(),
+		string(StatusInProgress), owner,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", api, postgres.ConvertPGError(err))
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%s: %w", api, ErrRecordNotFound)
+	}
+
+	return nil
+}
+
+// Release снимает резерв owner, чтобы повтор запроса выполнился заново
+func (s *PostgresStore) Release(ctx context.Context, key Key, owner string) error {
+	const api = "[PostgresStore][Release]"
+
+	_, err := s.conn.Exec(ctx, `
+DELETE FROM public.idempotency_keys
+WHERE subject = $1 AND method = $2 AND key = $3 AND status = $4 AND owner = $5`,
+		key.Subject, key.Method, key.Key, string(StatusInProgress), owner,
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", api, postgres.ConvertPGError(err))
+	}
+
+	return nil
+}
+
+// DeleteExpired удаляет истекшие записи
+func (s *PostgresStore) DeleteExpired(ctx context.Context) (int64, error) {
+	const api = "[PostgresStore][DeleteExpired]"
+
+	tag, err := s.conn.Exec(ctx, `DELETE FROM public.idempotency_keys WHERE expires_at < now()`)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", api, postgres.ConvertPGError(err))
+	}
+
+	return tag.RowsAffected(), nil
+}
@@ -143,3 +143,26 @@ func IncRequests(method string) {
 	}
 	ms.requestsCount.WithLabelValues(serviceName, method).Inc()
 }
+
+// Register регистрирует метрики сервиса в registry
+//
+// Если метрики не инициализированы, ничего не делает: счетчики продолжают работать, но не экспортируются.
+func Register(collectors ...prometheus.Collector) error {
+	if !initialized {
+		return nil
+	}
+	for _, c := range collectors {
+		if err := registry.Register(c); err != nil {
+			return fmt.Errorf("failed to register collector: %w", err)
+		}
+	}
+	return nil
+}
+
+// Namespace возвращает namespace метрик сервиса
+func Namespace() string {
+	if namespace == "" {
+		return "balun_courses"
+	}
+	return namespace
+}
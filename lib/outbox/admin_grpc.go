@@ -0,0 +1,141 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sskorolev/balun_microservices/lib/logger"
+	"github.com/sskorolev/balun_microservices/lib/outbox/adminpb"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Проверка удовлетворению интерфейсу adminpb.OutboxAdminServiceServer
+var _ adminpb.OutboxAdminServiceServer = (*AdminServer)(nil)
+
+// AdminServer gRPC сервис разбора dead-letter событий
+type AdminServer struct {
+	adminpb.UnimplementedOutboxAdminServiceServer
+
+	store DeadLetterStore
+}
+
+// NewAdminServer конструктор AdminServer
+func NewAdminServer(store DeadLetterStore) *AdminServer {
+	return &AdminServer{store: store}
+}
+
+// ListDeadLetters список dead-letter событий от новых к старым
+func (s *AdminServer) ListDeadLetters(ctx context.Context, req *adminpb.ListDeadLettersRequest) (*adminpb.ListDeadLettersResponse, error) {
+	filter := DeadLetterFilter{Limit: int(req.GetLimit())}
+	if req.AggregateType != nil {
+		t := AggregateType(req.GetAggregateType())
+		filter.AggregateType = &t
+	}
+	if req.EventType != nil {
+		t := EventType(req.GetEventType())
+		filter.EventType = &t
+	}
+	if req.Cursor != nil {
+		cursor, err := uuid.Parse(req.GetCursor())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid cursor")
+		}
+		filter.Cursor = &cursor
+	}
+
+	events, err := s.store.ListDeadLetters(ctx, filter)
+	if err != nil {
+		return nil, adminStatus(ctx, err)
+	}
+
+	resp := &adminpb.ListDeadLettersResponse{Events: make([]*adminpb.DeadLetter, 0, len(events))}
+	for _, e := range events {
+		resp.Events = append(resp.Events, toDeadLetterPb(e))
+	}
+	if len(events) > 0 && len(events) == normalizeLimit(filter.Limit) {
+		next := events[len(events)-1].ID.String()
+		resp.NextCursor = &next
+	}
+	return resp, nil
+}
+
+// GetDeadLetter событие по id
+func (s *AdminServer) GetDeadLetter(ctx context.Context, req *adminpb.GetDeadLetterRequest) (*adminpb.DeadLetter, error) {
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid event id")
+	}
+
+	e, err := s.store.GetEvent(ctx, id)
+	if err != nil {
+		return nil, adminStatus(ctx, err)
+	}
+	return toDeadLetterPb(e), nil
+}
+
+// RequeueDeadLetters возвращает события в очередь публикации
+func (s *AdminServer) RequeueDeadLetters(ctx context.Context, req *adminpb.DeadLetterIDsRequest) (*adminpb.DeadLetterActionResponse, error) {
+	return s.apply(ctx, req, s.store.RequeueDeadLetters)
+}
+
+// DiscardDeadLetters удаляет события из outbox
+func (s *AdminServer) DiscardDeadLetters(ctx context.Context, req *adminpb.DeadLetterIDsRequest) (*adminpb.DeadLetterActionResponse, error) {
+	return s.apply(ctx, req, s.store.DiscardDeadLetters)
+}
+
+func (s *AdminServer) apply(
+	ctx context.Context,
+	req *adminpb.DeadLetterIDsRequest,
+	action func(ctx context.Context, ids ...uuid.UUID) (int64, error),
+) (*adminpb.DeadLetterActionResponse, error) {
+	if len(req.GetIds()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "ids is required")
+	}
+
+	ids := make([]uuid.UUID, 0, len(req.GetIds()))
+	for _, raw := range req.GetIds() {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid event id %q", raw)
+		}
+		ids = append(ids, id)
+	}
+
+	affected, err := action(ctx, ids...)
+	if err != nil {
+		return nil, adminStatus(ctx, err)
+	}
+	return &adminpb.DeadLetterActionResponse{Affected: affected}, nil
+}
+
+func toDeadLetterPb(e *Event) *adminpb.DeadLetter {
+	res := &adminpb.DeadLetter{
+		Id:            e.ID.String(),
+		AggregateType: string(e.AggregateType),
+		AggregateId:   e.AggregateID,
+		EventType:     string(e.EventType),
+		Payload:       string(e.Payload),
+		CreatedAt:     timestamppb.New(e.CreatedAt),
+		RetryCount:    int32(e.RetryCount),
+		LastError:     e.LastError,
+	}
+	if e.DeadLetteredAt != nil {
+		res.DeadLetteredAt = timestamppb.New(*e.DeadLetteredAt)
+	}
+	if e.PublishedAt != nil {
+		res.PublishedAt = timestamppb.New(*e.PublishedAt)
+	}
+	return res
+}
+
+func adminStatus(ctx context.Context, err error) error {
+	if errors.Is(err, ErrEventNotFound) {
+		return status.Error(codes.NotFound, "event not found")
+	}
+	logger.ErrorKV(ctx, "outbox admin: dead-letter request failed", "error", err.Error())
+	return status.Error(codes.Internal, "outbox admin request failed")
+}
@@ -0,0 +1,173 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/sskorolev/balun_microservices/lib/logger"
+
+	"github.com/google/uuid"
+)
+
+// DeadLettersAdminPath префикс эндпоинтов dead-letter на admin сервере
+//
+//	GET    /outbox/dead-letters?aggregate_type=&event_type=&limit=&cursor=  список
+//	GET    /outbox/dead-letters/{id}                                       событие
+//	POST   /outbox/dead-letters/{id}/requeue                               вернуть в очередь
+//	DELETE /outbox/dead-letters/{id}                                       удалить
+const DeadLettersAdminPath = "/outbox/dead-letters"
+
+// DeadLetterJSON dead-letter событие в ответе admin эндпоинта
+type DeadLetterJSON struct {
+	ID             string          `json:"id"`
+	AggregateType  string          `json:"aggregateType"`
+	AggregateID    string          `json:"aggregateId"`
+	EventType      string          `json:"eventType"`
+	Payload        json.RawMessage `json:"payload"`
+	CreatedAt      time.Time       `json:"createdAt"`
+	RetryCount     int             `json:"retryCount"`
+	LastError      string          `json:"lastError,omitempty"`
+	DeadLetteredAt *time.Time      `json:"deadLetteredAt,omitempty"`
+	PublishedAt    *time.Time      `json:"publishedAt,omitempty"`
+}
+
+// DeadLettersHandler HTTP эндпоинты разбора dead-letter для admin сервера
+//
+// Регистрируется на DeadLettersAdminPath и DeadLettersAdminPath + "/".
+func DeadLettersHandler(store DeadLetterStore) http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET "+DeadLettersAdminPath, func(w http.ResponseWriter, r *http.Request) {
+		filter, err := parseDeadLetterFilter(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		events, err := store.ListDeadLetters(r.Context(), filter)
+		if err != nil {
+			writeAdminError(w, r, err)
+			return
+		}
+
+		resp := struct {
+			Events     []DeadLetterJSON `json:"events"`
+			NextCursor string           `json:"nextCursor,omitempty"`
+		}{Events: make([]DeadLetterJSON, 0, len(events))}
+		for _, e := range events {
+			resp.Events = append(resp.Events, toDeadLetterJSON(e))
+		}
+		if len(events) > 0 && len(events) == normalizeLimit(filter.Limit) {
+			resp.NextCursor = events[len(events)-1].ID.String()
+		}
+		writeJSON(w, resp)
+	})
+
+	mux.HandleFunc("GET "+DeadLettersAdminPath+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id, err := uuid.Parse(r.PathValue("id"))
+		if err != nil {
+			http.Error(w, "invalid event id", http.StatusBadRequest)
+			return
+		}
+
+		e, err := store.GetEvent(r.Context(), id)
+		if err != nil {
+			writeAdminError(w, r, err)
+			return
+		}
+		writeJSON(w, toDeadLetterJSON(e))
+	})
+
+	mux.HandleFunc("POST "+DeadLettersAdminPath+"/{id}/requeue", func(w http.ResponseWriter, r *http.Request) {
+		handleDeadLetterAction(w, r, store.RequeueDeadLetters)
+	})
+
+	mux.HandleFunc("DELETE "+DeadLettersAdminPath+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		handleDeadLetterAction(w, r, store.DiscardDeadLetters)
+	})
+
+	return mux
+}
+
+func handleDeadLetterAction(w http.ResponseWriter, r *http.Request, action func(ctx context.Context, ids ...uuid.UUID) (int64, error)) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, "invalid event id", http.StatusBadRequest)
+		return
+	}
+
+	affected, err := action(r.Context(), id)
+	if err != nil {
+		writeAdminError(w, r, err)
+		return
+	}
+	if affected == 0 {
+		http.Error(w, "dead-letter event not found", http.StatusNotFound)
+		return
+	}
+	writeJSON(w, struct {
+		Affected int64 `json:"affected"`
+	}{Affected: affected})
+}
+
+func parseDeadLetterFilter(r *http.Request) (DeadLetterFilter, error) {
+	q := r.URL.Query()
+
+	var filter DeadLetterFilter
+	if v := q.Get("aggregate_type"); v != "" {
+		t := AggregateType(v)
+		filter.AggregateType = &t
+	}
+	if v := q.Get("event_type"); v != "" {
+		t := EventType(v)
+		filter.EventType = &t
+	}
+	if v := q.Get("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil {
+			return filter, errors.New("invalid limit")
+		}
+		filter.Limit = limit
+	}
+	if v := q.Get("cursor"); v != "" {
+		cursor, err := uuid.Parse(v)
+		if err != nil {
+			return filter, errors.New("invalid cursor")
+		}
+		filter.Cursor = &cursor
+	}
+	return filter, nil
+}
+
+func toDeadLetterJSON(e *Event) DeadLetterJSON {
+	return DeadLetterJSON{
+		ID:             e.ID.String(),
+		AggregateType:  string(e.AggregateType),
+		AggregateID:    e.AggregateID,
+		EventType:      string(e.EventType),
+		Payload:        json.RawMessage(e.Payload),
+		CreatedAt:      e.CreatedAt,
+		RetryCount:     e.RetryCount,
+		LastError:      e.LastError,
+		DeadLetteredAt: e.DeadLetteredAt,
+		PublishedAt:    e.PublishedAt,
+	}
+}
+
+func writeAdminError(w http.ResponseWriter, r *http.Request, err error) {
+	if errors.Is(err, ErrEventNotFound) {
+		http.Error(w, "event not found", http.StatusNotFound)
+		return
+	}
+	logger.ErrorKV(r.Context(), "outbox admin: dead-letter request failed", "error", err.Error())
+	http.Error(w, "outbox admin request failed", http.StatusInternalServerError)
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
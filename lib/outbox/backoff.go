@@ -0,0 +1,35 @@
+package outbox
+
+import (
+	"math"
+	"math/rand/v2"
+	"time"
+)
+
+// Backoff задержка перед повторной публикацией: base * 2^(attempt-1), не больше max (0 - без ограничения)
+type Backoff struct {
+	Base   time.Duration
+	Max    time.Duration
+	Jitter bool
+}
+
+// Delay задержка перед попыткой attempt (attempt >= 1)
+func (b Backoff) Delay(attempt int) time.Duration {
+	limit := b.Max
+	if limit <= 0 {
+		limit = math.MaxInt64 / 2
+	}
+
+	delay := min(b.Base, limit)
+	for i := 1; i < attempt && delay < limit; i++ {
+		delay = min(delay*2, limit)
+	}
+
+	// Jitter в диапазоне [delay/2, delay], чтобы повторы разных событий не совпадали
+	if b.Jitter && delay > 1 {
+		half := delay / 2
+		delay = half + time.Duration(rand.Int64N(int64(delay-half)))
+	}
+
+	return delay
+}
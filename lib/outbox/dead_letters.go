@@ -0,0 +1,49 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+// ErrEventNotFound событие не найдено
+var ErrEventNotFound = errors.New("outbox: event not found")
+
+// DeadLetterFilter параметры выборки dead-letter событий
+type DeadLetterFilter struct {
+	AggregateType *AggregateType
+	EventType     *EventType
+	// Cursor id последнего события предыдущей страницы
+	Cursor *uuid.UUID
+	Limit  int
+}
+
+// DeadLetterStore хранилище для разбора dead-letter событий
+type DeadLetterStore interface {
+	// ListDeadLetters dead-letter события от новых к старым
+	ListDeadLetters(ctx context.Context, filter DeadLetterFilter) ([]*Event, error)
+	// GetEvent событие по id, ErrEventNotFound если его нет
+	GetEvent(ctx context.Context, id uuid.UUID) (*Event, error)
+	// RequeueDeadLetters возвращает события в очередь публикации, возвращает количество затронутых событий
+	RequeueDeadLetters(ctx context.Context, ids ...uuid.UUID) (int64, error)
+	// DiscardDeadLetters удаляет события из outbox, возвращает количество затронутых событий
+	DiscardDeadLetters(ctx context.Context, ids ...uuid.UUID) (int64, error)
+}
+
+const (
+	defaultDeadLetterLimit = 50
+	maxDeadLetterLimit     = 500
+)
+
+// normalizeLimit ограничивает размер страницы dead-letter
+func normalizeLimit(limit int) int {
+	switch {
+	case limit <= 0:
+		return defaultDeadLetterLimit
+	case limit > maxDeadLetterLimit:
+		return maxDeadLetterLimit
+	default:
+		return limit
+	}
+}
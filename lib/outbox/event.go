@@ -0,0 +1,36 @@
+package outbox
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AggregateType тип агрегата, например friend_request
+type AggregateType string
+
+// EventType тип события, например FriendRequestCreated
+type EventType string
+
+// Event событие в таблице outbox
+type Event struct {
+	ID            uuid.UUID
+	AggregateType AggregateType
+	AggregateID   string
+	EventType     EventType
+	// Payload тело события в JSON
+	Payload       []byte
+	CreatedAt     time.Time
+	PublishedAt   *time.Time
+	RetryCount    int
+	NextAttemptAt *time.Time
+	// DeadLetteredAt время перевода события в dead-letter, после этого воркер его не выбирает
+	DeadLetteredAt *time.Time
+	// LastError последняя ошибка публикации
+	LastError string
+}
+
+// IsDeadLettered событие исчерпало попытки публикации
+func (e *Event) IsDeadLettered() bool {
+	return e.DeadLetteredAt != nil
+}
@@ -0,0 +1,158 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/sskorolev/balun_microservices/lib/logger"
+
+	"github.com/IBM/sarama"
+	"github.com/google/uuid"
+)
+
+// Заголовки Kafka сообщений с метаданными события
+const (
+	HeaderEventID       = "event_id"
+	HeaderEventType     = "event_type"
+	HeaderAggregateType = "aggregate_type"
+	HeaderAggregateID   = "aggregate_id"
+)
+
+// TopicResolver выбирает топик и ключ партиционирования для события
+type TopicResolver func(e *Event) (topic string, key string)
+
+// KafkaPublisherOption опция KafkaPublisher
+type KafkaPublisherOption func(*KafkaPublisher)
+
+// WithTopic фиксирует один топик, ключ = AggregateID
+func WithTopic(topic string) KafkaPublisherOption {
+	return func(p *KafkaPublisher) {
+		p.resolve = func(e *Event) (string, string) { return topic, e.AggregateID }
+	}
+}
+
+// WithTopicResolver позволяет выбрать топик/ключ динамически
+func WithTopicResolver(r TopicResolver) KafkaPublisherOption {
+	return func(p *KafkaPublisher) { p.resolve = r }
+}
+
+// WithMaxBatchSize размер чанка для SendMessages (по умолчанию 500)
+func WithMaxBatchSize(n int) KafkaPublisherOption {
+	return func(p *KafkaPublisher) {
+		if n > 0 {
+			p.maxBatchSize = n
+		}
+	}
+}
+
+// Проверка удовлетворению интерфейсу Publisher
+var _ Publisher = (*KafkaPublisher)(nil)
+
+// KafkaPublisher публикует события в Kafka через sarama.SyncProducer
+//
+// Тело сообщения - Payload события, ключ - AggregateID (по умолчанию),
+// метаданные события передаются в заголовках.
+type KafkaPublisher struct {
+	producer     sarama.SyncProducer
+	resolve      TopicResolver
+	maxBatchSize int
+}
+
+// NewKafkaPublisher конструктор KafkaPublisher, топик обязателен (WithTopic или WithTopicResolver)
+func NewKafkaPublisher(producer sarama.SyncProducer, opts ...KafkaPublisherOption) (*KafkaPublisher, error) {
+	p := &KafkaPublisher{
+		producer:     producer,
+		maxBatchSize: 500,
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	if p.resolve == nil {
+		return nil, errors.New("outbox: kafka publisher topic is not configured")
+	}
+	return p, nil
+}
+
+// Publish отправляет события чанками
+func (p *KafkaPublisher) Publish(ctx context.Context, events []*Event) (succeeded []uuid.UUID, failed []Failure, err error) {
+	succeeded = make([]uuid.UUID, 0, len(events))
+	failed = make([]Failure, 0, len(events))
+
+	for evs := range slices.Chunk(events, p.maxBatchSize) {
+		select {
+		case <-ctx.Done():
+			return succeeded, append(failed, failures(evs, ctx.Err())...), ctx.Err()
+		default:
+		}
+
+		msgs := make([]*sarama.ProducerMessage, 0, len(evs))
+		for _, e := range evs {
+			msgs = append(msgs, p.message(e))
+		}
+
+		sendErr := p.producer.SendMessages(msgs)
+		if sendErr == nil {
+			for _, m := range msgs {
+				succeeded = append(succeeded, m.Metadata.(uuid.UUID))
+			}
+			continue
+		}
+
+		// Частичные ошибки приходят как sarama.ProducerErrors
+		var perrs sarama.ProducerErrors
+		if !errors.As(sendErr, &perrs) {
+			// Ошибка всего чанка — считаем все события чанка failed и продолжаем
+			failed = append(failed, failures(evs, sendErr)...)
+			err = sendErr
+			continue
+		}
+
+		failedSet := make(map[uuid.UUID]error, len(perrs))
+		for _, pe := range perrs {
+			if pe == nil || pe.Msg == nil {
+				continue
+			}
+			logger.ErrorKV(ctx, "outbox: write to kafka failed", "topic", pe.Msg.Topic, "error", pe.Err.Error())
+			if id, ok := pe.Msg.Metadata.(uuid.UUID); ok {
+				failedSet[id] = pe.Err
+			}
+		}
+		for _, m := range msgs {
+			id := m.Metadata.(uuid.UUID)
+			if failErr, bad := failedSet[id]; bad {
+				failed = append(failed, Failure{ID: id, Err: failErr})
+			} else {
+				succeeded = append(succeeded, id)
+			}
+		}
+		err = sendErr
+	}
+
+	return succeeded, failed, err
+}
+
+func (p *KafkaPublisher) message(e *Event) *sarama.ProducerMessage {
+	topic, key := p.resolve(e)
+	return &sarama.ProducerMessage{
+		Topic:     topic,
+		Key:       sarama.StringEncoder(key), // партиционирование по ключу
+		Value:     sarama.ByteEncoder(e.Payload),
+		Timestamp: e.CreatedAt,
+		Metadata:  e.ID, // чтобы распознать ошибку по id
+		Headers: []sarama.RecordHeader{
+			{Key: []byte(HeaderEventID), Value: []byte(e.ID.String())},
+			{Key: []byte(HeaderEventType), Value: []byte(e.EventType)},
+			{Key: []byte(HeaderAggregateType), Value: []byte(e.AggregateType)},
+			{Key: []byte(HeaderAggregateID), Value: []byte(e.AggregateID)},
+		},
+	}
+}
+
+func failures(events []*Event, err error) []Failure {
+	res := make([]Failure, len(events))
+	for i, e := range events {
+		res[i] = Failure{ID: e.ID, Err: err}
+	}
+	return res
+}
@@ -0,0 +1,173 @@
+package outbox
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ----- Search options -----
+
+// SearchOptions параметры выборки событий
+type SearchOptions struct {
+	// окно по created_at для partition pruning
+	NotBefore *time.Time
+	NotAfter  *time.Time
+
+	AggregateType *AggregateType
+	EventType     *EventType
+
+	OnlyUnpublished bool
+	// OnlyAlive исключает события в dead-letter
+	OnlyAlive bool
+	// MaxRetryCount, MinRetryCount ограничения retry_count, nil - без ограничения
+	MaxRetryCount *int
+	MinRetryCount *int
+	DueAt         *time.Time
+	Limit         int
+	WithLock      bool
+}
+
+// SearchOption опция выборки событий
+type SearchOption func(o *SearchOptions)
+
+// CollectSearchOptions применяет опции к значениям по умолчанию
+func CollectSearchOptions(opts ...SearchOption) SearchOptions {
+	res := SearchOptions{
+		Limit: 10,
+	}
+	for _, opt := range opts {
+		opt(&res)
+	}
+	return res
+}
+
+func WithLimit(n int) SearchOption {
+	return func(o *SearchOptions) { o.Limit = n }
+}
+
+func WithOnlyUnpublished() SearchOption {
+	return func(o *SearchOptions) { o.OnlyUnpublished = true }
+}
+
+// WithOnlyAlive исключает события в dead-letter
+func WithOnlyAlive() SearchOption {
+	return func(o *SearchOptions) { o.OnlyAlive = true }
+}
+
+func WithMaxRetryCount(n int) SearchOption {
+	return func(o *SearchOptions) { o.MaxRetryCount = &n }
+}
+
+func WithMinRetryCount(n int) SearchOption {
+	return func(o *SearchOptions) { o.MinRetryCount = &n }
+}
+
+// WithLock выбирает события с FOR UPDATE SKIP LOCKED
+func WithLock() SearchOption {
+	return func(o *SearchOptions) { o.WithLock = true }
+}
+
+func WithAggregateType(t AggregateType) SearchOption {
+	return func(o *SearchOptions) { o.AggregateType = &t }
+}
+
+func WithEventType(t EventType) SearchOption {
+	return func(o *SearchOptions) { o.EventType = &t }
+}
+
+func WithNotBefore(t time.Time) SearchOption {
+	return func(o *SearchOptions) { o.NotBefore = &t }
+}
+
+func WithNotAfter(t time.Time) SearchOption {
+	return func(o *SearchOptions) { o.NotAfter = &t }
+}
+
+// WithDueAt выбирает события, время следующей попытки которых наступило
+func WithDueAt(t time.Time) SearchOption {
+	return func(o *SearchOptions) { o.DueAt = &t }
+}
+
+// ----- Update options -----
+
+// UpdateOptions параметры обновления событий
+type UpdateOptions struct {
+	// окно по created_at для partition pruning
+	NotBefore *time.Time
+	NotAfter  *time.Time
+
+	AggregateType *AggregateType
+	EventType     *EventType
+
+	IDs []uuid.UUID
+
+	// что обновляем
+	SetPublishedAt    *time.Time
+	IncRetryBy        int
+	SetNextAttemptAt  *time.Time
+	SetLastError      *string
+	SetDeadLetteredAt *time.Time
+
+	// фильтры статуса
+	OnlyUnpublished bool // по умолчанию true
+}
+
+// UpdateOption опция обновления событий
+type UpdateOption func(*UpdateOptions)
+
+// CollectUpdateOptions применяет опции к значениям по умолчанию
+func CollectUpdateOptions(opts ...UpdateOption) UpdateOptions {
+	o := UpdateOptions{
+		OnlyUnpublished: true,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+func WithUpdateIDs(ids ...uuid.UUID) UpdateOption {
+	return func(o *UpdateOptions) { o.IDs = append(o.IDs, ids...) }
+}
+
+func WithUpdateAggregateType(at AggregateType) UpdateOption {
+	return func(o *UpdateOptions) { o.AggregateType = &at }
+}
+
+func WithUpdateEventType(et EventType) UpdateOption {
+	return func(o *UpdateOptions) { o.EventType = &et }
+}
+
+func WithUpdateNotBefore(t time.Time) UpdateOption {
+	return func(o *UpdateOptions) { o.NotBefore = &t }
+}
+
+func WithUpdateNotAfter(t time.Time) UpdateOption {
+	return func(o *UpdateOptions) { o.NotAfter = &t }
+}
+
+func SetPublishedAt(ts time.Time) UpdateOption {
+	return func(o *UpdateOptions) { o.SetPublishedAt = &ts }
+}
+
+func IncRetry(by int) UpdateOption {
+	return func(o *UpdateOptions) { o.IncRetryBy = by }
+}
+
+func IncludePublished() UpdateOption {
+	return func(o *UpdateOptions) { o.OnlyUnpublished = false }
+}
+
+func SetNextAttemptAt(ts time.Time) UpdateOption {
+	return func(o *UpdateOptions) { o.SetNextAttemptAt = &ts }
+}
+
+func SetLastError(msg string) UpdateOption {
+	return func(o *UpdateOptions) { o.SetLastError = &msg }
+}
+
+// SetDeadLetteredAt переводит события в dead-letter
+func SetDeadLetteredAt(ts time.Time) UpdateOption {
+	return func(o *UpdateOptions) { o.SetDeadLetteredAt = &ts }
+}
@@ -0,0 +1,121 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/sskorolev/balun_microservices/lib/postgres"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// Проверка удовлетворению интерфейсу DeadLetterStore
+var _ DeadLetterStore = (*PostgresStore)(nil)
+
+// ListDeadLetters dead-letter события от новых к старым, курсор - id (UUIDv7)
+func (s *PostgresStore) ListDeadLetters(ctx context.Context, filter DeadLetterFilter) ([]*Event, error) {
+	const api = "[outbox.PostgresStore][ListDeadLetters]"
+
+	qb := s.qb.
+		Select(columns...).
+		From(s.table).
+		Where(squirrel.NotEq{columnDeadAt: nil}).
+		Where(squirrel.Eq{columnPublishedAt: nil}).
+		OrderBy(columnID + " DESC").
+		Limit(uint64(normalizeLimit(filter.Limit)))
+
+	if filter.AggregateType != nil {
+		qb = qb.Where(squirrel.Eq{columnAggType: string(*filter.AggregateType)})
+	}
+	if filter.EventType != nil {
+		qb = qb.Where(squirrel.Eq{columnEventType: string(*filter.EventType)})
+	}
+	if filter.Cursor != nil {
+		qb = qb.Where(squirrel.Lt{columnID: *filter.Cursor})
+	}
+
+	conn := s.db.GetQueryEngine(ctx)
+	var rows []row
+	if err := conn.Selectx(ctx, &rows, qb); err != nil {
+		return nil, fmt.Errorf("%s: %w", api, postgres.ConvertPGError(err))
+	}
+
+	events := make([]*Event, 0, len(rows))
+	for i := range rows {
+		events = append(events, rows[i].toEvent())
+	}
+	return events, nil
+}
+
+// GetEvent событие по id
+func (s *PostgresStore) GetEvent(ctx context.Context, id uuid.UUID) (*Event, error) {
+	const api = "[outbox.PostgresStore][GetEvent]"
+
+	qb := s.qb.
+		Select(columns...).
+		From(s.table).
+		Where(squirrel.Eq{columnID: id}).
+		Limit(1)
+
+	conn := s.db.GetQueryEngine(ctx)
+	var r row
+	if err := conn.Getx(ctx, &r, qb); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrEventNotFound
+		}
+		return nil, fmt.Errorf("%s: %w", api, postgres.ConvertPGError(err))
+	}
+	return r.toEvent(), nil
+}
+
+// RequeueDeadLetters сбрасывает счетчик попыток и возвращает события в очередь публикации
+//
+// created_at сдвигается на текущий момент, иначе событие старше окна воркера не будет выбрано.
+// Строка при этом переезжает в партицию текущего месяца, id события сохраняется.
+func (s *PostgresStore) RequeueDeadLetters(ctx context.Context, ids ...uuid.UUID) (int64, error) {
+	const api = "[outbox.PostgresStore][RequeueDeadLetters]"
+
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	qb := s.qb.Update(s.table).
+		Set(columnDeadAt, nil).
+		Set(columnRetryCount, 0).
+		Set(columnNextAttemptAt, nil).
+		Set(columnCreatedAt, squirrel.Expr("now()")).
+		Where(squirrel.Eq{columnID: ids}).
+		Where(squirrel.NotEq{columnDeadAt: nil}).
+		Where(squirrel.Eq{columnPublishedAt: nil})
+
+	conn := s.db.GetQueryEngine(ctx)
+	tag, err := conn.Execx(ctx, qb)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", api, postgres.ConvertPGError(err))
+	}
+	return tag.RowsAffected(), nil
+}
+
+// DiscardDeadLetters удаляет dead-letter события
+func (s *PostgresStore) DiscardDeadLetters(ctx context.Context, ids ...uuid.UUID) (int64, error) {
+	const api = "[outbox.PostgresStore][DiscardDeadLetters]"
+
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	qb := s.qb.Delete(s.table).
+		Where(squirrel.Eq{columnID: ids}).
+		Where(squirrel.NotEq{columnDeadAt: nil}).
+		Where(squirrel.Eq{columnPublishedAt: nil})
+
+	conn := s.db.GetQueryEngine(ctx)
+	tag, err := conn.Execx(ctx, qb)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", api, postgres.ConvertPGError(err))
+	}
+	return tag.RowsAffected(), nil
+}
@@ -0,0 +1,288 @@
+package outbox
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/sskorolev/balun_microservices/lib/postgres"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+// DefaultTable таблица outbox по умолчанию
+const DefaultTable = "public.outbox_events"
+
+const (
+	columnID            = "id"
+	columnAggType       = "aggregate_type"
+	columnAggID         = "aggregate_id"
+	columnEventType     = "event_type"
+	columnPayload       = "payload"
+	columnCreatedAt     = "created_at"
+	columnPublishedAt   = "published_at"
+	columnRetryCount    = "retry_count"
+	columnNextAttemptAt = "next_attempt_at"
+	columnDeadAt        = "dead_lettered_at"
+	columnLastError     = "last_error"
+)
+
+var columns = []string{
+	columnID,
+	columnAggType,
+	columnAggID,
+	columnEventType,
+	columnPayload,
+	columnCreatedAt,
+	columnPublishedAt,
+	columnRetryCount,
+	columnNextAttemptAt,
+	columnDeadAt,
+	columnLastError,
+}
+
+type row struct {
+	ID            uuid.UUID           `db:"id"`
+	AggregateType string              `db:"aggregate_type"`
+	AggregateID   string              `db:"aggregate_id"`
+	EventType     string              `db:"event_type"`
+	Payload       []byte              `db:"payload"` // JSONB
+	CreatedAt     time.Time           `db:"created_at"`
+	PublishedAt   sql.Null[time.Time] `db:"published_at"`
+	RetryCount    int                 `db:"retry_count"`
+	NextAttemptAt sql.Null[time.Time] `db:"next_attempt_at"`
+	DeadAt        sql.Null[time.Time] `db:"dead_lettered_at"`
+	LastError     sql.Null[string]    `db:"last_error"`
+}
+
+// Проверка удовлетворению интерфейсу Store
+var _ Store = (*PostgresStore)(nil)
+
+// PostgresStoreOption опция PostgresStore
+type PostgresStoreOption func(*PostgresStore)
+
+// WithTable имя таблицы outbox
+func WithTable(table string) PostgresStoreOption {
+	return func(s *PostgresStore) { s.table = table }
+}
+
+// PostgresStore хранилище outbox в Postgres
+//
+// Таблица партиционирована по created_at, поэтому выборки и обновления стоит ограничивать окном
+// WithNotBefore/WithNotAfter для partition pruning.
+type PostgresStore struct {
+	db    postgres.TransactionManagerAPI
+	qb    squirrel.StatementBuilderType
+	table string
+}
+
+// NewPostgresStore конструктор PostgresStore
+func NewPostgresStore(db postgres.TransactionManagerAPI, opts ...PostgresStoreOption) *PostgresStore {
+	s := &PostgresStore{
+		db:    db,
+		qb:    squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+		table: DefaultTable,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// SaveEvents сохраняет события в транзакции из контекста
+func (s *PostgresStore) SaveEvents(ctx context.Context, events ...*Event) error {
+	const api = "[outbox.PostgresStore][SaveEvents]"
+
+	if len(events) == 0 {
+		return nil
+	}
+
+	qb := s.qb.Insert(s.table).Columns(columns...)
+	for _, e := range events {
+		payload := e.Payload
+		if payload == nil {
+			payload = []byte("null")
+		}
+		qb = qb.Values(
+			e.ID,
+			string(e.AggregateType),
+			e.AggregateID,
+			string(e.EventType),
+			payload,
+			e.CreatedAt,
+			nullTime(e.PublishedAt),
+			e.RetryCount,
+			nullTime(e.NextAttemptAt),
+			nullTime(e.DeadLetteredAt),
+			nullString(e.LastError),
+		)
+	}
+
+	conn := s.db.GetQueryEngine(ctx)
+	if _, err := conn.Execx(ctx, qb); err != nil {
+		return fmt.Errorf("%s: %w", api, postgres.ConvertPGError(err))
+	}
+	return nil
+}
+
+// SearchEvents выбирает события по заданным опциям
+func (s *PostgresStore) SearchEvents(ctx context.Context, opts ...SearchOption) ([]*Event, error) {
+	const api = "[outbox.PostgresStore][SearchEvents]"
+
+	o := CollectSearchOptions(opts...)
+
+	qb := s.qb.
+		Select(columns...).
+		From(s.table).
+		OrderBy(columnCreatedAt).
+		Limit(uint64(o.Limit))
+
+	if o.OnlyUnpublished {
+		qb = qb.Where(squirrel.Eq{columnPublishedAt: nil}) // IS NULL
+	}
+	if o.OnlyAlive {
+		qb = qb.Where(squirrel.Eq{columnDeadAt: nil})
+	}
+	if o.MaxRetryCount != nil {
+		qb = qb.Where(squirrel.LtOrEq{columnRetryCount: *o.MaxRetryCount})
+	}
+	if o.MinRetryCount != nil {
+		qb = qb.Where(squirrel.GtOrEq{columnRetryCount: *o.MinRetryCount})
+	}
+
+	if o.AggregateType != nil {
+		qb = qb.Where(squirrel.Eq{columnAggType: string(*o.AggregateType)})
+	}
+	if o.EventType != nil {
+		qb = qb.Where(squirrel.Eq{columnEventType: string(*o.EventType)})
+	}
+	if o.NotBefore != nil {
+		qb = qb.Where(squirrel.GtOrEq{columnCreatedAt: *o.NotBefore})
+	}
+	if o.NotAfter != nil {
+		qb = qb.Where(squirrel.LtOrEq{columnCreatedAt: *o.NotAfter})
+	}
+	if o.DueAt != nil {
+		// next_attempt_at IS NULL OR next_attempt_at <= dueAt
+		qb = qb.Where(
+			squirrel.Or{
+				squirrel.Eq{columnNextAttemptAt: nil},
+				squirrel.LtOrEq{columnNextAttemptAt: *o.DueAt},
+			},
+		)
+	}
+
+	// Блокировка строк для конкурентных воркеров
+	if o.WithLock {
+		qb = qb.Suffix("FOR UPDATE SKIP LOCKED")
+	}
+
+	conn := s.db.GetQueryEngine(ctx)
+	var rows []row
+	if err := conn.Selectx(ctx, &rows, qb); err != nil {
+		return nil, fmt.Errorf("%s: %w", api, postgres.ConvertPGError(err))
+	}
+
+	events := make([]*Event, 0, len(rows))
+	for i := range rows {
+		events = append(events, rows[i].toEvent())
+	}
+	return events, nil
+}
+
+// UpdateEvents обновляет события по заданным опциям
+func (s *PostgresStore) UpdateEvents(ctx context.Context, opts ...UpdateOption) error {
+	const api = "[outbox.PostgresStore][UpdateEvents]"
+
+	o := CollectUpdateOptions(opts...)
+
+	// защита от noop
+	if o.SetPublishedAt == nil && o.IncRetryBy == 0 && o.SetNextAttemptAt == nil &&
+		o.SetLastError == nil && o.SetDeadLetteredAt == nil {
+		return nil
+	}
+
+	qb := s.qb.Update(s.table)
+
+	// setters
+	if o.SetPublishedAt != nil {
+		qb = qb.Set(columnPublishedAt, *o.SetPublishedAt)
+	}
+	if o.IncRetryBy > 0 {
+		qb = qb.Set(columnRetryCount, squirrel.Expr(columnRetryCount+" + ?", o.IncRetryBy))
+	}
+	if o.SetNextAttemptAt != nil {
+		qb = qb.Set(columnNextAttemptAt, *o.SetNextAttemptAt)
+	}
+	if o.SetLastError != nil {
+		qb = qb.Set(columnLastError, *o.SetLastError)
+	}
+	if o.SetDeadLetteredAt != nil {
+		qb = qb.Set(columnDeadAt, *o.SetDeadLetteredAt)
+	}
+
+	// filters (для partition pruning)
+	if o.AggregateType != nil {
+		qb = qb.Where(squirrel.Eq{columnAggType: string(*o.AggregateType)})
+	}
+	if o.EventType != nil {
+		qb = qb.Where(squirrel.Eq{columnEventType: string(*o.EventType)})
+	}
+	if len(o.IDs) > 0 {
+		qb = qb.Where(squirrel.Eq{columnID: o.IDs}) // id IN (...)
+	}
+	if o.NotBefore != nil {
+		qb = qb.Where(squirrel.GtOrEq{columnCreatedAt: *o.NotBefore})
+	}
+	if o.NotAfter != nil {
+		qb = qb.Where(squirrel.LtOrEq{columnCreatedAt: *o.NotAfter})
+	}
+	if o.OnlyUnpublished {
+		qb = qb.Where(squirrel.Eq{columnPublishedAt: nil})
+	}
+
+	conn := s.db.GetQueryEngine(ctx)
+	if _, err := conn.Execx(ctx, qb); err != nil {
+		return fmt.Errorf("%s: %w", api, postgres.ConvertPGError(err))
+	}
+	return nil
+}
+
+func (r *row) toEvent() *Event {
+	e := &Event{
+		ID:            r.ID,
+		AggregateType: AggregateType(r.AggregateType),
+		AggregateID:   r.AggregateID,
+		EventType:     EventType(r.EventType),
+		Payload:       r.Payload,
+		CreatedAt:     r.CreatedAt,
+		RetryCount:    r.RetryCount,
+	}
+	if r.PublishedAt.Valid {
+		t := r.PublishedAt.V
+		e.PublishedAt = &t
+	}
+	if r.NextAttemptAt.Valid {
+		t := r.NextAttemptAt.V
+		e.NextAttemptAt = &t
+	}
+	if r.DeadAt.Valid {
+		t := r.DeadAt.V
+		e.DeadLetteredAt = &t
+	}
+	e.LastError = r.LastError.V
+	return e
+}
+
+func nullTime(t *time.Time) sql.Null[time.Time] {
+	if t == nil {
+		return sql.Null[time.Time]{}
+	}
+	return sql.Null[time.Time]{V: *t, Valid: true}
+}
+
+func nullString(s string) sql.Null[string] {
+	return sql.Null[string]{V: s, Valid: s != ""}
+}
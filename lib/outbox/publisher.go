@@ -0,0 +1,28 @@
+package outbox
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Failure неуспешная публикация события
+type Failure struct {
+	ID  uuid.UUID
+	Err error
+}
+
+// Publisher публикует батч событий во внешнюю систему
+//
+// Возвращает id успешно опубликованных событий и ошибки по неуспешным; err - для ошибок всего батча.
+type Publisher interface {
+	Publish(ctx context.Context, events []*Event) (succeeded []uuid.UUID, failed []Failure, err error)
+}
+
+// PublisherFunc адаптер функции к Publisher
+type PublisherFunc func(ctx context.Context, events []*Event) (succeeded []uuid.UUID, failed []Failure, err error)
+
+// Publish вызывает f
+func (f PublisherFunc) Publish(ctx context.Context, events []*Event) ([]uuid.UUID, []Failure, error) {
+	return f(ctx, events)
+}
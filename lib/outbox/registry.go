@@ -0,0 +1,111 @@
+package outbox
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrUnknownEvent событие не зарегистрировано в Registry
+var ErrUnknownEvent = errors.New("outbox: unknown event")
+
+// Registry реестр событий сервиса: какие события какого агрегата пишутся в outbox
+//
+// Writer отклоняет незарегистрированные события, а по реестру строятся партиции outbox.
+type Registry struct {
+	mu     sync.RWMutex
+	events map[EventType]AggregateType
+}
+
+// NewRegistry конструктор Registry
+func NewRegistry() *Registry {
+	return &Registry{events: make(map[EventType]AggregateType)}
+}
+
+// EventDef описание события с типизированным телом P
+type EventDef[P any] struct {
+	Aggregate AggregateType
+	Type      EventType
+}
+
+// Register регистрирует событие eventType агрегата aggregate с телом типа P
+//
+// Повторная регистрация того же события для другого агрегата - ошибка программиста, поэтому паника.
+func Register[P any](r *Registry, aggregate AggregateType, eventType EventType) EventDef[P] {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if existing, ok := r.events[eventType]; ok && existing != aggregate {
+		panic(fmt.Sprintf("outbox: event %s already registered for aggregate %s", eventType, existing))
+	}
+	r.events[eventType] = aggregate
+
+	return EventDef[P]{Aggregate: aggregate, Type: eventType}
+}
+
+// Validate проверяет, что событие зарегистрировано для своего агрегата
+func (r *Registry) Validate(e *Event) error {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	aggregate, ok := r.events[e.EventType]
+	if !ok || aggregate != e.AggregateType {
+		return fmt.Errorf("%w: %s/%s", ErrUnknownEvent, e.AggregateType, e.EventType)
+	}
+	return nil
+}
+
+// Aggregates возвращает зарегистрированные агрегаты и их события в детерминированном порядке
+func (r *Registry) Aggregates() map[AggregateType][]EventType {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make(map[AggregateType][]EventType)
+	for eventType, aggregate := range r.events {
+		result[aggregate] = append(result[aggregate], eventType)
+	}
+	for aggregate := range result {
+		slices.Sort(result[aggregate])
+	}
+	return result
+}
+
+// New создает событие с сериализованным в JSON телом
+func (d EventDef[P]) New(aggregateID string, payload P) (*Event, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("outbox: marshal %s payload: %w", d.Type, err)
+	}
+
+	// UUIDv7 упорядочен по времени, по нему строится курсор выборки dead-letter
+	id, err := uuid.NewV7()
+	if err != nil {
+		return nil, fmt.Errorf("outbox: generate event id: %w", err)
+	}
+
+	return &Event{
+		ID:            id,
+		AggregateType: d.Aggregate,
+		AggregateID:   aggregateID,
+		EventType:     d.Type,
+		Payload:       data,
+		CreatedAt:     time.Now().UTC(),
+	}, nil
+}
+
+// Decode восстанавливает тело события
+func (d EventDef[P]) Decode(e *Event) (P, error) {
+	var payload P
+	if e.EventType != d.Type {
+		return payload, fmt.Errorf("%w: expected %s, got %s", ErrUnknownEvent, d.Type, e.EventType)
+	}
+	if err := json.Unmarshal(e.Payload, &payload); err != nil {
+		return payload, fmt.Errorf("outbox: unmarshal %s payload: %w", d.Type, err)
+	}
+	return payload, nil
+}
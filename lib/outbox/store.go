@@ -0,0 +1,14 @@
+package outbox
+
+import (
+	"context"
+)
+
+// Store хранилище событий outbox
+//
+// Реализация должна выполнять запросы в транзакции из контекста, если она есть.
+type Store interface {
+	SaveEvents(ctx context.Context, events ...*Event) error
+	SearchEvents(ctx context.Context, opts ...SearchOption) ([]*Event, error)
+	UpdateEvents(ctx context.Context, opts ...UpdateOption) error
+}
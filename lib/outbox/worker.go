@@ -0,0 +1,244 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/sskorolev/balun_microservices/lib/logger"
+
+	"github.com/google/uuid"
+)
+
+// TransactionManager менеджер транзакций воркера
+type TransactionManager interface {
+	RunRepeatableRead(ctx context.Context, f func(txCtx context.Context) error) error
+}
+
+// WorkerOption опция Worker
+type WorkerOption func(*Worker)
+
+// WithBatchSize размер батча за один тик
+func WithBatchSize(n int) WorkerOption {
+	return func(w *Worker) { w.batchSize = n }
+}
+
+// WithPollInterval период опроса outbox
+func WithPollInterval(d time.Duration) WorkerOption {
+	return func(w *Worker) { w.pollInterval = d }
+}
+
+// WithRetryInterval начальная задержка перед повторной публикацией неуспешного события
+func WithRetryInterval(d time.Duration) WorkerOption {
+	return func(w *Worker) { w.backoff.Base = d }
+}
+
+// WithBackoff экспоненциальная задержка между повторами публикации
+func WithBackoff(b Backoff) WorkerOption {
+	return func(w *Worker) { w.backoff = b }
+}
+
+// WithMaxRetry количество неуспешных попыток, после которого событие уходит в dead-letter
+func WithMaxRetry(n int) WorkerOption {
+	return func(w *Worker) { w.maxRetry = n }
+}
+
+// WithWindow окно по created_at, в котором ищутся события (для partition pruning)
+func WithWindow(d time.Duration) WorkerOption {
+	return func(w *Worker) { w.window = d }
+}
+
+// Worker публикует события одного агрегата из outbox
+//
+// Каждый тик - одна транзакция: батч выбирается с FOR UPDATE SKIP LOCKED,
+// поэтому несколько реплик могут работать с одним агрегатом параллельно.
+type Worker struct {
+	aggregate AggregateType
+	store     Store
+	tm        TransactionManager
+	publisher Publisher
+
+	batchSize    int
+	maxRetry     int
+	backoff      Backoff
+	pollInterval time.Duration
+	window       time.Duration
+
+	now func() time.Time
+}
+
+// NewWorker конструктор Worker с дефолтами
+func NewWorker(aggregate AggregateType, store Store, tm TransactionManager, publisher Publisher, opts ...WorkerOption) *Worker {
+	w := &Worker{
+		aggregate:    aggregate,
+		store:        store,
+		tm:           tm,
+		publisher:    publisher,
+		batchSize:    100,
+		maxRetry:     10,
+		backoff:      Backoff{Base: 30 * time.Second, Max: time.Hour, Jitter: true},
+		pollInterval: 10 * time.Second,
+		window:       24 * time.Hour,
+		now:          time.Now,
+	}
+	for _, opt := range opts {
+		opt(w)
+	}
+	return w
+}
+
+// Run запускает цикл обработки до отмены ctx
+func (w *Worker) Run(ctx context.Context) error {
+	logger.InfoKV(ctx, "outbox worker started", "aggregate_type", w.aggregate)
+	defer logger.InfoKV(ctx, "outbox worker stopped", "aggregate_type", w.aggregate)
+
+	t := time.NewTicker(w.pollInterval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.C:
+			// Один "тик" — одна транзакция
+			if err := w.tm.RunRepeatableRead(ctx, w.Fetch); err != nil {
+				logger.ErrorKV(ctx, "outbox worker error", "aggregate_type", w.aggregate, "error", err.Error())
+			}
+		}
+	}
+}
+
+// Fetch публикует один батч событий
+func (w *Worker) Fetch(ctx context.Context) error {
+	const api = "[outbox.Worker][Fetch]"
+
+	var (
+		now  = w.now().UTC()
+		from = now.Add(-w.window)
+	)
+
+	// Новые события выбираются в окне по created_at для partition pruning
+	events, err := w.store.SearchEvents(
+		ctx,
+		// 1-я ступень pruning
+		WithNotBefore(from),
+		WithNotAfter(now),
+		// 2-я ступень pruning
+		WithAggregateType(w.aggregate),
+		// фильтрация
+		WithOnlyUnpublished(),
+		WithOnlyAlive(),
+		WithMaxRetryCount(0),
+		WithDueAt(now),
+		WithLimit(w.batchSize),
+		WithLock(), // FOR UPDATE
+	)
+	if err != nil {
+		return fmt.Errorf("%s: %w", api, err)
+	}
+
+	// Повторы выбираются по next_attempt_at без окна: суммарный backoff до maxRetry может быть
+	// больше окна, и событие иначе выпало бы из выборки, не дойдя ни до публикации, ни до dead-letter
+	if len(events) < w.batchSize {
+		retries, err := w.store.SearchEvents(
+			ctx,
+			WithNotAfter(now),
+			WithAggregateType(w.aggregate),
+			WithOnlyUnpublished(),
+			WithOnlyAlive(),
+			WithMinRetryCount(1),
+			WithDueAt(now),
+			WithLimit(w.batchSize-len(events)),
+			WithLock(), // FOR UPDATE
+		)
+		if err != nil {
+			return fmt.Errorf("%s: %w", api, err)
+		}
+		events = append(events, retries...)
+	}
+	if len(events) == 0 {
+		return nil
+	}
+
+	// Обновления ограничиваем самым старым событием батча, повторы могут быть старше окна
+	for _, e := range events {
+		if e.CreatedAt.Before(from) {
+			from = e.CreatedAt
+		}
+	}
+
+	succeeded, failed, publishErr := w.publisher.Publish(ctx, events)
+	if publishErr != nil {
+		// Ошибку не возвращаем, иначе транзакция откатится и уже опубликованные события уйдут повторно.
+		// Результат по каждому событию publisher вернул в succeeded/failed.
+		logger.ErrorKV(ctx, "outbox publish error", "aggregate_type", w.aggregate, "error", publishErr.Error())
+	}
+
+	if len(succeeded) > 0 {
+		e := w.store.UpdateEvents(
+			ctx,
+			WithUpdateNotBefore(from),
+			WithUpdateNotAfter(now),
+			WithUpdateAggregateType(w.aggregate),
+			WithUpdateIDs(succeeded...),
+
+			SetPublishedAt(now),
+		)
+		if e != nil {
+			err = errors.Join(err, e)
+		}
+	}
+
+	byID := make(map[uuid.UUID]*Event, len(events))
+	for _, e := range events {
+		byID[e.ID] = e
+	}
+
+	// Задержка зависит от retry_count события, поэтому неуспешные события обновляются по одному
+	for _, f := range failed {
+		e, ok := byID[f.ID]
+		if !ok {
+			continue
+		}
+
+		attempt := e.RetryCount + 1
+		opts := []UpdateOption{
+			WithUpdateNotBefore(from),
+			WithUpdateNotAfter(now),
+			WithUpdateAggregateType(w.aggregate),
+			WithUpdateIDs(f.ID),
+
+			IncRetry(1),
+			SetLastError(failureMessage(f, publishErr)),
+		}
+		if w.maxRetry > 0 && attempt >= w.maxRetry {
+			opts = append(opts, SetDeadLetteredAt(now))
+			logger.WarnKV(ctx, "outbox event moved to dead-letter",
+				"aggregate_type", w.aggregate, "event_id", e.ID.String(), "retry_count", attempt)
+		} else {
+			opts = append(opts, SetNextAttemptAt(now.Add(w.backoff.Delay(attempt))))
+		}
+
+		if ue := w.store.UpdateEvents(ctx, opts...); ue != nil {
+			err = errors.Join(err, ue)
+		}
+	}
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", api, err)
+	}
+	return nil
+}
+
+// failureMessage текст ошибки для last_error
+func failureMessage(f Failure, batchErr error) string {
+	switch {
+	case f.Err != nil:
+		return f.Err.Error()
+	case batchErr != nil:
+		return batchErr.Error()
+	default:
+		return "publish failed"
+	}
+}
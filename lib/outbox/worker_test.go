@@ -0,0 +1,225 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testAggregate AggregateType = "test_aggregate"
+
+type testPayload struct {
+	Value string `json:"value"`
+}
+
+// memoryStore Store в памяти для тестов
+type memoryStore struct {
+	events  []*Event
+	updates []UpdateOptions
+}
+
+func (s *memoryStore) SaveEvents(_ context.Context, events ...*Event) error {
+	s.events = append(s.events, events...)
+	return nil
+}
+
+func (s *memoryStore) SearchEvents(_ context.Context, opts ...SearchOption) ([]*Event, error) {
+	o := CollectSearchOptions(opts...)
+
+	var result []*Event
+	for _, e := range s.events {
+		if o.AggregateType != nil && e.AggregateType != *o.AggregateType {
+			continue
+		}
+		if o.OnlyUnpublished && e.PublishedAt != nil {
+			continue
+		}
+		if o.OnlyAlive && e.DeadLetteredAt != nil {
+			continue
+		}
+		if (o.NotBefore != nil && e.CreatedAt.Before(*o.NotBefore)) || (o.NotAfter != nil && e.CreatedAt.After(*o.NotAfter)) {
+			continue
+		}
+		if (o.MaxRetryCount != nil && e.RetryCount > *o.MaxRetryCount) || (o.MinRetryCount != nil && e.RetryCount < *o.MinRetryCount) {
+			continue
+		}
+		if o.DueAt != nil && e.NextAttemptAt != nil && e.NextAttemptAt.After(*o.DueAt) {
+			continue
+		}
+		if len(result) == o.Limit {
+			break
+		}
+		result = append(result, e)
+	}
+	return result, nil
+}
+
+func (s *memoryStore) UpdateEvents(_ context.Context, opts ...UpdateOption) error {
+	s.updates = append(s.updates, CollectUpdateOptions(opts...))
+	return nil
+}
+
+type noTx struct{}
+
+func (noTx) RunRepeatableRead(ctx context.Context, f func(txCtx context.Context) error) error {
+	return f(ctx)
+}
+
+func TestRegistry(t *testing.T) {
+	registry := NewRegistry()
+	created := Register[testPayload](registry, testAggregate, "TestCreated")
+
+	t.Run("событие создается и декодируется", func(t *testing.T) {
+		e, err := created.New("42", testPayload{Value: "hello"})
+		require.NoError(t, err)
+		require.NoError(t, registry.Validate(e))
+
+		payload, err := created.Decode(e)
+		require.NoError(t, err)
+		assert.Equal(t, "hello", payload.Value)
+	})
+
+	t.Run("незарегистрированное событие отклоняется", func(t *testing.T) {
+		unknown := EventDef[testPayload]{Aggregate: testAggregate, Type: "TestUnknown"}
+		e, err := unknown.New("42", testPayload{})
+		require.NoError(t, err)
+
+		writer := NewWriter(&memoryStore{}, registry)
+		assert.ErrorIs(t, writer.Write(context.Background(), e), ErrUnknownEvent)
+	})
+
+	t.Run("агрегаты для партиций", func(t *testing.T) {
+		assert.Equal(t, map[AggregateType][]EventType{testAggregate: {"TestCreated"}}, registry.Aggregates())
+	})
+}
+
+func TestWorkerFetch(t *testing.T) {
+	registry := NewRegistry()
+	created := Register[testPayload](registry, testAggregate, "TestCreated")
+
+	store := &memoryStore{}
+	writer := NewWriter(store, registry)
+	for i := 0; i < 2; i++ {
+		require.NoError(t, Emit(context.Background(), writer, created, "42", testPayload{}))
+	}
+	okID, failedID := store.events[0].ID, store.events[1].ID
+
+	publisher := PublisherFunc(func(_ context.Context, events []*Event) ([]uuid.UUID, []Failure, error) {
+		require.Len(t, events, 2)
+		return []uuid.UUID{okID}, []Failure{{ID: failedID, Err: errors.New("broker unavailable")}}, errors.New("partial failure")
+	})
+
+	now := time.Now().UTC().Add(time.Second)
+	newWorker := func(opts ...WorkerOption) *Worker {
+		store.updates = nil
+		w := NewWorker(testAggregate, store, noTx{}, publisher, opts...)
+		w.now = func() time.Time { return now }
+		return w
+	}
+
+	t.Run("неуспешное событие планируется с backoff", func(t *testing.T) {
+		worker := newWorker(WithBackoff(Backoff{Base: time.Minute, Max: time.Hour}))
+		require.NoError(t, worker.Fetch(context.Background()))
+
+		require.Len(t, store.updates, 2)
+		assert.Equal(t, []uuid.UUID{okID}, store.updates[0].IDs)
+		assert.NotNil(t, store.updates[0].SetPublishedAt)
+
+		failed := store.updates[1]
+		assert.Equal(t, []uuid.UUID{failedID}, failed.IDs)
+		assert.Equal(t, 1, failed.IncRetryBy)
+		require.NotNil(t, failed.SetLastError)
+		assert.Equal(t, "broker unavailable", *failed.SetLastError)
+		require.NotNil(t, failed.SetNextAttemptAt)
+		assert.Equal(t, now.Add(time.Minute), *failed.SetNextAttemptAt)
+		assert.Nil(t, failed.SetDeadLetteredAt)
+	})
+
+	t.Run("после maxRetry событие уходит в dead-letter", func(t *testing.T) {
+		store.events[1].RetryCount = 2
+		worker := newWorker(WithMaxRetry(3))
+		require.NoError(t, worker.Fetch(context.Background()))
+
+		require.Len(t, store.updates, 2)
+		failed := store.updates[1]
+		require.NotNil(t, failed.SetDeadLetteredAt)
+		assert.Equal(t, now, *failed.SetDeadLetteredAt)
+		assert.Nil(t, failed.SetNextAttemptAt)
+	})
+}
+
+func TestWorkerFetchRetryOutsideWindow(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	backoff := Backoff{Base: 30 * time.Second, Max: time.Hour}
+
+	// Событие с 7 неуспешными попытками: суммарный backoff уже больше окна в 1 час
+	var elapsed time.Duration
+	for attempt := 1; attempt <= 7; attempt++ {
+		elapsed += backoff.Delay(attempt)
+	}
+	require.Greater(t, elapsed, time.Hour)
+
+	nextAttemptAt := now.Add(-time.Second)
+	retried := &Event{
+		ID:            uuid.New(),
+		AggregateType: testAggregate,
+		EventType:     "TestCreated",
+		CreatedAt:     now.Add(-elapsed),
+		RetryCount:    7,
+		NextAttemptAt: &nextAttemptAt,
+	}
+	store := &memoryStore{events: []*Event{retried}}
+
+	var published []uuid.UUID
+	publisher := PublisherFunc(func(_ context.Context, events []*Event) ([]uuid.UUID, []Failure, error) {
+		for _, e := range events {
+			published = append(published, e.ID)
+		}
+		return []uuid.UUID{retried.ID}, nil, nil
+	})
+
+	worker := NewWorker(testAggregate, store, noTx{}, publisher,
+		WithWindow(time.Hour),
+		WithMaxRetry(10),
+		WithBackoff(backoff),
+	)
+	worker.now = func() time.Time { return now }
+
+	t.Run("повтор старше окна публикуется до исчерпания maxRetry", func(t *testing.T) {
+		require.NoError(t, worker.Fetch(context.Background()))
+		assert.Equal(t, []uuid.UUID{retried.ID}, published)
+
+		// Обновление не должно отсекаться окном по created_at
+		require.Len(t, store.updates, 1)
+		require.NotNil(t, store.updates[0].NotBefore)
+		assert.False(t, store.updates[0].NotBefore.After(retried.CreatedAt))
+		assert.NotNil(t, store.updates[0].SetPublishedAt)
+	})
+}
+
+func TestBackoffDelay(t *testing.T) {
+	b := Backoff{Base: time.Second, Max: 10 * time.Second}
+
+	t.Run("задержка растет экспоненциально до max", func(t *testing.T) {
+		assert.Equal(t, time.Second, b.Delay(1))
+		assert.Equal(t, 2*time.Second, b.Delay(2))
+		assert.Equal(t, 8*time.Second, b.Delay(4))
+		assert.Equal(t, 10*time.Second, b.Delay(5))
+		assert.Equal(t, 10*time.Second, b.Delay(100))
+	})
+
+	t.Run("jitter в диапазоне [delay/2, delay]", func(t *testing.T) {
+		jb := b
+		jb.Jitter = true
+		for i := 0; i < 100; i++ {
+			d := jb.Delay(3)
+			assert.GreaterOrEqual(t, d, 2*time.Second)
+			assert.LessOrEqual(t, d, 4*time.Second)
+		}
+	})
+}
@@ -0,0 +1,48 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+)
+
+// Writer записывает события в outbox в той же транзакции, что и изменение агрегата
+//
+// Транзакция берется из контекста (postgres.TransactionManager.RunReadCommitted и т.п.),
+// поэтому событие появится в outbox только вместе с коммитом бизнес-изменений.
+type Writer struct {
+	store    Store
+	registry *Registry
+}
+
+// NewWriter конструктор Writer
+func NewWriter(store Store, registry *Registry) *Writer {
+	return &Writer{
+		store:    store,
+		registry: registry,
+	}
+}
+
+// Write валидирует события по реестру и сохраняет их
+func (w *Writer) Write(ctx context.Context, events ...*Event) error {
+	const api = "[outbox.Writer][Write]"
+
+	for _, e := range events {
+		if err := w.registry.Validate(e); err != nil {
+			return fmt.Errorf("%s: %w", api, err)
+		}
+	}
+
+	if err := w.store.SaveEvents(ctx, events...); err != nil {
+		return fmt.Errorf("%s: %w", api, err)
+	}
+	return nil
+}
+
+// Emit создает событие по описанию и записывает его
+func Emit[P any](ctx context.Context, w *Writer, def EventDef[P], aggregateID string, payload P) error {
+	e, err := def.New(aggregateID, payload)
+	if err != nil {
+		return err
+	}
+	return w.Write(ctx, e)
+}
@@ -0,0 +1,76 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// unlistenTimeout таймаут на UNLISTEN перед возвратом соединения в пул
+const unlistenTimeout = 5 * time.Second
+
+// Notification - уведомление, полученное через LISTEN/NOTIFY
+type Notification struct {
+	// Channel канал, в который было отправлено уведомление
+	Channel string
+	// Payload полезная нагрузка pg_notify
+	Payload string
+}
+
+// NotificationHandler обработчик уведомлений LISTEN/NOTIFY
+type NotificationHandler func(ctx context.Context, n Notification)
+
+// Listen подписывается на канал через LISTEN и вызывает handler на каждое уведомление
+//
+// Под подписку из пула забирается отдельное соединение, которое удерживается до выхода.
+// Метод блокируется до отмены ctx или до ошибки соединения, переподключение - ответственность вызывающего.
+// Уведомления доставляются только после COMMIT транзакции, вызвавшей pg_notify.
+//
+// Пример:
+//
+//	err := conn.Listen(ctx, "chat_messages", func(ctx context.Context, n postgres.Notification) {
+//	    log.Println(n.Channel, n.Payload)
+//	})
+func (c *Connection) Listen(ctx context.Context, channel string, handler NotificationHandler) error {
+	poolConn, err := c.pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("acquire connection: %w", err)
+	}
+
+	conn := poolConn.Conn()
+	identifier := pgx.Identifier{channel}.Sanitize()
+
+	if _, err := conn.Exec(ctx, "LISTEN "+identifier); err != nil {
+		poolConn.Release()
+		return fmt.Errorf("listen %s: %w", channel, err)
+	}
+
+	defer func() {
+		// Соединение после отмены ожидания может быть в неконсистентном состоянии,
+		// поэтому при неудачном UNLISTEN закрываем его, чтобы пул его не переиспользовал
+		unlistenCtx, cancel := context.WithTimeout(context.Background(), unlistenTimeout)
+		defer cancel()
+
+		if _, err := conn.Exec(unlistenCtx, "UNLISTEN "+identifier); err != nil {
+			_ = conn.Close(unlistenCtx)
+		}
+		poolConn.Release()
+	}()
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return fmt.Errorf("wait for notification on %s: %w", channel, err)
+		}
+
+		handler(ctx, Notification{
+			Channel: notification.Channel,
+			Payload: notification.Payload,
+		})
+	}
+}
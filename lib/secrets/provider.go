@@ -16,3 +16,9 @@ type SecretsProvider interface {
 	// GetBytes получает секрет в виде байтов (для бинарных данных, например TLS ключей)
 	GetBytes(ctx context.Context, key string) ([]byte, error)
 }
+
+// SecretsWriter - провайдер, который умеет записывать секреты (реализуется Vault провайдером)
+type SecretsWriter interface {
+	// Set записывает секрет по ключу, остальные секреты по тому же пути сохраняются
+	Set(ctx context.Context, key, value string) error
+}
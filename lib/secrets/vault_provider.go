@@ -3,6 +3,7 @@ package secrets
 import (
 	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -152,6 +153,30 @@ func (p *vaultProvider) GetBytes(ctx context.Context, key string) ([]byte, error
 	return decoded, nil
 }
 
+// Set записывает секрет в Vault (KV v2 patch), остальные ключи по тому же пути сохраняются
+func (p *vaultProvider) Set(ctx context.Context, key, value string) error {
+	kv := p.client.KVv2(p.mountPath)
+	secretPath := p.buildSecretPath(key)
+	data := map[string]interface{}{key: value}
+
+	_, err := kv.Patch(ctx, secretPath, data)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, api.ErrSecretNotFound) {
+		return fmt.Errorf("failed to patch secret in vault: %w", err)
+	}
+
+	// Секрета по пути еще нет - создаем
+	if _, err := kv.Put(ctx, secretPath, data); err != nil {
+		return fmt.Errorf("failed to write secret to vault: %w", err)
+	}
+
+	return nil
+}
+
+var _ SecretsWriter = (*vaultProvider)(nil)
+
 // buildSecretPath формирует полный путь к секрету
 func (p *vaultProvider) buildSecretPath(key string) string {
 	if p.secretPath != "" {
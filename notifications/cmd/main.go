@@ -10,16 +10,26 @@ import (
 	"time"
 
 	"github.com/sskorolev/balun_microservices/lib/app"
+	"github.com/sskorolev/balun_microservices/lib/authmw"
 	"github.com/sskorolev/balun_microservices/lib/config"
 	"github.com/sskorolev/balun_microservices/lib/logger"
 
 	"notifications/internal/app/consumer"
 	"notifications/internal/app/delivery"
+	deliveryGrpc "notifications/internal/app/delivery/grpc"
+	"notifications/internal/app/dispatcher"
+	"notifications/internal/app/handlers"
+	"notifications/internal/app/hub"
+	"notifications/internal/app/models"
 	"notifications/internal/app/repository"
+	"notifications/internal/app/usecase"
 	"notifications/internal/app/worker"
+	errorsMiddleware "notifications/internal/middleware/errors"
 	workersConfig "notifications/internal/workers"
+	notificationsPb "notifications/pkg/api"
 
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
 )
 
 func main() {
@@ -71,10 +81,30 @@ func main() {
 		log.Fatalf("failed to load workers config: %v", err)
 	}
 
+	// Инициализируем auth компоненты (JWKS кеш и JWT validator)
+	authComponents, authCleanup, err := app.InitAuthComponents(
+		ctx,
+		cfg.AuthService,
+		"notifications", // audience для notifications сервиса
+	)
+	if err != nil {
+		logger.FatalKV(ctx, "failed to initialize auth components", "error", err.Error())
+	}
+	defer authCleanup()
+
 	inboxRepo := repository.NewRepository(application.TransactionManager())
 
 	handler := delivery.NewInboxHandler(inboxRepo)
 
+	// Хаб подписок StreamNotifications, рассылка между репликами через Postgres LISTEN/NOTIFY
+	notificationHub := hub.NewHub(hub.NewPostgresBackend(application.Postgres(), application.TransactionManager()))
+
+	// Обработчики событий: создают уведомления пользователей
+	friendRequestHandlers := handlers.NewFriendRequestHandlers(inboxRepo, notificationHub)
+	eventDispatcher := dispatcher.NewDispatcher()
+	eventDispatcher.Register(models.EventTypeFriendRequestCreated, dispatcher.HandlerFunc(friendRequestHandlers.HandleCreated))
+	eventDispatcher.Register(models.EventTypeFriendRequestStatusUpdated, dispatcher.HandlerFunc(friendRequestHandlers.HandleStatusUpdated))
+
 	// Создаем Kafka consumer
 	// Конвертируем строку с брокерами в слайс
 	brokers := strings.Split(cfg.KafkaConsumer.GetBrokers(), ",")
@@ -88,10 +118,47 @@ func main() {
 		log.Fatal(err)
 	}
 
+	controller := deliveryGrpc.NewNotificationController(usecase.NewUsecase(inboxRepo, notificationHub))
+
+	// Инициализируем gRPC сервер с JWT и errors middleware
+	application.InitGRPCServerWithStreams(
+		cfg.Server,
+		[]grpc.UnaryServerInterceptor{
+			errorsMiddleware.ErrorsUnaryInterceptor(),
+			authmw.UnaryServerInterceptor(authComponents.JWTValidator),
+		},
+		[]grpc.StreamServerInterceptor{
+			errorsMiddleware.ErrorsStreamInterceptor(),
+			authmw.StreamServerInterceptor(authComponents.JWTValidator),
+		},
+	)
+
+	// Регистрируем gRPC сервисы
+	application.RegisterGRPC(func(s *grpc.Server) {
+		notificationsPb.RegisterNotificationServiceServer(s, controller)
+	})
+
 	g, gCtx := errgroup.WithContext(ctx)
 
+	g.Go(func() error {
+		logger.InfoKV(gCtx, "starting notifications service gRPC server", "grpc_port", cfg.Server.GRPC.Port)
+		if err := application.Run(gCtx, *cfg.Server.GRPC); err != nil && !errors.Is(err, context.Canceled) {
+			return err
+		}
+		return nil
+	})
+
+	// Запускаем хаб подписок StreamNotifications
+	g.Go(func() error {
+		logger.InfoKV(gCtx, "starting notifications hub")
+		if err := notificationHub.Run(gCtx); err != nil && !errors.Is(err, context.Canceled) {
+			return err
+		}
+		return nil
+	})
+
 	// Запускаем воркер сохранения событий с настройками из конфигурации
-	saveEventsWorker := worker.NewSaveEventsWorker(inboxRepo, application.TransactionManager()).
+	saveEventsWorker := worker.NewSaveEventsWorker(inboxRepo, application.TransactionManager(), eventDispatcher).
 		WithTickInterval(workersCfg.SaveEvents.Interval).
 		WithBatchSize(workersCfg.SaveEvents.BatchSize)
 	g.Go(func() error {
@@ -132,6 +199,7 @@ func main() {
 	logger.InfoKV(ctx, "starting notifications service",
 		"version", cfg.Service.Version,
 		"environment", cfg.Service.Environment,
+		"grpc_port", cfg.Server.GRPC.Port,
 		"brokers", cfg.KafkaConsumer.GetBrokers(),
 	)
 
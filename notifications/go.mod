@@ -3,15 +3,21 @@ module notifications
 go 1.25.1
 
 require (
+	buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go v1.36.6-20250425153114-8976f5be98c1.1
 	github.com/IBM/sarama v1.46.1
 	github.com/Masterminds/squirrel v1.5.4
 	github.com/google/uuid v1.6.0
 	github.com/spf13/viper v1.21.0
 	github.com/sskorolev/balun_microservices/lib/app v0.0.0
+	github.com/sskorolev/balun_microservices/lib/authmw v0.0.0
 	github.com/sskorolev/balun_microservices/lib/config v0.0.0
+	github.com/sskorolev/balun_microservices/lib/hub v0.0.0
 	github.com/sskorolev/balun_microservices/lib/logger v0.0.0
 	github.com/sskorolev/balun_microservices/lib/postgres v0.0.0
+	github.com/stretchr/testify v1.11.1
 	golang.org/x/sync v0.17.0
+	google.golang.org/grpc v1.76.0
+	google.golang.org/protobuf v1.36.10
 )
 
 require (
@@ -31,6 +37,7 @@ require (
 	github.com/go-logr/logr v1.4.3 // indirect
 	github.com/go-logr/stdr v1.2.2 // indirect
 	github.com/go-viper/mapstructure/v2 v2.4.0 // indirect
+	github.com/golang-jwt/jwt/v5 v5.3.0 // indirect
 	github.com/golang/snappy v0.0.4 // indirect
 	github.com/grpc-ecosystem/go-grpc-middleware/providers/prometheus v1.1.0 // indirect
 	github.com/grpc-ecosystem/go-grpc-middleware/v2 v2.3.2 // indirect
@@ -67,6 +74,7 @@ require (
 	github.com/opentracing/opentracing-go v1.2.0 // indirect
 	github.com/pelletier/go-toml/v2 v2.2.4 // indirect
 	github.com/pierrec/lz4/v4 v4.1.22 // indirect
+	github.com/pmezard/go-difflib v1.0.1-0.20181226105442-5d4384ee4fb2 // indirect
 	github.com/prometheus/client_golang v1.23.2 // indirect
 	github.com/prometheus/client_model v0.6.2 // indirect
 	github.com/prometheus/common v0.67.1 // indirect
@@ -107,8 +115,6 @@ require (
 	golang.org/x/time v0.14.0 // indirect
 	google.golang.org/genproto/googleapis/api v0.0.0-20251014184007-4626949a642f // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20251014184007-4626949a642f // indirect
-	google.golang.org/grpc v1.76.0 // indirect
-	google.golang.org/protobuf v1.36.10 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
 
@@ -118,6 +124,8 @@ replace github.com/sskorolev/balun_microservices/lib/config => ../lib/config
 
 replace github.com/sskorolev/balun_microservices/lib/postgres => ../lib/postgres
 
+replace github.com/sskorolev/balun_microservices/lib/hub => ../lib/hub
+
 replace github.com/sskorolev/balun_microservices/lib/secrets => ../lib/secrets
 
 replace github.com/sskorolev/balun_microservices/lib/grpc => ../lib/grpc
@@ -129,3 +137,5 @@ replace github.com/sskorolev/balun_microservices/lib/metrics => ../lib/metrics
 replace github.com/sskorolev/balun_microservices/lib/admin => ../lib/admin
 
 replace github.com/sskorolev/balun_microservices/lib/logger => ../lib/logger
+
+replace github.com/sskorolev/balun_microservices/lib/authmw => ../lib/authmw
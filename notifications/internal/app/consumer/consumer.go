@@ -90,7 +90,7 @@ func (h *consumerGroupHandler) ConsumeClaim(sess sarama.ConsumerGroupSession, cl
 		for _, msg := range batch {
 			needMark, err := h.c.handler.SaveInboxMessage(sess.Context(), msg)
 			if err != nil {
-				log.Printf(err.Error())
+				log.Print(err.Error())
 			}
 			if needMark {
 				sess.MarkMessage(msg, "")
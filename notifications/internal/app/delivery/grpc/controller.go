@@ -0,0 +1,33 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/sskorolev/balun_microservices/lib/authmw"
+
+	"notifications/internal/app/usecase"
+	pb "notifications/pkg/api"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type NotificationController struct {
+	pb.NotificationServiceServer
+	usecase usecase.Usecase
+}
+
+func NewNotificationController(usecase usecase.Usecase) *NotificationController {
+	return &NotificationController{
+		usecase: usecase,
+	}
+}
+
+// userIDFromContext возвращает пользователя из JWT, проверенного authmw интерцептором
+func userIDFromContext(ctx context.Context) (string, error) {
+	userID, ok := authmw.GetUserID(ctx)
+	if !ok || userID == "" {
+		return "", status.Error(codes.Unauthenticated, "user is not authenticated")
+	}
+	return userID, nil
+}
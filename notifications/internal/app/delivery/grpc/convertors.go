@@ -0,0 +1,34 @@
+package grpc
+
+import (
+	"notifications/internal/app/models"
+	pb "notifications/pkg/api"
+)
+
+func newPbNotificationFromNotification(n *models.Notification) *pb.Notification {
+	var readAtUnixMs *int64
+	if n.ReadAt != nil {
+		ms := n.ReadAt.UnixMilli()
+		readAtUnixMs = &ms
+	}
+
+	return &pb.Notification{
+		NotificationId:  n.ID.String(),
+		Kind:            string(n.Kind),
+		Text:            n.Text,
+		Data:            n.Data,
+		Read:            n.Read,
+		CreatedAtUnixMs: n.CreatedAt.UnixMilli(),
+		ReadAtUnixMs:    readAtUnixMs,
+	}
+}
+
+func newPbNotificationsFromNotifications(notifications []*models.Notification) []*pb.Notification {
+	results := make([]*pb.Notification, len(notifications))
+
+	for i, n := range notifications {
+		results[i] = newPbNotificationFromNotification(n)
+	}
+
+	return results
+}
@@ -0,0 +1,23 @@
+package grpc
+
+import (
+	"context"
+
+	pb "notifications/pkg/api"
+)
+
+func (h *NotificationController) GetUnreadCount(ctx context.Context, _ *pb.GetUnreadCountRequest) (*pb.GetUnreadCountResponse, error) {
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := h.usecase.GetUnreadCount(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetUnreadCountResponse{
+		Count: count,
+	}, nil
+}
@@ -0,0 +1,31 @@
+package grpc
+
+import (
+	"context"
+
+	"notifications/internal/app/usecase/dto"
+
+	pb "notifications/pkg/api"
+)
+
+func (h *NotificationController) ListNotifications(ctx context.Context, req *pb.ListNotificationsRequest) (*pb.ListNotificationsResponse, error) {
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := h.usecase.ListNotifications(ctx, dto.ListNotificationsDto{
+		UserID:     userID,
+		Limit:      req.Limit,
+		Cursor:     req.Cursor,
+		UnreadOnly: req.UnreadOnly,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListNotificationsResponse{
+		Notifications: newPbNotificationsFromNotifications(response.Notifications),
+		NextCursor:    response.NextCursor,
+	}, nil
+}
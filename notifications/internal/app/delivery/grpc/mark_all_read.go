@@ -0,0 +1,23 @@
+package grpc
+
+import (
+	"context"
+
+	pb "notifications/pkg/api"
+)
+
+func (h *NotificationController) MarkAllRead(ctx context.Context, _ *pb.MarkAllReadRequest) (*pb.MarkAllReadResponse, error) {
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := h.usecase.MarkAllRead(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.MarkAllReadResponse{
+		Updated: updated,
+	}, nil
+}
@@ -0,0 +1,28 @@
+package grpc
+
+import (
+	"context"
+
+	"notifications/internal/app/usecase/dto"
+
+	pb "notifications/pkg/api"
+)
+
+func (h *NotificationController) MarkRead(ctx context.Context, req *pb.MarkReadRequest) (*pb.MarkReadResponse, error) {
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := h.usecase.MarkRead(ctx, dto.MarkReadDto{
+		UserID:          userID,
+		NotificationIDs: req.NotificationIds,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.MarkReadResponse{
+		Updated: updated,
+	}, nil
+}
@@ -0,0 +1,38 @@
+package grpc
+
+import (
+	"notifications/internal/app/models"
+
+	pb "notifications/pkg/api"
+
+	"google.golang.org/grpc/status"
+)
+
+func (h *NotificationController) StreamNotifications(_ *pb.StreamNotificationsRequest, stream pb.NotificationService_StreamNotificationsServer) error {
+	ctx := stream.Context()
+
+	userID, err := userIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	notifications, err := h.usecase.StreamNotifications(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	for n := range notifications {
+		if err := stream.Send(&pb.StreamNotificationsResponse{
+			Notification: newPbNotificationFromNotification(n),
+		}); err != nil {
+			return err
+		}
+	}
+
+	// Канал закрыт: либо клиент отключился, либо хаб отключил медленного подписчика
+	if err := ctx.Err(); err != nil {
+		return status.FromContextError(err).Err()
+	}
+
+	return models.ErrSlowSubscriber
+}
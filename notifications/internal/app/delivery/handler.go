@@ -12,7 +12,10 @@ import (
 	"github.com/google/uuid"
 )
 
-const headerEventID = "event_id"
+const (
+	headerEventID   = "event_id"
+	headerEventType = "event_type"
+)
 
 // Repository интерфейс для работы с хранилищем inbox сообщений
 type Repository interface {
@@ -33,7 +36,7 @@ func NewInboxHandler(repository Repository) *InboxHandler {
 
 // SaveInboxMessage преобразует Kafka сообщение в InboxMessage и сохраняет в БД
 func (h *InboxHandler) SaveInboxMessage(ctx context.Context, message *sarama.ConsumerMessage) (needMark bool, err error) {
-	id, ok := extractID(message)
+	id, ok := extractHeader(message, headerEventID)
 	if !ok {
 		// без ID не можем гарантировать идемпотентность — безопаснее скипнуть и скоммитить,
 		// либо отправить в DLQ.
@@ -55,11 +58,15 @@ func (h *InboxHandler) SaveInboxMessage(ctx context.Context, message *sarama.Con
 		return needMark, err
 	}
 
+	// Тип события нужен диспетчеру уведомлений, сообщение без типа будет пропущено при обработке
+	eventType, _ := extractHeader(message, headerEventType)
+
 	inboxMsg := &models.InboxMessage{
 		ID:          messageID,
 		Topic:       message.Topic,
 		Partition:   int(message.Partition),
 		Offset:      message.Offset,
+		EventType:   eventType,
 		Payload:     message.Value,
 		Status:      models.InboxMessageStatusReceived,
 		Attempts:    0,
@@ -84,9 +91,9 @@ func (h *InboxHandler) SaveInboxMessage(ctx context.Context, message *sarama.Con
 	return needMark, err
 }
 
-func extractID(msg *sarama.ConsumerMessage) (string, bool) {
+func extractHeader(msg *sarama.ConsumerMessage, key string) (string, bool) {
 	for _, h := range msg.Headers {
-		if strings.EqualFold(string(h.Key), headerEventID) && len(h.Value) > 0 {
+		if strings.EqualFold(string(h.Key), key) && len(h.Value) > 0 {
 			return string(h.Value), true
 		}
 	}
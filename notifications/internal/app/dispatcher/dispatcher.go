@@ -0,0 +1,60 @@
+package dispatcher
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sskorolev/balun_microservices/lib/logger"
+
+	"notifications/internal/app/models"
+)
+
+// Handler обработчик события одного типа
+type Handler interface {
+	Handle(ctx context.Context, msg models.InboxMessage) error
+}
+
+// HandlerFunc адаптер функции к Handler
+type HandlerFunc func(ctx context.Context, msg models.InboxMessage) error
+
+// Handle вызывает f
+func (f HandlerFunc) Handle(ctx context.Context, msg models.InboxMessage) error {
+	return f(ctx, msg)
+}
+
+// Dispatcher направляет сообщения inbox обработчикам по типу события
+type Dispatcher struct {
+	handlers map[string]Handler
+}
+
+// NewDispatcher конструктор Dispatcher
+func NewDispatcher() *Dispatcher {
+	return &Dispatcher{
+		handlers: make(map[string]Handler),
+	}
+}
+
+// Register регистрирует обработчик типа события, повторная регистрация - ошибка конфигурации
+func (d *Dispatcher) Register(eventType string, h Handler) {
+	if _, ok := d.handlers[eventType]; ok {
+		panic(fmt.Sprintf("dispatcher: handler for %s already registered", eventType))
+	}
+	d.handlers[eventType] = h
+}
+
+// Dispatch передает сообщение обработчику его типа
+//
+// Сообщения без обработчика пропускаются: повторная обработка их не изменит.
+func (d *Dispatcher) Dispatch(ctx context.Context, msg models.InboxMessage) error {
+	h, ok := d.handlers[msg.EventType]
+	if !ok {
+		logger.WarnKV(ctx, "dispatcher: no handler for event, skip",
+			"id", msg.ID.String(), "event_type", msg.EventType, "topic", msg.Topic)
+		return nil
+	}
+
+	if err := h.Handle(ctx, msg); err != nil {
+		return fmt.Errorf("dispatcher: handle %s: %w", msg.EventType, err)
+	}
+	return nil
+}
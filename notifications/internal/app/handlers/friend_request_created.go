@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"notifications/internal/app/models"
+)
+
+// HandleCreated уведомляет получателя о новой заявке в друзья
+func (h *FriendRequestHandlers) HandleCreated(ctx context.Context, msg models.InboxMessage) error {
+	const api = "[FriendRequestHandlers][HandleCreated]"
+
+	var event models.FriendRequestCreatedEvent
+	if err := json.Unmarshal(msg.Payload, &event); err != nil {
+		return fmt.Errorf("%s: decode payload: %w", api, err)
+	}
+	if event.FriendRequestID == "" || event.FromUserID == "" || event.ToUserID == "" {
+		return fmt.Errorf("%s: incomplete payload", api)
+	}
+
+	n := &models.Notification{
+		ID:     uuid.New(),
+		UserID: event.ToUserID,
+		Kind:   models.NotificationKindFriendRequestReceived,
+		Text:   fmt.Sprintf("Пользователь %s отправил вам заявку в друзья", event.FromUserID),
+		Data: map[string]string{
+			"friend_request_id": event.FriendRequestID,
+			"from_user_id":      event.FromUserID,
+		},
+		SourceEventID: msg.ID,
+		CreatedAt:     time.Now().UTC(),
+	}
+
+	if err := h.save(ctx, n); err != nil {
+		return fmt.Errorf("%s: %w", api, err)
+	}
+	return nil
+}
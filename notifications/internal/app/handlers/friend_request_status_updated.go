@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"notifications/internal/app/models"
+)
+
+// HandleStatusUpdated уведомляет автора заявки о ее принятии или отклонении
+func (h *FriendRequestHandlers) HandleStatusUpdated(ctx context.Context, msg models.InboxMessage) error {
+	const api = "[FriendRequestHandlers][HandleStatusUpdated]"
+
+	var event models.FriendRequestStatusUpdatedEvent
+	if err := json.Unmarshal(msg.Payload, &event); err != nil {
+		return fmt.Errorf("%s: decode payload: %w", api, err)
+	}
+	if event.FriendRequestID == "" || event.FromUserID == "" || event.ToUserID == "" {
+		return fmt.Errorf("%s: incomplete payload", api)
+	}
+
+	var (
+		kind models.NotificationKind
+		text string
+	)
+	switch event.Status {
+	case models.FriendRequestStatusAccepted:
+		kind = models.NotificationKindFriendRequestAccepted
+		text = fmt.Sprintf("Пользователь %s принял вашу заявку в друзья", event.ToUserID)
+	case models.FriendRequestStatusDeclined:
+		kind = models.NotificationKindFriendRequestDeclined
+		text = fmt.Sprintf("Пользователь %s отклонил вашу заявку в друзья", event.ToUserID)
+	default:
+		// Остальные статусы не требуют уведомления
+		return nil
+	}
+
+	n := &models.Notification{
+		ID:     uuid.New(),
+		UserID: event.FromUserID,
+		Kind:   kind,
+		Text:   text,
+		Data: map[string]string{
+			"friend_request_id": event.FriendRequestID,
+			"to_user_id":        event.ToUserID,
+		},
+		SourceEventID: msg.ID,
+		CreatedAt:     time.Now().UTC(),
+	}
+
+	if err := h.save(ctx, n); err != nil {
+		return fmt.Errorf("%s: %w", api, err)
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"notifications/internal/app/models"
+)
+
+type memoryRepo struct {
+	saved []*models.Notification
+}
+
+func (r *memoryRepo) SaveNotification(_ context.Context, n *models.Notification) (bool, error) {
+	for _, s := range r.saved {
+		if s.SourceEventID == n.SourceEventID && s.UserID == n.UserID && s.Kind == n.Kind {
+			return false, nil
+		}
+	}
+	r.saved = append(r.saved, n)
+	return true, nil
+}
+
+type memoryPublisher struct {
+	published []*models.Notification
+}
+
+func (p *memoryPublisher) Publish(_ context.Context, n *models.Notification) error {
+	p.published = append(p.published, n)
+	return nil
+}
+
+func TestFriendRequestHandlers(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("новая заявка уведомляет получателя", func(t *testing.T) {
+		repo := &memoryRepo{}
+		h := NewFriendRequestHandlers(repo, &memoryPublisher{})
+		msg := models.InboxMessage{
+			ID:        uuid.New(),
+			EventType: models.EventTypeFriendRequestCreated,
+			Payload:   []byte(`{"friend_request_id":"fr-1","from_user_id":"u-1","to_user_id":"u-2"}`),
+		}
+
+		require.NoError(t, h.HandleCreated(ctx, msg))
+		require.Len(t, repo.saved, 1)
+		assert.Equal(t, "u-2", repo.saved[0].UserID)
+		assert.Equal(t, models.NotificationKindFriendRequestReceived, repo.saved[0].Kind)
+		assert.Equal(t, msg.ID, repo.saved[0].SourceEventID)
+		assert.Equal(t, "fr-1", repo.saved[0].Data["friend_request_id"])
+		assert.False(t, repo.saved[0].Read)
+	})
+
+	t.Run("принятие заявки уведомляет автора", func(t *testing.T) {
+		repo := &memoryRepo{}
+		h := NewFriendRequestHandlers(repo, &memoryPublisher{})
+		msg := models.InboxMessage{
+			ID:      uuid.New(),
+			Payload: []byte(`{"friend_request_id":"fr-1","from_user_id":"u-1","to_user_id":"u-2","status":"accepted"}`),
+		}
+
+		require.NoError(t, h.HandleStatusUpdated(ctx, msg))
+		require.Len(t, repo.saved, 1)
+		assert.Equal(t, "u-1", repo.saved[0].UserID)
+		assert.Equal(t, models.NotificationKindFriendRequestAccepted, repo.saved[0].Kind)
+	})
+
+	t.Run("статус pending не создает уведомление", func(t *testing.T) {
+		repo := &memoryRepo{}
+		h := NewFriendRequestHandlers(repo, &memoryPublisher{})
+		msg := models.InboxMessage{
+			ID:      uuid.New(),
+			Payload: []byte(`{"friend_request_id":"fr-1","from_user_id":"u-1","to_user_id":"u-2","status":"pending"}`),
+		}
+
+		require.NoError(t, h.HandleStatusUpdated(ctx, msg))
+		assert.Empty(t, repo.saved)
+	})
+
+	t.Run("повтор события не рассылает уведомление повторно", func(t *testing.T) {
+		repo := &memoryRepo{}
+		publisher := &memoryPublisher{}
+		h := NewFriendRequestHandlers(repo, publisher)
+		msg := models.InboxMessage{
+			ID:      uuid.New(),
+			Payload: []byte(`{"friend_request_id":"fr-1","from_user_id":"u-1","to_user_id":"u-2"}`),
+		}
+
+		require.NoError(t, h.HandleCreated(ctx, msg))
+		require.NoError(t, h.HandleCreated(ctx, msg))
+		assert.Len(t, repo.saved, 1)
+		require.Len(t, publisher.published, 1)
+		assert.Equal(t, "u-2", publisher.published[0].UserID)
+	})
+
+	t.Run("битое тело события - ошибка", func(t *testing.T) {
+		h := NewFriendRequestHandlers(&memoryRepo{}, &memoryPublisher{})
+		err := h.HandleCreated(ctx, models.InboxMessage{ID: uuid.New(), Payload: []byte(`{`)})
+		assert.Error(t, err)
+	})
+}
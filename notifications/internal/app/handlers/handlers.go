@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"context"
+
+	"notifications/internal/app/models"
+)
+
+type (
+	// NotificationRepository хранилище уведомлений
+	NotificationRepository interface {
+		SaveNotification(ctx context.Context, n *models.Notification) (bool, error)
+	}
+
+	// NotificationPublisher рассылка новых уведомлений подписчикам StreamNotifications
+	NotificationPublisher interface {
+		Publish(ctx context.Context, n *models.Notification) error
+	}
+)
+
+// FriendRequestHandlers создают уведомления по событиям заявок в друзья
+type FriendRequestHandlers struct {
+	repo      NotificationRepository
+	publisher NotificationPublisher
+}
+
+// NewFriendRequestHandlers конструктор FriendRequestHandlers
+func NewFriendRequestHandlers(repo NotificationRepository, publisher NotificationPublisher) *FriendRequestHandlers {
+	return &FriendRequestHandlers{
+		repo:      repo,
+		publisher: publisher,
+	}
+}
+
+// save сохраняет уведомление и рассылает его подписчикам, если оно создано впервые
+func (h *FriendRequestHandlers) save(ctx context.Context, n *models.Notification) error {
+	created, err := h.repo.SaveNotification(ctx, n)
+	if err != nil {
+		return err
+	}
+	if !created {
+		// Повторная обработка события: уведомление уже было разослано
+		return nil
+	}
+
+	return h.publisher.Publish(ctx, n)
+}
@@ -0,0 +1,24 @@
+package hub
+
+import (
+	libhub "github.com/sskorolev/balun_microservices/lib/hub"
+
+	"notifications/internal/app/models"
+	"notifications/internal/app/usecase"
+)
+
+// Hub хаб подписок на новые уведомления, сгруппированных по получателям
+type Hub = libhub.Hub[string, models.Notification]
+
+// Проверка удовлетворению интерфейсу usecase.NotificationHub
+var _ usecase.NotificationHub = (*Hub)(nil)
+
+// NewHub конструктор Hub
+func NewHub(backend libhub.Backend[models.Notification], opts ...libhub.Option) *Hub {
+	return libhub.NewHub(backend, notificationUserID, opts...)
+}
+
+// notificationUserID уведомление раздается подписчикам его получателя
+func notificationUserID(n *models.Notification) string {
+	return n.UserID
+}
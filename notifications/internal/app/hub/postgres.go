@@ -0,0 +1,70 @@
+package hub
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	libhub "github.com/sskorolev/balun_microservices/lib/hub"
+	"github.com/sskorolev/balun_microservices/lib/postgres"
+
+	"notifications/internal/app/models"
+)
+
+// DefaultNotifyChannel канал LISTEN/NOTIFY для новых уведомлений
+const DefaultNotifyChannel = "user_notifications"
+
+// NewPostgresBackend backend рассылки новых уведомлений через Postgres LISTEN/NOTIFY
+//
+// Уведомления создаются воркером inbox внутри транзакции, поэтому клиент получит уведомление только после ее COMMIT.
+// Уведомления, отправленные во время переподключения, в стрим не попадут, клиент получит их через ListNotifications.
+func NewPostgresBackend(
+	conn *postgres.Connection,
+	tm postgres.TransactionManagerAPI,
+	opts ...libhub.PostgresBackendOption,
+) *libhub.PostgresBackend[models.Notification] {
+	return libhub.NewPostgresBackend(conn, tm, DefaultNotifyChannel, notificationCodec, opts...)
+}
+
+// notificationCodec кодирование уведомления в payload pg_notify
+var notificationCodec = libhub.Codec[models.Notification]{
+	Encode: encodeNotification,
+	Decode: decodeNotification,
+}
+
+// notificationPayload payload pg_notify о новом уведомлении
+type notificationPayload struct {
+	ID        uuid.UUID         `json:"id"`
+	UserID    string            `json:"user_id"`
+	Kind      string            `json:"kind"`
+	Text      string            `json:"text"`
+	Data      map[string]string `json:"data,omitempty"`
+	CreatedAt time.Time         `json:"created_at"`
+}
+
+func encodeNotification(n *models.Notification) ([]byte, error) {
+	return json.Marshal(notificationPayload{
+		ID:        n.ID,
+		UserID:    n.UserID,
+		Kind:      string(n.Kind),
+		Text:      n.Text,
+		Data:      n.Data,
+		CreatedAt: n.CreatedAt,
+	})
+}
+
+func decodeNotification(data []byte) (*models.Notification, error) {
+	var payload notificationPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, err
+	}
+
+	return &models.Notification{
+		ID:        payload.ID,
+		UserID:    payload.UserID,
+		Kind:      models.NotificationKind(payload.Kind),
+		Text:      payload.Text,
+		Data:      payload.Data,
+		CreatedAt: payload.CreatedAt,
+	}, nil
+}
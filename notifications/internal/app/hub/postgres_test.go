@@ -0,0 +1,38 @@
+package hub
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"notifications/internal/app/models"
+)
+
+func TestNotificationCodec(t *testing.T) {
+	t.Run("уведомление переживает кодирование", func(t *testing.T) {
+		n := &models.Notification{
+			ID:        uuid.New(),
+			UserID:    "u-1",
+			Kind:      models.NotificationKindFriendRequestReceived,
+			Text:      "заявка в друзья",
+			Data:      map[string]string{"request_id": "r-1"},
+			CreatedAt: time.Now().UTC().Truncate(time.Millisecond),
+		}
+
+		payload, err := notificationCodec.Encode(n)
+		require.NoError(t, err)
+
+		got, err := notificationCodec.Decode(payload)
+		require.NoError(t, err)
+		assert.Equal(t, n, got)
+		assert.Equal(t, "u-1", notificationUserID(got))
+	})
+
+	t.Run("некорректный payload", func(t *testing.T) {
+		_, err := notificationCodec.Decode([]byte("not json"))
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,9 @@
+package models
+
+import "errors"
+
+var (
+	ErrInvalidCursor  = errors.New("invalid cursor")
+	ErrInvalidID      = errors.New("invalid notification id")
+	ErrSlowSubscriber = errors.New("subscriber is too slow")
+)
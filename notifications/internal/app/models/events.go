@@ -0,0 +1,35 @@
+package models
+
+import "time"
+
+// Типы событий из заголовка event_type Kafka сообщения
+const (
+	EventTypeFriendRequestCreated       = "FriendRequestCreated"
+	EventTypeFriendRequestStatusUpdated = "FriendRequestStatusUpdated"
+)
+
+// Статусы заявки в событии FriendRequestStatusUpdated
+const (
+	FriendRequestStatusPending   = "pending"
+	FriendRequestStatusAccepted  = "accepted"
+	FriendRequestStatusDeclined  = "declined"
+	FriendRequestStatusCancelled = "cancelled"
+	FriendRequestStatusExpired   = "expired"
+)
+
+// FriendRequestCreatedEvent тело события FriendRequestCreated
+type FriendRequestCreatedEvent struct {
+	FriendRequestID string     `json:"friend_request_id"`
+	FromUserID      string     `json:"from_user_id"`
+	ToUserID        string     `json:"to_user_id"`
+	CreatedAt       *time.Time `json:"created_at,omitempty"`
+}
+
+// FriendRequestStatusUpdatedEvent тело события FriendRequestStatusUpdated
+type FriendRequestStatusUpdatedEvent struct {
+	FriendRequestID string     `json:"friend_request_id"`
+	FromUserID      string     `json:"from_user_id"`
+	ToUserID        string     `json:"to_user_id"`
+	Status          string     `json:"status"`
+	UpdatedAt       *time.Time `json:"updated_at,omitempty"`
+}
@@ -15,10 +15,12 @@ const (
 
 // InboxMessage представляет входящее сообщение из Kafka для обработки
 type InboxMessage struct {
-	ID          uuid.UUID
-	Topic       string
-	Partition   int
-	Offset      int64
+	ID        uuid.UUID
+	Topic     string
+	Partition int
+	Offset    int64
+	// EventType тип события из заголовка event_type, пусто для сообщений без заголовка
+	EventType   string
 	Payload     []byte
 	Status      string
 	Attempts    int
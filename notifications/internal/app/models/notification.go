@@ -0,0 +1,33 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NotificationKind вид уведомления
+type NotificationKind string
+
+const (
+	NotificationKindFriendRequestReceived NotificationKind = "friend_request_received"
+	NotificationKindFriendRequestAccepted NotificationKind = "friend_request_accepted"
+	NotificationKindFriendRequestDeclined NotificationKind = "friend_request_declined"
+)
+
+// Notification уведомление пользователя
+type Notification struct {
+	ID uuid.UUID
+	// UserID получатель уведомления
+	UserID string
+	Kind   NotificationKind
+	// Text отрендеренный текст уведомления
+	Text string
+	// Data параметры уведомления для клиента (id заявки, id автора и т.п.)
+	Data map[string]string
+	Read bool
+	// SourceEventID id события, из которого создано уведомление
+	SourceEventID uuid.UUID
+	CreatedAt     time.Time
+	ReadAt        *time.Time
+}
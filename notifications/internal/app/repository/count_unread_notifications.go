@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sskorolev/balun_microservices/lib/postgres"
+
+	"github.com/Masterminds/squirrel"
+)
+
+// CountUnreadNotifications возвращает количество непрочитанных уведомлений пользователя
+//
+// Запрос обслуживается частичным индексом idx_notifications_user_unread.
+func (r *Repository) CountUnreadNotifications(ctx context.Context, userID string) (int64, error) {
+	const api = "notification.Repository.CountUnreadNotifications"
+
+	qb := r.qb.Select("count(*)").
+		From(NotificationsTable).
+		Where(squirrel.Eq{
+			NotificationsTableColumnUserID: userID,
+			NotificationsTableColumnRead:   false,
+		})
+
+	conn := r.db.GetQueryEngine(ctx)
+	var count int64
+	if err := conn.Getx(ctx, &count, qb); err != nil {
+		return 0, fmt.Errorf("%s: %w", api, postgres.ConvertPGError(err))
+	}
+
+	return count, nil
+}
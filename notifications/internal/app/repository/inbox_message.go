@@ -28,6 +28,7 @@ func ToModel(r *inboxMessage) *models.InboxMessage {
 		Topic:       r.Topic,
 		Partition:   r.Partition,
 		Offset:      r.Offset,
+		EventType:   r.EventType.V,
 		Payload:     r.Payload,
 		Status:      r.Status,
 		Attempts:    r.Attempts,
@@ -58,6 +59,7 @@ func FromModel(m *models.InboxMessage) inboxMessage {
 		Topic:       m.Topic,
 		Partition:   m.Partition,
 		Offset:      m.Offset,
+		EventType:   sql.Null[string]{V: m.EventType, Valid: m.EventType != ""},
 		Payload:     m.Payload,
 		Status:      m.Status,
 		Attempts:    m.Attempts,
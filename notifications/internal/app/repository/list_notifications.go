@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sskorolev/balun_microservices/lib/postgres"
+
+	"notifications/internal/app/models"
+
+	"github.com/Masterminds/squirrel"
+)
+
+// ListNotifications возвращает ленту уведомлений пользователя с cursor-based пагинацией
+//
+// Уведомления возвращаются от новых к старым по (created_at, id), запрос обслуживается
+// индексом idx_notifications_user_created. Курсор - непрозрачная строка с позицией
+// последнего уведомления страницы.
+func (r *Repository) ListNotifications(
+	ctx context.Context,
+	userID string,
+	limit int64,
+	cursor *string,
+	unreadOnly bool,
+) (notifications []*models.Notification, nextCursor *string, err error) {
+	const api = "notification.Repository.ListNotifications"
+
+	qb := r.qb.Select(NotificationsTableColumns...).
+		From(NotificationsTable).
+		Where(squirrel.Eq{NotificationsTableColumnUserID: userID}).
+		OrderBy(
+			NotificationsTableColumnCreatedAt+" DESC",
+			NotificationsTableColumnID+" DESC",
+		)
+
+	if unreadOnly {
+		qb = qb.Where(squirrel.Eq{NotificationsTableColumnRead: false})
+	}
+
+	// Если есть cursor, продолжаем со следующего после него уведомления
+	if cursor != nil && *cursor != "" {
+		position, err := decodeNotificationCursor(*cursor)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", api, err)
+		}
+
+		qb = qb.Where(
+			squirrel.Expr(
+				"("+NotificationsTableColumnCreatedAt+", "+NotificationsTableColumnID+") < (?, ?)",
+				position.CreatedAt, position.ID,
+			),
+		)
+	}
+
+	// Запрашиваем limit + 1 уведомлений, чтобы понять, есть ли еще данные
+	qb = qb.Limit(uint64(limit + 1))
+
+	conn := r.db.GetQueryEngine(ctx)
+	var rows []notification
+	if err := conn.Selectx(ctx, &rows, qb); err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", api, postgres.ConvertPGError(err))
+	}
+
+	hasMore := len(rows) > int(limit)
+	if hasMore {
+		rows = rows[:limit]
+	}
+
+	result := make([]*models.Notification, 0, len(rows))
+	for i := range rows {
+		n, err := notificationToModel(&rows[i])
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", api, err)
+		}
+		result = append(result, n)
+	}
+
+	if hasMore && len(result) > 0 {
+		next := encodeNotificationCursor(result[len(result)-1])
+		nextCursor = &next
+	}
+
+	return result, nextCursor, nil
+}
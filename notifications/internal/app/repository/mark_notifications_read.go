@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sskorolev/balun_microservices/lib/postgres"
+
+	"github.com/Masterminds/squirrel"
+)
+
+// MarkNotificationsRead отмечает прочитанными уведомления пользователя из ids
+//
+// Чужие и уже прочитанные уведомления не изменяются, возвращается количество отмеченных.
+func (r *Repository) MarkNotificationsRead(ctx context.Context, userID string, ids []uuid.UUID, readAt time.Time) (int64, error) {
+	const api = "notification.Repository.MarkNotificationsRead"
+
+	qb := r.qb.Update(NotificationsTable).
+		Set(NotificationsTableColumnRead, true).
+		Set(NotificationsTableColumnReadAt, readAt).
+		Where(squirrel.Eq{
+			NotificationsTableColumnUserID: userID,
+			NotificationsTableColumnID:     ids,
+			NotificationsTableColumnRead:   false,
+		})
+
+	conn := r.db.GetQueryEngine(ctx)
+	tag, err := conn.Execx(ctx, qb)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", api, postgres.ConvertPGError(err))
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+// MarkAllNotificationsRead отмечает прочитанными все уведомления пользователя
+func (r *Repository) MarkAllNotificationsRead(ctx context.Context, userID string, readAt time.Time) (int64, error) {
+	const api = "notification.Repository.MarkAllNotificationsRead"
+
+	qb := r.qb.Update(NotificationsTable).
+		Set(NotificationsTableColumnRead, true).
+		Set(NotificationsTableColumnReadAt, readAt).
+		Where(squirrel.Eq{
+			NotificationsTableColumnUserID: userID,
+			NotificationsTableColumnRead:   false,
+		})
+
+	conn := r.db.GetQueryEngine(ctx)
+	tag, err := conn.Execx(ctx, qb)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", api, postgres.ConvertPGError(err))
+	}
+
+	return tag.RowsAffected(), nil
+}
@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"notifications/internal/app/models"
+)
+
+// notificationCursor позиция в ленте уведомлений для keyset пагинации по (created_at, id)
+type notificationCursor struct {
+	CreatedAt time.Time `json:"t"`
+	ID        uuid.UUID `json:"id"`
+}
+
+// encodeNotificationCursor кодирует позицию уведомления в непрозрачную для клиента строку
+func encodeNotificationCursor(n *models.Notification) string {
+	data, _ := json.Marshal(notificationCursor{
+		CreatedAt: n.CreatedAt,
+		ID:        n.ID,
+	})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeNotificationCursor декодирует курсор, полученный от клиента
+func decodeNotificationCursor(cursor string) (*notificationCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrInvalidCursor, err)
+	}
+
+	var c notificationCursor
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrInvalidCursor, err)
+	}
+	if c.ID == uuid.Nil || c.CreatedAt.IsZero() {
+		return nil, models.ErrInvalidCursor
+	}
+
+	return &c, nil
+}
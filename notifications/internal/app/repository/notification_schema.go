@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"notifications/internal/app/models"
+)
+
+const NotificationsTable = "public.notifications"
+
+const (
+	NotificationsTableColumnID            = "id"
+	NotificationsTableColumnUserID        = "user_id"
+	NotificationsTableColumnKind          = "kind"
+	NotificationsTableColumnText          = "text"
+	NotificationsTableColumnData          = "data"
+	NotificationsTableColumnRead          = "read"
+	NotificationsTableColumnSourceEventID = "source_event_id"
+	NotificationsTableColumnCreatedAt     = "created_at"
+	NotificationsTableColumnReadAt        = "read_at"
+)
+
+var NotificationsTableColumns = []string{
+	NotificationsTableColumnID,
+	NotificationsTableColumnUserID,
+	NotificationsTableColumnKind,
+	NotificationsTableColumnText,
+	NotificationsTableColumnData,
+	NotificationsTableColumnRead,
+	NotificationsTableColumnSourceEventID,
+	NotificationsTableColumnCreatedAt,
+	NotificationsTableColumnReadAt,
+}
+
+type notification struct {
+	ID            uuid.UUID           `db:"id"`
+	UserID        string              `db:"user_id"`
+	Kind          string              `db:"kind"`
+	Text          string              `db:"text"`
+	Data          []byte              `db:"data"` // JSONB
+	Read          bool                `db:"read"`
+	SourceEventID uuid.UUID           `db:"source_event_id"`
+	CreatedAt     time.Time           `db:"created_at"`
+	ReadAt        sql.Null[time.Time] `db:"read_at"`
+}
+
+func (n *notification) Values() []any {
+	return []any{
+		n.ID,
+		n.UserID,
+		n.Kind,
+		n.Text,
+		n.Data,
+		n.Read,
+		n.SourceEventID,
+		n.CreatedAt,
+		n.ReadAt,
+	}
+}
+
+// notificationFromModel конвертирует доменную модель в notification (для INSERT)
+func notificationFromModel(m *models.Notification) (notification, error) {
+	data := m.Data
+	if data == nil {
+		data = map[string]string{}
+	}
+	rawData, err := json.Marshal(data)
+	if err != nil {
+		return notification{}, err
+	}
+
+	var readAt sql.Null[time.Time]
+	if m.ReadAt != nil {
+		readAt = sql.Null[time.Time]{V: *m.ReadAt, Valid: true}
+	}
+
+	return notification{
+		ID:            m.ID,
+		UserID:        m.UserID,
+		Kind:          string(m.Kind),
+		Text:          m.Text,
+		Data:          rawData,
+		Read:          m.Read,
+		SourceEventID: m.SourceEventID,
+		CreatedAt:     m.CreatedAt,
+		ReadAt:        readAt,
+	}, nil
+}
+
+// notificationToModel конвертирует notification в доменную модель
+func notificationToModel(row *notification) (*models.Notification, error) {
+	data := map[string]string{}
+	if len(row.Data) > 0 {
+		if err := json.Unmarshal(row.Data, &data); err != nil {
+			return nil, err
+		}
+	}
+
+	var readAt *time.Time
+	if row.ReadAt.Valid {
+		readAt = &row.ReadAt.V
+	}
+
+	return &models.Notification{
+		ID:            row.ID,
+		UserID:        row.UserID,
+		Kind:          models.NotificationKind(row.Kind),
+		Text:          row.Text,
+		Data:          data,
+		Read:          row.Read,
+		SourceEventID: row.SourceEventID,
+		CreatedAt:     row.CreatedAt,
+		ReadAt:        readAt,
+	}, nil
+}
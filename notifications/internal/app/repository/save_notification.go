@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sskorolev/balun_microservices/lib/postgres"
+
+	"notifications/internal/app/models"
+)
+
+// SaveNotification сохраняет уведомление, повтор по тому же событию игнорируется
+//
+// Возвращает false, если уведомление по этому событию уже было сохранено.
+func (r *Repository) SaveNotification(ctx context.Context, n *models.Notification) (bool, error) {
+	const api = "notification.Repository.SaveNotification"
+
+	row, err := notificationFromModel(n)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", api, err)
+	}
+
+	qb := r.qb.Insert(NotificationsTable).
+		Columns(NotificationsTableColumns...).
+		Values(row.Values()...).
+		Suffix("ON CONFLICT (source_event_id, user_id, kind) DO NOTHING")
+
+	conn := r.db.GetQueryEngine(ctx)
+	tag, err := conn.Execx(ctx, qb)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", api, postgres.ConvertPGError(err))
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
@@ -14,6 +14,7 @@ const (
 	InboxMessagesTableColumnTopic       = "topic"
 	InboxMessagesTableColumnPartition   = "partition"
 	InboxMessagesTableColumnOffset      = "kafka_offset"
+	InboxMessagesTableColumnEventType   = "event_type"
 	InboxMessagesTableColumnPayload     = "payload"
 	InboxMessagesTableColumnStatus      = "status"
 	InboxMessagesTableColumnAttempts    = "attempts"
@@ -34,6 +35,7 @@ type inboxMessage struct {
 	Topic       string              `db:"topic"`
 	Partition   int                 `db:"partition"`
 	Offset      int64               `db:"kafka_offset"`
+	EventType   sql.Null[string]    `db:"event_type"`
 	Payload     []byte              `db:"payload"` // JSONB
 	Status      string              `db:"status"`
 	Attempts    int                 `db:"attempts"`
@@ -47,6 +49,7 @@ var InboxMessagesTableColumns = []string{
 	InboxMessagesTableColumnTopic,
 	InboxMessagesTableColumnPartition,
 	InboxMessagesTableColumnOffset,
+	InboxMessagesTableColumnEventType,
 	InboxMessagesTableColumnPayload,
 	InboxMessagesTableColumnStatus,
 	InboxMessagesTableColumnAttempts,
@@ -61,6 +64,7 @@ func (m *inboxMessage) mapFields() map[string]any {
 		InboxMessagesTableColumnTopic:       m.Topic,
 		InboxMessagesTableColumnPartition:   m.Partition,
 		InboxMessagesTableColumnOffset:      m.Offset,
+		InboxMessagesTableColumnEventType:   m.EventType,
 		InboxMessagesTableColumnPayload:     m.Payload,
 		InboxMessagesTableColumnStatus:      m.Status,
 		InboxMessagesTableColumnAttempts:    m.Attempts,
@@ -0,0 +1,22 @@
+package dto
+
+import (
+	"notifications/internal/app/models"
+)
+
+type ListNotificationsDto struct {
+	UserID     string
+	Limit      int64
+	Cursor     *string
+	UnreadOnly bool
+}
+
+type ListNotificationsResponse struct {
+	Notifications []*models.Notification
+	NextCursor    *string
+}
+
+type MarkReadDto struct {
+	UserID          string
+	NotificationIDs []string
+}
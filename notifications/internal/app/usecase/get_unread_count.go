@@ -0,0 +1,15 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+)
+
+func (s *NotificationService) GetUnreadCount(ctx context.Context, userID string) (int64, error) {
+	count, err := s.repo.CountUnreadNotifications(ctx, userID)
+	if err != nil {
+		return 0, fmt.Errorf("[NotificationService][GetUnreadCount] repo CountUnreadNotifications error: %w", err)
+	}
+
+	return count, nil
+}
@@ -0,0 +1,28 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"notifications/internal/app/usecase/dto"
+)
+
+func (s *NotificationService) ListNotifications(ctx context.Context, req dto.ListNotificationsDto) (*dto.ListNotificationsResponse, error) {
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultListLimit
+	}
+	if limit > maxListLimit {
+		limit = maxListLimit
+	}
+
+	notifications, nextCursor, err := s.repo.ListNotifications(ctx, req.UserID, limit, req.Cursor, req.UnreadOnly)
+	if err != nil {
+		return nil, fmt.Errorf("[NotificationService][ListNotifications] repo ListNotifications error: %w", err)
+	}
+
+	return &dto.ListNotificationsResponse{
+		Notifications: notifications,
+		NextCursor:    nextCursor,
+	}, nil
+}
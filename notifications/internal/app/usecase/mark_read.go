@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"notifications/internal/app/models"
+	"notifications/internal/app/usecase/dto"
+)
+
+func (s *NotificationService) MarkRead(ctx context.Context, req dto.MarkReadDto) (int64, error) {
+	if len(req.NotificationIDs) == 0 {
+		return 0, nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(req.NotificationIDs))
+	for _, raw := range req.NotificationIDs {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			return 0, fmt.Errorf("%w: %s", models.ErrInvalidID, raw)
+		}
+		ids = append(ids, id)
+	}
+
+	updated, err := s.repo.MarkNotificationsRead(ctx, req.UserID, ids, time.Now().UTC())
+	if err != nil {
+		return 0, fmt.Errorf("[NotificationService][MarkRead] repo MarkNotificationsRead error: %w", err)
+	}
+
+	return updated, nil
+}
+
+func (s *NotificationService) MarkAllRead(ctx context.Context, userID string) (int64, error) {
+	updated, err := s.repo.MarkAllNotificationsRead(ctx, userID, time.Now().UTC())
+	if err != nil {
+		return 0, fmt.Errorf("[NotificationService][MarkAllRead] repo MarkAllNotificationsRead error: %w", err)
+	}
+
+	return updated, nil
+}
@@ -0,0 +1,15 @@
+package usecase
+
+import (
+	"context"
+
+	"notifications/internal/app/models"
+)
+
+// StreamNotifications подписывает пользователя на новые уведомления
+//
+// Канал закрывается при отмене ctx или при отключении медленного подписчика хабом.
+// Уведомления, созданные до подписки, клиент получает через ListNotifications.
+func (s *NotificationService) StreamNotifications(ctx context.Context, userID string) (<-chan *models.Notification, error) {
+	return s.hub.Subscribe(ctx, userID), nil
+}
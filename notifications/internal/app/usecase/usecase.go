@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"notifications/internal/app/models"
+	"notifications/internal/app/usecase/dto"
+)
+
+const (
+	// defaultListLimit размер страницы ListNotifications по умолчанию
+	defaultListLimit = 20
+	// maxListLimit максимальный размер страницы ListNotifications
+	maxListLimit = 100
+)
+
+// Порты вторичные
+type (
+	NotificationRepository interface {
+		ListNotifications(ctx context.Context, userID string, limit int64, cursor *string, unreadOnly bool) (notifications []*models.Notification, nextCursor *string, err error)
+		MarkNotificationsRead(ctx context.Context, userID string, ids []uuid.UUID, readAt time.Time) (int64, error)
+		MarkAllNotificationsRead(ctx context.Context, userID string, readAt time.Time) (int64, error)
+		CountUnreadNotifications(ctx context.Context, userID string) (int64, error)
+	}
+
+	NotificationHub interface {
+		Subscribe(ctx context.Context, userID string) <-chan *models.Notification
+	}
+)
+
+type Usecase interface {
+	// ListNotifications лента уведомлений пользователя
+	ListNotifications(ctx context.Context, req dto.ListNotificationsDto) (*dto.ListNotificationsResponse, error)
+	// MarkRead отметка уведомлений прочитанными
+	MarkRead(ctx context.Context, req dto.MarkReadDto) (int64, error)
+	// MarkAllRead отметка всех уведомлений пользователя прочитанными
+	MarkAllRead(ctx context.Context, userID string) (int64, error)
+	// GetUnreadCount количество непрочитанных уведомлений
+	GetUnreadCount(ctx context.Context, userID string) (int64, error)
+	// StreamNotifications серверный стрим новых уведомлений
+	StreamNotifications(ctx context.Context, userID string) (<-chan *models.Notification, error)
+}
+
+type NotificationService struct {
+	repo NotificationRepository
+	hub  NotificationHub
+}
+
+var _ Usecase = (*NotificationService)(nil)
+
+func NewUsecase(repo NotificationRepository, hub NotificationHub) *NotificationService {
+	return &NotificationService{
+		repo: repo,
+		hub:  hub,
+	}
+}
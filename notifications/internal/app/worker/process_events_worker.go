@@ -27,20 +27,26 @@ type inboxRepository interface {
 	) ([]models.InboxMessage, error)
 }
 
+type eventDispatcher interface {
+	Dispatch(ctx context.Context, msg models.InboxMessage) error
+}
+
 // SaveEventsWorker воркер для обработки событий из inbox
 type SaveEventsWorker struct {
 	repo         inboxRepository
 	tm           postgres.TransactionManagerAPI
+	dispatcher   eventDispatcher
 	tickInterval time.Duration
 	batchSize    int
 	maxAttempts  int
 }
 
 // NewSaveEventsWorker создает новый воркер с настройками по умолчанию
-func NewSaveEventsWorker(repo inboxRepository, tm postgres.TransactionManagerAPI) *SaveEventsWorker {
+func NewSaveEventsWorker(repo inboxRepository, tm postgres.TransactionManagerAPI, dispatcher eventDispatcher) *SaveEventsWorker {
 	return &SaveEventsWorker{
 		repo:         repo,
 		tm:           tm,
+		dispatcher:   dispatcher,
 		tickInterval: defaultTickInterval,
 		batchSize:    defaultBatchSize,
 		maxAttempts:  defaultMaxAttempts,
@@ -139,12 +145,13 @@ func (w *SaveEventsWorker) processMessage(ctx context.Context, msg models.InboxM
 		return fmt.Errorf("failed to update message to processing: %w", err)
 	}
 
-	// Логируем информацию о событии
-	log.Printf("Processing event - ID: %s, Topic: %s, Partition: %d, Offset: %d, Payload: %s",
-		msg.ID, msg.Topic, msg.Partition, msg.Offset, string(msg.Payload))
+	log.Printf("Processing event - ID: %s, Type: %s, Topic: %s, Partition: %d, Offset: %d",
+		msg.ID, msg.EventType, msg.Topic, msg.Partition, msg.Offset)
 
-	// Здесь можно добавить реальную бизнес-логику обработки события
-	// Пока просто эмулируем успешную обработку
+	// Передаем событие обработчику его типа (создание уведомлений)
+	if err = w.dispatcher.Dispatch(ctx, msg); err != nil {
+		return err
+	}
 
 	// Обновляем статус на processed с временем обработки
 	now := time.Now()
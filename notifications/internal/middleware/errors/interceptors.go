@@ -0,0 +1,59 @@
+package errors
+
+import (
+	"context"
+	"errors"
+
+	"notifications/internal/app/models"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrorsUnaryInterceptor - convert any error to rpc error
+func ErrorsUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (resp interface{}, err error) {
+		resp, err = handler(ctx, req)
+		//
+		if _, ok := status.FromError(err); ok {
+			return resp, err
+		}
+
+		return resp, convertError(err)
+	}
+}
+
+// ErrorsStreamInterceptor - convert any error to rpc error for server streams
+func ErrorsStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		err := handler(srv, ss)
+		//
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+
+		return convertError(err)
+	}
+}
+
+func convertError(err error) error {
+	switch {
+	case errors.Is(err, models.ErrInvalidCursor), errors.Is(err, models.ErrInvalidID):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, models.ErrSlowSubscriber):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	default:
+		return status.Error(codes.Unknown, err.Error())
+	}
+}
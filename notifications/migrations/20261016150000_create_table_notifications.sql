@@ -0,0 +1,47 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE public.inbox_messages
+    ADD COLUMN IF NOT EXISTS event_type TEXT;
+
+COMMENT ON COLUMN public.inbox_messages.event_type IS 'Тип события из заголовка event_type (FriendRequestCreated, ...)';
+
+CREATE TABLE IF NOT EXISTS public.notifications (
+    id              UUID        PRIMARY KEY,
+    user_id         TEXT        NOT NULL,
+    kind            TEXT        NOT NULL,
+    text            TEXT        NOT NULL,
+    data            JSONB       NOT NULL DEFAULT '{}'::jsonb,
+    read            BOOLEAN     NOT NULL DEFAULT false,
+    source_event_id UUID        NOT NULL,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT now(),
+    read_at         TIMESTAMPTZ
+);
+
+COMMENT ON TABLE public.notifications IS 'Уведомления пользователей';
+COMMENT ON COLUMN public.notifications.id              IS 'Уникальный идентификатор уведомления';
+COMMENT ON COLUMN public.notifications.user_id         IS 'Получатель уведомления';
+COMMENT ON COLUMN public.notifications.kind            IS 'Вид уведомления: friend_request_received | friend_request_accepted | friend_request_declined';
+COMMENT ON COLUMN public.notifications.text            IS 'Отрендеренный текст уведомления';
+COMMENT ON COLUMN public.notifications.data            IS 'Параметры уведомления для клиента';
+COMMENT ON COLUMN public.notifications.read            IS 'Уведомление прочитано';
+COMMENT ON COLUMN public.notifications.source_event_id IS 'id события inbox, из которого создано уведомление';
+COMMENT ON COLUMN public.notifications.created_at      IS 'Время создания уведомления';
+COMMENT ON COLUMN public.notifications.read_at         IS 'Время прочтения уведомления';
+
+-- Повторная обработка события не создает дубликат уведомления
+CREATE UNIQUE INDEX IF NOT EXISTS idx_notifications_source_event ON public.notifications(source_event_id, user_id, kind);
+
+-- Лента уведомлений пользователя
+CREATE INDEX IF NOT EXISTS idx_notifications_user_created ON public.notifications(user_id, created_at DESC, id DESC);
+
+-- Счетчик непрочитанных
+CREATE INDEX IF NOT EXISTS idx_notifications_user_unread ON public.notifications(user_id) WHERE NOT read;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS public.notifications;
+
+ALTER TABLE public.inbox_messages
+    DROP COLUMN IF EXISTS event_type;
+-- +goose StatementEnd
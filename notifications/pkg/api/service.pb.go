@@ -0,0 +1,708 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.7
+// 	protoc        v6.32.1
+// source: api/service.proto
+
+package proto_v1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Notification struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// notificationId - идентификатор уведомления
+	NotificationId string `protobuf:"bytes,1,opt,name=notificationId,proto3" json:"notificationId,omitempty"`
+	// kind - вид уведомления (friend_request_received, friend_request_accepted, friend_request_declined)
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	// text - текст уведомления
+	Text string `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	// data - параметры уведомления (friendRequestId, fromUserId и т.п.)
+	Data map[string]string `protobuf:"bytes,4,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// read - уведомление прочитано
+	Read bool `protobuf:"varint,5,opt,name=read,proto3" json:"read,omitempty"`
+	// createdAtUnixMs - время создания в миллисекундах
+	CreatedAtUnixMs int64 `protobuf:"varint,6,opt,name=createdAtUnixMs,proto3" json:"createdAtUnixMs,omitempty"`
+	// readAtUnixMs - время прочтения в миллисекундах
+	ReadAtUnixMs  *int64 `protobuf:"varint,7,opt,name=readAtUnixMs,proto3,oneof" json:"readAtUnixMs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Notification) Reset() {
+	*x = Notification{}
+	mi := &file_api_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notification) ProtoMessage() {}
+
+func (x *Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Notification) GetNotificationId() string {
+	if x != nil {
+		return x.NotificationId
+	}
+	return ""
+}
+
+func (x *Notification) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Notification) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Notification) GetData() map[string]string {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Notification) GetRead() bool {
+	if x != nil {
+		return x.Read
+	}
+	return false
+}
+
+func (x *Notification) GetCreatedAtUnixMs() int64 {
+	if x != nil {
+		return x.CreatedAtUnixMs
+	}
+	return 0
+}
+
+func (x *Notification) GetReadAtUnixMs() int64 {
+	if x != nil && x.ReadAtUnixMs != nil {
+		return *x.ReadAtUnixMs
+	}
+	return 0
+}
+
+// ListNotificationsRequest - запрос ListNotifications
+type ListNotificationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// limit - лимит результатов, по умолчанию 20
+	Limit int64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// cursor - курсор для пагинации
+	Cursor *string `protobuf:"bytes,2,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	// unreadOnly - только непрочитанные
+	UnreadOnly    bool `protobuf:"varint,3,opt,name=unreadOnly,proto3" json:"unreadOnly,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationsRequest) Reset() {
+	*x = ListNotificationsRequest{}
+	mi := &file_api_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationsRequest) ProtoMessage() {}
+
+func (x *ListNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*ListNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListNotificationsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListNotificationsRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+func (x *ListNotificationsRequest) GetUnreadOnly() bool {
+	if x != nil {
+		return x.UnreadOnly
+	}
+	return false
+}
+
+// ListNotificationsResponse - ответ ListNotifications
+type ListNotificationsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// notifications - список уведомлений
+	Notifications []*Notification `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	// nextCursor - курсор для следующей страницы
+	NextCursor    *string `protobuf:"bytes,2,opt,name=nextCursor,proto3,oneof" json:"nextCursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationsResponse) Reset() {
+	*x = ListNotificationsResponse{}
+	mi := &file_api_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationsResponse) ProtoMessage() {}
+
+func (x *ListNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*ListNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListNotificationsResponse) GetNotifications() []*Notification {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+func (x *ListNotificationsResponse) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
+	}
+	return ""
+}
+
+// MarkReadRequest - запрос MarkRead
+type MarkReadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// notificationIds - идентификаторы уведомлений
+	NotificationIds []string `protobuf:"bytes,1,rep,name=notificationIds,proto3" json:"notificationIds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MarkReadRequest) Reset() {
+	*x = MarkReadRequest{}
+	mi := &file_api_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkReadRequest) ProtoMessage() {}
+
+func (x *MarkReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkReadRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MarkReadRequest) GetNotificationIds() []string {
+	if x != nil {
+		return x.NotificationIds
+	}
+	return nil
+}
+
+// MarkReadResponse - ответ MarkRead
+type MarkReadResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// updated - количество уведомлений, отмеченных прочитанными
+	Updated       int64 `protobuf:"varint,1,opt,name=updated,proto3" json:"updated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkReadResponse) Reset() {
+	*x = MarkReadResponse{}
+	mi := &file_api_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkReadResponse) ProtoMessage() {}
+
+func (x *MarkReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkReadResponse.ProtoReflect.Descriptor instead.
+func (*MarkReadResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MarkReadResponse) GetUpdated() int64 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+// MarkAllReadRequest - запрос MarkAllRead
+type MarkAllReadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkAllReadRequest) Reset() {
+	*x = MarkAllReadRequest{}
+	mi := &file_api_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkAllReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkAllReadRequest) ProtoMessage() {}
+
+func (x *MarkAllReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkAllReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkAllReadRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{5}
+}
+
+// MarkAllReadResponse - ответ MarkAllRead
+type MarkAllReadResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// updated - количество уведомлений, отмеченных прочитанными
+	Updated       int64 `protobuf:"varint,1,opt,name=updated,proto3" json:"updated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkAllReadResponse) Reset() {
+	*x = MarkAllReadResponse{}
+	mi := &file_api_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkAllReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkAllReadResponse) ProtoMessage() {}
+
+func (x *MarkAllReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkAllReadResponse.ProtoReflect.Descriptor instead.
+func (*MarkAllReadResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MarkAllReadResponse) GetUpdated() int64 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+// GetUnreadCountRequest - запрос GetUnreadCount
+type GetUnreadCountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUnreadCountRequest) Reset() {
+	*x = GetUnreadCountRequest{}
+	mi := &file_api_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUnreadCountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnreadCountRequest) ProtoMessage() {}
+
+func (x *GetUnreadCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnreadCountRequest.ProtoReflect.Descriptor instead.
+func (*GetUnreadCountRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{7}
+}
+
+// GetUnreadCountResponse - ответ GetUnreadCount
+type GetUnreadCountResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// count - количество непрочитанных уведомлений
+	Count         int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUnreadCountResponse) Reset() {
+	*x = GetUnreadCountResponse{}
+	mi := &file_api_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUnreadCountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnreadCountResponse) ProtoMessage() {}
+
+func (x *GetUnreadCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnreadCountResponse.ProtoReflect.Descriptor instead.
+func (*GetUnreadCountResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetUnreadCountResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// StreamNotificationsRequest - запрос StreamNotifications
+type StreamNotificationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamNotificationsRequest) Reset() {
+	*x = StreamNotificationsRequest{}
+	mi := &file_api_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamNotificationsRequest) ProtoMessage() {}
+
+func (x *StreamNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*StreamNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{9}
+}
+
+// StreamNotificationsResponse - ответ StreamNotifications
+type StreamNotificationsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// notification - новое уведомление
+	Notification  *Notification `protobuf:"bytes,1,opt,name=notification,proto3" json:"notification,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamNotificationsResponse) Reset() {
+	*x = StreamNotificationsResponse{}
+	mi := &file_api_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamNotificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamNotificationsResponse) ProtoMessage() {}
+
+func (x *StreamNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*StreamNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *StreamNotificationsResponse) GetNotification() *Notification {
+	if x != nil {
+		return x.Notification
+	}
+	return nil
+}
+
+var File_api_service_proto protoreflect.FileDescriptor
+
+const file_api_service_proto_rawDesc = "" +
+	"\n" +
+	"\x11api/service.proto\x12Fgithub.com.krus210.balun_microservices.protobuf.notifications.v1.proto\x1a\x1bbuf/validate/validate.proto\"\x83\x03\n" +
+	"\fNotification\x12&\n" +
+	"\x0enotificationId\x18\x01 \x01(\tR\x0enotificationId\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\x12r\n" +
+	"\x04data\x18\x04 \x03(\v2^.github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.Notification.DataEntryR\x04data\x12\x12\n" +
+	"\x04read\x18\x05 \x01(\bR\x04read\x12(\n" +
+	"\x0fcreatedAtUnixMs\x18\x06 \x01(\x03R\x0fcreatedAtUnixMs\x12'\n" +
+	"\freadAtUnixMs\x18\a \x01(\x03H\x00R\freadAtUnixMs\x88\x01\x01\x1a7\n" +
+	"\tDataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x0f\n" +
+	"\r_readAtUnixMs\"\x83\x01\n" +
+	"\x18ListNotificationsRequest\x12\x1f\n" +
+	"\x05limit\x18\x01 \x01(\x03B\t\xbaH\x06\"\x04\x18d(\x00R\x05limit\x12\x1b\n" +
+	"\x06cursor\x18\x02 \x01(\tH\x00R\x06cursor\x88\x01\x01\x12\x1e\n" +
+	"\n" +
+	"unreadOnly\x18\x03 \x01(\bR\n" +
+	"unreadOnlyB\t\n" +
+	"\a_cursor\"\xcb\x01\n" +
+	"\x19ListNotificationsResponse\x12z\n" +
+	"\rnotifications\x18\x01 \x03(\v2T.github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.NotificationR\rnotifications\x12#\n" +
+	"\n" +
+	"nextCursor\x18\x02 \x01(\tH\x00R\n" +
+	"nextCursor\x88\x01\x01B\r\n" +
+	"\v_nextCursor\"G\n" +
+	"\x0fMarkReadRequest\x124\n" +
+	"\x0fnotificationIds\x18\x01 \x03(\tB\n" +
+	"\xbaH\a\x92\x01\x04\b\x01\x10dR\x0fnotificationIds\",\n" +
+	"\x10MarkReadResponse\x12\x18\n" +
+	"\aupdated\x18\x01 \x01(\x03R\aupdated\"\x14\n" +
+	"\x12MarkAllReadRequest\"/\n" +
+	"\x13MarkAllReadResponse\x12\x18\n" +
+	"\aupdated\x18\x01 \x01(\x03R\aupdated\"\x17\n" +
+	"\x15GetUnreadCountRequest\".\n" +
+	"\x16GetUnreadCountResponse\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x03R\x05count\"\x1c\n" +
+	"\x1aStreamNotificationsRequest\"\x97\x01\n" +
+	"\x1bStreamNotificationsResponse\x12x\n" +
+	"\fnotification\x18\x01 \x01(\v2T.github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.NotificationR\fnotification2\xb8\b\n" +
+	"\x13NotificationService\x12\xda\x01\n" +
+	"\x11ListNotifications\x12`.github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.ListNotificationsRequest\x1aa.github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.ListNotificationsResponse\"\x00\x12\xbf\x01\n" +
+	"\bMarkRead\x12W.github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.MarkReadRequest\x1aX.github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.MarkReadResponse\"\x00\x12\xc8\x01\n" +
+	"\vMarkAllRead\x12Z.github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.MarkAllReadRequest\x1a[.github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.MarkAllReadResponse\"\x00\x12\xd1\x01\n" +
+	"\x0eGetUnreadCount\x12].github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.GetUnreadCountRequest\x1a^.github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.GetUnreadCountResponse\"\x00\x12\xe2\x01\n" +
+	"\x13StreamNotifications\x12b.github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.StreamNotificationsRequest\x1ac.github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.StreamNotificationsResponse\"\x000\x01B\x18Z\x16pkg/gen/proto;proto_v1b\x06proto3"
+
+var (
+	file_api_service_proto_rawDescOnce sync.Once
+	file_api_service_proto_rawDescData []byte
+)
+
+func file_api_service_proto_rawDescGZIP() []byte {
+	file_api_service_proto_rawDescOnce.Do(func() {
+		file_api_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_service_proto_rawDesc), len(file_api_service_proto_rawDesc)))
+	})
+	return file_api_service_proto_rawDescData
+}
+
+var file_api_service_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_api_service_proto_goTypes = []any{
+	(*Notification)(nil),                // 0: github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.Notification
+	(*ListNotificationsRequest)(nil),    // 1: github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.ListNotificationsRequest
+	(*ListNotificationsResponse)(nil),   // 2: github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.ListNotificationsResponse
+	(*MarkReadRequest)(nil),             // 3: github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.MarkReadRequest
+	(*MarkReadResponse)(nil),            // 4: github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.MarkReadResponse
+	(*MarkAllReadRequest)(nil),          // 5: github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.MarkAllReadRequest
+	(*MarkAllReadResponse)(nil),         // 6: github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.MarkAllReadResponse
+	(*GetUnreadCountRequest)(nil),       // 7: github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.GetUnreadCountRequest
+	(*GetUnreadCountResponse)(nil),      // 8: github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.GetUnreadCountResponse
+	(*StreamNotificationsRequest)(nil),  // 9: github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.StreamNotificationsRequest
+	(*StreamNotificationsResponse)(nil), // 10: github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.StreamNotificationsResponse
+	nil,                                 // 11: github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.Notification.DataEntry
+}
+var file_api_service_proto_depIdxs = []int32{
+	11, // 0: github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.Notification.data:type_name -> github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.Notification.DataEntry
+	0,  // 1: github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.ListNotificationsResponse.notifications:type_name -> github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.Notification
+	0,  // 2: github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.StreamNotificationsResponse.notification:type_name -> github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.Notification
+	1,  // 3: github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.NotificationService.ListNotifications:input_type -> github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.ListNotificationsRequest
+	3,  // 4: github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.NotificationService.MarkRead:input_type -> github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.MarkReadRequest
+	5,  // 5: github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.NotificationService.MarkAllRead:input_type -> github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.MarkAllReadRequest
+	7,  // 6: github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.NotificationService.GetUnreadCount:input_type -> github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.GetUnreadCountRequest
+	9,  // 7: github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.NotificationService.StreamNotifications:input_type -> github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.StreamNotificationsRequest
+	2,  // 8: github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.NotificationService.ListNotifications:output_type -> github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.ListNotificationsResponse
+	4,  // 9: github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.NotificationService.MarkRead:output_type -> github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.MarkReadResponse
+	6,  // 10: github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.NotificationService.MarkAllRead:output_type -> github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.MarkAllReadResponse
+	8,  // 11: github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.NotificationService.GetUnreadCount:output_type -> github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.GetUnreadCountResponse
+	10, // 12: github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.NotificationService.StreamNotifications:output_type -> github.com.krus210.balun_microservices.protobuf.notifications.v1.proto.StreamNotificationsResponse
+	8,  // [8:13] is the sub-list for method output_type
+	3,  // [3:8] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_api_service_proto_init() }
+func file_api_service_proto_init() {
+	if File_api_service_proto != nil {
+		return
+	}
+	file_api_service_proto_msgTypes[0].OneofWrappers = []any{}
+	file_api_service_proto_msgTypes[1].OneofWrappers = []any{}
+	file_api_service_proto_msgTypes[2].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_service_proto_rawDesc), len(file_api_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_service_proto_goTypes,
+		DependencyIndexes: file_api_service_proto_depIdxs,
+		MessageInfos:      file_api_service_proto_msgTypes,
+	}.Build()
+	File_api_service_proto = out.File
+	file_api_service_proto_goTypes = nil
+	file_api_service_proto_depIdxs = nil
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
@@ -12,11 +13,14 @@ import (
 	"github.com/sskorolev/balun_microservices/lib/app"
 	"github.com/sskorolev/balun_microservices/lib/authmw"
 	"github.com/sskorolev/balun_microservices/lib/config"
+	"github.com/sskorolev/balun_microservices/lib/idempotency"
 	"github.com/sskorolev/balun_microservices/lib/logger"
+	"github.com/sskorolev/balun_microservices/lib/outbox"
+	outboxAdminPb "github.com/sskorolev/balun_microservices/lib/outbox/adminpb"
 
 	"social/internal/app/adapters"
-	"social/internal/app/delivery/friend_request_handler"
 	deliveryGrpc "social/internal/app/delivery/grpc"
+	outboxPartitions "social/internal/app/outbox/partitions"
 	outboxProcessor "social/internal/app/outbox/processor"
 	outboxRepository "social/internal/app/outbox/repository"
 	"social/internal/app/repository"
@@ -33,7 +37,9 @@ func main() {
 	defer cancel()
 
 	// Загружаем конфигурацию через lib/config с явным указанием всех компонентов
-	cfg, err := config.LoadServiceConfig(ctx, "social")
+	cfg, err := config.LoadServiceConfig(ctx, "social",
+		config.WithIdempotency(24*time.Hour, socialPb.SocialService_SendFriendRequest_FullMethodName),
+	)
 	if err != nil {
 		logger.FatalKV(ctx, "failed to load config", "error", err.Error())
 	}
@@ -109,35 +115,101 @@ func main() {
 		}
 	}()
 
-	// Создаем обработчик событий заявок в друзья
-	friendRequestEventsHandler := friend_request_handler.NewKafkaFriendRequestBatchHandler(producer,
-		friend_request_handler.WithMaxBatchSize(cfg.FriendRequestHandler.BatchSize),
-		friend_request_handler.WithTopic(cfg.Kafka.Topics.FriendRequestEvents),
+	// Публикация событий заявок в друзья в Kafka
+	friendRequestPublisher, err := outbox.NewKafkaPublisher(producer,
+		outbox.WithTopic(cfg.Kafka.Topics.FriendRequestEvents),
+		outbox.WithMaxBatchSize(cfg.FriendRequestHandler.BatchSize),
+	)
+	if err != nil {
+		logger.FatalKV(ctx, "failed to create outbox publisher", "error", err.Error())
+	}
+
+	// Публикация событий блокировок пользователей в Kafka
+	userBlockPublisher, err := outbox.NewKafkaPublisher(producer,
+		outbox.WithTopic(cfg.Kafka.Topics.UserBlockEvents),
+		outbox.WithMaxBatchSize(cfg.FriendRequestHandler.BatchSize),
 	)
+	if err != nil {
+		logger.FatalKV(ctx, "failed to create outbox publisher", "error", err.Error())
+	}
 
 	// Инициализируем репозитории
 	friendRequestRepo := repository.NewRepository(application.TransactionManager())
 	outboxRepo := outboxRepository.NewRepository(application.TransactionManager())
+	outboxStore := outbox.NewPostgresStore(application.TransactionManager())
 
-	// Создаем outbox worker
-	worker := outboxProcessor.NewOutboxFriendRequestWorker(outboxRepo, application.TransactionManager(), friendRequestEventsHandler,
-		outboxProcessor.WithBatchSize(cfg.Outbox.Processor.BatchSize),
-		outboxProcessor.WithMaxRetry(cfg.Outbox.Processor.MaxRetry),
-		outboxProcessor.WithRetryInterval(cfg.Outbox.Processor.RetryInterval),
-		outboxProcessor.WithWindow(cfg.Outbox.Processor.Window),
+	// Создаем outbox worker'ы, по одному на агрегат
+	workerOpts := []outbox.WorkerOption{
+		outbox.WithBatchSize(cfg.Outbox.Processor.BatchSize),
+		outbox.WithMaxRetry(cfg.Outbox.Processor.MaxRetry),
+		outbox.WithBackoff(outboxBackoff(cfg.Outbox.Processor)),
+		outbox.WithWindow(cfg.Outbox.Processor.Window),
+	}
+	workers := []*outbox.Worker{
+		outbox.NewWorker(outboxProcessor.AggregateTypeFriendRequest, outboxStore, application.TransactionManager(), friendRequestPublisher, workerOpts...),
+		outbox.NewWorker(outboxProcessor.AggregateTypeUserBlock, outboxStore, application.TransactionManager(), userBlockPublisher, workerOpts...),
+	}
+
+	// Создаем менеджер месячных партиций outbox
+	partitionManager := outboxPartitions.NewManager(outboxRepo, application.TransactionManager(), outboxProcessor.Registry.Aggregates(),
+		outboxPartitions.WithInterval(cfg.Outbox.Partitions.Interval),
+		outboxPartitions.WithPremake(cfg.Outbox.Partitions.Premake),
+		outboxPartitions.WithRetention(cfg.Outbox.Partitions.Retention),
+		outboxPartitions.WithDropDetached(cfg.Outbox.Partitions.DropDetached),
 	)
 
+	// Текущая схема партиций и разбор dead-letter доступны на admin сервере
+	if cfg.Server.Admin != nil {
+		if err := application.RegisterAdminHandler(outboxPartitions.AdminPath, partitionManager.LayoutHandler()); err != nil {
+			logger.FatalKV(ctx, "failed to register outbox partitions handler", "error", err.Error())
+		}
+		deadLetters := outbox.DeadLettersHandler(outboxStore)
+		for _, pattern := range []string{outbox.DeadLettersAdminPath, outbox.DeadLettersAdminPath + "/"} {
+			if err := application.RegisterAdminHandler(pattern, deadLetters); err != nil {
+				logger.FatalKV(ctx, "failed to register outbox dead-letters handler", "error", err.Error())
+			}
+		}
+
+		// gRPC OutboxAdminService без JWT, поэтому только на admin сервере, не на публичном gRPC
+		outboxAdminServer := grpc.NewServer()
+		outboxAdminPb.RegisterOutboxAdminServiceServer(outboxAdminServer, outbox.NewAdminServer(outboxStore))
+		if err := application.RegisterAdminHandler("/"+outboxAdminPb.OutboxAdminService_ServiceDesc.ServiceName+"/", outboxAdminServer); err != nil {
+			logger.FatalKV(ctx, "failed to register outbox admin gRPC service", "error", err.Error())
+		}
+	}
+
 	// Создаем use cases и controller
-	outboxProc := outboxProcessor.NewProcessor(outboxProcessor.Deps{Repository: outboxRepo})
-	socialUsecase := usecase.NewUsecase(usersClient, friendRequestRepo, outboxProc, application.TransactionManager())
+	outboxProc := outboxProcessor.NewProcessor(outboxProcessor.Deps{Writer: outbox.NewWriter(outboxStore, outboxProcessor.Registry)})
+	var usecaseOpts []usecase.Option
+	if cfg.FriendRequests != nil {
+		usecaseOpts = append(usecaseOpts,
+			usecase.WithDeclineCooldown(cfg.FriendRequests.DeclineCooldown),
+			usecase.WithPendingTTL(cfg.FriendRequests.PendingTTL),
+			usecase.WithExpireBatchSize(cfg.FriendRequests.ExpireBatchSize),
+		)
+	}
+	socialUsecase := usecase.NewUsecase(usersClient, friendRequestRepo, outboxProc, application.TransactionManager(), usecaseOpts...)
 	controller := deliveryGrpc.NewSocialController(socialUsecase)
 
-	// Инициализируем gRPC сервер с JWT и errors middleware
-	application.InitGRPCServer(
-		cfg.Server,
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
 		errorsMiddleware.ErrorsUnaryInterceptor(),
 		authmw.UnaryServerInterceptor(authComponents.JWTValidator),
-	)
+	}
+
+	// Идемпотентность по idempotency-key, ключи хранятся в Postgres
+	var idempotencyStore *idempotency.PostgresStore
+	if cfg.Idempotency != nil && cfg.Idempotency.Enabled {
+		idempotencyStore = idempotency.NewPostgresStore(application.Postgres())
+		unaryInterceptors = append(unaryInterceptors, idempotency.UnaryServerInterceptor(idempotencyStore,
+			idempotency.WithTTL(cfg.Idempotency.TTL),
+			idempotency.WithLockTimeout(cfg.Idempotency.LockTimeout),
+			idempotency.WithMethods(cfg.Idempotency.Methods...),
+			idempotency.WithSubject(authmw.GetUserID),
+		))
+	}
+
+	// Инициализируем gRPC сервер с JWT, errors и idempotency middleware
+	application.InitGRPCServer(cfg.Server, unaryInterceptors...)
 
 	// Регистрируем gRPC сервисы
 	application.RegisterGRPC(func(s *grpc.Server) {
@@ -155,12 +227,39 @@ func main() {
 		return nil
 	})
 
-	g.Go(func() error {
-		logger.InfoKV(gCtx, "starting outbox worker")
-		worker.Run(gCtx)
-		logger.InfoKV(gCtx, "outbox worker stopped")
-		return nil
-	})
+	for _, worker := range workers {
+		g.Go(func() error {
+			if err := worker.Run(gCtx); err != nil && !errors.Is(err, context.Canceled) {
+				return err
+			}
+			return nil
+		})
+	}
+
+	if cfg.Outbox.Partitions.Enabled {
+		g.Go(func() error {
+			logger.InfoKV(gCtx, "starting outbox partition manager")
+			if err := partitionManager.Run(gCtx); err != nil && !errors.Is(err, context.Canceled) {
+				return err
+			}
+			return nil
+		})
+	}
+
+	// Запускаем истечение ожидающих заявок в друзья
+	if cfg.FriendRequests != nil && cfg.FriendRequests.ExpireInterval > 0 {
+		g.Go(func() error {
+			logger.InfoKV(gCtx, "starting friend requests expirer", "interval", cfg.FriendRequests.ExpireInterval.String())
+			return runFriendRequestsExpirer(gCtx, socialUsecase, cfg.FriendRequests.ExpireInterval)
+		})
+	}
+
+	// Запускаем очистку истекших ключей идемпотентности
+	if idempotencyStore != nil {
+		g.Go(func() error {
+			return idempotency.RunCleaner(gCtx, idempotencyStore, cfg.Idempotency.CleanupInterval)
+		})
+	}
 
 	// Запускаем admin HTTP сервер
 	if cfg.Server.Admin != nil {
@@ -185,3 +284,38 @@ func main() {
 
 	logger.InfoKV(ctx, "social service shutdown complete")
 }
+
+// runFriendRequestsExpirer периодически переводит просроченные ожидающие заявки в expired, ошибки только логируются
+func runFriendRequestsExpirer(ctx context.Context, socialUsecase usecase.Usecase, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			expired, err := socialUsecase.ExpireFriendRequests(ctx)
+			if err != nil {
+				logger.ErrorKV(ctx, "failed to expire friend requests", "expired", expired, "error", err.Error())
+				continue
+			}
+			if expired > 0 {
+				logger.InfoKV(ctx, "friend requests expired", "expired", expired)
+			}
+		}
+	}
+}
+
+// outboxBackoff backoff outbox воркера, без явного base используется retry_interval
+func outboxBackoff(cfg config.OutboxProcessorConfig) outbox.Backoff {
+	b := outbox.Backoff{
+		Base:   cfg.Backoff.Base,
+		Max:    cfg.Backoff.Max,
+		Jitter: cfg.Backoff.Jitter,
+	}
+	if b.Base <= 0 {
+		b.Base = cfg.RetryInterval
+	}
+	return b
+}
@@ -0,0 +1,27 @@
+package grpc
+
+import (
+	"context"
+
+	"social/internal/app/models"
+	"social/internal/app/usecase/dto"
+
+	pb "social/pkg/api"
+)
+
+func (h *SocialController) BlockUser(ctx context.Context, req *pb.BlockUserRequest) (*pb.BlockUserResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.usecase.BlockUser(ctx, dto.UserBlockDto{
+		BlockerID: userID,
+		BlockedID: models.UserID(req.UserId),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.BlockUserResponse{}, nil
+}
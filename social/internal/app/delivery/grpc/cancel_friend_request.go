@@ -0,0 +1,29 @@
+package grpc
+
+import (
+	"context"
+
+	"social/internal/app/models"
+	"social/internal/app/usecase/dto"
+
+	pb "social/pkg/api"
+)
+
+func (h *SocialController) CancelFriendRequest(ctx context.Context, req *pb.CancelFriendRequestRequest) (*pb.CancelFriendRequestResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	friendRequest, err := h.usecase.CancelFriendRequest(ctx, dto.ChangeFriendRequestDto{
+		UserID:    userID,
+		RequestID: models.FriendRequestID(req.RequestId),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CancelFriendRequestResponse{
+		FriendRequest: newPbFriendRequestFromFriendRequest(friendRequest),
+	}, nil
+}
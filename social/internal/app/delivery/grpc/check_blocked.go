@@ -0,0 +1,34 @@
+package grpc
+
+import (
+	"context"
+
+	"social/internal/app/models"
+
+	pb "social/pkg/api"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *SocialController) CheckBlocked(ctx context.Context, req *pb.CheckBlockedRequest) (*pb.CheckBlockedResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Блокировки чужих пар пользователей не раскрываем
+	firstUserID, secondUserID := models.UserID(req.FirstUserId), models.UserID(req.SecondUserId)
+	if userID != firstUserID && userID != secondUserID {
+		return nil, status.Error(codes.PermissionDenied, "caller must be one of the users")
+	}
+
+	blocked, err := h.usecase.CheckBlocked(ctx, firstUserID, secondUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CheckBlockedResponse{
+		Blocked: blocked,
+	}, nil
+}
@@ -24,12 +24,65 @@ func newPbFriendRequestsFromFriendRequests(frs []*models.FriendRequest) []*pb.Fr
 	return results
 }
 
-func newPbFriendRequestsIDsFromFriendRequests(frs []*models.FriendRequest) []string {
-	results := make([]string, len(frs), len(frs))
+func newPbFriendUserIDsFromFriendships(fs []*models.Friendship) []string {
+	results := make([]string, len(fs), len(fs))
 
-	for i, fr := range frs {
-		results[i] = string(fr.ID)
+	for i, f := range fs {
+		results[i] = string(f.FriendID)
+	}
+
+	return results
+}
+
+func newPbFriendsFromFriendships(fs []*models.Friendship) []*pb.Friend {
+	results := make([]*pb.Friend, len(fs), len(fs))
+
+	for i, f := range fs {
+		results[i] = &pb.Friend{
+			UserId:          string(f.FriendID),
+			CreatedAtUnixMs: f.CreatedAt.UnixMilli(),
+		}
+	}
+
+	return results
+}
+
+func newPbBlockedUsersFromUserBlocks(bs []*models.UserBlock) []*pb.BlockedUser {
+	results := make([]*pb.BlockedUser, len(bs), len(bs))
+
+	for i, b := range bs {
+		results[i] = &pb.BlockedUser{
+			UserId:          string(b.BlockedID),
+			CreatedAtUnixMs: b.CreatedAt.UnixMilli(),
+		}
 	}
 
 	return results
 }
+
+func newFriendRequestStatusesFromPb(ss []pb.FriendRequestStatus) []models.FriendRequestStatus {
+	statuses := make([]models.FriendRequestStatus, 0, len(ss))
+	for _, s := range ss {
+		statuses = append(statuses, models.FriendRequestStatus(s))
+	}
+	return statuses
+}
+
+func newPbUserIDsFromUserIDs(ids []models.UserID) []string {
+	userIDs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		userIDs = append(userIDs, string(id))
+	}
+	return userIDs
+}
+
+func newPbSuggestedFriendsFromFriendSuggestions(ss []*models.FriendSuggestion) []*pb.SuggestedFriend {
+	users := make([]*pb.SuggestedFriend, 0, len(ss))
+	for _, s := range ss {
+		users = append(users, &pb.SuggestedFriend{
+			UserId:             string(s.UserID),
+			MutualFriendsCount: s.MutualFriendsCount,
+		})
+	}
+	return users
+}
@@ -0,0 +1,21 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/sskorolev/balun_microservices/lib/authmw"
+
+	"social/internal/app/models"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// currentUserID идентификатор пользователя из JWT, положенный в контекст authmw
+func currentUserID(ctx context.Context) (models.UserID, error) {
+	userID, ok := authmw.GetUserID(ctx)
+	if !ok || userID == "" {
+		return "", status.Error(codes.Unauthenticated, "user_id not found in context")
+	}
+	return models.UserID(userID), nil
+}
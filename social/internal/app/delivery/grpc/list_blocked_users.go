@@ -0,0 +1,30 @@
+package grpc
+
+import (
+	"context"
+
+	"social/internal/app/usecase/dto"
+
+	pb "social/pkg/api"
+)
+
+func (h *SocialController) ListBlockedUsers(ctx context.Context, req *pb.ListBlockedUsersRequest) (*pb.ListBlockedUsersResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	blockedResponse, err := h.usecase.ListBlockedUsers(ctx, dto.ListBlockedUsersDto{
+		UserID: userID,
+		Limit:  req.Limit,
+		Cursor: req.Cursor,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListBlockedUsersResponse{
+		Users:      newPbBlockedUsersFromUserBlocks(blockedResponse.Blocks),
+		NextCursor: blockedResponse.NextCursor,
+	}, nil
+}
@@ -2,29 +2,40 @@ package grpc
 
 import (
 	"context"
-	"log"
 
 	"social/internal/app/models"
+	"social/internal/app/usecase/dto"
+
 	pb "social/pkg/api"
 
-	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func (h *SocialController) ListRequests(ctx context.Context, req *pb.ListRequestsRequest) (*pb.ListRequestsResponse, error) {
-	md, ok := metadata.FromIncomingContext(ctx)
-	if !ok {
-		log.Println("Заголовков нет")
-	} else {
-		const key = "x-header"
-		log.Println(key, md.Get(key))
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// toUserId оставлен для старых клиентов, чужие заявки смотреть нельзя
+	if toUserID := req.GetToUserId(); toUserID != "" && models.UserID(toUserID) != userID {
+		return nil, status.Error(codes.PermissionDenied, "friend requests of another user")
 	}
 
-	friendRequests, err := h.usecase.ListFriendRequests(ctx, models.UserID(req.GetToUserId()))
+	requestsResponse, err := h.usecase.ListFriendRequests(ctx, dto.ListFriendRequestsDto{
+		UserID:    userID,
+		Direction: models.FriendRequestDirection(req.GetDirection()),
+		Statuses:  newFriendRequestStatusesFromPb(req.GetStatuses()),
+		Limit:     req.GetLimit(),
+		Cursor:    req.Cursor,
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	return &pb.ListRequestsResponse{
-		Requests: newPbFriendRequestsFromFriendRequests(friendRequests),
+		Requests:   newPbFriendRequestsFromFriendRequests(requestsResponse.Requests),
+		NextCursor: requestsResponse.NextCursor,
 	}, nil
 }
@@ -31,7 +31,8 @@ func (h *SocialController) ListFriends(ctx context.Context, req *pb.ListFriendsR
 	}
 
 	return &pb.ListFriendsResponse{
-		FriendUserIds: newPbFriendRequestsIDsFromFriendRequests(friendsResponse.Friends),
+		FriendUserIds: newPbFriendUserIDsFromFriendships(friendsResponse.Friends),
 		NextCursor:    friendsResponse.NextCursor,
+		Friends:       newPbFriendsFromFriendships(friendsResponse.Friends),
 	}, nil
 }
@@ -0,0 +1,32 @@
+package grpc
+
+import (
+	"context"
+
+	"social/internal/app/models"
+	"social/internal/app/usecase/dto"
+
+	pb "social/pkg/api"
+)
+
+func (h *SocialController) ListMutualFriends(ctx context.Context, req *pb.ListMutualFriendsRequest) (*pb.ListMutualFriendsResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	mutualResponse, err := h.usecase.ListMutualFriends(ctx, dto.ListMutualFriendsDto{
+		FirstUserID:  userID,
+		SecondUserID: models.UserID(req.UserId),
+		Limit:        req.Limit,
+		Cursor:       req.Cursor,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListMutualFriendsResponse{
+		UserIds:    newPbUserIDsFromUserIDs(mutualResponse.UserIDs),
+		NextCursor: mutualResponse.NextCursor,
+	}, nil
+}
@@ -0,0 +1,30 @@
+package grpc
+
+import (
+	"context"
+
+	"social/internal/app/usecase/dto"
+
+	pb "social/pkg/api"
+)
+
+func (h *SocialController) ListOutgoingRequests(ctx context.Context, req *pb.ListOutgoingRequestsRequest) (*pb.ListOutgoingRequestsResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	requestsResponse, err := h.usecase.ListOutgoingFriendRequests(ctx, dto.ListOutgoingFriendRequestsDto{
+		UserID: userID,
+		Limit:  req.Limit,
+		Cursor: req.Cursor,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListOutgoingRequestsResponse{
+		Requests:   newPbFriendRequestsFromFriendRequests(requestsResponse.Requests),
+		NextCursor: requestsResponse.NextCursor,
+	}, nil
+}
@@ -0,0 +1,28 @@
+package grpc
+
+import (
+	"context"
+
+	"social/internal/app/usecase/dto"
+
+	pb "social/pkg/api"
+)
+
+func (h *SocialController) SuggestFriends(ctx context.Context, req *pb.SuggestFriendsRequest) (*pb.SuggestFriendsResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	suggestions, err := h.usecase.SuggestFriends(ctx, dto.SuggestFriendsDto{
+		UserID: userID,
+		Limit:  req.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SuggestFriendsResponse{
+		Users: newPbSuggestedFriendsFromFriendSuggestions(suggestions),
+	}, nil
+}
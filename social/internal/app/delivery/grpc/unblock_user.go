@@ -0,0 +1,27 @@
+package grpc
+
+import (
+	"context"
+
+	"social/internal/app/models"
+	"social/internal/app/usecase/dto"
+
+	pb "social/pkg/api"
+)
+
+func (h *SocialController) UnblockUser(ctx context.Context, req *pb.UnblockUserRequest) (*pb.UnblockUserResponse, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.usecase.UnblockUser(ctx, dto.UserBlockDto{
+		BlockerID: userID,
+		BlockedID: models.UserID(req.UserId),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UnblockUserResponse{}, nil
+}
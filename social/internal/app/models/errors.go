@@ -6,4 +6,9 @@ var (
 	ErrNotFound         = errors.New("user not found")
 	ErrAlreadyExists    = errors.New("user already exists")
 	ErrPermissionDenied = errors.New("permission denied")
+	ErrInvalidCursor    = errors.New("invalid cursor")
+	ErrInvalidArgument  = errors.New("invalid argument")
+	ErrBlocked          = errors.New("user is blocked")
+	ErrNotPending       = errors.New("friend request is not pending")
+	ErrCooldown         = errors.New("friend request cooldown is not over")
 )
@@ -8,6 +8,18 @@ const (
 	FriendRequestPending  FriendRequestStatus = 0
 	FriendRequestAccepted FriendRequestStatus = 1
 	FriendRequestDeclined FriendRequestStatus = 2
+	// FriendRequestCancelled - заявка отозвана отправителем
+	FriendRequestCancelled FriendRequestStatus = 3
+	// FriendRequestExpired - заявка осталась без ответа дольше срока ожидания
+	FriendRequestExpired FriendRequestStatus = 4
+)
+
+// FriendRequestDirection - заявки, адресованные пользователю, или отправленные им
+type FriendRequestDirection int
+
+const (
+	FriendRequestIncoming FriendRequestDirection = 0
+	FriendRequestOutgoing FriendRequestDirection = 1
 )
 
 type UserID string
@@ -22,3 +34,24 @@ type FriendRequest struct {
 	CreatedAt  *time.Time
 	UpdatedAt  *time.Time
 }
+
+// Friendship - дружба с точки зрения UserID
+type Friendship struct {
+	UserID    UserID
+	FriendID  UserID
+	RequestID FriendRequestID
+	CreatedAt time.Time // с какого момента пользователи друзья
+}
+
+// FriendSuggestion - возможный друг: друг друзей пользователя
+type FriendSuggestion struct {
+	UserID             UserID
+	MutualFriendsCount int64
+}
+
+// UserBlock - блокировка пользователя BlockedID пользователем BlockerID
+type UserBlock struct {
+	BlockerID UserID
+	BlockedID UserID
+	CreatedAt time.Time
+}
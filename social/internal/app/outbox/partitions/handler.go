@@ -0,0 +1,34 @@
+package partitions
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/sskorolev/balun_microservices/lib/logger"
+)
+
+// AdminPath путь эндпоинта с деревом партиций на admin сервере
+const AdminPath = "/outbox/partitions"
+
+// LayoutHandler отдает текущее дерево партиций outbox в JSON
+func (m *Manager) LayoutHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", http.MethodGet)
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		layout, err := m.Layout(r.Context())
+		if err != nil {
+			logger.ErrorKV(r.Context(), "outbox partitions: layout error", "error", err.Error())
+			http.Error(w, "failed to load outbox partitions", http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(struct {
+			Partitions []*Partition `json:"partitions"`
+		}{Partitions: layout})
+	})
+}
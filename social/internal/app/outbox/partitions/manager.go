@@ -0,0 +1,352 @@
+package partitions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/sskorolev/balun_microservices/lib/logger"
+	"github.com/sskorolev/balun_microservices/lib/outbox"
+)
+
+// lockKey ключ advisory lock, чтобы партиции обслуживала одна реплика за раз
+const lockKey int64 = 0x6f7574626f78 // "outbox"
+
+type (
+	// Repository - DDL и служебные запросы к партициям outbox
+	Repository interface {
+		ListPartitions(ctx context.Context) ([]PartitionInfo, error)
+		TryAdvisoryLock(ctx context.Context, key int64) (bool, error)
+		CreatePartition(ctx context.Context, spec PartitionSpec) error
+		CreateEventIndexes(ctx context.Context, table, indexPrefix string) error
+		HasUnpublishedEvents(ctx context.Context, table string) (bool, error)
+		DetachPartition(ctx context.Context, table string) error
+		DropPartition(ctx context.Context, table string) error
+	}
+
+	// TransactionManager - менеджер транзакций
+	TransactionManager interface {
+		RunReadCommitted(ctx context.Context, f func(txCtx context.Context) error) error
+	}
+)
+
+// Option опция Manager
+type Option func(*Manager)
+
+// WithInterval период проверки партиций
+func WithInterval(d time.Duration) Option {
+	return func(m *Manager) {
+		if d > 0 {
+			m.interval = d
+		}
+	}
+}
+
+// WithPremake количество будущих месяцев, для которых партиции создаются заранее
+func WithPremake(n int) Option {
+	return func(m *Manager) { m.premake = n }
+}
+
+// WithRetention сколько хранить партицию после окончания ее месяца, 0 - хранить всегда
+func WithRetention(d time.Duration) Option {
+	return func(m *Manager) { m.retention = d }
+}
+
+// WithDropDetached удалять старые партиции вместо DETACH
+func WithDropDetached(drop bool) Option {
+	return func(m *Manager) { m.dropDetached = drop }
+}
+
+// Manager создает будущие месячные партиции outbox и убирает старые
+type Manager struct {
+	repo Repository
+	tm   TransactionManager
+
+	scheme       Scheme
+	interval     time.Duration
+	premake      int
+	retention    time.Duration
+	dropDetached bool
+
+	now func() time.Time
+}
+
+// NewManager конструктор Manager, scheme - агрегаты и события, под которые создаются партиции
+func NewManager(repo Repository, tm TransactionManager, scheme Scheme, opts ...Option) *Manager {
+	m := &Manager{
+		repo:     repo,
+		tm:       tm,
+		scheme:   scheme,
+		interval: time.Hour,
+		premake:  3,
+		now:      time.Now,
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// Run обслуживает партиции сразу при старте и далее раз в interval до отмены ctx
+func (m *Manager) Run(ctx context.Context) error {
+	if err := m.Maintain(ctx); err != nil {
+		logger.ErrorKV(ctx, "outbox partitions: maintain error", "error", err.Error())
+	}
+
+	t := time.NewTicker(m.interval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.C:
+			if err := m.Maintain(ctx); err != nil {
+				logger.ErrorKV(ctx, "outbox partitions: maintain error", "error", err.Error())
+			}
+		}
+	}
+}
+
+// Maintain создает недостающие партиции на текущий и premake следующих месяцев
+// и отсоединяет (или удаляет) партиции старше retention, в которых все события опубликованы
+func (m *Manager) Maintain(ctx context.Context) error {
+	const api = "[partitions.Manager][Maintain]"
+
+	layout, err := m.Layout(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", api, err)
+	}
+
+	var errs []error
+
+	current := monthStart(m.now())
+	for i := 0; i <= m.premake; i++ {
+		month := current.AddDate(0, i, 0)
+		if err := m.ensureMonth(ctx, layout, month); err != nil {
+			errs = append(errs, fmt.Errorf("%s: month %s: %w", api, month.Format("2006-01"), err))
+		}
+	}
+
+	if m.retention > 0 {
+		threshold := m.now().Add(-m.retention)
+		for _, p := range layout {
+			if p.To == nil || p.To.After(threshold) {
+				continue
+			}
+			if err := m.retire(ctx, p.Name); err != nil {
+				errs = append(errs, fmt.Errorf("%s: retire %s: %w", api, p.Name, err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Layout возвращает текущее дерево партиций outbox, месяцы упорядочены по времени
+func (m *Manager) Layout(ctx context.Context) ([]*Partition, error) {
+	infos, err := m.repo.ListPartitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildTree(infos), nil
+}
+
+// ensureMonth создает месячную партицию или недостающие партиции внутри существующей
+func (m *Manager) ensureMonth(ctx context.Context, layout []*Partition, month time.Time) error {
+	var specs []PartitionSpec
+	var leaves []leafIndexes
+
+	monthPartition := findMonth(layout, month)
+	monthName := monthPartitionName(month)
+	// Индексы листьев именуются по началу месячной партиции, она может покрывать несколько месяцев
+	partitionStart := month
+	if monthPartition == nil {
+		specs = append(specs, PartitionSpec{
+			Name:        monthName,
+			Parent:      parentTable,
+			From:        month,
+			To:          month.AddDate(0, 1, 0),
+			PartitionBy: columnAggregateType,
+			Comment:     fmt.Sprintf("Партиция outbox за %s", month.Format("2006-01")),
+		})
+	} else {
+		monthName = monthPartition.Name
+		partitionStart = *monthPartition.From
+	}
+
+	for _, aggregate := range m.sortedAggregates() {
+		var aggregatePartition *Partition
+		if monthPartition != nil {
+			aggregatePartition = findByValue(monthPartition.Children, string(aggregate))
+		}
+
+		aggregateName := aggregatePartitionName(monthName, aggregate)
+		if aggregatePartition == nil {
+			specs = append(specs, PartitionSpec{
+				Name:        aggregateName,
+				Parent:      monthName,
+				Values:      []string{string(aggregate)},
+				PartitionBy: columnEventType,
+				Comment:     fmt.Sprintf("Партиция для aggregate_type=%s (внутри — по event_type)", aggregate),
+			})
+		} else {
+			aggregateName = aggregatePartition.Name
+		}
+
+		for _, event := range m.scheme[aggregate] {
+			if aggregatePartition != nil && findByValue(aggregatePartition.Children, string(event)) != nil {
+				continue
+			}
+
+			eventName := eventPartitionName(aggregateName, aggregate, event)
+			specs = append(specs, PartitionSpec{
+				Name:    eventName,
+				Parent:  aggregateName,
+				Values:  []string{string(event)},
+				Comment: fmt.Sprintf("Лист: %s / %s", aggregate, event),
+			})
+			leaves = append(leaves, leafIndexes{table: eventName, prefix: indexPrefix(partitionStart, aggregate, event)})
+		}
+	}
+
+	if len(specs) == 0 {
+		return nil
+	}
+
+	return m.tm.RunReadCommitted(ctx, func(txCtx context.Context) error {
+		locked, err := m.repo.TryAdvisoryLock(txCtx, lockKey)
+		if err != nil {
+			return err
+		}
+		if !locked {
+			// Партициями занимается другая реплика
+			return nil
+		}
+
+		for _, spec := range specs {
+			if err := m.repo.CreatePartition(txCtx, spec); err != nil {
+				return err
+			}
+		}
+		for _, leaf := range leaves {
+			if err := m.repo.CreateEventIndexes(txCtx, leaf.table, leaf.prefix); err != nil {
+				return err
+			}
+		}
+
+		logger.InfoKV(txCtx, "outbox partitions: created", "month", month.Format("2006-01"), "count", len(specs))
+		return nil
+	})
+}
+
+// retire отсоединяет или удаляет месячную партицию, если в ней не осталось неопубликованных событий
+func (m *Manager) retire(ctx context.Context, name string) error {
+	return m.tm.RunReadCommitted(ctx, func(txCtx context.Context) error {
+		locked, err := m.repo.TryAdvisoryLock(txCtx, lockKey)
+		if err != nil {
+			return err
+		}
+		if !locked {
+			return nil
+		}
+
+		unpublished, err := m.repo.HasUnpublishedEvents(txCtx, name)
+		if err != nil {
+			return err
+		}
+		if unpublished {
+			logger.WarnKV(txCtx, "outbox partitions: retention expired but partition has unpublished events", "partition", name)
+			return nil
+		}
+
+		if m.dropDetached {
+			if err := m.repo.DropPartition(txCtx, name); err != nil {
+				return err
+			}
+			logger.InfoKV(txCtx, "outbox partitions: dropped", "partition", name)
+			return nil
+		}
+
+		if err := m.repo.DetachPartition(txCtx, name); err != nil {
+			return err
+		}
+		logger.InfoKV(txCtx, "outbox partitions: detached", "partition", name)
+		return nil
+	})
+}
+
+func (m *Manager) sortedAggregates() []outbox.AggregateType {
+	aggregates := make([]outbox.AggregateType, 0, len(m.scheme))
+	for aggregate := range m.scheme {
+		aggregates = append(aggregates, aggregate)
+	}
+	slices.Sort(aggregates)
+	return aggregates
+}
+
+type leafIndexes struct {
+	table  string
+	prefix string
+}
+
+// buildTree собирает дерево партиций из плоского списка
+func buildTree(infos []PartitionInfo) []*Partition {
+	nodes := make(map[string]*Partition, len(infos))
+	for _, info := range infos {
+		node := &Partition{
+			Name:          info.Name,
+			Bound:         info.Bound,
+			IsLeaf:        info.IsLeaf,
+			EstimatedRows: info.EstimatedRows,
+		}
+		if from, to, ok := parseRangeBound(info.Bound); ok {
+			node.From, node.To = &from, &to
+		}
+		node.Values = parseListBound(info.Bound)
+		nodes[info.Name] = node
+	}
+
+	var roots []*Partition
+	for _, info := range infos {
+		node := nodes[info.Name]
+		if parent, ok := nodes[info.Parent]; ok {
+			parent.Children = append(parent.Children, node)
+		} else {
+			roots = append(roots, node)
+		}
+	}
+
+	sort.SliceStable(roots, func(i, j int) bool {
+		if roots[i].From == nil || roots[j].From == nil {
+			return roots[j].From == nil && roots[i].From != nil
+		}
+		return roots[i].From.Before(*roots[j].From)
+	})
+
+	return roots
+}
+
+// findMonth ищет RANGE партицию, которая покрывает начало месяца
+func findMonth(layout []*Partition, month time.Time) *Partition {
+	for _, p := range layout {
+		if p.From != nil && p.To != nil && !p.From.After(month) && p.To.After(month) {
+			return p
+		}
+	}
+	return nil
+}
+
+// findByValue ищет LIST партицию, содержащую значение
+func findByValue(partitions []*Partition, value string) *Partition {
+	for _, p := range partitions {
+		if slices.Contains(p.Values, value) {
+			return p
+		}
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package partitions
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/sskorolev/balun_microservices/lib/outbox"
+)
+
+// Схема партиционирования public.outbox_events:
+//
+//	outbox_events                                     RANGE (created_at)
+//	└── outbox_events_2025_10                         месяц, LIST (aggregate_type)
+//	    └── ..._2025_10__agg_friend_request           агрегат, LIST (event_type)
+//	        └── ..._agg_friend_request__evt_created   лист с индексами
+const (
+	parentTable = "outbox_events"
+
+	columnAggregateType = "aggregate_type"
+	columnEventType     = "event_type"
+)
+
+// Scheme агрегаты и их события, под каждую пару создается листовая партиция
+//
+// Обычно берется из реестра событий: outbox.Registry.Aggregates().
+type Scheme map[outbox.AggregateType][]outbox.EventType
+
+// PartitionInfo партиция outbox в плоском виде, как ее видит Postgres
+type PartitionInfo struct {
+	Name          string
+	Parent        string
+	Level         int
+	IsLeaf        bool
+	Bound         string
+	EstimatedRows int64
+}
+
+// Partition узел дерева партиций для admin эндпоинта
+type Partition struct {
+	Name          string       `json:"name"`
+	Bound         string       `json:"bound"`
+	From          *time.Time   `json:"from,omitempty"`
+	To            *time.Time   `json:"to,omitempty"`
+	Values        []string     `json:"values,omitempty"`
+	IsLeaf        bool         `json:"isLeaf"`
+	EstimatedRows int64        `json:"estimatedRows"`
+	Children      []*Partition `json:"children,omitempty"`
+}
+
+// PartitionSpec описание создаваемой партиции
+type PartitionSpec struct {
+	Name   string
+	Parent string
+	// From, To границы RANGE партиции
+	From, To time.Time
+	// Values значения LIST партиции, если пусто - партиция RANGE
+	Values []string
+	// PartitionBy колонка следующего уровня, пусто для листа
+	PartitionBy string
+	Comment     string
+}
+
+var (
+	rangeBoundRe = regexp.MustCompile(`FROM \('([^']+)'\) TO \('([^']+)'\)`)
+	listBoundRe  = regexp.MustCompile(`'((?:[^']|'')*)'`)
+	camelRe      = regexp.MustCompile(`([a-z0-9])([A-Z])`)
+)
+
+// boundLayouts форматы timestamptz в выводе pg_get_expr
+var boundLayouts = []string{
+	"2006-01-02 15:04:05.999999-07",
+	"2006-01-02 15:04:05.999999-07:00",
+}
+
+// parseRangeBound разбирает границы RANGE партиции: FOR VALUES FROM ('...') TO ('...')
+func parseRangeBound(bound string) (from, to time.Time, ok bool) {
+	m := rangeBoundRe.FindStringSubmatch(bound)
+	if m == nil {
+		return time.Time{}, time.Time{}, false
+	}
+
+	from, okFrom := parseBoundTime(m[1])
+	to, okTo := parseBoundTime(m[2])
+	return from, to, okFrom && okTo
+}
+
+func parseBoundTime(s string) (time.Time, bool) {
+	for _, layout := range boundLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t.UTC(), true
+		}
+	}
+	return time.Time{}, false
+}
+
+// parseListBound разбирает значения LIST партиции: FOR VALUES IN ('a', 'b')
+func parseListBound(bound string) []string {
+	if !strings.HasPrefix(bound, "FOR VALUES IN") {
+		return nil
+	}
+
+	matches := listBoundRe.FindAllStringSubmatch(bound, -1)
+	values := make([]string, 0, len(matches))
+	for _, m := range matches {
+		values = append(values, strings.ReplaceAll(m[1], "''", "'"))
+	}
+	return values
+}
+
+// monthStart начало месяца в UTC
+func monthStart(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// monthPartitionName outbox_events_2025_10
+func monthPartitionName(month time.Time) string {
+	return fmt.Sprintf("%s_%04d_%02d", parentTable, month.Year(), int(month.Month()))
+}
+
+// aggregatePartitionName outbox_events_2025_10__agg_friend_request
+func aggregatePartitionName(monthName string, aggregate outbox.AggregateType) string {
+	return monthName + "__agg_" + string(aggregate)
+}
+
+// eventPartitionName outbox_events_2025_10__agg_friend_request__evt_created
+func eventPartitionName(aggregateName string, aggregate outbox.AggregateType, event outbox.EventType) string {
+	return aggregateName + "__evt_" + eventSuffix(aggregate, event)
+}
+
+// indexPrefix idx_o_2025_10_fr_created
+func indexPrefix(month time.Time, aggregate outbox.AggregateType, event outbox.EventType) string {
+	var abbr strings.Builder
+	for _, word := range strings.Split(string(aggregate), "_") {
+		if word != "" {
+			abbr.WriteByte(word[0])
+		}
+	}
+
+	return fmt.Sprintf("idx_o_%04d_%02d_%s_%s", month.Year(), int(month.Month()), abbr.String(), eventSuffix(aggregate, event))
+}
+
+// eventSuffix FriendRequestStatusUpdated для friend_request -> status_updated
+func eventSuffix(aggregate outbox.AggregateType, event outbox.EventType) string {
+	snake := strings.ToLower(camelRe.ReplaceAllString(string(event), "${1}_${2}"))
+	return strings.TrimPrefix(snake, string(aggregate)+"_")
+}
@@ -0,0 +1,51 @@
+package partitions
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"social/internal/app/outbox/processor"
+)
+
+func TestPartitionNames(t *testing.T) {
+	month := time.Date(2025, time.October, 1, 0, 0, 0, 0, time.UTC)
+	aggregate := processor.AggregateTypeFriendRequest
+
+	monthName := monthPartitionName(month)
+	aggregateName := aggregatePartitionName(monthName, aggregate)
+
+	// Имена совпадают с созданными миграцией 20251022120500
+	assert.Equal(t, "outbox_events_2025_10", monthName)
+	assert.Equal(t, "outbox_events_2025_10__agg_friend_request", aggregateName)
+	assert.Equal(t, "outbox_events_2025_10__agg_friend_request__evt_created",
+		eventPartitionName(aggregateName, aggregate, processor.EventTypeFriendRequestCreated))
+	assert.Equal(t, "outbox_events_2025_10__agg_friend_request__evt_status_updated",
+		eventPartitionName(aggregateName, aggregate, processor.EventTypeFriendRequestStatusUpdated))
+	assert.Equal(t, "idx_o_2025_10_fr_status_updated",
+		indexPrefix(month, aggregate, processor.EventTypeFriendRequestStatusUpdated))
+}
+
+func TestBuildTree(t *testing.T) {
+	tree := buildTree([]PartitionInfo{
+		{Name: "outbox_events_2026_01", Parent: "outbox_events", Level: 1,
+			Bound: "FOR VALUES FROM ('2026-01-01 03:00:00+03') TO ('2026-02-01 03:00:00+03')"},
+		{Name: "outbox_events_2025_10", Parent: "outbox_events", Level: 1,
+			Bound: "FOR VALUES FROM ('2025-10-01 00:00:00+00') TO ('2025-12-01 00:00:00+00')"},
+		{Name: "outbox_events_2025_10__agg_friend_request", Parent: "outbox_events_2025_10", Level: 2,
+			Bound: "FOR VALUES IN ('friend_request')"},
+	})
+
+	require.Len(t, tree, 2)
+	assert.Equal(t, "outbox_events_2025_10", tree[0].Name)
+	assert.Equal(t, time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC), *tree[1].From)
+
+	require.Len(t, tree[0].Children, 1)
+	assert.Equal(t, []string{"friend_request"}, tree[0].Children[0].Values)
+
+	// Октябрьская партиция покрывает и ноябрь - отдельную создавать не нужно
+	assert.Equal(t, tree[0], findMonth(tree, time.Date(2025, time.November, 1, 0, 0, 0, 0, time.UTC)))
+	assert.Nil(t, findMonth(tree, time.Date(2025, time.December, 1, 0, 0, 0, 0, time.UTC)))
+}
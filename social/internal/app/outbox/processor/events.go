@@ -0,0 +1,96 @@
+package processor
+
+import (
+	"time"
+
+	"github.com/sskorolev/balun_microservices/lib/outbox"
+
+	"social/internal/app/models"
+)
+
+const (
+	// AggregateTypeFriendRequest агрегат заявки в друзья
+	AggregateTypeFriendRequest outbox.AggregateType = "friend_request"
+	// AggregateTypeUserBlock агрегат блокировки пользователя
+	AggregateTypeUserBlock outbox.AggregateType = "user_block"
+)
+
+const (
+	EventTypeFriendRequestCreated       outbox.EventType = "FriendRequestCreated"
+	EventTypeFriendRequestStatusUpdated outbox.EventType = "FriendRequestStatusUpdated"
+	EventTypeUserBlocked                outbox.EventType = "UserBlocked"
+	EventTypeUserUnblocked              outbox.EventType = "UserUnblocked"
+)
+
+// FriendRequestCreatedPayload тело события FriendRequestCreated
+type FriendRequestCreatedPayload struct {
+	FriendRequestID string     `json:"friend_request_id"`
+	FromUserID      string     `json:"from_user_id"`
+	ToUserID        string     `json:"to_user_id"`
+	CreatedAt       *time.Time `json:"created_at,omitempty"`
+}
+
+// FriendRequestStatusUpdatedPayload тело события FriendRequestStatusUpdated
+type FriendRequestStatusUpdatedPayload struct {
+	FriendRequestID string     `json:"friend_request_id"`
+	FromUserID      string     `json:"from_user_id"`
+	ToUserID        string     `json:"to_user_id"`
+	Status          string     `json:"status"`
+	UpdatedAt       *time.Time `json:"updated_at,omitempty"`
+}
+
+// UserBlockPayload тело событий UserBlocked и UserUnblocked
+type UserBlockPayload struct {
+	BlockerID string    `json:"blocker_id"`
+	BlockedID string    `json:"blocked_id"`
+	At        time.Time `json:"at"`
+}
+
+// Статусы заявки в теле событий
+const (
+	StatusPending   = "pending"
+	StatusAccepted  = "accepted"
+	StatusDeclined  = "declined"
+	StatusCancelled = "cancelled"
+	StatusExpired   = "expired"
+)
+
+var (
+	// Registry события, которые social сервис пишет в outbox
+	Registry = outbox.NewRegistry()
+
+	FriendRequestCreated = outbox.Register[FriendRequestCreatedPayload](
+		Registry, AggregateTypeFriendRequest, EventTypeFriendRequestCreated,
+	)
+	FriendRequestStatusUpdated = outbox.Register[FriendRequestStatusUpdatedPayload](
+		Registry, AggregateTypeFriendRequest, EventTypeFriendRequestStatusUpdated,
+	)
+	UserBlocked = outbox.Register[UserBlockPayload](
+		Registry, AggregateTypeUserBlock, EventTypeUserBlocked,
+	)
+	UserUnblocked = outbox.Register[UserBlockPayload](
+		Registry, AggregateTypeUserBlock, EventTypeUserUnblocked,
+	)
+)
+
+// userBlockAggregateID ключ агрегата блокировки: события одной пары пользователей
+// попадают в одну партицию Kafka и публикуются по порядку
+func userBlockAggregateID(block *models.UserBlock) string {
+	return string(block.BlockerID) + ":" + string(block.BlockedID)
+}
+
+// statusName название статуса заявки для тела события
+func statusName(status models.FriendRequestStatus) string {
+	switch status {
+	case models.FriendRequestAccepted:
+		return StatusAccepted
+	case models.FriendRequestDeclined:
+		return StatusDeclined
+	case models.FriendRequestCancelled:
+		return StatusCancelled
+	case models.FriendRequestExpired:
+		return StatusExpired
+	default:
+		return StatusPending
+	}
+}
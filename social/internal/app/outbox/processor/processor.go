@@ -1,39 +1,25 @@
 package processor
 
 import (
-	"context"
+	"github.com/sskorolev/balun_microservices/lib/outbox"
 
 	"social/internal/app/usecase"
 )
 
-// Проверка удовлетворению интерфейсу orders.OutboxRepository
+// Проверка удовлетворению интерфейсу usecase.OutboxRepository
 var _ usecase.OutboxRepository = (*Processor)(nil)
 
-type (
-	// Repository - репозиторий outbox
-	Repository interface {
-		SaveEvent(ctx context.Context, e *Event) error
-		SearchEvents(ctx context.Context, opts ...SearchEventsOption) []*Event
-		UpdateEvents(ctx context.Context, opts ...UpdateEventsOption) error
-	}
-
-	// TransactionManager
-	TransactionManager interface {
-		RunRepeatableRead(ctx context.Context, f func(tctx context.Context) error) error
-	}
-)
-
 // Deps - зависимости
 type Deps struct {
-	Repository Repository
+	Writer *outbox.Writer
 }
 
-// Processor - ...
+// Processor - запись событий social сервиса в outbox
 type Processor struct {
 	Deps
 }
 
-// NewProcessor - ...
+// NewProcessor - конструктор Processor
 func NewProcessor(d Deps) *Processor {
 	return &Processor{
 		Deps: d,
@@ -0,0 +1,19 @@
+package processor
+
+import (
+	"context"
+
+	"github.com/sskorolev/balun_microservices/lib/outbox"
+
+	"social/internal/app/models"
+)
+
+// SaveFriendRequestCreated записывает в outbox событие о новой заявке в друзья
+func (p *Processor) SaveFriendRequestCreated(ctx context.Context, req *models.FriendRequest) error {
+	return outbox.Emit(ctx, p.Writer, FriendRequestCreated, string(req.ID), FriendRequestCreatedPayload{
+		FriendRequestID: string(req.ID),
+		FromUserID:      string(req.FromUserID),
+		ToUserID:        string(req.ToUserID),
+		CreatedAt:       req.CreatedAt,
+	})
+}
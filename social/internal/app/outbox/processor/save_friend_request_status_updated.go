@@ -0,0 +1,20 @@
+package processor
+
+import (
+	"context"
+
+	"github.com/sskorolev/balun_microservices/lib/outbox"
+
+	"social/internal/app/models"
+)
+
+// SaveFriendRequestStatusUpdated записывает в outbox событие о смене статуса заявки в друзья
+func (p *Processor) SaveFriendRequestStatusUpdated(ctx context.Context, req *models.FriendRequest) error {
+	return outbox.Emit(ctx, p.Writer, FriendRequestStatusUpdated, string(req.ID), FriendRequestStatusUpdatedPayload{
+		FriendRequestID: string(req.ID),
+		FromUserID:      string(req.FromUserID),
+		ToUserID:        string(req.ToUserID),
+		Status:          statusName(req.Status),
+		UpdatedAt:       req.UpdatedAt,
+	})
+}
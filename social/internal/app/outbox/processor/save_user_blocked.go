@@ -0,0 +1,18 @@
+package processor
+
+import (
+	"context"
+
+	"github.com/sskorolev/balun_microservices/lib/outbox"
+
+	"social/internal/app/models"
+)
+
+// SaveUserBlocked записывает в outbox событие о блокировке пользователя
+func (p *Processor) SaveUserBlocked(ctx context.Context, block *models.UserBlock) error {
+	return outbox.Emit(ctx, p.Writer, UserBlocked, userBlockAggregateID(block), UserBlockPayload{
+		BlockerID: string(block.BlockerID),
+		BlockedID: string(block.BlockedID),
+		At:        block.CreatedAt,
+	})
+}
@@ -0,0 +1,18 @@
+package processor
+
+import (
+	"context"
+
+	"github.com/sskorolev/balun_microservices/lib/outbox"
+
+	"social/internal/app/models"
+)
+
+// SaveUserUnblocked записывает в outbox событие о снятии блокировки
+func (p *Processor) SaveUserUnblocked(ctx context.Context, block *models.UserBlock) error {
+	return outbox.Emit(ctx, p.Writer, UserUnblocked, userBlockAggregateID(block), UserBlockPayload{
+		BlockerID: string(block.BlockerID),
+		BlockedID: string(block.BlockedID),
+		At:        block.CreatedAt,
+	})
+}
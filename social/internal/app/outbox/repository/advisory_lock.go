@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sskorolev/balun_microservices/lib/postgres"
+
+	"github.com/Masterminds/squirrel"
+)
+
+// TryAdvisoryLock берет транзакционный advisory lock, не дожидаясь его освобождения
+//
+// Должен вызываться внутри транзакции, lock снимается на COMMIT/ROLLBACK.
+func (r *Repository) TryAdvisoryLock(ctx context.Context, key int64) (bool, error) {
+	const api = "outbox.Repository.TryAdvisoryLock"
+
+	conn := r.db.GetQueryEngine(ctx)
+	var locked bool
+	if err := conn.Getx(ctx, &locked, squirrel.Expr("SELECT pg_try_advisory_xact_lock($1)", key)); err != nil {
+		return false, fmt.Errorf("%s: %w", api, postgres.ConvertPGError(err))
+	}
+	return locked, nil
+}
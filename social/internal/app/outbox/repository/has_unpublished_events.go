@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sskorolev/balun_microservices/lib/postgres"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+)
+
+// HasUnpublishedEvents проверяет, остались ли в партиции неопубликованные события
+func (r *Repository) HasUnpublishedEvents(ctx context.Context, table string) (bool, error) {
+	const api = "outbox.Repository.HasUnpublishedEvents"
+
+	query := fmt.Sprintf("SELECT EXISTS (SELECT 1 FROM %s WHERE %s IS NULL)",
+		pgx.Identifier{"public", table}.Sanitize(), columnOutboxPublishedAt)
+
+	conn := r.db.GetQueryEngine(ctx)
+	var exists bool
+	if err := conn.Getx(ctx, &exists, squirrel.Expr(query)); err != nil {
+		return false, fmt.Errorf("%s: %w", api, postgres.ConvertPGError(err))
+	}
+	return exists, nil
+}
@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sskorolev/balun_microservices/lib/postgres"
+
+	"social/internal/app/outbox/partitions"
+
+	"github.com/Masterminds/squirrel"
+)
+
+type partitionRow struct {
+	Name          string `db:"name"`
+	Parent        string `db:"parent"`
+	Level         int    `db:"level"`
+	IsLeaf        bool   `db:"is_leaf"`
+	Bound         string `db:"bound"`
+	EstimatedRows int64  `db:"estimated_rows"`
+}
+
+// ListPartitions возвращает все партиции public.outbox_events (без самой таблицы)
+func (r *Repository) ListPartitions(ctx context.Context) ([]partitions.PartitionInfo, error) {
+	const api = "outbox.Repository.ListPartitions"
+
+	qb := squirrel.Expr(`
+SELECT c.relname                                    AS name,
+       COALESCE(p.relname, '')                      AS parent,
+       t.level                                      AS level,
+       t.isleaf                                     AS is_leaf,
+       COALESCE(pg_get_expr(c.relpartbound, c.oid), '') AS bound,
+       GREATEST(c.reltuples, 0)::BIGINT             AS estimated_rows
+FROM pg_partition_tree($1::regclass) t
+JOIN pg_class c ON c.oid = t.relid
+LEFT JOIN pg_class p ON p.oid = t.parentrelid
+WHERE t.level > 0
+ORDER BY t.level, c.relname`, tableOutboxEvents)
+
+	conn := r.db.GetQueryEngine(ctx)
+	var rows []partitionRow
+	if err := conn.Selectx(ctx, &rows, qb); err != nil {
+		return nil, fmt.Errorf("%s: %w", api, postgres.ConvertPGError(err))
+	}
+
+	result := make([]partitions.PartitionInfo, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, partitions.PartitionInfo{
+			Name:          row.Name,
+			Parent:        row.Parent,
+			Level:         row.Level,
+			IsLeaf:        row.IsLeaf,
+			Bound:         row.Bound,
+			EstimatedRows: row.EstimatedRows,
+		})
+	}
+	return result, nil
+}
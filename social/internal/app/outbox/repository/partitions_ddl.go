@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sskorolev/balun_microservices/lib/postgres"
+
+	"social/internal/app/outbox/partitions"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// ddlLockTimeout сколько DDL ждет блокировку таблицы, чтобы не копить за собой очередь вставок
+const ddlLockTimeout = "5s"
+
+// CreatePartition создает партицию outbox, если ее еще нет
+func (r *Repository) CreatePartition(ctx context.Context, spec partitions.PartitionSpec) error {
+	const api = "outbox.Repository.CreatePartition"
+
+	var bound string
+	if len(spec.Values) > 0 {
+		values := make([]string, 0, len(spec.Values))
+		for _, v := range spec.Values {
+			values = append(values, quoteLiteral(v))
+		}
+		bound = fmt.Sprintf("FOR VALUES IN (%s)", strings.Join(values, ", "))
+	} else {
+		bound = fmt.Sprintf("FOR VALUES FROM (%s) TO (%s)", quoteTime(spec.From), quoteTime(spec.To))
+	}
+
+	table := pgx.Identifier{"public", spec.Name}.Sanitize()
+	statements := []string{
+		"SET LOCAL lock_timeout = " + quoteLiteral(ddlLockTimeout),
+		fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s PARTITION OF %s %s%s",
+			table,
+			pgx.Identifier{"public", spec.Parent}.Sanitize(),
+			bound,
+			partitionBy(spec.PartitionBy),
+		),
+	}
+	if spec.Comment != "" {
+		statements = append(statements, fmt.Sprintf("COMMENT ON TABLE %s IS %s", table, quoteLiteral(spec.Comment)))
+	}
+
+	return r.execDDL(ctx, api, statements...)
+}
+
+// CreateEventIndexes создает на листовой партиции индексы, которые использует outbox worker
+func (r *Repository) CreateEventIndexes(ctx context.Context, table, indexPrefix string) error {
+	const api = "outbox.Repository.CreateEventIndexes"
+
+	t := pgx.Identifier{"public", table}.Sanitize()
+	return r.execDDL(ctx, api,
+		fmt.Sprintf("CREATE INDEX IF NOT EXISTS %s ON %s (%s) WHERE %s IS NULL",
+			pgx.Identifier{indexPrefix + "_due"}.Sanitize(), t, columnOutboxNextAttemptAt, columnOutboxPublishedAt),
+		fmt.Sprintf("CREATE INDEX IF NOT EXISTS %s ON %s (%s) WHERE %s IS NULL",
+			pgx.Identifier{indexPrefix + "_unpub"}.Sanitize(), t, columnOutboxPublishedAt, columnOutboxPublishedAt),
+		fmt.Sprintf("CREATE INDEX IF NOT EXISTS %s ON %s (%s, %s)",
+			pgx.Identifier{indexPrefix + "_ordering"}.Sanitize(), t, columnOutboxCreatedAt, columnOutboxID),
+	)
+}
+
+// DetachPartition отсоединяет месячную партицию от public.outbox_events, таблица остается для архива
+func (r *Repository) DetachPartition(ctx context.Context, table string) error {
+	const api = "outbox.Repository.DetachPartition"
+
+	return r.execDDL(ctx, api,
+		"SET LOCAL lock_timeout = "+quoteLiteral(ddlLockTimeout),
+		fmt.Sprintf("ALTER TABLE %s DETACH PARTITION %s", tableOutboxEvents, pgx.Identifier{"public", table}.Sanitize()),
+	)
+}
+
+// DropPartition удаляет месячную партицию вместе с вложенными
+func (r *Repository) DropPartition(ctx context.Context, table string) error {
+	const api = "outbox.Repository.DropPartition"
+
+	return r.execDDL(ctx, api,
+		"SET LOCAL lock_timeout = "+quoteLiteral(ddlLockTimeout),
+		fmt.Sprintf("DROP TABLE IF EXISTS %s", pgx.Identifier{"public", table}.Sanitize()),
+	)
+}
+
+func (r *Repository) execDDL(ctx context.Context, api string, statements ...string) error {
+	conn := r.db.GetQueryEngine(ctx)
+	for _, stmt := range statements {
+		if _, err := conn.Exec(ctx, stmt); err != nil {
+			return fmt.Errorf("%s: %w", api, postgres.ConvertPGError(err))
+		}
+	}
+	return nil
+}
+
+func partitionBy(column string) string {
+	if column == "" {
+		return ""
+	}
+	return fmt.Sprintf(" PARTITION BY LIST (%s)", pgx.Identifier{column}.Sanitize())
+}
+
+// quoteLiteral экранирует строковый литерал для DDL, где нельзя использовать параметры
+func quoteLiteral(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+func quoteTime(t time.Time) string {
+	return quoteLiteral(t.UTC().Format("2006-01-02 15:04:05+00"))
+}
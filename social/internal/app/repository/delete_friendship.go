@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sskorolev/balun_microservices/lib/postgres"
+
+	"social/internal/app/models"
+	"social/internal/app/repository/friendship"
+
+	"github.com/Masterminds/squirrel"
+)
+
+const deleteFriendshipApi = "[Repository][DeleteFriendship]"
+
+// DeleteFriendship удаляет дружбу между пользователями в обоих направлениях
+func (r *Repository) DeleteFriendship(ctx context.Context, firstUserID models.UserID, secondUserID models.UserID) error {
+	deleteQuery := r.sb.Delete(friendship.FriendshipsTable).
+		Where(squirrel.Or{
+			squirrel.Eq{
+				friendship.FriendshipsTableColumnUserID:   string(firstUserID),
+				friendship.FriendshipsTableColumnFriendID: string(secondUserID),
+			},
+			squirrel.Eq{
+				friendship.FriendshipsTableColumnUserID:   string(secondUserID),
+				friendship.FriendshipsTableColumnFriendID: string(firstUserID),
+			},
+		})
+
+	// Получаем QueryEngine из контекста
+	conn := r.tm.GetQueryEngine(ctx)
+
+	if _, err := conn.Execx(ctx, deleteQuery); err != nil {
+		return fmt.Errorf("%s: %w", deleteFriendshipApi, postgres.ConvertPGError(err))
+	}
+
+	return nil
+}
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_DeleteFriendship(t *testing.T) {
+	ctx := context.Background()
+	engine := &fakeQueryEngine{}
+	r := NewRepository(fakeTxManager{engine: engine})
+
+	require.NoError(t, r.DeleteFriendship(ctx, "alice", "bob"))
+
+	// Одно удаление обоих направлений
+	require.Len(t, engine.queries, 1)
+	q := engine.queries[0]
+	assert.Equal(t, "DELETE FROM friendships WHERE (friend_id = $1 AND user_id = $2 OR friend_id = $3 AND user_id = $4)", q.sql)
+	assert.Equal(t, []any{"bob", "alice", "alice", "bob"}, q.args)
+}
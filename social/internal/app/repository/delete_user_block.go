@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sskorolev/balun_microservices/lib/postgres"
+
+	"social/internal/app/models"
+	"social/internal/app/repository/user_block"
+
+	"github.com/Masterminds/squirrel"
+)
+
+const deleteUserBlockApi = "[Repository][DeleteUserBlock]"
+
+// DeleteUserBlock удаляет блокировку blockedID пользователем blockerID, deleted = false, если ее не было
+func (r *Repository) DeleteUserBlock(ctx context.Context, blockerID models.UserID, blockedID models.UserID) (deleted bool, err error) {
+	deleteQuery := r.sb.Delete(user_block.UserBlocksTable).
+		Where(squirrel.Eq{
+			user_block.UserBlocksTableColumnBlockerID: string(blockerID),
+			user_block.UserBlocksTableColumnBlockedID: string(blockedID),
+		})
+
+	// Получаем QueryEngine из контекста
+	conn := r.tm.GetQueryEngine(ctx)
+
+	tag, err := conn.Execx(ctx, deleteQuery)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", deleteUserBlockApi, postgres.ConvertPGError(err))
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sskorolev/balun_microservices/lib/postgres"
+
+	"social/internal/app/models"
+	"social/internal/app/repository/friend_request"
+
+	"github.com/Masterminds/squirrel"
+)
+
+const expireFriendRequestsApi = "[Repository][ExpireFriendRequests]"
+
+// ExpireFriendRequests переводит в статус expired до limit ожидающих заявок, созданных раньше createdBefore
+//
+// Заявки, которые в этот момент меняет другая транзакция, пропускаются и истекут при следующем запуске.
+func (r *Repository) ExpireFriendRequests(ctx context.Context, createdBefore time.Time, limit int) ([]*models.FriendRequest, error) {
+	now := time.Now()
+
+	// Выбираем самые старые ожидающие заявки, порядок совпадает с индексом idx_friend_requests_pending_created_at
+	staleQuery := r.sb.Select(friend_request.FriendRequestsTableColumnID).
+		From(friend_request.FriendRequestsTable).
+		Where(squirrel.Eq{friend_request.FriendRequestsTableColumnStatus: int(models.FriendRequestPending)}).
+		Where(squirrel.Lt{friend_request.FriendRequestsTableColumnCreatedAt: createdBefore}).
+		OrderBy(friend_request.FriendRequestsTableColumnCreatedAt).
+		Limit(uint64(limit)).
+		Suffix("FOR UPDATE SKIP LOCKED")
+
+	updateQuery := r.sb.Update(friend_request.FriendRequestsTable).
+		Set(friend_request.FriendRequestsTableColumnStatus, int(models.FriendRequestExpired)).
+		Set(friend_request.FriendRequestsTableColumnUpdatedAt, now).
+		Where(staleQuery.Prefix(friend_request.FriendRequestsTableColumnID + " IN (").Suffix(")")).
+		Suffix("RETURNING " + strings.Join(friend_request.FriendRequestsTableColumns, ", "))
+
+	// Получаем QueryEngine из контекста транзакции
+	conn := r.tm.GetQueryEngine(ctx)
+
+	var rows []friend_request.Row
+	if err := conn.Selectx(ctx, &rows, updateQuery); err != nil {
+		return nil, fmt.Errorf("%s: %w", expireFriendRequestsApi, postgres.ConvertPGError(err))
+	}
+
+	result := make([]*models.FriendRequest, 0, len(rows))
+	for i := range rows {
+		result = append(result, friend_request.ToModel(&rows[i]))
+	}
+
+	return result, nil
+}
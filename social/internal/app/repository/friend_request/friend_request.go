@@ -1,8 +1,13 @@
 package friend_request
 
 import (
+	"encoding/base64"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/google/uuid"
+
 	"social/internal/app/models"
 )
 
@@ -57,3 +62,36 @@ func FromModel(m *models.FriendRequest) Row {
 		UpdatedAt:  m.UpdatedAt,
 	}
 }
+
+// Cursor - позиция в списке заявок, отсортированном по (created_at, id) по убыванию
+type Cursor struct {
+	CreatedAt time.Time
+	ID        string
+}
+
+// EncodeCursor кодирует позицию последней строки страницы в непрозрачную строку
+func EncodeCursor(r *Row) string {
+	raw := strconv.FormatInt(r.CreatedAt.UnixMicro(), 10) + ":" + r.ID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeCursor разбирает строку, полученную от EncodeCursor
+func DecodeCursor(s string) (*Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, models.ErrInvalidCursor
+	}
+
+	// id сравнивается с uuid колонкой, невалидное значение сломало бы запрос
+	micros, id, ok := strings.Cut(string(raw), ":")
+	if _, err := uuid.Parse(id); !ok || err != nil {
+		return nil, models.ErrInvalidCursor
+	}
+
+	createdAt, err := strconv.ParseInt(micros, 10, 64)
+	if err != nil {
+		return nil, models.ErrInvalidCursor
+	}
+
+	return &Cursor{CreatedAt: time.UnixMicro(createdAt), ID: id}, nil
+}
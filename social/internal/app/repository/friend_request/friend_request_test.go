@@ -0,0 +1,40 @@
+package friend_request
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"social/internal/app/models"
+)
+
+func TestCursor(t *testing.T) {
+	t.Run("курсор восстанавливает позицию строки", func(t *testing.T) {
+		row := &Row{
+			ID:        "c2f6a1de-2d4b-4f43-9a53-5d1c0e3f7b10",
+			CreatedAt: time.Date(2026, time.October, 16, 12, 30, 0, 123456000, time.UTC),
+		}
+
+		cursor, err := DecodeCursor(EncodeCursor(row))
+		require.NoError(t, err)
+		assert.True(t, row.CreatedAt.Equal(cursor.CreatedAt))
+		assert.Equal(t, row.ID, cursor.ID)
+	})
+
+	t.Run("некорректный курсор", func(t *testing.T) {
+		for _, s := range []string{
+			"not base64!",
+			// нет разделителя
+			"bm8tc2VwYXJhdG9y",
+			// id не uuid
+			"MTIzOmZyaWVuZA",
+			// время не число
+			"YWJjOmMyZjZhMWRlLTJkNGItNGY0My05YTUzLTVkMWMwZTNmN2IxMA",
+		} {
+			_, err := DecodeCursor(s)
+			assert.ErrorIs(t, err, models.ErrInvalidCursor, s)
+		}
+	})
+}
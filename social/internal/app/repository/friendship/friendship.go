@@ -0,0 +1,83 @@
+package friendship
+
+import (
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	"social/internal/app/models"
+)
+
+// Row — «плоская» проекция строки таблицы friendships
+type Row struct {
+	UserID    string    `db:"user_id"`
+	FriendID  string    `db:"friend_id"`
+	RequestID string    `db:"request_id"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// ToModel конвертирует Row в доменную модель models.Friendship
+func ToModel(r *Row) *models.Friendship {
+	if r == nil {
+		return nil
+	}
+	return &models.Friendship{
+		UserID:    models.UserID(r.UserID),
+		FriendID:  models.UserID(r.FriendID),
+		RequestID: models.FriendRequestID(r.RequestID),
+		CreatedAt: r.CreatedAt,
+	}
+}
+
+// Cursor - позиция в списке друзей, отсортированном по (created_at, friend_id) по убыванию
+type Cursor struct {
+	CreatedAt time.Time
+	FriendID  string
+}
+
+// EncodeCursor кодирует позицию последней строки страницы в непрозрачную строку
+func EncodeCursor(r *Row) string {
+	raw := strconv.FormatInt(r.CreatedAt.UnixMicro(), 10) + ":" + r.FriendID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeCursor разбирает строку, полученную от EncodeCursor
+func DecodeCursor(s string) (*Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, models.ErrInvalidCursor
+	}
+
+	micros, friendID, ok := strings.Cut(string(raw), ":")
+	if !ok || friendID == "" {
+		return nil, models.ErrInvalidCursor
+	}
+
+	createdAt, err := strconv.ParseInt(micros, 10, 64)
+	if err != nil {
+		return nil, models.ErrInvalidCursor
+	}
+
+	return &Cursor{CreatedAt: time.UnixMicro(createdAt), FriendID: friendID}, nil
+}
+
+// EncodeMutualCursor кодирует позицию в списке общих друзей, отсортированном по friend_id
+func EncodeMutualCursor(friendID string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(friendID))
+}
+
+// DecodeMutualCursor разбирает строку, полученную от EncodeMutualCursor
+func DecodeMutualCursor(s string) (string, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil || len(raw) == 0 {
+		return "", models.ErrInvalidCursor
+	}
+	return string(raw), nil
+}
+
+// SuggestionRow - друг друзей и число общих друзей
+type SuggestionRow struct {
+	UserID             string `db:"user_id"`
+	MutualFriendsCount int64  `db:"mutual_friends_count"`
+}
@@ -0,0 +1,17 @@
+package friendship
+
+const FriendshipsTable = "friendships"
+
+const (
+	FriendshipsTableColumnUserID    = "user_id"
+	FriendshipsTableColumnFriendID  = "friend_id"
+	FriendshipsTableColumnRequestID = "request_id"
+	FriendshipsTableColumnCreatedAt = "created_at"
+)
+
+var FriendshipsTableColumns = []string{
+	FriendshipsTableColumnUserID,
+	FriendshipsTableColumnFriendID,
+	FriendshipsTableColumnRequestID,
+	FriendshipsTableColumnCreatedAt,
+}
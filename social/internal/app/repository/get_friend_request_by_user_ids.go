@@ -16,18 +16,23 @@ import (
 
 const GetFriendRequestByUserIDsApi = "[Repository][GetFriendRequestByUserIDs]"
 
-// GetFriendRequestByUserIDs получает заявку в друзья между двумя пользователями
+// GetFriendRequestByUserIDs получает последнюю заявку в друзья от fromUserID к toUserID
+//
+// Старые отклоненные, отозванные и истекшие заявки остаются в истории,
+// активной (ожидает или принята) может быть только последняя.
 func (r *Repository) GetFriendRequestByUserIDs(ctx context.Context, fromUserID models.UserID, toUserID models.UserID) (*models.FriendRequest, error) {
 	// Получаем QueryEngine из контекста (может быть транзакция или обычное соединение)
 	conn := r.tm.GetQueryEngine(ctx)
 
-	// Запрос для получения заявки между двумя пользователями
+	// Запрос для получения последней заявки между двумя пользователями
 	getQuery := r.sb.Select(friend_request.FriendRequestsTableColumns...).
 		From(friend_request.FriendRequestsTable).
 		Where(squirrel.Eq{
 			friend_request.FriendRequestsTableColumnFromUserID: string(fromUserID),
 			friend_request.FriendRequestsTableColumnToUserID:   string(toUserID),
-		})
+		}).
+		OrderBy(friend_request.FriendRequestsTableColumnCreatedAt + " DESC").
+		Limit(1)
 
 	// Выполняем запрос
 	var row friend_request.Row
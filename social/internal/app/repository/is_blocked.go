@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sskorolev/balun_microservices/lib/postgres"
+
+	"social/internal/app/models"
+	"social/internal/app/repository/user_block"
+
+	"github.com/Masterminds/squirrel"
+)
+
+const isBlockedApi = "[Repository][IsBlocked]"
+
+// IsBlocked проверяет, заблокировал ли один из пользователей другого
+func (r *Repository) IsBlocked(ctx context.Context, firstUserID models.UserID, secondUserID models.UserID) (bool, error) {
+	existsQuery := r.sb.Select("1").
+		From(user_block.UserBlocksTable).
+		Where(squirrel.Or{
+			squirrel.Eq{
+				user_block.UserBlocksTableColumnBlockerID: string(firstUserID),
+				user_block.UserBlocksTableColumnBlockedID: string(secondUserID),
+			},
+			squirrel.Eq{
+				user_block.UserBlocksTableColumnBlockerID: string(secondUserID),
+				user_block.UserBlocksTableColumnBlockedID: string(firstUserID),
+			},
+		}).
+		Prefix("SELECT EXISTS (").
+		Suffix(")")
+
+	// Получаем QueryEngine из контекста
+	conn := r.tm.GetQueryEngine(ctx)
+
+	var blocked bool
+	if err := conn.Getx(ctx, &blocked, existsQuery); err != nil {
+		return false, fmt.Errorf("%s: %w", isBlockedApi, postgres.ConvertPGError(err))
+	}
+
+	return blocked, nil
+}
@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sskorolev/balun_microservices/lib/postgres"
+
+	"social/internal/app/models"
+	"social/internal/app/repository/friend_request"
+
+	"github.com/Masterminds/squirrel"
+)
+
+const listFriendRequestsApi = "[Repository][ListFriendRequests]"
+
+// ListFriendRequests получает входящие или исходящие заявки пользователя от новых к старым
+// с cursor-based пагинацией по (created_at, id), пустой statuses - заявки в любом статусе
+func (r *Repository) ListFriendRequests(ctx context.Context, userID models.UserID, direction models.FriendRequestDirection, statuses []models.FriendRequestStatus, limit int64, cursor *string) (requests []*models.FriendRequest, nextCursor *string, err error) {
+	userColumn := friend_request.FriendRequestsTableColumnToUserID
+	if direction == models.FriendRequestOutgoing {
+		userColumn = friend_request.FriendRequestsTableColumnFromUserID
+	}
+
+	// Собираем базовый запрос, порядок совпадает с индексами idx_friend_requests_{to,from}_user_id_created_at
+	listQuery := r.sb.Select(friend_request.FriendRequestsTableColumns...).
+		From(friend_request.FriendRequestsTable).
+		Where(squirrel.Eq{userColumn: string(userID)}).
+		OrderBy(friend_request.FriendRequestsTableColumnCreatedAt+" DESC", friend_request.FriendRequestsTableColumnID+" DESC")
+
+	if len(statuses) > 0 {
+		values := make([]int, 0, len(statuses))
+		for _, status := range statuses {
+			values = append(values, int(status))
+		}
+		listQuery = listQuery.Where(squirrel.Eq{friend_request.FriendRequestsTableColumnStatus: values})
+	}
+
+	// Если есть cursor, продолжаем после последней строки предыдущей страницы
+	if cursor != nil && *cursor != "" {
+		position, err := friend_request.DecodeCursor(*cursor)
+		if err != nil {
+			return nil, nil, err
+		}
+		listQuery = listQuery.Where(
+			"("+friend_request.FriendRequestsTableColumnCreatedAt+", "+friend_request.FriendRequestsTableColumnID+") < (?, ?)",
+			position.CreatedAt, position.ID,
+		)
+	}
+
+	// Запрашиваем limit + 1 записей, чтобы понять, есть ли еще данные
+	listQuery = listQuery.Limit(uint64(limit + 1))
+
+	// Получаем QueryEngine из контекста (может быть транзакция или обычное соединение)
+	conn := r.tm.GetQueryEngine(ctx)
+
+	var rows []friend_request.Row
+	if err := conn.Selectx(ctx, &rows, listQuery); err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", listFriendRequestsApi, postgres.ConvertPGError(err))
+	}
+
+	// Определяем, есть ли еще записи, и обрезаем результат до limit
+	hasMore := int64(len(rows)) > limit
+	if hasMore {
+		rows = rows[:limit]
+		next := friend_request.EncodeCursor(&rows[len(rows)-1])
+		nextCursor = &next
+	}
+
+	result := make([]*models.FriendRequest, 0, len(rows))
+	for i := range rows {
+		result = append(result, friend_request.ToModel(&rows[i]))
+	}
+
+	return result, nextCursor, nil
+}
@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sskorolev/balun_microservices/lib/postgres"
+
+	"social/internal/app/models"
+	"social/internal/app/repository/friendship"
+
+	"github.com/Masterminds/squirrel"
+)
+
+const listFriendsApi = "[Repository][ListFriends]"
+
+// ListFriends получает друзей пользователя от новых к старым с cursor-based пагинацией по (created_at, friend_id)
+func (r *Repository) ListFriends(ctx context.Context, userID models.UserID, limit int64, cursor *string) (friends []*models.Friendship, nextCursor *string, err error) {
+	// Собираем базовый запрос, порядок совпадает с индексом idx_friendships_user_id_created_at
+	listQuery := r.sb.Select(friendship.FriendshipsTableColumns...).
+		From(friendship.FriendshipsTable).
+		Where(squirrel.Eq{friendship.FriendshipsTableColumnUserID: string(userID)}).
+		OrderBy(friendship.FriendshipsTableColumnCreatedAt+" DESC", friendship.FriendshipsTableColumnFriendID+" DESC")
+
+	// Если есть cursor, продолжаем после последней строки предыдущей страницы
+	if cursor != nil && *cursor != "" {
+		position, err := friendship.DecodeCursor(*cursor)
+		if err != nil {
+			return nil, nil, err
+		}
+		listQuery = listQuery.Where(
+			"("+friendship.FriendshipsTableColumnCreatedAt+", "+friendship.FriendshipsTableColumnFriendID+") < (?, ?)",
+			position.CreatedAt, position.FriendID,
+		)
+	}
+
+	// Запрашиваем limit + 1 записей, чтобы понять, есть ли еще данные
+	listQuery = listQuery.Limit(uint64(limit + 1))
+
+	// Получаем QueryEngine из контекста (может быть транзакция или обычное соединение)
+	conn := r.tm.GetQueryEngine(ctx)
+
+	var rows []friendship.Row
+	if err := conn.Selectx(ctx, &rows, listQuery); err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", listFriendsApi, postgres.ConvertPGError(err))
+	}
+
+	// Определяем, есть ли еще записи, и обрезаем результат до limit
+	hasMore := int64(len(rows)) > limit
+	if hasMore {
+		rows = rows[:limit]
+		next := friendship.EncodeCursor(&rows[len(rows)-1])
+		nextCursor = &next
+	}
+
+	result := make([]*models.Friendship, 0, len(rows))
+	for i := range rows {
+		result = append(result, friendship.ToModel(&rows[i]))
+	}
+
+	return result, nextCursor, nil
+}
@@ -0,0 +1,185 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/sskorolev/balun_microservices/lib/postgres"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"social/internal/app/models"
+	"social/internal/app/repository/friendship"
+)
+
+// query - запрос, дошедший до базы
+type query struct {
+	sql  string
+	args []any
+}
+
+// fakeQueryEngine запоминает запросы, результат Selectx отдает selectx
+type fakeQueryEngine struct {
+	postgres.QueryEngine
+	queries []query
+	selectx func(dest any, q query) error
+}
+
+func (e *fakeQueryEngine) record(sqlizer postgres.Sqlizer) (query, error) {
+	sql, args, err := sqlizer.ToSql()
+	if err != nil {
+		return query{}, err
+	}
+	q := query{sql: sql, args: args}
+	e.queries = append(e.queries, q)
+	return q, nil
+}
+
+func (e *fakeQueryEngine) Selectx(_ context.Context, dest any, sqlizer postgres.Sqlizer) error {
+	q, err := e.record(sqlizer)
+	if err != nil {
+		return err
+	}
+	if e.selectx == nil {
+		return nil
+	}
+	return e.selectx(dest, q)
+}
+
+func (e *fakeQueryEngine) Execx(_ context.Context, sqlizer postgres.Sqlizer) (pgconn.CommandTag, error) {
+	_, err := e.record(sqlizer)
+	return pgconn.CommandTag{}, err
+}
+
+type fakeTxManager struct {
+	postgres.TransactionManagerAPI
+	engine *fakeQueryEngine
+}
+
+func (m fakeTxManager) GetQueryEngine(context.Context) postgres.QueryEngine {
+	return m.engine
+}
+
+// friendshipsTable выполняет запрос ListFriends над строками в памяти:
+// фильтр по user_id, продолжение после (created_at, friend_id), сортировка по убыванию и LIMIT
+func friendshipsTable(rows []friendship.Row) func(dest any, q query) error {
+	return func(dest any, q query) error {
+		var limit int
+		if _, err := fmt.Sscanf(q.sql[strings.Index(q.sql, "LIMIT "):], "LIMIT %d", &limit); err != nil {
+			return err
+		}
+
+		var result []friendship.Row
+		for _, row := range rows {
+			if row.UserID != q.args[0] {
+				continue
+			}
+			if len(q.args) == 3 {
+				createdAt, friendID := q.args[1].(time.Time), q.args[2].(string)
+				if row.CreatedAt.After(createdAt) || row.CreatedAt.Equal(createdAt) && row.FriendID >= friendID {
+					continue
+				}
+			}
+			result = append(result, row)
+		}
+
+		slices.SortFunc(result, func(a, b friendship.Row) int {
+			if c := b.CreatedAt.Compare(a.CreatedAt); c != 0 {
+				return c
+			}
+			return strings.Compare(b.FriendID, a.FriendID)
+		})
+		*dest.(*[]friendship.Row) = result[:min(limit, len(result))]
+		return nil
+	}
+}
+
+func TestRepository_ListFriends(t *testing.T) {
+	ctx := context.Background()
+	createdAt := time.Date(2026, time.October, 16, 12, 30, 0, 0, time.UTC)
+
+	// Друзья alice: часть с одинаковым created_at, плюс дружбы других пользователей
+	var rows []friendship.Row
+	for i := range 7 {
+		rows = append(rows, friendship.Row{
+			UserID:    "alice",
+			FriendID:  fmt.Sprintf("friend-%d", i),
+			RequestID: fmt.Sprintf("request-%d", i),
+			CreatedAt: createdAt.Add(time.Duration(i/3) * time.Hour),
+		})
+	}
+	rows = append(rows, friendship.Row{UserID: "bob", FriendID: "friend-9", CreatedAt: createdAt})
+
+	t.Run("страницы идут без пропусков, повторов и неполных страниц", func(t *testing.T) {
+		engine := &fakeQueryEngine{selectx: friendshipsTable(rows)}
+		r := NewRepository(fakeTxManager{engine: engine})
+
+		var (
+			pages  [][]models.UserID
+			cursor *string
+		)
+		for {
+			friends, nextCursor, err := r.ListFriends(ctx, "alice", 3, cursor)
+			require.NoError(t, err)
+
+			page := make([]models.UserID, 0, len(friends))
+			for _, f := range friends {
+				assert.Equal(t, models.UserID("alice"), f.UserID)
+				page = append(page, f.FriendID)
+			}
+			pages = append(pages, page)
+
+			if nextCursor == nil {
+				break
+			}
+			cursor = nextCursor
+		}
+
+		// От новых к старым, при равном created_at - по friend_id по убыванию
+		assert.Equal(t, [][]models.UserID{
+			{"friend-6", "friend-5", "friend-4"},
+			{"friend-3", "friend-2", "friend-1"},
+			{"friend-0"},
+		}, pages)
+	})
+
+	t.Run("полная последняя страница без следующего курсора", func(t *testing.T) {
+		engine := &fakeQueryEngine{selectx: friendshipsTable(rows)}
+		r := NewRepository(fakeTxManager{engine: engine})
+
+		friends, nextCursor, err := r.ListFriends(ctx, "alice", 7, nil)
+		require.NoError(t, err)
+		assert.Len(t, friends, 7)
+		assert.Nil(t, nextCursor)
+	})
+
+	t.Run("продолжение после курсора сравнивает пару (created_at, friend_id)", func(t *testing.T) {
+		engine := &fakeQueryEngine{}
+		r := NewRepository(fakeTxManager{engine: engine})
+		cursor := friendship.EncodeCursor(&friendship.Row{FriendID: "friend-2", CreatedAt: createdAt})
+
+		_, _, err := r.ListFriends(ctx, "alice", 3, &cursor)
+		require.NoError(t, err)
+
+		require.Len(t, engine.queries, 1)
+		q := engine.queries[0]
+		assert.Contains(t, q.sql, "(created_at, friend_id) < ($2, $3)")
+		assert.Contains(t, q.sql, "ORDER BY created_at DESC, friend_id DESC")
+		assert.Contains(t, q.sql, "LIMIT 4")
+	})
+
+	t.Run("некорректный курсор не доходит до базы", func(t *testing.T) {
+		engine := &fakeQueryEngine{}
+		r := NewRepository(fakeTxManager{engine: engine})
+		cursor := "garbage"
+
+		_, _, err := r.ListFriends(ctx, "alice", 3, &cursor)
+		require.ErrorIs(t, err, models.ErrInvalidCursor)
+		assert.Empty(t, engine.queries)
+	})
+}
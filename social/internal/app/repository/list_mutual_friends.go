@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sskorolev/balun_microservices/lib/postgres"
+
+	"social/internal/app/models"
+	"social/internal/app/repository/friendship"
+
+	"github.com/Masterminds/squirrel"
+)
+
+const listMutualFriendsApi = "[Repository][ListMutualFriends]"
+
+// ListMutualFriends получает общих друзей двух пользователей по возрастанию friend_id с cursor-based пагинацией
+func (r *Repository) ListMutualFriends(ctx context.Context, firstUserID models.UserID, secondUserID models.UserID, limit int64, cursor *string) (userIDs []models.UserID, nextCursor *string, err error) {
+	firstFriendID := "a." + friendship.FriendshipsTableColumnFriendID
+
+	// Оба прохода идут по первичному ключу (user_id, friend_id)
+	listQuery := r.sb.Select(firstFriendID).
+		From(friendship.FriendshipsTable + " AS a").
+		Join(fmt.Sprintf("%s AS b ON b.%s = %s",
+			friendship.FriendshipsTable, friendship.FriendshipsTableColumnFriendID, firstFriendID)).
+		Where(squirrel.Eq{
+			"a." + friendship.FriendshipsTableColumnUserID: string(firstUserID),
+			"b." + friendship.FriendshipsTableColumnUserID: string(secondUserID),
+		}).
+		OrderBy(firstFriendID)
+
+	// Если есть cursor, продолжаем после последней строки предыдущей страницы
+	if cursor != nil && *cursor != "" {
+		lastFriendID, err := friendship.DecodeMutualCursor(*cursor)
+		if err != nil {
+			return nil, nil, err
+		}
+		listQuery = listQuery.Where(squirrel.Gt{firstFriendID: lastFriendID})
+	}
+
+	// Запрашиваем limit + 1 записей, чтобы понять, есть ли еще данные
+	listQuery = listQuery.Limit(uint64(limit + 1))
+
+	// Получаем QueryEngine из контекста (может быть транзакция или обычное соединение)
+	conn := r.tm.GetQueryEngine(ctx)
+
+	var friendIDs []string
+	if err := conn.Selectx(ctx, &friendIDs, listQuery); err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", listMutualFriendsApi, postgres.ConvertPGError(err))
+	}
+
+	// Определяем, есть ли еще записи, и обрезаем результат до limit
+	hasMore := int64(len(friendIDs)) > limit
+	if hasMore {
+		friendIDs = friendIDs[:limit]
+		next := friendship.EncodeMutualCursor(friendIDs[len(friendIDs)-1])
+		nextCursor = &next
+	}
+
+	result := make([]models.UserID, 0, len(friendIDs))
+	for _, friendID := range friendIDs {
+		result = append(result, models.UserID(friendID))
+	}
+
+	return result, nextCursor, nil
+}
@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sskorolev/balun_microservices/lib/postgres"
+
+	"social/internal/app/models"
+	"social/internal/app/repository/user_block"
+
+	"github.com/Masterminds/squirrel"
+)
+
+const listUserBlocksApi = "[Repository][ListUserBlocks]"
+
+// ListUserBlocks получает блокировки пользователя от новых к старым с cursor-based пагинацией по (created_at, blocked_id)
+func (r *Repository) ListUserBlocks(ctx context.Context, blockerID models.UserID, limit int64, cursor *string) (blocks []*models.UserBlock, nextCursor *string, err error) {
+	// Собираем базовый запрос, порядок совпадает с индексом idx_user_blocks_blocker_id_created_at
+	listQuery := r.sb.Select(user_block.UserBlocksTableColumns...).
+		From(user_block.UserBlocksTable).
+		Where(squirrel.Eq{user_block.UserBlocksTableColumnBlockerID: string(blockerID)}).
+		OrderBy(user_block.UserBlocksTableColumnCreatedAt+" DESC", user_block.UserBlocksTableColumnBlockedID+" DESC")
+
+	// Если есть cursor, продолжаем после последней строки предыдущей страницы
+	if cursor != nil && *cursor != "" {
+		position, err := user_block.DecodeCursor(*cursor)
+		if err != nil {
+			return nil, nil, err
+		}
+		listQuery = listQuery.Where(
+			"("+user_block.UserBlocksTableColumnCreatedAt+", "+user_block.UserBlocksTableColumnBlockedID+") < (?, ?)",
+			position.CreatedAt, position.BlockedID,
+		)
+	}
+
+	// Запрашиваем limit + 1 записей, чтобы понять, есть ли еще данные
+	listQuery = listQuery.Limit(uint64(limit + 1))
+
+	// Получаем QueryEngine из контекста (может быть транзакция или обычное соединение)
+	conn := r.tm.GetQueryEngine(ctx)
+
+	var rows []user_block.Row
+	if err := conn.Selectx(ctx, &rows, listQuery); err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", listUserBlocksApi, postgres.ConvertPGError(err))
+	}
+
+	// Определяем, есть ли еще записи, и обрезаем результат до limit
+	hasMore := int64(len(rows)) > limit
+	if hasMore {
+		rows = rows[:limit]
+		next := user_block.EncodeCursor(&rows[len(rows)-1])
+		nextCursor = &next
+	}
+
+	result := make([]*models.UserBlock, 0, len(rows))
+	for i := range rows {
+		result = append(result, user_block.ToModel(&rows[i]))
+	}
+
+	return result, nextCursor, nil
+}
@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sskorolev/balun_microservices/lib/postgres"
+
+	"social/internal/app/models"
+	"social/internal/app/repository/friendship"
+)
+
+const saveFriendshipApi = "[Repository][SaveFriendship]"
+
+// SaveFriendship сохраняет дружбу по принятой заявке, по строке на каждое направление
+func (r *Repository) SaveFriendship(ctx context.Context, req *models.FriendRequest, createdAt time.Time) error {
+	insertQuery := r.sb.Insert(friendship.FriendshipsTable).
+		Columns(friendship.FriendshipsTableColumns...).
+		Values(string(req.FromUserID), string(req.ToUserID), string(req.ID), createdAt).
+		Values(string(req.ToUserID), string(req.FromUserID), string(req.ID), createdAt).
+		Suffix("ON CONFLICT (" + friendship.FriendshipsTableColumnUserID + ", " +
+			friendship.FriendshipsTableColumnFriendID + ") DO NOTHING")
+
+	// Получаем QueryEngine из контекста транзакции
+	conn := r.tm.GetQueryEngine(ctx)
+
+	if _, err := conn.Execx(ctx, insertQuery); err != nil {
+		return fmt.Errorf("%s: %w", saveFriendshipApi, postgres.ConvertPGError(err))
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"social/internal/app/models"
+)
+
+func TestRepository_SaveFriendship(t *testing.T) {
+	ctx := context.Background()
+	createdAt := time.Date(2026, time.October, 16, 12, 30, 0, 0, time.UTC)
+	engine := &fakeQueryEngine{}
+	r := NewRepository(fakeTxManager{engine: engine})
+
+	err := r.SaveFriendship(ctx, &models.FriendRequest{ID: "request-1", FromUserID: "alice", ToUserID: "bob"}, createdAt)
+	require.NoError(t, err)
+
+	// Одна вставка со строкой на каждое направление
+	require.Len(t, engine.queries, 1)
+	q := engine.queries[0]
+	assert.Contains(t, q.sql, "INSERT INTO friendships (user_id,friend_id,request_id,created_at) VALUES ($1,$2,$3,$4),($5,$6,$7,$8)")
+	assert.Contains(t, q.sql, "ON CONFLICT (user_id, friend_id) DO NOTHING")
+	assert.Equal(t, []any{
+		"alice", "bob", "request-1", createdAt,
+		"bob", "alice", "request-1", createdAt,
+	}, q.args)
+}
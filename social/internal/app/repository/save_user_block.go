@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sskorolev/balun_microservices/lib/postgres"
+
+	"social/internal/app/models"
+	"social/internal/app/repository/user_block"
+)
+
+const saveUserBlockApi = "[Repository][SaveUserBlock]"
+
+// SaveUserBlock сохраняет блокировку, created = false, если она уже была
+func (r *Repository) SaveUserBlock(ctx context.Context, block *models.UserBlock) (created bool, err error) {
+	insertQuery := r.sb.Insert(user_block.UserBlocksTable).
+		Columns(user_block.UserBlocksTableColumns...).
+		Values(string(block.BlockerID), string(block.BlockedID), block.CreatedAt).
+		Suffix("ON CONFLICT (" + user_block.UserBlocksTableColumnBlockerID + ", " +
+			user_block.UserBlocksTableColumnBlockedID + ") DO NOTHING")
+
+	// Получаем QueryEngine из контекста транзакции
+	conn := r.tm.GetQueryEngine(ctx)
+
+	tag, err := conn.Execx(ctx, insertQuery)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", saveUserBlockApi, postgres.ConvertPGError(err))
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"slices"
 	"sort"
 	"sync"
 	"time"
@@ -65,32 +66,17 @@ func (r *InMemorySocialRepository) GetFriendRequest(ctx context.Context, request
 	return req, nil
 }
 
-func (r *InMemorySocialRepository) GetFriendRequestsByToUserID(ctx context.Context, toUserID models.UserID, limit *int64, cursor *string) ([]*models.FriendRequest, *string, error) {
+func (r *InMemorySocialRepository) ListFriendRequests(ctx context.Context, userID models.UserID, direction models.FriendRequestDirection, statuses []models.FriendRequestStatus, limit int64, cursor *string) ([]*models.FriendRequest, *string, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
 	var requests []*models.FriendRequest
 	for _, req := range r.friendRequests {
-		if req.ToUserID == toUserID {
-			requests = append(requests, req)
+		owner := req.ToUserID
+		if direction == models.FriendRequestOutgoing {
+			owner = req.FromUserID
 		}
-	}
-
-	// Сортировка по ID
-	sort.Slice(requests, func(i, j int) bool {
-		return requests[i].ID < requests[j].ID
-	})
-
-	return r.applyPagination(requests, limit, cursor)
-}
-
-func (r *InMemorySocialRepository) GetFriendRequestsByFromUserID(ctx context.Context, fromUserID models.UserID, limit *int64, cursor *string) ([]*models.FriendRequest, *string, error) {
-	r.mu.RLock()
-	defer r.mu.RUnlock()
-
-	var requests []*models.FriendRequest
-	for _, req := range r.friendRequests {
-		if req.FromUserID == fromUserID {
+		if owner == userID && (len(statuses) == 0 || slices.Contains(statuses, req.Status)) {
 			requests = append(requests, req)
 		}
 	}
@@ -100,7 +86,7 @@ func (r *InMemorySocialRepository) GetFriendRequestsByFromUserID(ctx context.Con
 		return requests[i].ID < requests[j].ID
 	})
 
-	return r.applyPagination(requests, limit, cursor)
+	return r.applyPagination(requests, &limit, cursor)
 }
 
 func (r *InMemorySocialRepository) GetFriendRequestByUserIDs(ctx context.Context, fromUserID models.UserID, toUserID models.UserID) (*models.FriendRequest, error) {
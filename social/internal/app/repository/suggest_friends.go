@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sskorolev/balun_microservices/lib/postgres"
+
+	"social/internal/app/models"
+	"social/internal/app/repository/friendship"
+
+	"github.com/Masterminds/squirrel"
+)
+
+const suggestFriendsApi = "[Repository][SuggestFriends]"
+
+// suggestFriendsQuery - друзья друзей $1 по убыванию числа общих друзей
+//
+// Из кандидатов исключаются сам пользователь, его друзья, блокировки в любую сторону
+// и ожидающие заявки в любую сторону. Все проверки идут по первичным ключам friendships
+// и user_blocks и по частичному индексу idx_friend_requests_users_active.
+const suggestFriendsQuery = `
+SELECT fof.friend_id AS user_id,
+       count(*)      AS mutual_friends_count
+FROM friendships f
+JOIN friendships fof ON fof.user_id = f.friend_id
+WHERE f.user_id = $1
+  AND fof.friend_id <> $1
+  AND NOT EXISTS (
+      SELECT 1 FROM friendships own
+      WHERE own.user_id = $1 AND own.friend_id = fof.friend_id
+  )
+  AND NOT EXISTS (
+      SELECT 1 FROM user_blocks b
+      WHERE (b.blocker_id = $1 AND b.blocked_id = fof.friend_id)
+         OR (b.blocker_id = fof.friend_id AND b.blocked_id = $1)
+  )
+  AND NOT EXISTS (
+      SELECT 1 FROM friend_requests fr
+      WHERE fr.status = $2
+        AND ((fr.from_user_id = $1 AND fr.to_user_id = fof.friend_id)
+          OR (fr.from_user_id = fof.friend_id AND fr.to_user_id = $1))
+  )
+GROUP BY fof.friend_id
+ORDER BY mutual_friends_count DESC, fof.friend_id
+LIMIT $3`
+
+// SuggestFriends получает возможных друзей пользователя: друзей его друзей, сначала с большим числом общих друзей
+func (r *Repository) SuggestFriends(ctx context.Context, userID models.UserID, limit int64) ([]*models.FriendSuggestion, error) {
+	query := squirrel.Expr(suggestFriendsQuery, string(userID), int(models.FriendRequestPending), limit)
+
+	// Получаем QueryEngine из контекста (может быть транзакция или обычное соединение)
+	conn := r.tm.GetQueryEngine(ctx)
+
+	var rows []friendship.SuggestionRow
+	if err := conn.Selectx(ctx, &rows, query); err != nil {
+		return nil, fmt.Errorf("%s: %w", suggestFriendsApi, postgres.ConvertPGError(err))
+	}
+
+	result := make([]*models.FriendSuggestion, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, &models.FriendSuggestion{
+			UserID:             models.UserID(row.UserID),
+			MutualFriendsCount: row.MutualFriendsCount,
+		})
+	}
+
+	return result, nil
+}
@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"social/internal/app/models"
+	"social/internal/app/repository/friendship"
+)
+
+func TestRepository_SuggestFriends(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("запрос исключает лишних кандидатов и ранжирует по общим друзьям", func(t *testing.T) {
+		engine := &fakeQueryEngine{}
+		r := NewRepository(fakeTxManager{engine: engine})
+
+		_, err := r.SuggestFriends(ctx, "alice", 20)
+		require.NoError(t, err)
+
+		require.Len(t, engine.queries, 1)
+		q := engine.queries[0]
+		assert.Equal(t, []any{"alice", int(models.FriendRequestPending), int64(20)}, q.args)
+
+		// Пробелы не важны для правил, сравниваем запрос в одну строку
+		sql := strings.Join(strings.Fields(q.sql), " ")
+		for rule, fragment := range map[string]string{
+			"кандидаты - друзья друзей": "FROM friendships f JOIN friendships fof ON fof.user_id = f.friend_id WHERE f.user_id = $1",
+			"без самого пользователя":   "fof.friend_id <> $1",
+			"без друзей": "NOT EXISTS ( SELECT 1 FROM friendships own " +
+				"WHERE own.user_id = $1 AND own.friend_id = fof.friend_id )",
+			"без блокировок в обе стороны": "NOT EXISTS ( SELECT 1 FROM user_blocks b " +
+				"WHERE (b.blocker_id = $1 AND b.blocked_id = fof.friend_id) " +
+				"OR (b.blocker_id = fof.friend_id AND b.blocked_id = $1) )",
+			"без ожидающих заявок в обе стороны": "NOT EXISTS ( SELECT 1 FROM friend_requests fr WHERE fr.status = $2 " +
+				"AND ((fr.from_user_id = $1 AND fr.to_user_id = fof.friend_id) " +
+				"OR (fr.from_user_id = fof.friend_id AND fr.to_user_id = $1)) )",
+			"число общих друзей":                 "count(*) AS mutual_friends_count",
+			"по числу общих друзей, затем по id": "GROUP BY fof.friend_id ORDER BY mutual_friends_count DESC, fof.friend_id LIMIT $3",
+		} {
+			assert.Contains(t, sql, fragment, rule)
+		}
+	})
+
+	t.Run("порядок строк сохраняется", func(t *testing.T) {
+		engine := &fakeQueryEngine{selectx: func(dest any, _ query) error {
+			*dest.(*[]friendship.SuggestionRow) = []friendship.SuggestionRow{
+				{UserID: "dave", MutualFriendsCount: 3},
+				{UserID: "bob", MutualFriendsCount: 1},
+				{UserID: "carol", MutualFriendsCount: 1},
+			}
+			return nil
+		}}
+		r := NewRepository(fakeTxManager{engine: engine})
+
+		suggestions, err := r.SuggestFriends(ctx, "alice", 20)
+		require.NoError(t, err)
+		assert.Equal(t, []*models.FriendSuggestion{
+			{UserID: "dave", MutualFriendsCount: 3},
+			{UserID: "bob", MutualFriendsCount: 1},
+			{UserID: "carol", MutualFriendsCount: 1},
+		}, suggestions)
+	})
+}
@@ -17,7 +17,10 @@ import (
 
 const updateFriendRequestApi = "[Repository][UpdateFriendRequest]"
 
-// UpdateFriendRequest обновляет статус заявки в друзья
+// UpdateFriendRequest переводит ожидающую заявку в друзья в новый статус
+//
+// Возвращает nil, если заявки нет или она уже не ожидает ответа: так принятие,
+// отклонение, отзыв и истечение одной заявки не перезаписывают друг друга.
 func (r *Repository) UpdateFriendRequest(ctx context.Context, requestID models.FriendRequestID, status models.FriendRequestStatus) (*models.FriendRequest, error) {
 	now := time.Now()
 
@@ -25,7 +28,10 @@ func (r *Repository) UpdateFriendRequest(ctx context.Context, requestID models.F
 	updateQuery := r.sb.Update(friend_request.FriendRequestsTable).
 		Set(friend_request.FriendRequestsTableColumnStatus, int(status)).
 		Set(friend_request.FriendRequestsTableColumnUpdatedAt, now).
-		Where(squirrel.Eq{friend_request.FriendRequestsTableColumnID: string(requestID)}).
+		Where(squirrel.Eq{
+			friend_request.FriendRequestsTableColumnID:     string(requestID),
+			friend_request.FriendRequestsTableColumnStatus: int(models.FriendRequestPending),
+		}).
 		Suffix("RETURNING " + friend_request.FriendRequestsTableColumnID + ", " +
 			friend_request.FriendRequestsTableColumnFromUserID + ", " +
 			friend_request.FriendRequestsTableColumnToUserID + ", " +
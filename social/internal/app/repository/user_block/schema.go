@@ -0,0 +1,15 @@
+package user_block
+
+const UserBlocksTable = "user_blocks"
+
+const (
+	UserBlocksTableColumnBlockerID = "blocker_id"
+	UserBlocksTableColumnBlockedID = "blocked_id"
+	UserBlocksTableColumnCreatedAt = "created_at"
+)
+
+var UserBlocksTableColumns = []string{
+	UserBlocksTableColumnBlockerID,
+	UserBlocksTableColumnBlockedID,
+	UserBlocksTableColumnCreatedAt,
+}
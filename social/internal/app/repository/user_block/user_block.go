@@ -0,0 +1,61 @@
+package user_block
+
+import (
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	"social/internal/app/models"
+)
+
+// Row — «плоская» проекция строки таблицы user_blocks
+type Row struct {
+	BlockerID string    `db:"blocker_id"`
+	BlockedID string    `db:"blocked_id"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// ToModel конвертирует Row в доменную модель models.UserBlock
+func ToModel(r *Row) *models.UserBlock {
+	if r == nil {
+		return nil
+	}
+	return &models.UserBlock{
+		BlockerID: models.UserID(r.BlockerID),
+		BlockedID: models.UserID(r.BlockedID),
+		CreatedAt: r.CreatedAt,
+	}
+}
+
+// Cursor - позиция в списке блокировок, отсортированном по (created_at, blocked_id) по убыванию
+type Cursor struct {
+	CreatedAt time.Time
+	BlockedID string
+}
+
+// EncodeCursor кодирует позицию последней строки страницы в непрозрачную строку
+func EncodeCursor(r *Row) string {
+	raw := strconv.FormatInt(r.CreatedAt.UnixMicro(), 10) + ":" + r.BlockedID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeCursor разбирает строку, полученную от EncodeCursor
+func DecodeCursor(s string) (*Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, models.ErrInvalidCursor
+	}
+
+	micros, blockedID, ok := strings.Cut(string(raw), ":")
+	if !ok || blockedID == "" {
+		return nil, models.ErrInvalidCursor
+	}
+
+	createdAt, err := strconv.ParseInt(micros, 10, 64)
+	if err != nil {
+		return nil, models.ErrInvalidCursor
+	}
+
+	return &Cursor{CreatedAt: time.UnixMicro(createdAt), BlockedID: blockedID}, nil
+}
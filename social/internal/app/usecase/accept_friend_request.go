@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"social/internal/app/usecase/dto"
 
@@ -25,6 +26,9 @@ func (s *SocialService) AcceptFriendRequest(ctx context.Context, req dto.ChangeF
 	if req.UserID != friendRequest.ToUserID {
 		return nil, models.ErrPermissionDenied
 	}
+	if friendRequest.Status != models.FriendRequestPending {
+		return nil, models.ErrNotPending
+	}
 
 	updatedFriendRequest := &models.FriendRequest{}
 	err = s.transactionalManager.RunReadCommitted(ctx,
@@ -35,9 +39,24 @@ func (s *SocialService) AcceptFriendRequest(ctx context.Context, req dto.ChangeF
 				return fmt.Errorf("%s: socialRepo UpdateFriendRequest error: %w", apiAcceptFriendRequest, err)
 			}
 
-			err = s.outboxRepository.SaveFriendRequestUpdatedID(txCtx, updatedFriendRequest.ID, models.FriendRequestAccepted)
+			// Заявку успели отозвать или она истекла
+			if updatedFriendRequest == nil {
+				return models.ErrNotPending
+			}
+
+			// Дружба пишется в той же транзакции, что и принятие заявки
+			friendsSince := time.Now()
+			if updatedFriendRequest.UpdatedAt != nil {
+				friendsSince = *updatedFriendRequest.UpdatedAt
+			}
+			err = s.socialRepo.SaveFriendship(txCtx, updatedFriendRequest, friendsSince)
+			if err != nil {
+				return fmt.Errorf("%s: socialRepo SaveFriendship error: %w", apiAcceptFriendRequest, err)
+			}
+
+			err = s.outboxRepository.SaveFriendRequestStatusUpdated(txCtx, updatedFriendRequest)
 			if err != nil {
-				return fmt.Errorf("%s: outboxRepository SaveFriendRequestUpdatedID error: %w", apiSendFriendRequest, err)
+				return fmt.Errorf("%s: outboxRepository SaveFriendRequestStatusUpdated error: %w", apiSendFriendRequest, err)
 			}
 
 			return nil
@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"social/internal/app/models"
+	"social/internal/app/usecase/dto"
+)
+
+const (
+	apiBlockUser = "[SocialService][BlockUser]"
+)
+
+func (s *SocialService) BlockUser(ctx context.Context, req dto.UserBlockDto) error {
+	if req.BlockerID == req.BlockedID {
+		return fmt.Errorf("%s: cannot block yourself: %w", apiBlockUser, models.ErrInvalidArgument)
+	}
+
+	blockedExists, err := s.usersService.CheckUserExists(ctx, req.BlockedID)
+	if err != nil {
+		return fmt.Errorf("%s: userService CheckUserExist error: %w", apiBlockUser, err)
+	}
+	if !blockedExists {
+		return models.ErrNotFound
+	}
+
+	block := &models.UserBlock{
+		BlockerID: req.BlockerID,
+		BlockedID: req.BlockedID,
+		CreatedAt: time.Now(),
+	}
+
+	return s.transactionalManager.RunReadCommitted(ctx, func(txCtx context.Context) error {
+		created, err := s.socialRepo.SaveUserBlock(txCtx, block)
+		if err != nil {
+			return fmt.Errorf("%s: socialRepo SaveUserBlock error: %w", apiBlockUser, err)
+		}
+		if !created {
+			// Пользователь уже заблокирован
+			return nil
+		}
+
+		// Заявки в обе стороны, в том числе принятые, больше не нужны
+		for _, pair := range [][2]models.UserID{
+			{req.BlockerID, req.BlockedID},
+			{req.BlockedID, req.BlockerID},
+		} {
+			friendRequest, err := s.socialRepo.GetFriendRequestByUserIDs(txCtx, pair[0], pair[1])
+			if err != nil {
+				return fmt.Errorf("%s: socialRepo GetFriendRequestByUserIDs error: %w", apiBlockUser, err)
+			}
+			if friendRequest == nil {
+				continue
+			}
+			if err := s.socialRepo.DeleteFriendRequest(txCtx, friendRequest.ID); err != nil {
+				return fmt.Errorf("%s: socialRepo DeleteFriendRequest error: %w", apiBlockUser, err)
+			}
+		}
+
+		if err := s.socialRepo.DeleteFriendship(txCtx, req.BlockerID, req.BlockedID); err != nil {
+			return fmt.Errorf("%s: socialRepo DeleteFriendship error: %w", apiBlockUser, err)
+		}
+
+		if err := s.outboxRepository.SaveUserBlocked(txCtx, block); err != nil {
+			return fmt.Errorf("%s: outboxRepository SaveUserBlocked error: %w", apiBlockUser, err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"social/internal/app/usecase/dto"
+
+	"social/internal/app/models"
+)
+
+const (
+	apiCancelFriendRequest = "[SocialService][CancelFriendRequest]"
+)
+
+func (s *SocialService) CancelFriendRequest(ctx context.Context, req dto.ChangeFriendRequestDto) (*models.FriendRequest, error) {
+	friendRequest, err := s.socialRepo.GetFriendRequest(ctx, req.RequestID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: socialRepo GetFriendRequest error: %w", apiCancelFriendRequest, err)
+	}
+	if friendRequest == nil {
+		return nil, models.ErrNotFound
+	}
+
+	// Отозвать заявку может только ее отправитель
+	if req.UserID != friendRequest.FromUserID {
+		return nil, models.ErrPermissionDenied
+	}
+	if friendRequest.Status != models.FriendRequestPending {
+		return nil, models.ErrNotPending
+	}
+
+	var cancelledFriendRequest *models.FriendRequest
+	err = s.transactionalManager.RunReadCommitted(ctx, func(txCtx context.Context) error {
+		cancelledFriendRequest, err = s.socialRepo.UpdateFriendRequest(txCtx, req.RequestID, models.FriendRequestCancelled)
+		if err != nil {
+			return fmt.Errorf("%s: socialRepo UpdateFriendRequest error: %w", apiCancelFriendRequest, err)
+		}
+
+		// Заявку успели принять, отклонить или она истекла
+		if cancelledFriendRequest == nil {
+			return models.ErrNotPending
+		}
+
+		err = s.outboxRepository.SaveFriendRequestStatusUpdated(txCtx, cancelledFriendRequest)
+		if err != nil {
+			return fmt.Errorf("%s: outboxRepository SaveFriendRequestStatusUpdated error: %w", apiCancelFriendRequest, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return cancelledFriendRequest, nil
+}
@@ -0,0 +1,21 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"social/internal/app/models"
+)
+
+const (
+	apiCheckBlocked = "[SocialService][CheckBlocked]"
+)
+
+func (s *SocialService) CheckBlocked(ctx context.Context, firstUserID models.UserID, secondUserID models.UserID) (bool, error) {
+	blocked, err := s.socialRepo.IsBlocked(ctx, firstUserID, secondUserID)
+	if err != nil {
+		return false, fmt.Errorf("%s: socialRepo IsBlocked error: %w", apiCheckBlocked, err)
+	}
+
+	return blocked, nil
+}
@@ -25,6 +25,9 @@ func (s *SocialService) DeclineFriendRequest(ctx context.Context, req dto.Change
 	if req.UserID != friendRequest.ToUserID {
 		return nil, models.ErrPermissionDenied
 	}
+	if friendRequest.Status != models.FriendRequestPending {
+		return nil, models.ErrNotPending
+	}
 
 	updatedFriendRequest := &models.FriendRequest{}
 	err = s.transactionalManager.RunReadCommitted(ctx,
@@ -35,9 +38,14 @@ func (s *SocialService) DeclineFriendRequest(ctx context.Context, req dto.Change
 				return fmt.Errorf("%s: socialRepo UpdateFriendRequest error: %w", apiAcceptFriendRequest, err)
 			}
 
-			err = s.outboxRepository.SaveFriendRequestUpdatedID(txCtx, updatedFriendRequest.ID, models.FriendRequestDeclined)
+			// Заявку успели отозвать или она истекла
+			if updatedFriendRequest == nil {
+				return models.ErrNotPending
+			}
+
+			err = s.outboxRepository.SaveFriendRequestStatusUpdated(txCtx, updatedFriendRequest)
 			if err != nil {
-				return fmt.Errorf("%s: outboxRepository SaveFriendRequestUpdatedID error: %w", apiSendFriendRequest, err)
+				return fmt.Errorf("%s: outboxRepository SaveFriendRequestStatusUpdated error: %w", apiSendFriendRequest, err)
 			}
 
 			return nil
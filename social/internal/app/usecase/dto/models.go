@@ -12,6 +12,25 @@ type ChangeFriendRequestDto struct {
 	RequestID models.FriendRequestID
 }
 
+type ListFriendRequestsDto struct {
+	UserID    models.UserID
+	Direction models.FriendRequestDirection
+	Statuses  []models.FriendRequestStatus // пустой - заявки в любом статусе
+	Limit     int64
+	Cursor    *string
+}
+
+type ListOutgoingFriendRequestsDto struct {
+	UserID models.UserID
+	Limit  int64
+	Cursor *string
+}
+
+type ListFriendRequestsResponse struct {
+	Requests   []*models.FriendRequest
+	NextCursor *string
+}
+
 type ListFriendsDto struct {
 	UserID models.UserID
 	Limit  int64
@@ -19,6 +38,39 @@ type ListFriendsDto struct {
 }
 
 type ListFriendsResponse struct {
-	Friends    []*models.FriendRequest
+	Friends    []*models.Friendship
+	NextCursor *string
+}
+
+type UserBlockDto struct {
+	BlockerID models.UserID
+	BlockedID models.UserID
+}
+
+type ListMutualFriendsDto struct {
+	FirstUserID  models.UserID
+	SecondUserID models.UserID
+	Limit        int64
+	Cursor       *string
+}
+
+type ListMutualFriendsResponse struct {
+	UserIDs    []models.UserID
+	NextCursor *string
+}
+
+type SuggestFriendsDto struct {
+	UserID models.UserID
+	Limit  int64
+}
+
+type ListBlockedUsersDto struct {
+	UserID models.UserID
+	Limit  int64
+	Cursor *string
+}
+
+type ListBlockedUsersResponse struct {
+	Blocks     []*models.UserBlock
 	NextCursor *string
 }
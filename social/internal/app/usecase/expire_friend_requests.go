@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	apiExpireFriendRequests = "[SocialService][ExpireFriendRequests]"
+)
+
+func (s *SocialService) ExpireFriendRequests(ctx context.Context) (int, error) {
+	createdBefore := s.now().Add(-s.pendingTTL)
+
+	// Истекаем пачками, каждая пачка со своими событиями в отдельной транзакции
+	expired := 0
+	for {
+		batch := 0
+		err := s.transactionalManager.RunReadCommitted(ctx, func(txCtx context.Context) error {
+			friendRequests, err := s.socialRepo.ExpireFriendRequests(txCtx, createdBefore, s.expireBatchSize)
+			if err != nil {
+				return fmt.Errorf("%s: socialRepo ExpireFriendRequests error: %w", apiExpireFriendRequests, err)
+			}
+
+			for _, friendRequest := range friendRequests {
+				err = s.outboxRepository.SaveFriendRequestStatusUpdated(txCtx, friendRequest)
+				if err != nil {
+					return fmt.Errorf("%s: outboxRepository SaveFriendRequestStatusUpdated error: %w", apiExpireFriendRequests, err)
+				}
+			}
+
+			batch = len(friendRequests)
+			return nil
+		})
+		if err != nil {
+			return expired, err
+		}
+
+		expired += batch
+		if batch < s.expireBatchSize {
+			return expired, nil
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"social/internal/app/usecase/dto"
+)
+
+const (
+	apiListBlockedUsers = "[SocialService][ListBlockedUsers]"
+
+	// defaultListBlockedUsersLimit, maxListBlockedUsersLimit - размер страницы ListBlockedUsers
+	defaultListBlockedUsersLimit = int64(50)
+	maxListBlockedUsersLimit     = int64(100)
+)
+
+func (s *SocialService) ListBlockedUsers(ctx context.Context, req dto.ListBlockedUsersDto) (*dto.ListBlockedUsersResponse, error) {
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultListBlockedUsersLimit
+	}
+	limit = min(limit, maxListBlockedUsersLimit)
+
+	blocks, nextCursor, err := s.socialRepo.ListUserBlocks(ctx, req.UserID, limit, req.Cursor)
+	if err != nil {
+		return nil, fmt.Errorf("%s: socialRepo ListUserBlocks error: %w", apiListBlockedUsers, err)
+	}
+
+	return &dto.ListBlockedUsersResponse{
+		Blocks:     blocks,
+		NextCursor: nextCursor,
+	}, nil
+}
@@ -4,26 +4,31 @@ import (
 	"context"
 	"fmt"
 
-	"social/internal/app/models"
+	"social/internal/app/usecase/dto"
 )
 
 const (
 	apiListFriendRequests = "[SocialService][ListFriendRequests]"
+
+	// defaultListFriendRequestsLimit, maxListFriendRequestsLimit - размер страницы списков заявок
+	defaultListFriendRequestsLimit = int64(50)
+	maxListFriendRequestsLimit     = int64(100)
 )
 
-func (s *SocialService) ListFriendRequests(ctx context.Context, toUserId models.UserID) ([]*models.FriendRequest, error) {
-	toUserExists, err := s.usersService.CheckUserExists(ctx, toUserId)
-	if err != nil {
-		return nil, fmt.Errorf("%s: userService CheckUserExist error: %w", apiListFriendRequests, err)
-	}
-	if !toUserExists {
-		return nil, models.ErrNotFound
+func (s *SocialService) ListFriendRequests(ctx context.Context, req dto.ListFriendRequestsDto) (*dto.ListFriendRequestsResponse, error) {
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultListFriendRequestsLimit
 	}
+	limit = min(limit, maxListFriendRequestsLimit)
 
-	friendRequests, _, err := s.socialRepo.GetFriendRequestsByToUserID(ctx, toUserId, nil, nil)
+	friendRequests, nextCursor, err := s.socialRepo.ListFriendRequests(ctx, req.UserID, req.Direction, req.Statuses, limit, req.Cursor)
 	if err != nil {
-		return nil, fmt.Errorf("%s: socialRepo GetFriendRequestsByToUserID error: %w", apiListFriendRequests, err)
+		return nil, fmt.Errorf("%s: socialRepo ListFriendRequests error: %w", apiListFriendRequests, err)
 	}
 
-	return friendRequests, nil
+	return &dto.ListFriendRequestsResponse{
+		Requests:   friendRequests,
+		NextCursor: nextCursor,
+	}, nil
 }
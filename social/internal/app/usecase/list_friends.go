@@ -10,6 +10,10 @@ import (
 
 const (
 	apiListFriends = "[SocialService][ListFriends]"
+
+	// defaultListFriendsLimit, maxListFriendsLimit - размер страницы ListFriends
+	defaultListFriendsLimit = int64(50)
+	maxListFriendsLimit     = int64(100)
 )
 
 func (s *SocialService) ListFriends(ctx context.Context, req dto.ListFriendsDto) (*dto.ListFriendsResponse, error) {
@@ -21,36 +25,15 @@ func (s *SocialService) ListFriends(ctx context.Context, req dto.ListFriendsDto)
 		return nil, models.ErrNotFound
 	}
 
-	friends := make([]*models.FriendRequest, 0)
-
-	toUserIDFriendRequests, nextCursor, err := s.socialRepo.GetFriendRequestsByToUserID(ctx, req.UserID, &req.Limit, req.Cursor)
-	if err != nil {
-		return nil, fmt.Errorf("%s: socialRepo GetFriendRequestsByToUserID error: %w", apiListFriends, err)
-	}
-
-	for _, friendRequest := range toUserIDFriendRequests {
-		if friendRequest != nil && friendRequest.Status == models.FriendRequestAccepted {
-			friends = append(friends, friendRequest)
-		}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultListFriendsLimit
 	}
+	limit = min(limit, maxListFriendsLimit)
 
-	if int64(len(friends)) >= req.Limit {
-		return &dto.ListFriendsResponse{
-			Friends:    friends,
-			NextCursor: nextCursor,
-		}, nil
-	}
-
-	newLimit := req.Limit - int64(len(friends))
-	fromUserIDFriendRequests, nextCursor, err := s.socialRepo.GetFriendRequestsByFromUserID(ctx, req.UserID, &newLimit, nextCursor)
+	friends, nextCursor, err := s.socialRepo.ListFriends(ctx, req.UserID, limit, req.Cursor)
 	if err != nil {
-		return nil, fmt.Errorf("%s: socialRepo GetFriendRequestsByFromUserID error: %w", apiListFriends, err)
-	}
-
-	for _, friendRequest := range fromUserIDFriendRequests {
-		if friendRequest != nil && friendRequest.Status == models.FriendRequestAccepted {
-			friends = append(friends, friendRequest)
-		}
+		return nil, fmt.Errorf("%s: socialRepo ListFriends error: %w", apiListFriends, err)
 	}
 
 	return &dto.ListFriendsResponse{
@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"social/internal/app/models"
+	"social/internal/app/usecase/dto"
+)
+
+const (
+	apiListMutualFriends = "[SocialService][ListMutualFriends]"
+)
+
+func (s *SocialService) ListMutualFriends(ctx context.Context, req dto.ListMutualFriendsDto) (*dto.ListMutualFriendsResponse, error) {
+	if req.FirstUserID == "" || req.SecondUserID == "" || req.FirstUserID == req.SecondUserID {
+		return nil, models.ErrInvalidArgument
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultListFriendsLimit
+	}
+	limit = min(limit, maxListFriendsLimit)
+
+	userIDs, nextCursor, err := s.socialRepo.ListMutualFriends(ctx, req.FirstUserID, req.SecondUserID, limit, req.Cursor)
+	if err != nil {
+		return nil, fmt.Errorf("%s: socialRepo ListMutualFriends error: %w", apiListMutualFriends, err)
+	}
+
+	return &dto.ListMutualFriendsResponse{
+		UserIDs:    userIDs,
+		NextCursor: nextCursor,
+	}, nil
+}
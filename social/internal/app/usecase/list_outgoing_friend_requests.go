@@ -0,0 +1,19 @@
+package usecase
+
+import (
+	"context"
+
+	"social/internal/app/models"
+	"social/internal/app/usecase/dto"
+)
+
+func (s *SocialService) ListOutgoingFriendRequests(ctx context.Context, req dto.ListOutgoingFriendRequestsDto) (*dto.ListFriendRequestsResponse, error) {
+	// Отозвать можно только ожидающие заявки, остальные в исходящих не показываем
+	return s.ListFriendRequests(ctx, dto.ListFriendRequestsDto{
+		UserID:    req.UserID,
+		Direction: models.FriendRequestOutgoing,
+		Statuses:  []models.FriendRequestStatus{models.FriendRequestPending},
+		Limit:     req.Limit,
+		Cursor:    req.Cursor,
+	})
+}
@@ -18,12 +18,19 @@ func (s *SocialService) RemoveFriend(ctx context.Context, req dto.FriendRequestD
 		return fmt.Errorf("%s: getAcceptedFriendRequest error: %w", apiRemoveFriend, err)
 	}
 
-	err = s.socialRepo.DeleteFriendRequest(ctx, friendRequest.ID)
-	if err != nil {
-		return fmt.Errorf("%s: socialRepo DeleteFriendRequest error: %w", apiRemoveFriend, err)
-	}
-
-	return nil
+	return s.transactionalManager.RunReadCommitted(ctx, func(txCtx context.Context) error {
+		err := s.socialRepo.DeleteFriendRequest(txCtx, friendRequest.ID)
+		if err != nil {
+			return fmt.Errorf("%s: socialRepo DeleteFriendRequest error: %w", apiRemoveFriend, err)
+		}
+
+		err = s.socialRepo.DeleteFriendship(txCtx, req.FromUserID, req.ToUserID)
+		if err != nil {
+			return fmt.Errorf("%s: socialRepo DeleteFriendship error: %w", apiRemoveFriend, err)
+		}
+
+		return nil
+	})
 }
 
 func (s *SocialService) getAcceptedFriendRequest(ctx context.Context, firstUserID models.UserID, secondUserID models.UserID) (*models.FriendRequest, error) {
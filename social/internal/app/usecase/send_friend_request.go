@@ -21,20 +21,35 @@ func (s *SocialService) SendFriendRequest(ctx context.Context, req dto.FriendReq
 		return nil, models.ErrNotFound
 	}
 
+	// Заявки между пользователями с блокировкой в любую сторону запрещены
+	blocked, err := s.socialRepo.IsBlocked(ctx, req.FromUserID, req.ToUserID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: socialRepo IsBlocked error: %w", apiSendFriendRequest, err)
+	}
+	if blocked {
+		return nil, models.ErrBlocked
+	}
+
+	// Отклоненную заявку можно повторить только после cooldown
 	friendRequest, err := s.socialRepo.GetFriendRequestByUserIDs(ctx, req.FromUserID, req.ToUserID)
 	if err != nil {
 		return nil, fmt.Errorf("%s: socialRepo GetFriendRequestByUserIDs error: %w", apiSendFriendRequest, err)
 	}
 	if friendRequest != nil {
-		return nil, models.ErrAlreadyExists
+		if isActiveFriendRequest(friendRequest) {
+			return nil, models.ErrAlreadyExists
+		}
+		if friendRequest.Status == models.FriendRequestDeclined && s.inDeclineCooldown(friendRequest) {
+			return nil, models.ErrCooldown
+		}
 	}
 
+	// Встречная заявка: отклонившему заявку ждать не нужно
 	friendRequest, err = s.socialRepo.GetFriendRequestByUserIDs(ctx, req.ToUserID, req.FromUserID)
 	if err != nil {
 		return nil, fmt.Errorf("%s: socialRepo GetFriendRequestByUserIDs error: %w", apiSendFriendRequest, err)
 	}
-
-	if friendRequest != nil {
+	if friendRequest != nil && isActiveFriendRequest(friendRequest) {
 		return nil, models.ErrAlreadyExists
 	}
 
@@ -53,9 +68,9 @@ func (s *SocialService) SendFriendRequest(ctx context.Context, req dto.FriendReq
 				return fmt.Errorf("%s: socialRepo SaveFriendRequest error: %w", apiSendFriendRequest, err)
 			}
 
-			err = s.outboxRepository.SaveFriendRequestCreatedID(txCtx, savedFriendRequest.ID)
+			err = s.outboxRepository.SaveFriendRequestCreated(txCtx, savedFriendRequest)
 			if err != nil {
-				return fmt.Errorf("%s: outboxRepository SaveFriendRequestCreatedID error: %w", apiSendFriendRequest, err)
+				return fmt.Errorf("%s: outboxRepository SaveFriendRequestCreated error: %w", apiSendFriendRequest, err)
 			}
 
 			return nil
@@ -67,3 +82,17 @@ func (s *SocialService) SendFriendRequest(ctx context.Context, req dto.FriendReq
 
 	return savedFriendRequest, nil
 }
+
+// isActiveFriendRequest заявка ожидает ответа или уже принята
+func isActiveFriendRequest(req *models.FriendRequest) bool {
+	return req.Status == models.FriendRequestPending || req.Status == models.FriendRequestAccepted
+}
+
+// inDeclineCooldown после отклонения заявки прошло меньше declineCooldown
+func (s *SocialService) inDeclineCooldown(req *models.FriendRequest) bool {
+	declinedAt := req.UpdatedAt
+	if declinedAt == nil {
+		declinedAt = req.CreatedAt
+	}
+	return declinedAt != nil && s.now().Before(declinedAt.Add(s.declineCooldown))
+}
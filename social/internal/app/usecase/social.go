@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	"social/internal/app/models"
 	"social/internal/app/usecase/dto"
@@ -17,17 +18,31 @@ type (
 		SaveFriendRequest(ctx context.Context, req *models.FriendRequest) (*models.FriendRequest, error)
 		UpdateFriendRequest(ctx context.Context, requestID models.FriendRequestID, status models.FriendRequestStatus) (*models.FriendRequest, error)
 		GetFriendRequest(ctx context.Context, requestID models.FriendRequestID) (*models.FriendRequest, error)
-		GetFriendRequestsByToUserID(ctx context.Context, toUserID models.UserID, limit *int64, cursor *string) (friends []*models.FriendRequest, nextCursor *string, err error)
-		GetFriendRequestsByFromUserID(ctx context.Context, fromUserID models.UserID, limit *int64, cursor *string) (friends []*models.FriendRequest, nextCursor *string, err error)
+		ListFriendRequests(ctx context.Context, userID models.UserID, direction models.FriendRequestDirection, statuses []models.FriendRequestStatus, limit int64, cursor *string) (requests []*models.FriendRequest, nextCursor *string, err error)
 		GetFriendRequestByUserIDs(ctx context.Context, fromUserID models.UserID, toUserID models.UserID) (*models.FriendRequest, error)
 		DeleteFriendRequest(ctx context.Context, requestID models.FriendRequestID) error
+		ExpireFriendRequests(ctx context.Context, createdBefore time.Time, limit int) ([]*models.FriendRequest, error)
+		SaveFriendship(ctx context.Context, req *models.FriendRequest, createdAt time.Time) error
+		DeleteFriendship(ctx context.Context, firstUserID models.UserID, secondUserID models.UserID) error
+		ListFriends(ctx context.Context, userID models.UserID, limit int64, cursor *string) (friends []*models.Friendship, nextCursor *string, err error)
+		ListMutualFriends(ctx context.Context, firstUserID models.UserID, secondUserID models.UserID, limit int64, cursor *string) (userIDs []models.UserID, nextCursor *string, err error)
+		SuggestFriends(ctx context.Context, userID models.UserID, limit int64) ([]*models.FriendSuggestion, error)
+		SaveUserBlock(ctx context.Context, block *models.UserBlock) (created bool, err error)
+		DeleteUserBlock(ctx context.Context, blockerID models.UserID, blockedID models.UserID) (deleted bool, err error)
+		IsBlocked(ctx context.Context, firstUserID models.UserID, secondUserID models.UserID) (bool, error)
+		ListUserBlocks(ctx context.Context, blockerID models.UserID, limit int64, cursor *string) (blocks []*models.UserBlock, nextCursor *string, err error)
 	}
 
 	// OutboxRepository - репозиторий outbox
 	OutboxRepository interface {
-		// SaveFriendRequestCreatedID - запись в Outbox сообщения по заказу
-		SaveFriendRequestCreatedID(ctx context.Context, id models.FriendRequestID) error
-		SaveFriendRequestUpdatedID(ctx context.Context, id models.FriendRequestID, status models.FriendRequestStatus) error
+		// SaveFriendRequestCreated - запись в Outbox события о новой заявке в друзья
+		SaveFriendRequestCreated(ctx context.Context, req *models.FriendRequest) error
+		// SaveFriendRequestStatusUpdated - запись в Outbox события о смене статуса заявки
+		SaveFriendRequestStatusUpdated(ctx context.Context, req *models.FriendRequest) error
+		// SaveUserBlocked - запись в Outbox события о блокировке пользователя
+		SaveUserBlocked(ctx context.Context, block *models.UserBlock) error
+		// SaveUserUnblocked - запись в Outbox события о снятии блокировки
+		SaveUserUnblocked(ctx context.Context, block *models.UserBlock) error
 	}
 
 	// TransactionManager
@@ -39,37 +54,106 @@ type (
 type Usecase interface {
 	// SendFriendRequest отправка заявки на друзья
 	SendFriendRequest(ctx context.Context, req dto.FriendRequestDto) (*models.FriendRequest, error)
-	// ListFriendRequests получение списка заявок на друзья
-	ListFriendRequests(ctx context.Context, toUserID models.UserID) ([]*models.FriendRequest, error)
+	// ListFriendRequests получение входящих или исходящих заявок на друзья, от новых к старым
+	ListFriendRequests(ctx context.Context, req dto.ListFriendRequestsDto) (*dto.ListFriendRequestsResponse, error)
 	// AcceptFriendRequest принятие заявки на друзья
 	AcceptFriendRequest(ctx context.Context, req dto.ChangeFriendRequestDto) (*models.FriendRequest, error)
 	// DeclineFriendRequest отказ от заявки на друзья
 	DeclineFriendRequest(ctx context.Context, req dto.ChangeFriendRequestDto) (*models.FriendRequest, error)
+	// CancelFriendRequest отзыв отправленной заявки на друзья
+	CancelFriendRequest(ctx context.Context, req dto.ChangeFriendRequestDto) (*models.FriendRequest, error)
+	// ListOutgoingFriendRequests получение исходящих заявок на друзья, ожидающих ответа
+	ListOutgoingFriendRequests(ctx context.Context, req dto.ListOutgoingFriendRequestsDto) (*dto.ListFriendRequestsResponse, error)
+	// ExpireFriendRequests истечение ожидающих заявок старше срока ожидания, возвращает число истекших
+	ExpireFriendRequests(ctx context.Context) (int, error)
 	// RemoveFriend удаление друга
 	RemoveFriend(ctx context.Context, req dto.FriendRequestDto) error
-	// ListFriends получение списка друзей
+	// ListFriends получение списка друзей, от новых к старым
 	ListFriends(ctx context.Context, req dto.ListFriendsDto) (*dto.ListFriendsResponse, error)
+	// ListMutualFriends получение общих друзей двух пользователей
+	ListMutualFriends(ctx context.Context, req dto.ListMutualFriendsDto) (*dto.ListMutualFriendsResponse, error)
+	// SuggestFriends получение возможных друзей: друзей друзей по числу общих друзей
+	SuggestFriends(ctx context.Context, req dto.SuggestFriendsDto) ([]*models.FriendSuggestion, error)
+	// BlockUser блокировка пользователя, дружба и заявки между пользователями удаляются
+	BlockUser(ctx context.Context, req dto.UserBlockDto) error
+	// UnblockUser снятие блокировки
+	UnblockUser(ctx context.Context, req dto.UserBlockDto) error
+	// ListBlockedUsers получение списка заблокированных пользователей, от новых к старым
+	ListBlockedUsers(ctx context.Context, req dto.ListBlockedUsersDto) (*dto.ListBlockedUsersResponse, error)
+	// CheckBlocked проверка блокировки между пользователями в любую сторону
+	CheckBlocked(ctx context.Context, firstUserID models.UserID, secondUserID models.UserID) (bool, error)
 }
 
+// Значения по умолчанию для жизненного цикла заявок в друзья
+const (
+	DefaultDeclineCooldown = 72 * time.Hour
+	DefaultPendingTTL      = 30 * 24 * time.Hour
+	DefaultExpireBatchSize = 100
+)
+
 type SocialService struct {
 	usersService         UsersService
 	socialRepo           SocialRepository
 	outboxRepository     OutboxRepository
 	transactionalManager TransactionManager
+
+	declineCooldown time.Duration
+	pendingTTL      time.Duration
+	expireBatchSize int
+	now             func() time.Time
 }
 
 var _ Usecase = (*SocialService)(nil)
 
+// Option - опция SocialService
+type Option func(*SocialService)
+
+// WithDeclineCooldown через сколько после отклонения можно отправить заявку тому же пользователю
+func WithDeclineCooldown(d time.Duration) Option {
+	return func(s *SocialService) {
+		if d >= 0 {
+			s.declineCooldown = d
+		}
+	}
+}
+
+// WithPendingTTL через сколько ожидающая заявка истекает
+func WithPendingTTL(d time.Duration) Option {
+	return func(s *SocialService) {
+		if d > 0 {
+			s.pendingTTL = d
+		}
+	}
+}
+
+// WithExpireBatchSize сколько заявок истекает в одной транзакции
+func WithExpireBatchSize(n int) Option {
+	return func(s *SocialService) {
+		if n > 0 {
+			s.expireBatchSize = n
+		}
+	}
+}
+
 func NewUsecase(
 	service UsersService,
 	repo SocialRepository,
 	outboxRepo OutboxRepository,
 	manager TransactionManager,
+	opts ...Option,
 ) *SocialService {
-	return &SocialService{
+	s := &SocialService{
 		usersService:         service,
 		socialRepo:           repo,
 		outboxRepository:     outboxRepo,
 		transactionalManager: manager,
+		declineCooldown:      DefaultDeclineCooldown,
+		pendingTTL:           DefaultPendingTTL,
+		expireBatchSize:      DefaultExpireBatchSize,
+		now:                  time.Now,
+	}
+	for _, opt := range opts {
+		opt(s)
 	}
+	return s
 }
@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"social/internal/app/models"
+	"social/internal/app/usecase/dto"
+)
+
+const (
+	apiSuggestFriends = "[SocialService][SuggestFriends]"
+
+	// defaultSuggestFriendsLimit, maxSuggestFriendsLimit - размер списка SuggestFriends
+	defaultSuggestFriendsLimit = int64(20)
+	maxSuggestFriendsLimit     = int64(50)
+)
+
+// SuggestFriends возможные друзья строятся только по дружбам: у пользователя без друзей список пустой
+func (s *SocialService) SuggestFriends(ctx context.Context, req dto.SuggestFriendsDto) ([]*models.FriendSuggestion, error) {
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultSuggestFriendsLimit
+	}
+	limit = min(limit, maxSuggestFriendsLimit)
+
+	suggestions, err := s.socialRepo.SuggestFriends(ctx, req.UserID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: socialRepo SuggestFriends error: %w", apiSuggestFriends, err)
+	}
+
+	return suggestions, nil
+}
@@ -0,0 +1,37 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"social/internal/app/models"
+	"social/internal/app/usecase/dto"
+)
+
+const (
+	apiUnblockUser = "[SocialService][UnblockUser]"
+)
+
+func (s *SocialService) UnblockUser(ctx context.Context, req dto.UserBlockDto) error {
+	return s.transactionalManager.RunReadCommitted(ctx, func(txCtx context.Context) error {
+		deleted, err := s.socialRepo.DeleteUserBlock(txCtx, req.BlockerID, req.BlockedID)
+		if err != nil {
+			return fmt.Errorf("%s: socialRepo DeleteUserBlock error: %w", apiUnblockUser, err)
+		}
+		if !deleted {
+			return models.ErrNotFound
+		}
+
+		err = s.outboxRepository.SaveUserUnblocked(txCtx, &models.UserBlock{
+			BlockerID: req.BlockerID,
+			BlockedID: req.BlockedID,
+			CreatedAt: time.Now(),
+		})
+		if err != nil {
+			return fmt.Errorf("%s: outboxRepository SaveUserUnblocked error: %w", apiUnblockUser, err)
+		}
+
+		return nil
+	})
+}
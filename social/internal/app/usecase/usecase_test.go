@@ -0,0 +1,835 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"social/internal/app/models"
+	"social/internal/app/usecase/dto"
+)
+
+type stubUsersService struct{}
+
+func (stubUsersService) CheckUserExists(context.Context, models.UserID) (bool, error) {
+	return true, nil
+}
+
+// expireCall - аргументы вызова ExpireFriendRequests
+type expireCall struct {
+	createdBefore time.Time
+	limit         int
+}
+
+type stubSocialRepository struct {
+	SocialRepository
+	log *callLog
+	// requests - последняя заявка между пользователями: [from, to]
+	requests map[[2]models.UserID]*models.FriendRequest
+	saved    []*models.FriendRequest
+	// acceptedAt - время принятия заявки в UpdateFriendRequest
+	acceptedAt time.Time
+
+	// friendships - дружба по принятой заявке: [user, friend] -> с какого момента друзья
+	friendships       map[[2]models.UserID]time.Time
+	saveFriendshipErr error
+	// friends - ответ ListFriends и аргументы последнего вызова
+	friends           []*models.Friendship
+	friendsNextCursor *string
+	listFriendsLimit  int64
+	listFriendsCursor *string
+
+	// suggestions - ответ SuggestFriends и limit последнего вызова
+	suggestions         []*models.FriendSuggestion
+	suggestFriendsUser  models.UserID
+	suggestFriendsLimit int64
+
+	// blocks - блокировки: [blocker, blocked]
+	blocks map[[2]models.UserID]bool
+
+	// pending - заявки, которые истекут, по limit за вызов
+	pending     []*models.FriendRequest
+	expireCalls []expireCall
+	// expireErrOnCall - номер вызова ExpireFriendRequests (с 1), который вернет ошибку
+	expireErrOnCall int
+}
+
+func (r *stubSocialRepository) IsBlocked(_ context.Context, firstUserID, secondUserID models.UserID) (bool, error) {
+	return r.blocks[[2]models.UserID{firstUserID, secondUserID}] || r.blocks[[2]models.UserID{secondUserID, firstUserID}], nil
+}
+
+func (r *stubSocialRepository) SaveUserBlock(ctx context.Context, block *models.UserBlock) (bool, error) {
+	r.log.add(ctx, "SaveUserBlock")
+
+	key := [2]models.UserID{block.BlockerID, block.BlockedID}
+	if r.blocks[key] {
+		return false, nil
+	}
+	if r.blocks == nil {
+		r.blocks = make(map[[2]models.UserID]bool)
+	}
+	r.blocks[key] = true
+	return true, nil
+}
+
+func (r *stubSocialRepository) DeleteUserBlock(ctx context.Context, blockerID, blockedID models.UserID) (bool, error) {
+	r.log.add(ctx, "DeleteUserBlock")
+
+	key := [2]models.UserID{blockerID, blockedID}
+	if !r.blocks[key] {
+		return false, nil
+	}
+	delete(r.blocks, key)
+	return true, nil
+}
+
+func (r *stubSocialRepository) GetFriendRequest(_ context.Context, requestID models.FriendRequestID) (*models.FriendRequest, error) {
+	for _, req := range r.requests {
+		if req.ID == requestID {
+			return req, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *stubSocialRepository) GetFriendRequestByUserIDs(_ context.Context, fromUserID, toUserID models.UserID) (*models.FriendRequest, error) {
+	return r.requests[[2]models.UserID{fromUserID, toUserID}], nil
+}
+
+func (r *stubSocialRepository) SaveFriendRequest(ctx context.Context, req *models.FriendRequest) (*models.FriendRequest, error) {
+	r.log.add(ctx, "SaveFriendRequest")
+	r.saved = append(r.saved, req)
+	return req, nil
+}
+
+// UpdateFriendRequest меняет статус только ожидающей заявки, как и условный UPDATE в postgres
+func (r *stubSocialRepository) UpdateFriendRequest(ctx context.Context, requestID models.FriendRequestID, status models.FriendRequestStatus) (*models.FriendRequest, error) {
+	r.log.add(ctx, "UpdateFriendRequest")
+
+	for _, req := range r.requests {
+		if req.ID != requestID || req.Status != models.FriendRequestPending {
+			continue
+		}
+		updated := *req
+		updated.Status = status
+		updated.UpdatedAt = &r.acceptedAt
+		return &updated, nil
+	}
+	return nil, nil
+}
+
+func (r *stubSocialRepository) DeleteFriendRequest(ctx context.Context, requestID models.FriendRequestID) error {
+	r.log.add(ctx, "DeleteFriendRequest")
+
+	for key, req := range r.requests {
+		if req.ID == requestID {
+			delete(r.requests, key)
+		}
+	}
+	return nil
+}
+
+func (r *stubSocialRepository) SaveFriendship(ctx context.Context, req *models.FriendRequest, createdAt time.Time) error {
+	r.log.add(ctx, "SaveFriendship")
+	if r.saveFriendshipErr != nil {
+		return r.saveFriendshipErr
+	}
+
+	if r.friendships == nil {
+		r.friendships = make(map[[2]models.UserID]time.Time)
+	}
+	r.friendships[[2]models.UserID{req.FromUserID, req.ToUserID}] = createdAt
+	r.friendships[[2]models.UserID{req.ToUserID, req.FromUserID}] = createdAt
+	return nil
+}
+
+func (r *stubSocialRepository) DeleteFriendship(ctx context.Context, firstUserID, secondUserID models.UserID) error {
+	r.log.add(ctx, "DeleteFriendship")
+
+	delete(r.friendships, [2]models.UserID{firstUserID, secondUserID})
+	delete(r.friendships, [2]models.UserID{secondUserID, firstUserID})
+	return nil
+}
+
+func (r *stubSocialRepository) ListFriends(_ context.Context, _ models.UserID, limit int64, cursor *string) ([]*models.Friendship, *string, error) {
+	r.listFriendsLimit = limit
+	r.listFriendsCursor = cursor
+	return r.friends, r.friendsNextCursor, nil
+}
+
+func (r *stubSocialRepository) SuggestFriends(_ context.Context, userID models.UserID, limit int64) ([]*models.FriendSuggestion, error) {
+	r.suggestFriendsUser = userID
+	r.suggestFriendsLimit = limit
+	return r.suggestions, nil
+}
+
+func (r *stubSocialRepository) ExpireFriendRequests(_ context.Context, createdBefore time.Time, limit int) ([]*models.FriendRequest, error) {
+	r.expireCalls = append(r.expireCalls, expireCall{createdBefore: createdBefore, limit: limit})
+	if len(r.expireCalls) == r.expireErrOnCall {
+		return nil, errDatabase
+	}
+
+	batch := r.pending[:min(limit, len(r.pending))]
+	r.pending = r.pending[len(batch):]
+	for _, req := range batch {
+		req.Status = models.FriendRequestExpired
+	}
+	return batch, nil
+}
+
+type stubOutboxRepository struct {
+	OutboxRepository
+	log           *callLog
+	created       []*models.FriendRequest
+	statusUpdated []*models.FriendRequest
+	blocked       []*models.UserBlock
+	unblocked     []*models.UserBlock
+}
+
+func (r *stubOutboxRepository) SaveFriendRequestCreated(ctx context.Context, req *models.FriendRequest) error {
+	r.log.add(ctx, "SaveFriendRequestCreated")
+	r.created = append(r.created, req)
+	return nil
+}
+
+func (r *stubOutboxRepository) SaveFriendRequestStatusUpdated(ctx context.Context, req *models.FriendRequest) error {
+	r.log.add(ctx, "SaveFriendRequestStatusUpdated")
+	r.statusUpdated = append(r.statusUpdated, req)
+	return nil
+}
+
+func (r *stubOutboxRepository) SaveUserBlocked(ctx context.Context, block *models.UserBlock) error {
+	r.log.add(ctx, "SaveUserBlocked")
+	r.blocked = append(r.blocked, block)
+	return nil
+}
+
+func (r *stubOutboxRepository) SaveUserUnblocked(ctx context.Context, block *models.UserBlock) error {
+	r.log.add(ctx, "SaveUserUnblocked")
+	r.unblocked = append(r.unblocked, block)
+	return nil
+}
+
+type txKey struct{}
+
+// stubTxManager помечает контекст транзакции, чтобы проверить, какие записи в нее попали
+type stubTxManager struct{}
+
+func (stubTxManager) RunReadCommitted(ctx context.Context, f func(ctx context.Context) error) error {
+	return f(context.WithValue(ctx, txKey{}, true))
+}
+
+// repoCall - запись в репозиторий и была ли она в транзакции
+type repoCall struct {
+	method string
+	inTx   bool
+}
+
+// callLog - порядок записей в репозитории
+type callLog []repoCall
+
+func (l *callLog) add(ctx context.Context, method string) {
+	if l == nil {
+		return
+	}
+	inTx, _ := ctx.Value(txKey{}).(bool)
+	*l = append(*l, repoCall{method: method, inTx: inTx})
+}
+
+var errDatabase = errors.New("database error")
+
+// newTestService SocialService с фиксированным текущим временем now
+func newTestService(repo *stubSocialRepository, outbox *stubOutboxRepository, now time.Time, opts ...Option) *SocialService {
+	s := NewUsecase(stubUsersService{}, repo, outbox, stubTxManager{}, opts...)
+	s.now = func() time.Time { return now }
+	return s
+}
+
+func TestSocialService_SendFriendRequestDeclineCooldown(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, time.October, 17, 12, 0, 0, 0, time.UTC)
+	ago := func(d time.Duration) *time.Time {
+		at := now.Add(-d)
+		return &at
+	}
+
+	tests := []struct {
+		name          string
+		opts          []Option
+		requests      map[[2]models.UserID]*models.FriendRequest
+		expectedError error
+	}{
+		{
+			name: "отклоненная заявка в пределах cooldown",
+			requests: map[[2]models.UserID]*models.FriendRequest{
+				{"alice", "bob"}: {Status: models.FriendRequestDeclined, CreatedAt: ago(96 * time.Hour), UpdatedAt: ago(time.Hour)},
+			},
+			expectedError: models.ErrCooldown,
+		},
+		{
+			name: "cooldown отсчитывается от отклонения, а не от отправки",
+			requests: map[[2]models.UserID]*models.FriendRequest{
+				{"alice", "bob"}: {Status: models.FriendRequestDeclined, CreatedAt: ago(96 * time.Hour), UpdatedAt: ago(71 * time.Hour)},
+			},
+			expectedError: models.ErrCooldown,
+		},
+		{
+			name: "cooldown истек",
+			requests: map[[2]models.UserID]*models.FriendRequest{
+				{"alice", "bob"}: {Status: models.FriendRequestDeclined, CreatedAt: ago(96 * time.Hour), UpdatedAt: ago(72 * time.Hour)},
+			},
+		},
+		{
+			name: "без времени отклонения cooldown считается от отправки",
+			requests: map[[2]models.UserID]*models.FriendRequest{
+				{"alice", "bob"}: {Status: models.FriendRequestDeclined, CreatedAt: ago(time.Hour)},
+			},
+			expectedError: models.ErrCooldown,
+		},
+		{
+			name: "cooldown задан опцией",
+			opts: []Option{WithDeclineCooldown(time.Hour)},
+			requests: map[[2]models.UserID]*models.FriendRequest{
+				{"alice", "bob"}: {Status: models.FriendRequestDeclined, UpdatedAt: ago(2 * time.Hour)},
+			},
+		},
+		{
+			name: "нулевой cooldown не ограничивает повтор",
+			opts: []Option{WithDeclineCooldown(0)},
+			requests: map[[2]models.UserID]*models.FriendRequest{
+				{"alice", "bob"}: {Status: models.FriendRequestDeclined, UpdatedAt: ago(time.Minute)},
+			},
+		},
+		{
+			name: "отклонившему заявку ждать не нужно",
+			requests: map[[2]models.UserID]*models.FriendRequest{
+				{"bob", "alice"}: {Status: models.FriendRequestDeclined, UpdatedAt: ago(time.Hour)},
+			},
+		},
+		{
+			name: "отозванная и истекшая заявки не ограничивают повтор",
+			requests: map[[2]models.UserID]*models.FriendRequest{
+				{"alice", "bob"}: {Status: models.FriendRequestCancelled, UpdatedAt: ago(time.Minute)},
+				{"bob", "alice"}: {Status: models.FriendRequestExpired, UpdatedAt: ago(time.Minute)},
+			},
+		},
+		{
+			name: "ожидающая заявка",
+			requests: map[[2]models.UserID]*models.FriendRequest{
+				{"alice", "bob"}: {Status: models.FriendRequestPending, CreatedAt: ago(time.Hour)},
+			},
+			expectedError: models.ErrAlreadyExists,
+		},
+		{
+			name: "встречная ожидающая заявка",
+			requests: map[[2]models.UserID]*models.FriendRequest{
+				{"bob", "alice"}: {Status: models.FriendRequestPending, CreatedAt: ago(time.Hour)},
+			},
+			expectedError: models.ErrAlreadyExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &stubSocialRepository{requests: tt.requests}
+			outbox := &stubOutboxRepository{}
+			s := newTestService(repo, outbox, now, tt.opts...)
+
+			friendRequest, err := s.SendFriendRequest(ctx, dto.FriendRequestDto{FromUserID: "alice", ToUserID: "bob"})
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, friendRequest)
+				assert.Empty(t, repo.saved)
+				assert.Empty(t, outbox.created)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, models.FriendRequestPending, friendRequest.Status)
+			assert.Len(t, outbox.created, 1)
+		})
+	}
+}
+
+func TestSocialService_ExpireFriendRequests(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, time.October, 17, 12, 0, 0, 0, time.UTC)
+	pendingTTL := 24 * time.Hour
+
+	pending := func(n int) []*models.FriendRequest {
+		requests := make([]*models.FriendRequest, 0, n)
+		for range n {
+			requests = append(requests, &models.FriendRequest{Status: models.FriendRequestPending})
+		}
+		return requests
+	}
+
+	tests := []struct {
+		name            string
+		pending         int
+		expireErrOnCall int
+		wantExpired     int
+		wantCalls       int
+		wantErr         bool
+	}{
+		{name: "нет истекших заявок", wantCalls: 1},
+		{name: "неполная пачка завершает истечение", pending: 5, wantExpired: 5, wantCalls: 3},
+		{name: "после полной пачки запрашивается следующая", pending: 4, wantExpired: 4, wantCalls: 3},
+		{
+			name:            "ошибка пачки возвращает уже истекшие",
+			pending:         5,
+			expireErrOnCall: 2,
+			wantExpired:     2,
+			wantCalls:       2,
+			wantErr:         true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &stubSocialRepository{pending: pending(tt.pending), expireErrOnCall: tt.expireErrOnCall}
+			outbox := &stubOutboxRepository{}
+			s := newTestService(repo, outbox, now, WithPendingTTL(pendingTTL), WithExpireBatchSize(2))
+
+			expired, err := s.ExpireFriendRequests(ctx)
+
+			if tt.wantErr {
+				require.ErrorIs(t, err, errDatabase)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantExpired, expired)
+			require.Len(t, repo.expireCalls, tt.wantCalls)
+			for _, call := range repo.expireCalls {
+				assert.Equal(t, now.Add(-pendingTTL), call.createdBefore)
+				assert.Equal(t, 2, call.limit)
+			}
+
+			// На каждую истекшую заявку - событие смены статуса
+			require.Len(t, outbox.statusUpdated, tt.wantExpired)
+			for _, req := range outbox.statusUpdated {
+				assert.Equal(t, models.FriendRequestExpired, req.Status)
+			}
+		})
+	}
+}
+
+func TestSocialService_AcceptFriendRequest(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, time.October, 17, 12, 0, 0, 0, time.UTC)
+	acceptedAt := now.Add(-time.Second)
+
+	newRepo := func(status models.FriendRequestStatus) *stubSocialRepository {
+		return &stubSocialRepository{
+			log: &callLog{},
+			requests: map[[2]models.UserID]*models.FriendRequest{
+				{"alice", "bob"}: {ID: "request-1", FromUserID: "alice", ToUserID: "bob", Status: status},
+			},
+			acceptedAt: acceptedAt,
+		}
+	}
+
+	t.Run("заявка, дружба в обе стороны и событие пишутся в одной транзакции", func(t *testing.T) {
+		repo := newRepo(models.FriendRequestPending)
+		outbox := &stubOutboxRepository{log: repo.log}
+		s := newTestService(repo, outbox, now)
+
+		friendRequest, err := s.AcceptFriendRequest(ctx, dto.ChangeFriendRequestDto{UserID: "bob", RequestID: "request-1"})
+		require.NoError(t, err)
+		assert.Equal(t, models.FriendRequestAccepted, friendRequest.Status)
+
+		assert.Equal(t, callLog{
+			{method: "UpdateFriendRequest", inTx: true},
+			{method: "SaveFriendship", inTx: true},
+			{method: "SaveFriendRequestStatusUpdated", inTx: true},
+		}, *repo.log)
+		// Друзья с момента принятия заявки
+		assert.Equal(t, map[[2]models.UserID]time.Time{
+			{"alice", "bob"}: acceptedAt,
+			{"bob", "alice"}: acceptedAt,
+		}, repo.friendships)
+		require.Len(t, outbox.statusUpdated, 1)
+		assert.Equal(t, models.FriendRequestAccepted, outbox.statusUpdated[0].Status)
+	})
+
+	t.Run("ошибка записи дружбы откатывает принятие", func(t *testing.T) {
+		repo := newRepo(models.FriendRequestPending)
+		repo.saveFriendshipErr = errDatabase
+		outbox := &stubOutboxRepository{log: repo.log}
+		s := newTestService(repo, outbox, now)
+
+		friendRequest, err := s.AcceptFriendRequest(ctx, dto.ChangeFriendRequestDto{UserID: "bob", RequestID: "request-1"})
+		require.ErrorIs(t, err, errDatabase)
+		assert.Nil(t, friendRequest)
+		assert.Empty(t, outbox.statusUpdated)
+	})
+
+	t.Run("принять может только получатель", func(t *testing.T) {
+		repo := newRepo(models.FriendRequestPending)
+		s := newTestService(repo, &stubOutboxRepository{}, now)
+
+		_, err := s.AcceptFriendRequest(ctx, dto.ChangeFriendRequestDto{UserID: "alice", RequestID: "request-1"})
+		require.ErrorIs(t, err, models.ErrPermissionDenied)
+		assert.Empty(t, *repo.log)
+	})
+
+	t.Run("заявка уже не ожидает ответа", func(t *testing.T) {
+		repo := newRepo(models.FriendRequestCancelled)
+		s := newTestService(repo, &stubOutboxRepository{}, now)
+
+		_, err := s.AcceptFriendRequest(ctx, dto.ChangeFriendRequestDto{UserID: "bob", RequestID: "request-1"})
+		require.ErrorIs(t, err, models.ErrNotPending)
+		assert.Empty(t, repo.friendships)
+	})
+
+	t.Run("заявку отозвали между чтением и принятием", func(t *testing.T) {
+		repo := newRepo(models.FriendRequestPending)
+		outbox := &stubOutboxRepository{log: repo.log}
+		s := newTestService(repo, outbox, now)
+
+		// Чтение видит ожидающую заявку, а условный UPDATE - уже отозванную
+		pending := *repo.requests[[2]models.UserID{"alice", "bob"}]
+		repo.requests[[2]models.UserID{"alice", "bob"}].Status = models.FriendRequestCancelled
+		s.socialRepo = &staleReadRepository{stubSocialRepository: repo, stale: &pending}
+
+		_, err := s.AcceptFriendRequest(ctx, dto.ChangeFriendRequestDto{UserID: "bob", RequestID: "request-1"})
+		require.ErrorIs(t, err, models.ErrNotPending)
+		assert.Equal(t, callLog{{method: "UpdateFriendRequest", inTx: true}}, *repo.log)
+		assert.Empty(t, repo.friendships)
+		assert.Empty(t, outbox.statusUpdated)
+	})
+
+	t.Run("заявка не найдена", func(t *testing.T) {
+		repo := newRepo(models.FriendRequestPending)
+		s := newTestService(repo, &stubOutboxRepository{}, now)
+
+		_, err := s.AcceptFriendRequest(ctx, dto.ChangeFriendRequestDto{UserID: "bob", RequestID: "request-2"})
+		require.ErrorIs(t, err, models.ErrNotFound)
+	})
+}
+
+// staleReadRepository возвращает из GetFriendRequest устаревшую заявку
+type staleReadRepository struct {
+	*stubSocialRepository
+	stale *models.FriendRequest
+}
+
+func (r *staleReadRepository) GetFriendRequest(context.Context, models.FriendRequestID) (*models.FriendRequest, error) {
+	return r.stale, nil
+}
+
+func TestSocialService_RemoveFriend(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, time.October, 17, 12, 0, 0, 0, time.UTC)
+	friendsSince := now.Add(-time.Hour)
+
+	newRepo := func(status models.FriendRequestStatus) *stubSocialRepository {
+		return &stubSocialRepository{
+			log: &callLog{},
+			requests: map[[2]models.UserID]*models.FriendRequest{
+				{"alice", "bob"}: {ID: "request-1", FromUserID: "alice", ToUserID: "bob", Status: status},
+			},
+			friendships: map[[2]models.UserID]time.Time{
+				{"alice", "bob"}:   friendsSince,
+				{"bob", "alice"}:   friendsSince,
+				{"alice", "carol"}: friendsSince,
+				{"carol", "alice"}: friendsSince,
+			},
+		}
+	}
+
+	for _, tt := range []struct {
+		name string
+		req  dto.FriendRequestDto
+	}{
+		{name: "удаляет отправитель заявки", req: dto.FriendRequestDto{FromUserID: "alice", ToUserID: "bob"}},
+		{name: "удаляет получатель заявки", req: dto.FriendRequestDto{FromUserID: "bob", ToUserID: "alice"}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newRepo(models.FriendRequestAccepted)
+			s := newTestService(repo, &stubOutboxRepository{}, now)
+
+			require.NoError(t, s.RemoveFriend(ctx, tt.req))
+
+			assert.Equal(t, callLog{
+				{method: "DeleteFriendRequest", inTx: true},
+				{method: "DeleteFriendship", inTx: true},
+			}, *repo.log)
+			assert.Empty(t, repo.requests)
+			// Дружба удалена в обе стороны, остальные дружбы на месте
+			assert.Equal(t, map[[2]models.UserID]time.Time{
+				{"alice", "carol"}: friendsSince,
+				{"carol", "alice"}: friendsSince,
+			}, repo.friendships)
+		})
+	}
+
+	t.Run("пользователи не друзья", func(t *testing.T) {
+		repo := newRepo(models.FriendRequestPending)
+		s := newTestService(repo, &stubOutboxRepository{}, now)
+
+		err := s.RemoveFriend(ctx, dto.FriendRequestDto{FromUserID: "alice", ToUserID: "bob"})
+		require.ErrorIs(t, err, models.ErrNotFound)
+		assert.Empty(t, *repo.log)
+	})
+}
+
+func TestSocialService_ListFriends(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, time.October, 17, 12, 0, 0, 0, time.UTC)
+	cursor := "cursor"
+
+	tests := []struct {
+		name      string
+		limit     int64
+		wantLimit int64
+	}{
+		{name: "размер страницы по умолчанию", wantLimit: defaultListFriendsLimit},
+		{name: "отрицательный размер страницы", limit: -1, wantLimit: defaultListFriendsLimit},
+		{name: "размер страницы из запроса", limit: 10, wantLimit: 10},
+		{name: "размер страницы ограничен", limit: 1000, wantLimit: maxListFriendsLimit},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nextCursor := "next"
+			repo := &stubSocialRepository{
+				friends:           []*models.Friendship{{UserID: "alice", FriendID: "bob"}},
+				friendsNextCursor: &nextCursor,
+			}
+			s := newTestService(repo, &stubOutboxRepository{}, now)
+
+			resp, err := s.ListFriends(ctx, dto.ListFriendsDto{UserID: "alice", Limit: tt.limit, Cursor: &cursor})
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantLimit, repo.listFriendsLimit)
+			assert.Equal(t, &cursor, repo.listFriendsCursor)
+			assert.Equal(t, repo.friends, resp.Friends)
+			assert.Equal(t, &nextCursor, resp.NextCursor)
+		})
+	}
+}
+
+func TestSocialService_BlockUser(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, time.October, 17, 12, 0, 0, 0, time.UTC)
+	friendsSince := now.Add(-time.Hour)
+
+	tests := []struct {
+		name     string
+		requests map[[2]models.UserID]*models.FriendRequest
+	}{
+		{
+			name: "исходящая ожидающая заявка",
+			requests: map[[2]models.UserID]*models.FriendRequest{
+				{"alice", "bob"}: {ID: "request-1", Status: models.FriendRequestPending},
+			},
+		},
+		{
+			name: "входящая ожидающая заявка",
+			requests: map[[2]models.UserID]*models.FriendRequest{
+				{"bob", "alice"}: {ID: "request-1", Status: models.FriendRequestPending},
+			},
+		},
+		{
+			name: "принятая исходящая заявка",
+			requests: map[[2]models.UserID]*models.FriendRequest{
+				{"alice", "bob"}: {ID: "request-1", Status: models.FriendRequestAccepted},
+			},
+		},
+		{
+			name: "принятая входящая заявка",
+			requests: map[[2]models.UserID]*models.FriendRequest{
+				{"bob", "alice"}: {ID: "request-1", Status: models.FriendRequestAccepted},
+			},
+		},
+		{
+			name: "заявки в обе стороны",
+			requests: map[[2]models.UserID]*models.FriendRequest{
+				{"alice", "bob"}: {ID: "request-1", Status: models.FriendRequestDeclined},
+				{"bob", "alice"}: {ID: "request-2", Status: models.FriendRequestAccepted},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &stubSocialRepository{
+				log:      &callLog{},
+				requests: tt.requests,
+				friendships: map[[2]models.UserID]time.Time{
+					{"alice", "bob"}: friendsSince,
+					{"bob", "alice"}: friendsSince,
+				},
+			}
+			outbox := &stubOutboxRepository{log: repo.log}
+			s := newTestService(repo, outbox, now)
+
+			require.NoError(t, s.BlockUser(ctx, dto.UserBlockDto{BlockerID: "alice", BlockedID: "bob"}))
+
+			// Блокировка, удаление заявок и дружбы и событие в одной транзакции
+			for _, call := range *repo.log {
+				assert.True(t, call.inTx, call.method)
+			}
+			assert.True(t, repo.blocks[[2]models.UserID{"alice", "bob"}])
+			assert.Empty(t, repo.requests)
+			assert.Empty(t, repo.friendships)
+
+			require.Len(t, outbox.blocked, 1)
+			assert.Equal(t, models.UserID("alice"), outbox.blocked[0].BlockerID)
+			assert.Equal(t, models.UserID("bob"), outbox.blocked[0].BlockedID)
+		})
+	}
+
+	t.Run("повторная блокировка ничего не меняет", func(t *testing.T) {
+		repo := &stubSocialRepository{
+			log:    &callLog{},
+			blocks: map[[2]models.UserID]bool{{"alice", "bob"}: true},
+			// По оставшейся заявке видно, что повторная блокировка ничего не удаляет
+			requests: map[[2]models.UserID]*models.FriendRequest{
+				{"alice", "bob"}: {ID: "request-1", Status: models.FriendRequestDeclined},
+			},
+		}
+		outbox := &stubOutboxRepository{log: repo.log}
+		s := newTestService(repo, outbox, now)
+
+		require.NoError(t, s.BlockUser(ctx, dto.UserBlockDto{BlockerID: "alice", BlockedID: "bob"}))
+
+		assert.Equal(t, callLog{{method: "SaveUserBlock", inTx: true}}, *repo.log)
+		assert.Len(t, repo.requests, 1)
+		assert.Empty(t, outbox.blocked)
+	})
+
+	t.Run("встречная блокировка", func(t *testing.T) {
+		repo := &stubSocialRepository{
+			log:    &callLog{},
+			blocks: map[[2]models.UserID]bool{{"bob", "alice"}: true},
+		}
+		outbox := &stubOutboxRepository{log: repo.log}
+		s := newTestService(repo, outbox, now)
+
+		require.NoError(t, s.BlockUser(ctx, dto.UserBlockDto{BlockerID: "alice", BlockedID: "bob"}))
+
+		assert.Equal(t, map[[2]models.UserID]bool{{"alice", "bob"}: true, {"bob", "alice"}: true}, repo.blocks)
+		assert.Len(t, outbox.blocked, 1)
+	})
+
+	t.Run("нельзя заблокировать себя", func(t *testing.T) {
+		repo := &stubSocialRepository{log: &callLog{}}
+		s := newTestService(repo, &stubOutboxRepository{}, now)
+
+		err := s.BlockUser(ctx, dto.UserBlockDto{BlockerID: "alice", BlockedID: "alice"})
+		require.ErrorIs(t, err, models.ErrInvalidArgument)
+		assert.Empty(t, *repo.log)
+	})
+}
+
+func TestSocialService_UnblockUser(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, time.October, 17, 12, 0, 0, 0, time.UTC)
+
+	t.Run("блокировка снимается в одной транзакции с событием", func(t *testing.T) {
+		repo := &stubSocialRepository{
+			log:    &callLog{},
+			blocks: map[[2]models.UserID]bool{{"alice", "bob"}: true, {"bob", "alice"}: true},
+		}
+		outbox := &stubOutboxRepository{log: repo.log}
+		s := newTestService(repo, outbox, now)
+
+		require.NoError(t, s.UnblockUser(ctx, dto.UserBlockDto{BlockerID: "alice", BlockedID: "bob"}))
+
+		assert.Equal(t, callLog{
+			{method: "DeleteUserBlock", inTx: true},
+			{method: "SaveUserUnblocked", inTx: true},
+		}, *repo.log)
+		// Встречная блокировка остается
+		assert.Equal(t, map[[2]models.UserID]bool{{"bob", "alice"}: true}, repo.blocks)
+		require.Len(t, outbox.unblocked, 1)
+		assert.Equal(t, models.UserID("alice"), outbox.unblocked[0].BlockerID)
+		assert.Equal(t, models.UserID("bob"), outbox.unblocked[0].BlockedID)
+	})
+
+	t.Run("пользователь не заблокирован", func(t *testing.T) {
+		repo := &stubSocialRepository{
+			log:    &callLog{},
+			blocks: map[[2]models.UserID]bool{{"bob", "alice"}: true},
+		}
+		outbox := &stubOutboxRepository{log: repo.log}
+		s := newTestService(repo, outbox, now)
+
+		err := s.UnblockUser(ctx, dto.UserBlockDto{BlockerID: "alice", BlockedID: "bob"})
+		require.ErrorIs(t, err, models.ErrNotFound)
+		assert.Empty(t, outbox.unblocked)
+	})
+}
+
+func TestSocialService_SendFriendRequestBlocked(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, time.October, 17, 12, 0, 0, 0, time.UTC)
+
+	for _, tt := range []struct {
+		name  string
+		block [2]models.UserID
+	}{
+		{name: "отправитель заблокировал получателя", block: [2]models.UserID{"alice", "bob"}},
+		{name: "получатель заблокировал отправителя", block: [2]models.UserID{"bob", "alice"}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &stubSocialRepository{log: &callLog{}, blocks: map[[2]models.UserID]bool{tt.block: true}}
+			outbox := &stubOutboxRepository{log: repo.log}
+			s := newTestService(repo, outbox, now)
+
+			friendRequest, err := s.SendFriendRequest(ctx, dto.FriendRequestDto{FromUserID: "alice", ToUserID: "bob"})
+			require.ErrorIs(t, err, models.ErrBlocked)
+			assert.Nil(t, friendRequest)
+			assert.Empty(t, *repo.log)
+		})
+	}
+}
+
+func TestSocialService_SuggestFriends(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, time.October, 17, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		limit     int64
+		wantLimit int64
+	}{
+		{name: "размер списка по умолчанию", wantLimit: defaultSuggestFriendsLimit},
+		{name: "размер списка из запроса", limit: 5, wantLimit: 5},
+		{name: "размер списка ограничен", limit: 1000, wantLimit: maxSuggestFriendsLimit},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Ранжирует репозиторий, usecase порядок не меняет
+			repo := &stubSocialRepository{suggestions: []*models.FriendSuggestion{
+				{UserID: "dave", MutualFriendsCount: 3},
+				{UserID: "bob", MutualFriendsCount: 1},
+				{UserID: "carol", MutualFriendsCount: 1},
+			}}
+			s := newTestService(repo, &stubOutboxRepository{}, now)
+
+			suggestions, err := s.SuggestFriends(ctx, dto.SuggestFriendsDto{UserID: "alice", Limit: tt.limit})
+			require.NoError(t, err)
+
+			assert.Equal(t, models.UserID("alice"), repo.suggestFriendsUser)
+			assert.Equal(t, tt.wantLimit, repo.suggestFriendsLimit)
+			assert.Equal(t, repo.suggestions, suggestions)
+		})
+	}
+
+	t.Run("у пользователя без друзей список пустой", func(t *testing.T) {
+		repo := &stubSocialRepository{}
+		s := newTestService(repo, &stubOutboxRepository{}, now)
+
+		suggestions, err := s.SuggestFriends(ctx, dto.SuggestFriendsDto{UserID: "alice"})
+		require.NoError(t, err)
+		assert.Empty(t, suggestions)
+	})
+}
@@ -30,8 +30,12 @@ func ErrorsUnaryInterceptor() grpc.UnaryServerInterceptor {
 			err = status.Error(codes.NotFound, err.Error())
 		case errors.Is(err, models.ErrAlreadyExists):
 			err = status.Error(codes.AlreadyExists, err.Error())
-		case errors.Is(err, models.ErrPermissionDenied):
+		case errors.Is(err, models.ErrPermissionDenied), errors.Is(err, models.ErrBlocked):
 			err = status.Error(codes.PermissionDenied, err.Error())
+		case errors.Is(err, models.ErrInvalidCursor), errors.Is(err, models.ErrInvalidArgument):
+			err = status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, models.ErrNotPending), errors.Is(err, models.ErrCooldown):
+			err = status.Error(codes.FailedPrecondition, err.Error())
 		default:
 			err = status.Error(codes.Unknown, err.Error())
 		}
@@ -0,0 +1,38 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS public.idempotency_keys (
+    subject       TEXT        NOT NULL DEFAULT '',
+    method        TEXT        NOT NULL,
+    key           TEXT        NOT NULL,
+    fingerprint   TEXT        NOT NULL,
+    status        TEXT        NOT NULL,
+    response_type TEXT,
+    response      BYTEA,
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at    TIMESTAMPTZ NOT NULL DEFAULT now(),
+    expires_at    TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (subject, method, key)
+);
+
+-- Индекс для очистки истекших ключей
+CREATE INDEX IF NOT EXISTS idx_idempotency_keys_expires_at ON public.idempotency_keys(expires_at);
+
+COMMENT ON TABLE public.idempotency_keys IS 'Ключи идемпотентности gRPC методов и сохраненные ответы';
+
+COMMENT ON COLUMN public.idempotency_keys.subject       IS 'Владелец ключа (user_id из JWT, пусто для анонимных методов)';
+COMMENT ON COLUMN public.idempotency_keys.method        IS 'Полное имя gRPC метода';
+COMMENT ON COLUMN public.idempotency_keys.key           IS 'Значение idempotency-key';
+COMMENT ON COLUMN public.idempotency_keys.fingerprint   IS 'sha256 отпечаток тела запроса';
+COMMENT ON COLUMN public.idempotency_keys.status        IS 'Статус обработки (in_progress, completed)';
+COMMENT ON COLUMN public.idempotency_keys.response_type IS 'Полное имя proto сообщения ответа';
+COMMENT ON COLUMN public.idempotency_keys.response      IS 'Сериализованный ответ';
+COMMENT ON COLUMN public.idempotency_keys.created_at    IS 'Дата и время первого запроса';
+COMMENT ON COLUMN public.idempotency_keys.updated_at    IS 'Дата и время последнего изменения статуса';
+COMMENT ON COLUMN public.idempotency_keys.expires_at    IS 'Дата и время, после которого ключ можно переиспользовать';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS public.idx_idempotency_keys_expires_at;
+DROP TABLE IF EXISTS public.idempotency_keys;
+-- +goose StatementEnd
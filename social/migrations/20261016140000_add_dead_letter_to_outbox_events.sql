@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE public.outbox_events
+    ADD COLUMN IF NOT EXISTS dead_lettered_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS last_error       TEXT;
+
+COMMENT ON COLUMN public.outbox_events.dead_lettered_at IS 'Время перевода события в dead-letter (попытки публикации исчерпаны)';
+COMMENT ON COLUMN public.outbox_events.last_error       IS 'Последняя ошибка публикации';
+
+-- Индекс на родительской таблице создается во всех текущих и будущих партициях
+CREATE INDEX IF NOT EXISTS idx_outbox_events_dead_letters
+    ON public.outbox_events (id)
+    WHERE dead_lettered_at IS NOT NULL AND published_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS public.idx_outbox_events_dead_letters;
+
+ALTER TABLE public.outbox_events
+    DROP COLUMN IF EXISTS last_error,
+    DROP COLUMN IF EXISTS dead_lettered_at;
+-- +goose StatementEnd
@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS public.friendships (
+    user_id TEXT NOT NULL,
+    friend_id TEXT NOT NULL,
+    request_id UUID NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (user_id, friend_id)
+);
+
+-- Индекс для ListFriends: друзья пользователя от новых к старым, курсор (created_at, friend_id)
+CREATE INDEX idx_friendships_user_id_created_at ON public.friendships(user_id, created_at DESC, friend_id DESC);
+
+COMMENT ON TABLE public.friendships IS 'Дружба, по строке на каждое направление (user_id -> friend_id и friend_id -> user_id)';
+
+COMMENT ON COLUMN public.friendships.request_id IS 'Принятая заявка, которой установлена дружба';
+COMMENT ON COLUMN public.friendships.created_at IS 'Дата и время, с которых пользователи друзья';
+
+-- Дружба по ранее принятым заявкам
+INSERT INTO public.friendships (user_id, friend_id, request_id, created_at)
+SELECT from_user_id, to_user_id, id, COALESCE(updated_at, created_at) FROM public.friend_requests WHERE status = 1
+UNION ALL
+SELECT to_user_id, from_user_id, id, COALESCE(updated_at, created_at) FROM public.friend_requests WHERE status = 1
+ON CONFLICT (user_id, friend_id) DO NOTHING;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS public.friendships;
+-- +goose StatementEnd
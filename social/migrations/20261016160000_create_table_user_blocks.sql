@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS public.user_blocks (
+    blocker_id TEXT NOT NULL,
+    blocked_id TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (blocker_id, blocked_id)
+);
+
+-- Индекс для ListBlockedUsers: заблокированные пользователем от новых к старым, курсор (created_at, blocked_id)
+CREATE INDEX idx_user_blocks_blocker_id_created_at ON public.user_blocks(blocker_id, created_at DESC, blocked_id DESC);
+
+COMMENT ON TABLE public.user_blocks IS 'Блокировки пользователей, действуют в обе стороны';
+
+COMMENT ON COLUMN public.user_blocks.blocker_id IS 'Пользователь, который заблокировал';
+COMMENT ON COLUMN public.user_blocks.blocked_id IS 'Заблокированный пользователь';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS public.user_blocks;
+-- +goose StatementEnd
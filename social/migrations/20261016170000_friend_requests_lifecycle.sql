@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Повторная заявка после отклонения, отзыва или истечения создает новую строку,
+-- поэтому уникальность пары действует только для активных заявок (ожидает или принята)
+DROP INDEX IF EXISTS public.idx_friend_requests_users;
+CREATE UNIQUE INDEX idx_friend_requests_users_active ON public.friend_requests(from_user_id, to_user_id) WHERE status IN (0, 1);
+
+-- Индекс для поиска последней заявки между пользователями
+CREATE INDEX idx_friend_requests_users_created_at ON public.friend_requests(from_user_id, to_user_id, created_at DESC);
+
+-- Индекс для задачи истечения ожидающих заявок
+CREATE INDEX idx_friend_requests_pending_created_at ON public.friend_requests(created_at) WHERE status = 0;
+
+COMMENT ON COLUMN public.friend_requests.status IS 'Статус заявки (0 - ожидает, 1 - принята, 2 - отклонена, 3 - отозвана, 4 - истекла)';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- Оставляем только последнюю заявку для каждой пары, иначе уникальный индекс не создать
+DELETE FROM public.friend_requests fr
+USING public.friend_requests newer
+WHERE newer.from_user_id = fr.from_user_id
+  AND newer.to_user_id = fr.to_user_id
+  AND (newer.created_at, newer.id) > (fr.created_at, fr.id);
+
+DROP INDEX IF EXISTS public.idx_friend_requests_pending_created_at;
+DROP INDEX IF EXISTS public.idx_friend_requests_users_created_at;
+DROP INDEX IF EXISTS public.idx_friend_requests_users_active;
+CREATE UNIQUE INDEX idx_friend_requests_users ON public.friend_requests(from_user_id, to_user_id);
+
+COMMENT ON COLUMN public.friend_requests.status IS 'Статус заявки (0 - ожидает, 1 - принята, 2 - отклонена)';
+-- +goose StatementEnd
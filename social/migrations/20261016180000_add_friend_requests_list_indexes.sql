@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Индексы для ListRequests: заявки пользователя от новых к старым, курсор (created_at, id)
+CREATE INDEX idx_friend_requests_to_user_id_created_at ON public.friend_requests(to_user_id, created_at DESC, id DESC);
+CREATE INDEX idx_friend_requests_from_user_id_created_at ON public.friend_requests(from_user_id, created_at DESC, id DESC);
+
+-- Покрываются новыми индексами
+DROP INDEX IF EXISTS public.idx_friend_requests_to_user_id;
+DROP INDEX IF EXISTS public.idx_friend_requests_from_user_id;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS idx_friend_requests_from_user_id ON public.friend_requests(from_user_id);
+CREATE INDEX IF NOT EXISTS idx_friend_requests_to_user_id ON public.friend_requests(to_user_id);
+
+DROP INDEX IF EXISTS public.idx_friend_requests_from_user_id_created_at;
+DROP INDEX IF EXISTS public.idx_friend_requests_to_user_id_created_at;
+-- +goose StatementEnd
@@ -26,9 +26,11 @@ const (
 type FriendRequestStatus int32
 
 const (
-	FriendRequestStatus_FRIEND_REQUEST_STATUS_PENDING  FriendRequestStatus = 0
-	FriendRequestStatus_FRIEND_REQUEST_STATUS_ACCEPTED FriendRequestStatus = 1
-	FriendRequestStatus_FRIEND_REQUEST_STATUS_DECLINED FriendRequestStatus = 2
+	FriendRequestStatus_FRIEND_REQUEST_STATUS_PENDING   FriendRequestStatus = 0
+	FriendRequestStatus_FRIEND_REQUEST_STATUS_ACCEPTED  FriendRequestStatus = 1
+	FriendRequestStatus_FRIEND_REQUEST_STATUS_DECLINED  FriendRequestStatus = 2
+	FriendRequestStatus_FRIEND_REQUEST_STATUS_CANCELLED FriendRequestStatus = 3
+	FriendRequestStatus_FRIEND_REQUEST_STATUS_EXPIRED   FriendRequestStatus = 4
 )
 
 // Enum value maps for FriendRequestStatus.
@@ -37,11 +39,15 @@ var (
 		0: "FRIEND_REQUEST_STATUS_PENDING",
 		1: "FRIEND_REQUEST_STATUS_ACCEPTED",
 		2: "FRIEND_REQUEST_STATUS_DECLINED",
+		3: "FRIEND_REQUEST_STATUS_CANCELLED",
+		4: "FRIEND_REQUEST_STATUS_EXPIRED",
 	}
 	FriendRequestStatus_value = map[string]int32{
-		"FRIEND_REQUEST_STATUS_PENDING":  0,
-		"FRIEND_REQUEST_STATUS_ACCEPTED": 1,
-		"FRIEND_REQUEST_STATUS_DECLINED": 2,
+		"FRIEND_REQUEST_STATUS_PENDING":   0,
+		"FRIEND_REQUEST_STATUS_ACCEPTED":  1,
+		"FRIEND_REQUEST_STATUS_DECLINED":  2,
+		"FRIEND_REQUEST_STATUS_CANCELLED": 3,
+		"FRIEND_REQUEST_STATUS_EXPIRED":   4,
 	}
 )
 
@@ -72,6 +78,53 @@ func (FriendRequestStatus) EnumDescriptor() ([]byte, []int) {
 	return file_api_service_proto_rawDescGZIP(), []int{0}
 }
 
+// FriendRequestDirection - входящие или исходящие заявки
+type FriendRequestDirection int32
+
+const (
+	FriendRequestDirection_FRIEND_REQUEST_DIRECTION_INCOMING FriendRequestDirection = 0
+	FriendRequestDirection_FRIEND_REQUEST_DIRECTION_OUTGOING FriendRequestDirection = 1
+)
+
+// Enum value maps for FriendRequestDirection.
+var (
+	FriendRequestDirection_name = map[int32]string{
+		0: "FRIEND_REQUEST_DIRECTION_INCOMING",
+		1: "FRIEND_REQUEST_DIRECTION_OUTGOING",
+	}
+	FriendRequestDirection_value = map[string]int32{
+		"FRIEND_REQUEST_DIRECTION_INCOMING": 0,
+		"FRIEND_REQUEST_DIRECTION_OUTGOING": 1,
+	}
+)
+
+func (x FriendRequestDirection) Enum() *FriendRequestDirection {
+	p := new(FriendRequestDirection)
+	*p = x
+	return p
+}
+
+func (x FriendRequestDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FriendRequestDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_service_proto_enumTypes[1].Descriptor()
+}
+
+func (FriendRequestDirection) Type() protoreflect.EnumType {
+	return &file_api_service_proto_enumTypes[1]
+}
+
+func (x FriendRequestDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FriendRequestDirection.Descriptor instead.
+func (FriendRequestDirection) EnumDescriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{1}
+}
+
 // FriendRequest - заявка в друзья
 type FriendRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -237,11 +290,22 @@ func (x *SendFriendRequestResponse) GetFriendRequest() *FriendRequest {
 	return nil
 }
 
-// ListRequestsRequest - запрос ListRequests
+// ListRequestsRequest - запрос ListRequests, заявки текущего пользователя от новых к старым
 type ListRequestsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// toUserId - идентификатор пользователя получающего заявку
-	ToUserId      string `protobuf:"bytes,1,opt,name=toUserId,proto3" json:"toUserId,omitempty"`
+	// toUserId - устарело, заявки всегда берутся для текущего пользователя;
+	// если задан, должен совпадать с ним
+	//
+	// Deprecated: Marked as deprecated in api/service.proto.
+	ToUserId string `protobuf:"bytes,1,opt,name=toUserId,proto3" json:"toUserId,omitempty"`
+	// direction - входящие (по умолчанию) или исходящие заявки
+	Direction FriendRequestDirection `protobuf:"varint,2,opt,name=direction,proto3,enum=github.com.krus210.balun_microservices.protobuf.social.v1.proto.FriendRequestDirection" json:"direction,omitempty"`
+	// statuses - только заявки в этих статусах, пустой список - все статусы
+	Statuses []FriendRequestStatus `protobuf:"varint,3,rep,packed,name=statuses,proto3,enum=github.com.krus210.balun_microservices.protobuf.social.v1.proto.FriendRequestStatus" json:"statuses,omitempty"`
+	// limit - лимит результатов, по умолчанию 50
+	Limit int64 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	// cursor - курсор для пагинации
+	Cursor        *string `protobuf:"bytes,5,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -276,6 +340,7 @@ func (*ListRequestsRequest) Descriptor() ([]byte, []int) {
 	return file_api_service_proto_rawDescGZIP(), []int{3}
 }
 
+// Deprecated: Marked as deprecated in api/service.proto.
 func (x *ListRequestsRequest) GetToUserId() string {
 	if x != nil {
 		return x.ToUserId
@@ -283,11 +348,41 @@ func (x *ListRequestsRequest) GetToUserId() string {
 	return ""
 }
 
+func (x *ListRequestsRequest) GetDirection() FriendRequestDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return FriendRequestDirection_FRIEND_REQUEST_DIRECTION_INCOMING
+}
+
+func (x *ListRequestsRequest) GetStatuses() []FriendRequestStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ListRequestsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListRequestsRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
 // ListRequestsResponse - ответ ListRequests
 type ListRequestsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// requests - список заявок в друзья
-	Requests      []*FriendRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	Requests []*FriendRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	// nextCursor - следующий курсор для пагинации
+	NextCursor    *string `protobuf:"bytes,2,opt,name=nextCursor,proto3,oneof" json:"nextCursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -329,6 +424,13 @@ func (x *ListRequestsResponse) GetRequests() []*FriendRequest {
 	return nil
 }
 
+func (x *ListRequestsResponse) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
+	}
+	return ""
+}
+
 // AcceptFriendRequestRequest - запрос AcceptFriendRequest
 type AcceptFriendRequestRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -513,6 +615,208 @@ func (x *DeclineFriendRequestResponse) GetFriendRequest() *FriendRequest {
 	return nil
 }
 
+// CancelFriendRequestRequest - запрос CancelFriendRequest
+type CancelFriendRequestRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// requestId - идентификатор заявки
+	RequestId     string `protobuf:"bytes,1,opt,name=requestId,proto3" json:"requestId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelFriendRequestRequest) Reset() {
+	*x = CancelFriendRequestRequest{}
+	mi := &file_api_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelFriendRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelFriendRequestRequest) ProtoMessage() {}
+
+func (x *CancelFriendRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelFriendRequestRequest.ProtoReflect.Descriptor instead.
+func (*CancelFriendRequestRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CancelFriendRequestRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+// CancelFriendRequestResponse - ответ CancelFriendRequest
+type CancelFriendRequestResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// friendRequest - заявка в друзья
+	FriendRequest *FriendRequest `protobuf:"bytes,1,opt,name=friendRequest,proto3" json:"friendRequest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelFriendRequestResponse) Reset() {
+	*x = CancelFriendRequestResponse{}
+	mi := &file_api_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelFriendRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelFriendRequestResponse) ProtoMessage() {}
+
+func (x *CancelFriendRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelFriendRequestResponse.ProtoReflect.Descriptor instead.
+func (*CancelFriendRequestResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CancelFriendRequestResponse) GetFriendRequest() *FriendRequest {
+	if x != nil {
+		return x.FriendRequest
+	}
+	return nil
+}
+
+// ListOutgoingRequestsRequest - запрос ListOutgoingRequests
+type ListOutgoingRequestsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// limit - лимит результатов, по умолчанию 50
+	Limit int64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// cursor - курсор для пагинации
+	Cursor        *string `protobuf:"bytes,2,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOutgoingRequestsRequest) Reset() {
+	*x = ListOutgoingRequestsRequest{}
+	mi := &file_api_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOutgoingRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOutgoingRequestsRequest) ProtoMessage() {}
+
+func (x *ListOutgoingRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOutgoingRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListOutgoingRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListOutgoingRequestsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListOutgoingRequestsRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+// ListOutgoingRequestsResponse - ответ ListOutgoingRequests
+type ListOutgoingRequestsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// requests - список заявок в друзья
+	Requests []*FriendRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	// nextCursor - следующий курсор для пагинации
+	NextCursor    *string `protobuf:"bytes,2,opt,name=nextCursor,proto3,oneof" json:"nextCursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOutgoingRequestsResponse) Reset() {
+	*x = ListOutgoingRequestsResponse{}
+	mi := &file_api_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOutgoingRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOutgoingRequestsResponse) ProtoMessage() {}
+
+func (x *ListOutgoingRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOutgoingRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListOutgoingRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListOutgoingRequestsResponse) GetRequests() []*FriendRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+func (x *ListOutgoingRequestsResponse) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
+	}
+	return ""
+}
+
 // RemoveFriendRequest - запрос RemoveFriend
 type RemoveFriendRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -524,7 +828,7 @@ type RemoveFriendRequest struct {
 
 func (x *RemoveFriendRequest) Reset() {
 	*x = RemoveFriendRequest{}
-	mi := &file_api_service_proto_msgTypes[9]
+	mi := &file_api_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -536,7 +840,7 @@ func (x *RemoveFriendRequest) String() string {
 func (*RemoveFriendRequest) ProtoMessage() {}
 
 func (x *RemoveFriendRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_service_proto_msgTypes[9]
+	mi := &file_api_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -549,7 +853,7 @@ func (x *RemoveFriendRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveFriendRequest.ProtoReflect.Descriptor instead.
 func (*RemoveFriendRequest) Descriptor() ([]byte, []int) {
-	return file_api_service_proto_rawDescGZIP(), []int{9}
+	return file_api_service_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *RemoveFriendRequest) GetUserId() string {
@@ -568,7 +872,7 @@ type RemoveFriendResponse struct {
 
 func (x *RemoveFriendResponse) Reset() {
 	*x = RemoveFriendResponse{}
-	mi := &file_api_service_proto_msgTypes[10]
+	mi := &file_api_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -580,7 +884,7 @@ func (x *RemoveFriendResponse) String() string {
 func (*RemoveFriendResponse) ProtoMessage() {}
 
 func (x *RemoveFriendResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_service_proto_msgTypes[10]
+	mi := &file_api_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -593,7 +897,7 @@ func (x *RemoveFriendResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveFriendResponse.ProtoReflect.Descriptor instead.
 func (*RemoveFriendResponse) Descriptor() ([]byte, []int) {
-	return file_api_service_proto_rawDescGZIP(), []int{10}
+	return file_api_service_proto_rawDescGZIP(), []int{14}
 }
 
 // ListFriendsRequest - запрос ListFriends
@@ -601,7 +905,7 @@ type ListFriendsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// userId - идентификатор пользователя
 	UserId string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
-	// limit - лимит результатов
+	// limit - лимит результатов, по умолчанию 50
 	Limit int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 	// cursor - курсор для пагинации
 	Cursor        *string `protobuf:"bytes,3,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
@@ -611,7 +915,7 @@ type ListFriendsRequest struct {
 
 func (x *ListFriendsRequest) Reset() {
 	*x = ListFriendsRequest{}
-	mi := &file_api_service_proto_msgTypes[11]
+	mi := &file_api_service_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -623,7 +927,7 @@ func (x *ListFriendsRequest) String() string {
 func (*ListFriendsRequest) ProtoMessage() {}
 
 func (x *ListFriendsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_service_proto_msgTypes[11]
+	mi := &file_api_service_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -636,7 +940,7 @@ func (x *ListFriendsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListFriendsRequest.ProtoReflect.Descriptor instead.
 func (*ListFriendsRequest) Descriptor() ([]byte, []int) {
-	return file_api_service_proto_rawDescGZIP(), []int{11}
+	return file_api_service_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ListFriendsRequest) GetUserId() string {
@@ -660,32 +964,32 @@ func (x *ListFriendsRequest) GetCursor() string {
 	return ""
 }
 
-// ListFriendsResponse - ответ ListFriends
-type ListFriendsResponse struct {
+// Friend - друг пользователя
+type Friend struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// friendUserIds - список идентификаторов друзей
-	FriendUserIds []string `protobuf:"bytes,1,rep,name=friendUserIds,proto3" json:"friendUserIds,omitempty"`
-	// nextCursor - следующий курсор для пагинации
-	NextCursor    *string `protobuf:"bytes,2,opt,name=nextCursor,proto3,oneof" json:"nextCursor,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// userId - идентификатор друга
+	UserId string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	// createdAtUnixMs - с какого момента пользователи друзья, в миллисекундах
+	CreatedAtUnixMs int64 `protobuf:"varint,2,opt,name=createdAtUnixMs,proto3" json:"createdAtUnixMs,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *ListFriendsResponse) Reset() {
-	*x = ListFriendsResponse{}
-	mi := &file_api_service_proto_msgTypes[12]
+func (x *Friend) Reset() {
+	*x = Friend{}
+	mi := &file_api_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListFriendsResponse) String() string {
+func (x *Friend) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListFriendsResponse) ProtoMessage() {}
+func (*Friend) ProtoMessage() {}
 
-func (x *ListFriendsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_service_proto_msgTypes[12]
+func (x *Friend) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -696,30 +1000,792 @@ func (x *ListFriendsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListFriendsResponse.ProtoReflect.Descriptor instead.
-func (*ListFriendsResponse) Descriptor() ([]byte, []int) {
-	return file_api_service_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use Friend.ProtoReflect.Descriptor instead.
+func (*Friend) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *ListFriendsResponse) GetFriendUserIds() []string {
+func (x *Friend) GetUserId() string {
 	if x != nil {
-		return x.FriendUserIds
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-func (x *ListFriendsResponse) GetNextCursor() string {
-	if x != nil && x.NextCursor != nil {
-		return *x.NextCursor
+func (x *Friend) GetCreatedAtUnixMs() int64 {
+	if x != nil {
+		return x.CreatedAtUnixMs
 	}
-	return ""
+	return 0
 }
 
-var File_api_service_proto protoreflect.FileDescriptor
-
-const file_api_service_proto_rawDesc = "" +
-	"\n" +
-	"\x11api/service.proto\x12?github.com.krus210.balun_microservices.protobuf.social.v1.proto\x1a\x1bbuf/validate/validate.proto\"\xd7\x01\n" +
+// ListFriendsResponse - ответ ListFriends
+type ListFriendsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// friendUserIds - список идентификаторов друзей, в том же порядке, что и friends
+	FriendUserIds []string `protobuf:"bytes,1,rep,name=friendUserIds,proto3" json:"friendUserIds,omitempty"`
+	// nextCursor - следующий курсор для пагинации
+	NextCursor *string `protobuf:"bytes,2,opt,name=nextCursor,proto3,oneof" json:"nextCursor,omitempty"`
+	// friends - друзья от новых к старым
+	Friends       []*Friend `protobuf:"bytes,3,rep,name=friends,proto3" json:"friends,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFriendsResponse) Reset() {
+	*x = ListFriendsResponse{}
+	mi := &file_api_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFriendsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFriendsResponse) ProtoMessage() {}
+
+func (x *ListFriendsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFriendsResponse.ProtoReflect.Descriptor instead.
+func (*ListFriendsResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListFriendsResponse) GetFriendUserIds() []string {
+	if x != nil {
+		return x.FriendUserIds
+	}
+	return nil
+}
+
+func (x *ListFriendsResponse) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
+	}
+	return ""
+}
+
+func (x *ListFriendsResponse) GetFriends() []*Friend {
+	if x != nil {
+		return x.Friends
+	}
+	return nil
+}
+
+// BlockUserRequest - запрос BlockUser
+type BlockUserRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// userId - идентификатор блокируемого пользователя
+	UserId        string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockUserRequest) Reset() {
+	*x = BlockUserRequest{}
+	mi := &file_api_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUserRequest) ProtoMessage() {}
+
+func (x *BlockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUserRequest.ProtoReflect.Descriptor instead.
+func (*BlockUserRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *BlockUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// BlockUserResponse - ответ BlockUser
+type BlockUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockUserResponse) Reset() {
+	*x = BlockUserResponse{}
+	mi := &file_api_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUserResponse) ProtoMessage() {}
+
+func (x *BlockUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUserResponse.ProtoReflect.Descriptor instead.
+func (*BlockUserResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{19}
+}
+
+// UnblockUserRequest - запрос UnblockUser
+type UnblockUserRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// userId - идентификатор разблокируемого пользователя
+	UserId        string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnblockUserRequest) Reset() {
+	*x = UnblockUserRequest{}
+	mi := &file_api_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnblockUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockUserRequest) ProtoMessage() {}
+
+func (x *UnblockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockUserRequest.ProtoReflect.Descriptor instead.
+func (*UnblockUserRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UnblockUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// UnblockUserResponse - ответ UnblockUser
+type UnblockUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnblockUserResponse) Reset() {
+	*x = UnblockUserResponse{}
+	mi := &file_api_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnblockUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockUserResponse) ProtoMessage() {}
+
+func (x *UnblockUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockUserResponse.ProtoReflect.Descriptor instead.
+func (*UnblockUserResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{21}
+}
+
+// ListBlockedUsersRequest - запрос ListBlockedUsers
+type ListBlockedUsersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// limit - лимит результатов, по умолчанию 50
+	Limit int64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// cursor - курсор для пагинации
+	Cursor        *string `protobuf:"bytes,2,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBlockedUsersRequest) Reset() {
+	*x = ListBlockedUsersRequest{}
+	mi := &file_api_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlockedUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlockedUsersRequest) ProtoMessage() {}
+
+func (x *ListBlockedUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlockedUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListBlockedUsersRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListBlockedUsersRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListBlockedUsersRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+// BlockedUser - заблокированный пользователь
+type BlockedUser struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// userId - идентификатор заблокированного пользователя
+	UserId string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	// createdAtUnixMs - когда пользователь заблокирован, в миллисекундах
+	CreatedAtUnixMs int64 `protobuf:"varint,2,opt,name=createdAtUnixMs,proto3" json:"createdAtUnixMs,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BlockedUser) Reset() {
+	*x = BlockedUser{}
+	mi := &file_api_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockedUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockedUser) ProtoMessage() {}
+
+func (x *BlockedUser) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockedUser.ProtoReflect.Descriptor instead.
+func (*BlockedUser) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *BlockedUser) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BlockedUser) GetCreatedAtUnixMs() int64 {
+	if x != nil {
+		return x.CreatedAtUnixMs
+	}
+	return 0
+}
+
+// ListBlockedUsersResponse - ответ ListBlockedUsers
+type ListBlockedUsersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// users - заблокированные пользователи от новых к старым
+	Users []*BlockedUser `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	// nextCursor - следующий курсор для пагинации
+	NextCursor    *string `protobuf:"bytes,2,opt,name=nextCursor,proto3,oneof" json:"nextCursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBlockedUsersResponse) Reset() {
+	*x = ListBlockedUsersResponse{}
+	mi := &file_api_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlockedUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlockedUsersResponse) ProtoMessage() {}
+
+func (x *ListBlockedUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlockedUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListBlockedUsersResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListBlockedUsersResponse) GetUsers() []*BlockedUser {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ListBlockedUsersResponse) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
+	}
+	return ""
+}
+
+// CheckBlockedRequest - запрос CheckBlocked
+type CheckBlockedRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// firstUserId - идентификатор первого пользователя
+	FirstUserId string `protobuf:"bytes,1,opt,name=firstUserId,proto3" json:"firstUserId,omitempty"`
+	// secondUserId - идентификатор второго пользователя
+	SecondUserId  string `protobuf:"bytes,2,opt,name=secondUserId,proto3" json:"secondUserId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckBlockedRequest) Reset() {
+	*x = CheckBlockedRequest{}
+	mi := &file_api_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckBlockedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckBlockedRequest) ProtoMessage() {}
+
+func (x *CheckBlockedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckBlockedRequest.ProtoReflect.Descriptor instead.
+func (*CheckBlockedRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CheckBlockedRequest) GetFirstUserId() string {
+	if x != nil {
+		return x.FirstUserId
+	}
+	return ""
+}
+
+func (x *CheckBlockedRequest) GetSecondUserId() string {
+	if x != nil {
+		return x.SecondUserId
+	}
+	return ""
+}
+
+// CheckBlockedResponse - ответ CheckBlocked
+type CheckBlockedResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// blocked - один из пользователей заблокировал другого
+	Blocked       bool `protobuf:"varint,1,opt,name=blocked,proto3" json:"blocked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckBlockedResponse) Reset() {
+	*x = CheckBlockedResponse{}
+	mi := &file_api_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckBlockedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckBlockedResponse) ProtoMessage() {}
+
+func (x *CheckBlockedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckBlockedResponse.ProtoReflect.Descriptor instead.
+func (*CheckBlockedResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CheckBlockedResponse) GetBlocked() bool {
+	if x != nil {
+		return x.Blocked
+	}
+	return false
+}
+
+// ListMutualFriendsRequest - запрос ListMutualFriends, общие друзья текущего пользователя и userId
+type ListMutualFriendsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// userId - идентификатор второго пользователя
+	UserId string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	// limit - лимит результатов, по умолчанию 50
+	Limit int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// cursor - курсор для пагинации
+	Cursor        *string `protobuf:"bytes,3,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMutualFriendsRequest) Reset() {
+	*x = ListMutualFriendsRequest{}
+	mi := &file_api_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMutualFriendsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMutualFriendsRequest) ProtoMessage() {}
+
+func (x *ListMutualFriendsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMutualFriendsRequest.ProtoReflect.Descriptor instead.
+func (*ListMutualFriendsRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListMutualFriendsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListMutualFriendsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListMutualFriendsRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+// ListMutualFriendsResponse - ответ ListMutualFriends
+type ListMutualFriendsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// userIds - идентификаторы общих друзей
+	UserIds []string `protobuf:"bytes,1,rep,name=userIds,proto3" json:"userIds,omitempty"`
+	// nextCursor - следующий курсор для пагинации
+	NextCursor    *string `protobuf:"bytes,2,opt,name=nextCursor,proto3,oneof" json:"nextCursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMutualFriendsResponse) Reset() {
+	*x = ListMutualFriendsResponse{}
+	mi := &file_api_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMutualFriendsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMutualFriendsResponse) ProtoMessage() {}
+
+func (x *ListMutualFriendsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMutualFriendsResponse.ProtoReflect.Descriptor instead.
+func (*ListMutualFriendsResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListMutualFriendsResponse) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *ListMutualFriendsResponse) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
+	}
+	return ""
+}
+
+// SuggestFriendsRequest - запрос SuggestFriends для текущего пользователя
+type SuggestFriendsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// limit - лимит результатов, по умолчанию 20
+	Limit         int64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestFriendsRequest) Reset() {
+	*x = SuggestFriendsRequest{}
+	mi := &file_api_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestFriendsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestFriendsRequest) ProtoMessage() {}
+
+func (x *SuggestFriendsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestFriendsRequest.ProtoReflect.Descriptor instead.
+func (*SuggestFriendsRequest) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SuggestFriendsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// SuggestedFriend - возможный друг
+type SuggestedFriend struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// userId - идентификатор пользователя
+	UserId string `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	// mutualFriendsCount - число общих друзей
+	MutualFriendsCount int64 `protobuf:"varint,2,opt,name=mutualFriendsCount,proto3" json:"mutualFriendsCount,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SuggestedFriend) Reset() {
+	*x = SuggestedFriend{}
+	mi := &file_api_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestedFriend) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestedFriend) ProtoMessage() {}
+
+func (x *SuggestedFriend) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestedFriend.ProtoReflect.Descriptor instead.
+func (*SuggestedFriend) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SuggestedFriend) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SuggestedFriend) GetMutualFriendsCount() int64 {
+	if x != nil {
+		return x.MutualFriendsCount
+	}
+	return 0
+}
+
+// SuggestFriendsResponse - ответ SuggestFriends
+type SuggestFriendsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// users - возможные друзья, сначала с большим числом общих друзей
+	Users         []*SuggestedFriend `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestFriendsResponse) Reset() {
+	*x = SuggestFriendsResponse{}
+	mi := &file_api_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestFriendsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestFriendsResponse) ProtoMessage() {}
+
+func (x *SuggestFriendsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestFriendsResponse.ProtoReflect.Descriptor instead.
+func (*SuggestFriendsResponse) Descriptor() ([]byte, []int) {
+	return file_api_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SuggestFriendsResponse) GetUsers() []*SuggestedFriend {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+var File_api_service_proto protoreflect.FileDescriptor
+
+const file_api_service_proto_rawDesc = "" +
+	"\n" +
+	"\x11api/service.proto\x12?github.com.krus210.balun_microservices.protobuf.social.v1.proto\x1a\x1bbuf/validate/validate.proto\"\xd7\x01\n" +
 	"\rFriendRequest\x12\x1c\n" +
 	"\trequestId\x18\x01 \x01(\tR\trequestId\x12\x1e\n" +
 	"\n" +
@@ -730,11 +1796,20 @@ const file_api_service_proto_rawDesc = "" +
 	"\x18SendFriendRequestRequest\x12\x1a\n" +
 	"\btoUserId\x18\x01 \x01(\tR\btoUserId\"\x91\x01\n" +
 	"\x19SendFriendRequestResponse\x12t\n" +
-	"\rfriendRequest\x18\x01 \x01(\v2N.github.com.krus210.balun_microservices.protobuf.social.v1.proto.FriendRequestR\rfriendRequest\"1\n" +
-	"\x13ListRequestsRequest\x12\x1a\n" +
-	"\btoUserId\x18\x01 \x01(\tR\btoUserId\"\x82\x01\n" +
+	"\rfriendRequest\x18\x01 \x01(\v2N.github.com.krus210.balun_microservices.protobuf.social.v1.proto.FriendRequestR\rfriendRequest\"\xe7\x02\n" +
+	"\x13ListRequestsRequest\x12\x1e\n" +
+	"\btoUserId\x18\x01 \x01(\tB\x02\x18\x01R\btoUserId\x12u\n" +
+	"\tdirection\x18\x02 \x01(\x0e2W.github.com.krus210.balun_microservices.protobuf.social.v1.proto.FriendRequestDirectionR\tdirection\x12p\n" +
+	"\bstatuses\x18\x03 \x03(\x0e2T.github.com.krus210.balun_microservices.protobuf.social.v1.proto.FriendRequestStatusR\bstatuses\x12\x1f\n" +
+	"\x05limit\x18\x04 \x01(\x03B\t\xbaH\x06\"\x04\x18d(\x00R\x05limit\x12\x1b\n" +
+	"\x06cursor\x18\x05 \x01(\tH\x00R\x06cursor\x88\x01\x01B\t\n" +
+	"\a_cursor\"\xb6\x01\n" +
 	"\x14ListRequestsResponse\x12j\n" +
-	"\brequests\x18\x01 \x03(\v2N.github.com.krus210.balun_microservices.protobuf.social.v1.proto.FriendRequestR\brequests\":\n" +
+	"\brequests\x18\x01 \x03(\v2N.github.com.krus210.balun_microservices.protobuf.social.v1.proto.FriendRequestR\brequests\x12#\n" +
+	"\n" +
+	"nextCursor\x18\x02 \x01(\tH\x00R\n" +
+	"nextCursor\x88\x01\x01B\r\n" +
+	"\v_nextCursor\":\n" +
 	"\x1aAcceptFriendRequestRequest\x12\x1c\n" +
 	"\trequestId\x18\x01 \x01(\tR\trequestId\"\x93\x01\n" +
 	"\x1bAcceptFriendRequestResponse\x12t\n" +
@@ -742,32 +1817,105 @@ const file_api_service_proto_rawDesc = "" +
 	"\x1bDeclineFriendRequestRequest\x12\x1c\n" +
 	"\trequestId\x18\x01 \x01(\tR\trequestId\"\x94\x01\n" +
 	"\x1cDeclineFriendRequestResponse\x12t\n" +
-	"\rfriendRequest\x18\x01 \x01(\v2N.github.com.krus210.balun_microservices.protobuf.social.v1.proto.FriendRequestR\rfriendRequest\"-\n" +
+	"\rfriendRequest\x18\x01 \x01(\v2N.github.com.krus210.balun_microservices.protobuf.social.v1.proto.FriendRequestR\rfriendRequest\":\n" +
+	"\x1aCancelFriendRequestRequest\x12\x1c\n" +
+	"\trequestId\x18\x01 \x01(\tR\trequestId\"\x93\x01\n" +
+	"\x1bCancelFriendRequestResponse\x12t\n" +
+	"\rfriendRequest\x18\x01 \x01(\v2N.github.com.krus210.balun_microservices.protobuf.social.v1.proto.FriendRequestR\rfriendRequest\"f\n" +
+	"\x1bListOutgoingRequestsRequest\x12\x1f\n" +
+	"\x05limit\x18\x01 \x01(\x03B\t\xbaH\x06\"\x04\x18d(\x00R\x05limit\x12\x1b\n" +
+	"\x06cursor\x18\x02 \x01(\tH\x00R\x06cursor\x88\x01\x01B\t\n" +
+	"\a_cursor\"\xbe\x01\n" +
+	"\x1cListOutgoingRequestsResponse\x12j\n" +
+	"\brequests\x18\x01 \x03(\v2N.github.com.krus210.balun_microservices.protobuf.social.v1.proto.FriendRequestR\brequests\x12#\n" +
+	"\n" +
+	"nextCursor\x18\x02 \x01(\tH\x00R\n" +
+	"nextCursor\x88\x01\x01B\r\n" +
+	"\v_nextCursor\"-\n" +
 	"\x13RemoveFriendRequest\x12\x16\n" +
 	"\x06userId\x18\x01 \x01(\tR\x06userId\"\x16\n" +
-	"\x14RemoveFriendResponse\"j\n" +
+	"\x14RemoveFriendResponse\"u\n" +
 	"\x12ListFriendsRequest\x12\x16\n" +
-	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
-	"\x05limit\x18\x02 \x01(\x03R\x05limit\x12\x1b\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\x05limit\x18\x02 \x01(\x03B\t\xbaH\x06\"\x04\x18d(\x00R\x05limit\x12\x1b\n" +
 	"\x06cursor\x18\x03 \x01(\tH\x00R\x06cursor\x88\x01\x01B\t\n" +
-	"\a_cursor\"o\n" +
+	"\a_cursor\"J\n" +
+	"\x06Friend\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12(\n" +
+	"\x0fcreatedAtUnixMs\x18\x02 \x01(\x03R\x0fcreatedAtUnixMs\"\xd2\x01\n" +
 	"\x13ListFriendsResponse\x12$\n" +
 	"\rfriendUserIds\x18\x01 \x03(\tR\rfriendUserIds\x12#\n" +
 	"\n" +
 	"nextCursor\x18\x02 \x01(\tH\x00R\n" +
+	"nextCursor\x88\x01\x01\x12a\n" +
+	"\afriends\x18\x03 \x03(\v2G.github.com.krus210.balun_microservices.protobuf.social.v1.proto.FriendR\afriendsB\r\n" +
+	"\v_nextCursor\"*\n" +
+	"\x10BlockUserRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\"\x13\n" +
+	"\x11BlockUserResponse\",\n" +
+	"\x12UnblockUserRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\"\x15\n" +
+	"\x13UnblockUserResponse\"b\n" +
+	"\x17ListBlockedUsersRequest\x12\x1f\n" +
+	"\x05limit\x18\x01 \x01(\x03B\t\xbaH\x06\"\x04\x18d(\x00R\x05limit\x12\x1b\n" +
+	"\x06cursor\x18\x02 \x01(\tH\x00R\x06cursor\x88\x01\x01B\t\n" +
+	"\a_cursor\"O\n" +
+	"\vBlockedUser\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12(\n" +
+	"\x0fcreatedAtUnixMs\x18\x02 \x01(\x03R\x0fcreatedAtUnixMs\"\xb2\x01\n" +
+	"\x18ListBlockedUsersResponse\x12b\n" +
+	"\x05users\x18\x01 \x03(\v2L.github.com.krus210.balun_microservices.protobuf.social.v1.proto.BlockedUserR\x05users\x12#\n" +
+	"\n" +
+	"nextCursor\x18\x02 \x01(\tH\x00R\n" +
+	"nextCursor\x88\x01\x01B\r\n" +
+	"\v_nextCursor\"[\n" +
+	"\x13CheckBlockedRequest\x12 \n" +
+	"\vfirstUserId\x18\x01 \x01(\tR\vfirstUserId\x12\"\n" +
+	"\fsecondUserId\x18\x02 \x01(\tR\fsecondUserId\"0\n" +
+	"\x14CheckBlockedResponse\x12\x18\n" +
+	"\ablocked\x18\x01 \x01(\bR\ablocked\"{\n" +
+	"\x18ListMutualFriendsRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\x05limit\x18\x02 \x01(\x03B\t\xbaH\x06\"\x04\x18d(\x00R\x05limit\x12\x1b\n" +
+	"\x06cursor\x18\x03 \x01(\tH\x00R\x06cursor\x88\x01\x01B\t\n" +
+	"\a_cursor\"i\n" +
+	"\x19ListMutualFriendsResponse\x12\x18\n" +
+	"\auserIds\x18\x01 \x03(\tR\auserIds\x12#\n" +
+	"\n" +
+	"nextCursor\x18\x02 \x01(\tH\x00R\n" +
 	"nextCursor\x88\x01\x01B\r\n" +
-	"\v_nextCursor*\x80\x01\n" +
+	"\v_nextCursor\"8\n" +
+	"\x15SuggestFriendsRequest\x12\x1f\n" +
+	"\x05limit\x18\x01 \x01(\x03B\t\xbaH\x06\"\x04\x182(\x00R\x05limit\"Y\n" +
+	"\x0fSuggestedFriend\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\tR\x06userId\x12.\n" +
+	"\x12mutualFriendsCount\x18\x02 \x01(\x03R\x12mutualFriendsCount\"\x80\x01\n" +
+	"\x16SuggestFriendsResponse\x12f\n" +
+	"\x05users\x18\x01 \x03(\v2P.github.com.krus210.balun_microservices.protobuf.social.v1.proto.SuggestedFriendR\x05users*\xc8\x01\n" +
 	"\x13FriendRequestStatus\x12!\n" +
 	"\x1dFRIEND_REQUEST_STATUS_PENDING\x10\x00\x12\"\n" +
 	"\x1eFRIEND_REQUEST_STATUS_ACCEPTED\x10\x01\x12\"\n" +
-	"\x1eFRIEND_REQUEST_STATUS_DECLINED\x10\x022\xc8\t\n" +
+	"\x1eFRIEND_REQUEST_STATUS_DECLINED\x10\x02\x12#\n" +
+	"\x1fFRIEND_REQUEST_STATUS_CANCELLED\x10\x03\x12!\n" +
+	"\x1dFRIEND_REQUEST_STATUS_EXPIRED\x10\x04*f\n" +
+	"\x16FriendRequestDirection\x12%\n" +
+	"!FRIEND_REQUEST_DIRECTION_INCOMING\x10\x00\x12%\n" +
+	"!FRIEND_REQUEST_DIRECTION_OUTGOING\x10\x012\x8a\x16\n" +
 	"\rSocialService\x12\xcc\x01\n" +
 	"\x11SendFriendRequest\x12Y.github.com.krus210.balun_microservices.protobuf.social.v1.proto.SendFriendRequestRequest\x1aZ.github.com.krus210.balun_microservices.protobuf.social.v1.proto.SendFriendRequestResponse\"\x00\x12\xbd\x01\n" +
 	"\fListRequests\x12T.github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListRequestsRequest\x1aU.github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListRequestsResponse\"\x00\x12\xd2\x01\n" +
 	"\x13AcceptFriendRequest\x12[.github.com.krus210.balun_microservices.protobuf.social.v1.proto.AcceptFriendRequestRequest\x1a\\.github.com.krus210.balun_microservices.protobuf.social.v1.proto.AcceptFriendRequestResponse\"\x00\x12\xd5\x01\n" +
-	"\x14DeclineFriendRequest\x12\\.github.com.krus210.balun_microservices.protobuf.social.v1.proto.DeclineFriendRequestRequest\x1a].github.com.krus210.balun_microservices.protobuf.social.v1.proto.DeclineFriendRequestResponse\"\x00\x12\xbd\x01\n" +
+	"\x14DeclineFriendRequest\x12\\.github.com.krus210.balun_microservices.protobuf.social.v1.proto.DeclineFriendRequestRequest\x1a].github.com.krus210.balun_microservices.protobuf.social.v1.proto.DeclineFriendRequestResponse\"\x00\x12\xd2\x01\n" +
+	"\x13CancelFriendRequest\x12[.github.com.krus210.balun_microservices.protobuf.social.v1.proto.CancelFriendRequestRequest\x1a\\.github.com.krus210.balun_microservices.protobuf.social.v1.proto.CancelFriendRequestResponse\"\x00\x12\xd5\x01\n" +
+	"\x14ListOutgoingRequests\x12\\.github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListOutgoingRequestsRequest\x1a].github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListOutgoingRequestsResponse\"\x00\x12\xbd\x01\n" +
 	"\fRemoveFriend\x12T.github.com.krus210.balun_microservices.protobuf.social.v1.proto.RemoveFriendRequest\x1aU.github.com.krus210.balun_microservices.protobuf.social.v1.proto.RemoveFriendResponse\"\x00\x12\xba\x01\n" +
-	"\vListFriends\x12S.github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListFriendsRequest\x1aT.github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListFriendsResponse\"\x00B\x14Z\x12pkg/api;service_pbb\x06proto3"
+	"\vListFriends\x12S.github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListFriendsRequest\x1aT.github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListFriendsResponse\"\x00\x12\xb4\x01\n" +
+	"\tBlockUser\x12Q.github.com.krus210.balun_microservices.protobuf.social.v1.proto.BlockUserRequest\x1aR.github.com.krus210.balun_microservices.protobuf.social.v1.proto.BlockUserResponse\"\x00\x12\xba\x01\n" +
+	"\vUnblockUser\x12S.github.com.krus210.balun_microservices.protobuf.social.v1.proto.UnblockUserRequest\x1aT.github.com.krus210.balun_microservices.protobuf.social.v1.proto.UnblockUserResponse\"\x00\x12\xc9\x01\n" +
+	"\x10ListBlockedUsers\x12X.github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListBlockedUsersRequest\x1aY.github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListBlockedUsersResponse\"\x00\x12\xbd\x01\n" +
+	"\fCheckBlocked\x12T.github.com.krus210.balun_microservices.protobuf.social.v1.proto.CheckBlockedRequest\x1aU.github.com.krus210.balun_microservices.protobuf.social.v1.proto.CheckBlockedResponse\"\x00\x12\xcc\x01\n" +
+	"\x11ListMutualFriends\x12Y.github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListMutualFriendsRequest\x1aZ.github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListMutualFriendsResponse\"\x00\x12\xc3\x01\n" +
+	"\x0eSuggestFriends\x12V.github.com.krus210.balun_microservices.protobuf.social.v1.proto.SuggestFriendsRequest\x1aW.github.com.krus210.balun_microservices.protobuf.social.v1.proto.SuggestFriendsResponse\"\x00B\x14Z\x12pkg/api;service_pbb\x06proto3"
 
 var (
 	file_api_service_proto_rawDescOnce sync.Once
@@ -781,47 +1929,90 @@ func file_api_service_proto_rawDescGZIP() []byte {
 	return file_api_service_proto_rawDescData
 }
 
-var file_api_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_service_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_api_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_api_service_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
 var file_api_service_proto_goTypes = []any{
 	(FriendRequestStatus)(0),             // 0: github.com.krus210.balun_microservices.protobuf.social.v1.proto.FriendRequestStatus
-	(*FriendRequest)(nil),                // 1: github.com.krus210.balun_microservices.protobuf.social.v1.proto.FriendRequest
-	(*SendFriendRequestRequest)(nil),     // 2: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SendFriendRequestRequest
-	(*SendFriendRequestResponse)(nil),    // 3: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SendFriendRequestResponse
-	(*ListRequestsRequest)(nil),          // 4: github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListRequestsRequest
-	(*ListRequestsResponse)(nil),         // 5: github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListRequestsResponse
-	(*AcceptFriendRequestRequest)(nil),   // 6: github.com.krus210.balun_microservices.protobuf.social.v1.proto.AcceptFriendRequestRequest
-	(*AcceptFriendRequestResponse)(nil),  // 7: github.com.krus210.balun_microservices.protobuf.social.v1.proto.AcceptFriendRequestResponse
-	(*DeclineFriendRequestRequest)(nil),  // 8: github.com.krus210.balun_microservices.protobuf.social.v1.proto.DeclineFriendRequestRequest
-	(*DeclineFriendRequestResponse)(nil), // 9: github.com.krus210.balun_microservices.protobuf.social.v1.proto.DeclineFriendRequestResponse
-	(*RemoveFriendRequest)(nil),          // 10: github.com.krus210.balun_microservices.protobuf.social.v1.proto.RemoveFriendRequest
-	(*RemoveFriendResponse)(nil),         // 11: github.com.krus210.balun_microservices.protobuf.social.v1.proto.RemoveFriendResponse
-	(*ListFriendsRequest)(nil),           // 12: github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListFriendsRequest
-	(*ListFriendsResponse)(nil),          // 13: github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListFriendsResponse
+	(FriendRequestDirection)(0),          // 1: github.com.krus210.balun_microservices.protobuf.social.v1.proto.FriendRequestDirection
+	(*FriendRequest)(nil),                // 2: github.com.krus210.balun_microservices.protobuf.social.v1.proto.FriendRequest
+	(*SendFriendRequestRequest)(nil),     // 3: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SendFriendRequestRequest
+	(*SendFriendRequestResponse)(nil),    // 4: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SendFriendRequestResponse
+	(*ListRequestsRequest)(nil),          // 5: github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListRequestsRequest
+	(*ListRequestsResponse)(nil),         // 6: github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListRequestsResponse
+	(*AcceptFriendRequestRequest)(nil),   // 7: github.com.krus210.balun_microservices.protobuf.social.v1.proto.AcceptFriendRequestRequest
+	(*AcceptFriendRequestResponse)(nil),  // 8: github.com.krus210.balun_microservices.protobuf.social.v1.proto.AcceptFriendRequestResponse
+	(*DeclineFriendRequestRequest)(nil),  // 9: github.com.krus210.balun_microservices.protobuf.social.v1.proto.DeclineFriendRequestRequest
+	(*DeclineFriendRequestResponse)(nil), // 10: github.com.krus210.balun_microservices.protobuf.social.v1.proto.DeclineFriendRequestResponse
+	(*CancelFriendRequestRequest)(nil),   // 11: github.com.krus210.balun_microservices.protobuf.social.v1.proto.CancelFriendRequestRequest
+	(*CancelFriendRequestResponse)(nil),  // 12: github.com.krus210.balun_microservices.protobuf.social.v1.proto.CancelFriendRequestResponse
+	(*ListOutgoingRequestsRequest)(nil),  // 13: github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListOutgoingRequestsRequest
+	(*ListOutgoingRequestsResponse)(nil), // 14: github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListOutgoingRequestsResponse
+	(*RemoveFriendRequest)(nil),          // 15: github.com.krus210.balun_microservices.protobuf.social.v1.proto.RemoveFriendRequest
+	(*RemoveFriendResponse)(nil),         // 16: github.com.krus210.balun_microservices.protobuf.social.v1.proto.RemoveFriendResponse
+	(*ListFriendsRequest)(nil),           // 17: github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListFriendsRequest
+	(*Friend)(nil),                       // 18: github.com.krus210.balun_microservices.protobuf.social.v1.proto.Friend
+	(*ListFriendsResponse)(nil),          // 19: github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListFriendsResponse
+	(*BlockUserRequest)(nil),             // 20: github.com.krus210.balun_microservices.protobuf.social.v1.proto.BlockUserRequest
+	(*BlockUserResponse)(nil),            // 21: github.com.krus210.balun_microservices.protobuf.social.v1.proto.BlockUserResponse
+	(*UnblockUserRequest)(nil),           // 22: github.com.krus210.balun_microservices.protobuf.social.v1.proto.UnblockUserRequest
+	(*UnblockUserResponse)(nil),          // 23: github.com.krus210.balun_microservices.protobuf.social.v1.proto.UnblockUserResponse
+	(*ListBlockedUsersRequest)(nil),      // 24: github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListBlockedUsersRequest
+	(*BlockedUser)(nil),                  // 25: github.com.krus210.balun_microservices.protobuf.social.v1.proto.BlockedUser
+	(*ListBlockedUsersResponse)(nil),     // 26: github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListBlockedUsersResponse
+	(*CheckBlockedRequest)(nil),          // 27: github.com.krus210.balun_microservices.protobuf.social.v1.proto.CheckBlockedRequest
+	(*CheckBlockedResponse)(nil),         // 28: github.com.krus210.balun_microservices.protobuf.social.v1.proto.CheckBlockedResponse
+	(*ListMutualFriendsRequest)(nil),     // 29: github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListMutualFriendsRequest
+	(*ListMutualFriendsResponse)(nil),    // 30: github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListMutualFriendsResponse
+	(*SuggestFriendsRequest)(nil),        // 31: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SuggestFriendsRequest
+	(*SuggestedFriend)(nil),              // 32: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SuggestedFriend
+	(*SuggestFriendsResponse)(nil),       // 33: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SuggestFriendsResponse
 }
 var file_api_service_proto_depIdxs = []int32{
 	0,  // 0: github.com.krus210.balun_microservices.protobuf.social.v1.proto.FriendRequest.status:type_name -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.FriendRequestStatus
-	1,  // 1: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SendFriendRequestResponse.friendRequest:type_name -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.FriendRequest
-	1,  // 2: github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListRequestsResponse.requests:type_name -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.FriendRequest
-	1,  // 3: github.com.krus210.balun_microservices.protobuf.social.v1.proto.AcceptFriendRequestResponse.friendRequest:type_name -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.FriendRequest
-	1,  // 4: github.com.krus210.balun_microservices.protobuf.social.v1.proto.DeclineFriendRequestResponse.friendRequest:type_name -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.FriendRequest
-	2,  // 5: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SocialService.SendFriendRequest:input_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.SendFriendRequestRequest
-	4,  // 6: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SocialService.ListRequests:input_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListRequestsRequest
-	6,  // 7: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SocialService.AcceptFriendRequest:input_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.AcceptFriendRequestRequest
-	8,  // 8: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SocialService.DeclineFriendRequest:input_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.DeclineFriendRequestRequest
-	10, // 9: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SocialService.RemoveFriend:input_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.RemoveFriendRequest
-	12, // 10: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SocialService.ListFriends:input_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListFriendsRequest
-	3,  // 11: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SocialService.SendFriendRequest:output_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.SendFriendRequestResponse
-	5,  // 12: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SocialService.ListRequests:output_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListRequestsResponse
-	7,  // 13: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SocialService.AcceptFriendRequest:output_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.AcceptFriendRequestResponse
-	9,  // 14: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SocialService.DeclineFriendRequest:output_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.DeclineFriendRequestResponse
-	11, // 15: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SocialService.RemoveFriend:output_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.RemoveFriendResponse
-	13, // 16: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SocialService.ListFriends:output_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListFriendsResponse
-	11, // [11:17] is the sub-list for method output_type
-	5,  // [5:11] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	2,  // 1: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SendFriendRequestResponse.friendRequest:type_name -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.FriendRequest
+	1,  // 2: github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListRequestsRequest.direction:type_name -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.FriendRequestDirection
+	0,  // 3: github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListRequestsRequest.statuses:type_name -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.FriendRequestStatus
+	2,  // 4: github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListRequestsResponse.requests:type_name -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.FriendRequest
+	2,  // 5: github.com.krus210.balun_microservices.protobuf.social.v1.proto.AcceptFriendRequestResponse.friendRequest:type_name -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.FriendRequest
+	2,  // 6: github.com.krus210.balun_microservices.protobuf.social.v1.proto.DeclineFriendRequestResponse.friendRequest:type_name -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.FriendRequest
+	2,  // 7: github.com.krus210.balun_microservices.protobuf.social.v1.proto.CancelFriendRequestResponse.friendRequest:type_name -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.FriendRequest
+	2,  // 8: github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListOutgoingRequestsResponse.requests:type_name -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.FriendRequest
+	18, // 9: github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListFriendsResponse.friends:type_name -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.Friend
+	25, // 10: github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListBlockedUsersResponse.users:type_name -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.BlockedUser
+	32, // 11: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SuggestFriendsResponse.users:type_name -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.SuggestedFriend
+	3,  // 12: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SocialService.SendFriendRequest:input_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.SendFriendRequestRequest
+	5,  // 13: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SocialService.ListRequests:input_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListRequestsRequest
+	7,  // 14: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SocialService.AcceptFriendRequest:input_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.AcceptFriendRequestRequest
+	9,  // 15: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SocialService.DeclineFriendRequest:input_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.DeclineFriendRequestRequest
+	11, // 16: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SocialService.CancelFriendRequest:input_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.CancelFriendRequestRequest
+	13, // 17: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SocialService.ListOutgoingRequests:input_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListOutgoingRequestsRequest
+	15, // 18: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SocialService.RemoveFriend:input_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.RemoveFriendRequest
+	17, // 19: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SocialService.ListFriends:input_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListFriendsRequest
+	20, // 20: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SocialService.BlockUser:input_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.BlockUserRequest
+	22, // 21: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SocialService.UnblockUser:input_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.UnblockUserRequest
+	24, // 22: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SocialService.ListBlockedUsers:input_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListBlockedUsersRequest
+	27, // 23: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SocialService.CheckBlocked:input_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.CheckBlockedRequest
+	29, // 24: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SocialService.ListMutualFriends:input_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListMutualFriendsRequest
+	31, // 25: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SocialService.SuggestFriends:input_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.SuggestFriendsRequest
+	4,  // 26: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SocialService.SendFriendRequest:output_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.SendFriendRequestResponse
+	6,  // 27: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SocialService.ListRequests:output_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListRequestsResponse
+	8,  // 28: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SocialService.AcceptFriendRequest:output_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.AcceptFriendRequestResponse
+	10, // 29: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SocialService.DeclineFriendRequest:output_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.DeclineFriendRequestResponse
+	12, // 30: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SocialService.CancelFriendRequest:output_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.CancelFriendRequestResponse
+	14, // 31: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SocialService.ListOutgoingRequests:output_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListOutgoingRequestsResponse
+	16, // 32: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SocialService.RemoveFriend:output_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.RemoveFriendResponse
+	19, // 33: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SocialService.ListFriends:output_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListFriendsResponse
+	21, // 34: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SocialService.BlockUser:output_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.BlockUserResponse
+	23, // 35: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SocialService.UnblockUser:output_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.UnblockUserResponse
+	26, // 36: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SocialService.ListBlockedUsers:output_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListBlockedUsersResponse
+	28, // 37: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SocialService.CheckBlocked:output_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.CheckBlockedResponse
+	30, // 38: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SocialService.ListMutualFriends:output_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.ListMutualFriendsResponse
+	33, // 39: github.com.krus210.balun_microservices.protobuf.social.v1.proto.SocialService.SuggestFriends:output_type -> github.com.krus210.balun_microservices.protobuf.social.v1.proto.SuggestFriendsResponse
+	26, // [26:40] is the sub-list for method output_type
+	12, // [12:26] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_api_service_proto_init() }
@@ -829,15 +2020,23 @@ func file_api_service_proto_init() {
 	if File_api_service_proto != nil {
 		return
 	}
+	file_api_service_proto_msgTypes[3].OneofWrappers = []any{}
+	file_api_service_proto_msgTypes[4].OneofWrappers = []any{}
 	file_api_service_proto_msgTypes[11].OneofWrappers = []any{}
 	file_api_service_proto_msgTypes[12].OneofWrappers = []any{}
+	file_api_service_proto_msgTypes[15].OneofWrappers = []any{}
+	file_api_service_proto_msgTypes[17].OneofWrappers = []any{}
+	file_api_service_proto_msgTypes[22].OneofWrappers = []any{}
+	file_api_service_proto_msgTypes[24].OneofWrappers = []any{}
+	file_api_service_proto_msgTypes[27].OneofWrappers = []any{}
+	file_api_service_proto_msgTypes[28].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_service_proto_rawDesc), len(file_api_service_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   13,
+			NumEnums:      2,
+			NumMessages:   32,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -23,8 +23,16 @@ const (
 	SocialService_ListRequests_FullMethodName         = "/github.com.krus210.balun_microservices.protobuf.social.v1.proto.SocialService/ListRequests"
 	SocialService_AcceptFriendRequest_FullMethodName  = "/github.com.krus210.balun_microservices.protobuf.social.v1.proto.SocialService/AcceptFriendRequest"
 	SocialService_DeclineFriendRequest_FullMethodName = "/github.com.krus210.balun_microservices.protobuf.social.v1.proto.SocialService/DeclineFriendRequest"
+	SocialService_CancelFriendRequest_FullMethodName  = "/github.com.krus210.balun_microservices.protobuf.social.v1.proto.SocialService/CancelFriendRequest"
+	SocialService_ListOutgoingRequests_FullMethodName = "/github.com.krus210.balun_microservices.protobuf.social.v1.proto.SocialService/ListOutgoingRequests"
 	SocialService_RemoveFriend_FullMethodName         = "/github.com.krus210.balun_microservices.protobuf.social.v1.proto.SocialService/RemoveFriend"
 	SocialService_ListFriends_FullMethodName          = "/github.com.krus210.balun_microservices.protobuf.social.v1.proto.SocialService/ListFriends"
+	SocialService_BlockUser_FullMethodName            = "/github.com.krus210.balun_microservices.protobuf.social.v1.proto.SocialService/BlockUser"
+	SocialService_UnblockUser_FullMethodName          = "/github.com.krus210.balun_microservices.protobuf.social.v1.proto.SocialService/UnblockUser"
+	SocialService_ListBlockedUsers_FullMethodName     = "/github.com.krus210.balun_microservices.protobuf.social.v1.proto.SocialService/ListBlockedUsers"
+	SocialService_CheckBlocked_FullMethodName         = "/github.com.krus210.balun_microservices.protobuf.social.v1.proto.SocialService/CheckBlocked"
+	SocialService_ListMutualFriends_FullMethodName    = "/github.com.krus210.balun_microservices.protobuf.social.v1.proto.SocialService/ListMutualFriends"
+	SocialService_SuggestFriends_FullMethodName       = "/github.com.krus210.balun_microservices.protobuf.social.v1.proto.SocialService/SuggestFriends"
 )
 
 // SocialServiceClient is the client API for SocialService service.
@@ -35,16 +43,34 @@ const (
 type SocialServiceClient interface {
 	// SendFriendRequest - Отправить заявку в друзья
 	SendFriendRequest(ctx context.Context, in *SendFriendRequestRequest, opts ...grpc.CallOption) (*SendFriendRequestResponse, error)
-	// ListRequests - Входящие заявки в друзья
+	// ListRequests - Входящие или исходящие заявки в друзья с фильтром по статусу
 	ListRequests(ctx context.Context, in *ListRequestsRequest, opts ...grpc.CallOption) (*ListRequestsResponse, error)
 	// AcceptFriendRequest - Принять заявку в друзья
 	AcceptFriendRequest(ctx context.Context, in *AcceptFriendRequestRequest, opts ...grpc.CallOption) (*AcceptFriendRequestResponse, error)
 	// DeclineFriendRequest - Отклонить заявку в друзья
 	DeclineFriendRequest(ctx context.Context, in *DeclineFriendRequestRequest, opts ...grpc.CallOption) (*DeclineFriendRequestResponse, error)
+	// CancelFriendRequest - Отозвать отправленную заявку в друзья
+	CancelFriendRequest(ctx context.Context, in *CancelFriendRequestRequest, opts ...grpc.CallOption) (*CancelFriendRequestResponse, error)
+	// ListOutgoingRequests - Исходящие заявки в друзья, ожидающие ответа
+	ListOutgoingRequests(ctx context.Context, in *ListOutgoingRequestsRequest, opts ...grpc.CallOption) (*ListOutgoingRequestsResponse, error)
 	// RemoveFriend - Удалить пользователя из друзей
 	RemoveFriend(ctx context.Context, in *RemoveFriendRequest, opts ...grpc.CallOption) (*RemoveFriendResponse, error)
 	// ListFriends - Список друзей
 	ListFriends(ctx context.Context, in *ListFriendsRequest, opts ...grpc.CallOption) (*ListFriendsResponse, error)
+	// BlockUser - Заблокировать пользователя
+	BlockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*BlockUserResponse, error)
+	// UnblockUser - Разблокировать пользователя
+	UnblockUser(ctx context.Context, in *UnblockUserRequest, opts ...grpc.CallOption) (*UnblockUserResponse, error)
+	// ListBlockedUsers - Список заблокированных пользователей
+	ListBlockedUsers(ctx context.Context, in *ListBlockedUsersRequest, opts ...grpc.CallOption) (*ListBlockedUsersResponse, error)
+	// CheckBlocked - Есть ли блокировка между двумя пользователями в любую сторону,
+	// вызывающий должен быть одним из них
+	CheckBlocked(ctx context.Context, in *CheckBlockedRequest, opts ...grpc.CallOption) (*CheckBlockedResponse, error)
+	// ListMutualFriends - Общие друзья текущего пользователя и другого пользователя
+	ListMutualFriends(ctx context.Context, in *ListMutualFriendsRequest, opts ...grpc.CallOption) (*ListMutualFriendsResponse, error)
+	// SuggestFriends - Возможные друзья: друзья друзей по числу общих друзей.
+	// Строятся только по дружбам, у пользователя без друзей список пустой
+	SuggestFriends(ctx context.Context, in *SuggestFriendsRequest, opts ...grpc.CallOption) (*SuggestFriendsResponse, error)
 }
 
 type socialServiceClient struct {
@@ -95,6 +121,26 @@ func (c *socialServiceClient) DeclineFriendRequest(ctx context.Context, in *Decl
 	return out, nil
 }
 
+func (c *socialServiceClient) CancelFriendRequest(ctx context.Context, in *CancelFriendRequestRequest, opts ...grpc.CallOption) (*CancelFriendRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelFriendRequestResponse)
+	err := c.cc.Invoke(ctx, SocialService_CancelFriendRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *socialServiceClient) ListOutgoingRequests(ctx context.Context, in *ListOutgoingRequestsRequest, opts ...grpc.CallOption) (*ListOutgoingRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOutgoingRequestsResponse)
+	err := c.cc.Invoke(ctx, SocialService_ListOutgoingRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *socialServiceClient) RemoveFriend(ctx context.Context, in *RemoveFriendRequest, opts ...grpc.CallOption) (*RemoveFriendResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RemoveFriendResponse)
@@ -115,6 +161,66 @@ func (c *socialServiceClient) ListFriends(ctx context.Context, in *ListFriendsRe
 	return out, nil
 }
 
+func (c *socialServiceClient) BlockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*BlockUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlockUserResponse)
+	err := c.cc.Invoke(ctx, SocialService_BlockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *socialServiceClient) UnblockUser(ctx context.Context, in *UnblockUserRequest, opts ...grpc.CallOption) (*UnblockUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnblockUserResponse)
+	err := c.cc.Invoke(ctx, SocialService_UnblockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *socialServiceClient) ListBlockedUsers(ctx context.Context, in *ListBlockedUsersRequest, opts ...grpc.CallOption) (*ListBlockedUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBlockedUsersResponse)
+	err := c.cc.Invoke(ctx, SocialService_ListBlockedUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *socialServiceClient) CheckBlocked(ctx context.Context, in *CheckBlockedRequest, opts ...grpc.CallOption) (*CheckBlockedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckBlockedResponse)
+	err := c.cc.Invoke(ctx, SocialService_CheckBlocked_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *socialServiceClient) ListMutualFriends(ctx context.Context, in *ListMutualFriendsRequest, opts ...grpc.CallOption) (*ListMutualFriendsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMutualFriendsResponse)
+	err := c.cc.Invoke(ctx, SocialService_ListMutualFriends_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *socialServiceClient) SuggestFriends(ctx context.Context, in *SuggestFriendsRequest, opts ...grpc.CallOption) (*SuggestFriendsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestFriendsResponse)
+	err := c.cc.Invoke(ctx, SocialService_SuggestFriends_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SocialServiceServer is the server API for SocialService service.
 // All implementations must embed UnimplementedSocialServiceServer
 // for forward compatibility.
@@ -123,16 +229,34 @@ func (c *socialServiceClient) ListFriends(ctx context.Context, in *ListFriendsRe
 type SocialServiceServer interface {
 	// SendFriendRequest - Отправить заявку в друзья
 	SendFriendRequest(context.Context, *SendFriendRequestRequest) (*SendFriendRequestResponse, error)
-	// ListRequests - Входящие заявки в друзья
+	// ListRequests - Входящие или исходящие заявки в друзья с фильтром по статусу
 	ListRequests(context.Context, *ListRequestsRequest) (*ListRequestsResponse, error)
 	// AcceptFriendRequest - Принять заявку в друзья
 	AcceptFriendRequest(context.Context, *AcceptFriendRequestRequest) (*AcceptFriendRequestResponse, error)
 	// DeclineFriendRequest - Отклонить заявку в друзья
 	DeclineFriendRequest(context.Context, *DeclineFriendRequestRequest) (*DeclineFriendRequestResponse, error)
+	// CancelFriendRequest - Отозвать отправленную заявку в друзья
+	CancelFriendRequest(context.Context, *CancelFriendRequestRequest) (*CancelFriendRequestResponse, error)
+	// ListOutgoingRequests - Исходящие заявки в друзья, ожидающие ответа
+	ListOutgoingRequests(context.Context, *ListOutgoingRequestsRequest) (*ListOutgoingRequestsResponse, error)
 	// RemoveFriend - Удалить пользователя из друзей
 	RemoveFriend(context.Context, *RemoveFriendRequest) (*RemoveFriendResponse, error)
 	// ListFriends - Список друзей
 	ListFriends(context.Context, *ListFriendsRequest) (*ListFriendsResponse, error)
+	// BlockUser - Заблокировать пользователя
+	BlockUser(context.Context, *BlockUserRequest) (*BlockUserResponse, error)
+	// UnblockUser - Разблокировать пользователя
+	UnblockUser(context.Context, *UnblockUserRequest) (*UnblockUserResponse, error)
+	// ListBlockedUsers - Список заблокированных пользователей
+	ListBlockedUsers(context.Context, *ListBlockedUsersRequest) (*ListBlockedUsersResponse, error)
+	// CheckBlocked - Есть ли блокировка между двумя пользователями в любую сторону,
+	// вызывающий должен быть одним из них
+	CheckBlocked(context.Context, *CheckBlockedRequest) (*CheckBlockedResponse, error)
+	// ListMutualFriends - Общие друзья текущего пользователя и другого пользователя
+	ListMutualFriends(context.Context, *ListMutualFriendsRequest) (*ListMutualFriendsResponse, error)
+	// SuggestFriends - Возможные друзья: друзья друзей по числу общих друзей.
+	// Строятся только по дружбам, у пользователя без друзей список пустой
+	SuggestFriends(context.Context, *SuggestFriendsRequest) (*SuggestFriendsResponse, error)
 	mustEmbedUnimplementedSocialServiceServer()
 }
 
@@ -155,12 +279,36 @@ func (UnimplementedSocialServiceServer) AcceptFriendRequest(context.Context, *Ac
 func (UnimplementedSocialServiceServer) DeclineFriendRequest(context.Context, *DeclineFriendRequestRequest) (*DeclineFriendRequestResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeclineFriendRequest not implemented")
 }
+func (UnimplementedSocialServiceServer) CancelFriendRequest(context.Context, *CancelFriendRequestRequest) (*CancelFriendRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelFriendRequest not implemented")
+}
+func (UnimplementedSocialServiceServer) ListOutgoingRequests(context.Context, *ListOutgoingRequestsRequest) (*ListOutgoingRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOutgoingRequests not implemented")
+}
 func (UnimplementedSocialServiceServer) RemoveFriend(context.Context, *RemoveFriendRequest) (*RemoveFriendResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveFriend not implemented")
 }
 func (UnimplementedSocialServiceServer) ListFriends(context.Context, *ListFriendsRequest) (*ListFriendsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListFriends not implemented")
 }
+func (UnimplementedSocialServiceServer) BlockUser(context.Context, *BlockUserRequest) (*BlockUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockUser not implemented")
+}
+func (UnimplementedSocialServiceServer) UnblockUser(context.Context, *UnblockUserRequest) (*UnblockUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockUser not implemented")
+}
+func (UnimplementedSocialServiceServer) ListBlockedUsers(context.Context, *ListBlockedUsersRequest) (*ListBlockedUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBlockedUsers not implemented")
+}
+func (UnimplementedSocialServiceServer) CheckBlocked(context.Context, *CheckBlockedRequest) (*CheckBlockedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckBlocked not implemented")
+}
+func (UnimplementedSocialServiceServer) ListMutualFriends(context.Context, *ListMutualFriendsRequest) (*ListMutualFriendsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMutualFriends not implemented")
+}
+func (UnimplementedSocialServiceServer) SuggestFriends(context.Context, *SuggestFriendsRequest) (*SuggestFriendsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestFriends not implemented")
+}
 func (UnimplementedSocialServiceServer) mustEmbedUnimplementedSocialServiceServer() {}
 func (UnimplementedSocialServiceServer) testEmbeddedByValue()                       {}
 
@@ -254,6 +402,42 @@ func _SocialService_DeclineFriendRequest_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SocialService_CancelFriendRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelFriendRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SocialServiceServer).CancelFriendRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SocialService_CancelFriendRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SocialServiceServer).CancelFriendRequest(ctx, req.(*CancelFriendRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SocialService_ListOutgoingRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOutgoingRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SocialServiceServer).ListOutgoingRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SocialService_ListOutgoingRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SocialServiceServer).ListOutgoingRequests(ctx, req.(*ListOutgoingRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SocialService_RemoveFriend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RemoveFriendRequest)
 	if err := dec(in); err != nil {
@@ -290,6 +474,114 @@ func _SocialService_ListFriends_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SocialService_BlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SocialServiceServer).BlockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SocialService_BlockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SocialServiceServer).BlockUser(ctx, req.(*BlockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SocialService_UnblockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnblockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SocialServiceServer).UnblockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SocialService_UnblockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SocialServiceServer).UnblockUser(ctx, req.(*UnblockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SocialService_ListBlockedUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBlockedUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SocialServiceServer).ListBlockedUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SocialService_ListBlockedUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SocialServiceServer).ListBlockedUsers(ctx, req.(*ListBlockedUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SocialService_CheckBlocked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckBlockedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SocialServiceServer).CheckBlocked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SocialService_CheckBlocked_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SocialServiceServer).CheckBlocked(ctx, req.(*CheckBlockedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SocialService_ListMutualFriends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMutualFriendsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SocialServiceServer).ListMutualFriends(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SocialService_ListMutualFriends_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SocialServiceServer).ListMutualFriends(ctx, req.(*ListMutualFriendsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SocialService_SuggestFriends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestFriendsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SocialServiceServer).SuggestFriends(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SocialService_SuggestFriends_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SocialServiceServer).SuggestFriends(ctx, req.(*SuggestFriendsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SocialService_ServiceDesc is the grpc.ServiceDesc for SocialService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -313,6 +605,14 @@ var SocialService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeclineFriendRequest",
 			Handler:    _SocialService_DeclineFriendRequest_Handler,
 		},
+		{
+			MethodName: "CancelFriendRequest",
+			Handler:    _SocialService_CancelFriendRequest_Handler,
+		},
+		{
+			MethodName: "ListOutgoingRequests",
+			Handler:    _SocialService_ListOutgoingRequests_Handler,
+		},
 		{
 			MethodName: "RemoveFriend",
 			Handler:    _SocialService_RemoveFriend_Handler,
@@ -321,6 +621,30 @@ var SocialService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListFriends",
 			Handler:    _SocialService_ListFriends_Handler,
 		},
+		{
+			MethodName: "BlockUser",
+			Handler:    _SocialService_BlockUser_Handler,
+		},
+		{
+			MethodName: "UnblockUser",
+			Handler:    _SocialService_UnblockUser_Handler,
+		},
+		{
+			MethodName: "ListBlockedUsers",
+			Handler:    _SocialService_ListBlockedUsers_Handler,
+		},
+		{
+			MethodName: "CheckBlocked",
+			Handler:    _SocialService_CheckBlocked_Handler,
+		},
+		{
+			MethodName: "ListMutualFriends",
+			Handler:    _SocialService_ListMutualFriends_Handler,
+		},
+		{
+			MethodName: "SuggestFriends",
+			Handler:    _SocialService_SuggestFriends_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/service.proto",
@@ -21,6 +21,15 @@ func (s *UsersService) CreateProfile(ctx context.Context, req dto.CreateProfileR
 		return nil, models.ErrAlreadyExists
 	}
 
+	// Никнейм уникален, auth при этой ошибке откатывает регистрацию
+	byNickname, err := s.usersRepo.GetUserByNickname(ctx, req.Nickname)
+	if err != nil {
+		return nil, fmt.Errorf("%s: usersRepo GetUserByNickname error: %w", apiCreateProfile, err)
+	}
+	if byNickname != nil {
+		return nil, models.ErrAlreadyExists
+	}
+
 	user = &models.UserProfile{
 		UserID:    req.UserID,
 		Nickname:  req.Nickname,